@@ -1027,14 +1027,16 @@ func (x *_BlockedAddressesAdded_1_list) IsValid() bool {
 }
 
 var (
-	md_BlockedAddressesAdded          protoreflect.MessageDescriptor
-	fd_BlockedAddressesAdded_accounts protoreflect.FieldDescriptor
+	md_BlockedAddressesAdded                 protoreflect.MessageDescriptor
+	fd_BlockedAddressesAdded_accounts        protoreflect.FieldDescriptor
+	fd_BlockedAddressesAdded_roles_suspended protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_aura_blocklist_v1_events_proto_init()
 	md_BlockedAddressesAdded = File_aura_blocklist_v1_events_proto.Messages().ByName("BlockedAddressesAdded")
 	fd_BlockedAddressesAdded_accounts = md_BlockedAddressesAdded.Fields().ByName("accounts")
+	fd_BlockedAddressesAdded_roles_suspended = md_BlockedAddressesAdded.Fields().ByName("roles_suspended")
 }
 
 var _ protoreflect.Message = (*fastReflection_BlockedAddressesAdded)(nil)
@@ -1108,6 +1110,12 @@ func (x *fastReflection_BlockedAddressesAdded) Range(f func(protoreflect.FieldDe
 			return
 		}
 	}
+	if x.RolesSuspended != false {
+		value := protoreflect.ValueOfBool(x.RolesSuspended)
+		if !f(fd_BlockedAddressesAdded_roles_suspended, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1125,6 +1133,8 @@ func (x *fastReflection_BlockedAddressesAdded) Has(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "aura.blocklist.v1.BlockedAddressesAdded.accounts":
 		return len(x.Accounts) != 0
+	case "aura.blocklist.v1.BlockedAddressesAdded.roles_suspended":
+		return x.RolesSuspended != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.blocklist.v1.BlockedAddressesAdded"))
@@ -1143,6 +1153,8 @@ func (x *fastReflection_BlockedAddressesAdded) Clear(fd protoreflect.FieldDescri
 	switch fd.FullName() {
 	case "aura.blocklist.v1.BlockedAddressesAdded.accounts":
 		x.Accounts = nil
+	case "aura.blocklist.v1.BlockedAddressesAdded.roles_suspended":
+		x.RolesSuspended = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.blocklist.v1.BlockedAddressesAdded"))
@@ -1165,6 +1177,9 @@ func (x *fastReflection_BlockedAddressesAdded) Get(descriptor protoreflect.Field
 		}
 		listValue := &_BlockedAddressesAdded_1_list{list: &x.Accounts}
 		return protoreflect.ValueOfList(listValue)
+	case "aura.blocklist.v1.BlockedAddressesAdded.roles_suspended":
+		value := x.RolesSuspended
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.blocklist.v1.BlockedAddressesAdded"))
@@ -1189,6 +1204,8 @@ func (x *fastReflection_BlockedAddressesAdded) Set(fd protoreflect.FieldDescript
 		lv := value.List()
 		clv := lv.(*_BlockedAddressesAdded_1_list)
 		x.Accounts = *clv.list
+	case "aura.blocklist.v1.BlockedAddressesAdded.roles_suspended":
+		x.RolesSuspended = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.blocklist.v1.BlockedAddressesAdded"))
@@ -1215,6 +1232,8 @@ func (x *fastReflection_BlockedAddressesAdded) Mutable(fd protoreflect.FieldDesc
 		}
 		value := &_BlockedAddressesAdded_1_list{list: &x.Accounts}
 		return protoreflect.ValueOfList(value)
+	case "aura.blocklist.v1.BlockedAddressesAdded.roles_suspended":
+		panic(fmt.Errorf("field roles_suspended of message aura.blocklist.v1.BlockedAddressesAdded is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.blocklist.v1.BlockedAddressesAdded"))
@@ -1231,6 +1250,8 @@ func (x *fastReflection_BlockedAddressesAdded) NewField(fd protoreflect.FieldDes
 	case "aura.blocklist.v1.BlockedAddressesAdded.accounts":
 		list := []string{}
 		return protoreflect.ValueOfList(&_BlockedAddressesAdded_1_list{list: &list})
+	case "aura.blocklist.v1.BlockedAddressesAdded.roles_suspended":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.blocklist.v1.BlockedAddressesAdded"))
@@ -1306,6 +1327,9 @@ func (x *fastReflection_BlockedAddressesAdded) ProtoMethods() *protoiface.Method
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.RolesSuspended {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1335,6 +1359,16 @@ func (x *fastReflection_BlockedAddressesAdded) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.RolesSuspended {
+			i--
+			if x.RolesSuspended {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
 		if len(x.Accounts) > 0 {
 			for iNdEx := len(x.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.Accounts[iNdEx])
@@ -1425,6 +1459,26 @@ func (x *fastReflection_BlockedAddressesAdded) ProtoMethods() *protoiface.Method
 				}
 				x.Accounts = append(x.Accounts, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RolesSuspended", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RolesSuspended = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2053,6 +2107,8 @@ type BlockedAddressesAdded struct {
 
 	// accounts is the list of addresses that were added to the blocklist.
 	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// roles_suspended is whether the roles of the accounts were suspended on all governed denoms.
+	RolesSuspended bool `protobuf:"varint,2,opt,name=roles_suspended,json=rolesSuspended,proto3" json:"roles_suspended,omitempty"`
 }
 
 func (x *BlockedAddressesAdded) Reset() {
@@ -2082,6 +2138,13 @@ func (x *BlockedAddressesAdded) GetAccounts() []string {
 	return nil
 }
 
+func (x *BlockedAddressesAdded) GetRolesSuspended() bool {
+	if x != nil {
+		return x.RolesSuspended
+	}
+	return false
+}
+
 // BlockedAddressesRemoved is emitted whenever addresses are removed from the blocklist.
 type BlockedAddressesRemoved struct {
 	state         protoimpl.MessageState
@@ -2137,27 +2200,30 @@ var file_aura_blocklist_v1_events_proto_rawDesc = []byte{
 	0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4f, 0x77, 0x6e,
 	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22,
-	0x33, 0x0a, 0x15, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x5c, 0x0a, 0x15, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
 	0x73, 0x65, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f,
 	0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x22, 0x35, 0x0a, 0x17, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0xd3, 0x01, 0x0a, 0x15,
-	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69,
-	0x73, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6f, 0x6e, 0x64, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x75, 0x73,
-	0x64, 0x79, 0x2d, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x75, 0x72, 0x61, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x76,
-	0x31, 0x3b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x41, 0x42, 0x58, 0xaa, 0x02, 0x11, 0x41, 0x75, 0x72, 0x61, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x6c, 0x69, 0x73, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x41, 0x75, 0x72, 0x61, 0x5c, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x41, 0x75,
-	0x72, 0x61, 0x5c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x41, 0x75,
-	0x72, 0x61, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x5f, 0x73, 0x75,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x22, 0x35, 0x0a,
+	0x17, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x42, 0xd3, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x75, 0x72,
+	0x61, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0b,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x6e, 0x64, 0x6f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x75, 0x73, 0x64, 0x79, 0x2d, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x75, 0x72, 0x61, 0x2f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x6c, 0x69, 0x73, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x42, 0x58, 0xaa, 0x02, 0x11, 0x41,
+	0x75, 0x72, 0x61, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x11, 0x41, 0x75, 0x72, 0x61, 0x5c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73,
+	0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x41, 0x75, 0x72, 0x61, 0x5c, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x6c, 0x69, 0x73, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x41, 0x75, 0x72, 0x61, 0x3a, 0x3a, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
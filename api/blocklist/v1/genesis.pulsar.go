@@ -58,11 +58,58 @@ func (x *_GenesisState_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field SuspendedAddresses as it is not of Message kind"))
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                   protoreflect.MessageDescriptor
-	fd_GenesisState_owner             protoreflect.FieldDescriptor
-	fd_GenesisState_pending_owner     protoreflect.FieldDescriptor
-	fd_GenesisState_blocked_addresses protoreflect.FieldDescriptor
+	md_GenesisState                     protoreflect.MessageDescriptor
+	fd_GenesisState_owner               protoreflect.FieldDescriptor
+	fd_GenesisState_pending_owner       protoreflect.FieldDescriptor
+	fd_GenesisState_blocked_addresses   protoreflect.FieldDescriptor
+	fd_GenesisState_suspended_addresses protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -71,6 +118,7 @@ func init() {
 	fd_GenesisState_owner = md_GenesisState.Fields().ByName("owner")
 	fd_GenesisState_pending_owner = md_GenesisState.Fields().ByName("pending_owner")
 	fd_GenesisState_blocked_addresses = md_GenesisState.Fields().ByName("blocked_addresses")
+	fd_GenesisState_suspended_addresses = md_GenesisState.Fields().ByName("suspended_addresses")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -156,6 +204,12 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.SuspendedAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.SuspendedAddresses})
+		if !f(fd_GenesisState_suspended_addresses, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -177,6 +231,8 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.PendingOwner != ""
 	case "aura.blocklist.v1.GenesisState.blocked_addresses":
 		return len(x.BlockedAddresses) != 0
+	case "aura.blocklist.v1.GenesisState.suspended_addresses":
+		return len(x.SuspendedAddresses) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.blocklist.v1.GenesisState"))
@@ -199,6 +255,8 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.PendingOwner = ""
 	case "aura.blocklist.v1.GenesisState.blocked_addresses":
 		x.BlockedAddresses = nil
+	case "aura.blocklist.v1.GenesisState.suspended_addresses":
+		x.SuspendedAddresses = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.blocklist.v1.GenesisState"))
@@ -227,6 +285,12 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_3_list{list: &x.BlockedAddresses}
 		return protoreflect.ValueOfList(listValue)
+	case "aura.blocklist.v1.GenesisState.suspended_addresses":
+		if len(x.SuspendedAddresses) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.SuspendedAddresses}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.blocklist.v1.GenesisState"))
@@ -255,6 +319,10 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_3_list)
 		x.BlockedAddresses = *clv.list
+	case "aura.blocklist.v1.GenesisState.suspended_addresses":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.SuspendedAddresses = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.blocklist.v1.GenesisState"))
@@ -281,6 +349,12 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_3_list{list: &x.BlockedAddresses}
 		return protoreflect.ValueOfList(value)
+	case "aura.blocklist.v1.GenesisState.suspended_addresses":
+		if x.SuspendedAddresses == nil {
+			x.SuspendedAddresses = []string{}
+		}
+		value := &_GenesisState_4_list{list: &x.SuspendedAddresses}
+		return protoreflect.ValueOfList(value)
 	case "aura.blocklist.v1.GenesisState.owner":
 		panic(fmt.Errorf("field owner of message aura.blocklist.v1.GenesisState is not mutable"))
 	case "aura.blocklist.v1.GenesisState.pending_owner":
@@ -305,6 +379,9 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "aura.blocklist.v1.GenesisState.blocked_addresses":
 		list := []string{}
 		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "aura.blocklist.v1.GenesisState.suspended_addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.blocklist.v1.GenesisState"))
@@ -388,6 +465,12 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.SuspendedAddresses) > 0 {
+			for _, s := range x.SuspendedAddresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -417,6 +500,15 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.SuspendedAddresses) > 0 {
+			for iNdEx := len(x.SuspendedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SuspendedAddresses[iNdEx])
+				copy(dAtA[i:], x.SuspendedAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SuspendedAddresses[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.BlockedAddresses) > 0 {
 			for iNdEx := len(x.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.BlockedAddresses[iNdEx])
@@ -585,6 +677,38 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				}
 				x.BlockedAddresses = append(x.BlockedAddresses, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SuspendedAddresses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SuspendedAddresses = append(x.SuspendedAddresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -644,6 +768,9 @@ type GenesisState struct {
 	PendingOwner string `protobuf:"bytes,2,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
 	// blocked_addresses is a list of blocked user addresses.
 	BlockedAddresses []string `protobuf:"bytes,3,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses,omitempty"`
+	// suspended_addresses is a list of blocked user addresses whose roles are
+	// suspended on all governed denoms.
+	SuspendedAddresses []string `protobuf:"bytes,4,rep,name=suspended_addresses,json=suspendedAddresses,proto3" json:"suspended_addresses,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -687,34 +814,44 @@ func (x *GenesisState) GetBlockedAddresses() []string {
 	return nil
 }
 
+func (x *GenesisState) GetSuspendedAddresses() []string {
+	if x != nil {
+		return x.SuspendedAddresses
+	}
+	return nil
+}
+
 var File_aura_blocklist_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_aura_blocklist_v1_genesis_proto_rawDesc = []byte{
 	0x0a, 0x1f, 0x61, 0x75, 0x72, 0x61, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74,
 	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x11, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73,
-	0x74, 0x2e, 0x76, 0x31, 0x22, 0x76, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12,
-	0x2b, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x42, 0xd4, 0x01, 0x0a,
-	0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c,
-	0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6f, 0x6e, 0x64, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
-	0x75, 0x73, 0x64, 0x79, 0x2d, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x75, 0x72, 0x61, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74,
-	0x2f, 0x76, 0x31, 0x3b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x41, 0x42, 0x58, 0xaa, 0x02, 0x11, 0x41, 0x75, 0x72, 0x61, 0x2e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x41, 0x75, 0x72, 0x61,
-	0x5c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d,
-	0x41, 0x75, 0x72, 0x61, 0x5c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13,
-	0x41, 0x75, 0x72, 0x61, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x2e, 0x76, 0x31, 0x22, 0xa7, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x2b, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2f, 0x0a,
+	0x13, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x73, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x42, 0xd4,
+	0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x6e, 0x64, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2f, 0x75, 0x73, 0x64, 0x79, 0x2d, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x75, 0x72, 0x61, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69,
+	0x73, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x41, 0x42, 0x58, 0xaa, 0x02, 0x11, 0x41, 0x75, 0x72, 0x61, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x41, 0x75,
+	0x72, 0x61, 0x5c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1d, 0x41, 0x75, 0x72, 0x61, 0x5c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x13, 0x41, 0x75, 0x72, 0x61, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73,
+	0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Owner_FullMethodName     = "/aura.blocklist.v1.Query/Owner"
-	Query_Addresses_FullMethodName = "/aura.blocklist.v1.Query/Addresses"
-	Query_Address_FullMethodName   = "/aura.blocklist.v1.Query/Address"
+	Query_Owner_FullMethodName         = "/aura.blocklist.v1.Query/Owner"
+	Query_Addresses_FullMethodName     = "/aura.blocklist.v1.Query/Addresses"
+	Query_Address_FullMethodName       = "/aura.blocklist.v1.Query/Address"
+	Query_RoleConflicts_FullMethodName = "/aura.blocklist.v1.Query/RoleConflicts"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +32,7 @@ type QueryClient interface {
 	Owner(ctx context.Context, in *QueryOwner, opts ...grpc.CallOption) (*QueryOwnerResponse, error)
 	Addresses(ctx context.Context, in *QueryAddresses, opts ...grpc.CallOption) (*QueryAddressesResponse, error)
 	Address(ctx context.Context, in *QueryAddress, opts ...grpc.CallOption) (*QueryAddressResponse, error)
+	RoleConflicts(ctx context.Context, in *QueryRoleConflicts, opts ...grpc.CallOption) (*QueryRoleConflictsResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +73,16 @@ func (c *queryClient) Address(ctx context.Context, in *QueryAddress, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) RoleConflicts(ctx context.Context, in *QueryRoleConflicts, opts ...grpc.CallOption) (*QueryRoleConflictsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRoleConflictsResponse)
+	err := c.cc.Invoke(ctx, Query_RoleConflicts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -78,6 +90,7 @@ type QueryServer interface {
 	Owner(context.Context, *QueryOwner) (*QueryOwnerResponse, error)
 	Addresses(context.Context, *QueryAddresses) (*QueryAddressesResponse, error)
 	Address(context.Context, *QueryAddress) (*QueryAddressResponse, error)
+	RoleConflicts(context.Context, *QueryRoleConflicts) (*QueryRoleConflictsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +110,9 @@ func (UnimplementedQueryServer) Addresses(context.Context, *QueryAddresses) (*Qu
 func (UnimplementedQueryServer) Address(context.Context, *QueryAddress) (*QueryAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Address not implemented")
 }
+func (UnimplementedQueryServer) RoleConflicts(context.Context, *QueryRoleConflicts) (*QueryRoleConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleConflicts not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -172,6 +188,24 @@ func _Query_Address_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RoleConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoleConflicts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoleConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RoleConflicts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoleConflicts(ctx, req.(*QueryRoleConflicts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +225,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Address",
 			Handler:    _Query_Address_Handler,
 		},
+		{
+			MethodName: "RoleConflicts",
+			Handler:    _Query_RoleConflicts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "aura/blocklist/v1/query.proto",
@@ -3,6 +3,8 @@ package aurav1
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	v1beta11 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -11,6 +13,8 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -210,757 +214,15472 @@ func (x *_GenesisState_8_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_GenesisState                  protoreflect.MessageDescriptor
-	fd_GenesisState_blocklist_state  protoreflect.FieldDescriptor
-	fd_GenesisState_paused           protoreflect.FieldDescriptor
-	fd_GenesisState_owner            protoreflect.FieldDescriptor
-	fd_GenesisState_pending_owner    protoreflect.FieldDescriptor
-	fd_GenesisState_burners          protoreflect.FieldDescriptor
-	fd_GenesisState_minters          protoreflect.FieldDescriptor
-	fd_GenesisState_pausers          protoreflect.FieldDescriptor
-	fd_GenesisState_blocked_channels protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
 
-func init() {
-	file_aura_v1_genesis_proto_init()
-	md_GenesisState = File_aura_v1_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_blocklist_state = md_GenesisState.Fields().ByName("blocklist_state")
-	fd_GenesisState_paused = md_GenesisState.Fields().ByName("paused")
-	fd_GenesisState_owner = md_GenesisState.Fields().ByName("owner")
-	fd_GenesisState_pending_owner = md_GenesisState.Fields().ByName("pending_owner")
-	fd_GenesisState_burners = md_GenesisState.Fields().ByName("burners")
-	fd_GenesisState_minters = md_GenesisState.Fields().ByName("minters")
-	fd_GenesisState_pausers = md_GenesisState.Fields().ByName("pausers")
-	fd_GenesisState_blocked_channels = md_GenesisState.Fields().ByName("blocked_channels")
+type _GenesisState_10_list struct {
+	list *[]string
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_aura_v1_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field AllowedChannels as it is not of Message kind"))
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*RateLimit
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RateLimit)
+	(*x.list)[i] = concreteValue
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RateLimit)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BlocklistState != nil {
-		value := protoreflect.ValueOfMessage(x.BlocklistState.ProtoReflect())
-		if !f(fd_GenesisState_blocklist_state, value) {
-			return
-		}
-	}
-	if x.Paused != false {
-		value := protoreflect.ValueOfBool(x.Paused)
-		if !f(fd_GenesisState_paused, value) {
-			return
-		}
-	}
-	if x.Owner != "" {
-		value := protoreflect.ValueOfString(x.Owner)
-		if !f(fd_GenesisState_owner, value) {
-			return
-		}
-	}
-	if x.PendingOwner != "" {
-		value := protoreflect.ValueOfString(x.PendingOwner)
-		if !f(fd_GenesisState_pending_owner, value) {
-			return
-		}
-	}
-	if len(x.Burners) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Burners})
-		if !f(fd_GenesisState_burners, value) {
-			return
-		}
-	}
-	if len(x.Minters) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.Minters})
-		if !f(fd_GenesisState_minters, value) {
-			return
-		}
-	}
-	if len(x.Pausers) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.Pausers})
-		if !f(fd_GenesisState_pausers, value) {
-			return
-		}
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(RateLimit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.BlockedChannels) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.BlockedChannels})
-		if !f(fd_GenesisState_blocked_channels, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(RateLimit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*RateLimitFlow
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "aura.v1.GenesisState.blocklist_state":
-		return x.BlocklistState != nil
-	case "aura.v1.GenesisState.paused":
-		return x.Paused != false
-	case "aura.v1.GenesisState.owner":
-		return x.Owner != ""
-	case "aura.v1.GenesisState.pending_owner":
-		return x.PendingOwner != ""
-	case "aura.v1.GenesisState.burners":
-		return len(x.Burners) != 0
-	case "aura.v1.GenesisState.minters":
-		return len(x.Minters) != 0
-	case "aura.v1.GenesisState.pausers":
-		return len(x.Pausers) != 0
-	case "aura.v1.GenesisState.blocked_channels":
-		return len(x.BlockedChannels) != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message aura.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RateLimitFlow)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RateLimitFlow)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(RateLimitFlow)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "aura.v1.GenesisState.blocklist_state":
-		x.BlocklistState = nil
-	case "aura.v1.GenesisState.paused":
-		x.Paused = false
-	case "aura.v1.GenesisState.owner":
-		x.Owner = ""
-	case "aura.v1.GenesisState.pending_owner":
-		x.PendingOwner = ""
-	case "aura.v1.GenesisState.burners":
-		x.Burners = nil
-	case "aura.v1.GenesisState.minters":
-		x.Minters = nil
-	case "aura.v1.GenesisState.pausers":
-		x.Pausers = nil
-	case "aura.v1.GenesisState.blocked_channels":
-		x.BlockedChannels = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message aura.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(RateLimitFlow)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "aura.v1.GenesisState.blocklist_state":
-		value := x.BlocklistState
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "aura.v1.GenesisState.paused":
-		value := x.Paused
-		return protoreflect.ValueOfBool(value)
-	case "aura.v1.GenesisState.owner":
-		value := x.Owner
-		return protoreflect.ValueOfString(value)
-	case "aura.v1.GenesisState.pending_owner":
-		value := x.PendingOwner
-		return protoreflect.ValueOfString(value)
-	case "aura.v1.GenesisState.burners":
-		if len(x.Burners) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_5_list{})
-		}
-		listValue := &_GenesisState_5_list{list: &x.Burners}
-		return protoreflect.ValueOfList(listValue)
-	case "aura.v1.GenesisState.minters":
-		if len(x.Minters) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_6_list{})
-		}
-		listValue := &_GenesisState_6_list{list: &x.Minters}
-		return protoreflect.ValueOfList(listValue)
-	case "aura.v1.GenesisState.pausers":
-		if len(x.Pausers) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_7_list{})
-		}
-		listValue := &_GenesisState_7_list{list: &x.Pausers}
-		return protoreflect.ValueOfList(listValue)
-	case "aura.v1.GenesisState.blocked_channels":
-		if len(x.BlockedChannels) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_8_list{})
-		}
-		listValue := &_GenesisState_8_list{list: &x.BlockedChannels}
-		return protoreflect.ValueOfList(listValue)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message aura.v1.GenesisState does not contain field %s", descriptor.FullName()))
-	}
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "aura.v1.GenesisState.blocklist_state":
-		x.BlocklistState = value.Message().Interface().(*v1.GenesisState)
-	case "aura.v1.GenesisState.paused":
-		x.Paused = value.Bool()
-	case "aura.v1.GenesisState.owner":
-		x.Owner = value.Interface().(string)
-	case "aura.v1.GenesisState.pending_owner":
-		x.PendingOwner = value.Interface().(string)
-	case "aura.v1.GenesisState.burners":
-		lv := value.List()
-		clv := lv.(*_GenesisState_5_list)
-		x.Burners = *clv.list
-	case "aura.v1.GenesisState.minters":
-		lv := value.List()
-		clv := lv.(*_GenesisState_6_list)
-		x.Minters = *clv.list
-	case "aura.v1.GenesisState.pausers":
-		lv := value.List()
-		clv := lv.(*_GenesisState_7_list)
-		x.Pausers = *clv.list
-	case "aura.v1.GenesisState.blocked_channels":
-		lv := value.List()
-		clv := lv.(*_GenesisState_8_list)
-		x.BlockedChannels = *clv.list
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message aura.v1.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "aura.v1.GenesisState.blocklist_state":
-		if x.BlocklistState == nil {
-			x.BlocklistState = new(v1.GenesisState)
-		}
-		return protoreflect.ValueOfMessage(x.BlocklistState.ProtoReflect())
-	case "aura.v1.GenesisState.burners":
-		if x.Burners == nil {
-			x.Burners = []*Burner{}
-		}
-		value := &_GenesisState_5_list{list: &x.Burners}
-		return protoreflect.ValueOfList(value)
-	case "aura.v1.GenesisState.minters":
-		if x.Minters == nil {
-			x.Minters = []*Minter{}
-		}
-		value := &_GenesisState_6_list{list: &x.Minters}
-		return protoreflect.ValueOfList(value)
-	case "aura.v1.GenesisState.pausers":
-		if x.Pausers == nil {
-			x.Pausers = []string{}
-		}
-		value := &_GenesisState_7_list{list: &x.Pausers}
-		return protoreflect.ValueOfList(value)
-	case "aura.v1.GenesisState.blocked_channels":
-		if x.BlockedChannels == nil {
-			x.BlockedChannels = []string{}
-		}
-		value := &_GenesisState_8_list{list: &x.BlockedChannels}
-		return protoreflect.ValueOfList(value)
-	case "aura.v1.GenesisState.paused":
-		panic(fmt.Errorf("field paused of message aura.v1.GenesisState is not mutable"))
-	case "aura.v1.GenesisState.owner":
-		panic(fmt.Errorf("field owner of message aura.v1.GenesisState is not mutable"))
-	case "aura.v1.GenesisState.pending_owner":
-		panic(fmt.Errorf("field pending_owner of message aura.v1.GenesisState is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message aura.v1.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "aura.v1.GenesisState.blocklist_state":
-		m := new(v1.GenesisState)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "aura.v1.GenesisState.paused":
-		return protoreflect.ValueOfBool(false)
-	case "aura.v1.GenesisState.owner":
-		return protoreflect.ValueOfString("")
-	case "aura.v1.GenesisState.pending_owner":
-		return protoreflect.ValueOfString("")
-	case "aura.v1.GenesisState.burners":
-		list := []*Burner{}
-		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
-	case "aura.v1.GenesisState.minters":
-		list := []*Minter{}
-		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
-	case "aura.v1.GenesisState.pausers":
-		list := []string{}
-		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
-	case "aura.v1.GenesisState.blocked_channels":
-		list := []string{}
-		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message aura.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field PriceSetters as it is not of Message kind"))
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*PriceRange
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in aura.v1.GenesisState", d.FullName()))
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceRange)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceRange)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(PriceRange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	panic("unreachable")
+	*x.list = (*x.list)[:n]
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(PriceRange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+var _ protoreflect.List = (*_GenesisState_17_list)(nil)
+
+type _GenesisState_17_list struct {
+	list *[]*Subscription
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.BlocklistState != nil {
-			l = options.Size(x.BlocklistState)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Paused {
-			n += 2
-		}
-		l = len(x.Owner)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.PendingOwner)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Burners) > 0 {
-			for _, e := range x.Burners {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.Minters) > 0 {
-			for _, e := range x.Minters {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.Pausers) > 0 {
-			for _, s := range x.Pausers {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.BlockedChannels) > 0 {
-			for _, s := range x.BlockedChannels {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *_GenesisState_17_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.BlockedChannels) > 0 {
-			for iNdEx := len(x.BlockedChannels) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.BlockedChannels[iNdEx])
-				copy(dAtA[i:], x.BlockedChannels[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockedChannels[iNdEx])))
-				i--
-				dAtA[i] = 0x42
-			}
-		}
-		if len(x.Pausers) > 0 {
-			for iNdEx := len(x.Pausers) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Pausers[iNdEx])
-				copy(dAtA[i:], x.Pausers[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Pausers[iNdEx])))
-				i--
-				dAtA[i] = 0x3a
-			}
-		}
-		if len(x.Minters) > 0 {
-			for iNdEx := len(x.Minters) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Minters[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x32
-			}
-		}
-		if len(x.Burners) > 0 {
-			for iNdEx := len(x.Burners) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Burners[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2a
-			}
-		}
-		if len(x.PendingOwner) > 0 {
-			i -= len(x.PendingOwner)
-			copy(dAtA[i:], x.PendingOwner)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingOwner)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Owner) > 0 {
-			i -= len(x.Owner)
-			copy(dAtA[i:], x.Owner)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.Paused {
-			i--
-			if x.Paused {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.BlocklistState != nil {
-			encoded, err := options.Marshal(x.BlocklistState)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *_GenesisState_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Subscription)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Subscription)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_17_list) AppendMutable() protoreflect.Value {
+	v := new(Subscription)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlocklistState", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_17_list) NewElement() protoreflect.Value {
+	v := new(Subscription)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_19_list)(nil)
+
+type _GenesisState_19_list struct {
+	list *[]*Redemption
+}
+
+func (x *_GenesisState_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Redemption)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Redemption)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_19_list) AppendMutable() protoreflect.Value {
+	v := new(Redemption)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_19_list) NewElement() protoreflect.Value {
+	v := new(Redemption)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_24_list)(nil)
+
+type _GenesisState_24_list struct {
+	list *[]*SwapVolume
+}
+
+func (x *_GenesisState_24_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_24_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SwapVolume)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_24_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SwapVolume)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_24_list) AppendMutable() protoreflect.Value {
+	v := new(SwapVolume)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_24_list) NewElement() protoreflect.Value {
+	v := new(SwapVolume)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_26_list)(nil)
+
+type _GenesisState_26_list struct {
+	list *[]*LockedLot
+}
+
+func (x *_GenesisState_26_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_26_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockedLot)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_26_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockedLot)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_26_list) AppendMutable() protoreflect.Value {
+	v := new(LockedLot)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_26_list) NewElement() protoreflect.Value {
+	v := new(LockedLot)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_27_list)(nil)
+
+type _GenesisState_27_list struct {
+	list *[]*DenomState
+}
+
+func (x *_GenesisState_27_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_27_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_27_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomState)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_27_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomState)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_27_list) AppendMutable() protoreflect.Value {
+	v := new(DenomState)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_27_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_27_list) NewElement() protoreflect.Value {
+	v := new(DenomState)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_27_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_28_list)(nil)
+
+type _GenesisState_28_list struct {
+	list *[]*v1beta1.Metadata
+}
+
+func (x *_GenesisState_28_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_28_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_28_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Metadata)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_28_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Metadata)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_28_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Metadata)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_28_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_28_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Metadata)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_28_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_29_list)(nil)
+
+type _GenesisState_29_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_29_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_29_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_29_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_29_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_29_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field Attestors as it is not of Message kind"))
+}
+
+func (x *_GenesisState_29_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_29_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_29_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_30_list)(nil)
+
+type _GenesisState_30_list struct {
+	list *[]*Attestation
+}
+
+func (x *_GenesisState_30_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_30_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_30_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Attestation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_30_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Attestation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_30_list) AppendMutable() protoreflect.Value {
+	v := new(Attestation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_30_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_30_list) NewElement() protoreflect.Value {
+	v := new(Attestation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_30_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_32_list)(nil)
+
+type _GenesisState_32_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_32_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_32_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_32_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_32_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_32_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field Recoverers as it is not of Message kind"))
+}
+
+func (x *_GenesisState_32_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_32_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_32_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_33_list)(nil)
+
+type _GenesisState_33_list struct {
+	list *[]PauseScope
+}
+
+func (x *_GenesisState_33_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_33_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
+}
+
+func (x *_GenesisState_33_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (PauseScope)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_33_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (PauseScope)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_33_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field PausedScopes as it is not of Message kind"))
+}
+
+func (x *_GenesisState_33_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_33_list) NewElement() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_GenesisState_33_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_34_list)(nil)
+
+type _GenesisState_34_list struct {
+	list *[]*PauseWindow
+}
+
+func (x *_GenesisState_34_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_34_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_34_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PauseWindow)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_34_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PauseWindow)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_34_list) AppendMutable() protoreflect.Value {
+	v := new(PauseWindow)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_34_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_34_list) NewElement() protoreflect.Value {
+	v := new(PauseWindow)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_34_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_35_list)(nil)
+
+type _GenesisState_35_list struct {
+	list *[]*PauseExemption
+}
+
+func (x *_GenesisState_35_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_35_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_35_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PauseExemption)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_35_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PauseExemption)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_35_list) AppendMutable() protoreflect.Value {
+	v := new(PauseExemption)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_35_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_35_list) NewElement() protoreflect.Value {
+	v := new(PauseExemption)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_35_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_36_list)(nil)
+
+type _GenesisState_36_list struct {
+	list *[]*BlockedChannel
+}
+
+func (x *_GenesisState_36_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_36_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_36_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BlockedChannel)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_36_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BlockedChannel)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_36_list) AppendMutable() protoreflect.Value {
+	v := new(BlockedChannel)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_36_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_36_list) NewElement() protoreflect.Value {
+	v := new(BlockedChannel)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_36_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                              protoreflect.MessageDescriptor
+	fd_GenesisState_blocklist_state              protoreflect.FieldDescriptor
+	fd_GenesisState_paused                       protoreflect.FieldDescriptor
+	fd_GenesisState_owner                        protoreflect.FieldDescriptor
+	fd_GenesisState_pending_owner                protoreflect.FieldDescriptor
+	fd_GenesisState_burners                      protoreflect.FieldDescriptor
+	fd_GenesisState_minters                      protoreflect.FieldDescriptor
+	fd_GenesisState_pausers                      protoreflect.FieldDescriptor
+	fd_GenesisState_blocked_channels             protoreflect.FieldDescriptor
+	fd_GenesisState_channel_mode                 protoreflect.FieldDescriptor
+	fd_GenesisState_allowed_channels             protoreflect.FieldDescriptor
+	fd_GenesisState_rate_limits                  protoreflect.FieldDescriptor
+	fd_GenesisState_rate_limit_flows             protoreflect.FieldDescriptor
+	fd_GenesisState_price_setters                protoreflect.FieldDescriptor
+	fd_GenesisState_price_ranges                 protoreflect.FieldDescriptor
+	fd_GenesisState_max_price_deviation          protoreflect.FieldDescriptor
+	fd_GenesisState_stablecoin                   protoreflect.FieldDescriptor
+	fd_GenesisState_subscriptions                protoreflect.FieldDescriptor
+	fd_GenesisState_next_subscription_id         protoreflect.FieldDescriptor
+	fd_GenesisState_redemptions                  protoreflect.FieldDescriptor
+	fd_GenesisState_next_redemption_id           protoreflect.FieldDescriptor
+	fd_GenesisState_swap_config                  protoreflect.FieldDescriptor
+	fd_GenesisState_swaps_paused                 protoreflect.FieldDescriptor
+	fd_GenesisState_swap_liquidity               protoreflect.FieldDescriptor
+	fd_GenesisState_swap_volumes                 protoreflect.FieldDescriptor
+	fd_GenesisState_lockup_period                protoreflect.FieldDescriptor
+	fd_GenesisState_locked_lots                  protoreflect.FieldDescriptor
+	fd_GenesisState_denoms                       protoreflect.FieldDescriptor
+	fd_GenesisState_denom_metadata               protoreflect.FieldDescriptor
+	fd_GenesisState_attestors                    protoreflect.FieldDescriptor
+	fd_GenesisState_attestations                 protoreflect.FieldDescriptor
+	fd_GenesisState_reserve_config               protoreflect.FieldDescriptor
+	fd_GenesisState_recoverers                   protoreflect.FieldDescriptor
+	fd_GenesisState_paused_scopes                protoreflect.FieldDescriptor
+	fd_GenesisState_pause_windows                protoreflect.FieldDescriptor
+	fd_GenesisState_pause_exemptions             protoreflect.FieldDescriptor
+	fd_GenesisState_directional_blocked_channels protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_GenesisState = File_aura_v1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_blocklist_state = md_GenesisState.Fields().ByName("blocklist_state")
+	fd_GenesisState_paused = md_GenesisState.Fields().ByName("paused")
+	fd_GenesisState_owner = md_GenesisState.Fields().ByName("owner")
+	fd_GenesisState_pending_owner = md_GenesisState.Fields().ByName("pending_owner")
+	fd_GenesisState_burners = md_GenesisState.Fields().ByName("burners")
+	fd_GenesisState_minters = md_GenesisState.Fields().ByName("minters")
+	fd_GenesisState_pausers = md_GenesisState.Fields().ByName("pausers")
+	fd_GenesisState_blocked_channels = md_GenesisState.Fields().ByName("blocked_channels")
+	fd_GenesisState_channel_mode = md_GenesisState.Fields().ByName("channel_mode")
+	fd_GenesisState_allowed_channels = md_GenesisState.Fields().ByName("allowed_channels")
+	fd_GenesisState_rate_limits = md_GenesisState.Fields().ByName("rate_limits")
+	fd_GenesisState_rate_limit_flows = md_GenesisState.Fields().ByName("rate_limit_flows")
+	fd_GenesisState_price_setters = md_GenesisState.Fields().ByName("price_setters")
+	fd_GenesisState_price_ranges = md_GenesisState.Fields().ByName("price_ranges")
+	fd_GenesisState_max_price_deviation = md_GenesisState.Fields().ByName("max_price_deviation")
+	fd_GenesisState_stablecoin = md_GenesisState.Fields().ByName("stablecoin")
+	fd_GenesisState_subscriptions = md_GenesisState.Fields().ByName("subscriptions")
+	fd_GenesisState_next_subscription_id = md_GenesisState.Fields().ByName("next_subscription_id")
+	fd_GenesisState_redemptions = md_GenesisState.Fields().ByName("redemptions")
+	fd_GenesisState_next_redemption_id = md_GenesisState.Fields().ByName("next_redemption_id")
+	fd_GenesisState_swap_config = md_GenesisState.Fields().ByName("swap_config")
+	fd_GenesisState_swaps_paused = md_GenesisState.Fields().ByName("swaps_paused")
+	fd_GenesisState_swap_liquidity = md_GenesisState.Fields().ByName("swap_liquidity")
+	fd_GenesisState_swap_volumes = md_GenesisState.Fields().ByName("swap_volumes")
+	fd_GenesisState_lockup_period = md_GenesisState.Fields().ByName("lockup_period")
+	fd_GenesisState_locked_lots = md_GenesisState.Fields().ByName("locked_lots")
+	fd_GenesisState_denoms = md_GenesisState.Fields().ByName("denoms")
+	fd_GenesisState_denom_metadata = md_GenesisState.Fields().ByName("denom_metadata")
+	fd_GenesisState_attestors = md_GenesisState.Fields().ByName("attestors")
+	fd_GenesisState_attestations = md_GenesisState.Fields().ByName("attestations")
+	fd_GenesisState_reserve_config = md_GenesisState.Fields().ByName("reserve_config")
+	fd_GenesisState_recoverers = md_GenesisState.Fields().ByName("recoverers")
+	fd_GenesisState_paused_scopes = md_GenesisState.Fields().ByName("paused_scopes")
+	fd_GenesisState_pause_windows = md_GenesisState.Fields().ByName("pause_windows")
+	fd_GenesisState_pause_exemptions = md_GenesisState.Fields().ByName("pause_exemptions")
+	fd_GenesisState_directional_blocked_channels = md_GenesisState.Fields().ByName("directional_blocked_channels")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlocklistState != nil {
+		value := protoreflect.ValueOfMessage(x.BlocklistState.ProtoReflect())
+		if !f(fd_GenesisState_blocklist_state, value) {
+			return
+		}
+	}
+	if x.Paused != false {
+		value := protoreflect.ValueOfBool(x.Paused)
+		if !f(fd_GenesisState_paused, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_GenesisState_owner, value) {
+			return
+		}
+	}
+	if x.PendingOwner != "" {
+		value := protoreflect.ValueOfString(x.PendingOwner)
+		if !f(fd_GenesisState_pending_owner, value) {
+			return
+		}
+	}
+	if len(x.Burners) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Burners})
+		if !f(fd_GenesisState_burners, value) {
+			return
+		}
+	}
+	if len(x.Minters) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.Minters})
+		if !f(fd_GenesisState_minters, value) {
+			return
+		}
+	}
+	if len(x.Pausers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.Pausers})
+		if !f(fd_GenesisState_pausers, value) {
+			return
+		}
+	}
+	if len(x.BlockedChannels) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.BlockedChannels})
+		if !f(fd_GenesisState_blocked_channels, value) {
+			return
+		}
+	}
+	if x.ChannelMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ChannelMode))
+		if !f(fd_GenesisState_channel_mode, value) {
+			return
+		}
+	}
+	if len(x.AllowedChannels) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.AllowedChannels})
+		if !f(fd_GenesisState_allowed_channels, value) {
+			return
+		}
+	}
+	if len(x.RateLimits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.RateLimits})
+		if !f(fd_GenesisState_rate_limits, value) {
+			return
+		}
+	}
+	if len(x.RateLimitFlows) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.RateLimitFlows})
+		if !f(fd_GenesisState_rate_limit_flows, value) {
+			return
+		}
+	}
+	if len(x.PriceSetters) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.PriceSetters})
+		if !f(fd_GenesisState_price_setters, value) {
+			return
+		}
+	}
+	if len(x.PriceRanges) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.PriceRanges})
+		if !f(fd_GenesisState_price_ranges, value) {
+			return
+		}
+	}
+	if x.MaxPriceDeviation != "" {
+		value := protoreflect.ValueOfString(x.MaxPriceDeviation)
+		if !f(fd_GenesisState_max_price_deviation, value) {
+			return
+		}
+	}
+	if x.Stablecoin != nil {
+		value := protoreflect.ValueOfMessage(x.Stablecoin.ProtoReflect())
+		if !f(fd_GenesisState_stablecoin, value) {
+			return
+		}
+	}
+	if len(x.Subscriptions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.Subscriptions})
+		if !f(fd_GenesisState_subscriptions, value) {
+			return
+		}
+	}
+	if x.NextSubscriptionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextSubscriptionId)
+		if !f(fd_GenesisState_next_subscription_id, value) {
+			return
+		}
+	}
+	if len(x.Redemptions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_19_list{list: &x.Redemptions})
+		if !f(fd_GenesisState_redemptions, value) {
+			return
+		}
+	}
+	if x.NextRedemptionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextRedemptionId)
+		if !f(fd_GenesisState_next_redemption_id, value) {
+			return
+		}
+	}
+	if x.SwapConfig != nil {
+		value := protoreflect.ValueOfMessage(x.SwapConfig.ProtoReflect())
+		if !f(fd_GenesisState_swap_config, value) {
+			return
+		}
+	}
+	if x.SwapsPaused != false {
+		value := protoreflect.ValueOfBool(x.SwapsPaused)
+		if !f(fd_GenesisState_swaps_paused, value) {
+			return
+		}
+	}
+	if x.SwapLiquidity != nil {
+		value := protoreflect.ValueOfMessage(x.SwapLiquidity.ProtoReflect())
+		if !f(fd_GenesisState_swap_liquidity, value) {
+			return
+		}
+	}
+	if len(x.SwapVolumes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_24_list{list: &x.SwapVolumes})
+		if !f(fd_GenesisState_swap_volumes, value) {
+			return
+		}
+	}
+	if x.LockupPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.LockupPeriod.ProtoReflect())
+		if !f(fd_GenesisState_lockup_period, value) {
+			return
+		}
+	}
+	if len(x.LockedLots) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_26_list{list: &x.LockedLots})
+		if !f(fd_GenesisState_locked_lots, value) {
+			return
+		}
+	}
+	if len(x.Denoms) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_27_list{list: &x.Denoms})
+		if !f(fd_GenesisState_denoms, value) {
+			return
+		}
+	}
+	if len(x.DenomMetadata) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_28_list{list: &x.DenomMetadata})
+		if !f(fd_GenesisState_denom_metadata, value) {
+			return
+		}
+	}
+	if len(x.Attestors) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_29_list{list: &x.Attestors})
+		if !f(fd_GenesisState_attestors, value) {
+			return
+		}
+	}
+	if len(x.Attestations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_30_list{list: &x.Attestations})
+		if !f(fd_GenesisState_attestations, value) {
+			return
+		}
+	}
+	if x.ReserveConfig != nil {
+		value := protoreflect.ValueOfMessage(x.ReserveConfig.ProtoReflect())
+		if !f(fd_GenesisState_reserve_config, value) {
+			return
+		}
+	}
+	if len(x.Recoverers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_32_list{list: &x.Recoverers})
+		if !f(fd_GenesisState_recoverers, value) {
+			return
+		}
+	}
+	if len(x.PausedScopes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_33_list{list: &x.PausedScopes})
+		if !f(fd_GenesisState_paused_scopes, value) {
+			return
+		}
+	}
+	if len(x.PauseWindows) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_34_list{list: &x.PauseWindows})
+		if !f(fd_GenesisState_pause_windows, value) {
+			return
+		}
+	}
+	if len(x.PauseExemptions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_35_list{list: &x.PauseExemptions})
+		if !f(fd_GenesisState_pause_exemptions, value) {
+			return
+		}
+	}
+	if len(x.DirectionalBlockedChannels) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_36_list{list: &x.DirectionalBlockedChannels})
+		if !f(fd_GenesisState_directional_blocked_channels, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.GenesisState.blocklist_state":
+		return x.BlocklistState != nil
+	case "aura.v1.GenesisState.paused":
+		return x.Paused != false
+	case "aura.v1.GenesisState.owner":
+		return x.Owner != ""
+	case "aura.v1.GenesisState.pending_owner":
+		return x.PendingOwner != ""
+	case "aura.v1.GenesisState.burners":
+		return len(x.Burners) != 0
+	case "aura.v1.GenesisState.minters":
+		return len(x.Minters) != 0
+	case "aura.v1.GenesisState.pausers":
+		return len(x.Pausers) != 0
+	case "aura.v1.GenesisState.blocked_channels":
+		return len(x.BlockedChannels) != 0
+	case "aura.v1.GenesisState.channel_mode":
+		return x.ChannelMode != 0
+	case "aura.v1.GenesisState.allowed_channels":
+		return len(x.AllowedChannels) != 0
+	case "aura.v1.GenesisState.rate_limits":
+		return len(x.RateLimits) != 0
+	case "aura.v1.GenesisState.rate_limit_flows":
+		return len(x.RateLimitFlows) != 0
+	case "aura.v1.GenesisState.price_setters":
+		return len(x.PriceSetters) != 0
+	case "aura.v1.GenesisState.price_ranges":
+		return len(x.PriceRanges) != 0
+	case "aura.v1.GenesisState.max_price_deviation":
+		return x.MaxPriceDeviation != ""
+	case "aura.v1.GenesisState.stablecoin":
+		return x.Stablecoin != nil
+	case "aura.v1.GenesisState.subscriptions":
+		return len(x.Subscriptions) != 0
+	case "aura.v1.GenesisState.next_subscription_id":
+		return x.NextSubscriptionId != uint64(0)
+	case "aura.v1.GenesisState.redemptions":
+		return len(x.Redemptions) != 0
+	case "aura.v1.GenesisState.next_redemption_id":
+		return x.NextRedemptionId != uint64(0)
+	case "aura.v1.GenesisState.swap_config":
+		return x.SwapConfig != nil
+	case "aura.v1.GenesisState.swaps_paused":
+		return x.SwapsPaused != false
+	case "aura.v1.GenesisState.swap_liquidity":
+		return x.SwapLiquidity != nil
+	case "aura.v1.GenesisState.swap_volumes":
+		return len(x.SwapVolumes) != 0
+	case "aura.v1.GenesisState.lockup_period":
+		return x.LockupPeriod != nil
+	case "aura.v1.GenesisState.locked_lots":
+		return len(x.LockedLots) != 0
+	case "aura.v1.GenesisState.denoms":
+		return len(x.Denoms) != 0
+	case "aura.v1.GenesisState.denom_metadata":
+		return len(x.DenomMetadata) != 0
+	case "aura.v1.GenesisState.attestors":
+		return len(x.Attestors) != 0
+	case "aura.v1.GenesisState.attestations":
+		return len(x.Attestations) != 0
+	case "aura.v1.GenesisState.reserve_config":
+		return x.ReserveConfig != nil
+	case "aura.v1.GenesisState.recoverers":
+		return len(x.Recoverers) != 0
+	case "aura.v1.GenesisState.paused_scopes":
+		return len(x.PausedScopes) != 0
+	case "aura.v1.GenesisState.pause_windows":
+		return len(x.PauseWindows) != 0
+	case "aura.v1.GenesisState.pause_exemptions":
+		return len(x.PauseExemptions) != 0
+	case "aura.v1.GenesisState.directional_blocked_channels":
+		return len(x.DirectionalBlockedChannels) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message aura.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.GenesisState.blocklist_state":
+		x.BlocklistState = nil
+	case "aura.v1.GenesisState.paused":
+		x.Paused = false
+	case "aura.v1.GenesisState.owner":
+		x.Owner = ""
+	case "aura.v1.GenesisState.pending_owner":
+		x.PendingOwner = ""
+	case "aura.v1.GenesisState.burners":
+		x.Burners = nil
+	case "aura.v1.GenesisState.minters":
+		x.Minters = nil
+	case "aura.v1.GenesisState.pausers":
+		x.Pausers = nil
+	case "aura.v1.GenesisState.blocked_channels":
+		x.BlockedChannels = nil
+	case "aura.v1.GenesisState.channel_mode":
+		x.ChannelMode = 0
+	case "aura.v1.GenesisState.allowed_channels":
+		x.AllowedChannels = nil
+	case "aura.v1.GenesisState.rate_limits":
+		x.RateLimits = nil
+	case "aura.v1.GenesisState.rate_limit_flows":
+		x.RateLimitFlows = nil
+	case "aura.v1.GenesisState.price_setters":
+		x.PriceSetters = nil
+	case "aura.v1.GenesisState.price_ranges":
+		x.PriceRanges = nil
+	case "aura.v1.GenesisState.max_price_deviation":
+		x.MaxPriceDeviation = ""
+	case "aura.v1.GenesisState.stablecoin":
+		x.Stablecoin = nil
+	case "aura.v1.GenesisState.subscriptions":
+		x.Subscriptions = nil
+	case "aura.v1.GenesisState.next_subscription_id":
+		x.NextSubscriptionId = uint64(0)
+	case "aura.v1.GenesisState.redemptions":
+		x.Redemptions = nil
+	case "aura.v1.GenesisState.next_redemption_id":
+		x.NextRedemptionId = uint64(0)
+	case "aura.v1.GenesisState.swap_config":
+		x.SwapConfig = nil
+	case "aura.v1.GenesisState.swaps_paused":
+		x.SwapsPaused = false
+	case "aura.v1.GenesisState.swap_liquidity":
+		x.SwapLiquidity = nil
+	case "aura.v1.GenesisState.swap_volumes":
+		x.SwapVolumes = nil
+	case "aura.v1.GenesisState.lockup_period":
+		x.LockupPeriod = nil
+	case "aura.v1.GenesisState.locked_lots":
+		x.LockedLots = nil
+	case "aura.v1.GenesisState.denoms":
+		x.Denoms = nil
+	case "aura.v1.GenesisState.denom_metadata":
+		x.DenomMetadata = nil
+	case "aura.v1.GenesisState.attestors":
+		x.Attestors = nil
+	case "aura.v1.GenesisState.attestations":
+		x.Attestations = nil
+	case "aura.v1.GenesisState.reserve_config":
+		x.ReserveConfig = nil
+	case "aura.v1.GenesisState.recoverers":
+		x.Recoverers = nil
+	case "aura.v1.GenesisState.paused_scopes":
+		x.PausedScopes = nil
+	case "aura.v1.GenesisState.pause_windows":
+		x.PauseWindows = nil
+	case "aura.v1.GenesisState.pause_exemptions":
+		x.PauseExemptions = nil
+	case "aura.v1.GenesisState.directional_blocked_channels":
+		x.DirectionalBlockedChannels = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message aura.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.GenesisState.blocklist_state":
+		value := x.BlocklistState
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "aura.v1.GenesisState.paused":
+		value := x.Paused
+		return protoreflect.ValueOfBool(value)
+	case "aura.v1.GenesisState.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.GenesisState.pending_owner":
+		value := x.PendingOwner
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.GenesisState.burners":
+		if len(x.Burners) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.Burners}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.minters":
+		if len(x.Minters) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.Minters}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.pausers":
+		if len(x.Pausers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.Pausers}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.blocked_channels":
+		if len(x.BlockedChannels) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.BlockedChannels}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.channel_mode":
+		value := x.ChannelMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "aura.v1.GenesisState.allowed_channels":
+		if len(x.AllowedChannels) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.AllowedChannels}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.rate_limits":
+		if len(x.RateLimits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.RateLimits}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.rate_limit_flows":
+		if len(x.RateLimitFlows) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.RateLimitFlows}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.price_setters":
+		if len(x.PriceSetters) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.PriceSetters}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.price_ranges":
+		if len(x.PriceRanges) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.PriceRanges}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.max_price_deviation":
+		value := x.MaxPriceDeviation
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.GenesisState.stablecoin":
+		value := x.Stablecoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "aura.v1.GenesisState.subscriptions":
+		if len(x.Subscriptions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.Subscriptions}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.next_subscription_id":
+		value := x.NextSubscriptionId
+		return protoreflect.ValueOfUint64(value)
+	case "aura.v1.GenesisState.redemptions":
+		if len(x.Redemptions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_19_list{})
+		}
+		listValue := &_GenesisState_19_list{list: &x.Redemptions}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.next_redemption_id":
+		value := x.NextRedemptionId
+		return protoreflect.ValueOfUint64(value)
+	case "aura.v1.GenesisState.swap_config":
+		value := x.SwapConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "aura.v1.GenesisState.swaps_paused":
+		value := x.SwapsPaused
+		return protoreflect.ValueOfBool(value)
+	case "aura.v1.GenesisState.swap_liquidity":
+		value := x.SwapLiquidity
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "aura.v1.GenesisState.swap_volumes":
+		if len(x.SwapVolumes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_24_list{})
+		}
+		listValue := &_GenesisState_24_list{list: &x.SwapVolumes}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.lockup_period":
+		value := x.LockupPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "aura.v1.GenesisState.locked_lots":
+		if len(x.LockedLots) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_26_list{})
+		}
+		listValue := &_GenesisState_26_list{list: &x.LockedLots}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.denoms":
+		if len(x.Denoms) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_27_list{})
+		}
+		listValue := &_GenesisState_27_list{list: &x.Denoms}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.denom_metadata":
+		if len(x.DenomMetadata) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_28_list{})
+		}
+		listValue := &_GenesisState_28_list{list: &x.DenomMetadata}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.attestors":
+		if len(x.Attestors) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_29_list{})
+		}
+		listValue := &_GenesisState_29_list{list: &x.Attestors}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.attestations":
+		if len(x.Attestations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_30_list{})
+		}
+		listValue := &_GenesisState_30_list{list: &x.Attestations}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.reserve_config":
+		value := x.ReserveConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "aura.v1.GenesisState.recoverers":
+		if len(x.Recoverers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_32_list{})
+		}
+		listValue := &_GenesisState_32_list{list: &x.Recoverers}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.paused_scopes":
+		if len(x.PausedScopes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_33_list{})
+		}
+		listValue := &_GenesisState_33_list{list: &x.PausedScopes}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.pause_windows":
+		if len(x.PauseWindows) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_34_list{})
+		}
+		listValue := &_GenesisState_34_list{list: &x.PauseWindows}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.pause_exemptions":
+		if len(x.PauseExemptions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_35_list{})
+		}
+		listValue := &_GenesisState_35_list{list: &x.PauseExemptions}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.GenesisState.directional_blocked_channels":
+		if len(x.DirectionalBlockedChannels) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_36_list{})
+		}
+		listValue := &_GenesisState_36_list{list: &x.DirectionalBlockedChannels}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message aura.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.GenesisState.blocklist_state":
+		x.BlocklistState = value.Message().Interface().(*v1.GenesisState)
+	case "aura.v1.GenesisState.paused":
+		x.Paused = value.Bool()
+	case "aura.v1.GenesisState.owner":
+		x.Owner = value.Interface().(string)
+	case "aura.v1.GenesisState.pending_owner":
+		x.PendingOwner = value.Interface().(string)
+	case "aura.v1.GenesisState.burners":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.Burners = *clv.list
+	case "aura.v1.GenesisState.minters":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.Minters = *clv.list
+	case "aura.v1.GenesisState.pausers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.Pausers = *clv.list
+	case "aura.v1.GenesisState.blocked_channels":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.BlockedChannels = *clv.list
+	case "aura.v1.GenesisState.channel_mode":
+		x.ChannelMode = (ChannelMode)(value.Enum())
+	case "aura.v1.GenesisState.allowed_channels":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.AllowedChannels = *clv.list
+	case "aura.v1.GenesisState.rate_limits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.RateLimits = *clv.list
+	case "aura.v1.GenesisState.rate_limit_flows":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.RateLimitFlows = *clv.list
+	case "aura.v1.GenesisState.price_setters":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.PriceSetters = *clv.list
+	case "aura.v1.GenesisState.price_ranges":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.PriceRanges = *clv.list
+	case "aura.v1.GenesisState.max_price_deviation":
+		x.MaxPriceDeviation = value.Interface().(string)
+	case "aura.v1.GenesisState.stablecoin":
+		x.Stablecoin = value.Message().Interface().(*Stablecoin)
+	case "aura.v1.GenesisState.subscriptions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.Subscriptions = *clv.list
+	case "aura.v1.GenesisState.next_subscription_id":
+		x.NextSubscriptionId = value.Uint()
+	case "aura.v1.GenesisState.redemptions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_19_list)
+		x.Redemptions = *clv.list
+	case "aura.v1.GenesisState.next_redemption_id":
+		x.NextRedemptionId = value.Uint()
+	case "aura.v1.GenesisState.swap_config":
+		x.SwapConfig = value.Message().Interface().(*SwapConfig)
+	case "aura.v1.GenesisState.swaps_paused":
+		x.SwapsPaused = value.Bool()
+	case "aura.v1.GenesisState.swap_liquidity":
+		x.SwapLiquidity = value.Message().Interface().(*v1beta11.Coin)
+	case "aura.v1.GenesisState.swap_volumes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_24_list)
+		x.SwapVolumes = *clv.list
+	case "aura.v1.GenesisState.lockup_period":
+		x.LockupPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "aura.v1.GenesisState.locked_lots":
+		lv := value.List()
+		clv := lv.(*_GenesisState_26_list)
+		x.LockedLots = *clv.list
+	case "aura.v1.GenesisState.denoms":
+		lv := value.List()
+		clv := lv.(*_GenesisState_27_list)
+		x.Denoms = *clv.list
+	case "aura.v1.GenesisState.denom_metadata":
+		lv := value.List()
+		clv := lv.(*_GenesisState_28_list)
+		x.DenomMetadata = *clv.list
+	case "aura.v1.GenesisState.attestors":
+		lv := value.List()
+		clv := lv.(*_GenesisState_29_list)
+		x.Attestors = *clv.list
+	case "aura.v1.GenesisState.attestations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_30_list)
+		x.Attestations = *clv.list
+	case "aura.v1.GenesisState.reserve_config":
+		x.ReserveConfig = value.Message().Interface().(*ReserveConfig)
+	case "aura.v1.GenesisState.recoverers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_32_list)
+		x.Recoverers = *clv.list
+	case "aura.v1.GenesisState.paused_scopes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_33_list)
+		x.PausedScopes = *clv.list
+	case "aura.v1.GenesisState.pause_windows":
+		lv := value.List()
+		clv := lv.(*_GenesisState_34_list)
+		x.PauseWindows = *clv.list
+	case "aura.v1.GenesisState.pause_exemptions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_35_list)
+		x.PauseExemptions = *clv.list
+	case "aura.v1.GenesisState.directional_blocked_channels":
+		lv := value.List()
+		clv := lv.(*_GenesisState_36_list)
+		x.DirectionalBlockedChannels = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message aura.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.GenesisState.blocklist_state":
+		if x.BlocklistState == nil {
+			x.BlocklistState = new(v1.GenesisState)
+		}
+		return protoreflect.ValueOfMessage(x.BlocklistState.ProtoReflect())
+	case "aura.v1.GenesisState.burners":
+		if x.Burners == nil {
+			x.Burners = []*Burner{}
+		}
+		value := &_GenesisState_5_list{list: &x.Burners}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.minters":
+		if x.Minters == nil {
+			x.Minters = []*Minter{}
+		}
+		value := &_GenesisState_6_list{list: &x.Minters}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.pausers":
+		if x.Pausers == nil {
+			x.Pausers = []string{}
+		}
+		value := &_GenesisState_7_list{list: &x.Pausers}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.blocked_channels":
+		if x.BlockedChannels == nil {
+			x.BlockedChannels = []string{}
+		}
+		value := &_GenesisState_8_list{list: &x.BlockedChannels}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.allowed_channels":
+		if x.AllowedChannels == nil {
+			x.AllowedChannels = []string{}
+		}
+		value := &_GenesisState_10_list{list: &x.AllowedChannels}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.rate_limits":
+		if x.RateLimits == nil {
+			x.RateLimits = []*RateLimit{}
+		}
+		value := &_GenesisState_11_list{list: &x.RateLimits}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.rate_limit_flows":
+		if x.RateLimitFlows == nil {
+			x.RateLimitFlows = []*RateLimitFlow{}
+		}
+		value := &_GenesisState_12_list{list: &x.RateLimitFlows}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.price_setters":
+		if x.PriceSetters == nil {
+			x.PriceSetters = []string{}
+		}
+		value := &_GenesisState_13_list{list: &x.PriceSetters}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.price_ranges":
+		if x.PriceRanges == nil {
+			x.PriceRanges = []*PriceRange{}
+		}
+		value := &_GenesisState_14_list{list: &x.PriceRanges}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.stablecoin":
+		if x.Stablecoin == nil {
+			x.Stablecoin = new(Stablecoin)
+		}
+		return protoreflect.ValueOfMessage(x.Stablecoin.ProtoReflect())
+	case "aura.v1.GenesisState.subscriptions":
+		if x.Subscriptions == nil {
+			x.Subscriptions = []*Subscription{}
+		}
+		value := &_GenesisState_17_list{list: &x.Subscriptions}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.redemptions":
+		if x.Redemptions == nil {
+			x.Redemptions = []*Redemption{}
+		}
+		value := &_GenesisState_19_list{list: &x.Redemptions}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.swap_config":
+		if x.SwapConfig == nil {
+			x.SwapConfig = new(SwapConfig)
+		}
+		return protoreflect.ValueOfMessage(x.SwapConfig.ProtoReflect())
+	case "aura.v1.GenesisState.swap_liquidity":
+		if x.SwapLiquidity == nil {
+			x.SwapLiquidity = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.SwapLiquidity.ProtoReflect())
+	case "aura.v1.GenesisState.swap_volumes":
+		if x.SwapVolumes == nil {
+			x.SwapVolumes = []*SwapVolume{}
+		}
+		value := &_GenesisState_24_list{list: &x.SwapVolumes}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.lockup_period":
+		if x.LockupPeriod == nil {
+			x.LockupPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.LockupPeriod.ProtoReflect())
+	case "aura.v1.GenesisState.locked_lots":
+		if x.LockedLots == nil {
+			x.LockedLots = []*LockedLot{}
+		}
+		value := &_GenesisState_26_list{list: &x.LockedLots}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.denoms":
+		if x.Denoms == nil {
+			x.Denoms = []*DenomState{}
+		}
+		value := &_GenesisState_27_list{list: &x.Denoms}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.denom_metadata":
+		if x.DenomMetadata == nil {
+			x.DenomMetadata = []*v1beta1.Metadata{}
+		}
+		value := &_GenesisState_28_list{list: &x.DenomMetadata}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.attestors":
+		if x.Attestors == nil {
+			x.Attestors = []string{}
+		}
+		value := &_GenesisState_29_list{list: &x.Attestors}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.attestations":
+		if x.Attestations == nil {
+			x.Attestations = []*Attestation{}
+		}
+		value := &_GenesisState_30_list{list: &x.Attestations}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.reserve_config":
+		if x.ReserveConfig == nil {
+			x.ReserveConfig = new(ReserveConfig)
+		}
+		return protoreflect.ValueOfMessage(x.ReserveConfig.ProtoReflect())
+	case "aura.v1.GenesisState.recoverers":
+		if x.Recoverers == nil {
+			x.Recoverers = []string{}
+		}
+		value := &_GenesisState_32_list{list: &x.Recoverers}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.paused_scopes":
+		if x.PausedScopes == nil {
+			x.PausedScopes = []PauseScope{}
+		}
+		value := &_GenesisState_33_list{list: &x.PausedScopes}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.pause_windows":
+		if x.PauseWindows == nil {
+			x.PauseWindows = []*PauseWindow{}
+		}
+		value := &_GenesisState_34_list{list: &x.PauseWindows}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.pause_exemptions":
+		if x.PauseExemptions == nil {
+			x.PauseExemptions = []*PauseExemption{}
+		}
+		value := &_GenesisState_35_list{list: &x.PauseExemptions}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.directional_blocked_channels":
+		if x.DirectionalBlockedChannels == nil {
+			x.DirectionalBlockedChannels = []*BlockedChannel{}
+		}
+		value := &_GenesisState_36_list{list: &x.DirectionalBlockedChannels}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.GenesisState.paused":
+		panic(fmt.Errorf("field paused of message aura.v1.GenesisState is not mutable"))
+	case "aura.v1.GenesisState.owner":
+		panic(fmt.Errorf("field owner of message aura.v1.GenesisState is not mutable"))
+	case "aura.v1.GenesisState.pending_owner":
+		panic(fmt.Errorf("field pending_owner of message aura.v1.GenesisState is not mutable"))
+	case "aura.v1.GenesisState.channel_mode":
+		panic(fmt.Errorf("field channel_mode of message aura.v1.GenesisState is not mutable"))
+	case "aura.v1.GenesisState.max_price_deviation":
+		panic(fmt.Errorf("field max_price_deviation of message aura.v1.GenesisState is not mutable"))
+	case "aura.v1.GenesisState.next_subscription_id":
+		panic(fmt.Errorf("field next_subscription_id of message aura.v1.GenesisState is not mutable"))
+	case "aura.v1.GenesisState.next_redemption_id":
+		panic(fmt.Errorf("field next_redemption_id of message aura.v1.GenesisState is not mutable"))
+	case "aura.v1.GenesisState.swaps_paused":
+		panic(fmt.Errorf("field swaps_paused of message aura.v1.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message aura.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.GenesisState.blocklist_state":
+		m := new(v1.GenesisState)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "aura.v1.GenesisState.paused":
+		return protoreflect.ValueOfBool(false)
+	case "aura.v1.GenesisState.owner":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.GenesisState.pending_owner":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.GenesisState.burners":
+		list := []*Burner{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "aura.v1.GenesisState.minters":
+		list := []*Minter{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "aura.v1.GenesisState.pausers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "aura.v1.GenesisState.blocked_channels":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "aura.v1.GenesisState.channel_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "aura.v1.GenesisState.allowed_channels":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "aura.v1.GenesisState.rate_limits":
+		list := []*RateLimit{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "aura.v1.GenesisState.rate_limit_flows":
+		list := []*RateLimitFlow{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "aura.v1.GenesisState.price_setters":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "aura.v1.GenesisState.price_ranges":
+		list := []*PriceRange{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "aura.v1.GenesisState.max_price_deviation":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.GenesisState.stablecoin":
+		m := new(Stablecoin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "aura.v1.GenesisState.subscriptions":
+		list := []*Subscription{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+	case "aura.v1.GenesisState.next_subscription_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "aura.v1.GenesisState.redemptions":
+		list := []*Redemption{}
+		return protoreflect.ValueOfList(&_GenesisState_19_list{list: &list})
+	case "aura.v1.GenesisState.next_redemption_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "aura.v1.GenesisState.swap_config":
+		m := new(SwapConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "aura.v1.GenesisState.swaps_paused":
+		return protoreflect.ValueOfBool(false)
+	case "aura.v1.GenesisState.swap_liquidity":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "aura.v1.GenesisState.swap_volumes":
+		list := []*SwapVolume{}
+		return protoreflect.ValueOfList(&_GenesisState_24_list{list: &list})
+	case "aura.v1.GenesisState.lockup_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "aura.v1.GenesisState.locked_lots":
+		list := []*LockedLot{}
+		return protoreflect.ValueOfList(&_GenesisState_26_list{list: &list})
+	case "aura.v1.GenesisState.denoms":
+		list := []*DenomState{}
+		return protoreflect.ValueOfList(&_GenesisState_27_list{list: &list})
+	case "aura.v1.GenesisState.denom_metadata":
+		list := []*v1beta1.Metadata{}
+		return protoreflect.ValueOfList(&_GenesisState_28_list{list: &list})
+	case "aura.v1.GenesisState.attestors":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_29_list{list: &list})
+	case "aura.v1.GenesisState.attestations":
+		list := []*Attestation{}
+		return protoreflect.ValueOfList(&_GenesisState_30_list{list: &list})
+	case "aura.v1.GenesisState.reserve_config":
+		m := new(ReserveConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "aura.v1.GenesisState.recoverers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_32_list{list: &list})
+	case "aura.v1.GenesisState.paused_scopes":
+		list := []PauseScope{}
+		return protoreflect.ValueOfList(&_GenesisState_33_list{list: &list})
+	case "aura.v1.GenesisState.pause_windows":
+		list := []*PauseWindow{}
+		return protoreflect.ValueOfList(&_GenesisState_34_list{list: &list})
+	case "aura.v1.GenesisState.pause_exemptions":
+		list := []*PauseExemption{}
+		return protoreflect.ValueOfList(&_GenesisState_35_list{list: &list})
+	case "aura.v1.GenesisState.directional_blocked_channels":
+		list := []*BlockedChannel{}
+		return protoreflect.ValueOfList(&_GenesisState_36_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message aura.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BlocklistState != nil {
+			l = options.Size(x.BlocklistState)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Paused {
+			n += 2
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PendingOwner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Burners) > 0 {
+			for _, e := range x.Burners {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Minters) > 0 {
+			for _, e := range x.Minters {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Pausers) > 0 {
+			for _, s := range x.Pausers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BlockedChannels) > 0 {
+			for _, s := range x.BlockedChannels {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ChannelMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChannelMode))
+		}
+		if len(x.AllowedChannels) > 0 {
+			for _, s := range x.AllowedChannels {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RateLimits) > 0 {
+			for _, e := range x.RateLimits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RateLimitFlows) > 0 {
+			for _, e := range x.RateLimitFlows {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PriceSetters) > 0 {
+			for _, s := range x.PriceSetters {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PriceRanges) > 0 {
+			for _, e := range x.PriceRanges {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.MaxPriceDeviation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Stablecoin != nil {
+			l = options.Size(x.Stablecoin)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Subscriptions) > 0 {
+			for _, e := range x.Subscriptions {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextSubscriptionId != 0 {
+			n += 2 + runtime.Sov(uint64(x.NextSubscriptionId))
+		}
+		if len(x.Redemptions) > 0 {
+			for _, e := range x.Redemptions {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextRedemptionId != 0 {
+			n += 2 + runtime.Sov(uint64(x.NextRedemptionId))
+		}
+		if x.SwapConfig != nil {
+			l = options.Size(x.SwapConfig)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.SwapsPaused {
+			n += 3
+		}
+		if x.SwapLiquidity != nil {
+			l = options.Size(x.SwapLiquidity)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.SwapVolumes) > 0 {
+			for _, e := range x.SwapVolumes {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LockupPeriod != nil {
+			l = options.Size(x.LockupPeriod)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.LockedLots) > 0 {
+			for _, e := range x.LockedLots {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Denoms) > 0 {
+			for _, e := range x.Denoms {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DenomMetadata) > 0 {
+			for _, e := range x.DenomMetadata {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Attestors) > 0 {
+			for _, s := range x.Attestors {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Attestations) > 0 {
+			for _, e := range x.Attestations {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ReserveConfig != nil {
+			l = options.Size(x.ReserveConfig)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Recoverers) > 0 {
+			for _, s := range x.Recoverers {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PausedScopes) > 0 {
+			l = 0
+			for _, e := range x.PausedScopes {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 2 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.PauseWindows) > 0 {
+			for _, e := range x.PauseWindows {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PauseExemptions) > 0 {
+			for _, e := range x.PauseExemptions {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DirectionalBlockedChannels) > 0 {
+			for _, e := range x.DirectionalBlockedChannels {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DirectionalBlockedChannels) > 0 {
+			for iNdEx := len(x.DirectionalBlockedChannels) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DirectionalBlockedChannels[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0xa2
+			}
+		}
+		if len(x.PauseExemptions) > 0 {
+			for iNdEx := len(x.PauseExemptions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PauseExemptions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if len(x.PauseWindows) > 0 {
+			for iNdEx := len(x.PauseWindows) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PauseWindows[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.PausedScopes) > 0 {
+			var pksize2 int
+			for _, num := range x.PausedScopes {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.PausedScopes {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.Recoverers) > 0 {
+			for iNdEx := len(x.Recoverers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Recoverers[iNdEx])
+				copy(dAtA[i:], x.Recoverers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recoverers[iNdEx])))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if x.ReserveConfig != nil {
+			encoded, err := options.Marshal(x.ReserveConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+		if len(x.Attestations) > 0 {
+			for iNdEx := len(x.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Attestations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xf2
+			}
+		}
+		if len(x.Attestors) > 0 {
+			for iNdEx := len(x.Attestors) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Attestors[iNdEx])
+				copy(dAtA[i:], x.Attestors[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Attestors[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xea
+			}
+		}
+		if len(x.DenomMetadata) > 0 {
+			for iNdEx := len(x.DenomMetadata) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomMetadata[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xe2
+			}
+		}
+		if len(x.Denoms) > 0 {
+			for iNdEx := len(x.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Denoms[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xda
+			}
+		}
+		if len(x.LockedLots) > 0 {
+			for iNdEx := len(x.LockedLots) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LockedLots[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xd2
+			}
+		}
+		if x.LockupPeriod != nil {
+			encoded, err := options.Marshal(x.LockupPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+		if len(x.SwapVolumes) > 0 {
+			for iNdEx := len(x.SwapVolumes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SwapVolumes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xc2
+			}
+		}
+		if x.SwapLiquidity != nil {
+			encoded, err := options.Marshal(x.SwapLiquidity)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+		if x.SwapsPaused {
+			i--
+			if x.SwapsPaused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
+		}
+		if x.SwapConfig != nil {
+			encoded, err := options.Marshal(x.SwapConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if x.NextRedemptionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextRedemptionId))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if len(x.Redemptions) > 0 {
+			for iNdEx := len(x.Redemptions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Redemptions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if x.NextSubscriptionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextSubscriptionId))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if len(x.Subscriptions) > 0 {
+			for iNdEx := len(x.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Subscriptions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if x.Stablecoin != nil {
+			encoded, err := options.Marshal(x.Stablecoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.MaxPriceDeviation) > 0 {
+			i -= len(x.MaxPriceDeviation)
+			copy(dAtA[i:], x.MaxPriceDeviation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPriceDeviation)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.PriceRanges) > 0 {
+			for iNdEx := len(x.PriceRanges) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceRanges[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.PriceSetters) > 0 {
+			for iNdEx := len(x.PriceSetters) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PriceSetters[iNdEx])
+				copy(dAtA[i:], x.PriceSetters[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PriceSetters[iNdEx])))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.RateLimitFlows) > 0 {
+			for iNdEx := len(x.RateLimitFlows) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RateLimitFlows[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.RateLimits) > 0 {
+			for iNdEx := len(x.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RateLimits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.AllowedChannels) > 0 {
+			for iNdEx := len(x.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedChannels[iNdEx])
+				copy(dAtA[i:], x.AllowedChannels[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedChannels[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.ChannelMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChannelMode))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.BlockedChannels) > 0 {
+			for iNdEx := len(x.BlockedChannels) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.BlockedChannels[iNdEx])
+				copy(dAtA[i:], x.BlockedChannels[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockedChannels[iNdEx])))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.Pausers) > 0 {
+			for iNdEx := len(x.Pausers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Pausers[iNdEx])
+				copy(dAtA[i:], x.Pausers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Pausers[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Minters) > 0 {
+			for iNdEx := len(x.Minters) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Minters[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Burners) > 0 {
+			for iNdEx := len(x.Burners) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Burners[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.PendingOwner) > 0 {
+			i -= len(x.PendingOwner)
+			copy(dAtA[i:], x.PendingOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingOwner)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Paused {
+			i--
+			if x.Paused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.BlocklistState != nil {
+			encoded, err := options.Marshal(x.BlocklistState)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlocklistState", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlocklistState == nil {
+					x.BlocklistState = &v1.GenesisState{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlocklistState); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Paused = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Burners = append(x.Burners, &Burner{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Burners[len(x.Burners)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Minters = append(x.Minters, &Minter{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Minters[len(x.Minters)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pausers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pausers = append(x.Pausers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockedChannels", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockedChannels = append(x.BlockedChannels, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelMode", wireType)
+				}
+				x.ChannelMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChannelMode |= ChannelMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedChannels = append(x.AllowedChannels, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RateLimits = append(x.RateLimits, &RateLimit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RateLimits[len(x.RateLimits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RateLimitFlows", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RateLimitFlows = append(x.RateLimitFlows, &RateLimitFlow{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RateLimitFlows[len(x.RateLimitFlows)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceSetters", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceSetters = append(x.PriceSetters, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceRanges", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceRanges = append(x.PriceRanges, &PriceRange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceRanges[len(x.PriceRanges)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPriceDeviation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stablecoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Stablecoin == nil {
+					x.Stablecoin = &Stablecoin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stablecoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Subscriptions = append(x.Subscriptions, &Subscription{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Subscriptions[len(x.Subscriptions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextSubscriptionId", wireType)
+				}
+				x.NextSubscriptionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextSubscriptionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Redemptions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Redemptions = append(x.Redemptions, &Redemption{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Redemptions[len(x.Redemptions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextRedemptionId", wireType)
+				}
+				x.NextRedemptionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextRedemptionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwapConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SwapConfig == nil {
+					x.SwapConfig = &SwapConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SwapConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 22:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwapsPaused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SwapsPaused = bool(v != 0)
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwapLiquidity", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SwapLiquidity == nil {
+					x.SwapLiquidity = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SwapLiquidity); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 24:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwapVolumes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SwapVolumes = append(x.SwapVolumes, &SwapVolume{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SwapVolumes[len(x.SwapVolumes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockupPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LockupPeriod == nil {
+					x.LockupPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockupPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 26:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedLots", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockedLots = append(x.LockedLots, &LockedLot{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockedLots[len(x.LockedLots)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 27:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denoms = append(x.Denoms, &DenomState{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Denoms[len(x.Denoms)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 28:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomMetadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomMetadata = append(x.DenomMetadata, &v1beta1.Metadata{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomMetadata[len(x.DenomMetadata)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 29:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attestors", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attestors = append(x.Attestors, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 30:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attestations = append(x.Attestations, &Attestation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Attestations[len(x.Attestations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 31:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReserveConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ReserveConfig == nil {
+					x.ReserveConfig = &ReserveConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReserveConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 32:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recoverers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recoverers = append(x.Recoverers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 33:
+				if wireType == 0 {
+					var v PauseScope
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PauseScope(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.PausedScopes = append(x.PausedScopes, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					if elementCount != 0 && len(x.PausedScopes) == 0 {
+						x.PausedScopes = make([]PauseScope, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v PauseScope
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= PauseScope(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.PausedScopes = append(x.PausedScopes, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausedScopes", wireType)
+				}
+			case 34:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PauseWindows", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PauseWindows = append(x.PauseWindows, &PauseWindow{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PauseWindows[len(x.PauseWindows)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 35:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PauseExemptions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PauseExemptions = append(x.PauseExemptions, &PauseExemption{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PauseExemptions[len(x.PauseExemptions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 36:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DirectionalBlockedChannels", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DirectionalBlockedChannels = append(x.DirectionalBlockedChannels, &BlockedChannel{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DirectionalBlockedChannels[len(x.DirectionalBlockedChannels)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Burner_4_list)(nil)
+
+type _Burner_4_list struct {
+	list *[]string
+}
+
+func (x *_Burner_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Burner_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Burner_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Burner_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Burner_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Burner at list field Sources as it is not of Message kind"))
+}
+
+func (x *_Burner_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Burner_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Burner_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Burner           protoreflect.MessageDescriptor
+	fd_Burner_address   protoreflect.FieldDescriptor
+	fd_Burner_allowance protoreflect.FieldDescriptor
+	fd_Burner_expiry    protoreflect.FieldDescriptor
+	fd_Burner_sources   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_Burner = File_aura_v1_genesis_proto.Messages().ByName("Burner")
+	fd_Burner_address = md_Burner.Fields().ByName("address")
+	fd_Burner_allowance = md_Burner.Fields().ByName("allowance")
+	fd_Burner_expiry = md_Burner.Fields().ByName("expiry")
+	fd_Burner_sources = md_Burner.Fields().ByName("sources")
+}
+
+var _ protoreflect.Message = (*fastReflection_Burner)(nil)
+
+type fastReflection_Burner Burner
+
+func (x *Burner) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Burner)(x)
+}
+
+func (x *Burner) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Burner_messageType fastReflection_Burner_messageType
+var _ protoreflect.MessageType = fastReflection_Burner_messageType{}
+
+type fastReflection_Burner_messageType struct{}
+
+func (x fastReflection_Burner_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Burner)(nil)
+}
+func (x fastReflection_Burner_messageType) New() protoreflect.Message {
+	return new(fastReflection_Burner)
+}
+func (x fastReflection_Burner_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Burner
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Burner) Descriptor() protoreflect.MessageDescriptor {
+	return md_Burner
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Burner) Type() protoreflect.MessageType {
+	return _fastReflection_Burner_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Burner) New() protoreflect.Message {
+	return new(fastReflection_Burner)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Burner) Interface() protoreflect.ProtoMessage {
+	return (*Burner)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Burner) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Burner_address, value) {
+			return
+		}
+	}
+	if x.Allowance != "" {
+		value := protoreflect.ValueOfString(x.Allowance)
+		if !f(fd_Burner_allowance, value) {
+			return
+		}
+	}
+	if x.Expiry != nil {
+		value := protoreflect.ValueOfMessage(x.Expiry.ProtoReflect())
+		if !f(fd_Burner_expiry, value) {
+			return
+		}
+	}
+	if len(x.Sources) != 0 {
+		value := protoreflect.ValueOfList(&_Burner_4_list{list: &x.Sources})
+		if !f(fd_Burner_sources, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Burner) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.Burner.address":
+		return x.Address != ""
+	case "aura.v1.Burner.allowance":
+		return x.Allowance != ""
+	case "aura.v1.Burner.expiry":
+		return x.Expiry != nil
+	case "aura.v1.Burner.sources":
+		return len(x.Sources) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Burner"))
+		}
+		panic(fmt.Errorf("message aura.v1.Burner does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Burner) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.Burner.address":
+		x.Address = ""
+	case "aura.v1.Burner.allowance":
+		x.Allowance = ""
+	case "aura.v1.Burner.expiry":
+		x.Expiry = nil
+	case "aura.v1.Burner.sources":
+		x.Sources = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Burner"))
+		}
+		panic(fmt.Errorf("message aura.v1.Burner does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Burner) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.Burner.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.Burner.allowance":
+		value := x.Allowance
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.Burner.expiry":
+		value := x.Expiry
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "aura.v1.Burner.sources":
+		if len(x.Sources) == 0 {
+			return protoreflect.ValueOfList(&_Burner_4_list{})
+		}
+		listValue := &_Burner_4_list{list: &x.Sources}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Burner"))
+		}
+		panic(fmt.Errorf("message aura.v1.Burner does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Burner) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.Burner.address":
+		x.Address = value.Interface().(string)
+	case "aura.v1.Burner.allowance":
+		x.Allowance = value.Interface().(string)
+	case "aura.v1.Burner.expiry":
+		x.Expiry = value.Message().Interface().(*timestamppb.Timestamp)
+	case "aura.v1.Burner.sources":
+		lv := value.List()
+		clv := lv.(*_Burner_4_list)
+		x.Sources = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Burner"))
+		}
+		panic(fmt.Errorf("message aura.v1.Burner does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Burner) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.Burner.expiry":
+		if x.Expiry == nil {
+			x.Expiry = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiry.ProtoReflect())
+	case "aura.v1.Burner.sources":
+		if x.Sources == nil {
+			x.Sources = []string{}
+		}
+		value := &_Burner_4_list{list: &x.Sources}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.Burner.address":
+		panic(fmt.Errorf("field address of message aura.v1.Burner is not mutable"))
+	case "aura.v1.Burner.allowance":
+		panic(fmt.Errorf("field allowance of message aura.v1.Burner is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Burner"))
+		}
+		panic(fmt.Errorf("message aura.v1.Burner does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Burner) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.Burner.address":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.Burner.allowance":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.Burner.expiry":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "aura.v1.Burner.sources":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Burner_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Burner"))
+		}
+		panic(fmt.Errorf("message aura.v1.Burner does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Burner) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.Burner", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Burner) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Burner) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Burner) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Burner) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Burner)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Allowance)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Expiry != nil {
+			l = options.Size(x.Expiry)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Sources) > 0 {
+			for _, s := range x.Sources {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Burner)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Sources) > 0 {
+			for iNdEx := len(x.Sources) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Sources[iNdEx])
+				copy(dAtA[i:], x.Sources[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sources[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Expiry != nil {
+			encoded, err := options.Marshal(x.Expiry)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Allowance) > 0 {
+			i -= len(x.Allowance)
+			copy(dAtA[i:], x.Allowance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Allowance)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Burner)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Burner: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Burner: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Allowance = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiry == nil {
+					x.Expiry = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiry); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sources = append(x.Sources, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Minter_4_list)(nil)
+
+type _Minter_4_list struct {
+	list *[]string
+}
+
+func (x *_Minter_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Minter_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Minter_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Minter_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Minter_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Minter at list field Recipients as it is not of Message kind"))
+}
+
+func (x *_Minter_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Minter_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Minter_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Minter            protoreflect.MessageDescriptor
+	fd_Minter_address    protoreflect.FieldDescriptor
+	fd_Minter_allowance  protoreflect.FieldDescriptor
+	fd_Minter_expiry     protoreflect.FieldDescriptor
+	fd_Minter_recipients protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_Minter = File_aura_v1_genesis_proto.Messages().ByName("Minter")
+	fd_Minter_address = md_Minter.Fields().ByName("address")
+	fd_Minter_allowance = md_Minter.Fields().ByName("allowance")
+	fd_Minter_expiry = md_Minter.Fields().ByName("expiry")
+	fd_Minter_recipients = md_Minter.Fields().ByName("recipients")
+}
+
+var _ protoreflect.Message = (*fastReflection_Minter)(nil)
+
+type fastReflection_Minter Minter
+
+func (x *Minter) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Minter)(x)
+}
+
+func (x *Minter) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Minter_messageType fastReflection_Minter_messageType
+var _ protoreflect.MessageType = fastReflection_Minter_messageType{}
+
+type fastReflection_Minter_messageType struct{}
+
+func (x fastReflection_Minter_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Minter)(nil)
+}
+func (x fastReflection_Minter_messageType) New() protoreflect.Message {
+	return new(fastReflection_Minter)
+}
+func (x fastReflection_Minter_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Minter
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Minter) Descriptor() protoreflect.MessageDescriptor {
+	return md_Minter
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Minter) Type() protoreflect.MessageType {
+	return _fastReflection_Minter_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Minter) New() protoreflect.Message {
+	return new(fastReflection_Minter)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Minter) Interface() protoreflect.ProtoMessage {
+	return (*Minter)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Minter) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Minter_address, value) {
+			return
+		}
+	}
+	if x.Allowance != "" {
+		value := protoreflect.ValueOfString(x.Allowance)
+		if !f(fd_Minter_allowance, value) {
+			return
+		}
+	}
+	if x.Expiry != nil {
+		value := protoreflect.ValueOfMessage(x.Expiry.ProtoReflect())
+		if !f(fd_Minter_expiry, value) {
+			return
+		}
+	}
+	if len(x.Recipients) != 0 {
+		value := protoreflect.ValueOfList(&_Minter_4_list{list: &x.Recipients})
+		if !f(fd_Minter_recipients, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Minter) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.Minter.address":
+		return x.Address != ""
+	case "aura.v1.Minter.allowance":
+		return x.Allowance != ""
+	case "aura.v1.Minter.expiry":
+		return x.Expiry != nil
+	case "aura.v1.Minter.recipients":
+		return len(x.Recipients) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Minter"))
+		}
+		panic(fmt.Errorf("message aura.v1.Minter does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Minter) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.Minter.address":
+		x.Address = ""
+	case "aura.v1.Minter.allowance":
+		x.Allowance = ""
+	case "aura.v1.Minter.expiry":
+		x.Expiry = nil
+	case "aura.v1.Minter.recipients":
+		x.Recipients = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Minter"))
+		}
+		panic(fmt.Errorf("message aura.v1.Minter does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Minter) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.Minter.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.Minter.allowance":
+		value := x.Allowance
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.Minter.expiry":
+		value := x.Expiry
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "aura.v1.Minter.recipients":
+		if len(x.Recipients) == 0 {
+			return protoreflect.ValueOfList(&_Minter_4_list{})
+		}
+		listValue := &_Minter_4_list{list: &x.Recipients}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Minter"))
+		}
+		panic(fmt.Errorf("message aura.v1.Minter does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Minter) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.Minter.address":
+		x.Address = value.Interface().(string)
+	case "aura.v1.Minter.allowance":
+		x.Allowance = value.Interface().(string)
+	case "aura.v1.Minter.expiry":
+		x.Expiry = value.Message().Interface().(*timestamppb.Timestamp)
+	case "aura.v1.Minter.recipients":
+		lv := value.List()
+		clv := lv.(*_Minter_4_list)
+		x.Recipients = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Minter"))
+		}
+		panic(fmt.Errorf("message aura.v1.Minter does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Minter) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.Minter.expiry":
+		if x.Expiry == nil {
+			x.Expiry = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiry.ProtoReflect())
+	case "aura.v1.Minter.recipients":
+		if x.Recipients == nil {
+			x.Recipients = []string{}
+		}
+		value := &_Minter_4_list{list: &x.Recipients}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.Minter.address":
+		panic(fmt.Errorf("field address of message aura.v1.Minter is not mutable"))
+	case "aura.v1.Minter.allowance":
+		panic(fmt.Errorf("field allowance of message aura.v1.Minter is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Minter"))
+		}
+		panic(fmt.Errorf("message aura.v1.Minter does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Minter) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.Minter.address":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.Minter.allowance":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.Minter.expiry":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "aura.v1.Minter.recipients":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Minter_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Minter"))
+		}
+		panic(fmt.Errorf("message aura.v1.Minter does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Minter) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.Minter", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Minter) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Minter) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Minter) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Minter) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Minter)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Allowance)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Expiry != nil {
+			l = options.Size(x.Expiry)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Recipients) > 0 {
+			for _, s := range x.Recipients {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Minter)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Recipients) > 0 {
+			for iNdEx := len(x.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Recipients[iNdEx])
+				copy(dAtA[i:], x.Recipients[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipients[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Expiry != nil {
+			encoded, err := options.Marshal(x.Expiry)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Allowance) > 0 {
+			i -= len(x.Allowance)
+			copy(dAtA[i:], x.Allowance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Allowance)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Minter)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Allowance = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiry == nil {
+					x.Expiry = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiry); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipients = append(x.Recipients, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BlockedChannel           protoreflect.MessageDescriptor
+	fd_BlockedChannel_channel   protoreflect.FieldDescriptor
+	fd_BlockedChannel_direction protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_BlockedChannel = File_aura_v1_genesis_proto.Messages().ByName("BlockedChannel")
+	fd_BlockedChannel_channel = md_BlockedChannel.Fields().ByName("channel")
+	fd_BlockedChannel_direction = md_BlockedChannel.Fields().ByName("direction")
+}
+
+var _ protoreflect.Message = (*fastReflection_BlockedChannel)(nil)
+
+type fastReflection_BlockedChannel BlockedChannel
+
+func (x *BlockedChannel) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BlockedChannel)(x)
+}
+
+func (x *BlockedChannel) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BlockedChannel_messageType fastReflection_BlockedChannel_messageType
+var _ protoreflect.MessageType = fastReflection_BlockedChannel_messageType{}
+
+type fastReflection_BlockedChannel_messageType struct{}
+
+func (x fastReflection_BlockedChannel_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BlockedChannel)(nil)
+}
+func (x fastReflection_BlockedChannel_messageType) New() protoreflect.Message {
+	return new(fastReflection_BlockedChannel)
+}
+func (x fastReflection_BlockedChannel_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BlockedChannel
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BlockedChannel) Descriptor() protoreflect.MessageDescriptor {
+	return md_BlockedChannel
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BlockedChannel) Type() protoreflect.MessageType {
+	return _fastReflection_BlockedChannel_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BlockedChannel) New() protoreflect.Message {
+	return new(fastReflection_BlockedChannel)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BlockedChannel) Interface() protoreflect.ProtoMessage {
+	return (*BlockedChannel)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BlockedChannel) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Channel != "" {
+		value := protoreflect.ValueOfString(x.Channel)
+		if !f(fd_BlockedChannel_channel, value) {
+			return
+		}
+	}
+	if x.Direction != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Direction))
+		if !f(fd_BlockedChannel_direction, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BlockedChannel) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.BlockedChannel.channel":
+		return x.Channel != ""
+	case "aura.v1.BlockedChannel.direction":
+		return x.Direction != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.BlockedChannel"))
+		}
+		panic(fmt.Errorf("message aura.v1.BlockedChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockedChannel) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.BlockedChannel.channel":
+		x.Channel = ""
+	case "aura.v1.BlockedChannel.direction":
+		x.Direction = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.BlockedChannel"))
+		}
+		panic(fmt.Errorf("message aura.v1.BlockedChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BlockedChannel) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.BlockedChannel.channel":
+		value := x.Channel
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.BlockedChannel.direction":
+		value := x.Direction
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.BlockedChannel"))
+		}
+		panic(fmt.Errorf("message aura.v1.BlockedChannel does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockedChannel) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.BlockedChannel.channel":
+		x.Channel = value.Interface().(string)
+	case "aura.v1.BlockedChannel.direction":
+		x.Direction = (ChannelDirection)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.BlockedChannel"))
+		}
+		panic(fmt.Errorf("message aura.v1.BlockedChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockedChannel) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.BlockedChannel.channel":
+		panic(fmt.Errorf("field channel of message aura.v1.BlockedChannel is not mutable"))
+	case "aura.v1.BlockedChannel.direction":
+		panic(fmt.Errorf("field direction of message aura.v1.BlockedChannel is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.BlockedChannel"))
+		}
+		panic(fmt.Errorf("message aura.v1.BlockedChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BlockedChannel) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.BlockedChannel.channel":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.BlockedChannel.direction":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.BlockedChannel"))
+		}
+		panic(fmt.Errorf("message aura.v1.BlockedChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BlockedChannel) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.BlockedChannel", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BlockedChannel) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockedChannel) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BlockedChannel) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BlockedChannel) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BlockedChannel)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Channel)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Direction != 0 {
+			n += 1 + runtime.Sov(uint64(x.Direction))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BlockedChannel)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Direction != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Direction))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Channel) > 0 {
+			i -= len(x.Channel)
+			copy(dAtA[i:], x.Channel)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Channel)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BlockedChannel)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockedChannel: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Channel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+				}
+				x.Direction = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Direction |= ChannelDirection(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PauseExemption           protoreflect.MessageDescriptor
+	fd_PauseExemption_address   protoreflect.FieldDescriptor
+	fd_PauseExemption_direction protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_PauseExemption = File_aura_v1_genesis_proto.Messages().ByName("PauseExemption")
+	fd_PauseExemption_address = md_PauseExemption.Fields().ByName("address")
+	fd_PauseExemption_direction = md_PauseExemption.Fields().ByName("direction")
+}
+
+var _ protoreflect.Message = (*fastReflection_PauseExemption)(nil)
+
+type fastReflection_PauseExemption PauseExemption
+
+func (x *PauseExemption) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PauseExemption)(x)
+}
+
+func (x *PauseExemption) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PauseExemption_messageType fastReflection_PauseExemption_messageType
+var _ protoreflect.MessageType = fastReflection_PauseExemption_messageType{}
+
+type fastReflection_PauseExemption_messageType struct{}
+
+func (x fastReflection_PauseExemption_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PauseExemption)(nil)
+}
+func (x fastReflection_PauseExemption_messageType) New() protoreflect.Message {
+	return new(fastReflection_PauseExemption)
+}
+func (x fastReflection_PauseExemption_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PauseExemption
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PauseExemption) Descriptor() protoreflect.MessageDescriptor {
+	return md_PauseExemption
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PauseExemption) Type() protoreflect.MessageType {
+	return _fastReflection_PauseExemption_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PauseExemption) New() protoreflect.Message {
+	return new(fastReflection_PauseExemption)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PauseExemption) Interface() protoreflect.ProtoMessage {
+	return (*PauseExemption)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PauseExemption) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_PauseExemption_address, value) {
+			return
+		}
+	}
+	if x.Direction != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Direction))
+		if !f(fd_PauseExemption_direction, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PauseExemption) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.PauseExemption.address":
+		return x.Address != ""
+	case "aura.v1.PauseExemption.direction":
+		return x.Direction != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PauseExemption"))
+		}
+		panic(fmt.Errorf("message aura.v1.PauseExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PauseExemption) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.PauseExemption.address":
+		x.Address = ""
+	case "aura.v1.PauseExemption.direction":
+		x.Direction = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PauseExemption"))
+		}
+		panic(fmt.Errorf("message aura.v1.PauseExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PauseExemption) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.PauseExemption.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.PauseExemption.direction":
+		value := x.Direction
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PauseExemption"))
+		}
+		panic(fmt.Errorf("message aura.v1.PauseExemption does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PauseExemption) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.PauseExemption.address":
+		x.Address = value.Interface().(string)
+	case "aura.v1.PauseExemption.direction":
+		x.Direction = (ExemptionDirection)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PauseExemption"))
+		}
+		panic(fmt.Errorf("message aura.v1.PauseExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PauseExemption) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.PauseExemption.address":
+		panic(fmt.Errorf("field address of message aura.v1.PauseExemption is not mutable"))
+	case "aura.v1.PauseExemption.direction":
+		panic(fmt.Errorf("field direction of message aura.v1.PauseExemption is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PauseExemption"))
+		}
+		panic(fmt.Errorf("message aura.v1.PauseExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PauseExemption) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.PauseExemption.address":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.PauseExemption.direction":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PauseExemption"))
+		}
+		panic(fmt.Errorf("message aura.v1.PauseExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PauseExemption) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.PauseExemption", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PauseExemption) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PauseExemption) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PauseExemption) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PauseExemption) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PauseExemption)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Direction != 0 {
+			n += 1 + runtime.Sov(uint64(x.Direction))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PauseExemption)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Direction != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Direction))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PauseExemption)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PauseExemption: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PauseExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+				}
+				x.Direction = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Direction |= ExemptionDirection(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DenomState_2_list)(nil)
+
+type _DenomState_2_list struct {
+	list *[]PauseScope
+}
+
+func (x *_DenomState_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DenomState_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
+}
+
+func (x *_DenomState_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (PauseScope)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DenomState_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (PauseScope)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DenomState_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DenomState at list field PausedScopes as it is not of Message kind"))
+}
+
+func (x *_DenomState_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DenomState_2_list) NewElement() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_DenomState_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DenomState_5_list)(nil)
+
+type _DenomState_5_list struct {
+	list *[]*Burner
+}
+
+func (x *_DenomState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DenomState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DenomState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Burner)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DenomState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Burner)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DenomState_5_list) AppendMutable() protoreflect.Value {
+	v := new(Burner)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DenomState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DenomState_5_list) NewElement() protoreflect.Value {
+	v := new(Burner)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DenomState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DenomState_6_list)(nil)
+
+type _DenomState_6_list struct {
+	list *[]*Minter
+}
+
+func (x *_DenomState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DenomState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DenomState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Minter)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DenomState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Minter)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DenomState_6_list) AppendMutable() protoreflect.Value {
+	v := new(Minter)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DenomState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DenomState_6_list) NewElement() protoreflect.Value {
+	v := new(Minter)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DenomState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DenomState_7_list)(nil)
+
+type _DenomState_7_list struct {
+	list *[]string
+}
+
+func (x *_DenomState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DenomState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DenomState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DenomState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DenomState_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DenomState at list field Pausers as it is not of Message kind"))
+}
+
+func (x *_DenomState_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DenomState_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DenomState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DenomState_8_list)(nil)
+
+type _DenomState_8_list struct {
+	list *[]*BlockedChannel
+}
+
+func (x *_DenomState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DenomState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DenomState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BlockedChannel)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DenomState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BlockedChannel)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DenomState_8_list) AppendMutable() protoreflect.Value {
+	v := new(BlockedChannel)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DenomState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DenomState_8_list) NewElement() protoreflect.Value {
+	v := new(BlockedChannel)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DenomState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DenomState_10_list)(nil)
+
+type _DenomState_10_list struct {
+	list *[]*PauseExemption
+}
+
+func (x *_DenomState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DenomState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DenomState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PauseExemption)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DenomState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PauseExemption)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DenomState_10_list) AppendMutable() protoreflect.Value {
+	v := new(PauseExemption)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DenomState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DenomState_10_list) NewElement() protoreflect.Value {
+	v := new(PauseExemption)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DenomState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DenomState_11_list)(nil)
+
+type _DenomState_11_list struct {
+	list *[]string
+}
+
+func (x *_DenomState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DenomState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DenomState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DenomState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DenomState_11_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DenomState at list field Recoverers as it is not of Message kind"))
+}
+
+func (x *_DenomState_11_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DenomState_11_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DenomState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DenomState                  protoreflect.MessageDescriptor
+	fd_DenomState_denom            protoreflect.FieldDescriptor
+	fd_DenomState_paused_scopes    protoreflect.FieldDescriptor
+	fd_DenomState_owner            protoreflect.FieldDescriptor
+	fd_DenomState_pending_owner    protoreflect.FieldDescriptor
+	fd_DenomState_burners          protoreflect.FieldDescriptor
+	fd_DenomState_minters          protoreflect.FieldDescriptor
+	fd_DenomState_pausers          protoreflect.FieldDescriptor
+	fd_DenomState_blocked_channels protoreflect.FieldDescriptor
+	fd_DenomState_blocklist_bound  protoreflect.FieldDescriptor
+	fd_DenomState_pause_exemptions protoreflect.FieldDescriptor
+	fd_DenomState_recoverers       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_DenomState = File_aura_v1_genesis_proto.Messages().ByName("DenomState")
+	fd_DenomState_denom = md_DenomState.Fields().ByName("denom")
+	fd_DenomState_paused_scopes = md_DenomState.Fields().ByName("paused_scopes")
+	fd_DenomState_owner = md_DenomState.Fields().ByName("owner")
+	fd_DenomState_pending_owner = md_DenomState.Fields().ByName("pending_owner")
+	fd_DenomState_burners = md_DenomState.Fields().ByName("burners")
+	fd_DenomState_minters = md_DenomState.Fields().ByName("minters")
+	fd_DenomState_pausers = md_DenomState.Fields().ByName("pausers")
+	fd_DenomState_blocked_channels = md_DenomState.Fields().ByName("blocked_channels")
+	fd_DenomState_blocklist_bound = md_DenomState.Fields().ByName("blocklist_bound")
+	fd_DenomState_pause_exemptions = md_DenomState.Fields().ByName("pause_exemptions")
+	fd_DenomState_recoverers = md_DenomState.Fields().ByName("recoverers")
+}
+
+var _ protoreflect.Message = (*fastReflection_DenomState)(nil)
+
+type fastReflection_DenomState DenomState
+
+func (x *DenomState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DenomState)(x)
+}
+
+func (x *DenomState) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DenomState_messageType fastReflection_DenomState_messageType
+var _ protoreflect.MessageType = fastReflection_DenomState_messageType{}
+
+type fastReflection_DenomState_messageType struct{}
+
+func (x fastReflection_DenomState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DenomState)(nil)
+}
+func (x fastReflection_DenomState_messageType) New() protoreflect.Message {
+	return new(fastReflection_DenomState)
+}
+func (x fastReflection_DenomState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DenomState) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DenomState) Type() protoreflect.MessageType {
+	return _fastReflection_DenomState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DenomState) New() protoreflect.Message {
+	return new(fastReflection_DenomState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DenomState) Interface() protoreflect.ProtoMessage {
+	return (*DenomState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DenomState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_DenomState_denom, value) {
+			return
+		}
+	}
+	if len(x.PausedScopes) != 0 {
+		value := protoreflect.ValueOfList(&_DenomState_2_list{list: &x.PausedScopes})
+		if !f(fd_DenomState_paused_scopes, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_DenomState_owner, value) {
+			return
+		}
+	}
+	if x.PendingOwner != "" {
+		value := protoreflect.ValueOfString(x.PendingOwner)
+		if !f(fd_DenomState_pending_owner, value) {
+			return
+		}
+	}
+	if len(x.Burners) != 0 {
+		value := protoreflect.ValueOfList(&_DenomState_5_list{list: &x.Burners})
+		if !f(fd_DenomState_burners, value) {
+			return
+		}
+	}
+	if len(x.Minters) != 0 {
+		value := protoreflect.ValueOfList(&_DenomState_6_list{list: &x.Minters})
+		if !f(fd_DenomState_minters, value) {
+			return
+		}
+	}
+	if len(x.Pausers) != 0 {
+		value := protoreflect.ValueOfList(&_DenomState_7_list{list: &x.Pausers})
+		if !f(fd_DenomState_pausers, value) {
+			return
+		}
+	}
+	if len(x.BlockedChannels) != 0 {
+		value := protoreflect.ValueOfList(&_DenomState_8_list{list: &x.BlockedChannels})
+		if !f(fd_DenomState_blocked_channels, value) {
+			return
+		}
+	}
+	if x.BlocklistBound != false {
+		value := protoreflect.ValueOfBool(x.BlocklistBound)
+		if !f(fd_DenomState_blocklist_bound, value) {
+			return
+		}
+	}
+	if len(x.PauseExemptions) != 0 {
+		value := protoreflect.ValueOfList(&_DenomState_10_list{list: &x.PauseExemptions})
+		if !f(fd_DenomState_pause_exemptions, value) {
+			return
+		}
+	}
+	if len(x.Recoverers) != 0 {
+		value := protoreflect.ValueOfList(&_DenomState_11_list{list: &x.Recoverers})
+		if !f(fd_DenomState_recoverers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DenomState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.DenomState.denom":
+		return x.Denom != ""
+	case "aura.v1.DenomState.paused_scopes":
+		return len(x.PausedScopes) != 0
+	case "aura.v1.DenomState.owner":
+		return x.Owner != ""
+	case "aura.v1.DenomState.pending_owner":
+		return x.PendingOwner != ""
+	case "aura.v1.DenomState.burners":
+		return len(x.Burners) != 0
+	case "aura.v1.DenomState.minters":
+		return len(x.Minters) != 0
+	case "aura.v1.DenomState.pausers":
+		return len(x.Pausers) != 0
+	case "aura.v1.DenomState.blocked_channels":
+		return len(x.BlockedChannels) != 0
+	case "aura.v1.DenomState.blocklist_bound":
+		return x.BlocklistBound != false
+	case "aura.v1.DenomState.pause_exemptions":
+		return len(x.PauseExemptions) != 0
+	case "aura.v1.DenomState.recoverers":
+		return len(x.Recoverers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.DenomState"))
+		}
+		panic(fmt.Errorf("message aura.v1.DenomState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.DenomState.denom":
+		x.Denom = ""
+	case "aura.v1.DenomState.paused_scopes":
+		x.PausedScopes = nil
+	case "aura.v1.DenomState.owner":
+		x.Owner = ""
+	case "aura.v1.DenomState.pending_owner":
+		x.PendingOwner = ""
+	case "aura.v1.DenomState.burners":
+		x.Burners = nil
+	case "aura.v1.DenomState.minters":
+		x.Minters = nil
+	case "aura.v1.DenomState.pausers":
+		x.Pausers = nil
+	case "aura.v1.DenomState.blocked_channels":
+		x.BlockedChannels = nil
+	case "aura.v1.DenomState.blocklist_bound":
+		x.BlocklistBound = false
+	case "aura.v1.DenomState.pause_exemptions":
+		x.PauseExemptions = nil
+	case "aura.v1.DenomState.recoverers":
+		x.Recoverers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.DenomState"))
+		}
+		panic(fmt.Errorf("message aura.v1.DenomState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DenomState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.DenomState.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.DenomState.paused_scopes":
+		if len(x.PausedScopes) == 0 {
+			return protoreflect.ValueOfList(&_DenomState_2_list{})
+		}
+		listValue := &_DenomState_2_list{list: &x.PausedScopes}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.DenomState.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.DenomState.pending_owner":
+		value := x.PendingOwner
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.DenomState.burners":
+		if len(x.Burners) == 0 {
+			return protoreflect.ValueOfList(&_DenomState_5_list{})
+		}
+		listValue := &_DenomState_5_list{list: &x.Burners}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.DenomState.minters":
+		if len(x.Minters) == 0 {
+			return protoreflect.ValueOfList(&_DenomState_6_list{})
+		}
+		listValue := &_DenomState_6_list{list: &x.Minters}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.DenomState.pausers":
+		if len(x.Pausers) == 0 {
+			return protoreflect.ValueOfList(&_DenomState_7_list{})
+		}
+		listValue := &_DenomState_7_list{list: &x.Pausers}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.DenomState.blocked_channels":
+		if len(x.BlockedChannels) == 0 {
+			return protoreflect.ValueOfList(&_DenomState_8_list{})
+		}
+		listValue := &_DenomState_8_list{list: &x.BlockedChannels}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.DenomState.blocklist_bound":
+		value := x.BlocklistBound
+		return protoreflect.ValueOfBool(value)
+	case "aura.v1.DenomState.pause_exemptions":
+		if len(x.PauseExemptions) == 0 {
+			return protoreflect.ValueOfList(&_DenomState_10_list{})
+		}
+		listValue := &_DenomState_10_list{list: &x.PauseExemptions}
+		return protoreflect.ValueOfList(listValue)
+	case "aura.v1.DenomState.recoverers":
+		if len(x.Recoverers) == 0 {
+			return protoreflect.ValueOfList(&_DenomState_11_list{})
+		}
+		listValue := &_DenomState_11_list{list: &x.Recoverers}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.DenomState"))
+		}
+		panic(fmt.Errorf("message aura.v1.DenomState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.DenomState.denom":
+		x.Denom = value.Interface().(string)
+	case "aura.v1.DenomState.paused_scopes":
+		lv := value.List()
+		clv := lv.(*_DenomState_2_list)
+		x.PausedScopes = *clv.list
+	case "aura.v1.DenomState.owner":
+		x.Owner = value.Interface().(string)
+	case "aura.v1.DenomState.pending_owner":
+		x.PendingOwner = value.Interface().(string)
+	case "aura.v1.DenomState.burners":
+		lv := value.List()
+		clv := lv.(*_DenomState_5_list)
+		x.Burners = *clv.list
+	case "aura.v1.DenomState.minters":
+		lv := value.List()
+		clv := lv.(*_DenomState_6_list)
+		x.Minters = *clv.list
+	case "aura.v1.DenomState.pausers":
+		lv := value.List()
+		clv := lv.(*_DenomState_7_list)
+		x.Pausers = *clv.list
+	case "aura.v1.DenomState.blocked_channels":
+		lv := value.List()
+		clv := lv.(*_DenomState_8_list)
+		x.BlockedChannels = *clv.list
+	case "aura.v1.DenomState.blocklist_bound":
+		x.BlocklistBound = value.Bool()
+	case "aura.v1.DenomState.pause_exemptions":
+		lv := value.List()
+		clv := lv.(*_DenomState_10_list)
+		x.PauseExemptions = *clv.list
+	case "aura.v1.DenomState.recoverers":
+		lv := value.List()
+		clv := lv.(*_DenomState_11_list)
+		x.Recoverers = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.DenomState"))
+		}
+		panic(fmt.Errorf("message aura.v1.DenomState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.DenomState.paused_scopes":
+		if x.PausedScopes == nil {
+			x.PausedScopes = []PauseScope{}
+		}
+		value := &_DenomState_2_list{list: &x.PausedScopes}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.DenomState.burners":
+		if x.Burners == nil {
+			x.Burners = []*Burner{}
+		}
+		value := &_DenomState_5_list{list: &x.Burners}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.DenomState.minters":
+		if x.Minters == nil {
+			x.Minters = []*Minter{}
+		}
+		value := &_DenomState_6_list{list: &x.Minters}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.DenomState.pausers":
+		if x.Pausers == nil {
+			x.Pausers = []string{}
+		}
+		value := &_DenomState_7_list{list: &x.Pausers}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.DenomState.blocked_channels":
+		if x.BlockedChannels == nil {
+			x.BlockedChannels = []*BlockedChannel{}
+		}
+		value := &_DenomState_8_list{list: &x.BlockedChannels}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.DenomState.pause_exemptions":
+		if x.PauseExemptions == nil {
+			x.PauseExemptions = []*PauseExemption{}
+		}
+		value := &_DenomState_10_list{list: &x.PauseExemptions}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.DenomState.recoverers":
+		if x.Recoverers == nil {
+			x.Recoverers = []string{}
+		}
+		value := &_DenomState_11_list{list: &x.Recoverers}
+		return protoreflect.ValueOfList(value)
+	case "aura.v1.DenomState.denom":
+		panic(fmt.Errorf("field denom of message aura.v1.DenomState is not mutable"))
+	case "aura.v1.DenomState.owner":
+		panic(fmt.Errorf("field owner of message aura.v1.DenomState is not mutable"))
+	case "aura.v1.DenomState.pending_owner":
+		panic(fmt.Errorf("field pending_owner of message aura.v1.DenomState is not mutable"))
+	case "aura.v1.DenomState.blocklist_bound":
+		panic(fmt.Errorf("field blocklist_bound of message aura.v1.DenomState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.DenomState"))
+		}
+		panic(fmt.Errorf("message aura.v1.DenomState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DenomState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.DenomState.denom":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.DenomState.paused_scopes":
+		list := []PauseScope{}
+		return protoreflect.ValueOfList(&_DenomState_2_list{list: &list})
+	case "aura.v1.DenomState.owner":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.DenomState.pending_owner":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.DenomState.burners":
+		list := []*Burner{}
+		return protoreflect.ValueOfList(&_DenomState_5_list{list: &list})
+	case "aura.v1.DenomState.minters":
+		list := []*Minter{}
+		return protoreflect.ValueOfList(&_DenomState_6_list{list: &list})
+	case "aura.v1.DenomState.pausers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DenomState_7_list{list: &list})
+	case "aura.v1.DenomState.blocked_channels":
+		list := []*BlockedChannel{}
+		return protoreflect.ValueOfList(&_DenomState_8_list{list: &list})
+	case "aura.v1.DenomState.blocklist_bound":
+		return protoreflect.ValueOfBool(false)
+	case "aura.v1.DenomState.pause_exemptions":
+		list := []*PauseExemption{}
+		return protoreflect.ValueOfList(&_DenomState_10_list{list: &list})
+	case "aura.v1.DenomState.recoverers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DenomState_11_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.DenomState"))
+		}
+		panic(fmt.Errorf("message aura.v1.DenomState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DenomState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.DenomState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DenomState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DenomState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DenomState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DenomState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PausedScopes) > 0 {
+			l = 0
+			for _, e := range x.PausedScopes {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PendingOwner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Burners) > 0 {
+			for _, e := range x.Burners {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Minters) > 0 {
+			for _, e := range x.Minters {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Pausers) > 0 {
+			for _, s := range x.Pausers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BlockedChannels) > 0 {
+			for _, e := range x.BlockedChannels {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BlocklistBound {
+			n += 2
+		}
+		if len(x.PauseExemptions) > 0 {
+			for _, e := range x.PauseExemptions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Recoverers) > 0 {
+			for _, s := range x.Recoverers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DenomState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Recoverers) > 0 {
+			for iNdEx := len(x.Recoverers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Recoverers[iNdEx])
+				copy(dAtA[i:], x.Recoverers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recoverers[iNdEx])))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.PauseExemptions) > 0 {
+			for iNdEx := len(x.PauseExemptions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PauseExemptions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.BlocklistBound {
+			i--
+			if x.BlocklistBound {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.BlockedChannels) > 0 {
+			for iNdEx := len(x.BlockedChannels) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BlockedChannels[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.Pausers) > 0 {
+			for iNdEx := len(x.Pausers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Pausers[iNdEx])
+				copy(dAtA[i:], x.Pausers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Pausers[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Minters) > 0 {
+			for iNdEx := len(x.Minters) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Minters[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Burners) > 0 {
+			for iNdEx := len(x.Burners) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Burners[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.PendingOwner) > 0 {
+			i -= len(x.PendingOwner)
+			copy(dAtA[i:], x.PendingOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingOwner)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.PausedScopes) > 0 {
+			var pksize2 int
+			for _, num := range x.PausedScopes {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.PausedScopes {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DenomState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType == 0 {
+					var v PauseScope
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PauseScope(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.PausedScopes = append(x.PausedScopes, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					if elementCount != 0 && len(x.PausedScopes) == 0 {
+						x.PausedScopes = make([]PauseScope, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v PauseScope
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= PauseScope(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.PausedScopes = append(x.PausedScopes, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausedScopes", wireType)
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Burners = append(x.Burners, &Burner{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Burners[len(x.Burners)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Minters = append(x.Minters, &Minter{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Minters[len(x.Minters)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pausers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pausers = append(x.Pausers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockedChannels", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockedChannels = append(x.BlockedChannels, &BlockedChannel{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockedChannels[len(x.BlockedChannels)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlocklistBound", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BlocklistBound = bool(v != 0)
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PauseExemptions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PauseExemptions = append(x.PauseExemptions, &PauseExemption{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PauseExemptions[len(x.PauseExemptions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recoverers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recoverers = append(x.Recoverers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RateLimit                protoreflect.MessageDescriptor
+	fd_RateLimit_channel        protoreflect.FieldDescriptor
+	fd_RateLimit_window         protoreflect.FieldDescriptor
+	fd_RateLimit_max_amount     protoreflect.FieldDescriptor
+	fd_RateLimit_max_percentage protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_RateLimit = File_aura_v1_genesis_proto.Messages().ByName("RateLimit")
+	fd_RateLimit_channel = md_RateLimit.Fields().ByName("channel")
+	fd_RateLimit_window = md_RateLimit.Fields().ByName("window")
+	fd_RateLimit_max_amount = md_RateLimit.Fields().ByName("max_amount")
+	fd_RateLimit_max_percentage = md_RateLimit.Fields().ByName("max_percentage")
+}
+
+var _ protoreflect.Message = (*fastReflection_RateLimit)(nil)
+
+type fastReflection_RateLimit RateLimit
+
+func (x *RateLimit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RateLimit)(x)
+}
+
+func (x *RateLimit) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RateLimit_messageType fastReflection_RateLimit_messageType
+var _ protoreflect.MessageType = fastReflection_RateLimit_messageType{}
+
+type fastReflection_RateLimit_messageType struct{}
+
+func (x fastReflection_RateLimit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RateLimit)(nil)
+}
+func (x fastReflection_RateLimit_messageType) New() protoreflect.Message {
+	return new(fastReflection_RateLimit)
+}
+func (x fastReflection_RateLimit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RateLimit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RateLimit) Descriptor() protoreflect.MessageDescriptor {
+	return md_RateLimit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RateLimit) Type() protoreflect.MessageType {
+	return _fastReflection_RateLimit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RateLimit) New() protoreflect.Message {
+	return new(fastReflection_RateLimit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RateLimit) Interface() protoreflect.ProtoMessage {
+	return (*RateLimit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RateLimit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Channel != "" {
+		value := protoreflect.ValueOfString(x.Channel)
+		if !f(fd_RateLimit_channel, value) {
+			return
+		}
+	}
+	if x.Window != nil {
+		value := protoreflect.ValueOfMessage(x.Window.ProtoReflect())
+		if !f(fd_RateLimit_window, value) {
+			return
+		}
+	}
+	if x.MaxAmount != "" {
+		value := protoreflect.ValueOfString(x.MaxAmount)
+		if !f(fd_RateLimit_max_amount, value) {
+			return
+		}
+	}
+	if x.MaxPercentage != "" {
+		value := protoreflect.ValueOfString(x.MaxPercentage)
+		if !f(fd_RateLimit_max_percentage, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RateLimit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.RateLimit.channel":
+		return x.Channel != ""
+	case "aura.v1.RateLimit.window":
+		return x.Window != nil
+	case "aura.v1.RateLimit.max_amount":
+		return x.MaxAmount != ""
+	case "aura.v1.RateLimit.max_percentage":
+		return x.MaxPercentage != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.RateLimit"))
+		}
+		panic(fmt.Errorf("message aura.v1.RateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RateLimit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.RateLimit.channel":
+		x.Channel = ""
+	case "aura.v1.RateLimit.window":
+		x.Window = nil
+	case "aura.v1.RateLimit.max_amount":
+		x.MaxAmount = ""
+	case "aura.v1.RateLimit.max_percentage":
+		x.MaxPercentage = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.RateLimit"))
+		}
+		panic(fmt.Errorf("message aura.v1.RateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RateLimit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.RateLimit.channel":
+		value := x.Channel
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.RateLimit.window":
+		value := x.Window
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "aura.v1.RateLimit.max_amount":
+		value := x.MaxAmount
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.RateLimit.max_percentage":
+		value := x.MaxPercentage
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.RateLimit"))
+		}
+		panic(fmt.Errorf("message aura.v1.RateLimit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RateLimit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.RateLimit.channel":
+		x.Channel = value.Interface().(string)
+	case "aura.v1.RateLimit.window":
+		x.Window = value.Message().Interface().(*durationpb.Duration)
+	case "aura.v1.RateLimit.max_amount":
+		x.MaxAmount = value.Interface().(string)
+	case "aura.v1.RateLimit.max_percentage":
+		x.MaxPercentage = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.RateLimit"))
+		}
+		panic(fmt.Errorf("message aura.v1.RateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RateLimit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.RateLimit.window":
+		if x.Window == nil {
+			x.Window = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Window.ProtoReflect())
+	case "aura.v1.RateLimit.channel":
+		panic(fmt.Errorf("field channel of message aura.v1.RateLimit is not mutable"))
+	case "aura.v1.RateLimit.max_amount":
+		panic(fmt.Errorf("field max_amount of message aura.v1.RateLimit is not mutable"))
+	case "aura.v1.RateLimit.max_percentage":
+		panic(fmt.Errorf("field max_percentage of message aura.v1.RateLimit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.RateLimit"))
+		}
+		panic(fmt.Errorf("message aura.v1.RateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RateLimit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.RateLimit.channel":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.RateLimit.window":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "aura.v1.RateLimit.max_amount":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.RateLimit.max_percentage":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.RateLimit"))
+		}
+		panic(fmt.Errorf("message aura.v1.RateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RateLimit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.RateLimit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RateLimit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RateLimit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RateLimit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RateLimit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RateLimit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Channel)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Window != nil {
+			l = options.Size(x.Window)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxPercentage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RateLimit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxPercentage) > 0 {
+			i -= len(x.MaxPercentage)
+			copy(dAtA[i:], x.MaxPercentage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPercentage)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.MaxAmount) > 0 {
+			i -= len(x.MaxAmount)
+			copy(dAtA[i:], x.MaxAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxAmount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Window != nil {
+			encoded, err := options.Marshal(x.Window)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Channel) > 0 {
+			i -= len(x.Channel)
+			copy(dAtA[i:], x.Channel)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Channel)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RateLimit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Channel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Window == nil {
+					x.Window = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Window); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPercentage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPercentage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RateLimitFlow              protoreflect.MessageDescriptor
+	fd_RateLimitFlow_channel      protoreflect.FieldDescriptor
+	fd_RateLimitFlow_window_start protoreflect.FieldDescriptor
+	fd_RateLimitFlow_inflow       protoreflect.FieldDescriptor
+	fd_RateLimitFlow_outflow      protoreflect.FieldDescriptor
+	fd_RateLimitFlow_supply       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_RateLimitFlow = File_aura_v1_genesis_proto.Messages().ByName("RateLimitFlow")
+	fd_RateLimitFlow_channel = md_RateLimitFlow.Fields().ByName("channel")
+	fd_RateLimitFlow_window_start = md_RateLimitFlow.Fields().ByName("window_start")
+	fd_RateLimitFlow_inflow = md_RateLimitFlow.Fields().ByName("inflow")
+	fd_RateLimitFlow_outflow = md_RateLimitFlow.Fields().ByName("outflow")
+	fd_RateLimitFlow_supply = md_RateLimitFlow.Fields().ByName("supply")
+}
+
+var _ protoreflect.Message = (*fastReflection_RateLimitFlow)(nil)
+
+type fastReflection_RateLimitFlow RateLimitFlow
+
+func (x *RateLimitFlow) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RateLimitFlow)(x)
+}
+
+func (x *RateLimitFlow) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RateLimitFlow_messageType fastReflection_RateLimitFlow_messageType
+var _ protoreflect.MessageType = fastReflection_RateLimitFlow_messageType{}
+
+type fastReflection_RateLimitFlow_messageType struct{}
+
+func (x fastReflection_RateLimitFlow_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RateLimitFlow)(nil)
+}
+func (x fastReflection_RateLimitFlow_messageType) New() protoreflect.Message {
+	return new(fastReflection_RateLimitFlow)
+}
+func (x fastReflection_RateLimitFlow_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RateLimitFlow
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RateLimitFlow) Descriptor() protoreflect.MessageDescriptor {
+	return md_RateLimitFlow
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RateLimitFlow) Type() protoreflect.MessageType {
+	return _fastReflection_RateLimitFlow_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RateLimitFlow) New() protoreflect.Message {
+	return new(fastReflection_RateLimitFlow)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RateLimitFlow) Interface() protoreflect.ProtoMessage {
+	return (*RateLimitFlow)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RateLimitFlow) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Channel != "" {
+		value := protoreflect.ValueOfString(x.Channel)
+		if !f(fd_RateLimitFlow_channel, value) {
+			return
+		}
+	}
+	if x.WindowStart != nil {
+		value := protoreflect.ValueOfMessage(x.WindowStart.ProtoReflect())
+		if !f(fd_RateLimitFlow_window_start, value) {
+			return
+		}
+	}
+	if x.Inflow != "" {
+		value := protoreflect.ValueOfString(x.Inflow)
+		if !f(fd_RateLimitFlow_inflow, value) {
+			return
+		}
+	}
+	if x.Outflow != "" {
+		value := protoreflect.ValueOfString(x.Outflow)
+		if !f(fd_RateLimitFlow_outflow, value) {
+			return
+		}
+	}
+	if x.Supply != "" {
+		value := protoreflect.ValueOfString(x.Supply)
+		if !f(fd_RateLimitFlow_supply, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RateLimitFlow) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.RateLimitFlow.channel":
+		return x.Channel != ""
+	case "aura.v1.RateLimitFlow.window_start":
+		return x.WindowStart != nil
+	case "aura.v1.RateLimitFlow.inflow":
+		return x.Inflow != ""
+	case "aura.v1.RateLimitFlow.outflow":
+		return x.Outflow != ""
+	case "aura.v1.RateLimitFlow.supply":
+		return x.Supply != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.RateLimitFlow"))
+		}
+		panic(fmt.Errorf("message aura.v1.RateLimitFlow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RateLimitFlow) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.RateLimitFlow.channel":
+		x.Channel = ""
+	case "aura.v1.RateLimitFlow.window_start":
+		x.WindowStart = nil
+	case "aura.v1.RateLimitFlow.inflow":
+		x.Inflow = ""
+	case "aura.v1.RateLimitFlow.outflow":
+		x.Outflow = ""
+	case "aura.v1.RateLimitFlow.supply":
+		x.Supply = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.RateLimitFlow"))
+		}
+		panic(fmt.Errorf("message aura.v1.RateLimitFlow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RateLimitFlow) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.RateLimitFlow.channel":
+		value := x.Channel
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.RateLimitFlow.window_start":
+		value := x.WindowStart
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "aura.v1.RateLimitFlow.inflow":
+		value := x.Inflow
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.RateLimitFlow.outflow":
+		value := x.Outflow
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.RateLimitFlow.supply":
+		value := x.Supply
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.RateLimitFlow"))
+		}
+		panic(fmt.Errorf("message aura.v1.RateLimitFlow does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RateLimitFlow) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.RateLimitFlow.channel":
+		x.Channel = value.Interface().(string)
+	case "aura.v1.RateLimitFlow.window_start":
+		x.WindowStart = value.Message().Interface().(*timestamppb.Timestamp)
+	case "aura.v1.RateLimitFlow.inflow":
+		x.Inflow = value.Interface().(string)
+	case "aura.v1.RateLimitFlow.outflow":
+		x.Outflow = value.Interface().(string)
+	case "aura.v1.RateLimitFlow.supply":
+		x.Supply = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.RateLimitFlow"))
+		}
+		panic(fmt.Errorf("message aura.v1.RateLimitFlow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RateLimitFlow) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.RateLimitFlow.window_start":
+		if x.WindowStart == nil {
+			x.WindowStart = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.WindowStart.ProtoReflect())
+	case "aura.v1.RateLimitFlow.channel":
+		panic(fmt.Errorf("field channel of message aura.v1.RateLimitFlow is not mutable"))
+	case "aura.v1.RateLimitFlow.inflow":
+		panic(fmt.Errorf("field inflow of message aura.v1.RateLimitFlow is not mutable"))
+	case "aura.v1.RateLimitFlow.outflow":
+		panic(fmt.Errorf("field outflow of message aura.v1.RateLimitFlow is not mutable"))
+	case "aura.v1.RateLimitFlow.supply":
+		panic(fmt.Errorf("field supply of message aura.v1.RateLimitFlow is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.RateLimitFlow"))
+		}
+		panic(fmt.Errorf("message aura.v1.RateLimitFlow does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RateLimitFlow) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.RateLimitFlow.channel":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.RateLimitFlow.window_start":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "aura.v1.RateLimitFlow.inflow":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.RateLimitFlow.outflow":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.RateLimitFlow.supply":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.RateLimitFlow"))
+		}
+		panic(fmt.Errorf("message aura.v1.RateLimitFlow does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RateLimitFlow) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.RateLimitFlow", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RateLimitFlow) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RateLimitFlow) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RateLimitFlow) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RateLimitFlow) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RateLimitFlow)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Channel)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.WindowStart != nil {
+			l = options.Size(x.WindowStart)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Inflow)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Outflow)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Supply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RateLimitFlow)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Supply) > 0 {
+			i -= len(x.Supply)
+			copy(dAtA[i:], x.Supply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Supply)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Outflow) > 0 {
+			i -= len(x.Outflow)
+			copy(dAtA[i:], x.Outflow)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Outflow)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Inflow) > 0 {
+			i -= len(x.Inflow)
+			copy(dAtA[i:], x.Inflow)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Inflow)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.WindowStart != nil {
+			encoded, err := options.Marshal(x.WindowStart)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Channel) > 0 {
+			i -= len(x.Channel)
+			copy(dAtA[i:], x.Channel)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Channel)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RateLimitFlow)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RateLimitFlow: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RateLimitFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Channel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.WindowStart == nil {
+					x.WindowStart = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WindowStart); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Inflow = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Outflow = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Supply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PriceRange             protoreflect.MessageDescriptor
+	fd_PriceRange_index       protoreflect.FieldDescriptor
+	fd_PriceRange_start       protoreflect.FieldDescriptor
+	fd_PriceRange_end         protoreflect.FieldDescriptor
+	fd_PriceRange_daily_rate  protoreflect.FieldDescriptor
+	fd_PriceRange_start_price protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_PriceRange = File_aura_v1_genesis_proto.Messages().ByName("PriceRange")
+	fd_PriceRange_index = md_PriceRange.Fields().ByName("index")
+	fd_PriceRange_start = md_PriceRange.Fields().ByName("start")
+	fd_PriceRange_end = md_PriceRange.Fields().ByName("end")
+	fd_PriceRange_daily_rate = md_PriceRange.Fields().ByName("daily_rate")
+	fd_PriceRange_start_price = md_PriceRange.Fields().ByName("start_price")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceRange)(nil)
+
+type fastReflection_PriceRange PriceRange
+
+func (x *PriceRange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceRange)(x)
+}
+
+func (x *PriceRange) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceRange_messageType fastReflection_PriceRange_messageType
+var _ protoreflect.MessageType = fastReflection_PriceRange_messageType{}
+
+type fastReflection_PriceRange_messageType struct{}
+
+func (x fastReflection_PriceRange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceRange)(nil)
+}
+func (x fastReflection_PriceRange_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceRange)
+}
+func (x fastReflection_PriceRange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceRange
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceRange) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceRange
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceRange) Type() protoreflect.MessageType {
+	return _fastReflection_PriceRange_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceRange) New() protoreflect.Message {
+	return new(fastReflection_PriceRange)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceRange) Interface() protoreflect.ProtoMessage {
+	return (*PriceRange)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceRange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_PriceRange_index, value) {
+			return
+		}
+	}
+	if x.Start != nil {
+		value := protoreflect.ValueOfMessage(x.Start.ProtoReflect())
+		if !f(fd_PriceRange_start, value) {
+			return
+		}
+	}
+	if x.End != nil {
+		value := protoreflect.ValueOfMessage(x.End.ProtoReflect())
+		if !f(fd_PriceRange_end, value) {
+			return
+		}
+	}
+	if x.DailyRate != "" {
+		value := protoreflect.ValueOfString(x.DailyRate)
+		if !f(fd_PriceRange_daily_rate, value) {
+			return
+		}
+	}
+	if x.StartPrice != "" {
+		value := protoreflect.ValueOfString(x.StartPrice)
+		if !f(fd_PriceRange_start_price, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceRange) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.PriceRange.index":
+		return x.Index != uint64(0)
+	case "aura.v1.PriceRange.start":
+		return x.Start != nil
+	case "aura.v1.PriceRange.end":
+		return x.End != nil
+	case "aura.v1.PriceRange.daily_rate":
+		return x.DailyRate != ""
+	case "aura.v1.PriceRange.start_price":
+		return x.StartPrice != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PriceRange"))
+		}
+		panic(fmt.Errorf("message aura.v1.PriceRange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceRange) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.PriceRange.index":
+		x.Index = uint64(0)
+	case "aura.v1.PriceRange.start":
+		x.Start = nil
+	case "aura.v1.PriceRange.end":
+		x.End = nil
+	case "aura.v1.PriceRange.daily_rate":
+		x.DailyRate = ""
+	case "aura.v1.PriceRange.start_price":
+		x.StartPrice = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PriceRange"))
+		}
+		panic(fmt.Errorf("message aura.v1.PriceRange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceRange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.PriceRange.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	case "aura.v1.PriceRange.start":
+		value := x.Start
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "aura.v1.PriceRange.end":
+		value := x.End
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "aura.v1.PriceRange.daily_rate":
+		value := x.DailyRate
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.PriceRange.start_price":
+		value := x.StartPrice
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PriceRange"))
+		}
+		panic(fmt.Errorf("message aura.v1.PriceRange does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceRange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.PriceRange.index":
+		x.Index = value.Uint()
+	case "aura.v1.PriceRange.start":
+		x.Start = value.Message().Interface().(*timestamppb.Timestamp)
+	case "aura.v1.PriceRange.end":
+		x.End = value.Message().Interface().(*timestamppb.Timestamp)
+	case "aura.v1.PriceRange.daily_rate":
+		x.DailyRate = value.Interface().(string)
+	case "aura.v1.PriceRange.start_price":
+		x.StartPrice = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PriceRange"))
+		}
+		panic(fmt.Errorf("message aura.v1.PriceRange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceRange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.PriceRange.start":
+		if x.Start == nil {
+			x.Start = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Start.ProtoReflect())
+	case "aura.v1.PriceRange.end":
+		if x.End == nil {
+			x.End = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.End.ProtoReflect())
+	case "aura.v1.PriceRange.index":
+		panic(fmt.Errorf("field index of message aura.v1.PriceRange is not mutable"))
+	case "aura.v1.PriceRange.daily_rate":
+		panic(fmt.Errorf("field daily_rate of message aura.v1.PriceRange is not mutable"))
+	case "aura.v1.PriceRange.start_price":
+		panic(fmt.Errorf("field start_price of message aura.v1.PriceRange is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PriceRange"))
+		}
+		panic(fmt.Errorf("message aura.v1.PriceRange does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceRange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.PriceRange.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "aura.v1.PriceRange.start":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "aura.v1.PriceRange.end":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "aura.v1.PriceRange.daily_rate":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.PriceRange.start_price":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PriceRange"))
+		}
+		panic(fmt.Errorf("message aura.v1.PriceRange does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceRange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.PriceRange", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceRange) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceRange) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceRange) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceRange) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceRange)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		if x.Start != nil {
+			l = options.Size(x.Start)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.End != nil {
+			l = options.Size(x.End)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DailyRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StartPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceRange)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.StartPrice) > 0 {
+			i -= len(x.StartPrice)
+			copy(dAtA[i:], x.StartPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StartPrice)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.DailyRate) > 0 {
+			i -= len(x.DailyRate)
+			copy(dAtA[i:], x.DailyRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DailyRate)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.End != nil {
+			encoded, err := options.Marshal(x.End)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Start != nil {
+			encoded, err := options.Marshal(x.Start)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceRange)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceRange: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Start == nil {
+					x.Start = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Start); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.End == nil {
+					x.End = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.End); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DailyRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DailyRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StartPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PauseWindow              protoreflect.MessageDescriptor
+	fd_PauseWindow_denom        protoreflect.FieldDescriptor
+	fd_PauseWindow_scope        protoreflect.FieldDescriptor
+	fd_PauseWindow_start_height protoreflect.FieldDescriptor
+	fd_PauseWindow_start_time   protoreflect.FieldDescriptor
+	fd_PauseWindow_end_height   protoreflect.FieldDescriptor
+	fd_PauseWindow_end_time     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_PauseWindow = File_aura_v1_genesis_proto.Messages().ByName("PauseWindow")
+	fd_PauseWindow_denom = md_PauseWindow.Fields().ByName("denom")
+	fd_PauseWindow_scope = md_PauseWindow.Fields().ByName("scope")
+	fd_PauseWindow_start_height = md_PauseWindow.Fields().ByName("start_height")
+	fd_PauseWindow_start_time = md_PauseWindow.Fields().ByName("start_time")
+	fd_PauseWindow_end_height = md_PauseWindow.Fields().ByName("end_height")
+	fd_PauseWindow_end_time = md_PauseWindow.Fields().ByName("end_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_PauseWindow)(nil)
+
+type fastReflection_PauseWindow PauseWindow
+
+func (x *PauseWindow) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PauseWindow)(x)
+}
+
+func (x *PauseWindow) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PauseWindow_messageType fastReflection_PauseWindow_messageType
+var _ protoreflect.MessageType = fastReflection_PauseWindow_messageType{}
+
+type fastReflection_PauseWindow_messageType struct{}
+
+func (x fastReflection_PauseWindow_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PauseWindow)(nil)
+}
+func (x fastReflection_PauseWindow_messageType) New() protoreflect.Message {
+	return new(fastReflection_PauseWindow)
+}
+func (x fastReflection_PauseWindow_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PauseWindow
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PauseWindow) Descriptor() protoreflect.MessageDescriptor {
+	return md_PauseWindow
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PauseWindow) Type() protoreflect.MessageType {
+	return _fastReflection_PauseWindow_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PauseWindow) New() protoreflect.Message {
+	return new(fastReflection_PauseWindow)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PauseWindow) Interface() protoreflect.ProtoMessage {
+	return (*PauseWindow)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PauseWindow) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_PauseWindow_denom, value) {
+			return
+		}
+	}
+	if x.Scope != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Scope))
+		if !f(fd_PauseWindow_scope, value) {
+			return
+		}
+	}
+	if x.StartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartHeight)
+		if !f(fd_PauseWindow_start_height, value) {
+			return
+		}
+	}
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_PauseWindow_start_time, value) {
+			return
+		}
+	}
+	if x.EndHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EndHeight)
+		if !f(fd_PauseWindow_end_height, value) {
+			return
+		}
+	}
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_PauseWindow_end_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PauseWindow) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.PauseWindow.denom":
+		return x.Denom != ""
+	case "aura.v1.PauseWindow.scope":
+		return x.Scope != 0
+	case "aura.v1.PauseWindow.start_height":
+		return x.StartHeight != int64(0)
+	case "aura.v1.PauseWindow.start_time":
+		return x.StartTime != nil
+	case "aura.v1.PauseWindow.end_height":
+		return x.EndHeight != int64(0)
+	case "aura.v1.PauseWindow.end_time":
+		return x.EndTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PauseWindow"))
+		}
+		panic(fmt.Errorf("message aura.v1.PauseWindow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PauseWindow) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.PauseWindow.denom":
+		x.Denom = ""
+	case "aura.v1.PauseWindow.scope":
+		x.Scope = 0
+	case "aura.v1.PauseWindow.start_height":
+		x.StartHeight = int64(0)
+	case "aura.v1.PauseWindow.start_time":
+		x.StartTime = nil
+	case "aura.v1.PauseWindow.end_height":
+		x.EndHeight = int64(0)
+	case "aura.v1.PauseWindow.end_time":
+		x.EndTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PauseWindow"))
+		}
+		panic(fmt.Errorf("message aura.v1.PauseWindow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PauseWindow) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.PauseWindow.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.PauseWindow.scope":
+		value := x.Scope
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "aura.v1.PauseWindow.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfInt64(value)
+	case "aura.v1.PauseWindow.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "aura.v1.PauseWindow.end_height":
+		value := x.EndHeight
+		return protoreflect.ValueOfInt64(value)
+	case "aura.v1.PauseWindow.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PauseWindow"))
+		}
+		panic(fmt.Errorf("message aura.v1.PauseWindow does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PauseWindow) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.PauseWindow.denom":
+		x.Denom = value.Interface().(string)
+	case "aura.v1.PauseWindow.scope":
+		x.Scope = (PauseScope)(value.Enum())
+	case "aura.v1.PauseWindow.start_height":
+		x.StartHeight = value.Int()
+	case "aura.v1.PauseWindow.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "aura.v1.PauseWindow.end_height":
+		x.EndHeight = value.Int()
+	case "aura.v1.PauseWindow.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PauseWindow"))
+		}
+		panic(fmt.Errorf("message aura.v1.PauseWindow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PauseWindow) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.PauseWindow.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "aura.v1.PauseWindow.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	case "aura.v1.PauseWindow.denom":
+		panic(fmt.Errorf("field denom of message aura.v1.PauseWindow is not mutable"))
+	case "aura.v1.PauseWindow.scope":
+		panic(fmt.Errorf("field scope of message aura.v1.PauseWindow is not mutable"))
+	case "aura.v1.PauseWindow.start_height":
+		panic(fmt.Errorf("field start_height of message aura.v1.PauseWindow is not mutable"))
+	case "aura.v1.PauseWindow.end_height":
+		panic(fmt.Errorf("field end_height of message aura.v1.PauseWindow is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PauseWindow"))
+		}
+		panic(fmt.Errorf("message aura.v1.PauseWindow does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PauseWindow) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.PauseWindow.denom":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.PauseWindow.scope":
+		return protoreflect.ValueOfEnum(0)
+	case "aura.v1.PauseWindow.start_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "aura.v1.PauseWindow.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "aura.v1.PauseWindow.end_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "aura.v1.PauseWindow.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.PauseWindow"))
+		}
+		panic(fmt.Errorf("message aura.v1.PauseWindow does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PauseWindow) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.PauseWindow", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PauseWindow) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PauseWindow) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PauseWindow) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PauseWindow) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PauseWindow)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Scope != 0 {
+			n += 1 + runtime.Sov(uint64(x.Scope))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndHeight))
+		}
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PauseWindow)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.EndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Scope != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Scope))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PauseWindow)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PauseWindow: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PauseWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				}
+				x.Scope = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Scope |= PauseScope(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+				}
+				x.EndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Stablecoin          protoreflect.MessageDescriptor
+	fd_Stablecoin_denom    protoreflect.FieldDescriptor
+	fd_Stablecoin_exponent protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_Stablecoin = File_aura_v1_genesis_proto.Messages().ByName("Stablecoin")
+	fd_Stablecoin_denom = md_Stablecoin.Fields().ByName("denom")
+	fd_Stablecoin_exponent = md_Stablecoin.Fields().ByName("exponent")
+}
+
+var _ protoreflect.Message = (*fastReflection_Stablecoin)(nil)
+
+type fastReflection_Stablecoin Stablecoin
+
+func (x *Stablecoin) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Stablecoin)(x)
+}
+
+func (x *Stablecoin) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Stablecoin_messageType fastReflection_Stablecoin_messageType
+var _ protoreflect.MessageType = fastReflection_Stablecoin_messageType{}
+
+type fastReflection_Stablecoin_messageType struct{}
+
+func (x fastReflection_Stablecoin_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Stablecoin)(nil)
+}
+func (x fastReflection_Stablecoin_messageType) New() protoreflect.Message {
+	return new(fastReflection_Stablecoin)
+}
+func (x fastReflection_Stablecoin_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Stablecoin
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Stablecoin) Descriptor() protoreflect.MessageDescriptor {
+	return md_Stablecoin
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Stablecoin) Type() protoreflect.MessageType {
+	return _fastReflection_Stablecoin_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Stablecoin) New() protoreflect.Message {
+	return new(fastReflection_Stablecoin)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Stablecoin) Interface() protoreflect.ProtoMessage {
+	return (*Stablecoin)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Stablecoin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_Stablecoin_denom, value) {
+			return
+		}
+	}
+	if x.Exponent != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Exponent)
+		if !f(fd_Stablecoin_exponent, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Stablecoin) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.Stablecoin.denom":
+		return x.Denom != ""
+	case "aura.v1.Stablecoin.exponent":
+		return x.Exponent != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Stablecoin"))
+		}
+		panic(fmt.Errorf("message aura.v1.Stablecoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Stablecoin) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.Stablecoin.denom":
+		x.Denom = ""
+	case "aura.v1.Stablecoin.exponent":
+		x.Exponent = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Stablecoin"))
+		}
+		panic(fmt.Errorf("message aura.v1.Stablecoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Stablecoin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.Stablecoin.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.Stablecoin.exponent":
+		value := x.Exponent
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Stablecoin"))
+		}
+		panic(fmt.Errorf("message aura.v1.Stablecoin does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Stablecoin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.Stablecoin.denom":
+		x.Denom = value.Interface().(string)
+	case "aura.v1.Stablecoin.exponent":
+		x.Exponent = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Stablecoin"))
+		}
+		panic(fmt.Errorf("message aura.v1.Stablecoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Stablecoin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.Stablecoin.denom":
+		panic(fmt.Errorf("field denom of message aura.v1.Stablecoin is not mutable"))
+	case "aura.v1.Stablecoin.exponent":
+		panic(fmt.Errorf("field exponent of message aura.v1.Stablecoin is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Stablecoin"))
+		}
+		panic(fmt.Errorf("message aura.v1.Stablecoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Stablecoin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.Stablecoin.denom":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.Stablecoin.exponent":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Stablecoin"))
+		}
+		panic(fmt.Errorf("message aura.v1.Stablecoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Stablecoin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.Stablecoin", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Stablecoin) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Stablecoin) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Stablecoin) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Stablecoin) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Stablecoin)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Exponent != 0 {
+			n += 1 + runtime.Sov(uint64(x.Exponent))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Stablecoin)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Exponent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Stablecoin)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Stablecoin: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Stablecoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				}
+				x.Exponent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Exponent |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Subscription            protoreflect.MessageDescriptor
+	fd_Subscription_id         protoreflect.FieldDescriptor
+	fd_Subscription_user       protoreflect.FieldDescriptor
+	fd_Subscription_deposit    protoreflect.FieldDescriptor
+	fd_Subscription_status     protoreflect.FieldDescriptor
+	fd_Subscription_price      protoreflect.FieldDescriptor
+	fd_Subscription_minted     protoreflect.FieldDescriptor
+	fd_Subscription_created_at protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_Subscription = File_aura_v1_genesis_proto.Messages().ByName("Subscription")
+	fd_Subscription_id = md_Subscription.Fields().ByName("id")
+	fd_Subscription_user = md_Subscription.Fields().ByName("user")
+	fd_Subscription_deposit = md_Subscription.Fields().ByName("deposit")
+	fd_Subscription_status = md_Subscription.Fields().ByName("status")
+	fd_Subscription_price = md_Subscription.Fields().ByName("price")
+	fd_Subscription_minted = md_Subscription.Fields().ByName("minted")
+	fd_Subscription_created_at = md_Subscription.Fields().ByName("created_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_Subscription)(nil)
+
+type fastReflection_Subscription Subscription
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Subscription)(x)
+}
+
+func (x *Subscription) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Subscription_messageType fastReflection_Subscription_messageType
+var _ protoreflect.MessageType = fastReflection_Subscription_messageType{}
+
+type fastReflection_Subscription_messageType struct{}
+
+func (x fastReflection_Subscription_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Subscription)(nil)
+}
+func (x fastReflection_Subscription_messageType) New() protoreflect.Message {
+	return new(fastReflection_Subscription)
+}
+func (x fastReflection_Subscription_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Subscription
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Subscription) Descriptor() protoreflect.MessageDescriptor {
+	return md_Subscription
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Subscription) Type() protoreflect.MessageType {
+	return _fastReflection_Subscription_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Subscription) New() protoreflect.Message {
+	return new(fastReflection_Subscription)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Subscription) Interface() protoreflect.ProtoMessage {
+	return (*Subscription)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Subscription) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Subscription_id, value) {
+			return
+		}
+	}
+	if x.User != "" {
+		value := protoreflect.ValueOfString(x.User)
+		if !f(fd_Subscription_user, value) {
+			return
+		}
+	}
+	if x.Deposit != nil {
+		value := protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+		if !f(fd_Subscription_deposit, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Subscription_status, value) {
+			return
+		}
+	}
+	if x.Price != "" {
+		value := protoreflect.ValueOfString(x.Price)
+		if !f(fd_Subscription_price, value) {
+			return
+		}
+	}
+	if x.Minted != "" {
+		value := protoreflect.ValueOfString(x.Minted)
+		if !f(fd_Subscription_minted, value) {
+			return
+		}
+	}
+	if x.CreatedAt != nil {
+		value := protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+		if !f(fd_Subscription_created_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Subscription) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.Subscription.id":
+		return x.Id != uint64(0)
+	case "aura.v1.Subscription.user":
+		return x.User != ""
+	case "aura.v1.Subscription.deposit":
+		return x.Deposit != nil
+	case "aura.v1.Subscription.status":
+		return x.Status != 0
+	case "aura.v1.Subscription.price":
+		return x.Price != ""
+	case "aura.v1.Subscription.minted":
+		return x.Minted != ""
+	case "aura.v1.Subscription.created_at":
+		return x.CreatedAt != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message aura.v1.Subscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Subscription) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.Subscription.id":
+		x.Id = uint64(0)
+	case "aura.v1.Subscription.user":
+		x.User = ""
+	case "aura.v1.Subscription.deposit":
+		x.Deposit = nil
+	case "aura.v1.Subscription.status":
+		x.Status = 0
+	case "aura.v1.Subscription.price":
+		x.Price = ""
+	case "aura.v1.Subscription.minted":
+		x.Minted = ""
+	case "aura.v1.Subscription.created_at":
+		x.CreatedAt = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message aura.v1.Subscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Subscription) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.Subscription.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "aura.v1.Subscription.user":
+		value := x.User
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.Subscription.deposit":
+		value := x.Deposit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "aura.v1.Subscription.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "aura.v1.Subscription.price":
+		value := x.Price
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.Subscription.minted":
+		value := x.Minted
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.Subscription.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message aura.v1.Subscription does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Subscription) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.Subscription.id":
+		x.Id = value.Uint()
+	case "aura.v1.Subscription.user":
+		x.User = value.Interface().(string)
+	case "aura.v1.Subscription.deposit":
+		x.Deposit = value.Message().Interface().(*v1beta11.Coin)
+	case "aura.v1.Subscription.status":
+		x.Status = (RequestStatus)(value.Enum())
+	case "aura.v1.Subscription.price":
+		x.Price = value.Interface().(string)
+	case "aura.v1.Subscription.minted":
+		x.Minted = value.Interface().(string)
+	case "aura.v1.Subscription.created_at":
+		x.CreatedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message aura.v1.Subscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Subscription) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.Subscription.deposit":
+		if x.Deposit == nil {
+			x.Deposit = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+	case "aura.v1.Subscription.created_at":
+		if x.CreatedAt == nil {
+			x.CreatedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+	case "aura.v1.Subscription.id":
+		panic(fmt.Errorf("field id of message aura.v1.Subscription is not mutable"))
+	case "aura.v1.Subscription.user":
+		panic(fmt.Errorf("field user of message aura.v1.Subscription is not mutable"))
+	case "aura.v1.Subscription.status":
+		panic(fmt.Errorf("field status of message aura.v1.Subscription is not mutable"))
+	case "aura.v1.Subscription.price":
+		panic(fmt.Errorf("field price of message aura.v1.Subscription is not mutable"))
+	case "aura.v1.Subscription.minted":
+		panic(fmt.Errorf("field minted of message aura.v1.Subscription is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message aura.v1.Subscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Subscription) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.Subscription.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "aura.v1.Subscription.user":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.Subscription.deposit":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "aura.v1.Subscription.status":
+		return protoreflect.ValueOfEnum(0)
+	case "aura.v1.Subscription.price":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.Subscription.minted":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.Subscription.created_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message aura.v1.Subscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Subscription) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.Subscription", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Subscription) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Subscription) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Subscription) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Subscription) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Subscription)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.User)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Deposit != nil {
+			l = options.Size(x.Deposit)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Price)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Minted)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreatedAt != nil {
+			l = options.Size(x.CreatedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Subscription)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CreatedAt != nil {
+			encoded, err := options.Marshal(x.CreatedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Minted) > 0 {
+			i -= len(x.Minted)
+			copy(dAtA[i:], x.Minted)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Minted)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Price) > 0 {
+			i -= len(x.Price)
+			copy(dAtA[i:], x.Price)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Price)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Deposit != nil {
+			encoded, err := options.Marshal(x.Deposit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.User) > 0 {
+			i -= len(x.User)
+			copy(dAtA[i:], x.User)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.User)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Subscription)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Subscription: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.User = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Deposit == nil {
+					x.Deposit = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= RequestStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Price = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Minted = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CreatedAt == nil {
+					x.CreatedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreatedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Redemption            protoreflect.MessageDescriptor
+	fd_Redemption_id         protoreflect.FieldDescriptor
+	fd_Redemption_user       protoreflect.FieldDescriptor
+	fd_Redemption_amount     protoreflect.FieldDescriptor
+	fd_Redemption_status     protoreflect.FieldDescriptor
+	fd_Redemption_payout     protoreflect.FieldDescriptor
+	fd_Redemption_created_at protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_Redemption = File_aura_v1_genesis_proto.Messages().ByName("Redemption")
+	fd_Redemption_id = md_Redemption.Fields().ByName("id")
+	fd_Redemption_user = md_Redemption.Fields().ByName("user")
+	fd_Redemption_amount = md_Redemption.Fields().ByName("amount")
+	fd_Redemption_status = md_Redemption.Fields().ByName("status")
+	fd_Redemption_payout = md_Redemption.Fields().ByName("payout")
+	fd_Redemption_created_at = md_Redemption.Fields().ByName("created_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_Redemption)(nil)
+
+type fastReflection_Redemption Redemption
+
+func (x *Redemption) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Redemption)(x)
+}
+
+func (x *Redemption) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Redemption_messageType fastReflection_Redemption_messageType
+var _ protoreflect.MessageType = fastReflection_Redemption_messageType{}
+
+type fastReflection_Redemption_messageType struct{}
+
+func (x fastReflection_Redemption_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Redemption)(nil)
+}
+func (x fastReflection_Redemption_messageType) New() protoreflect.Message {
+	return new(fastReflection_Redemption)
+}
+func (x fastReflection_Redemption_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Redemption
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Redemption) Descriptor() protoreflect.MessageDescriptor {
+	return md_Redemption
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Redemption) Type() protoreflect.MessageType {
+	return _fastReflection_Redemption_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Redemption) New() protoreflect.Message {
+	return new(fastReflection_Redemption)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Redemption) Interface() protoreflect.ProtoMessage {
+	return (*Redemption)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Redemption) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Redemption_id, value) {
+			return
+		}
+	}
+	if x.User != "" {
+		value := protoreflect.ValueOfString(x.User)
+		if !f(fd_Redemption_user, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_Redemption_amount, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Redemption_status, value) {
+			return
+		}
+	}
+	if x.Payout != nil {
+		value := protoreflect.ValueOfMessage(x.Payout.ProtoReflect())
+		if !f(fd_Redemption_payout, value) {
+			return
+		}
+	}
+	if x.CreatedAt != nil {
+		value := protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+		if !f(fd_Redemption_created_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Redemption) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.Redemption.id":
+		return x.Id != uint64(0)
+	case "aura.v1.Redemption.user":
+		return x.User != ""
+	case "aura.v1.Redemption.amount":
+		return x.Amount != ""
+	case "aura.v1.Redemption.status":
+		return x.Status != 0
+	case "aura.v1.Redemption.payout":
+		return x.Payout != nil
+	case "aura.v1.Redemption.created_at":
+		return x.CreatedAt != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Redemption"))
+		}
+		panic(fmt.Errorf("message aura.v1.Redemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Redemption) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.Redemption.id":
+		x.Id = uint64(0)
+	case "aura.v1.Redemption.user":
+		x.User = ""
+	case "aura.v1.Redemption.amount":
+		x.Amount = ""
+	case "aura.v1.Redemption.status":
+		x.Status = 0
+	case "aura.v1.Redemption.payout":
+		x.Payout = nil
+	case "aura.v1.Redemption.created_at":
+		x.CreatedAt = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Redemption"))
+		}
+		panic(fmt.Errorf("message aura.v1.Redemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Redemption) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.Redemption.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "aura.v1.Redemption.user":
+		value := x.User
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.Redemption.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.Redemption.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "aura.v1.Redemption.payout":
+		value := x.Payout
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "aura.v1.Redemption.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Redemption"))
+		}
+		panic(fmt.Errorf("message aura.v1.Redemption does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Redemption) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.Redemption.id":
+		x.Id = value.Uint()
+	case "aura.v1.Redemption.user":
+		x.User = value.Interface().(string)
+	case "aura.v1.Redemption.amount":
+		x.Amount = value.Interface().(string)
+	case "aura.v1.Redemption.status":
+		x.Status = (RequestStatus)(value.Enum())
+	case "aura.v1.Redemption.payout":
+		x.Payout = value.Message().Interface().(*v1beta11.Coin)
+	case "aura.v1.Redemption.created_at":
+		x.CreatedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Redemption"))
+		}
+		panic(fmt.Errorf("message aura.v1.Redemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Redemption) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.Redemption.payout":
+		if x.Payout == nil {
+			x.Payout = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Payout.ProtoReflect())
+	case "aura.v1.Redemption.created_at":
+		if x.CreatedAt == nil {
+			x.CreatedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+	case "aura.v1.Redemption.id":
+		panic(fmt.Errorf("field id of message aura.v1.Redemption is not mutable"))
+	case "aura.v1.Redemption.user":
+		panic(fmt.Errorf("field user of message aura.v1.Redemption is not mutable"))
+	case "aura.v1.Redemption.amount":
+		panic(fmt.Errorf("field amount of message aura.v1.Redemption is not mutable"))
+	case "aura.v1.Redemption.status":
+		panic(fmt.Errorf("field status of message aura.v1.Redemption is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Redemption"))
+		}
+		panic(fmt.Errorf("message aura.v1.Redemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Redemption) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.Redemption.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "aura.v1.Redemption.user":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.Redemption.amount":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.Redemption.status":
+		return protoreflect.ValueOfEnum(0)
+	case "aura.v1.Redemption.payout":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "aura.v1.Redemption.created_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Redemption"))
+		}
+		panic(fmt.Errorf("message aura.v1.Redemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Redemption) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.Redemption", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Redemption) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Redemption) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Redemption) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Redemption) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Redemption)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.User)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.Payout != nil {
+			l = options.Size(x.Payout)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreatedAt != nil {
+			l = options.Size(x.CreatedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Redemption)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CreatedAt != nil {
+			encoded, err := options.Marshal(x.CreatedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Payout != nil {
+			encoded, err := options.Marshal(x.Payout)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.User) > 0 {
+			i -= len(x.User)
+			copy(dAtA[i:], x.User)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.User)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Redemption)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Redemption: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Redemption: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.User = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= RequestStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Payout == nil {
+					x.Payout = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Payout); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CreatedAt == nil {
+					x.CreatedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreatedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SwapConfig             protoreflect.MessageDescriptor
+	fd_SwapConfig_fee         protoreflect.FieldDescriptor
+	fd_SwapConfig_daily_limit protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_SwapConfig = File_aura_v1_genesis_proto.Messages().ByName("SwapConfig")
+	fd_SwapConfig_fee = md_SwapConfig.Fields().ByName("fee")
+	fd_SwapConfig_daily_limit = md_SwapConfig.Fields().ByName("daily_limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_SwapConfig)(nil)
+
+type fastReflection_SwapConfig SwapConfig
+
+func (x *SwapConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SwapConfig)(x)
+}
+
+func (x *SwapConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SwapConfig_messageType fastReflection_SwapConfig_messageType
+var _ protoreflect.MessageType = fastReflection_SwapConfig_messageType{}
+
+type fastReflection_SwapConfig_messageType struct{}
+
+func (x fastReflection_SwapConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SwapConfig)(nil)
+}
+func (x fastReflection_SwapConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_SwapConfig)
+}
+func (x fastReflection_SwapConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SwapConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SwapConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_SwapConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SwapConfig) Type() protoreflect.MessageType {
+	return _fastReflection_SwapConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SwapConfig) New() protoreflect.Message {
+	return new(fastReflection_SwapConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SwapConfig) Interface() protoreflect.ProtoMessage {
+	return (*SwapConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SwapConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Fee != "" {
+		value := protoreflect.ValueOfString(x.Fee)
+		if !f(fd_SwapConfig_fee, value) {
+			return
+		}
+	}
+	if x.DailyLimit != "" {
+		value := protoreflect.ValueOfString(x.DailyLimit)
+		if !f(fd_SwapConfig_daily_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SwapConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.SwapConfig.fee":
+		return x.Fee != ""
+	case "aura.v1.SwapConfig.daily_limit":
+		return x.DailyLimit != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.SwapConfig"))
+		}
+		panic(fmt.Errorf("message aura.v1.SwapConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SwapConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.SwapConfig.fee":
+		x.Fee = ""
+	case "aura.v1.SwapConfig.daily_limit":
+		x.DailyLimit = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.SwapConfig"))
+		}
+		panic(fmt.Errorf("message aura.v1.SwapConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SwapConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.SwapConfig.fee":
+		value := x.Fee
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.SwapConfig.daily_limit":
+		value := x.DailyLimit
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.SwapConfig"))
+		}
+		panic(fmt.Errorf("message aura.v1.SwapConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SwapConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.SwapConfig.fee":
+		x.Fee = value.Interface().(string)
+	case "aura.v1.SwapConfig.daily_limit":
+		x.DailyLimit = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.SwapConfig"))
+		}
+		panic(fmt.Errorf("message aura.v1.SwapConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SwapConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.SwapConfig.fee":
+		panic(fmt.Errorf("field fee of message aura.v1.SwapConfig is not mutable"))
+	case "aura.v1.SwapConfig.daily_limit":
+		panic(fmt.Errorf("field daily_limit of message aura.v1.SwapConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.SwapConfig"))
+		}
+		panic(fmt.Errorf("message aura.v1.SwapConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SwapConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.SwapConfig.fee":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.SwapConfig.daily_limit":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.SwapConfig"))
+		}
+		panic(fmt.Errorf("message aura.v1.SwapConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SwapConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.SwapConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SwapConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SwapConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SwapConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SwapConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SwapConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Fee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DailyLimit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SwapConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DailyLimit) > 0 {
+			i -= len(x.DailyLimit)
+			copy(dAtA[i:], x.DailyLimit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DailyLimit)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Fee) > 0 {
+			i -= len(x.Fee)
+			copy(dAtA[i:], x.Fee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Fee)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SwapConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SwapConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SwapConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DailyLimit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DailyLimit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SwapVolume         protoreflect.MessageDescriptor
+	fd_SwapVolume_address protoreflect.FieldDescriptor
+	fd_SwapVolume_day     protoreflect.FieldDescriptor
+	fd_SwapVolume_volume  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_SwapVolume = File_aura_v1_genesis_proto.Messages().ByName("SwapVolume")
+	fd_SwapVolume_address = md_SwapVolume.Fields().ByName("address")
+	fd_SwapVolume_day = md_SwapVolume.Fields().ByName("day")
+	fd_SwapVolume_volume = md_SwapVolume.Fields().ByName("volume")
+}
+
+var _ protoreflect.Message = (*fastReflection_SwapVolume)(nil)
+
+type fastReflection_SwapVolume SwapVolume
+
+func (x *SwapVolume) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SwapVolume)(x)
+}
+
+func (x *SwapVolume) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SwapVolume_messageType fastReflection_SwapVolume_messageType
+var _ protoreflect.MessageType = fastReflection_SwapVolume_messageType{}
+
+type fastReflection_SwapVolume_messageType struct{}
+
+func (x fastReflection_SwapVolume_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SwapVolume)(nil)
+}
+func (x fastReflection_SwapVolume_messageType) New() protoreflect.Message {
+	return new(fastReflection_SwapVolume)
+}
+func (x fastReflection_SwapVolume_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SwapVolume
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SwapVolume) Descriptor() protoreflect.MessageDescriptor {
+	return md_SwapVolume
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SwapVolume) Type() protoreflect.MessageType {
+	return _fastReflection_SwapVolume_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SwapVolume) New() protoreflect.Message {
+	return new(fastReflection_SwapVolume)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SwapVolume) Interface() protoreflect.ProtoMessage {
+	return (*SwapVolume)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SwapVolume) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_SwapVolume_address, value) {
+			return
+		}
+	}
+	if x.Day != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Day)
+		if !f(fd_SwapVolume_day, value) {
+			return
+		}
+	}
+	if x.Volume != "" {
+		value := protoreflect.ValueOfString(x.Volume)
+		if !f(fd_SwapVolume_volume, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SwapVolume) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.SwapVolume.address":
+		return x.Address != ""
+	case "aura.v1.SwapVolume.day":
+		return x.Day != uint64(0)
+	case "aura.v1.SwapVolume.volume":
+		return x.Volume != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.SwapVolume"))
+		}
+		panic(fmt.Errorf("message aura.v1.SwapVolume does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SwapVolume) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.SwapVolume.address":
+		x.Address = ""
+	case "aura.v1.SwapVolume.day":
+		x.Day = uint64(0)
+	case "aura.v1.SwapVolume.volume":
+		x.Volume = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.SwapVolume"))
+		}
+		panic(fmt.Errorf("message aura.v1.SwapVolume does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SwapVolume) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.SwapVolume.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.SwapVolume.day":
+		value := x.Day
+		return protoreflect.ValueOfUint64(value)
+	case "aura.v1.SwapVolume.volume":
+		value := x.Volume
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.SwapVolume"))
+		}
+		panic(fmt.Errorf("message aura.v1.SwapVolume does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SwapVolume) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.SwapVolume.address":
+		x.Address = value.Interface().(string)
+	case "aura.v1.SwapVolume.day":
+		x.Day = value.Uint()
+	case "aura.v1.SwapVolume.volume":
+		x.Volume = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.SwapVolume"))
+		}
+		panic(fmt.Errorf("message aura.v1.SwapVolume does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SwapVolume) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.SwapVolume.address":
+		panic(fmt.Errorf("field address of message aura.v1.SwapVolume is not mutable"))
+	case "aura.v1.SwapVolume.day":
+		panic(fmt.Errorf("field day of message aura.v1.SwapVolume is not mutable"))
+	case "aura.v1.SwapVolume.volume":
+		panic(fmt.Errorf("field volume of message aura.v1.SwapVolume is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.SwapVolume"))
+		}
+		panic(fmt.Errorf("message aura.v1.SwapVolume does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SwapVolume) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.SwapVolume.address":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.SwapVolume.day":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "aura.v1.SwapVolume.volume":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.SwapVolume"))
+		}
+		panic(fmt.Errorf("message aura.v1.SwapVolume does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SwapVolume) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.SwapVolume", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SwapVolume) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SwapVolume) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SwapVolume) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SwapVolume) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SwapVolume)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Day != 0 {
+			n += 1 + runtime.Sov(uint64(x.Day))
+		}
+		l = len(x.Volume)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SwapVolume)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Volume) > 0 {
+			i -= len(x.Volume)
+			copy(dAtA[i:], x.Volume)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Volume)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Day != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Day))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SwapVolume)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SwapVolume: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SwapVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+				}
+				x.Day = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Day |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Volume = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_LockedLot             protoreflect.MessageDescriptor
+	fd_LockedLot_address     protoreflect.FieldDescriptor
+	fd_LockedLot_amount      protoreflect.FieldDescriptor
+	fd_LockedLot_unlock_time protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_LockedLot = File_aura_v1_genesis_proto.Messages().ByName("LockedLot")
+	fd_LockedLot_address = md_LockedLot.Fields().ByName("address")
+	fd_LockedLot_amount = md_LockedLot.Fields().ByName("amount")
+	fd_LockedLot_unlock_time = md_LockedLot.Fields().ByName("unlock_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_LockedLot)(nil)
+
+type fastReflection_LockedLot LockedLot
+
+func (x *LockedLot) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LockedLot)(x)
+}
+
+func (x *LockedLot) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LockedLot_messageType fastReflection_LockedLot_messageType
+var _ protoreflect.MessageType = fastReflection_LockedLot_messageType{}
+
+type fastReflection_LockedLot_messageType struct{}
+
+func (x fastReflection_LockedLot_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LockedLot)(nil)
+}
+func (x fastReflection_LockedLot_messageType) New() protoreflect.Message {
+	return new(fastReflection_LockedLot)
+}
+func (x fastReflection_LockedLot_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockedLot
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LockedLot) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockedLot
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LockedLot) Type() protoreflect.MessageType {
+	return _fastReflection_LockedLot_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LockedLot) New() protoreflect.Message {
+	return new(fastReflection_LockedLot)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LockedLot) Interface() protoreflect.ProtoMessage {
+	return (*LockedLot)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LockedLot) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_LockedLot_address, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_LockedLot_amount, value) {
+			return
+		}
+	}
+	if x.UnlockTime != nil {
+		value := protoreflect.ValueOfMessage(x.UnlockTime.ProtoReflect())
+		if !f(fd_LockedLot_unlock_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LockedLot) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.LockedLot.address":
+		return x.Address != ""
+	case "aura.v1.LockedLot.amount":
+		return x.Amount != ""
+	case "aura.v1.LockedLot.unlock_time":
+		return x.UnlockTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.LockedLot"))
+		}
+		panic(fmt.Errorf("message aura.v1.LockedLot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockedLot) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.LockedLot.address":
+		x.Address = ""
+	case "aura.v1.LockedLot.amount":
+		x.Amount = ""
+	case "aura.v1.LockedLot.unlock_time":
+		x.UnlockTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.LockedLot"))
+		}
+		panic(fmt.Errorf("message aura.v1.LockedLot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LockedLot) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.LockedLot.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.LockedLot.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.LockedLot.unlock_time":
+		value := x.UnlockTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.LockedLot"))
+		}
+		panic(fmt.Errorf("message aura.v1.LockedLot does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockedLot) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.LockedLot.address":
+		x.Address = value.Interface().(string)
+	case "aura.v1.LockedLot.amount":
+		x.Amount = value.Interface().(string)
+	case "aura.v1.LockedLot.unlock_time":
+		x.UnlockTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.LockedLot"))
+		}
+		panic(fmt.Errorf("message aura.v1.LockedLot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockedLot) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.LockedLot.unlock_time":
+		if x.UnlockTime == nil {
+			x.UnlockTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.UnlockTime.ProtoReflect())
+	case "aura.v1.LockedLot.address":
+		panic(fmt.Errorf("field address of message aura.v1.LockedLot is not mutable"))
+	case "aura.v1.LockedLot.amount":
+		panic(fmt.Errorf("field amount of message aura.v1.LockedLot is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.LockedLot"))
+		}
+		panic(fmt.Errorf("message aura.v1.LockedLot does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LockedLot) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.LockedLot.address":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.LockedLot.amount":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.LockedLot.unlock_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.LockedLot"))
+		}
+		panic(fmt.Errorf("message aura.v1.LockedLot does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LockedLot) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.LockedLot", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LockedLot) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockedLot) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LockedLot) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LockedLot) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LockedLot)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UnlockTime != nil {
+			l = options.Size(x.UnlockTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LockedLot)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.UnlockTime != nil {
+			encoded, err := options.Marshal(x.UnlockTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LockedLot)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockedLot: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockedLot: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UnlockTime == nil {
+					x.UnlockTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnlockTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Attestation             protoreflect.MessageDescriptor
+	fd_Attestation_index       protoreflect.FieldDescriptor
+	fd_Attestation_attestor    protoreflect.FieldDescriptor
+	fd_Attestation_amount      protoreflect.FieldDescriptor
+	fd_Attestation_as_of       protoreflect.FieldDescriptor
+	fd_Attestation_report_hash protoreflect.FieldDescriptor
+	fd_Attestation_posted_at   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_Attestation = File_aura_v1_genesis_proto.Messages().ByName("Attestation")
+	fd_Attestation_index = md_Attestation.Fields().ByName("index")
+	fd_Attestation_attestor = md_Attestation.Fields().ByName("attestor")
+	fd_Attestation_amount = md_Attestation.Fields().ByName("amount")
+	fd_Attestation_as_of = md_Attestation.Fields().ByName("as_of")
+	fd_Attestation_report_hash = md_Attestation.Fields().ByName("report_hash")
+	fd_Attestation_posted_at = md_Attestation.Fields().ByName("posted_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_Attestation)(nil)
+
+type fastReflection_Attestation Attestation
+
+func (x *Attestation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Attestation)(x)
+}
+
+func (x *Attestation) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Attestation_messageType fastReflection_Attestation_messageType
+var _ protoreflect.MessageType = fastReflection_Attestation_messageType{}
+
+type fastReflection_Attestation_messageType struct{}
+
+func (x fastReflection_Attestation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Attestation)(nil)
+}
+func (x fastReflection_Attestation_messageType) New() protoreflect.Message {
+	return new(fastReflection_Attestation)
+}
+func (x fastReflection_Attestation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Attestation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Attestation) Descriptor() protoreflect.MessageDescriptor {
+	return md_Attestation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Attestation) Type() protoreflect.MessageType {
+	return _fastReflection_Attestation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Attestation) New() protoreflect.Message {
+	return new(fastReflection_Attestation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Attestation) Interface() protoreflect.ProtoMessage {
+	return (*Attestation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Attestation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_Attestation_index, value) {
+			return
+		}
+	}
+	if x.Attestor != "" {
+		value := protoreflect.ValueOfString(x.Attestor)
+		if !f(fd_Attestation_attestor, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_Attestation_amount, value) {
+			return
+		}
+	}
+	if x.AsOf != nil {
+		value := protoreflect.ValueOfMessage(x.AsOf.ProtoReflect())
+		if !f(fd_Attestation_as_of, value) {
+			return
+		}
+	}
+	if x.ReportHash != "" {
+		value := protoreflect.ValueOfString(x.ReportHash)
+		if !f(fd_Attestation_report_hash, value) {
+			return
+		}
+	}
+	if x.PostedAt != nil {
+		value := protoreflect.ValueOfMessage(x.PostedAt.ProtoReflect())
+		if !f(fd_Attestation_posted_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Attestation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.Attestation.index":
+		return x.Index != uint64(0)
+	case "aura.v1.Attestation.attestor":
+		return x.Attestor != ""
+	case "aura.v1.Attestation.amount":
+		return x.Amount != ""
+	case "aura.v1.Attestation.as_of":
+		return x.AsOf != nil
+	case "aura.v1.Attestation.report_hash":
+		return x.ReportHash != ""
+	case "aura.v1.Attestation.posted_at":
+		return x.PostedAt != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Attestation"))
+		}
+		panic(fmt.Errorf("message aura.v1.Attestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Attestation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.Attestation.index":
+		x.Index = uint64(0)
+	case "aura.v1.Attestation.attestor":
+		x.Attestor = ""
+	case "aura.v1.Attestation.amount":
+		x.Amount = ""
+	case "aura.v1.Attestation.as_of":
+		x.AsOf = nil
+	case "aura.v1.Attestation.report_hash":
+		x.ReportHash = ""
+	case "aura.v1.Attestation.posted_at":
+		x.PostedAt = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Attestation"))
+		}
+		panic(fmt.Errorf("message aura.v1.Attestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Attestation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.Attestation.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	case "aura.v1.Attestation.attestor":
+		value := x.Attestor
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.Attestation.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.Attestation.as_of":
+		value := x.AsOf
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "aura.v1.Attestation.report_hash":
+		value := x.ReportHash
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.Attestation.posted_at":
+		value := x.PostedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Attestation"))
+		}
+		panic(fmt.Errorf("message aura.v1.Attestation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Attestation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.Attestation.index":
+		x.Index = value.Uint()
+	case "aura.v1.Attestation.attestor":
+		x.Attestor = value.Interface().(string)
+	case "aura.v1.Attestation.amount":
+		x.Amount = value.Interface().(string)
+	case "aura.v1.Attestation.as_of":
+		x.AsOf = value.Message().Interface().(*timestamppb.Timestamp)
+	case "aura.v1.Attestation.report_hash":
+		x.ReportHash = value.Interface().(string)
+	case "aura.v1.Attestation.posted_at":
+		x.PostedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Attestation"))
+		}
+		panic(fmt.Errorf("message aura.v1.Attestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Attestation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.Attestation.as_of":
+		if x.AsOf == nil {
+			x.AsOf = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.AsOf.ProtoReflect())
+	case "aura.v1.Attestation.posted_at":
+		if x.PostedAt == nil {
+			x.PostedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.PostedAt.ProtoReflect())
+	case "aura.v1.Attestation.index":
+		panic(fmt.Errorf("field index of message aura.v1.Attestation is not mutable"))
+	case "aura.v1.Attestation.attestor":
+		panic(fmt.Errorf("field attestor of message aura.v1.Attestation is not mutable"))
+	case "aura.v1.Attestation.amount":
+		panic(fmt.Errorf("field amount of message aura.v1.Attestation is not mutable"))
+	case "aura.v1.Attestation.report_hash":
+		panic(fmt.Errorf("field report_hash of message aura.v1.Attestation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Attestation"))
+		}
+		panic(fmt.Errorf("message aura.v1.Attestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Attestation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.Attestation.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "aura.v1.Attestation.attestor":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.Attestation.amount":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.Attestation.as_of":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "aura.v1.Attestation.report_hash":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.Attestation.posted_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Attestation"))
+		}
+		panic(fmt.Errorf("message aura.v1.Attestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Attestation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.Attestation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Attestation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Attestation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Attestation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Attestation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Attestation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		l = len(x.Attestor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AsOf != nil {
+			l = options.Size(x.AsOf)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReportHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PostedAt != nil {
+			l = options.Size(x.PostedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Attestation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PostedAt != nil {
+			encoded, err := options.Marshal(x.PostedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.ReportHash) > 0 {
+			i -= len(x.ReportHash)
+			copy(dAtA[i:], x.ReportHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReportHash)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.AsOf != nil {
+			encoded, err := options.Marshal(x.AsOf)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Attestor) > 0 {
+			i -= len(x.Attestor)
+			copy(dAtA[i:], x.Attestor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Attestor)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Attestation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Attestation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Attestation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attestor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AsOf", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AsOf == nil {
+					x.AsOf = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AsOf); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReportHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PostedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PostedAt == nil {
+					x.PostedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PostedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ReserveConfig                  protoreflect.MessageDescriptor
+	fd_ReserveConfig_collateral_ratio protoreflect.FieldDescriptor
+	fd_ReserveConfig_max_staleness    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aura_v1_genesis_proto_init()
+	md_ReserveConfig = File_aura_v1_genesis_proto.Messages().ByName("ReserveConfig")
+	fd_ReserveConfig_collateral_ratio = md_ReserveConfig.Fields().ByName("collateral_ratio")
+	fd_ReserveConfig_max_staleness = md_ReserveConfig.Fields().ByName("max_staleness")
+}
+
+var _ protoreflect.Message = (*fastReflection_ReserveConfig)(nil)
+
+type fastReflection_ReserveConfig ReserveConfig
+
+func (x *ReserveConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReserveConfig)(x)
+}
+
+func (x *ReserveConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_aura_v1_genesis_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ReserveConfig_messageType fastReflection_ReserveConfig_messageType
+var _ protoreflect.MessageType = fastReflection_ReserveConfig_messageType{}
+
+type fastReflection_ReserveConfig_messageType struct{}
+
+func (x fastReflection_ReserveConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReserveConfig)(nil)
+}
+func (x fastReflection_ReserveConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReserveConfig)
+}
+func (x fastReflection_ReserveConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReserveConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ReserveConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReserveConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ReserveConfig) Type() protoreflect.MessageType {
+	return _fastReflection_ReserveConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ReserveConfig) New() protoreflect.Message {
+	return new(fastReflection_ReserveConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ReserveConfig) Interface() protoreflect.ProtoMessage {
+	return (*ReserveConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ReserveConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CollateralRatio != "" {
+		value := protoreflect.ValueOfString(x.CollateralRatio)
+		if !f(fd_ReserveConfig_collateral_ratio, value) {
+			return
+		}
+	}
+	if x.MaxStaleness != nil {
+		value := protoreflect.ValueOfMessage(x.MaxStaleness.ProtoReflect())
+		if !f(fd_ReserveConfig_max_staleness, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ReserveConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aura.v1.ReserveConfig.collateral_ratio":
+		return x.CollateralRatio != ""
+	case "aura.v1.ReserveConfig.max_staleness":
+		return x.MaxStaleness != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.ReserveConfig"))
+		}
+		panic(fmt.Errorf("message aura.v1.ReserveConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReserveConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aura.v1.ReserveConfig.collateral_ratio":
+		x.CollateralRatio = ""
+	case "aura.v1.ReserveConfig.max_staleness":
+		x.MaxStaleness = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.ReserveConfig"))
+		}
+		panic(fmt.Errorf("message aura.v1.ReserveConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ReserveConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aura.v1.ReserveConfig.collateral_ratio":
+		value := x.CollateralRatio
+		return protoreflect.ValueOfString(value)
+	case "aura.v1.ReserveConfig.max_staleness":
+		value := x.MaxStaleness
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.ReserveConfig"))
+		}
+		panic(fmt.Errorf("message aura.v1.ReserveConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReserveConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aura.v1.ReserveConfig.collateral_ratio":
+		x.CollateralRatio = value.Interface().(string)
+	case "aura.v1.ReserveConfig.max_staleness":
+		x.MaxStaleness = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.ReserveConfig"))
+		}
+		panic(fmt.Errorf("message aura.v1.ReserveConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReserveConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.ReserveConfig.max_staleness":
+		if x.MaxStaleness == nil {
+			x.MaxStaleness = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxStaleness.ProtoReflect())
+	case "aura.v1.ReserveConfig.collateral_ratio":
+		panic(fmt.Errorf("field collateral_ratio of message aura.v1.ReserveConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.ReserveConfig"))
+		}
+		panic(fmt.Errorf("message aura.v1.ReserveConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ReserveConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aura.v1.ReserveConfig.collateral_ratio":
+		return protoreflect.ValueOfString("")
+	case "aura.v1.ReserveConfig.max_staleness":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.ReserveConfig"))
+		}
+		panic(fmt.Errorf("message aura.v1.ReserveConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ReserveConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aura.v1.ReserveConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ReserveConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReserveConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ReserveConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ReserveConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ReserveConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.CollateralRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxStaleness != nil {
+			l = options.Size(x.MaxStaleness)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ReserveConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxStaleness != nil {
+			encoded, err := options.Marshal(x.MaxStaleness)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.CollateralRatio) > 0 {
+			i -= len(x.CollateralRatio)
+			copy(dAtA[i:], x.CollateralRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CollateralRatio)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ReserveConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.BlocklistState == nil {
-					x.BlocklistState = &v1.GenesisState{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlocklistState); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.Paused = bool(v != 0)
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.Owner = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReserveConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReserveConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollateralRatio", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -988,11 +15707,11 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PendingOwner = string(dAtA[iNdEx:postIndex])
+				x.CollateralRatio = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1019,109 +15738,13 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Burners = append(x.Burners, &Burner{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Burners[len(x.Burners)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if x.MaxStaleness == nil {
+					x.MaxStaleness = &durationpb.Duration{}
 				}
-				x.Minters = append(x.Minters, &Minter{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Minters[len(x.Minters)-1]); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxStaleness); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pausers", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Pausers = append(x.Pausers, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockedChannels", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.BlockedChannels = append(x.BlockedChannels, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1141,1186 +15764,1796 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: aura/v1/genesis.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ChannelDirection defines which IBC transfers are blocked on a channel.
+type ChannelDirection int32
+
+const (
+	// CHANNEL_DIRECTION_UNSPECIFIED is treated as CHANNEL_DIRECTION_OUTBOUND.
+	ChannelDirection_CHANNEL_DIRECTION_UNSPECIFIED ChannelDirection = 0
+	// CHANNEL_DIRECTION_OUTBOUND blocks transfers from Noble to the counterparty.
+	ChannelDirection_CHANNEL_DIRECTION_OUTBOUND ChannelDirection = 1
+	// CHANNEL_DIRECTION_INBOUND blocks transfers from the counterparty to Noble.
+	ChannelDirection_CHANNEL_DIRECTION_INBOUND ChannelDirection = 2
+	// CHANNEL_DIRECTION_BOTH blocks transfers in both directions.
+	ChannelDirection_CHANNEL_DIRECTION_BOTH ChannelDirection = 3
+)
+
+// Enum value maps for ChannelDirection.
+var (
+	ChannelDirection_name = map[int32]string{
+		0: "CHANNEL_DIRECTION_UNSPECIFIED",
+		1: "CHANNEL_DIRECTION_OUTBOUND",
+		2: "CHANNEL_DIRECTION_INBOUND",
+		3: "CHANNEL_DIRECTION_BOTH",
+	}
+	ChannelDirection_value = map[string]int32{
+		"CHANNEL_DIRECTION_UNSPECIFIED": 0,
+		"CHANNEL_DIRECTION_OUTBOUND":    1,
+		"CHANNEL_DIRECTION_INBOUND":     2,
+		"CHANNEL_DIRECTION_BOTH":        3,
+	}
+)
+
+func (x ChannelDirection) Enum() *ChannelDirection {
+	p := new(ChannelDirection)
+	*p = x
+	return p
+}
+
+func (x ChannelDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChannelDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_aura_v1_genesis_proto_enumTypes[0].Descriptor()
+}
+
+func (ChannelDirection) Type() protoreflect.EnumType {
+	return &file_aura_v1_genesis_proto_enumTypes[0]
+}
+
+func (x ChannelDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChannelDirection.Descriptor instead.
+func (ChannelDirection) EnumDescriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+// ExemptionDirection defines which transfers of an address are exempt from
+// paused transfers.
+type ExemptionDirection int32
+
+const (
+	// EXEMPTION_DIRECTION_UNSPECIFIED is treated as EXEMPTION_DIRECTION_BOTH.
+	ExemptionDirection_EXEMPTION_DIRECTION_UNSPECIFIED ExemptionDirection = 0
+	// EXEMPTION_DIRECTION_SEND exempts transfers from the address.
+	ExemptionDirection_EXEMPTION_DIRECTION_SEND ExemptionDirection = 1
+	// EXEMPTION_DIRECTION_RECEIVE exempts transfers to the address.
+	ExemptionDirection_EXEMPTION_DIRECTION_RECEIVE ExemptionDirection = 2
+	// EXEMPTION_DIRECTION_BOTH exempts transfers from and to the address.
+	ExemptionDirection_EXEMPTION_DIRECTION_BOTH ExemptionDirection = 3
+)
+
+// Enum value maps for ExemptionDirection.
+var (
+	ExemptionDirection_name = map[int32]string{
+		0: "EXEMPTION_DIRECTION_UNSPECIFIED",
+		1: "EXEMPTION_DIRECTION_SEND",
+		2: "EXEMPTION_DIRECTION_RECEIVE",
+		3: "EXEMPTION_DIRECTION_BOTH",
+	}
+	ExemptionDirection_value = map[string]int32{
+		"EXEMPTION_DIRECTION_UNSPECIFIED": 0,
+		"EXEMPTION_DIRECTION_SEND":        1,
+		"EXEMPTION_DIRECTION_RECEIVE":     2,
+		"EXEMPTION_DIRECTION_BOTH":        3,
+	}
+)
+
+func (x ExemptionDirection) Enum() *ExemptionDirection {
+	p := new(ExemptionDirection)
+	*p = x
+	return p
+}
+
+func (x ExemptionDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExemptionDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_aura_v1_genesis_proto_enumTypes[1].Descriptor()
+}
+
+func (ExemptionDirection) Type() protoreflect.EnumType {
+	return &file_aura_v1_genesis_proto_enumTypes[1]
+}
+
+func (x ExemptionDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExemptionDirection.Descriptor instead.
+func (ExemptionDirection) EnumDescriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+// ChannelMode defines how IBC channels are restricted.
+type ChannelMode int32
+
+const (
+	// CHANNEL_MODE_UNSPECIFIED is treated as CHANNEL_MODE_DENYLIST.
+	ChannelMode_CHANNEL_MODE_UNSPECIFIED ChannelMode = 0
+	// CHANNEL_MODE_DENYLIST allows outbound transfers on all channels except blocked ones.
+	ChannelMode_CHANNEL_MODE_DENYLIST ChannelMode = 1
+	// CHANNEL_MODE_ALLOWLIST only allows outbound transfers on allowed channels.
+	ChannelMode_CHANNEL_MODE_ALLOWLIST ChannelMode = 2
+)
+
+// Enum value maps for ChannelMode.
+var (
+	ChannelMode_name = map[int32]string{
+		0: "CHANNEL_MODE_UNSPECIFIED",
+		1: "CHANNEL_MODE_DENYLIST",
+		2: "CHANNEL_MODE_ALLOWLIST",
+	}
+	ChannelMode_value = map[string]int32{
+		"CHANNEL_MODE_UNSPECIFIED": 0,
+		"CHANNEL_MODE_DENYLIST":    1,
+		"CHANNEL_MODE_ALLOWLIST":   2,
+	}
+)
+
+func (x ChannelMode) Enum() *ChannelMode {
+	p := new(ChannelMode)
+	*p = x
+	return p
+}
+
+func (x ChannelMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChannelMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_aura_v1_genesis_proto_enumTypes[2].Descriptor()
+}
+
+func (ChannelMode) Type() protoreflect.EnumType {
+	return &file_aura_v1_genesis_proto_enumTypes[2]
+}
+
+func (x ChannelMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChannelMode.Descriptor instead.
+func (ChannelMode) EnumDescriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+// PauseScope defines the operations of a governed denom that can be paused.
+type PauseScope int32
+
+const (
+	// PAUSE_SCOPE_UNSPECIFIED is used in messages to target all scopes, except
+	// for burning when pausing.
+	PauseScope_PAUSE_SCOPE_UNSPECIFIED PauseScope = 0
+	// PAUSE_SCOPE_IBC_OUTFLOWS pauses transfers into the escrow account of IBC channels.
+	PauseScope_PAUSE_SCOPE_IBC_OUTFLOWS PauseScope = 1
+	// PAUSE_SCOPE_TRANSFERS pauses all other transfers between accounts.
+	PauseScope_PAUSE_SCOPE_TRANSFERS PauseScope = 2
+	// PAUSE_SCOPE_MINT pauses minting.
+	PauseScope_PAUSE_SCOPE_MINT PauseScope = 3
+	// PAUSE_SCOPE_BURN pauses burning.
+	PauseScope_PAUSE_SCOPE_BURN PauseScope = 4
+)
+
+// Enum value maps for PauseScope.
+var (
+	PauseScope_name = map[int32]string{
+		0: "PAUSE_SCOPE_UNSPECIFIED",
+		1: "PAUSE_SCOPE_IBC_OUTFLOWS",
+		2: "PAUSE_SCOPE_TRANSFERS",
+		3: "PAUSE_SCOPE_MINT",
+		4: "PAUSE_SCOPE_BURN",
+	}
+	PauseScope_value = map[string]int32{
+		"PAUSE_SCOPE_UNSPECIFIED":  0,
+		"PAUSE_SCOPE_IBC_OUTFLOWS": 1,
+		"PAUSE_SCOPE_TRANSFERS":    2,
+		"PAUSE_SCOPE_MINT":         3,
+		"PAUSE_SCOPE_BURN":         4,
+	}
+)
+
+func (x PauseScope) Enum() *PauseScope {
+	p := new(PauseScope)
+	*p = x
+	return p
+}
+
+func (x PauseScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PauseScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_aura_v1_genesis_proto_enumTypes[3].Descriptor()
+}
+
+func (PauseScope) Type() protoreflect.EnumType {
+	return &file_aura_v1_genesis_proto_enumTypes[3]
+}
+
+func (x PauseScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PauseScope.Descriptor instead.
+func (PauseScope) EnumDescriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{3}
+}
+
+type RequestStatus int32
+
+const (
+	// REQUEST_STATUS_UNSPECIFIED is the default value, used to match any status in queries.
+	RequestStatus_REQUEST_STATUS_UNSPECIFIED RequestStatus = 0
+	// REQUEST_STATUS_PENDING is the status of requests awaiting fulfillment.
+	RequestStatus_REQUEST_STATUS_PENDING RequestStatus = 1
+	// REQUEST_STATUS_FULFILLED is the status of fulfilled requests.
+	RequestStatus_REQUEST_STATUS_FULFILLED RequestStatus = 2
+	// REQUEST_STATUS_REJECTED is the status of rejected and refunded requests.
+	RequestStatus_REQUEST_STATUS_REJECTED RequestStatus = 3
+	// REQUEST_STATUS_CANCELLED is the status of requests cancelled and refunded by the user.
+	RequestStatus_REQUEST_STATUS_CANCELLED RequestStatus = 4
+)
+
+// Enum value maps for RequestStatus.
+var (
+	RequestStatus_name = map[int32]string{
+		0: "REQUEST_STATUS_UNSPECIFIED",
+		1: "REQUEST_STATUS_PENDING",
+		2: "REQUEST_STATUS_FULFILLED",
+		3: "REQUEST_STATUS_REJECTED",
+		4: "REQUEST_STATUS_CANCELLED",
+	}
+	RequestStatus_value = map[string]int32{
+		"REQUEST_STATUS_UNSPECIFIED": 0,
+		"REQUEST_STATUS_PENDING":     1,
+		"REQUEST_STATUS_FULFILLED":   2,
+		"REQUEST_STATUS_REJECTED":    3,
+		"REQUEST_STATUS_CANCELLED":   4,
+	}
+)
+
+func (x RequestStatus) Enum() *RequestStatus {
+	p := new(RequestStatus)
+	*p = x
+	return p
+}
+
+func (x RequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_aura_v1_genesis_proto_enumTypes[4].Descriptor()
+}
+
+func (RequestStatus) Type() protoreflect.EnumType {
+	return &file_aura_v1_genesis_proto_enumTypes[4]
+}
+
+func (x RequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RequestStatus.Descriptor instead.
+func (RequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{4}
+}
+
+type GenesisState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// blocklist_state is the genesis state of the blocklist submodule.
+	BlocklistState *v1.GenesisState `protobuf:"bytes,1,opt,name=blocklist_state,json=blocklistState,proto3" json:"blocklist_state,omitempty"`
+	// paused is the legacy paused state of USDY, imported as a pause of all
+	// scopes but burning. Deprecated: use paused_scopes instead.
+	//
+	// Deprecated: Do not use.
+	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	// owner is the address that can control this module.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pending_owner is the address of the new owner during an ownership transfer.
+	PendingOwner string `protobuf:"bytes,4,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+	// burners is the list of addresses that can burn USDY.
+	Burners []*Burner `protobuf:"bytes,5,rep,name=burners,proto3" json:"burners,omitempty"`
+	// minters is the list of addresses that can mint USDY.
+	Minters []*Minter `protobuf:"bytes,6,rep,name=minters,proto3" json:"minters,omitempty"`
+	// pausers is the list of addresses that can pause USDY.
+	Pausers []string `protobuf:"bytes,7,rep,name=pausers,proto3" json:"pausers,omitempty"`
+	// blocked_channels is the legacy list of IBC channels where outbound
+	// transfers are blocked. Deprecated: use directional_blocked_channels instead.
+	//
+	// Deprecated: Do not use.
+	BlockedChannels []string `protobuf:"bytes,8,rep,name=blocked_channels,json=blockedChannels,proto3" json:"blocked_channels,omitempty"`
+	// channel_mode is the mode used to restrict IBC transfers.
+	ChannelMode ChannelMode `protobuf:"varint,9,opt,name=channel_mode,json=channelMode,proto3,enum=aura.v1.ChannelMode" json:"channel_mode,omitempty"`
+	// allowed_channels is the list of IBC channels where outbound transfers are allowed in allowlist mode.
+	AllowedChannels []string `protobuf:"bytes,10,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty"`
+	// rate_limits is the list of IBC channels where the net flow of transfers is limited.
+	RateLimits []*RateLimit `protobuf:"bytes,11,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+	// rate_limit_flows is the list of transfer flows in the current window of rate limited channels.
+	RateLimitFlows []*RateLimitFlow `protobuf:"bytes,12,rep,name=rate_limit_flows,json=rateLimitFlows,proto3" json:"rate_limit_flows,omitempty"`
+	// price_setters is the list of addresses that can set USDY price ranges.
+	PriceSetters []string `protobuf:"bytes,13,rep,name=price_setters,json=priceSetters,proto3" json:"price_setters,omitempty"`
+	// price_ranges is the list of price ranges used to derive the USDY price.
+	PriceRanges []*PriceRange `protobuf:"bytes,14,rep,name=price_ranges,json=priceRanges,proto3" json:"price_ranges,omitempty"`
+	// max_price_deviation is the maximum relative price change allowed between consecutive days.
+	MaxPriceDeviation string `protobuf:"bytes,15,opt,name=max_price_deviation,json=maxPriceDeviation,proto3" json:"max_price_deviation,omitempty"`
+	// stablecoin is the stablecoin used to subscribe to USDY.
+	Stablecoin *Stablecoin `protobuf:"bytes,16,opt,name=stablecoin,proto3" json:"stablecoin,omitempty"`
+	// subscriptions is the list of all subscription requests.
+	Subscriptions []*Subscription `protobuf:"bytes,17,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	// next_subscription_id is the id assigned to the next subscription request.
+	NextSubscriptionId uint64 `protobuf:"varint,18,opt,name=next_subscription_id,json=nextSubscriptionId,proto3" json:"next_subscription_id,omitempty"`
+	// redemptions is the list of all redemption requests.
+	Redemptions []*Redemption `protobuf:"bytes,19,rep,name=redemptions,proto3" json:"redemptions,omitempty"`
+	// next_redemption_id is the id assigned to the next redemption request.
+	NextRedemptionId uint64 `protobuf:"varint,20,opt,name=next_redemption_id,json=nextRedemptionId,proto3" json:"next_redemption_id,omitempty"`
+	// swap_config is the configuration of the swap facility.
+	SwapConfig *SwapConfig `protobuf:"bytes,21,opt,name=swap_config,json=swapConfig,proto3" json:"swap_config,omitempty"`
+	// swaps_paused is the paused state of the swap facility.
+	SwapsPaused bool `protobuf:"varint,22,opt,name=swaps_paused,json=swapsPaused,proto3" json:"swaps_paused,omitempty"`
+	// swap_liquidity is the amount of stablecoin held by the swap facility.
+	SwapLiquidity *v1beta11.Coin `protobuf:"bytes,23,opt,name=swap_liquidity,json=swapLiquidity,proto3" json:"swap_liquidity,omitempty"`
+	// swap_volumes is the list of daily swap volumes of accounts.
+	SwapVolumes []*SwapVolume `protobuf:"bytes,24,rep,name=swap_volumes,json=swapVolumes,proto3" json:"swap_volumes,omitempty"`
+	// lockup_period is the holding period during which newly minted USDY is non-transferable.
+	LockupPeriod *durationpb.Duration `protobuf:"bytes,25,opt,name=lockup_period,json=lockupPeriod,proto3" json:"lockup_period,omitempty"`
+	// locked_lots is the list of outstanding locked lots of newly minted USDY.
+	LockedLots []*LockedLot `protobuf:"bytes,26,rep,name=locked_lots,json=lockedLots,proto3" json:"locked_lots,omitempty"`
+	// denoms is the state of the additional denoms governed by this module.
+	Denoms []*DenomState `protobuf:"bytes,27,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// denom_metadata is the list of bank metadata of governed denoms.
+	DenomMetadata []*v1beta1.Metadata `protobuf:"bytes,28,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata,omitempty"`
+	// attestors is the list of addresses that can post reserve attestations.
+	Attestors []string `protobuf:"bytes,29,rep,name=attestors,proto3" json:"attestors,omitempty"`
+	// attestations is the history of posted reserve attestations.
+	Attestations []*Attestation `protobuf:"bytes,30,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	// reserve_config is the configuration of how attested reserves limit minting.
+	ReserveConfig *ReserveConfig `protobuf:"bytes,31,opt,name=reserve_config,json=reserveConfig,proto3" json:"reserve_config,omitempty"`
+	// recoverers is the list of addresses that can force transfer funds of the
+	// default denom out of blocked accounts.
+	Recoverers []string `protobuf:"bytes,32,rep,name=recoverers,proto3" json:"recoverers,omitempty"`
+	// paused_scopes are the paused operations of the default denom.
+	PausedScopes []PauseScope `protobuf:"varint,33,rep,packed,name=paused_scopes,json=pausedScopes,proto3,enum=aura.v1.PauseScope" json:"paused_scopes,omitempty"`
+	// pause_windows are the scheduled and expiring pauses of governed denoms.
+	PauseWindows []*PauseWindow `protobuf:"bytes,34,rep,name=pause_windows,json=pauseWindows,proto3" json:"pause_windows,omitempty"`
+	// pause_exemptions are the addresses that can transfer the default denom
+	// while its transfers are paused.
+	PauseExemptions []*PauseExemption `protobuf:"bytes,35,rep,name=pause_exemptions,json=pauseExemptions,proto3" json:"pause_exemptions,omitempty"`
+	// directional_blocked_channels is the list of IBC channels where transfers
+	// are blocked, and the direction of transfers that are blocked.
+	DirectionalBlockedChannels []*BlockedChannel `protobuf:"bytes,36,rep,name=directional_blocked_channels,json=directionalBlockedChannels,proto3" json:"directional_blocked_channels,omitempty"`
+}
+
+func (x *GenesisState) Reset() {
+	*x = GenesisState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aura_v1_genesis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisState) ProtoMessage() {}
+
+// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenesisState) GetBlocklistState() *v1.GenesisState {
+	if x != nil {
+		return x.BlocklistState
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *GenesisState) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *GenesisState) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *GenesisState) GetPendingOwner() string {
+	if x != nil {
+		return x.PendingOwner
+	}
+	return ""
+}
+
+func (x *GenesisState) GetBurners() []*Burner {
+	if x != nil {
+		return x.Burners
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMinters() []*Minter {
+	if x != nil {
+		return x.Minters
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPausers() []string {
+	if x != nil {
+		return x.Pausers
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *GenesisState) GetBlockedChannels() []string {
+	if x != nil {
+		return x.BlockedChannels
+	}
+	return nil
+}
+
+func (x *GenesisState) GetChannelMode() ChannelMode {
+	if x != nil {
+		return x.ChannelMode
+	}
+	return ChannelMode_CHANNEL_MODE_UNSPECIFIED
+}
+
+func (x *GenesisState) GetAllowedChannels() []string {
+	if x != nil {
+		return x.AllowedChannels
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRateLimits() []*RateLimit {
+	if x != nil {
+		return x.RateLimits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRateLimitFlows() []*RateLimitFlow {
+	if x != nil {
+		return x.RateLimitFlows
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPriceSetters() []string {
+	if x != nil {
+		return x.PriceSetters
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPriceRanges() []*PriceRange {
+	if x != nil {
+		return x.PriceRanges
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMaxPriceDeviation() string {
+	if x != nil {
+		return x.MaxPriceDeviation
+	}
+	return ""
+}
+
+func (x *GenesisState) GetStablecoin() *Stablecoin {
+	if x != nil {
+		return x.Stablecoin
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextSubscriptionId() uint64 {
+	if x != nil {
+		return x.NextSubscriptionId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetRedemptions() []*Redemption {
+	if x != nil {
+		return x.Redemptions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextRedemptionId() uint64 {
+	if x != nil {
+		return x.NextRedemptionId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetSwapConfig() *SwapConfig {
+	if x != nil {
+		return x.SwapConfig
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSwapsPaused() bool {
+	if x != nil {
+		return x.SwapsPaused
+	}
+	return false
+}
+
+func (x *GenesisState) GetSwapLiquidity() *v1beta11.Coin {
+	if x != nil {
+		return x.SwapLiquidity
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSwapVolumes() []*SwapVolume {
+	if x != nil {
+		return x.SwapVolumes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLockupPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.LockupPeriod
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLockedLots() []*LockedLot {
+	if x != nil {
+		return x.LockedLots
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDenoms() []*DenomState {
+	if x != nil {
+		return x.Denoms
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDenomMetadata() []*v1beta1.Metadata {
+	if x != nil {
+		return x.DenomMetadata
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAttestors() []string {
+	if x != nil {
+		return x.Attestors
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAttestations() []*Attestation {
+	if x != nil {
+		return x.Attestations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetReserveConfig() *ReserveConfig {
+	if x != nil {
+		return x.ReserveConfig
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRecoverers() []string {
+	if x != nil {
+		return x.Recoverers
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPausedScopes() []PauseScope {
+	if x != nil {
+		return x.PausedScopes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPauseWindows() []*PauseWindow {
+	if x != nil {
+		return x.PauseWindows
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPauseExemptions() []*PauseExemption {
+	if x != nil {
+		return x.PauseExemptions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDirectionalBlockedChannels() []*BlockedChannel {
+	if x != nil {
+		return x.DirectionalBlockedChannels
+	}
+	return nil
+}
+
+type Burner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Allowance string `protobuf:"bytes,2,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// expiry is the optional time at which the allowance expires.
+	Expiry *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// sources are the only addresses the burner can burn from, unrestricted if empty.
+	Sources []string `protobuf:"bytes,4,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *Burner) Reset() {
+	*x = Burner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aura_v1_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Burner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Burner) ProtoMessage() {}
+
+// Deprecated: Use Burner.ProtoReflect.Descriptor instead.
+func (*Burner) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Burner) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return ""
+}
+
+func (x *Burner) GetAllowance() string {
+	if x != nil {
+		return x.Allowance
 	}
+	return ""
 }
 
-var (
-	md_Burner           protoreflect.MessageDescriptor
-	fd_Burner_address   protoreflect.FieldDescriptor
-	fd_Burner_allowance protoreflect.FieldDescriptor
-)
+func (x *Burner) GetExpiry() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
 
-func init() {
-	file_aura_v1_genesis_proto_init()
-	md_Burner = File_aura_v1_genesis_proto.Messages().ByName("Burner")
-	fd_Burner_address = md_Burner.Fields().ByName("address")
-	fd_Burner_allowance = md_Burner.Fields().ByName("allowance")
+func (x *Burner) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
 }
 
-var _ protoreflect.Message = (*fastReflection_Burner)(nil)
+type Minter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type fastReflection_Burner Burner
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Allowance string `protobuf:"bytes,2,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// expiry is the optional time at which the allowance expires.
+	Expiry *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// recipients are the only addresses the minter can mint to, unrestricted if empty.
+	Recipients []string `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients,omitempty"`
+}
 
-func (x *Burner) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Burner)(x)
+func (x *Minter) Reset() {
+	*x = Minter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aura_v1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *Burner) slowProtoReflect() protoreflect.Message {
-	mi := &file_aura_v1_genesis_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *Minter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Minter) ProtoMessage() {}
+
+// Deprecated: Use Minter.ProtoReflect.Descriptor instead.
+func (*Minter) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Minter) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Minter) GetAllowance() string {
+	if x != nil {
+		return x.Allowance
+	}
+	return ""
+}
+
+func (x *Minter) GetExpiry() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
+func (x *Minter) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+type BlockedChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channel   string           `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Direction ChannelDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=aura.v1.ChannelDirection" json:"direction,omitempty"`
+}
+
+func (x *BlockedChannel) Reset() {
+	*x = BlockedChannel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aura_v1_genesis_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		ms.StoreMessageInfo(mi)
 	}
-	return mi.MessageOf(x)
 }
 
-var _fastReflection_Burner_messageType fastReflection_Burner_messageType
-var _ protoreflect.MessageType = fastReflection_Burner_messageType{}
+func (x *BlockedChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-type fastReflection_Burner_messageType struct{}
+func (*BlockedChannel) ProtoMessage() {}
 
-func (x fastReflection_Burner_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Burner)(nil)
+// Deprecated: Use BlockedChannel.ProtoReflect.Descriptor instead.
+func (*BlockedChannel) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{3}
 }
-func (x fastReflection_Burner_messageType) New() protoreflect.Message {
-	return new(fastReflection_Burner)
+
+func (x *BlockedChannel) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
 }
-func (x fastReflection_Burner_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Burner
+
+func (x *BlockedChannel) GetDirection() ChannelDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return ChannelDirection_CHANNEL_DIRECTION_UNSPECIFIED
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_Burner) Descriptor() protoreflect.MessageDescriptor {
-	return md_Burner
+type PauseExemption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Direction ExemptionDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=aura.v1.ExemptionDirection" json:"direction,omitempty"`
+}
+
+func (x *PauseExemption) Reset() {
+	*x = PauseExemption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aura_v1_genesis_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseExemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseExemption) ProtoMessage() {}
+
+// Deprecated: Use PauseExemption.ProtoReflect.Descriptor instead.
+func (*PauseExemption) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PauseExemption) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PauseExemption) GetDirection() ExemptionDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return ExemptionDirection_EXEMPTION_DIRECTION_UNSPECIFIED
+}
+
+type DenomState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom           string            `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	PausedScopes    []PauseScope      `protobuf:"varint,2,rep,packed,name=paused_scopes,json=pausedScopes,proto3,enum=aura.v1.PauseScope" json:"paused_scopes,omitempty"`
+	Owner           string            `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	PendingOwner    string            `protobuf:"bytes,4,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+	Burners         []*Burner         `protobuf:"bytes,5,rep,name=burners,proto3" json:"burners,omitempty"`
+	Minters         []*Minter         `protobuf:"bytes,6,rep,name=minters,proto3" json:"minters,omitempty"`
+	Pausers         []string          `protobuf:"bytes,7,rep,name=pausers,proto3" json:"pausers,omitempty"`
+	BlockedChannels []*BlockedChannel `protobuf:"bytes,8,rep,name=blocked_channels,json=blockedChannels,proto3" json:"blocked_channels,omitempty"`
+	BlocklistBound  bool              `protobuf:"varint,9,opt,name=blocklist_bound,json=blocklistBound,proto3" json:"blocklist_bound,omitempty"`
+	PauseExemptions []*PauseExemption `protobuf:"bytes,10,rep,name=pause_exemptions,json=pauseExemptions,proto3" json:"pause_exemptions,omitempty"`
+	Recoverers      []string          `protobuf:"bytes,11,rep,name=recoverers,proto3" json:"recoverers,omitempty"`
+}
+
+func (x *DenomState) Reset() {
+	*x = DenomState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aura_v1_genesis_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenomState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenomState) ProtoMessage() {}
+
+// Deprecated: Use DenomState.ProtoReflect.Descriptor instead.
+func (*DenomState) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DenomState) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *DenomState) GetPausedScopes() []PauseScope {
+	if x != nil {
+		return x.PausedScopes
+	}
+	return nil
+}
+
+func (x *DenomState) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *DenomState) GetPendingOwner() string {
+	if x != nil {
+		return x.PendingOwner
+	}
+	return ""
+}
+
+func (x *DenomState) GetBurners() []*Burner {
+	if x != nil {
+		return x.Burners
+	}
+	return nil
+}
+
+func (x *DenomState) GetMinters() []*Minter {
+	if x != nil {
+		return x.Minters
+	}
+	return nil
+}
+
+func (x *DenomState) GetPausers() []string {
+	if x != nil {
+		return x.Pausers
+	}
+	return nil
+}
+
+func (x *DenomState) GetBlockedChannels() []*BlockedChannel {
+	if x != nil {
+		return x.BlockedChannels
+	}
+	return nil
+}
+
+func (x *DenomState) GetBlocklistBound() bool {
+	if x != nil {
+		return x.BlocklistBound
+	}
+	return false
+}
+
+func (x *DenomState) GetPauseExemptions() []*PauseExemption {
+	if x != nil {
+		return x.PauseExemptions
+	}
+	return nil
+}
+
+func (x *DenomState) GetRecoverers() []string {
+	if x != nil {
+		return x.Recoverers
+	}
+	return nil
+}
+
+type RateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channel is the id of the rate limited channel.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// window is the duration after which the flow of transfers is reset.
+	Window *durationpb.Duration `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	// max_amount is the maximum net flow per window, zero if disabled.
+	MaxAmount string `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	// max_percentage is the maximum net flow per window as a percentage of supply, zero if disabled.
+	MaxPercentage string `protobuf:"bytes,4,opt,name=max_percentage,json=maxPercentage,proto3" json:"max_percentage,omitempty"`
+}
+
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aura_v1_genesis_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Burner) Type() protoreflect.MessageType {
-	return _fastReflection_Burner_messageType
-}
+func (*RateLimit) ProtoMessage() {}
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Burner) New() protoreflect.Message {
-	return new(fastReflection_Burner)
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{6}
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_Burner) Interface() protoreflect.ProtoMessage {
-	return (*Burner)(x)
+func (x *RateLimit) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_Burner) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_Burner_address, value) {
-			return
-		}
+func (x *RateLimit) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
 	}
-	if x.Allowance != "" {
-		value := protoreflect.ValueOfString(x.Allowance)
-		if !f(fd_Burner_allowance, value) {
-			return
-		}
+	return nil
+}
+
+func (x *RateLimit) GetMaxAmount() string {
+	if x != nil {
+		return x.MaxAmount
 	}
+	return ""
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_Burner) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "aura.v1.Burner.address":
-		return x.Address != ""
-	case "aura.v1.Burner.allowance":
-		return x.Allowance != ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Burner"))
-		}
-		panic(fmt.Errorf("message aura.v1.Burner does not contain field %s", fd.FullName()))
+func (x *RateLimit) GetMaxPercentage() string {
+	if x != nil {
+		return x.MaxPercentage
 	}
+	return ""
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Burner) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "aura.v1.Burner.address":
-		x.Address = ""
-	case "aura.v1.Burner.allowance":
-		x.Allowance = ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Burner"))
-		}
-		panic(fmt.Errorf("message aura.v1.Burner does not contain field %s", fd.FullName()))
+type RateLimitFlow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channel is the id of the rate limited channel.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// window_start is the time at which the current window started.
+	WindowStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// inflow is the amount transferred to Noble in the current window.
+	Inflow string `protobuf:"bytes,3,opt,name=inflow,proto3" json:"inflow,omitempty"`
+	// outflow is the amount transferred from Noble in the current window.
+	Outflow string `protobuf:"bytes,4,opt,name=outflow,proto3" json:"outflow,omitempty"`
+	// supply is the supply at the start of the current window.
+	Supply string `protobuf:"bytes,5,opt,name=supply,proto3" json:"supply,omitempty"`
+}
+
+func (x *RateLimitFlow) Reset() {
+	*x = RateLimitFlow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aura_v1_genesis_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Burner) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "aura.v1.Burner.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "aura.v1.Burner.allowance":
-		value := x.Allowance
-		return protoreflect.ValueOfString(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Burner"))
-		}
-		panic(fmt.Errorf("message aura.v1.Burner does not contain field %s", descriptor.FullName()))
+func (x *RateLimitFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitFlow) ProtoMessage() {}
+
+// Deprecated: Use RateLimitFlow.ProtoReflect.Descriptor instead.
+func (*RateLimitFlow) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RateLimitFlow) GetChannel() string {
+	if x != nil {
+		return x.Channel
 	}
+	return ""
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Burner) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "aura.v1.Burner.address":
-		x.Address = value.Interface().(string)
-	case "aura.v1.Burner.allowance":
-		x.Allowance = value.Interface().(string)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Burner"))
-		}
-		panic(fmt.Errorf("message aura.v1.Burner does not contain field %s", fd.FullName()))
+func (x *RateLimitFlow) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
 	}
+	return nil
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Burner) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "aura.v1.Burner.address":
-		panic(fmt.Errorf("field address of message aura.v1.Burner is not mutable"))
-	case "aura.v1.Burner.allowance":
-		panic(fmt.Errorf("field allowance of message aura.v1.Burner is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Burner"))
-		}
-		panic(fmt.Errorf("message aura.v1.Burner does not contain field %s", fd.FullName()))
+func (x *RateLimitFlow) GetInflow() string {
+	if x != nil {
+		return x.Inflow
 	}
+	return ""
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Burner) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "aura.v1.Burner.address":
-		return protoreflect.ValueOfString("")
-	case "aura.v1.Burner.allowance":
-		return protoreflect.ValueOfString("")
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Burner"))
-		}
-		panic(fmt.Errorf("message aura.v1.Burner does not contain field %s", fd.FullName()))
+func (x *RateLimitFlow) GetOutflow() string {
+	if x != nil {
+		return x.Outflow
 	}
+	return ""
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Burner) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in aura.v1.Burner", d.FullName()))
+func (x *RateLimitFlow) GetSupply() string {
+	if x != nil {
+		return x.Supply
 	}
-	panic("unreachable")
+	return ""
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Burner) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+type PriceRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index is the position of the range, ranges are contiguous and ordered by index.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// start is the time at which the range starts.
+	Start *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// end is the time at which the range ends.
+	End *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// daily_rate is the factor the price is multiplied by for every full day elapsed in the range.
+	DailyRate string `protobuf:"bytes,4,opt,name=daily_rate,json=dailyRate,proto3" json:"daily_rate,omitempty"`
+	// start_price is the price at the start of the range.
+	StartPrice string `protobuf:"bytes,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Burner) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *PriceRange) Reset() {
+	*x = PriceRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aura_v1_genesis_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_Burner) IsValid() bool {
-	return x != nil
+func (x *PriceRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_Burner) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Burner)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Allowance)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (*PriceRange) ProtoMessage() {}
+
+// Deprecated: Use PriceRange.ProtoReflect.Descriptor instead.
+func (*PriceRange) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PriceRange) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
 	}
+	return 0
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Burner)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.Allowance) > 0 {
-			i -= len(x.Allowance)
-			copy(dAtA[i:], x.Allowance)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Allowance)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *PriceRange) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Burner)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Burner: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Burner: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Address = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Allowance = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return nil
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (x *PriceRange) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return nil
+}
+
+func (x *PriceRange) GetDailyRate() string {
+	if x != nil {
+		return x.DailyRate
 	}
+	return ""
 }
 
-var (
-	md_Minter           protoreflect.MessageDescriptor
-	fd_Minter_address   protoreflect.FieldDescriptor
-	fd_Minter_allowance protoreflect.FieldDescriptor
-)
+func (x *PriceRange) GetStartPrice() string {
+	if x != nil {
+		return x.StartPrice
+	}
+	return ""
+}
 
-func init() {
-	file_aura_v1_genesis_proto_init()
-	md_Minter = File_aura_v1_genesis_proto.Messages().ByName("Minter")
-	fd_Minter_address = md_Minter.Fields().ByName("address")
-	fd_Minter_allowance = md_Minter.Fields().ByName("allowance")
+// PauseWindow bounds the pause of a scope of a governed denom. A pause starts
+// once either start bound is reached, and is lifted once either end bound is
+// reached. Bounds that are zero are not set.
+type PauseWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom is the governed denom the pause applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// scope is the paused operation.
+	Scope PauseScope `protobuf:"varint,2,opt,name=scope,proto3,enum=aura.v1.PauseScope" json:"scope,omitempty"`
+	// start_height is the block height at which the pause starts.
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// start_time is the block time at which the pause starts.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_height is the block height at which the pause is lifted.
+	EndHeight int64 `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// end_time is the block time at which the pause is lifted.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 }
 
-var _ protoreflect.Message = (*fastReflection_Minter)(nil)
+func (x *PauseWindow) Reset() {
+	*x = PauseWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aura_v1_genesis_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-type fastReflection_Minter Minter
+func (x *PauseWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (x *Minter) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Minter)(x)
+func (*PauseWindow) ProtoMessage() {}
+
+// Deprecated: Use PauseWindow.ProtoReflect.Descriptor instead.
+func (*PauseWindow) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Minter) slowProtoReflect() protoreflect.Message {
-	mi := &file_aura_v1_genesis_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PauseWindow) GetDenom() string {
+	if x != nil {
+		return x.Denom
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-var _fastReflection_Minter_messageType fastReflection_Minter_messageType
-var _ protoreflect.MessageType = fastReflection_Minter_messageType{}
+func (x *PauseWindow) GetScope() PauseScope {
+	if x != nil {
+		return x.Scope
+	}
+	return PauseScope_PAUSE_SCOPE_UNSPECIFIED
+}
 
-type fastReflection_Minter_messageType struct{}
+func (x *PauseWindow) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
 
-func (x fastReflection_Minter_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Minter)(nil)
+func (x *PauseWindow) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
 }
-func (x fastReflection_Minter_messageType) New() protoreflect.Message {
-	return new(fastReflection_Minter)
+
+func (x *PauseWindow) GetEndHeight() int64 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
 }
-func (x fastReflection_Minter_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Minter
+
+func (x *PauseWindow) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_Minter) Descriptor() protoreflect.MessageDescriptor {
-	return md_Minter
+type Stablecoin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom is the base denom of the stablecoin.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// exponent is the number of decimals of the stablecoin's base denom.
+	Exponent uint32 `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Minter) Type() protoreflect.MessageType {
-	return _fastReflection_Minter_messageType
+func (x *Stablecoin) Reset() {
+	*x = Stablecoin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aura_v1_genesis_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Minter) New() protoreflect.Message {
-	return new(fastReflection_Minter)
+func (x *Stablecoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_Minter) Interface() protoreflect.ProtoMessage {
-	return (*Minter)(x)
+func (*Stablecoin) ProtoMessage() {}
+
+// Deprecated: Use Stablecoin.ProtoReflect.Descriptor instead.
+func (*Stablecoin) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{10}
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_Minter) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_Minter_address, value) {
-			return
-		}
-	}
-	if x.Allowance != "" {
-		value := protoreflect.ValueOfString(x.Allowance)
-		if !f(fd_Minter_allowance, value) {
-			return
-		}
+func (x *Stablecoin) GetDenom() string {
+	if x != nil {
+		return x.Denom
 	}
+	return ""
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_Minter) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "aura.v1.Minter.address":
-		return x.Address != ""
-	case "aura.v1.Minter.allowance":
-		return x.Allowance != ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Minter"))
-		}
-		panic(fmt.Errorf("message aura.v1.Minter does not contain field %s", fd.FullName()))
+func (x *Stablecoin) GetExponent() uint32 {
+	if x != nil {
+		return x.Exponent
 	}
+	return 0
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Minter) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "aura.v1.Minter.address":
-		x.Address = ""
-	case "aura.v1.Minter.allowance":
-		x.Allowance = ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Minter"))
-		}
-		panic(fmt.Errorf("message aura.v1.Minter does not contain field %s", fd.FullName()))
+type Subscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique identifier of the request.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// user is the address of the account that requested the subscription.
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// deposit is the amount of stablecoin escrowed in the module.
+	Deposit *v1beta11.Coin `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// status is the current status of the request.
+	Status RequestStatus `protobuf:"varint,4,opt,name=status,proto3,enum=aura.v1.RequestStatus" json:"status,omitempty"`
+	// price is the USDY price the request was fulfilled at, zero if not fulfilled.
+	Price string `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	// minted is the amount of USDY minted to the user, zero if not fulfilled.
+	Minted string `protobuf:"bytes,6,opt,name=minted,proto3" json:"minted,omitempty"`
+	// created_at is the time at which the request was made.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aura_v1_genesis_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Minter) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "aura.v1.Minter.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "aura.v1.Minter.allowance":
-		value := x.Allowance
-		return protoreflect.ValueOfString(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Minter"))
-		}
-		panic(fmt.Errorf("message aura.v1.Minter does not contain field %s", descriptor.FullName()))
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Subscription) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
+	return 0
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Minter) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "aura.v1.Minter.address":
-		x.Address = value.Interface().(string)
-	case "aura.v1.Minter.allowance":
-		x.Allowance = value.Interface().(string)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Minter"))
-		}
-		panic(fmt.Errorf("message aura.v1.Minter does not contain field %s", fd.FullName()))
+func (x *Subscription) GetUser() string {
+	if x != nil {
+		return x.User
 	}
+	return ""
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Minter) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "aura.v1.Minter.address":
-		panic(fmt.Errorf("field address of message aura.v1.Minter is not mutable"))
-	case "aura.v1.Minter.allowance":
-		panic(fmt.Errorf("field allowance of message aura.v1.Minter is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Minter"))
-		}
-		panic(fmt.Errorf("message aura.v1.Minter does not contain field %s", fd.FullName()))
+func (x *Subscription) GetDeposit() *v1beta11.Coin {
+	if x != nil {
+		return x.Deposit
 	}
+	return nil
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Minter) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "aura.v1.Minter.address":
-		return protoreflect.ValueOfString("")
-	case "aura.v1.Minter.allowance":
-		return protoreflect.ValueOfString("")
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aura.v1.Minter"))
-		}
-		panic(fmt.Errorf("message aura.v1.Minter does not contain field %s", fd.FullName()))
+func (x *Subscription) GetStatus() RequestStatus {
+	if x != nil {
+		return x.Status
 	}
+	return RequestStatus_REQUEST_STATUS_UNSPECIFIED
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Minter) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in aura.v1.Minter", d.FullName()))
+func (x *Subscription) GetPrice() string {
+	if x != nil {
+		return x.Price
 	}
-	panic("unreachable")
+	return ""
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Minter) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *Subscription) GetMinted() string {
+	if x != nil {
+		return x.Minted
+	}
+	return ""
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Minter) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *Subscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_Minter) IsValid() bool {
-	return x != nil
+type Redemption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique identifier of the request.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// user is the address of the account that requested the redemption.
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// amount is the amount of USDY escrowed in the module.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// status is the current status of the request.
+	Status RequestStatus `protobuf:"varint,4,opt,name=status,proto3,enum=aura.v1.RequestStatus" json:"status,omitempty"`
+	// payout is the amount of stablecoin paid to the user on settlement, if any.
+	Payout *v1beta11.Coin `protobuf:"bytes,5,opt,name=payout,proto3" json:"payout,omitempty"`
+	// created_at is the time at which the request was made.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_Minter) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Minter)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Allowance)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *Redemption) Reset() {
+	*x = Redemption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aura_v1_genesis_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Minter)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.Allowance) > 0 {
-			i -= len(x.Allowance)
-			copy(dAtA[i:], x.Allowance)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Allowance)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *Redemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Redemption) ProtoMessage() {}
+
+// Deprecated: Use Redemption.ProtoReflect.Descriptor instead.
+func (*Redemption) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Redemption) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Minter)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Minter: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Address = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Allowance = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return 0
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (x *Redemption) GetUser() string {
+	if x != nil {
+		return x.User
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return ""
+}
+
+func (x *Redemption) GetAmount() string {
+	if x != nil {
+		return x.Amount
 	}
+	return ""
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: aura/v1/genesis.proto
+func (x *Redemption) GetStatus() RequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RequestStatus_REQUEST_STATUS_UNSPECIFIED
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *Redemption) GetPayout() *v1beta11.Coin {
+	if x != nil {
+		return x.Payout
+	}
+	return nil
+}
 
-type GenesisState struct {
+func (x *Redemption) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SwapConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// blocklist_state is the genesis state of the blocklist submodule.
-	BlocklistState *v1.GenesisState `protobuf:"bytes,1,opt,name=blocklist_state,json=blocklistState,proto3" json:"blocklist_state,omitempty"`
-	// paused is the paused state of this module.
-	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
-	// owner is the address that can control this module.
-	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
-	// pending_owner is the address of the new owner during an ownership transfer.
-	PendingOwner string `protobuf:"bytes,4,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
-	// burners is the list of addresses that can burn USDY.
-	Burners []*Burner `protobuf:"bytes,5,rep,name=burners,proto3" json:"burners,omitempty"`
-	// minters is the list of addresses that can mint USDY.
-	Minters []*Minter `protobuf:"bytes,6,rep,name=minters,proto3" json:"minters,omitempty"`
-	// pausers is the list of addresses that can pause USDY.
-	Pausers []string `protobuf:"bytes,7,rep,name=pausers,proto3" json:"pausers,omitempty"`
-	// blocked_channels is the list of IBC channels where transfers are blocked.
-	BlockedChannels []string `protobuf:"bytes,8,rep,name=blocked_channels,json=blockedChannels,proto3" json:"blocked_channels,omitempty"`
+	// fee is the fraction of the oracle price charged on swaps.
+	Fee string `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee,omitempty"`
+	// daily_limit is the maximum amount of USDY an account can swap per day, zero if disabled.
+	DailyLimit string `protobuf:"bytes,2,opt,name=daily_limit,json=dailyLimit,proto3" json:"daily_limit,omitempty"`
+}
+
+func (x *SwapConfig) Reset() {
+	*x = SwapConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aura_v1_genesis_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapConfig) ProtoMessage() {}
+
+// Deprecated: Use SwapConfig.ProtoReflect.Descriptor instead.
+func (*SwapConfig) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SwapConfig) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *SwapConfig) GetDailyLimit() string {
+	if x != nil {
+		return x.DailyLimit
+	}
+	return ""
+}
+
+type SwapVolume struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the address of the account that swapped.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// day is the number of days since the unix epoch the volume was swapped on.
+	Day uint64 `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	// volume is the amount of USDY swapped on that day.
+	Volume string `protobuf:"bytes,3,opt,name=volume,proto3" json:"volume,omitempty"`
 }
 
-func (x *GenesisState) Reset() {
-	*x = GenesisState{}
+func (x *SwapVolume) Reset() {
+	*x = SwapVolume{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aura_v1_genesis_proto_msgTypes[0]
+		mi := &file_aura_v1_genesis_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenesisState) String() string {
+func (x *SwapVolume) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenesisState) ProtoMessage() {}
+func (*SwapVolume) ProtoMessage() {}
 
-// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use SwapVolume.ProtoReflect.Descriptor instead.
+func (*SwapVolume) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GenesisState) GetBlocklistState() *v1.GenesisState {
+func (x *SwapVolume) GetAddress() string {
 	if x != nil {
-		return x.BlocklistState
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *GenesisState) GetPaused() bool {
+func (x *SwapVolume) GetDay() uint64 {
 	if x != nil {
-		return x.Paused
+		return x.Day
 	}
-	return false
+	return 0
 }
 
-func (x *GenesisState) GetOwner() string {
+func (x *SwapVolume) GetVolume() string {
 	if x != nil {
-		return x.Owner
+		return x.Volume
 	}
 	return ""
 }
 
-func (x *GenesisState) GetPendingOwner() string {
-	if x != nil {
-		return x.PendingOwner
-	}
-	return ""
+type LockedLot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the address of the account that received the minted USDY.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the amount of USDY that is locked.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// unlock_time is the time at which the USDY becomes transferable.
+	UnlockTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
 }
 
-func (x *GenesisState) GetBurners() []*Burner {
-	if x != nil {
-		return x.Burners
+func (x *LockedLot) Reset() {
+	*x = LockedLot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aura_v1_genesis_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *GenesisState) GetMinters() []*Minter {
+func (x *LockedLot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockedLot) ProtoMessage() {}
+
+// Deprecated: Use LockedLot.ProtoReflect.Descriptor instead.
+func (*LockedLot) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LockedLot) GetAddress() string {
 	if x != nil {
-		return x.Minters
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *GenesisState) GetPausers() []string {
+func (x *LockedLot) GetAmount() string {
 	if x != nil {
-		return x.Pausers
+		return x.Amount
 	}
-	return nil
+	return ""
 }
 
-func (x *GenesisState) GetBlockedChannels() []string {
+func (x *LockedLot) GetUnlockTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BlockedChannels
+		return x.UnlockTime
 	}
 	return nil
 }
 
-type Burner struct {
+type Attestation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Allowance string `protobuf:"bytes,2,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// index is the position of the attestation in the history.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// attestor is the address that posted the attestation.
+	Attestor string `protobuf:"bytes,2,opt,name=attestor,proto3" json:"attestor,omitempty"`
+	// amount is the amount of reserves backing USDY, denominated in USDY.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// as_of is the time at which the reserves were attested.
+	AsOf *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	// report_hash is the hash of the off-chain attestation report.
+	ReportHash string `protobuf:"bytes,5,opt,name=report_hash,json=reportHash,proto3" json:"report_hash,omitempty"`
+	// posted_at is the time at which the attestation was posted.
+	PostedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=posted_at,json=postedAt,proto3" json:"posted_at,omitempty"`
 }
 
-func (x *Burner) Reset() {
-	*x = Burner{}
+func (x *Attestation) Reset() {
+	*x = Attestation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aura_v1_genesis_proto_msgTypes[1]
+		mi := &file_aura_v1_genesis_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Burner) String() string {
+func (x *Attestation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Burner) ProtoMessage() {}
+func (*Attestation) ProtoMessage() {}
 
-// Deprecated: Use Burner.ProtoReflect.Descriptor instead.
-func (*Burner) Descriptor() ([]byte, []int) {
-	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Attestation.ProtoReflect.Descriptor instead.
+func (*Attestation) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Burner) GetAddress() string {
+func (x *Attestation) GetIndex() uint64 {
 	if x != nil {
-		return x.Address
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Attestation) GetAttestor() string {
+	if x != nil {
+		return x.Attestor
 	}
 	return ""
 }
 
-func (x *Burner) GetAllowance() string {
+func (x *Attestation) GetAmount() string {
 	if x != nil {
-		return x.Allowance
+		return x.Amount
 	}
 	return ""
 }
 
-type Minter struct {
+func (x *Attestation) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *Attestation) GetReportHash() string {
+	if x != nil {
+		return x.ReportHash
+	}
+	return ""
+}
+
+func (x *Attestation) GetPostedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PostedAt
+	}
+	return nil
+}
+
+type ReserveConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Allowance string `protobuf:"bytes,2,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// collateral_ratio is the factor applied to the latest attested reserves to
+	// cap the supply of USDY, zero if minting isn't limited by reserves.
+	CollateralRatio string `protobuf:"bytes,1,opt,name=collateral_ratio,json=collateralRatio,proto3" json:"collateral_ratio,omitempty"`
+	// max_staleness is how long an attestation is valid for after its as of
+	// time, zero if attestations don't become stale.
+	MaxStaleness *durationpb.Duration `protobuf:"bytes,2,opt,name=max_staleness,json=maxStaleness,proto3" json:"max_staleness,omitempty"`
 }
 
-func (x *Minter) Reset() {
-	*x = Minter{}
+func (x *ReserveConfig) Reset() {
+	*x = ReserveConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aura_v1_genesis_proto_msgTypes[2]
+		mi := &file_aura_v1_genesis_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Minter) String() string {
+func (x *ReserveConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Minter) ProtoMessage() {}
+func (*ReserveConfig) ProtoMessage() {}
 
-// Deprecated: Use Minter.ProtoReflect.Descriptor instead.
-func (*Minter) Descriptor() ([]byte, []int) {
-	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ReserveConfig.ProtoReflect.Descriptor instead.
+func (*ReserveConfig) Descriptor() ([]byte, []int) {
+	return file_aura_v1_genesis_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Minter) GetAddress() string {
+func (x *ReserveConfig) GetCollateralRatio() string {
 	if x != nil {
-		return x.Address
+		return x.CollateralRatio
 	}
 	return ""
 }
 
-func (x *Minter) GetAllowance() string {
+func (x *ReserveConfig) GetMaxStaleness() *durationpb.Duration {
 	if x != nil {
-		return x.Allowance
+		return x.MaxStaleness
 	}
-	return ""
+	return nil
 }
 
 var File_aura_v1_genesis_proto protoreflect.FileDescriptor
@@ -2331,56 +17564,494 @@ var file_aura_v1_genesis_proto_rawDesc = []byte{
 	0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x61, 0x75, 0x72, 0x61, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c,
 	0x69, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x6e,
+	0x6b, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c,
-	0x69, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x07, 0x62, 0x75, 0x72,
-	0x6e, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x75, 0x72,
-	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x00, 0x52, 0x07, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x75,
-	0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x61, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
-	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
-	0x22, 0x72, 0x0a, 0x06, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x4e, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x61, 0x6e, 0x63, 0x65, 0x22, 0x72, 0x0a, 0x06, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4e, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x10, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2f,
+	0x0a, 0x07, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x72,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x72, 0x73, 0x12,
+	0x2f, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x10, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x39, 0x0a,
+	0x0b, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0e, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x53, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75,
+	0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x12, 0x66, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x63, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x12, 0x41, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x72,
+	0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x64,
+	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75,
+	0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x77, 0x61, 0x70, 0x73, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x0e, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x73, 0x77, 0x61, 0x70, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0b, 0x73, 0x77, 0x61, 0x70, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73,
+	0x12, 0x48, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x6c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x4c, 0x6f, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x4c, 0x6f, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18,
+	0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x06, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x4a, 0x0a, 0x0e, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x72,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x65, 0x72, 0x73, 0x18, 0x20, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x0d, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x64, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x21, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x52, 0x0c, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x73, 0x18, 0x22, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70, 0x61, 0x75, 0x73, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x73, 0x12, 0x48, 0x0a, 0x10, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x23, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61,
+	0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x78, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5f, 0x0a, 0x1c,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x24, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x1a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0xca, 0x01,
+	0x0a, 0x06, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x4e, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
+	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x06, 0x4d,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x4e, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
+	0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x3c, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f,
+	0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x63, 0x0a,
+	0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x37, 0x0a, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61,
+	0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x65, 0x0a, 0x0e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x39,
+	0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf0, 0x03, 0x0a, 0x0a, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x38,
+	0x0a, 0x0d, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x0c, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x07, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x75, 0x72, 0x6e, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x62, 0x75, 0x72,
+	0x6e, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x6d, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x48, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x72, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x48, 0x0a, 0x10, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61,
+	0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x78, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x72, 0x73, 0x22, 0x92, 0x02, 0x0a,
+	0x09, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x3b, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x4f, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x5d, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x22, 0xd2, 0x02, 0x0a, 0x0d, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x46,
+	0x6c, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x47, 0x0a,
+	0x0c, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x48, 0x0a, 0x06, 0x69, 0x6e, 0x66, 0x6c, 0x6f, 0x77,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x69, 0x6e, 0x66, 0x6c, 0x6f, 0x77,
+	0x12, 0x4a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x48, 0x0a, 0x06,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x22, 0xc6, 0x02, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3a, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12,
+	0x55, 0x0a, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x64, 0x61, 0x69,
+	0x6c, 0x79, 0x52, 0x61, 0x74, 0x65, 0x12, 0x57, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22,
+	0x96, 0x02, 0x0a, 0x0b, 0x50, 0x61, 0x75, 0x73, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x43, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e,
+	0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0xff, 0x02, 0x0a, 0x0c, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x3e, 0x0a,
+	0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x2e, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4c, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x6d,
+	0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f,
 	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
 	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x92, 0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6d,
-	0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x6e, 0x64, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x2f, 0x75, 0x73, 0x64, 0x79, 0x2d, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x75, 0x72, 0x61, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x72, 0x61,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x58, 0x58, 0xaa, 0x02, 0x07, 0x41, 0x75, 0x72, 0x61, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x07, 0x41, 0x75, 0x72, 0x61, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x13, 0x41,
-	0x75, 0x72, 0x61, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x08, 0x41, 0x75, 0x72, 0x61, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x6d,
+	0x69, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xad, 0x02, 0x0a, 0x0a, 0x52,
+	0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x48, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x72, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x0a, 0x53,
+	0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x48, 0x0a, 0x03, 0x66, 0x65, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x03,
+	0x66, 0x65, 0x65, 0x12, 0x51, 0x0a, 0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c,
+	0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x0a, 0x53, 0x77, 0x61, 0x70, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x64, 0x61,
+	0x79, 0x12, 0x48, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x09,
+	0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4c, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x48, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x45, 0x0a,
+	0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
+	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0xa8, 0x02, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x12, 0x48, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x39, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f,
+	0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x41, 0x0a, 0x09,
+	0x70, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f,
+	0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0xbc, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x61, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x12, 0x48, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x6c,
+	0x65, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01,
+	0x52, 0x0c, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x2a, 0x8c,
+	0x02, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x1d, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x44,
+	0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x1f, 0x8a, 0x9d, 0x20, 0x1b, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x73, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x1a, 0x43, 0x48, 0x41, 0x4e, 0x4e,
+	0x45, 0x4c, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54,
+	0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x1a, 0x1c, 0x8a, 0x9d, 0x20, 0x18, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c,
+	0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x42, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x02, 0x1a, 0x1b, 0x8a, 0x9d, 0x20, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x34, 0x0a, 0x16, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x44, 0x49, 0x52,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x4f, 0x54, 0x48, 0x10, 0x03, 0x1a, 0x18, 0x8a,
+	0x9d, 0x20, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x74, 0x68, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x96, 0x02,
+	0x0a, 0x12, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x1f, 0x45, 0x58, 0x45, 0x4d, 0x50, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x21, 0x8a, 0x9d, 0x20, 0x1d, 0x45,
+	0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18,
+	0x45, 0x58, 0x45, 0x4d, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0x01, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16,
+	0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x3e, 0x0a, 0x1b, 0x45, 0x58, 0x45, 0x4d, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x43, 0x45, 0x49, 0x56, 0x45, 0x10, 0x02, 0x1a, 0x1d, 0x8a, 0x9d, 0x20, 0x19, 0x45, 0x78, 0x65,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x45, 0x58, 0x45, 0x4d, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x4f,
+	0x54, 0x48, 0x10, 0x03, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x74, 0x68,
+	0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xb7, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45,
+	0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x12, 0x32, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x44, 0x45, 0x4e, 0x59, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x01, 0x1a, 0x17, 0x8a, 0x9d, 0x20,
+	0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x6e, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x02,
+	0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64,
+	0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00,
+	0x2a, 0x8b, 0x02, 0x0a, 0x0a, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12,
+	0x36, 0x0a, 0x17, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x19, 0x8a, 0x9d,
+	0x20, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x55, 0x6e, 0x73, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x50, 0x41, 0x55, 0x53, 0x45,
+	0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x49, 0x42, 0x43, 0x5f, 0x4f, 0x55, 0x54, 0x46, 0x4c,
+	0x4f, 0x57, 0x53, 0x10, 0x01, 0x1a, 0x19, 0x8a, 0x9d, 0x20, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x53, 0x63, 0x6f, 0x70, 0x65, 0x49, 0x42, 0x43, 0x4f, 0x75, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x73,
+	0x12, 0x32, 0x0a, 0x15, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x53, 0x10, 0x02, 0x1a, 0x17, 0x8a, 0x9d, 0x20,
+	0x13, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x43,
+	0x4f, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x54, 0x10, 0x03, 0x1a, 0x12, 0x8a, 0x9d, 0x20, 0x0e,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x28,
+	0x0a, 0x10, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x42, 0x55,
+	0x52, 0x4e, 0x10, 0x04, 0x1a, 0x12, 0x8a, 0x9d, 0x20, 0x0e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xb5,
+	0x02, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x3c, 0x0a, 0x1a, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x1a, 0x1c, 0x8a, 0x9d, 0x20, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x34,
+	0x0a, 0x16, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x18, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x55, 0x4c, 0x46, 0x49, 0x4c, 0x4c, 0x45, 0x44,
+	0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x36,
+	0x0a, 0x17, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x19, 0x8a, 0x9d, 0x20,
+	0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
+	0x45, 0x44, 0x10, 0x04, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
+	0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0x92, 0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x61,
+	0x75, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6f, 0x6e, 0x64, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
+	0x75, 0x73, 0x64, 0x79, 0x2d, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x75, 0x72, 0x61, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x72, 0x61, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x41, 0x58, 0x58, 0xaa, 0x02, 0x07, 0x41, 0x75, 0x72, 0x61, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x07, 0x41, 0x75, 0x72, 0x61, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x13, 0x41, 0x75, 0x72,
+	0x61, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x08, 0x41, 0x75, 0x72, 0x61, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2395,22 +18066,93 @@ func file_aura_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_aura_v1_genesis_proto_rawDescData
 }
 
-var file_aura_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_aura_v1_genesis_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_aura_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_aura_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),    // 0: aura.v1.GenesisState
-	(*Burner)(nil),          // 1: aura.v1.Burner
-	(*Minter)(nil),          // 2: aura.v1.Minter
-	(*v1.GenesisState)(nil), // 3: aura.blocklist.v1.GenesisState
+	(ChannelDirection)(0),         // 0: aura.v1.ChannelDirection
+	(ExemptionDirection)(0),       // 1: aura.v1.ExemptionDirection
+	(ChannelMode)(0),              // 2: aura.v1.ChannelMode
+	(PauseScope)(0),               // 3: aura.v1.PauseScope
+	(RequestStatus)(0),            // 4: aura.v1.RequestStatus
+	(*GenesisState)(nil),          // 5: aura.v1.GenesisState
+	(*Burner)(nil),                // 6: aura.v1.Burner
+	(*Minter)(nil),                // 7: aura.v1.Minter
+	(*BlockedChannel)(nil),        // 8: aura.v1.BlockedChannel
+	(*PauseExemption)(nil),        // 9: aura.v1.PauseExemption
+	(*DenomState)(nil),            // 10: aura.v1.DenomState
+	(*RateLimit)(nil),             // 11: aura.v1.RateLimit
+	(*RateLimitFlow)(nil),         // 12: aura.v1.RateLimitFlow
+	(*PriceRange)(nil),            // 13: aura.v1.PriceRange
+	(*PauseWindow)(nil),           // 14: aura.v1.PauseWindow
+	(*Stablecoin)(nil),            // 15: aura.v1.Stablecoin
+	(*Subscription)(nil),          // 16: aura.v1.Subscription
+	(*Redemption)(nil),            // 17: aura.v1.Redemption
+	(*SwapConfig)(nil),            // 18: aura.v1.SwapConfig
+	(*SwapVolume)(nil),            // 19: aura.v1.SwapVolume
+	(*LockedLot)(nil),             // 20: aura.v1.LockedLot
+	(*Attestation)(nil),           // 21: aura.v1.Attestation
+	(*ReserveConfig)(nil),         // 22: aura.v1.ReserveConfig
+	(*v1.GenesisState)(nil),       // 23: aura.blocklist.v1.GenesisState
+	(*v1beta11.Coin)(nil),         // 24: cosmos.base.v1beta1.Coin
+	(*durationpb.Duration)(nil),   // 25: google.protobuf.Duration
+	(*v1beta1.Metadata)(nil),      // 26: cosmos.bank.v1beta1.Metadata
+	(*timestamppb.Timestamp)(nil), // 27: google.protobuf.Timestamp
 }
 var file_aura_v1_genesis_proto_depIdxs = []int32{
-	3, // 0: aura.v1.GenesisState.blocklist_state:type_name -> aura.blocklist.v1.GenesisState
-	1, // 1: aura.v1.GenesisState.burners:type_name -> aura.v1.Burner
-	2, // 2: aura.v1.GenesisState.minters:type_name -> aura.v1.Minter
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	23, // 0: aura.v1.GenesisState.blocklist_state:type_name -> aura.blocklist.v1.GenesisState
+	6,  // 1: aura.v1.GenesisState.burners:type_name -> aura.v1.Burner
+	7,  // 2: aura.v1.GenesisState.minters:type_name -> aura.v1.Minter
+	2,  // 3: aura.v1.GenesisState.channel_mode:type_name -> aura.v1.ChannelMode
+	11, // 4: aura.v1.GenesisState.rate_limits:type_name -> aura.v1.RateLimit
+	12, // 5: aura.v1.GenesisState.rate_limit_flows:type_name -> aura.v1.RateLimitFlow
+	13, // 6: aura.v1.GenesisState.price_ranges:type_name -> aura.v1.PriceRange
+	15, // 7: aura.v1.GenesisState.stablecoin:type_name -> aura.v1.Stablecoin
+	16, // 8: aura.v1.GenesisState.subscriptions:type_name -> aura.v1.Subscription
+	17, // 9: aura.v1.GenesisState.redemptions:type_name -> aura.v1.Redemption
+	18, // 10: aura.v1.GenesisState.swap_config:type_name -> aura.v1.SwapConfig
+	24, // 11: aura.v1.GenesisState.swap_liquidity:type_name -> cosmos.base.v1beta1.Coin
+	19, // 12: aura.v1.GenesisState.swap_volumes:type_name -> aura.v1.SwapVolume
+	25, // 13: aura.v1.GenesisState.lockup_period:type_name -> google.protobuf.Duration
+	20, // 14: aura.v1.GenesisState.locked_lots:type_name -> aura.v1.LockedLot
+	10, // 15: aura.v1.GenesisState.denoms:type_name -> aura.v1.DenomState
+	26, // 16: aura.v1.GenesisState.denom_metadata:type_name -> cosmos.bank.v1beta1.Metadata
+	21, // 17: aura.v1.GenesisState.attestations:type_name -> aura.v1.Attestation
+	22, // 18: aura.v1.GenesisState.reserve_config:type_name -> aura.v1.ReserveConfig
+	3,  // 19: aura.v1.GenesisState.paused_scopes:type_name -> aura.v1.PauseScope
+	14, // 20: aura.v1.GenesisState.pause_windows:type_name -> aura.v1.PauseWindow
+	9,  // 21: aura.v1.GenesisState.pause_exemptions:type_name -> aura.v1.PauseExemption
+	8,  // 22: aura.v1.GenesisState.directional_blocked_channels:type_name -> aura.v1.BlockedChannel
+	27, // 23: aura.v1.Burner.expiry:type_name -> google.protobuf.Timestamp
+	27, // 24: aura.v1.Minter.expiry:type_name -> google.protobuf.Timestamp
+	0,  // 25: aura.v1.BlockedChannel.direction:type_name -> aura.v1.ChannelDirection
+	1,  // 26: aura.v1.PauseExemption.direction:type_name -> aura.v1.ExemptionDirection
+	3,  // 27: aura.v1.DenomState.paused_scopes:type_name -> aura.v1.PauseScope
+	6,  // 28: aura.v1.DenomState.burners:type_name -> aura.v1.Burner
+	7,  // 29: aura.v1.DenomState.minters:type_name -> aura.v1.Minter
+	8,  // 30: aura.v1.DenomState.blocked_channels:type_name -> aura.v1.BlockedChannel
+	9,  // 31: aura.v1.DenomState.pause_exemptions:type_name -> aura.v1.PauseExemption
+	25, // 32: aura.v1.RateLimit.window:type_name -> google.protobuf.Duration
+	27, // 33: aura.v1.RateLimitFlow.window_start:type_name -> google.protobuf.Timestamp
+	27, // 34: aura.v1.PriceRange.start:type_name -> google.protobuf.Timestamp
+	27, // 35: aura.v1.PriceRange.end:type_name -> google.protobuf.Timestamp
+	3,  // 36: aura.v1.PauseWindow.scope:type_name -> aura.v1.PauseScope
+	27, // 37: aura.v1.PauseWindow.start_time:type_name -> google.protobuf.Timestamp
+	27, // 38: aura.v1.PauseWindow.end_time:type_name -> google.protobuf.Timestamp
+	24, // 39: aura.v1.Subscription.deposit:type_name -> cosmos.base.v1beta1.Coin
+	4,  // 40: aura.v1.Subscription.status:type_name -> aura.v1.RequestStatus
+	27, // 41: aura.v1.Subscription.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 42: aura.v1.Redemption.status:type_name -> aura.v1.RequestStatus
+	24, // 43: aura.v1.Redemption.payout:type_name -> cosmos.base.v1beta1.Coin
+	27, // 44: aura.v1.Redemption.created_at:type_name -> google.protobuf.Timestamp
+	27, // 45: aura.v1.LockedLot.unlock_time:type_name -> google.protobuf.Timestamp
+	27, // 46: aura.v1.Attestation.as_of:type_name -> google.protobuf.Timestamp
+	27, // 47: aura.v1.Attestation.posted_at:type_name -> google.protobuf.Timestamp
+	25, // 48: aura.v1.ReserveConfig.max_staleness:type_name -> google.protobuf.Duration
+	49, // [49:49] is the sub-list for method output_type
+	49, // [49:49] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_aura_v1_genesis_proto_init() }
@@ -2455,19 +18197,200 @@ func file_aura_v1_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_aura_v1_genesis_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockedChannel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aura_v1_genesis_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseExemption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aura_v1_genesis_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenomState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aura_v1_genesis_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aura_v1_genesis_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimitFlow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aura_v1_genesis_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aura_v1_genesis_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aura_v1_genesis_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stablecoin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aura_v1_genesis_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Subscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aura_v1_genesis_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Redemption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aura_v1_genesis_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aura_v1_genesis_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapVolume); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aura_v1_genesis_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockedLot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aura_v1_genesis_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attestation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aura_v1_genesis_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReserveConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_aura_v1_genesis_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      5,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_aura_v1_genesis_proto_goTypes,
 		DependencyIndexes: file_aura_v1_genesis_proto_depIdxs,
+		EnumInfos:         file_aura_v1_genesis_proto_enumTypes,
 		MessageInfos:      file_aura_v1_genesis_proto_msgTypes,
 	}.Build()
 	File_aura_v1_genesis_proto = out.File
@@ -20,12 +20,37 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	Query_Denom_FullMethodName           = "/aura.v1.Query/Denom"
+	Query_Denoms_FullMethodName          = "/aura.v1.Query/Denoms"
 	Query_Paused_FullMethodName          = "/aura.v1.Query/Paused"
 	Query_Owner_FullMethodName           = "/aura.v1.Query/Owner"
 	Query_Burners_FullMethodName         = "/aura.v1.Query/Burners"
 	Query_Minters_FullMethodName         = "/aura.v1.Query/Minters"
 	Query_Pausers_FullMethodName         = "/aura.v1.Query/Pausers"
 	Query_BlockedChannels_FullMethodName = "/aura.v1.Query/BlockedChannels"
+	Query_PauseExemptions_FullMethodName = "/aura.v1.Query/PauseExemptions"
+	Query_ChannelMode_FullMethodName     = "/aura.v1.Query/ChannelMode"
+	Query_AllowedChannels_FullMethodName = "/aura.v1.Query/AllowedChannels"
+	Query_RateLimits_FullMethodName      = "/aura.v1.Query/RateLimits"
+	Query_RateLimitUsage_FullMethodName  = "/aura.v1.Query/RateLimitUsage"
+	Query_PriceSetters_FullMethodName    = "/aura.v1.Query/PriceSetters"
+	Query_PriceRanges_FullMethodName     = "/aura.v1.Query/PriceRanges"
+	Query_Price_FullMethodName           = "/aura.v1.Query/Price"
+	Query_Shares_FullMethodName          = "/aura.v1.Query/Shares"
+	Query_TotalShares_FullMethodName     = "/aura.v1.Query/TotalShares"
+	Query_Stablecoin_FullMethodName      = "/aura.v1.Query/Stablecoin"
+	Query_Subscription_FullMethodName    = "/aura.v1.Query/Subscription"
+	Query_Subscriptions_FullMethodName   = "/aura.v1.Query/Subscriptions"
+	Query_Redemption_FullMethodName      = "/aura.v1.Query/Redemption"
+	Query_Redemptions_FullMethodName     = "/aura.v1.Query/Redemptions"
+	Query_RedemptionQueue_FullMethodName = "/aura.v1.Query/RedemptionQueue"
+	Query_SwapFacility_FullMethodName    = "/aura.v1.Query/SwapFacility"
+	Query_SwapVolume_FullMethodName      = "/aura.v1.Query/SwapVolume"
+	Query_LockupPeriod_FullMethodName    = "/aura.v1.Query/LockupPeriod"
+	Query_LockedBalance_FullMethodName   = "/aura.v1.Query/LockedBalance"
+	Query_Attestors_FullMethodName       = "/aura.v1.Query/Attestors"
+	Query_Attestations_FullMethodName    = "/aura.v1.Query/Attestations"
+	Query_Reserves_FullMethodName        = "/aura.v1.Query/Reserves"
+	Query_Recoverers_FullMethodName      = "/aura.v1.Query/Recoverers"
 )
 
 // QueryClient is the client API for Query service.
@@ -33,12 +58,37 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type QueryClient interface {
 	Denom(ctx context.Context, in *QueryDenom, opts ...grpc.CallOption) (*QueryDenomResponse, error)
+	Denoms(ctx context.Context, in *QueryDenoms, opts ...grpc.CallOption) (*QueryDenomsResponse, error)
 	Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error)
 	Owner(ctx context.Context, in *QueryOwner, opts ...grpc.CallOption) (*QueryOwnerResponse, error)
 	Burners(ctx context.Context, in *QueryBurners, opts ...grpc.CallOption) (*QueryBurnersResponse, error)
 	Minters(ctx context.Context, in *QueryMinters, opts ...grpc.CallOption) (*QueryMintersResponse, error)
 	Pausers(ctx context.Context, in *QueryPausers, opts ...grpc.CallOption) (*QueryPausersResponse, error)
 	BlockedChannels(ctx context.Context, in *QueryBlockedChannels, opts ...grpc.CallOption) (*QueryBlockedChannelsResponse, error)
+	PauseExemptions(ctx context.Context, in *QueryPauseExemptions, opts ...grpc.CallOption) (*QueryPauseExemptionsResponse, error)
+	ChannelMode(ctx context.Context, in *QueryChannelMode, opts ...grpc.CallOption) (*QueryChannelModeResponse, error)
+	AllowedChannels(ctx context.Context, in *QueryAllowedChannels, opts ...grpc.CallOption) (*QueryAllowedChannelsResponse, error)
+	RateLimits(ctx context.Context, in *QueryRateLimits, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	RateLimitUsage(ctx context.Context, in *QueryRateLimitUsage, opts ...grpc.CallOption) (*QueryRateLimitUsageResponse, error)
+	PriceSetters(ctx context.Context, in *QueryPriceSetters, opts ...grpc.CallOption) (*QueryPriceSettersResponse, error)
+	PriceRanges(ctx context.Context, in *QueryPriceRanges, opts ...grpc.CallOption) (*QueryPriceRangesResponse, error)
+	Price(ctx context.Context, in *QueryPrice, opts ...grpc.CallOption) (*QueryPriceResponse, error)
+	Shares(ctx context.Context, in *QueryShares, opts ...grpc.CallOption) (*QuerySharesResponse, error)
+	TotalShares(ctx context.Context, in *QueryTotalShares, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error)
+	Stablecoin(ctx context.Context, in *QueryStablecoin, opts ...grpc.CallOption) (*QueryStablecoinResponse, error)
+	Subscription(ctx context.Context, in *QuerySubscription, opts ...grpc.CallOption) (*QuerySubscriptionResponse, error)
+	Subscriptions(ctx context.Context, in *QuerySubscriptions, opts ...grpc.CallOption) (*QuerySubscriptionsResponse, error)
+	Redemption(ctx context.Context, in *QueryRedemption, opts ...grpc.CallOption) (*QueryRedemptionResponse, error)
+	Redemptions(ctx context.Context, in *QueryRedemptions, opts ...grpc.CallOption) (*QueryRedemptionsResponse, error)
+	RedemptionQueue(ctx context.Context, in *QueryRedemptionQueue, opts ...grpc.CallOption) (*QueryRedemptionQueueResponse, error)
+	SwapFacility(ctx context.Context, in *QuerySwapFacility, opts ...grpc.CallOption) (*QuerySwapFacilityResponse, error)
+	SwapVolume(ctx context.Context, in *QuerySwapVolume, opts ...grpc.CallOption) (*QuerySwapVolumeResponse, error)
+	LockupPeriod(ctx context.Context, in *QueryLockupPeriod, opts ...grpc.CallOption) (*QueryLockupPeriodResponse, error)
+	LockedBalance(ctx context.Context, in *QueryLockedBalance, opts ...grpc.CallOption) (*QueryLockedBalanceResponse, error)
+	Attestors(ctx context.Context, in *QueryAttestors, opts ...grpc.CallOption) (*QueryAttestorsResponse, error)
+	Attestations(ctx context.Context, in *QueryAttestations, opts ...grpc.CallOption) (*QueryAttestationsResponse, error)
+	Reserves(ctx context.Context, in *QueryReserves, opts ...grpc.CallOption) (*QueryReservesResponse, error)
+	Recoverers(ctx context.Context, in *QueryRecoverers, opts ...grpc.CallOption) (*QueryRecoverersResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +109,16 @@ func (c *queryClient) Denom(ctx context.Context, in *QueryDenom, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *queryClient) Denoms(ctx context.Context, in *QueryDenoms, opts ...grpc.CallOption) (*QueryDenomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDenomsResponse)
+	err := c.cc.Invoke(ctx, Query_Denoms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryPausedResponse)
@@ -119,17 +179,282 @@ func (c *queryClient) BlockedChannels(ctx context.Context, in *QueryBlockedChann
 	return out, nil
 }
 
+func (c *queryClient) PauseExemptions(ctx context.Context, in *QueryPauseExemptions, opts ...grpc.CallOption) (*QueryPauseExemptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPauseExemptionsResponse)
+	err := c.cc.Invoke(ctx, Query_PauseExemptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelMode(ctx context.Context, in *QueryChannelMode, opts ...grpc.CallOption) (*QueryChannelModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryChannelModeResponse)
+	err := c.cc.Invoke(ctx, Query_ChannelMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedChannels(ctx context.Context, in *QueryAllowedChannels, opts ...grpc.CallOption) (*QueryAllowedChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllowedChannelsResponse)
+	err := c.cc.Invoke(ctx, Query_AllowedChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimits, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, Query_RateLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitUsage(ctx context.Context, in *QueryRateLimitUsage, opts ...grpc.CallOption) (*QueryRateLimitUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRateLimitUsageResponse)
+	err := c.cc.Invoke(ctx, Query_RateLimitUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceSetters(ctx context.Context, in *QueryPriceSetters, opts ...grpc.CallOption) (*QueryPriceSettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPriceSettersResponse)
+	err := c.cc.Invoke(ctx, Query_PriceSetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceRanges(ctx context.Context, in *QueryPriceRanges, opts ...grpc.CallOption) (*QueryPriceRangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPriceRangesResponse)
+	err := c.cc.Invoke(ctx, Query_PriceRanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Price(ctx context.Context, in *QueryPrice, opts ...grpc.CallOption) (*QueryPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPriceResponse)
+	err := c.cc.Invoke(ctx, Query_Price_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Shares(ctx context.Context, in *QueryShares, opts ...grpc.CallOption) (*QuerySharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySharesResponse)
+	err := c.cc.Invoke(ctx, Query_Shares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalShares(ctx context.Context, in *QueryTotalShares, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTotalSharesResponse)
+	err := c.cc.Invoke(ctx, Query_TotalShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Stablecoin(ctx context.Context, in *QueryStablecoin, opts ...grpc.CallOption) (*QueryStablecoinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStablecoinResponse)
+	err := c.cc.Invoke(ctx, Query_Stablecoin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Subscription(ctx context.Context, in *QuerySubscription, opts ...grpc.CallOption) (*QuerySubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySubscriptionResponse)
+	err := c.cc.Invoke(ctx, Query_Subscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Subscriptions(ctx context.Context, in *QuerySubscriptions, opts ...grpc.CallOption) (*QuerySubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySubscriptionsResponse)
+	err := c.cc.Invoke(ctx, Query_Subscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Redemption(ctx context.Context, in *QueryRedemption, opts ...grpc.CallOption) (*QueryRedemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRedemptionResponse)
+	err := c.cc.Invoke(ctx, Query_Redemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Redemptions(ctx context.Context, in *QueryRedemptions, opts ...grpc.CallOption) (*QueryRedemptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRedemptionsResponse)
+	err := c.cc.Invoke(ctx, Query_Redemptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedemptionQueue(ctx context.Context, in *QueryRedemptionQueue, opts ...grpc.CallOption) (*QueryRedemptionQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRedemptionQueueResponse)
+	err := c.cc.Invoke(ctx, Query_RedemptionQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwapFacility(ctx context.Context, in *QuerySwapFacility, opts ...grpc.CallOption) (*QuerySwapFacilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySwapFacilityResponse)
+	err := c.cc.Invoke(ctx, Query_SwapFacility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwapVolume(ctx context.Context, in *QuerySwapVolume, opts ...grpc.CallOption) (*QuerySwapVolumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySwapVolumeResponse)
+	err := c.cc.Invoke(ctx, Query_SwapVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockupPeriod(ctx context.Context, in *QueryLockupPeriod, opts ...grpc.CallOption) (*QueryLockupPeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryLockupPeriodResponse)
+	err := c.cc.Invoke(ctx, Query_LockupPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockedBalance(ctx context.Context, in *QueryLockedBalance, opts ...grpc.CallOption) (*QueryLockedBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryLockedBalanceResponse)
+	err := c.cc.Invoke(ctx, Query_LockedBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Attestors(ctx context.Context, in *QueryAttestors, opts ...grpc.CallOption) (*QueryAttestorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAttestorsResponse)
+	err := c.cc.Invoke(ctx, Query_Attestors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Attestations(ctx context.Context, in *QueryAttestations, opts ...grpc.CallOption) (*QueryAttestationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAttestationsResponse)
+	err := c.cc.Invoke(ctx, Query_Attestations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Reserves(ctx context.Context, in *QueryReserves, opts ...grpc.CallOption) (*QueryReservesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryReservesResponse)
+	err := c.cc.Invoke(ctx, Query_Reserves_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Recoverers(ctx context.Context, in *QueryRecoverers, opts ...grpc.CallOption) (*QueryRecoverersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRecoverersResponse)
+	err := c.cc.Invoke(ctx, Query_Recoverers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
 type QueryServer interface {
 	Denom(context.Context, *QueryDenom) (*QueryDenomResponse, error)
+	Denoms(context.Context, *QueryDenoms) (*QueryDenomsResponse, error)
 	Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error)
 	Owner(context.Context, *QueryOwner) (*QueryOwnerResponse, error)
 	Burners(context.Context, *QueryBurners) (*QueryBurnersResponse, error)
 	Minters(context.Context, *QueryMinters) (*QueryMintersResponse, error)
 	Pausers(context.Context, *QueryPausers) (*QueryPausersResponse, error)
 	BlockedChannels(context.Context, *QueryBlockedChannels) (*QueryBlockedChannelsResponse, error)
+	PauseExemptions(context.Context, *QueryPauseExemptions) (*QueryPauseExemptionsResponse, error)
+	ChannelMode(context.Context, *QueryChannelMode) (*QueryChannelModeResponse, error)
+	AllowedChannels(context.Context, *QueryAllowedChannels) (*QueryAllowedChannelsResponse, error)
+	RateLimits(context.Context, *QueryRateLimits) (*QueryRateLimitsResponse, error)
+	RateLimitUsage(context.Context, *QueryRateLimitUsage) (*QueryRateLimitUsageResponse, error)
+	PriceSetters(context.Context, *QueryPriceSetters) (*QueryPriceSettersResponse, error)
+	PriceRanges(context.Context, *QueryPriceRanges) (*QueryPriceRangesResponse, error)
+	Price(context.Context, *QueryPrice) (*QueryPriceResponse, error)
+	Shares(context.Context, *QueryShares) (*QuerySharesResponse, error)
+	TotalShares(context.Context, *QueryTotalShares) (*QueryTotalSharesResponse, error)
+	Stablecoin(context.Context, *QueryStablecoin) (*QueryStablecoinResponse, error)
+	Subscription(context.Context, *QuerySubscription) (*QuerySubscriptionResponse, error)
+	Subscriptions(context.Context, *QuerySubscriptions) (*QuerySubscriptionsResponse, error)
+	Redemption(context.Context, *QueryRedemption) (*QueryRedemptionResponse, error)
+	Redemptions(context.Context, *QueryRedemptions) (*QueryRedemptionsResponse, error)
+	RedemptionQueue(context.Context, *QueryRedemptionQueue) (*QueryRedemptionQueueResponse, error)
+	SwapFacility(context.Context, *QuerySwapFacility) (*QuerySwapFacilityResponse, error)
+	SwapVolume(context.Context, *QuerySwapVolume) (*QuerySwapVolumeResponse, error)
+	LockupPeriod(context.Context, *QueryLockupPeriod) (*QueryLockupPeriodResponse, error)
+	LockedBalance(context.Context, *QueryLockedBalance) (*QueryLockedBalanceResponse, error)
+	Attestors(context.Context, *QueryAttestors) (*QueryAttestorsResponse, error)
+	Attestations(context.Context, *QueryAttestations) (*QueryAttestationsResponse, error)
+	Reserves(context.Context, *QueryReserves) (*QueryReservesResponse, error)
+	Recoverers(context.Context, *QueryRecoverers) (*QueryRecoverersResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -143,6 +468,9 @@ type UnimplementedQueryServer struct{}
 func (UnimplementedQueryServer) Denom(context.Context, *QueryDenom) (*QueryDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Denom not implemented")
 }
+func (UnimplementedQueryServer) Denoms(context.Context, *QueryDenoms) (*QueryDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Denoms not implemented")
+}
 func (UnimplementedQueryServer) Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
 }
@@ -161,6 +489,78 @@ func (UnimplementedQueryServer) Pausers(context.Context, *QueryPausers) (*QueryP
 func (UnimplementedQueryServer) BlockedChannels(context.Context, *QueryBlockedChannels) (*QueryBlockedChannelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockedChannels not implemented")
 }
+func (UnimplementedQueryServer) PauseExemptions(context.Context, *QueryPauseExemptions) (*QueryPauseExemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseExemptions not implemented")
+}
+func (UnimplementedQueryServer) ChannelMode(context.Context, *QueryChannelMode) (*QueryChannelModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelMode not implemented")
+}
+func (UnimplementedQueryServer) AllowedChannels(context.Context, *QueryAllowedChannels) (*QueryAllowedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedChannels not implemented")
+}
+func (UnimplementedQueryServer) RateLimits(context.Context, *QueryRateLimits) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (UnimplementedQueryServer) RateLimitUsage(context.Context, *QueryRateLimitUsage) (*QueryRateLimitUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitUsage not implemented")
+}
+func (UnimplementedQueryServer) PriceSetters(context.Context, *QueryPriceSetters) (*QueryPriceSettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceSetters not implemented")
+}
+func (UnimplementedQueryServer) PriceRanges(context.Context, *QueryPriceRanges) (*QueryPriceRangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceRanges not implemented")
+}
+func (UnimplementedQueryServer) Price(context.Context, *QueryPrice) (*QueryPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Price not implemented")
+}
+func (UnimplementedQueryServer) Shares(context.Context, *QueryShares) (*QuerySharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Shares not implemented")
+}
+func (UnimplementedQueryServer) TotalShares(context.Context, *QueryTotalShares) (*QueryTotalSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalShares not implemented")
+}
+func (UnimplementedQueryServer) Stablecoin(context.Context, *QueryStablecoin) (*QueryStablecoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stablecoin not implemented")
+}
+func (UnimplementedQueryServer) Subscription(context.Context, *QuerySubscription) (*QuerySubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscription not implemented")
+}
+func (UnimplementedQueryServer) Subscriptions(context.Context, *QuerySubscriptions) (*QuerySubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscriptions not implemented")
+}
+func (UnimplementedQueryServer) Redemption(context.Context, *QueryRedemption) (*QueryRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redemption not implemented")
+}
+func (UnimplementedQueryServer) Redemptions(context.Context, *QueryRedemptions) (*QueryRedemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redemptions not implemented")
+}
+func (UnimplementedQueryServer) RedemptionQueue(context.Context, *QueryRedemptionQueue) (*QueryRedemptionQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionQueue not implemented")
+}
+func (UnimplementedQueryServer) SwapFacility(context.Context, *QuerySwapFacility) (*QuerySwapFacilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapFacility not implemented")
+}
+func (UnimplementedQueryServer) SwapVolume(context.Context, *QuerySwapVolume) (*QuerySwapVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapVolume not implemented")
+}
+func (UnimplementedQueryServer) LockupPeriod(context.Context, *QueryLockupPeriod) (*QueryLockupPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockupPeriod not implemented")
+}
+func (UnimplementedQueryServer) LockedBalance(context.Context, *QueryLockedBalance) (*QueryLockedBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockedBalance not implemented")
+}
+func (UnimplementedQueryServer) Attestors(context.Context, *QueryAttestors) (*QueryAttestorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestors not implemented")
+}
+func (UnimplementedQueryServer) Attestations(context.Context, *QueryAttestations) (*QueryAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestations not implemented")
+}
+func (UnimplementedQueryServer) Reserves(context.Context, *QueryReserves) (*QueryReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reserves not implemented")
+}
+func (UnimplementedQueryServer) Recoverers(context.Context, *QueryRecoverers) (*QueryRecoverersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recoverers not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -200,6 +600,24 @@ func _Query_Denom_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Denoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Denoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Denoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Denoms(ctx, req.(*QueryDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPaused)
 	if err := dec(in); err != nil {
@@ -308,40 +726,572 @@ func _Query_BlockedChannels_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Query_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "aura.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Denom",
-			Handler:    _Query_Denom_Handler,
-		},
-		{
-			MethodName: "Paused",
-			Handler:    _Query_Paused_Handler,
-		},
-		{
-			MethodName: "Owner",
-			Handler:    _Query_Owner_Handler,
-		},
-		{
-			MethodName: "Burners",
-			Handler:    _Query_Burners_Handler,
-		},
-		{
-			MethodName: "Minters",
-			Handler:    _Query_Minters_Handler,
-		},
-		{
-			MethodName: "Pausers",
-			Handler:    _Query_Pausers_Handler,
-		},
-		{
-			MethodName: "BlockedChannels",
-			Handler:    _Query_BlockedChannels_Handler,
+func _Query_PauseExemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseExemptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PauseExemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PauseExemptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PauseExemptions(ctx, req.(*QueryPauseExemptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ChannelMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelMode(ctx, req.(*QueryChannelMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedChannels)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllowedChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedChannels(ctx, req.(*QueryAllowedChannels))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RateLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitUsage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RateLimitUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitUsage(ctx, req.(*QueryRateLimitUsage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceSetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceSetters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceSetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceSetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceSetters(ctx, req.(*QueryPriceSetters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceRanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceRanges)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceRanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceRanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceRanges(ctx, req.(*QueryPriceRanges))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Price_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Price(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Price_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Price(ctx, req.(*QueryPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Shares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Shares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Shares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Shares(ctx, req.(*QueryShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalShares(ctx, req.(*QueryTotalShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stablecoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStablecoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stablecoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Stablecoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stablecoin(ctx, req.(*QueryStablecoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Subscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Subscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Subscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Subscription(ctx, req.(*QuerySubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Subscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubscriptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Subscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Subscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Subscriptions(ctx, req.(*QuerySubscriptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Redemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Redemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Redemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Redemption(ctx, req.(*QueryRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Redemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Redemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Redemptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Redemptions(ctx, req.(*QueryRedemptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedemptionQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionQueue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RedemptionQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionQueue(ctx, req.(*QueryRedemptionQueue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapFacility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapFacility)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapFacility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SwapFacility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapFacility(ctx, req.(*QuerySwapFacility))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapVolume)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SwapVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapVolume(ctx, req.(*QuerySwapVolume))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockupPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockupPeriod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockupPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LockupPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockupPeriod(ctx, req.(*QueryLockupPeriod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockedBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockedBalance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockedBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LockedBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockedBalance(ctx, req.(*QueryLockedBalance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Attestors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestors)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attestors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Attestors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestors(ctx, req.(*QueryAttestors))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Attestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestations)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Attestations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestations(ctx, req.(*QueryAttestations))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Reserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReserves)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Reserves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Reserves_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reserves(ctx, req.(*QueryReserves))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Recoverers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecoverers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Recoverers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Recoverers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Recoverers(ctx, req.(*QueryRecoverers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "aura.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Denom",
+			Handler:    _Query_Denom_Handler,
+		},
+		{
+			MethodName: "Denoms",
+			Handler:    _Query_Denoms_Handler,
+		},
+		{
+			MethodName: "Paused",
+			Handler:    _Query_Paused_Handler,
+		},
+		{
+			MethodName: "Owner",
+			Handler:    _Query_Owner_Handler,
+		},
+		{
+			MethodName: "Burners",
+			Handler:    _Query_Burners_Handler,
+		},
+		{
+			MethodName: "Minters",
+			Handler:    _Query_Minters_Handler,
+		},
+		{
+			MethodName: "Pausers",
+			Handler:    _Query_Pausers_Handler,
+		},
+		{
+			MethodName: "BlockedChannels",
+			Handler:    _Query_BlockedChannels_Handler,
+		},
+		{
+			MethodName: "PauseExemptions",
+			Handler:    _Query_PauseExemptions_Handler,
+		},
+		{
+			MethodName: "ChannelMode",
+			Handler:    _Query_ChannelMode_Handler,
+		},
+		{
+			MethodName: "AllowedChannels",
+			Handler:    _Query_AllowedChannels_Handler,
+		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "RateLimitUsage",
+			Handler:    _Query_RateLimitUsage_Handler,
+		},
+		{
+			MethodName: "PriceSetters",
+			Handler:    _Query_PriceSetters_Handler,
+		},
+		{
+			MethodName: "PriceRanges",
+			Handler:    _Query_PriceRanges_Handler,
+		},
+		{
+			MethodName: "Price",
+			Handler:    _Query_Price_Handler,
+		},
+		{
+			MethodName: "Shares",
+			Handler:    _Query_Shares_Handler,
+		},
+		{
+			MethodName: "TotalShares",
+			Handler:    _Query_TotalShares_Handler,
+		},
+		{
+			MethodName: "Stablecoin",
+			Handler:    _Query_Stablecoin_Handler,
+		},
+		{
+			MethodName: "Subscription",
+			Handler:    _Query_Subscription_Handler,
+		},
+		{
+			MethodName: "Subscriptions",
+			Handler:    _Query_Subscriptions_Handler,
+		},
+		{
+			MethodName: "Redemption",
+			Handler:    _Query_Redemption_Handler,
+		},
+		{
+			MethodName: "Redemptions",
+			Handler:    _Query_Redemptions_Handler,
+		},
+		{
+			MethodName: "RedemptionQueue",
+			Handler:    _Query_RedemptionQueue_Handler,
+		},
+		{
+			MethodName: "SwapFacility",
+			Handler:    _Query_SwapFacility_Handler,
+		},
+		{
+			MethodName: "SwapVolume",
+			Handler:    _Query_SwapVolume_Handler,
+		},
+		{
+			MethodName: "LockupPeriod",
+			Handler:    _Query_LockupPeriod_Handler,
+		},
+		{
+			MethodName: "LockedBalance",
+			Handler:    _Query_LockedBalance_Handler,
+		},
+		{
+			MethodName: "Attestors",
+			Handler:    _Query_Attestors_Handler,
+		},
+		{
+			MethodName: "Attestations",
+			Handler:    _Query_Attestations_Handler,
+		},
+		{
+			MethodName: "Reserves",
+			Handler:    _Query_Reserves_Handler,
+		},
+		{
+			MethodName: "Recoverers",
+			Handler:    _Query_Recoverers_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
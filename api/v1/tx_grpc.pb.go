@@ -19,22 +19,70 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_Burn_FullMethodName                 = "/aura.v1.Msg/Burn"
-	Msg_Mint_FullMethodName                 = "/aura.v1.Msg/Mint"
-	Msg_Pause_FullMethodName                = "/aura.v1.Msg/Pause"
-	Msg_Unpause_FullMethodName              = "/aura.v1.Msg/Unpause"
-	Msg_TransferOwnership_FullMethodName    = "/aura.v1.Msg/TransferOwnership"
-	Msg_AcceptOwnership_FullMethodName      = "/aura.v1.Msg/AcceptOwnership"
-	Msg_AddBurner_FullMethodName            = "/aura.v1.Msg/AddBurner"
-	Msg_RemoveBurner_FullMethodName         = "/aura.v1.Msg/RemoveBurner"
-	Msg_SetBurnerAllowance_FullMethodName   = "/aura.v1.Msg/SetBurnerAllowance"
-	Msg_AddMinter_FullMethodName            = "/aura.v1.Msg/AddMinter"
-	Msg_RemoveMinter_FullMethodName         = "/aura.v1.Msg/RemoveMinter"
-	Msg_SetMinterAllowance_FullMethodName   = "/aura.v1.Msg/SetMinterAllowance"
-	Msg_AddPauser_FullMethodName            = "/aura.v1.Msg/AddPauser"
-	Msg_RemovePauser_FullMethodName         = "/aura.v1.Msg/RemovePauser"
-	Msg_AddBlockedChannel_FullMethodName    = "/aura.v1.Msg/AddBlockedChannel"
-	Msg_RemoveBlockedChannel_FullMethodName = "/aura.v1.Msg/RemoveBlockedChannel"
+	Msg_Burn_FullMethodName                    = "/aura.v1.Msg/Burn"
+	Msg_Mint_FullMethodName                    = "/aura.v1.Msg/Mint"
+	Msg_Pause_FullMethodName                   = "/aura.v1.Msg/Pause"
+	Msg_Unpause_FullMethodName                 = "/aura.v1.Msg/Unpause"
+	Msg_SchedulePause_FullMethodName           = "/aura.v1.Msg/SchedulePause"
+	Msg_TransferOwnership_FullMethodName       = "/aura.v1.Msg/TransferOwnership"
+	Msg_AcceptOwnership_FullMethodName         = "/aura.v1.Msg/AcceptOwnership"
+	Msg_ForceOwnership_FullMethodName          = "/aura.v1.Msg/ForceOwnership"
+	Msg_AddBurner_FullMethodName               = "/aura.v1.Msg/AddBurner"
+	Msg_RemoveBurner_FullMethodName            = "/aura.v1.Msg/RemoveBurner"
+	Msg_SetBurnerAllowance_FullMethodName      = "/aura.v1.Msg/SetBurnerAllowance"
+	Msg_IncreaseBurnerAllowance_FullMethodName = "/aura.v1.Msg/IncreaseBurnerAllowance"
+	Msg_DecreaseBurnerAllowance_FullMethodName = "/aura.v1.Msg/DecreaseBurnerAllowance"
+	Msg_AddBurnerSource_FullMethodName         = "/aura.v1.Msg/AddBurnerSource"
+	Msg_RemoveBurnerSource_FullMethodName      = "/aura.v1.Msg/RemoveBurnerSource"
+	Msg_AddMinter_FullMethodName               = "/aura.v1.Msg/AddMinter"
+	Msg_RemoveMinter_FullMethodName            = "/aura.v1.Msg/RemoveMinter"
+	Msg_SetMinterAllowance_FullMethodName      = "/aura.v1.Msg/SetMinterAllowance"
+	Msg_IncreaseMinterAllowance_FullMethodName = "/aura.v1.Msg/IncreaseMinterAllowance"
+	Msg_DecreaseMinterAllowance_FullMethodName = "/aura.v1.Msg/DecreaseMinterAllowance"
+	Msg_AddMinterRecipient_FullMethodName      = "/aura.v1.Msg/AddMinterRecipient"
+	Msg_RemoveMinterRecipient_FullMethodName   = "/aura.v1.Msg/RemoveMinterRecipient"
+	Msg_AddPauser_FullMethodName               = "/aura.v1.Msg/AddPauser"
+	Msg_RemovePauser_FullMethodName            = "/aura.v1.Msg/RemovePauser"
+	Msg_AddBlockedChannel_FullMethodName       = "/aura.v1.Msg/AddBlockedChannel"
+	Msg_RemoveBlockedChannel_FullMethodName    = "/aura.v1.Msg/RemoveBlockedChannel"
+	Msg_AddPauseExemption_FullMethodName       = "/aura.v1.Msg/AddPauseExemption"
+	Msg_RemovePauseExemption_FullMethodName    = "/aura.v1.Msg/RemovePauseExemption"
+	Msg_SetChannelMode_FullMethodName          = "/aura.v1.Msg/SetChannelMode"
+	Msg_AddAllowedChannel_FullMethodName       = "/aura.v1.Msg/AddAllowedChannel"
+	Msg_RemoveAllowedChannel_FullMethodName    = "/aura.v1.Msg/RemoveAllowedChannel"
+	Msg_SetRateLimit_FullMethodName            = "/aura.v1.Msg/SetRateLimit"
+	Msg_RemoveRateLimit_FullMethodName         = "/aura.v1.Msg/RemoveRateLimit"
+	Msg_AddPriceSetter_FullMethodName          = "/aura.v1.Msg/AddPriceSetter"
+	Msg_RemovePriceSetter_FullMethodName       = "/aura.v1.Msg/RemovePriceSetter"
+	Msg_SetPriceRange_FullMethodName           = "/aura.v1.Msg/SetPriceRange"
+	Msg_SetMaxPriceDeviation_FullMethodName    = "/aura.v1.Msg/SetMaxPriceDeviation"
+	Msg_Wrap_FullMethodName                    = "/aura.v1.Msg/Wrap"
+	Msg_Unwrap_FullMethodName                  = "/aura.v1.Msg/Unwrap"
+	Msg_TransferRebasing_FullMethodName        = "/aura.v1.Msg/TransferRebasing"
+	Msg_SetStablecoin_FullMethodName           = "/aura.v1.Msg/SetStablecoin"
+	Msg_RequestSubscription_FullMethodName     = "/aura.v1.Msg/RequestSubscription"
+	Msg_FulfillSubscription_FullMethodName     = "/aura.v1.Msg/FulfillSubscription"
+	Msg_RejectSubscription_FullMethodName      = "/aura.v1.Msg/RejectSubscription"
+	Msg_RequestRedemption_FullMethodName       = "/aura.v1.Msg/RequestRedemption"
+	Msg_CancelRedemption_FullMethodName        = "/aura.v1.Msg/CancelRedemption"
+	Msg_SettleRedemption_FullMethodName        = "/aura.v1.Msg/SettleRedemption"
+	Msg_RejectRedemption_FullMethodName        = "/aura.v1.Msg/RejectRedemption"
+	Msg_Swap_FullMethodName                    = "/aura.v1.Msg/Swap"
+	Msg_SetSwapConfig_FullMethodName           = "/aura.v1.Msg/SetSwapConfig"
+	Msg_FundSwapFacility_FullMethodName        = "/aura.v1.Msg/FundSwapFacility"
+	Msg_WithdrawSwapFacility_FullMethodName    = "/aura.v1.Msg/WithdrawSwapFacility"
+	Msg_PauseSwaps_FullMethodName              = "/aura.v1.Msg/PauseSwaps"
+	Msg_UnpauseSwaps_FullMethodName            = "/aura.v1.Msg/UnpauseSwaps"
+	Msg_SetLockupPeriod_FullMethodName         = "/aura.v1.Msg/SetLockupPeriod"
+	Msg_SetBlocklistBinding_FullMethodName     = "/aura.v1.Msg/SetBlocklistBinding"
+	Msg_SetDenomMetadata_FullMethodName        = "/aura.v1.Msg/SetDenomMetadata"
+	Msg_AddAttestor_FullMethodName             = "/aura.v1.Msg/AddAttestor"
+	Msg_RemoveAttestor_FullMethodName          = "/aura.v1.Msg/RemoveAttestor"
+	Msg_PostAttestation_FullMethodName         = "/aura.v1.Msg/PostAttestation"
+	Msg_SetReserveConfig_FullMethodName        = "/aura.v1.Msg/SetReserveConfig"
+	Msg_AddRecoverer_FullMethodName            = "/aura.v1.Msg/AddRecoverer"
+	Msg_RemoveRecoverer_FullMethodName         = "/aura.v1.Msg/RemoveRecoverer"
+	Msg_ForceTransfer_FullMethodName           = "/aura.v1.Msg/ForceTransfer"
 )
 
 // MsgClient is the client API for Msg service.
@@ -45,18 +93,66 @@ type MsgClient interface {
 	Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error)
 	Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error)
 	Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error)
+	SchedulePause(ctx context.Context, in *MsgSchedulePause, opts ...grpc.CallOption) (*MsgSchedulePauseResponse, error)
 	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
 	AcceptOwnership(ctx context.Context, in *MsgAcceptOwnership, opts ...grpc.CallOption) (*MsgAcceptOwnershipResponse, error)
+	ForceOwnership(ctx context.Context, in *MsgForceOwnership, opts ...grpc.CallOption) (*MsgForceOwnershipResponse, error)
 	AddBurner(ctx context.Context, in *MsgAddBurner, opts ...grpc.CallOption) (*MsgAddBurnerResponse, error)
 	RemoveBurner(ctx context.Context, in *MsgRemoveBurner, opts ...grpc.CallOption) (*MsgRemoveBurnerResponse, error)
 	SetBurnerAllowance(ctx context.Context, in *MsgSetBurnerAllowance, opts ...grpc.CallOption) (*MsgSetBurnerAllowanceResponse, error)
+	IncreaseBurnerAllowance(ctx context.Context, in *MsgIncreaseBurnerAllowance, opts ...grpc.CallOption) (*MsgIncreaseBurnerAllowanceResponse, error)
+	DecreaseBurnerAllowance(ctx context.Context, in *MsgDecreaseBurnerAllowance, opts ...grpc.CallOption) (*MsgDecreaseBurnerAllowanceResponse, error)
+	AddBurnerSource(ctx context.Context, in *MsgAddBurnerSource, opts ...grpc.CallOption) (*MsgAddBurnerSourceResponse, error)
+	RemoveBurnerSource(ctx context.Context, in *MsgRemoveBurnerSource, opts ...grpc.CallOption) (*MsgRemoveBurnerSourceResponse, error)
 	AddMinter(ctx context.Context, in *MsgAddMinter, opts ...grpc.CallOption) (*MsgAddMinterResponse, error)
 	RemoveMinter(ctx context.Context, in *MsgRemoveMinter, opts ...grpc.CallOption) (*MsgRemoveMinterResponse, error)
 	SetMinterAllowance(ctx context.Context, in *MsgSetMinterAllowance, opts ...grpc.CallOption) (*MsgSetMinterAllowanceResponse, error)
+	IncreaseMinterAllowance(ctx context.Context, in *MsgIncreaseMinterAllowance, opts ...grpc.CallOption) (*MsgIncreaseMinterAllowanceResponse, error)
+	DecreaseMinterAllowance(ctx context.Context, in *MsgDecreaseMinterAllowance, opts ...grpc.CallOption) (*MsgDecreaseMinterAllowanceResponse, error)
+	AddMinterRecipient(ctx context.Context, in *MsgAddMinterRecipient, opts ...grpc.CallOption) (*MsgAddMinterRecipientResponse, error)
+	RemoveMinterRecipient(ctx context.Context, in *MsgRemoveMinterRecipient, opts ...grpc.CallOption) (*MsgRemoveMinterRecipientResponse, error)
 	AddPauser(ctx context.Context, in *MsgAddPauser, opts ...grpc.CallOption) (*MsgAddPauserResponse, error)
 	RemovePauser(ctx context.Context, in *MsgRemovePauser, opts ...grpc.CallOption) (*MsgRemovePauserResponse, error)
 	AddBlockedChannel(ctx context.Context, in *MsgAddBlockedChannel, opts ...grpc.CallOption) (*MsgAddBlockedChannelResponse, error)
 	RemoveBlockedChannel(ctx context.Context, in *MsgRemoveBlockedChannel, opts ...grpc.CallOption) (*MsgRemoveBlockedChannelResponse, error)
+	AddPauseExemption(ctx context.Context, in *MsgAddPauseExemption, opts ...grpc.CallOption) (*MsgAddPauseExemptionResponse, error)
+	RemovePauseExemption(ctx context.Context, in *MsgRemovePauseExemption, opts ...grpc.CallOption) (*MsgRemovePauseExemptionResponse, error)
+	SetChannelMode(ctx context.Context, in *MsgSetChannelMode, opts ...grpc.CallOption) (*MsgSetChannelModeResponse, error)
+	AddAllowedChannel(ctx context.Context, in *MsgAddAllowedChannel, opts ...grpc.CallOption) (*MsgAddAllowedChannelResponse, error)
+	RemoveAllowedChannel(ctx context.Context, in *MsgRemoveAllowedChannel, opts ...grpc.CallOption) (*MsgRemoveAllowedChannelResponse, error)
+	SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error)
+	RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error)
+	AddPriceSetter(ctx context.Context, in *MsgAddPriceSetter, opts ...grpc.CallOption) (*MsgAddPriceSetterResponse, error)
+	RemovePriceSetter(ctx context.Context, in *MsgRemovePriceSetter, opts ...grpc.CallOption) (*MsgRemovePriceSetterResponse, error)
+	SetPriceRange(ctx context.Context, in *MsgSetPriceRange, opts ...grpc.CallOption) (*MsgSetPriceRangeResponse, error)
+	SetMaxPriceDeviation(ctx context.Context, in *MsgSetMaxPriceDeviation, opts ...grpc.CallOption) (*MsgSetMaxPriceDeviationResponse, error)
+	Wrap(ctx context.Context, in *MsgWrap, opts ...grpc.CallOption) (*MsgWrapResponse, error)
+	Unwrap(ctx context.Context, in *MsgUnwrap, opts ...grpc.CallOption) (*MsgUnwrapResponse, error)
+	TransferRebasing(ctx context.Context, in *MsgTransferRebasing, opts ...grpc.CallOption) (*MsgTransferRebasingResponse, error)
+	SetStablecoin(ctx context.Context, in *MsgSetStablecoin, opts ...grpc.CallOption) (*MsgSetStablecoinResponse, error)
+	RequestSubscription(ctx context.Context, in *MsgRequestSubscription, opts ...grpc.CallOption) (*MsgRequestSubscriptionResponse, error)
+	FulfillSubscription(ctx context.Context, in *MsgFulfillSubscription, opts ...grpc.CallOption) (*MsgFulfillSubscriptionResponse, error)
+	RejectSubscription(ctx context.Context, in *MsgRejectSubscription, opts ...grpc.CallOption) (*MsgRejectSubscriptionResponse, error)
+	RequestRedemption(ctx context.Context, in *MsgRequestRedemption, opts ...grpc.CallOption) (*MsgRequestRedemptionResponse, error)
+	CancelRedemption(ctx context.Context, in *MsgCancelRedemption, opts ...grpc.CallOption) (*MsgCancelRedemptionResponse, error)
+	SettleRedemption(ctx context.Context, in *MsgSettleRedemption, opts ...grpc.CallOption) (*MsgSettleRedemptionResponse, error)
+	RejectRedemption(ctx context.Context, in *MsgRejectRedemption, opts ...grpc.CallOption) (*MsgRejectRedemptionResponse, error)
+	Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error)
+	SetSwapConfig(ctx context.Context, in *MsgSetSwapConfig, opts ...grpc.CallOption) (*MsgSetSwapConfigResponse, error)
+	FundSwapFacility(ctx context.Context, in *MsgFundSwapFacility, opts ...grpc.CallOption) (*MsgFundSwapFacilityResponse, error)
+	WithdrawSwapFacility(ctx context.Context, in *MsgWithdrawSwapFacility, opts ...grpc.CallOption) (*MsgWithdrawSwapFacilityResponse, error)
+	PauseSwaps(ctx context.Context, in *MsgPauseSwaps, opts ...grpc.CallOption) (*MsgPauseSwapsResponse, error)
+	UnpauseSwaps(ctx context.Context, in *MsgUnpauseSwaps, opts ...grpc.CallOption) (*MsgUnpauseSwapsResponse, error)
+	SetLockupPeriod(ctx context.Context, in *MsgSetLockupPeriod, opts ...grpc.CallOption) (*MsgSetLockupPeriodResponse, error)
+	SetBlocklistBinding(ctx context.Context, in *MsgSetBlocklistBinding, opts ...grpc.CallOption) (*MsgSetBlocklistBindingResponse, error)
+	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
+	AddAttestor(ctx context.Context, in *MsgAddAttestor, opts ...grpc.CallOption) (*MsgAddAttestorResponse, error)
+	RemoveAttestor(ctx context.Context, in *MsgRemoveAttestor, opts ...grpc.CallOption) (*MsgRemoveAttestorResponse, error)
+	PostAttestation(ctx context.Context, in *MsgPostAttestation, opts ...grpc.CallOption) (*MsgPostAttestationResponse, error)
+	SetReserveConfig(ctx context.Context, in *MsgSetReserveConfig, opts ...grpc.CallOption) (*MsgSetReserveConfigResponse, error)
+	AddRecoverer(ctx context.Context, in *MsgAddRecoverer, opts ...grpc.CallOption) (*MsgAddRecovererResponse, error)
+	RemoveRecoverer(ctx context.Context, in *MsgRemoveRecoverer, opts ...grpc.CallOption) (*MsgRemoveRecovererResponse, error)
+	ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error)
 }
 
 type msgClient struct {
@@ -107,6 +203,16 @@ func (c *msgClient) Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) SchedulePause(ctx context.Context, in *MsgSchedulePause, opts ...grpc.CallOption) (*MsgSchedulePauseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSchedulePauseResponse)
+	err := c.cc.Invoke(ctx, Msg_SchedulePause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgTransferOwnershipResponse)
@@ -127,6 +233,16 @@ func (c *msgClient) AcceptOwnership(ctx context.Context, in *MsgAcceptOwnership,
 	return out, nil
 }
 
+func (c *msgClient) ForceOwnership(ctx context.Context, in *MsgForceOwnership, opts ...grpc.CallOption) (*MsgForceOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgForceOwnershipResponse)
+	err := c.cc.Invoke(ctx, Msg_ForceOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AddBurner(ctx context.Context, in *MsgAddBurner, opts ...grpc.CallOption) (*MsgAddBurnerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgAddBurnerResponse)
@@ -157,6 +273,46 @@ func (c *msgClient) SetBurnerAllowance(ctx context.Context, in *MsgSetBurnerAllo
 	return out, nil
 }
 
+func (c *msgClient) IncreaseBurnerAllowance(ctx context.Context, in *MsgIncreaseBurnerAllowance, opts ...grpc.CallOption) (*MsgIncreaseBurnerAllowanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgIncreaseBurnerAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_IncreaseBurnerAllowance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DecreaseBurnerAllowance(ctx context.Context, in *MsgDecreaseBurnerAllowance, opts ...grpc.CallOption) (*MsgDecreaseBurnerAllowanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDecreaseBurnerAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_DecreaseBurnerAllowance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddBurnerSource(ctx context.Context, in *MsgAddBurnerSource, opts ...grpc.CallOption) (*MsgAddBurnerSourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAddBurnerSourceResponse)
+	err := c.cc.Invoke(ctx, Msg_AddBurnerSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveBurnerSource(ctx context.Context, in *MsgRemoveBurnerSource, opts ...grpc.CallOption) (*MsgRemoveBurnerSourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveBurnerSourceResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveBurnerSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AddMinter(ctx context.Context, in *MsgAddMinter, opts ...grpc.CallOption) (*MsgAddMinterResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgAddMinterResponse)
@@ -187,6 +343,46 @@ func (c *msgClient) SetMinterAllowance(ctx context.Context, in *MsgSetMinterAllo
 	return out, nil
 }
 
+func (c *msgClient) IncreaseMinterAllowance(ctx context.Context, in *MsgIncreaseMinterAllowance, opts ...grpc.CallOption) (*MsgIncreaseMinterAllowanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgIncreaseMinterAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_IncreaseMinterAllowance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DecreaseMinterAllowance(ctx context.Context, in *MsgDecreaseMinterAllowance, opts ...grpc.CallOption) (*MsgDecreaseMinterAllowanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDecreaseMinterAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_DecreaseMinterAllowance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddMinterRecipient(ctx context.Context, in *MsgAddMinterRecipient, opts ...grpc.CallOption) (*MsgAddMinterRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAddMinterRecipientResponse)
+	err := c.cc.Invoke(ctx, Msg_AddMinterRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveMinterRecipient(ctx context.Context, in *MsgRemoveMinterRecipient, opts ...grpc.CallOption) (*MsgRemoveMinterRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveMinterRecipientResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveMinterRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AddPauser(ctx context.Context, in *MsgAddPauser, opts ...grpc.CallOption) (*MsgAddPauserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgAddPauserResponse)
@@ -227,389 +423,1825 @@ func (c *msgClient) RemoveBlockedChannel(ctx context.Context, in *MsgRemoveBlock
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-// All implementations must embed UnimplementedMsgServer
-// for forward compatibility.
-type MsgServer interface {
-	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
-	Mint(context.Context, *MsgMint) (*MsgMintResponse, error)
-	Pause(context.Context, *MsgPause) (*MsgPauseResponse, error)
-	Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error)
-	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
-	AcceptOwnership(context.Context, *MsgAcceptOwnership) (*MsgAcceptOwnershipResponse, error)
-	AddBurner(context.Context, *MsgAddBurner) (*MsgAddBurnerResponse, error)
-	RemoveBurner(context.Context, *MsgRemoveBurner) (*MsgRemoveBurnerResponse, error)
-	SetBurnerAllowance(context.Context, *MsgSetBurnerAllowance) (*MsgSetBurnerAllowanceResponse, error)
-	AddMinter(context.Context, *MsgAddMinter) (*MsgAddMinterResponse, error)
-	RemoveMinter(context.Context, *MsgRemoveMinter) (*MsgRemoveMinterResponse, error)
-	SetMinterAllowance(context.Context, *MsgSetMinterAllowance) (*MsgSetMinterAllowanceResponse, error)
-	AddPauser(context.Context, *MsgAddPauser) (*MsgAddPauserResponse, error)
-	RemovePauser(context.Context, *MsgRemovePauser) (*MsgRemovePauserResponse, error)
-	AddBlockedChannel(context.Context, *MsgAddBlockedChannel) (*MsgAddBlockedChannelResponse, error)
-	RemoveBlockedChannel(context.Context, *MsgRemoveBlockedChannel) (*MsgRemoveBlockedChannelResponse, error)
-	mustEmbedUnimplementedMsgServer()
+func (c *msgClient) AddPauseExemption(ctx context.Context, in *MsgAddPauseExemption, opts ...grpc.CallOption) (*MsgAddPauseExemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAddPauseExemptionResponse)
+	err := c.cc.Invoke(ctx, Msg_AddPauseExemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedMsgServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedMsgServer struct{}
+func (c *msgClient) RemovePauseExemption(ctx context.Context, in *MsgRemovePauseExemption, opts ...grpc.CallOption) (*MsgRemovePauseExemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemovePauseExemptionResponse)
+	err := c.cc.Invoke(ctx, Msg_RemovePauseExemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedMsgServer) Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+func (c *msgClient) SetChannelMode(ctx context.Context, in *MsgSetChannelMode, opts ...grpc.CallOption) (*MsgSetChannelModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetChannelModeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetChannelMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMsgServer) Mint(context.Context, *MsgMint) (*MsgMintResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+
+func (c *msgClient) AddAllowedChannel(ctx context.Context, in *MsgAddAllowedChannel, opts ...grpc.CallOption) (*MsgAddAllowedChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAddAllowedChannelResponse)
+	err := c.cc.Invoke(ctx, Msg_AddAllowedChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMsgServer) Pause(context.Context, *MsgPause) (*MsgPauseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+
+func (c *msgClient) RemoveAllowedChannel(ctx context.Context, in *MsgRemoveAllowedChannel, opts ...grpc.CallOption) (*MsgRemoveAllowedChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveAllowedChannelResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAllowedChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMsgServer) Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unpause not implemented")
+
+func (c *msgClient) SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMsgServer) TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+
+func (c *msgClient) RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMsgServer) AcceptOwnership(context.Context, *MsgAcceptOwnership) (*MsgAcceptOwnershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcceptOwnership not implemented")
+
+func (c *msgClient) AddPriceSetter(ctx context.Context, in *MsgAddPriceSetter, opts ...grpc.CallOption) (*MsgAddPriceSetterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAddPriceSetterResponse)
+	err := c.cc.Invoke(ctx, Msg_AddPriceSetter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMsgServer) AddBurner(context.Context, *MsgAddBurner) (*MsgAddBurnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddBurner not implemented")
+
+func (c *msgClient) RemovePriceSetter(ctx context.Context, in *MsgRemovePriceSetter, opts ...grpc.CallOption) (*MsgRemovePriceSetterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemovePriceSetterResponse)
+	err := c.cc.Invoke(ctx, Msg_RemovePriceSetter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMsgServer) RemoveBurner(context.Context, *MsgRemoveBurner) (*MsgRemoveBurnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveBurner not implemented")
+
+func (c *msgClient) SetPriceRange(ctx context.Context, in *MsgSetPriceRange, opts ...grpc.CallOption) (*MsgSetPriceRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetPriceRangeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPriceRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMsgServer) SetBurnerAllowance(context.Context, *MsgSetBurnerAllowance) (*MsgSetBurnerAllowanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetBurnerAllowance not implemented")
+
+func (c *msgClient) SetMaxPriceDeviation(ctx context.Context, in *MsgSetMaxPriceDeviation, opts ...grpc.CallOption) (*MsgSetMaxPriceDeviationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetMaxPriceDeviationResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMaxPriceDeviation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMsgServer) AddMinter(context.Context, *MsgAddMinter) (*MsgAddMinterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMinter not implemented")
+
+func (c *msgClient) Wrap(ctx context.Context, in *MsgWrap, opts ...grpc.CallOption) (*MsgWrapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgWrapResponse)
+	err := c.cc.Invoke(ctx, Msg_Wrap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMsgServer) RemoveMinter(context.Context, *MsgRemoveMinter) (*MsgRemoveMinterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveMinter not implemented")
+
+func (c *msgClient) Unwrap(ctx context.Context, in *MsgUnwrap, opts ...grpc.CallOption) (*MsgUnwrapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnwrapResponse)
+	err := c.cc.Invoke(ctx, Msg_Unwrap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMsgServer) SetMinterAllowance(context.Context, *MsgSetMinterAllowance) (*MsgSetMinterAllowanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetMinterAllowance not implemented")
+
+func (c *msgClient) TransferRebasing(ctx context.Context, in *MsgTransferRebasing, opts ...grpc.CallOption) (*MsgTransferRebasingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgTransferRebasingResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferRebasing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMsgServer) AddPauser(context.Context, *MsgAddPauser) (*MsgAddPauserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPauser not implemented")
+
+func (c *msgClient) SetStablecoin(ctx context.Context, in *MsgSetStablecoin, opts ...grpc.CallOption) (*MsgSetStablecoinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetStablecoinResponse)
+	err := c.cc.Invoke(ctx, Msg_SetStablecoin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestSubscription(ctx context.Context, in *MsgRequestSubscription, opts ...grpc.CallOption) (*MsgRequestSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRequestSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Msg_RequestSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FulfillSubscription(ctx context.Context, in *MsgFulfillSubscription, opts ...grpc.CallOption) (*MsgFulfillSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgFulfillSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Msg_FulfillSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectSubscription(ctx context.Context, in *MsgRejectSubscription, opts ...grpc.CallOption) (*MsgRejectSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRejectSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Msg_RejectSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestRedemption(ctx context.Context, in *MsgRequestRedemption, opts ...grpc.CallOption) (*MsgRequestRedemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRequestRedemptionResponse)
+	err := c.cc.Invoke(ctx, Msg_RequestRedemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRedemption(ctx context.Context, in *MsgCancelRedemption, opts ...grpc.CallOption) (*MsgCancelRedemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelRedemptionResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelRedemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SettleRedemption(ctx context.Context, in *MsgSettleRedemption, opts ...grpc.CallOption) (*MsgSettleRedemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSettleRedemptionResponse)
+	err := c.cc.Invoke(ctx, Msg_SettleRedemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectRedemption(ctx context.Context, in *MsgRejectRedemption, opts ...grpc.CallOption) (*MsgRejectRedemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRejectRedemptionResponse)
+	err := c.cc.Invoke(ctx, Msg_RejectRedemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSwapResponse)
+	err := c.cc.Invoke(ctx, Msg_Swap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSwapConfig(ctx context.Context, in *MsgSetSwapConfig, opts ...grpc.CallOption) (*MsgSetSwapConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetSwapConfigResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSwapConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundSwapFacility(ctx context.Context, in *MsgFundSwapFacility, opts ...grpc.CallOption) (*MsgFundSwapFacilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgFundSwapFacilityResponse)
+	err := c.cc.Invoke(ctx, Msg_FundSwapFacility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawSwapFacility(ctx context.Context, in *MsgWithdrawSwapFacility, opts ...grpc.CallOption) (*MsgWithdrawSwapFacilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgWithdrawSwapFacilityResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawSwapFacility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseSwaps(ctx context.Context, in *MsgPauseSwaps, opts ...grpc.CallOption) (*MsgPauseSwapsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgPauseSwapsResponse)
+	err := c.cc.Invoke(ctx, Msg_PauseSwaps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpauseSwaps(ctx context.Context, in *MsgUnpauseSwaps, opts ...grpc.CallOption) (*MsgUnpauseSwapsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnpauseSwapsResponse)
+	err := c.cc.Invoke(ctx, Msg_UnpauseSwaps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetLockupPeriod(ctx context.Context, in *MsgSetLockupPeriod, opts ...grpc.CallOption) (*MsgSetLockupPeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetLockupPeriodResponse)
+	err := c.cc.Invoke(ctx, Msg_SetLockupPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBlocklistBinding(ctx context.Context, in *MsgSetBlocklistBinding, opts ...grpc.CallOption) (*MsgSetBlocklistBindingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetBlocklistBindingResponse)
+	err := c.cc.Invoke(ctx, Msg_SetBlocklistBinding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAttestor(ctx context.Context, in *MsgAddAttestor, opts ...grpc.CallOption) (*MsgAddAttestorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAddAttestorResponse)
+	err := c.cc.Invoke(ctx, Msg_AddAttestor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAttestor(ctx context.Context, in *MsgRemoveAttestor, opts ...grpc.CallOption) (*MsgRemoveAttestorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveAttestorResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAttestor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PostAttestation(ctx context.Context, in *MsgPostAttestation, opts ...grpc.CallOption) (*MsgPostAttestationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgPostAttestationResponse)
+	err := c.cc.Invoke(ctx, Msg_PostAttestation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetReserveConfig(ctx context.Context, in *MsgSetReserveConfig, opts ...grpc.CallOption) (*MsgSetReserveConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetReserveConfigResponse)
+	err := c.cc.Invoke(ctx, Msg_SetReserveConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddRecoverer(ctx context.Context, in *MsgAddRecoverer, opts ...grpc.CallOption) (*MsgAddRecovererResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAddRecovererResponse)
+	err := c.cc.Invoke(ctx, Msg_AddRecoverer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRecoverer(ctx context.Context, in *MsgRemoveRecoverer, opts ...grpc.CallOption) (*MsgRemoveRecovererResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveRecovererResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveRecoverer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgForceTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_ForceTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility.
+type MsgServer interface {
+	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
+	Mint(context.Context, *MsgMint) (*MsgMintResponse, error)
+	Pause(context.Context, *MsgPause) (*MsgPauseResponse, error)
+	Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error)
+	SchedulePause(context.Context, *MsgSchedulePause) (*MsgSchedulePauseResponse, error)
+	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
+	AcceptOwnership(context.Context, *MsgAcceptOwnership) (*MsgAcceptOwnershipResponse, error)
+	ForceOwnership(context.Context, *MsgForceOwnership) (*MsgForceOwnershipResponse, error)
+	AddBurner(context.Context, *MsgAddBurner) (*MsgAddBurnerResponse, error)
+	RemoveBurner(context.Context, *MsgRemoveBurner) (*MsgRemoveBurnerResponse, error)
+	SetBurnerAllowance(context.Context, *MsgSetBurnerAllowance) (*MsgSetBurnerAllowanceResponse, error)
+	IncreaseBurnerAllowance(context.Context, *MsgIncreaseBurnerAllowance) (*MsgIncreaseBurnerAllowanceResponse, error)
+	DecreaseBurnerAllowance(context.Context, *MsgDecreaseBurnerAllowance) (*MsgDecreaseBurnerAllowanceResponse, error)
+	AddBurnerSource(context.Context, *MsgAddBurnerSource) (*MsgAddBurnerSourceResponse, error)
+	RemoveBurnerSource(context.Context, *MsgRemoveBurnerSource) (*MsgRemoveBurnerSourceResponse, error)
+	AddMinter(context.Context, *MsgAddMinter) (*MsgAddMinterResponse, error)
+	RemoveMinter(context.Context, *MsgRemoveMinter) (*MsgRemoveMinterResponse, error)
+	SetMinterAllowance(context.Context, *MsgSetMinterAllowance) (*MsgSetMinterAllowanceResponse, error)
+	IncreaseMinterAllowance(context.Context, *MsgIncreaseMinterAllowance) (*MsgIncreaseMinterAllowanceResponse, error)
+	DecreaseMinterAllowance(context.Context, *MsgDecreaseMinterAllowance) (*MsgDecreaseMinterAllowanceResponse, error)
+	AddMinterRecipient(context.Context, *MsgAddMinterRecipient) (*MsgAddMinterRecipientResponse, error)
+	RemoveMinterRecipient(context.Context, *MsgRemoveMinterRecipient) (*MsgRemoveMinterRecipientResponse, error)
+	AddPauser(context.Context, *MsgAddPauser) (*MsgAddPauserResponse, error)
+	RemovePauser(context.Context, *MsgRemovePauser) (*MsgRemovePauserResponse, error)
+	AddBlockedChannel(context.Context, *MsgAddBlockedChannel) (*MsgAddBlockedChannelResponse, error)
+	RemoveBlockedChannel(context.Context, *MsgRemoveBlockedChannel) (*MsgRemoveBlockedChannelResponse, error)
+	AddPauseExemption(context.Context, *MsgAddPauseExemption) (*MsgAddPauseExemptionResponse, error)
+	RemovePauseExemption(context.Context, *MsgRemovePauseExemption) (*MsgRemovePauseExemptionResponse, error)
+	SetChannelMode(context.Context, *MsgSetChannelMode) (*MsgSetChannelModeResponse, error)
+	AddAllowedChannel(context.Context, *MsgAddAllowedChannel) (*MsgAddAllowedChannelResponse, error)
+	RemoveAllowedChannel(context.Context, *MsgRemoveAllowedChannel) (*MsgRemoveAllowedChannelResponse, error)
+	SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error)
+	RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error)
+	AddPriceSetter(context.Context, *MsgAddPriceSetter) (*MsgAddPriceSetterResponse, error)
+	RemovePriceSetter(context.Context, *MsgRemovePriceSetter) (*MsgRemovePriceSetterResponse, error)
+	SetPriceRange(context.Context, *MsgSetPriceRange) (*MsgSetPriceRangeResponse, error)
+	SetMaxPriceDeviation(context.Context, *MsgSetMaxPriceDeviation) (*MsgSetMaxPriceDeviationResponse, error)
+	Wrap(context.Context, *MsgWrap) (*MsgWrapResponse, error)
+	Unwrap(context.Context, *MsgUnwrap) (*MsgUnwrapResponse, error)
+	TransferRebasing(context.Context, *MsgTransferRebasing) (*MsgTransferRebasingResponse, error)
+	SetStablecoin(context.Context, *MsgSetStablecoin) (*MsgSetStablecoinResponse, error)
+	RequestSubscription(context.Context, *MsgRequestSubscription) (*MsgRequestSubscriptionResponse, error)
+	FulfillSubscription(context.Context, *MsgFulfillSubscription) (*MsgFulfillSubscriptionResponse, error)
+	RejectSubscription(context.Context, *MsgRejectSubscription) (*MsgRejectSubscriptionResponse, error)
+	RequestRedemption(context.Context, *MsgRequestRedemption) (*MsgRequestRedemptionResponse, error)
+	CancelRedemption(context.Context, *MsgCancelRedemption) (*MsgCancelRedemptionResponse, error)
+	SettleRedemption(context.Context, *MsgSettleRedemption) (*MsgSettleRedemptionResponse, error)
+	RejectRedemption(context.Context, *MsgRejectRedemption) (*MsgRejectRedemptionResponse, error)
+	Swap(context.Context, *MsgSwap) (*MsgSwapResponse, error)
+	SetSwapConfig(context.Context, *MsgSetSwapConfig) (*MsgSetSwapConfigResponse, error)
+	FundSwapFacility(context.Context, *MsgFundSwapFacility) (*MsgFundSwapFacilityResponse, error)
+	WithdrawSwapFacility(context.Context, *MsgWithdrawSwapFacility) (*MsgWithdrawSwapFacilityResponse, error)
+	PauseSwaps(context.Context, *MsgPauseSwaps) (*MsgPauseSwapsResponse, error)
+	UnpauseSwaps(context.Context, *MsgUnpauseSwaps) (*MsgUnpauseSwapsResponse, error)
+	SetLockupPeriod(context.Context, *MsgSetLockupPeriod) (*MsgSetLockupPeriodResponse, error)
+	SetBlocklistBinding(context.Context, *MsgSetBlocklistBinding) (*MsgSetBlocklistBindingResponse, error)
+	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
+	AddAttestor(context.Context, *MsgAddAttestor) (*MsgAddAttestorResponse, error)
+	RemoveAttestor(context.Context, *MsgRemoveAttestor) (*MsgRemoveAttestorResponse, error)
+	PostAttestation(context.Context, *MsgPostAttestation) (*MsgPostAttestationResponse, error)
+	SetReserveConfig(context.Context, *MsgSetReserveConfig) (*MsgSetReserveConfigResponse, error)
+	AddRecoverer(context.Context, *MsgAddRecoverer) (*MsgAddRecovererResponse, error)
+	RemoveRecoverer(context.Context, *MsgRemoveRecoverer) (*MsgRemoveRecovererResponse, error)
+	ForceTransfer(context.Context, *MsgForceTransfer) (*MsgForceTransferResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMsgServer struct{}
+
+func (UnimplementedMsgServer) Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+}
+func (UnimplementedMsgServer) Mint(context.Context, *MsgMint) (*MsgMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+}
+func (UnimplementedMsgServer) Pause(context.Context, *MsgPause) (*MsgPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (UnimplementedMsgServer) Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpause not implemented")
+}
+func (UnimplementedMsgServer) SchedulePause(context.Context, *MsgSchedulePause) (*MsgSchedulePauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePause not implemented")
+}
+func (UnimplementedMsgServer) TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (UnimplementedMsgServer) AcceptOwnership(context.Context, *MsgAcceptOwnership) (*MsgAcceptOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOwnership not implemented")
+}
+func (UnimplementedMsgServer) ForceOwnership(context.Context, *MsgForceOwnership) (*MsgForceOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceOwnership not implemented")
+}
+func (UnimplementedMsgServer) AddBurner(context.Context, *MsgAddBurner) (*MsgAddBurnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBurner not implemented")
+}
+func (UnimplementedMsgServer) RemoveBurner(context.Context, *MsgRemoveBurner) (*MsgRemoveBurnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBurner not implemented")
+}
+func (UnimplementedMsgServer) SetBurnerAllowance(context.Context, *MsgSetBurnerAllowance) (*MsgSetBurnerAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBurnerAllowance not implemented")
+}
+func (UnimplementedMsgServer) IncreaseBurnerAllowance(context.Context, *MsgIncreaseBurnerAllowance) (*MsgIncreaseBurnerAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncreaseBurnerAllowance not implemented")
+}
+func (UnimplementedMsgServer) DecreaseBurnerAllowance(context.Context, *MsgDecreaseBurnerAllowance) (*MsgDecreaseBurnerAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecreaseBurnerAllowance not implemented")
+}
+func (UnimplementedMsgServer) AddBurnerSource(context.Context, *MsgAddBurnerSource) (*MsgAddBurnerSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBurnerSource not implemented")
+}
+func (UnimplementedMsgServer) RemoveBurnerSource(context.Context, *MsgRemoveBurnerSource) (*MsgRemoveBurnerSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBurnerSource not implemented")
+}
+func (UnimplementedMsgServer) AddMinter(context.Context, *MsgAddMinter) (*MsgAddMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMinter not implemented")
+}
+func (UnimplementedMsgServer) RemoveMinter(context.Context, *MsgRemoveMinter) (*MsgRemoveMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMinter not implemented")
+}
+func (UnimplementedMsgServer) SetMinterAllowance(context.Context, *MsgSetMinterAllowance) (*MsgSetMinterAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMinterAllowance not implemented")
+}
+func (UnimplementedMsgServer) IncreaseMinterAllowance(context.Context, *MsgIncreaseMinterAllowance) (*MsgIncreaseMinterAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncreaseMinterAllowance not implemented")
+}
+func (UnimplementedMsgServer) DecreaseMinterAllowance(context.Context, *MsgDecreaseMinterAllowance) (*MsgDecreaseMinterAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecreaseMinterAllowance not implemented")
+}
+func (UnimplementedMsgServer) AddMinterRecipient(context.Context, *MsgAddMinterRecipient) (*MsgAddMinterRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMinterRecipient not implemented")
+}
+func (UnimplementedMsgServer) RemoveMinterRecipient(context.Context, *MsgRemoveMinterRecipient) (*MsgRemoveMinterRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMinterRecipient not implemented")
+}
+func (UnimplementedMsgServer) AddPauser(context.Context, *MsgAddPauser) (*MsgAddPauserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPauser not implemented")
+}
+func (UnimplementedMsgServer) RemovePauser(context.Context, *MsgRemovePauser) (*MsgRemovePauserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePauser not implemented")
+}
+func (UnimplementedMsgServer) AddBlockedChannel(context.Context, *MsgAddBlockedChannel) (*MsgAddBlockedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBlockedChannel not implemented")
+}
+func (UnimplementedMsgServer) RemoveBlockedChannel(context.Context, *MsgRemoveBlockedChannel) (*MsgRemoveBlockedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBlockedChannel not implemented")
+}
+func (UnimplementedMsgServer) AddPauseExemption(context.Context, *MsgAddPauseExemption) (*MsgAddPauseExemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPauseExemption not implemented")
+}
+func (UnimplementedMsgServer) RemovePauseExemption(context.Context, *MsgRemovePauseExemption) (*MsgRemovePauseExemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePauseExemption not implemented")
+}
+func (UnimplementedMsgServer) SetChannelMode(context.Context, *MsgSetChannelMode) (*MsgSetChannelModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelMode not implemented")
+}
+func (UnimplementedMsgServer) AddAllowedChannel(context.Context, *MsgAddAllowedChannel) (*MsgAddAllowedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedChannel not implemented")
+}
+func (UnimplementedMsgServer) RemoveAllowedChannel(context.Context, *MsgRemoveAllowedChannel) (*MsgRemoveAllowedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowedChannel not implemented")
+}
+func (UnimplementedMsgServer) SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimit not implemented")
+}
+func (UnimplementedMsgServer) RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRateLimit not implemented")
+}
+func (UnimplementedMsgServer) AddPriceSetter(context.Context, *MsgAddPriceSetter) (*MsgAddPriceSetterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPriceSetter not implemented")
+}
+func (UnimplementedMsgServer) RemovePriceSetter(context.Context, *MsgRemovePriceSetter) (*MsgRemovePriceSetterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePriceSetter not implemented")
+}
+func (UnimplementedMsgServer) SetPriceRange(context.Context, *MsgSetPriceRange) (*MsgSetPriceRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPriceRange not implemented")
+}
+func (UnimplementedMsgServer) SetMaxPriceDeviation(context.Context, *MsgSetMaxPriceDeviation) (*MsgSetMaxPriceDeviationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxPriceDeviation not implemented")
+}
+func (UnimplementedMsgServer) Wrap(context.Context, *MsgWrap) (*MsgWrapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Wrap not implemented")
+}
+func (UnimplementedMsgServer) Unwrap(context.Context, *MsgUnwrap) (*MsgUnwrapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unwrap not implemented")
+}
+func (UnimplementedMsgServer) TransferRebasing(context.Context, *MsgTransferRebasing) (*MsgTransferRebasingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRebasing not implemented")
+}
+func (UnimplementedMsgServer) SetStablecoin(context.Context, *MsgSetStablecoin) (*MsgSetStablecoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStablecoin not implemented")
+}
+func (UnimplementedMsgServer) RequestSubscription(context.Context, *MsgRequestSubscription) (*MsgRequestSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestSubscription not implemented")
+}
+func (UnimplementedMsgServer) FulfillSubscription(context.Context, *MsgFulfillSubscription) (*MsgFulfillSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillSubscription not implemented")
+}
+func (UnimplementedMsgServer) RejectSubscription(context.Context, *MsgRejectSubscription) (*MsgRejectSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectSubscription not implemented")
+}
+func (UnimplementedMsgServer) RequestRedemption(context.Context, *MsgRequestRedemption) (*MsgRequestRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRedemption not implemented")
+}
+func (UnimplementedMsgServer) CancelRedemption(context.Context, *MsgCancelRedemption) (*MsgCancelRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRedemption not implemented")
+}
+func (UnimplementedMsgServer) SettleRedemption(context.Context, *MsgSettleRedemption) (*MsgSettleRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleRedemption not implemented")
+}
+func (UnimplementedMsgServer) RejectRedemption(context.Context, *MsgRejectRedemption) (*MsgRejectRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRedemption not implemented")
+}
+func (UnimplementedMsgServer) Swap(context.Context, *MsgSwap) (*MsgSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+}
+func (UnimplementedMsgServer) SetSwapConfig(context.Context, *MsgSetSwapConfig) (*MsgSetSwapConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSwapConfig not implemented")
+}
+func (UnimplementedMsgServer) FundSwapFacility(context.Context, *MsgFundSwapFacility) (*MsgFundSwapFacilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundSwapFacility not implemented")
+}
+func (UnimplementedMsgServer) WithdrawSwapFacility(context.Context, *MsgWithdrawSwapFacility) (*MsgWithdrawSwapFacilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawSwapFacility not implemented")
+}
+func (UnimplementedMsgServer) PauseSwaps(context.Context, *MsgPauseSwaps) (*MsgPauseSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSwaps not implemented")
+}
+func (UnimplementedMsgServer) UnpauseSwaps(context.Context, *MsgUnpauseSwaps) (*MsgUnpauseSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseSwaps not implemented")
+}
+func (UnimplementedMsgServer) SetLockupPeriod(context.Context, *MsgSetLockupPeriod) (*MsgSetLockupPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLockupPeriod not implemented")
+}
+func (UnimplementedMsgServer) SetBlocklistBinding(context.Context, *MsgSetBlocklistBinding) (*MsgSetBlocklistBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBlocklistBinding not implemented")
+}
+func (UnimplementedMsgServer) SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+}
+func (UnimplementedMsgServer) AddAttestor(context.Context, *MsgAddAttestor) (*MsgAddAttestorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAttestor not implemented")
+}
+func (UnimplementedMsgServer) RemoveAttestor(context.Context, *MsgRemoveAttestor) (*MsgRemoveAttestorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAttestor not implemented")
+}
+func (UnimplementedMsgServer) PostAttestation(context.Context, *MsgPostAttestation) (*MsgPostAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostAttestation not implemented")
+}
+func (UnimplementedMsgServer) SetReserveConfig(context.Context, *MsgSetReserveConfig) (*MsgSetReserveConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReserveConfig not implemented")
+}
+func (UnimplementedMsgServer) AddRecoverer(context.Context, *MsgAddRecoverer) (*MsgAddRecovererResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRecoverer not implemented")
+}
+func (UnimplementedMsgServer) RemoveRecoverer(context.Context, *MsgRemoveRecoverer) (*MsgRemoveRecovererResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRecoverer not implemented")
+}
+func (UnimplementedMsgServer) ForceTransfer(context.Context, *MsgForceTransfer) (*MsgForceTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceTransfer not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+func (UnimplementedMsgServer) testEmbeddedByValue()             {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	// If the following call pancis, it indicates UnimplementedMsgServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Burn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Burn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Burn(ctx, req.(*MsgBurn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Mint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Mint(ctx, req.(*MsgMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Pause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Pause(ctx, req.(*MsgPause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unpause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Unpause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unpause(ctx, req.(*MsgUnpause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SchedulePause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSchedulePause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SchedulePause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SchedulePause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SchedulePause(ctx, req.(*MsgSchedulePause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferOwnership(ctx, req.(*MsgTransferOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptOwnership(ctx, req.(*MsgAcceptOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ForceOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceOwnership(ctx, req.(*MsgForceOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddBurner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddBurner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddBurner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddBurner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddBurner(ctx, req.(*MsgAddBurner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveBurner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveBurner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveBurner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveBurner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveBurner(ctx, req.(*MsgRemoveBurner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBurnerAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBurnerAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBurnerAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetBurnerAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBurnerAllowance(ctx, req.(*MsgSetBurnerAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_IncreaseBurnerAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIncreaseBurnerAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IncreaseBurnerAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_IncreaseBurnerAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IncreaseBurnerAllowance(ctx, req.(*MsgIncreaseBurnerAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DecreaseBurnerAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDecreaseBurnerAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DecreaseBurnerAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DecreaseBurnerAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DecreaseBurnerAllowance(ctx, req.(*MsgDecreaseBurnerAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddBurnerSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddBurnerSource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddBurnerSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddBurnerSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddBurnerSource(ctx, req.(*MsgAddBurnerSource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveBurnerSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveBurnerSource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveBurnerSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveBurnerSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveBurnerSource(ctx, req.(*MsgRemoveBurnerSource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMinter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddMinter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMinter(ctx, req.(*MsgAddMinter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMinter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveMinter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMinter(ctx, req.(*MsgRemoveMinter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMinterAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMinterAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMinterAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMinterAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMinterAllowance(ctx, req.(*MsgSetMinterAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_IncreaseMinterAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIncreaseMinterAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IncreaseMinterAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_IncreaseMinterAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IncreaseMinterAllowance(ctx, req.(*MsgIncreaseMinterAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DecreaseMinterAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDecreaseMinterAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DecreaseMinterAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DecreaseMinterAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DecreaseMinterAllowance(ctx, req.(*MsgDecreaseMinterAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddMinterRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMinterRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddMinterRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddMinterRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMinterRecipient(ctx, req.(*MsgAddMinterRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveMinterRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMinterRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMinterRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveMinterRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMinterRecipient(ctx, req.(*MsgRemoveMinterRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddPauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddPauser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddPauser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddPauser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddPauser(ctx, req.(*MsgAddPauser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemovePauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemovePauser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemovePauser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemovePauser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemovePauser(ctx, req.(*MsgRemovePauser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddBlockedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddBlockedChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddBlockedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddBlockedChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddBlockedChannel(ctx, req.(*MsgAddBlockedChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveBlockedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveBlockedChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveBlockedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveBlockedChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveBlockedChannel(ctx, req.(*MsgRemoveBlockedChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddPauseExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddPauseExemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddPauseExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddPauseExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddPauseExemption(ctx, req.(*MsgAddPauseExemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemovePauseExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemovePauseExemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemovePauseExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemovePauseExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemovePauseExemption(ctx, req.(*MsgRemovePauseExemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetChannelMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChannelMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetChannelMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetChannelMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChannelMode(ctx, req.(*MsgSetChannelMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAllowedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAllowedChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAllowedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddAllowedChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAllowedChannel(ctx, req.(*MsgAddAllowedChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAllowedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAllowedChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAllowedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveAllowedChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAllowedChannel(ctx, req.(*MsgRemoveAllowedChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRateLimit(ctx, req.(*MsgSetRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRateLimit(ctx, req.(*MsgRemoveRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddPriceSetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddPriceSetter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddPriceSetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddPriceSetter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddPriceSetter(ctx, req.(*MsgAddPriceSetter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemovePriceSetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemovePriceSetter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemovePriceSetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemovePriceSetter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemovePriceSetter(ctx, req.(*MsgRemovePriceSetter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPriceRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPriceRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPriceRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPriceRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPriceRange(ctx, req.(*MsgSetPriceRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMaxPriceDeviation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaxPriceDeviation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaxPriceDeviation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMaxPriceDeviation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaxPriceDeviation(ctx, req.(*MsgSetMaxPriceDeviation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Wrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWrap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Wrap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Wrap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Wrap(ctx, req.(*MsgWrap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unwrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnwrap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unwrap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Unwrap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unwrap(ctx, req.(*MsgUnwrap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferRebasing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferRebasing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferRebasing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferRebasing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferRebasing(ctx, req.(*MsgTransferRebasing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetStablecoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetStablecoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetStablecoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetStablecoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetStablecoin(ctx, req.(*MsgSetStablecoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RequestSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestSubscription(ctx, req.(*MsgRequestSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FulfillSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FulfillSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillSubscription(ctx, req.(*MsgFulfillSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMsgServer) RemovePauser(context.Context, *MsgRemovePauser) (*MsgRemovePauserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemovePauser not implemented")
+
+func _Msg_RejectSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RejectSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectSubscription(ctx, req.(*MsgRejectSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMsgServer) AddBlockedChannel(context.Context, *MsgAddBlockedChannel) (*MsgAddBlockedChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddBlockedChannel not implemented")
+
+func _Msg_RequestRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RequestRedemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestRedemption(ctx, req.(*MsgRequestRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMsgServer) RemoveBlockedChannel(context.Context, *MsgRemoveBlockedChannel) (*MsgRemoveBlockedChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveBlockedChannel not implemented")
+
+func _Msg_CancelRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelRedemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRedemption(ctx, req.(*MsgCancelRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
-func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
-// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MsgServer will
-// result in compilation errors.
-type UnsafeMsgServer interface {
-	mustEmbedUnimplementedMsgServer()
+func _Msg_SettleRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSettleRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SettleRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SettleRedemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SettleRedemption(ctx, req.(*MsgSettleRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
-	// If the following call pancis, it indicates UnimplementedMsgServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _Msg_RejectRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&Msg_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(MsgServer).RejectRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RejectRedemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectRedemption(ctx, req.(*MsgRejectRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBurn)
+func _Msg_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwap)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).Burn(ctx, in)
+		return srv.(MsgServer).Swap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_Burn_FullMethodName,
+		FullMethod: Msg_Swap_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Burn(ctx, req.(*MsgBurn))
+		return srv.(MsgServer).Swap(ctx, req.(*MsgSwap))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMint)
+func _Msg_SetSwapConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSwapConfig)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).Mint(ctx, in)
+		return srv.(MsgServer).SetSwapConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_Mint_FullMethodName,
+		FullMethod: Msg_SetSwapConfig_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Mint(ctx, req.(*MsgMint))
+		return srv.(MsgServer).SetSwapConfig(ctx, req.(*MsgSetSwapConfig))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPause)
+func _Msg_FundSwapFacility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundSwapFacility)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).Pause(ctx, in)
+		return srv.(MsgServer).FundSwapFacility(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_Pause_FullMethodName,
+		FullMethod: Msg_FundSwapFacility_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Pause(ctx, req.(*MsgPause))
+		return srv.(MsgServer).FundSwapFacility(ctx, req.(*MsgFundSwapFacility))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_Unpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnpause)
+func _Msg_WithdrawSwapFacility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawSwapFacility)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).Unpause(ctx, in)
+		return srv.(MsgServer).WithdrawSwapFacility(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_Unpause_FullMethodName,
+		FullMethod: Msg_WithdrawSwapFacility_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Unpause(ctx, req.(*MsgUnpause))
+		return srv.(MsgServer).WithdrawSwapFacility(ctx, req.(*MsgWithdrawSwapFacility))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTransferOwnership)
+func _Msg_PauseSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseSwaps)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).TransferOwnership(ctx, in)
+		return srv.(MsgServer).PauseSwaps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_TransferOwnership_FullMethodName,
+		FullMethod: Msg_PauseSwaps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TransferOwnership(ctx, req.(*MsgTransferOwnership))
+		return srv.(MsgServer).PauseSwaps(ctx, req.(*MsgPauseSwaps))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_AcceptOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAcceptOwnership)
+func _Msg_UnpauseSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseSwaps)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).AcceptOwnership(ctx, in)
+		return srv.(MsgServer).UnpauseSwaps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_AcceptOwnership_FullMethodName,
+		FullMethod: Msg_UnpauseSwaps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AcceptOwnership(ctx, req.(*MsgAcceptOwnership))
+		return srv.(MsgServer).UnpauseSwaps(ctx, req.(*MsgUnpauseSwaps))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_AddBurner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddBurner)
+func _Msg_SetLockupPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetLockupPeriod)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).AddBurner(ctx, in)
+		return srv.(MsgServer).SetLockupPeriod(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_AddBurner_FullMethodName,
+		FullMethod: Msg_SetLockupPeriod_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddBurner(ctx, req.(*MsgAddBurner))
+		return srv.(MsgServer).SetLockupPeriod(ctx, req.(*MsgSetLockupPeriod))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_RemoveBurner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveBurner)
+func _Msg_SetBlocklistBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBlocklistBinding)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).RemoveBurner(ctx, in)
+		return srv.(MsgServer).SetBlocklistBinding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_RemoveBurner_FullMethodName,
+		FullMethod: Msg_SetBlocklistBinding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveBurner(ctx, req.(*MsgRemoveBurner))
+		return srv.(MsgServer).SetBlocklistBinding(ctx, req.(*MsgSetBlocklistBinding))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_SetBurnerAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetBurnerAllowance)
+func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadata)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).SetBurnerAllowance(ctx, in)
+		return srv.(MsgServer).SetDenomMetadata(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_SetBurnerAllowance_FullMethodName,
+		FullMethod: Msg_SetDenomMetadata_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetBurnerAllowance(ctx, req.(*MsgSetBurnerAllowance))
+		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadata))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_AddMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddMinter)
+func _Msg_AddAttestor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAttestor)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).AddMinter(ctx, in)
+		return srv.(MsgServer).AddAttestor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_AddMinter_FullMethodName,
+		FullMethod: Msg_AddAttestor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddMinter(ctx, req.(*MsgAddMinter))
+		return srv.(MsgServer).AddAttestor(ctx, req.(*MsgAddAttestor))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_RemoveMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveMinter)
+func _Msg_RemoveAttestor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAttestor)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).RemoveMinter(ctx, in)
+		return srv.(MsgServer).RemoveAttestor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_RemoveMinter_FullMethodName,
+		FullMethod: Msg_RemoveAttestor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveMinter(ctx, req.(*MsgRemoveMinter))
+		return srv.(MsgServer).RemoveAttestor(ctx, req.(*MsgRemoveAttestor))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_SetMinterAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetMinterAllowance)
+func _Msg_PostAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPostAttestation)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).SetMinterAllowance(ctx, in)
+		return srv.(MsgServer).PostAttestation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_SetMinterAllowance_FullMethodName,
+		FullMethod: Msg_PostAttestation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetMinterAllowance(ctx, req.(*MsgSetMinterAllowance))
+		return srv.(MsgServer).PostAttestation(ctx, req.(*MsgPostAttestation))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_AddPauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddPauser)
+func _Msg_SetReserveConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReserveConfig)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).AddPauser(ctx, in)
+		return srv.(MsgServer).SetReserveConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_AddPauser_FullMethodName,
+		FullMethod: Msg_SetReserveConfig_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddPauser(ctx, req.(*MsgAddPauser))
+		return srv.(MsgServer).SetReserveConfig(ctx, req.(*MsgSetReserveConfig))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_RemovePauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemovePauser)
+func _Msg_AddRecoverer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddRecoverer)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).RemovePauser(ctx, in)
+		return srv.(MsgServer).AddRecoverer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_RemovePauser_FullMethodName,
+		FullMethod: Msg_AddRecoverer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemovePauser(ctx, req.(*MsgRemovePauser))
+		return srv.(MsgServer).AddRecoverer(ctx, req.(*MsgAddRecoverer))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_AddBlockedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddBlockedChannel)
+func _Msg_RemoveRecoverer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRecoverer)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).AddBlockedChannel(ctx, in)
+		return srv.(MsgServer).RemoveRecoverer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_AddBlockedChannel_FullMethodName,
+		FullMethod: Msg_RemoveRecoverer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddBlockedChannel(ctx, req.(*MsgAddBlockedChannel))
+		return srv.(MsgServer).RemoveRecoverer(ctx, req.(*MsgRemoveRecoverer))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_RemoveBlockedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveBlockedChannel)
+func _Msg_ForceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceTransfer)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).RemoveBlockedChannel(ctx, in)
+		return srv.(MsgServer).ForceTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_RemoveBlockedChannel_FullMethodName,
+		FullMethod: Msg_ForceTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveBlockedChannel(ctx, req.(*MsgRemoveBlockedChannel))
+		return srv.(MsgServer).ForceTransfer(ctx, req.(*MsgForceTransfer))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -637,6 +2269,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Unpause",
 			Handler:    _Msg_Unpause_Handler,
 		},
+		{
+			MethodName: "SchedulePause",
+			Handler:    _Msg_SchedulePause_Handler,
+		},
 		{
 			MethodName: "TransferOwnership",
 			Handler:    _Msg_TransferOwnership_Handler,
@@ -645,6 +2281,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AcceptOwnership",
 			Handler:    _Msg_AcceptOwnership_Handler,
 		},
+		{
+			MethodName: "ForceOwnership",
+			Handler:    _Msg_ForceOwnership_Handler,
+		},
 		{
 			MethodName: "AddBurner",
 			Handler:    _Msg_AddBurner_Handler,
@@ -657,6 +2297,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetBurnerAllowance",
 			Handler:    _Msg_SetBurnerAllowance_Handler,
 		},
+		{
+			MethodName: "IncreaseBurnerAllowance",
+			Handler:    _Msg_IncreaseBurnerAllowance_Handler,
+		},
+		{
+			MethodName: "DecreaseBurnerAllowance",
+			Handler:    _Msg_DecreaseBurnerAllowance_Handler,
+		},
+		{
+			MethodName: "AddBurnerSource",
+			Handler:    _Msg_AddBurnerSource_Handler,
+		},
+		{
+			MethodName: "RemoveBurnerSource",
+			Handler:    _Msg_RemoveBurnerSource_Handler,
+		},
 		{
 			MethodName: "AddMinter",
 			Handler:    _Msg_AddMinter_Handler,
@@ -669,6 +2325,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetMinterAllowance",
 			Handler:    _Msg_SetMinterAllowance_Handler,
 		},
+		{
+			MethodName: "IncreaseMinterAllowance",
+			Handler:    _Msg_IncreaseMinterAllowance_Handler,
+		},
+		{
+			MethodName: "DecreaseMinterAllowance",
+			Handler:    _Msg_DecreaseMinterAllowance_Handler,
+		},
+		{
+			MethodName: "AddMinterRecipient",
+			Handler:    _Msg_AddMinterRecipient_Handler,
+		},
+		{
+			MethodName: "RemoveMinterRecipient",
+			Handler:    _Msg_RemoveMinterRecipient_Handler,
+		},
 		{
 			MethodName: "AddPauser",
 			Handler:    _Msg_AddPauser_Handler,
@@ -685,6 +2357,158 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveBlockedChannel",
 			Handler:    _Msg_RemoveBlockedChannel_Handler,
 		},
+		{
+			MethodName: "AddPauseExemption",
+			Handler:    _Msg_AddPauseExemption_Handler,
+		},
+		{
+			MethodName: "RemovePauseExemption",
+			Handler:    _Msg_RemovePauseExemption_Handler,
+		},
+		{
+			MethodName: "SetChannelMode",
+			Handler:    _Msg_SetChannelMode_Handler,
+		},
+		{
+			MethodName: "AddAllowedChannel",
+			Handler:    _Msg_AddAllowedChannel_Handler,
+		},
+		{
+			MethodName: "RemoveAllowedChannel",
+			Handler:    _Msg_RemoveAllowedChannel_Handler,
+		},
+		{
+			MethodName: "SetRateLimit",
+			Handler:    _Msg_SetRateLimit_Handler,
+		},
+		{
+			MethodName: "RemoveRateLimit",
+			Handler:    _Msg_RemoveRateLimit_Handler,
+		},
+		{
+			MethodName: "AddPriceSetter",
+			Handler:    _Msg_AddPriceSetter_Handler,
+		},
+		{
+			MethodName: "RemovePriceSetter",
+			Handler:    _Msg_RemovePriceSetter_Handler,
+		},
+		{
+			MethodName: "SetPriceRange",
+			Handler:    _Msg_SetPriceRange_Handler,
+		},
+		{
+			MethodName: "SetMaxPriceDeviation",
+			Handler:    _Msg_SetMaxPriceDeviation_Handler,
+		},
+		{
+			MethodName: "Wrap",
+			Handler:    _Msg_Wrap_Handler,
+		},
+		{
+			MethodName: "Unwrap",
+			Handler:    _Msg_Unwrap_Handler,
+		},
+		{
+			MethodName: "TransferRebasing",
+			Handler:    _Msg_TransferRebasing_Handler,
+		},
+		{
+			MethodName: "SetStablecoin",
+			Handler:    _Msg_SetStablecoin_Handler,
+		},
+		{
+			MethodName: "RequestSubscription",
+			Handler:    _Msg_RequestSubscription_Handler,
+		},
+		{
+			MethodName: "FulfillSubscription",
+			Handler:    _Msg_FulfillSubscription_Handler,
+		},
+		{
+			MethodName: "RejectSubscription",
+			Handler:    _Msg_RejectSubscription_Handler,
+		},
+		{
+			MethodName: "RequestRedemption",
+			Handler:    _Msg_RequestRedemption_Handler,
+		},
+		{
+			MethodName: "CancelRedemption",
+			Handler:    _Msg_CancelRedemption_Handler,
+		},
+		{
+			MethodName: "SettleRedemption",
+			Handler:    _Msg_SettleRedemption_Handler,
+		},
+		{
+			MethodName: "RejectRedemption",
+			Handler:    _Msg_RejectRedemption_Handler,
+		},
+		{
+			MethodName: "Swap",
+			Handler:    _Msg_Swap_Handler,
+		},
+		{
+			MethodName: "SetSwapConfig",
+			Handler:    _Msg_SetSwapConfig_Handler,
+		},
+		{
+			MethodName: "FundSwapFacility",
+			Handler:    _Msg_FundSwapFacility_Handler,
+		},
+		{
+			MethodName: "WithdrawSwapFacility",
+			Handler:    _Msg_WithdrawSwapFacility_Handler,
+		},
+		{
+			MethodName: "PauseSwaps",
+			Handler:    _Msg_PauseSwaps_Handler,
+		},
+		{
+			MethodName: "UnpauseSwaps",
+			Handler:    _Msg_UnpauseSwaps_Handler,
+		},
+		{
+			MethodName: "SetLockupPeriod",
+			Handler:    _Msg_SetLockupPeriod_Handler,
+		},
+		{
+			MethodName: "SetBlocklistBinding",
+			Handler:    _Msg_SetBlocklistBinding_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadata",
+			Handler:    _Msg_SetDenomMetadata_Handler,
+		},
+		{
+			MethodName: "AddAttestor",
+			Handler:    _Msg_AddAttestor_Handler,
+		},
+		{
+			MethodName: "RemoveAttestor",
+			Handler:    _Msg_RemoveAttestor_Handler,
+		},
+		{
+			MethodName: "PostAttestation",
+			Handler:    _Msg_PostAttestation_Handler,
+		},
+		{
+			MethodName: "SetReserveConfig",
+			Handler:    _Msg_SetReserveConfig_Handler,
+		},
+		{
+			MethodName: "AddRecoverer",
+			Handler:    _Msg_AddRecoverer_Handler,
+		},
+		{
+			MethodName: "RemoveRecoverer",
+			Handler:    _Msg_RemoveRecoverer_Handler,
+		},
+		{
+			MethodName: "ForceTransfer",
+			Handler:    _Msg_ForceTransfer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "aura/v1/tx.proto",
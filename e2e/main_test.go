@@ -1,10 +1,14 @@
 package e2e
 
 import (
+	"strconv"
 	"testing"
 
 	"cosmossdk.io/math"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
 	"github.com/strangelove-ventures/interchaintest/v8/ibc"
 	"github.com/strangelove-ventures/interchaintest/v8/testutil"
 	"github.com/stretchr/testify/require"
@@ -256,7 +260,7 @@ func TestIBCTransfer(t *testing.T) {
 	)
 	require.NoError(t, err)
 	// ASSERT: channel-0 has been blocked.
-	EnsureBlockedChannel(t, wrapper, ctx, "channel-0")
+	EnsureBlockedChannel(t, wrapper, ctx, "channel-0", types.ChannelDirectionOutbound)
 
 	// ACT: Attempt to transfer out of Noble, channel is blocked.
 	_, err = wrapper.chain.SendIBCTransfer(ctx, "channel-0", wrapper.alice.KeyName(), ibc.WalletAmount{
@@ -283,3 +287,172 @@ func TestIBCTransfer(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, balance.IsZero())
 }
+
+func TestIBCTransferInboundBlocked(t *testing.T) {
+	t.Parallel()
+
+	var wrapper Wrapper
+	ctx := Suite(t, &wrapper, true)
+	validator := wrapper.chain.Validators[0]
+	denom := transfertypes.DenomTrace{
+		Path:      "transfer/channel-0",
+		BaseDenom: "ausdy",
+	}.IBCDenom()
+
+	// ARRANGE: Mint 2 $USDY to Alice.
+	_, err := validator.ExecTx(
+		ctx, wrapper.owner.KeyName(),
+		"aura", "set-minter-allowance", wrapper.minter.FormattedAddress(), ONE.MulRaw(2).String(),
+	)
+	require.NoError(t, err)
+	_, err = validator.ExecTx(
+		ctx, wrapper.minter.KeyName(),
+		"aura", "mint", wrapper.alice.FormattedAddress(), ONE.MulRaw(2).String(),
+	)
+	require.NoError(t, err)
+
+	// ACT: Block inbound transfers over channel-0.
+	_, err = validator.ExecTx(
+		ctx, wrapper.owner.KeyName(),
+		"aura", "add-blocked-channel", "channel-0", "--direction", "inbound",
+	)
+	require.NoError(t, err)
+	// ASSERT: channel-0 has been blocked.
+	EnsureBlockedChannel(t, wrapper, ctx, "channel-0", types.ChannelDirectionInbound)
+
+	// ACT: Attempt to transfer out of Noble, channel is only blocked inbound.
+	_, err = wrapper.chain.SendIBCTransfer(ctx, "channel-0", wrapper.alice.KeyName(), ibc.WalletAmount{
+		Address: wrapper.charlie.FormattedAddress(),
+		Denom:   "ausdy",
+		Amount:  ONE.MulRaw(2),
+	}, ibc.TransferOptions{})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.NoError(t, testutil.WaitForBlocks(ctx, 5, wrapper.chain, wrapper.gaia))
+	balance, err := wrapper.gaia.GetBalance(ctx, wrapper.charlie.FormattedAddress(), denom)
+	require.NoError(t, err)
+	require.Equal(t, ONE.MulRaw(2), balance)
+
+	// ACT: Attempt to transfer back to Noble, channel is blocked inbound.
+	_, err = wrapper.gaia.SendIBCTransfer(ctx, "channel-0", wrapper.charlie.KeyName(), ibc.WalletAmount{
+		Address: wrapper.alice.FormattedAddress(),
+		Denom:   denom,
+		Amount:  ONE,
+	}, ibc.TransferOptions{})
+	// ASSERT: The transfer should've been rejected on Noble, and refunded on the counterparty.
+	require.NoError(t, err)
+	require.NoError(t, testutil.WaitForBlocks(ctx, 5, wrapper.chain, wrapper.gaia))
+	balance, err = wrapper.chain.GetBalance(ctx, wrapper.alice.FormattedAddress(), "ausdy")
+	require.NoError(t, err)
+	require.True(t, balance.IsZero())
+	balance, err = wrapper.gaia.GetBalance(ctx, wrapper.charlie.FormattedAddress(), denom)
+	require.NoError(t, err)
+	require.Equal(t, ONE.MulRaw(2), balance)
+}
+
+func TestIBCTransferAllowlist(t *testing.T) {
+	t.Parallel()
+
+	var wrapper Wrapper
+	ctx := Suite(t, &wrapper, true)
+	validator := wrapper.chain.Validators[0]
+	denom := transfertypes.DenomTrace{
+		Path:      "transfer/channel-0",
+		BaseDenom: "ausdy",
+	}.IBCDenom()
+
+	// ARRANGE: Mint 1 $USDY to Alice.
+	_, err := validator.ExecTx(
+		ctx, wrapper.owner.KeyName(),
+		"aura", "set-minter-allowance", wrapper.minter.FormattedAddress(), ONE.String(),
+	)
+	require.NoError(t, err)
+	_, err = validator.ExecTx(
+		ctx, wrapper.minter.KeyName(),
+		"aura", "mint", wrapper.alice.FormattedAddress(), ONE.String(),
+	)
+	require.NoError(t, err)
+
+	// ACT: Switch to allowlist mode.
+	_, err = validator.ExecTx(
+		ctx, wrapper.owner.KeyName(),
+		"aura", "set-channel-mode", "allowlist",
+	)
+	require.NoError(t, err)
+	// ASSERT: The channel mode has been updated.
+	EnsureChannelMode(t, wrapper, ctx, types.ChannelModeAllowlist)
+
+	// ACT: Attempt to transfer out of Noble, channel isn't allowed.
+	_, err = wrapper.chain.SendIBCTransfer(ctx, "channel-0", wrapper.alice.KeyName(), ibc.WalletAmount{
+		Address: wrapper.charlie.FormattedAddress(),
+		Denom:   "ausdy",
+		Amount:  ONE,
+	}, ibc.TransferOptions{})
+	// ASSERT: The action should've failed.
+	require.ErrorContains(t, err, "ausdy transfers are not allowed on channel-0")
+
+	// ACT: Allow transfers over channel-0.
+	_, err = validator.ExecTx(
+		ctx, wrapper.owner.KeyName(),
+		"aura", "add-allowed-channel", "channel-0",
+	)
+	require.NoError(t, err)
+
+	// ACT: Attempt to transfer out of Noble, channel is allowed.
+	_, err = wrapper.chain.SendIBCTransfer(ctx, "channel-0", wrapper.alice.KeyName(), ibc.WalletAmount{
+		Address: wrapper.charlie.FormattedAddress(),
+		Denom:   "ausdy",
+		Amount:  ONE,
+	}, ibc.TransferOptions{})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.NoError(t, testutil.WaitForBlocks(ctx, 5, wrapper.chain, wrapper.gaia))
+	balance, err := wrapper.gaia.GetBalance(ctx, wrapper.charlie.FormattedAddress(), denom)
+	require.NoError(t, err)
+	require.Equal(t, ONE, balance)
+}
+
+func TestForceOwnershipProposal(t *testing.T) {
+	t.Parallel()
+
+	var wrapper Wrapper
+	ctx := Suite(t, &wrapper, false)
+	validator := wrapper.chain.Validators[0]
+
+	// ASSERT: Owner is the owner of the module and submodule.
+	EnsureOwner(t, wrapper, ctx, wrapper.owner.FormattedAddress())
+	EnsureBlocklistOwner(t, wrapper, ctx, wrapper.owner.FormattedAddress())
+
+	// ACT: Attempt to force ownership to Alice without authority.
+	_, err := validator.ExecTx(
+		ctx, wrapper.owner.KeyName(),
+		"aura", "force-ownership", "--owner", wrapper.alice.FormattedAddress(),
+	)
+	// ASSERT: The action should've failed.
+	require.ErrorContains(t, err, "signer is not authority")
+
+	// ACT: Submit a proposal to force ownership to Alice.
+	authority, err := wrapper.chain.GetGovernanceAddress(ctx)
+	require.NoError(t, err)
+	proposal, err := wrapper.chain.BuildProposal([]cosmos.ProtoMessage{&types.MsgForceOwnership{
+		Signer:         authority,
+		Owner:          wrapper.alice.FormattedAddress(),
+		BlocklistOwner: wrapper.alice.FormattedAddress(),
+	}}, "Force Ownership", "Force ownership to Alice", "", "1ustake", wrapper.owner.FormattedAddress(), false)
+	require.NoError(t, err)
+	tx, err := wrapper.chain.SubmitProposal(ctx, wrapper.owner.KeyName(), proposal)
+	require.NoError(t, err)
+	proposalID, err := strconv.ParseUint(tx.ProposalID, 10, 64)
+	require.NoError(t, err)
+
+	// ACT: Vote in favour of, and wait for the proposal to pass.
+	height, err := wrapper.chain.Height(ctx)
+	require.NoError(t, err)
+	require.NoError(t, wrapper.chain.VoteOnProposalAllValidators(ctx, proposalID, cosmos.ProposalVoteYes))
+	_, err = cosmos.PollForProposalStatusV1(ctx, wrapper.chain, height, height+20, proposalID, govv1.StatusPassed)
+	require.NoError(t, err)
+
+	// ASSERT: Alice is the owner of the module and submodule.
+	EnsureOwner(t, wrapper, ctx, wrapper.alice.FormattedAddress())
+	EnsureBlocklistOwner(t, wrapper, ctx, wrapper.alice.FormattedAddress())
+}
@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/ondoprotocol/usdy-noble/v2/types"
 	"github.com/ondoprotocol/usdy-noble/v2/types/blocklist"
@@ -60,6 +61,7 @@ func Suite(t *testing.T, wrapper *Wrapper, ibcEnabled bool) (ctx context.Context
 				Bin:            "simd",
 				Bech32Prefix:   "noble",
 				Denom:          "ustake",
+				EncodingConfig: AuraEncoding(),
 				GasPrices:      "0.0ustake",
 				GasAdjustment:  5,
 				TrustingPeriod: "504h",
@@ -127,6 +129,10 @@ func Suite(t *testing.T, wrapper *Wrapper, ibcEnabled bool) (ctx context.Context
 						{Key: "app_state.aura.burners", Value: []types.Burner{
 							{Address: wrapper.burner.FormattedAddress(), Allowance: ONE},
 						}},
+						{Key: "app_state.gov.params.min_deposit", Value: sdk.NewCoins(sdk.NewInt64Coin("ustake", 1))},
+						{Key: "app_state.gov.params.expedited_min_deposit", Value: sdk.NewCoins(sdk.NewInt64Coin("ustake", 2))},
+						{Key: "app_state.gov.params.voting_period", Value: "10s"},
+						{Key: "app_state.gov.params.expedited_voting_period", Value: "5s"},
 					}
 
 					return cosmos.ModifyGenesis(changes)(cfg, bz)
@@ -211,6 +217,16 @@ func Suite(t *testing.T, wrapper *Wrapper, ibcEnabled bool) (ctx context.Context
 
 //
 
+// AuraEncoding returns the default encoding config, extended with the
+// messages of this module so that they can be included in proposals.
+func AuraEncoding() *testutil.TestEncodingConfig {
+	cfg := cosmos.DefaultEncoding()
+	types.RegisterInterfaces(cfg.InterfaceRegistry)
+	blocklist.RegisterInterfaces(cfg.InterfaceRegistry)
+
+	return &cfg
+}
+
 func EnsureBlocked(t *testing.T, wrapper Wrapper, ctx context.Context, address string) {
 	validator := wrapper.chain.Validators[0]
 
@@ -247,14 +263,50 @@ func EnsureMinter(t *testing.T, wrapper Wrapper, ctx context.Context, address st
 	require.Contains(t, res.Minters, types.Minter{Address: address, Allowance: allowance})
 }
 
-func EnsureBlockedChannel(t *testing.T, wrapper Wrapper, ctx context.Context, channel string) {
+func EnsureBlockedChannel(t *testing.T, wrapper Wrapper, ctx context.Context, channel string, direction types.ChannelDirection) {
 	validator := wrapper.chain.Validators[0]
 
 	raw, _, err := validator.ExecQuery(ctx, "aura", "blocked-channels")
 	require.NoError(t, err)
 
 	var res types.QueryBlockedChannelsResponse
-	require.NoError(t, json.Unmarshal(raw, &res))
+	require.NoError(t, jsonpb.UnmarshalString(string(raw), &res))
+
+	require.Contains(t, res.BlockedChannels, types.BlockedChannel{Channel: channel, Direction: direction})
+}
+
+func EnsureOwner(t *testing.T, wrapper Wrapper, ctx context.Context, owner string) {
+	validator := wrapper.chain.Validators[0]
+
+	raw, _, err := validator.ExecQuery(ctx, "aura", "owner")
+	require.NoError(t, err)
+
+	var res types.QueryOwnerResponse
+	require.NoError(t, jsonpb.UnmarshalString(string(raw), &res))
+
+	require.Equal(t, owner, res.Owner)
+}
+
+func EnsureBlocklistOwner(t *testing.T, wrapper Wrapper, ctx context.Context, owner string) {
+	validator := wrapper.chain.Validators[0]
+
+	raw, _, err := validator.ExecQuery(ctx, "aura", "blocklist", "owner")
+	require.NoError(t, err)
+
+	var res blocklist.QueryOwnerResponse
+	require.NoError(t, jsonpb.UnmarshalString(string(raw), &res))
+
+	require.Equal(t, owner, res.Owner)
+}
+
+func EnsureChannelMode(t *testing.T, wrapper Wrapper, ctx context.Context, mode types.ChannelMode) {
+	validator := wrapper.chain.Validators[0]
+
+	raw, _, err := validator.ExecQuery(ctx, "aura", "channel-mode")
+	require.NoError(t, err)
+
+	var res types.QueryChannelModeResponse
+	require.NoError(t, jsonpb.UnmarshalString(string(raw), &res))
 
-	require.Contains(t, res.BlockedChannels, channel)
+	require.Equal(t, mode, res.Mode)
 }
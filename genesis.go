@@ -1,18 +1,21 @@
 package aura
 
 import (
+	"fmt"
+
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ondoprotocol/usdy-noble/v2/keeper"
 	"github.com/ondoprotocol/usdy-noble/v2/types"
 	"github.com/ondoprotocol/usdy-noble/v2/types/blocklist"
 )
 
 func InitGenesis(ctx sdk.Context, k *keeper.Keeper, addressCodec address.Codec, genesis types.GenesisState) {
-	if err := k.SetBlocklistOwner(ctx, genesis.BlocklistState.Owner); err != nil {
+	if err := k.SetBlocklistOwner(ctx, canonicalAddress(addressCodec, genesis.BlocklistState.Owner)); err != nil {
 		panic(err)
 	}
-	if err := k.SetBlocklistPendingOwner(ctx, genesis.BlocklistState.PendingOwner); err != nil {
+	if err := k.SetBlocklistPendingOwner(ctx, canonicalAddress(addressCodec, genesis.BlocklistState.PendingOwner)); err != nil {
 		panic(err)
 	}
 	for _, account := range genesis.BlocklistState.BlockedAddresses {
@@ -21,45 +24,280 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, addressCodec address.Codec,
 			panic(err)
 		}
 	}
+	for _, account := range genesis.BlocklistState.SuspendedAddresses {
+		address, _ := addressCodec.StringToBytes(account)
+		if err := k.SetSuspendedAddress(ctx, address); err != nil {
+			panic(err)
+		}
+	}
+
+	initDenomState(ctx, k, addressCodec, genesis.DefaultDenomState(k.Denom))
+	for _, state := range genesis.Denoms {
+		if state.Denom == k.Denom || !k.HasDenom(state.Denom) {
+			panic(fmt.Sprintf("%s is not an additional denom governed by this module", state.Denom))
+		}
 
-	if err := k.SetPaused(ctx, genesis.Paused); err != nil {
+		initDenomState(ctx, k, addressCodec, state)
+		if err := k.SetBlocklistBinding(ctx, state.Denom, state.BlocklistBound); err != nil {
+			panic(err)
+		}
+	}
+	for _, metadata := range genesis.DenomMetadata {
+		if err := k.SetDenomMetadata(ctx, metadata); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.SetChannelMode(ctx, genesis.ChannelMode); err != nil {
 		panic(err)
 	}
-	if err := k.SetOwner(ctx, genesis.Owner); err != nil {
+	for _, channel := range genesis.AllowedChannels {
+		if err := k.SetAllowedChannel(ctx, channel); err != nil {
+			panic(err)
+		}
+	}
+	for _, rateLimit := range genesis.RateLimits {
+		if err := k.SetRateLimit(ctx, rateLimit); err != nil {
+			panic(err)
+		}
+	}
+	for _, flow := range genesis.RateLimitFlows {
+		if err := k.SetRateLimitFlow(ctx, flow); err != nil {
+			panic(err)
+		}
+	}
+	for _, priceSetter := range genesis.PriceSetters {
+		address, _ := addressCodec.StringToBytes(priceSetter)
+		if err := k.SetPriceSetter(ctx, address); err != nil {
+			panic(err)
+		}
+	}
+	for _, priceRange := range genesis.PriceRanges {
+		if err := k.SetPriceRange(ctx, priceRange); err != nil {
+			panic(err)
+		}
+	}
+	if !genesis.MaxPriceDeviation.IsNil() && !genesis.MaxPriceDeviation.IsZero() {
+		if err := k.SetMaxPriceDeviation(ctx, genesis.MaxPriceDeviation); err != nil {
+			panic(err)
+		}
+	}
+	if genesis.Stablecoin.IsSet() {
+		if err := k.SetStablecoin(ctx, genesis.Stablecoin); err != nil {
+			panic(err)
+		}
+	}
+	for _, subscription := range genesis.Subscriptions {
+		if err := k.SetSubscription(ctx, subscription); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.SetNextSubscriptionID(ctx, genesis.NextSubscriptionId); err != nil {
 		panic(err)
 	}
-	if err := k.SetPendingOwner(ctx, genesis.PendingOwner); err != nil {
+	for _, redemption := range genesis.Redemptions {
+		if err := k.SetRedemption(ctx, redemption); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.SetNextRedemptionID(ctx, genesis.NextRedemptionId); err != nil {
+		panic(err)
+	}
+	if !genesis.SwapConfig.Fee.IsNil() {
+		if err := k.SetSwapConfig(ctx, genesis.SwapConfig); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.SetSwapsPaused(ctx, genesis.SwapsPaused); err != nil {
+		panic(err)
+	}
+	if genesis.SwapLiquidity.Denom != "" {
+		if err := k.SetSwapLiquidity(ctx, genesis.SwapLiquidity); err != nil {
+			panic(err)
+		}
+	}
+	for _, volume := range genesis.SwapVolumes {
+		address, _ := addressCodec.StringToBytes(volume.Address)
+		if err := k.SetSwapVolume(ctx, address, volume); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.SetLockupPeriod(ctx, genesis.LockupPeriod); err != nil {
 		panic(err)
 	}
-	for _, burner := range genesis.Burners {
-		if err := k.SetBurner(ctx, burner.Address, burner.Allowance); err != nil {
+	for _, lot := range genesis.LockedLots {
+		address, _ := addressCodec.StringToBytes(lot.Address)
+		if err := k.SetLockedLot(ctx, address, lot.UnlockTime, lot.Amount); err != nil {
 			panic(err)
 		}
 	}
-	for _, minter := range genesis.Minters {
-		if err := k.SetMinter(ctx, minter.Address, minter.Allowance); err != nil {
+	for _, attestor := range genesis.Attestors {
+		address, _ := addressCodec.StringToBytes(attestor)
+		if err := k.SetAttestor(ctx, address); err != nil {
 			panic(err)
 		}
 	}
-	for _, pauser := range genesis.Pausers {
-		if err := k.SetPauser(ctx, pauser); err != nil {
+	for _, attestation := range genesis.Attestations {
+		if err := k.SetAttestation(ctx, attestation); err != nil {
+			panic(err)
+		}
+	}
+	if !genesis.ReserveConfig.CollateralRatio.IsNil() {
+		if err := k.SetReserveConfig(ctx, genesis.ReserveConfig); err != nil {
+			panic(err)
+		}
+	}
+	for _, window := range genesis.PauseWindows {
+		if err := k.SetPauseWindow(ctx, window); err != nil {
 			panic(err)
 		}
 	}
 }
 
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
+	// NOTE: The state of the default denom is exported at the top level.
+	var denoms []types.DenomState
+	for _, denom := range k.Denoms[1:] {
+		denoms = append(denoms, types.DenomState{
+			Denom:           denom,
+			PausedScopes:    k.GetPausedScopes(ctx, denom),
+			Owner:           k.GetOwner(ctx, denom),
+			PendingOwner:    k.GetPendingOwner(ctx, denom),
+			Burners:         k.GetBurners(ctx, denom),
+			Minters:         k.GetMinters(ctx, denom),
+			Pausers:         k.GetPausers(ctx, denom),
+			BlockedChannels: k.GetBlockedChannels(ctx, denom),
+			PauseExemptions: k.GetPauseExemptions(ctx, denom),
+			BlocklistBound:  k.IsBlocklistBound(ctx, denom),
+			Recoverers:      k.GetRecoverers(ctx, denom),
+		})
+	}
+
+	var metadata []banktypes.Metadata
+	for _, denom := range k.Denoms {
+		if denomMetadata, found := k.GetDenomMetadata(ctx, denom); found {
+			metadata = append(metadata, denomMetadata)
+		}
+	}
+
 	return &types.GenesisState{
 		BlocklistState: blocklist.GenesisState{
-			Owner:            k.GetBlocklistOwner(ctx),
-			PendingOwner:     k.GetBlocklistPendingOwner(ctx),
-			BlockedAddresses: k.GetBlockedAddresses(ctx),
+			Owner:              k.GetBlocklistOwner(ctx),
+			PendingOwner:       k.GetBlocklistPendingOwner(ctx),
+			BlockedAddresses:   k.GetBlockedAddresses(ctx),
+			SuspendedAddresses: k.GetSuspendedAddresses(ctx),
 		},
-		Paused:       k.GetPaused(ctx),
-		Owner:        k.GetOwner(ctx),
-		PendingOwner: k.GetPendingOwner(ctx),
-		Burners:      k.GetBurners(ctx),
-		Minters:      k.GetMinters(ctx),
-		Pausers:      k.GetPausers(ctx),
+		PausedScopes:       k.GetPausedScopes(ctx, k.Denom),
+		Owner:              k.GetOwner(ctx, k.Denom),
+		PendingOwner:       k.GetPendingOwner(ctx, k.Denom),
+		Burners:            k.GetBurners(ctx, k.Denom),
+		Minters:            k.GetMinters(ctx, k.Denom),
+		Pausers:            k.GetPausers(ctx, k.Denom),
+		PauseExemptions:    k.GetPauseExemptions(ctx, k.Denom),
+		ChannelMode:        k.GetChannelMode(ctx),
+		AllowedChannels:    k.GetAllowedChannels(ctx),
+		RateLimits:         k.GetRateLimits(ctx),
+		RateLimitFlows:     k.GetRateLimitFlows(ctx),
+		PriceSetters:       k.GetPriceSetters(ctx),
+		PriceRanges:        k.GetPriceRanges(ctx),
+		MaxPriceDeviation:  k.GetMaxPriceDeviation(ctx),
+		Stablecoin:         k.GetStablecoin(ctx),
+		Subscriptions:      k.GetSubscriptions(ctx),
+		NextSubscriptionId: k.GetNextSubscriptionID(ctx),
+		Redemptions:        k.GetRedemptions(ctx),
+		NextRedemptionId:   k.GetNextRedemptionID(ctx),
+		SwapConfig:         k.GetSwapConfig(ctx),
+		SwapsPaused:        k.GetSwapsPaused(ctx),
+		SwapLiquidity:      k.GetSwapLiquidity(ctx),
+		SwapVolumes:        k.GetSwapVolumes(ctx),
+		LockupPeriod:       k.GetLockupPeriod(ctx),
+		LockedLots:         k.GetLockedLots(ctx),
+		Denoms:             denoms,
+		DenomMetadata:      metadata,
+		Attestors:          k.GetAttestors(ctx),
+		Attestations:       k.GetAttestations(ctx),
+		ReserveConfig:      k.GetReserveConfig(ctx),
+		Recoverers:         k.GetRecoverers(ctx, k.Denom),
+		PauseWindows:       k.GetPauseWindows(ctx),
+
+		DirectionalBlockedChannels: k.GetBlockedChannels(ctx, k.Denom),
 	}
 }
+
+func initDenomState(ctx sdk.Context, k *keeper.Keeper, addressCodec address.Codec, state types.DenomState) {
+	for _, scope := range state.PausedScopes {
+		if err := k.SetPaused(ctx, state.Denom, scope, true); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.SetOwner(ctx, state.Denom, canonicalAddress(addressCodec, state.Owner)); err != nil {
+		panic(err)
+	}
+	if err := k.SetPendingOwner(ctx, state.Denom, canonicalAddress(addressCodec, state.PendingOwner)); err != nil {
+		panic(err)
+	}
+	for _, burner := range state.Burners {
+		address, _ := addressCodec.StringToBytes(burner.Address)
+		if err := k.SetBurner(ctx, state.Denom, address, burner.Allowance); err != nil {
+			panic(err)
+		}
+		if err := k.SetBurnerExpiry(ctx, state.Denom, address, burner.Expiry); err != nil {
+			panic(err)
+		}
+		for _, source := range burner.Sources {
+			sourceAddress, _ := addressCodec.StringToBytes(source)
+			if err := k.SetBurnerSource(ctx, state.Denom, address, sourceAddress); err != nil {
+				panic(err)
+			}
+		}
+	}
+	for _, minter := range state.Minters {
+		address, _ := addressCodec.StringToBytes(minter.Address)
+		if err := k.SetMinter(ctx, state.Denom, address, minter.Allowance); err != nil {
+			panic(err)
+		}
+		if err := k.SetMinterExpiry(ctx, state.Denom, address, minter.Expiry); err != nil {
+			panic(err)
+		}
+		for _, recipient := range minter.Recipients {
+			recipientAddress, _ := addressCodec.StringToBytes(recipient)
+			if err := k.SetMinterRecipient(ctx, state.Denom, address, recipientAddress); err != nil {
+				panic(err)
+			}
+		}
+	}
+	for _, pauser := range state.Pausers {
+		address, _ := addressCodec.StringToBytes(pauser)
+		if err := k.SetPauser(ctx, state.Denom, address); err != nil {
+			panic(err)
+		}
+	}
+	for _, channel := range state.BlockedChannels {
+		if err := k.SetBlockedChannel(ctx, state.Denom, channel.Channel, channel.Direction); err != nil {
+			panic(err)
+		}
+	}
+	for _, exemption := range state.PauseExemptions {
+		address, _ := addressCodec.StringToBytes(exemption.Address)
+		if err := k.SetPauseExemption(ctx, state.Denom, address, exemption.Direction); err != nil {
+			panic(err)
+		}
+	}
+	for _, recoverer := range state.Recoverers {
+		address, _ := addressCodec.StringToBytes(recoverer)
+		if err := k.SetRecoverer(ctx, state.Denom, address); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// canonicalAddress returns the canonical encoding of an owner address, which
+// is empty if no owner is set.
+func canonicalAddress(addressCodec address.Codec, account string) string {
+	bz, err := addressCodec.StringToBytes(account)
+	if err != nil {
+		return ""
+	}
+
+	canonical, _ := addressCodec.BytesToString(bz)
+	return canonical
+}
@@ -0,0 +1,449 @@
+package aura_test
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ondoprotocol/usdy-noble/v2"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types/blocklist"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisRoundTrip(t *testing.T) {
+	addressCodec := address.NewBech32Codec("noble")
+
+	for seed := int64(0); seed < 100; seed++ {
+		t.Run(fmt.Sprintf("Seed=%d", seed), func(t *testing.T) {
+			k, ctx := mocks.AuraKeeper()
+
+			// ARRANGE: Generate a random genesis state.
+			genesis := RandomGenesisState(rand.New(rand.NewSource(seed)))
+			require.NoError(t, genesis.Validate(addressCodec))
+
+			// ACT: Import and export the genesis state.
+			aura.InitGenesis(ctx, k, addressCodec, genesis)
+			exported := aura.ExportGenesis(ctx, k)
+
+			// ASSERT: The exported genesis state should match the imported one.
+			require.Equal(t, CanonicalGenesisJSON(t, genesis), CanonicalGenesisJSON(t, *exported))
+		})
+	}
+}
+
+func TestGenesisCanonicalOwners(t *testing.T) {
+	addressCodec := address.NewBech32Codec("noble")
+	k, ctx := mocks.AuraKeeper()
+	owner := RandomAddress(rand.New(rand.NewSource(0)))
+
+	// ARRANGE: Generate a genesis state with uppercase owner addresses.
+	genesis := *types.DefaultGenesisState()
+	genesis.Owner = strings.ToUpper(owner)
+	genesis.BlocklistState.PendingOwner = strings.ToUpper(owner)
+	require.NoError(t, genesis.Validate(addressCodec))
+
+	// ACT: Import and export the genesis state.
+	aura.InitGenesis(ctx, k, addressCodec, genesis)
+	exported := aura.ExportGenesis(ctx, k)
+
+	// ASSERT: The canonical addresses should've been stored.
+	require.Equal(t, owner, exported.Owner)
+	require.Equal(t, owner, exported.BlocklistState.PendingOwner)
+}
+
+func TestGenesisLegacyFields(t *testing.T) {
+	addressCodec := address.NewBech32Codec("noble")
+	k, ctx := mocks.AuraKeeper()
+
+	// ARRANGE: Generate a genesis state using the deprecated paused and blocked channels fields.
+	genesis := *types.DefaultGenesisState()
+	genesis.Paused = true
+	genesis.PausedScopes = []types.PauseScope{types.PauseScopeTransfers}
+	genesis.BlockedChannels = []string{"channel-0"}
+	genesis.DirectionalBlockedChannels = []types.BlockedChannel{{Channel: "channel-1", Direction: types.ChannelDirectionInbound}}
+
+	// ACT: Validate the genesis state.
+	err := genesis.Validate(addressCodec)
+	// ASSERT: The genesis state should be valid.
+	require.NoError(t, err)
+
+	// ACT: Import and export the genesis state.
+	aura.InitGenesis(ctx, k, addressCodec, genesis)
+	exported := aura.ExportGenesis(ctx, k)
+
+	// ASSERT: The paused flag should've paused all scopes but burning.
+	require.Equal(t, types.DefaultPauseScopes, k.GetPausedScopes(ctx, k.Denom))
+	require.False(t, exported.Paused)
+	require.Equal(t, types.DefaultPauseScopes, exported.PausedScopes)
+	// ASSERT: The legacy blocked channels should've been blocked outbound.
+	require.Equal(t, types.ChannelDirectionOutbound, k.GetBlockedChannel(ctx, k.Denom, "channel-0"))
+	require.Equal(t, types.ChannelDirectionInbound, k.GetBlockedChannel(ctx, k.Denom, "channel-1"))
+	require.Empty(t, exported.BlockedChannels)
+	require.Equal(t, []types.BlockedChannel{
+		{Channel: "channel-0", Direction: types.ChannelDirectionOutbound},
+		{Channel: "channel-1", Direction: types.ChannelDirectionInbound},
+	}, exported.DirectionalBlockedChannels)
+
+	// ARRANGE: Block a channel in both the deprecated and current fields.
+	genesis.BlockedChannels = []string{"channel-1"}
+
+	// ACT: Validate the genesis state.
+	err = genesis.Validate(addressCodec)
+	// ASSERT: The genesis state should be invalid due to the duplicate channel.
+	require.ErrorContains(t, err, "duplicate blocked channel (channel-1)")
+
+	// ARRANGE: Block an invalid channel in the deprecated field.
+	genesis.BlockedChannels = []string{"invalid"}
+
+	// ACT: Validate the genesis state.
+	err = genesis.Validate(addressCodec)
+	// ASSERT: The genesis state should be invalid due to the invalid channel.
+	require.ErrorContains(t, err, "invalid blocked channel (invalid)")
+}
+
+// RandomGenesisState generates a valid genesis state, where every field of
+// the state is populated with a random number of entries.
+func RandomGenesisState(r *rand.Rand) types.GenesisState {
+	genesis := types.GenesisState{
+		BlocklistState: blocklist.GenesisState{
+			Owner:        RandomAddress(r),
+			PendingOwner: RandomOptionalAddress(r),
+		},
+		ChannelMode: []types.ChannelMode{types.ChannelModeDenylist, types.ChannelModeAllowlist}[r.Intn(2)],
+	}
+
+	for i, n := 0, r.Intn(5); i < n; i++ {
+		address := RandomAddress(r)
+		genesis.BlocklistState.BlockedAddresses = append(genesis.BlocklistState.BlockedAddresses, address)
+		if r.Intn(2) == 0 {
+			genesis.BlocklistState.SuspendedAddresses = append(genesis.BlocklistState.SuspendedAddresses, address)
+		}
+	}
+
+	state := RandomDenomState(r, "ausdy")
+	genesis.PausedScopes = state.PausedScopes
+	genesis.Owner = state.Owner
+	genesis.PendingOwner = state.PendingOwner
+	genesis.Burners = state.Burners
+	genesis.Minters = state.Minters
+	genesis.Pausers = state.Pausers
+	genesis.DirectionalBlockedChannels = state.BlockedChannels
+	genesis.PauseExemptions = state.PauseExemptions
+	genesis.Recoverers = state.Recoverers
+	genesis.Denoms = []types.DenomState{RandomDenomState(r, "atbill")}
+	for _, denom := range []string{"ausdy", "atbill"} {
+		if r.Intn(2) == 0 {
+			genesis.DenomMetadata = append(genesis.DenomMetadata, DenomMetadata(denom))
+		}
+	}
+
+	genesis.AllowedChannels = RandomChannels(r)
+	for _, channel := range RandomChannels(r) {
+		genesis.RateLimits = append(genesis.RateLimits, types.RateLimit{
+			Channel:       channel,
+			Window:        time.Duration(r.Intn(86400)+1) * time.Second,
+			MaxAmount:     RandomAmount(r).AddRaw(1),
+			MaxPercentage: math.LegacyNewDecWithPrec(r.Int63n(100_000), 3),
+		})
+		genesis.RateLimitFlows = append(genesis.RateLimitFlows, types.RateLimitFlow{
+			Channel:     channel,
+			WindowStart: time.Unix(r.Int63n(2_000_000_000), 0).UTC(),
+			Inflow:      RandomAmount(r),
+			Outflow:     RandomAmount(r),
+			Supply:      RandomAmount(r),
+		})
+	}
+
+	for i, n := 0, r.Intn(5); i < n; i++ {
+		genesis.PriceSetters = append(genesis.PriceSetters, RandomAddress(r))
+	}
+	start := time.Unix(r.Int63n(2_000_000_000), 0).UTC()
+	for i, n := 0, r.Intn(5); i < n; i++ {
+		end := start.Add(time.Duration(r.Intn(30)+1) * types.Day)
+		genesis.PriceRanges = append(genesis.PriceRanges, types.PriceRange{
+			Index:      uint64(i),
+			Start:      start,
+			End:        end,
+			DailyRate:  math.LegacyOneDec().Add(math.LegacyNewDecWithPrec(r.Int63n(1_000), 6)),
+			StartPrice: math.LegacyNewDecWithPrec(r.Int63n(1_000_000)+1, 6),
+		})
+		start = end
+	}
+	genesis.MaxPriceDeviation = math.LegacyNewDecWithPrec(r.Int63n(1_000)+1, 3)
+
+	genesis.Stablecoin = types.Stablecoin{Denom: "uusdc", Exponent: uint32(r.Intn(19))}
+	statuses := []types.RequestStatus{types.RequestStatusPending, types.RequestStatusFulfilled, types.RequestStatusRejected}
+	for i, n := 0, r.Intn(5); i < n; i++ {
+		genesis.Subscriptions = append(genesis.Subscriptions, types.Subscription{
+			Id:        uint64(i),
+			User:      RandomAddress(r),
+			Deposit:   sdk.NewCoin("uusdc", RandomAmount(r).AddRaw(1)),
+			Status:    statuses[r.Intn(len(statuses))],
+			Price:     math.LegacyNewDecWithPrec(r.Int63n(1_000_000), 6),
+			Minted:    RandomAmount(r),
+			CreatedAt: time.Unix(r.Int63n(2_000_000_000), 0).UTC(),
+		})
+	}
+	genesis.NextSubscriptionId = uint64(len(genesis.Subscriptions) + r.Intn(5))
+
+	statuses = append(statuses, types.RequestStatusCancelled)
+	for i, n := 0, r.Intn(5); i < n; i++ {
+		payout := sdk.Coin{Amount: math.ZeroInt()}
+		if r.Intn(2) == 0 {
+			payout = sdk.NewCoin("uusdc", RandomAmount(r).AddRaw(1))
+		}
+		genesis.Redemptions = append(genesis.Redemptions, types.Redemption{
+			Id:        uint64(i),
+			User:      RandomAddress(r),
+			Amount:    RandomAmount(r).AddRaw(1),
+			Status:    statuses[r.Intn(len(statuses))],
+			Payout:    payout,
+			CreatedAt: time.Unix(r.Int63n(2_000_000_000), 0).UTC(),
+		})
+	}
+	genesis.NextRedemptionId = uint64(len(genesis.Redemptions) + r.Intn(5))
+
+	genesis.SwapConfig = types.SwapConfig{
+		Fee:        math.LegacyNewDecWithPrec(r.Int63n(1_000), 3),
+		DailyLimit: RandomAmount(r),
+	}
+	genesis.SwapsPaused = r.Intn(2) == 0
+	genesis.SwapLiquidity = sdk.NewCoin("uusdc", RandomAmount(r))
+	for i, n := 0, r.Intn(5); i < n; i++ {
+		genesis.SwapVolumes = append(genesis.SwapVolumes, types.SwapVolume{
+			Address: RandomAddress(r),
+			Day:     uint64(r.Intn(25_000)),
+			Volume:  RandomAmount(r).AddRaw(1),
+		})
+	}
+
+	genesis.LockupPeriod = time.Duration(r.Intn(365)) * types.Day
+	for i, n := 0, r.Intn(5); i < n; i++ {
+		genesis.LockedLots = append(genesis.LockedLots, types.LockedLot{
+			Address:    RandomAddress(r),
+			Amount:     RandomAmount(r).AddRaw(1),
+			UnlockTime: time.Unix(r.Int63n(2_000_000_000), 0).UTC(),
+		})
+	}
+
+	for i, n := 0, r.Intn(5); i < n; i++ {
+		genesis.Attestors = append(genesis.Attestors, RandomAddress(r))
+	}
+	asOf := time.Unix(r.Int63n(2_000_000_000), 0).UTC()
+	for i, n := 0, r.Intn(5); i < n; i++ {
+		genesis.Attestations = append(genesis.Attestations, types.Attestation{
+			Index:      uint64(i),
+			Attestor:   RandomAddress(r),
+			Amount:     RandomAmount(r),
+			AsOf:       asOf,
+			ReportHash: fmt.Sprintf("%064x", r.Uint64()),
+			PostedAt:   asOf.Add(time.Duration(r.Intn(86400)) * time.Second),
+		})
+		asOf = asOf.Add(time.Duration(r.Intn(30)+1) * types.Day)
+	}
+	genesis.ReserveConfig = types.ReserveConfig{
+		CollateralRatio: math.LegacyNewDecWithPrec(r.Int63n(2_000), 3),
+		MaxStaleness:    time.Duration(r.Intn(30)) * types.Day,
+	}
+
+	for _, denom := range []string{"ausdy", "atbill"} {
+		for _, scope := range types.PauseScopes {
+			if r.Intn(3) == 0 {
+				genesis.PauseWindows = append(genesis.PauseWindows, RandomPauseWindow(r, denom, scope))
+			}
+		}
+	}
+
+	return genesis
+}
+
+// RandomPauseWindow generates a pause window with a random start and end,
+// where each bound is either a height, a time, or unset.
+func RandomPauseWindow(r *rand.Rand, denom string, scope types.PauseScope) types.PauseWindow {
+	window := types.PauseWindow{Denom: denom, Scope: scope}
+	start := time.Unix(r.Int63n(2_000_000_000), 0).UTC()
+
+	if r.Intn(2) == 0 {
+		window.StartHeight = r.Int63n(1_000_000) + 1
+		window.StartTime = start
+	}
+	switch r.Intn(3) {
+	case 0:
+		window.EndHeight = window.StartHeight + r.Int63n(1_000_000) + 1
+	case 1:
+		window.EndTime = start.Add(time.Duration(r.Intn(30)+1) * types.Day)
+	default:
+		if !window.HasStart() {
+			window.EndHeight = r.Int63n(1_000_000) + 1
+		}
+	}
+
+	return window
+}
+
+// RandomDenomState generates the state of a governed denom, where the roles
+// and blocked channels are populated with a random number of entries.
+func RandomDenomState(r *rand.Rand, denom string) types.DenomState {
+	state := types.DenomState{
+		Denom:          denom,
+		Owner:          RandomAddress(r),
+		PendingOwner:   RandomOptionalAddress(r),
+		BlocklistBound: r.Intn(2) == 0,
+	}
+
+	for _, scope := range types.PauseScopes {
+		if r.Intn(2) == 0 {
+			state.PausedScopes = append(state.PausedScopes, scope)
+		}
+	}
+
+	for i, n := 0, r.Intn(5); i < n; i++ {
+		state.Burners = append(state.Burners, types.Burner{Address: RandomAddress(r), Allowance: RandomAmount(r), Sources: RandomAddresses(r)})
+	}
+	for i, n := 0, r.Intn(5); i < n; i++ {
+		state.Minters = append(state.Minters, types.Minter{Address: RandomAddress(r), Allowance: RandomAmount(r), Recipients: RandomAddresses(r)})
+	}
+	for i, n := 0, r.Intn(5); i < n; i++ {
+		state.Pausers = append(state.Pausers, RandomAddress(r))
+	}
+
+	directions := []types.ChannelDirection{types.ChannelDirectionOutbound, types.ChannelDirectionInbound, types.ChannelDirectionBoth}
+	for _, channel := range RandomChannels(r) {
+		state.BlockedChannels = append(state.BlockedChannels, types.BlockedChannel{
+			Channel:   channel,
+			Direction: directions[r.Intn(len(directions))],
+		})
+	}
+
+	exemptions := []types.ExemptionDirection{types.ExemptionDirectionSend, types.ExemptionDirectionReceive, types.ExemptionDirectionBoth}
+	for i, n := 0, r.Intn(5); i < n; i++ {
+		state.PauseExemptions = append(state.PauseExemptions, types.PauseExemption{
+			Address:   RandomAddress(r),
+			Direction: exemptions[r.Intn(len(exemptions))],
+		})
+	}
+
+	for i, n := 0, r.Intn(5); i < n; i++ {
+		state.Recoverers = append(state.Recoverers, RandomAddress(r))
+	}
+
+	return state
+}
+
+// DenomMetadata returns valid bank metadata of a governed denom with 18
+// decimals, e.g. "ausdy" is displayed as "usdy".
+func DenomMetadata(denom string) banktypes.Metadata {
+	display := denom[1:]
+
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("Metadata of %s", denom),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: display, Exponent: 18},
+		},
+		Base:    denom,
+		Display: display,
+		Name:    strings.ToUpper(display),
+		Symbol:  strings.ToUpper(display),
+	}
+}
+
+func RandomAddress(r *rand.Rand) string {
+	bz := make([]byte, 20)
+	_, _ = r.Read(bz)
+
+	address, _ := sdk.Bech32ifyAddressBytes("noble", bz)
+	return address
+}
+
+func RandomOptionalAddress(r *rand.Rand) string {
+	if r.Intn(2) == 0 {
+		return ""
+	}
+
+	return RandomAddress(r)
+}
+
+// RandomAddresses returns a random number of addresses, or nil if none.
+func RandomAddresses(r *rand.Rand) (addresses []string) {
+	for i, n := 0, r.Intn(3); i < n; i++ {
+		addresses = append(addresses, RandomAddress(r))
+	}
+
+	return
+}
+
+func RandomAmount(r *rand.Rand) math.Int {
+	return math.NewIntFromUint64(r.Uint64())
+}
+
+// RandomChannels returns a random set of unique channel identifiers.
+func RandomChannels(r *rand.Rand) (channels []string) {
+	for _, sequence := range r.Perm(10)[:r.Intn(5)] {
+		channels = append(channels, fmt.Sprintf("channel-%d", sequence))
+	}
+
+	return
+}
+
+// CanonicalGenesisJSON sorts all lists of a genesis state, as state is
+// exported in key order, and encodes it as JSON for comparison.
+func CanonicalGenesisJSON(t *testing.T, genesis types.GenesisState) string {
+	sort.Strings(genesis.BlocklistState.BlockedAddresses)
+	sort.Strings(genesis.BlocklistState.SuspendedAddresses)
+	SortRoles(genesis.Burners, genesis.Minters, genesis.Pausers, genesis.DirectionalBlockedChannels, genesis.PauseExemptions)
+	for _, state := range genesis.Denoms {
+		SortRoles(state.Burners, state.Minters, state.Pausers, state.BlockedChannels, state.PauseExemptions)
+		sort.Strings(state.Recoverers)
+	}
+	sort.Strings(genesis.AllowedChannels)
+	sort.Slice(genesis.RateLimits, func(i, j int) bool { return genesis.RateLimits[i].Channel < genesis.RateLimits[j].Channel })
+	sort.Slice(genesis.RateLimitFlows, func(i, j int) bool {
+		return genesis.RateLimitFlows[i].Channel < genesis.RateLimitFlows[j].Channel
+	})
+	sort.Strings(genesis.PriceSetters)
+	sort.Strings(genesis.Attestors)
+	sort.Strings(genesis.Recoverers)
+	sort.Slice(genesis.SwapVolumes, func(i, j int) bool {
+		return genesis.SwapVolumes[i].Address < genesis.SwapVolumes[j].Address
+	})
+	sort.Slice(genesis.DenomMetadata, func(i, j int) bool {
+		return genesis.DenomMetadata[i].Base < genesis.DenomMetadata[j].Base
+	})
+	sort.Slice(genesis.LockedLots, func(i, j int) bool {
+		return genesis.LockedLots[i].Address < genesis.LockedLots[j].Address
+	})
+	sort.SliceStable(genesis.PauseWindows, func(i, j int) bool {
+		return genesis.PauseWindows[i].Denom < genesis.PauseWindows[j].Denom
+	})
+
+	bz, err := mocks.Codec().MarshalJSON(&genesis)
+	require.NoError(t, err)
+
+	return string(bz)
+}
+
+// SortRoles sorts the roles, including the bound addresses of burners and
+// minters, blocked channels, and pause exemptions of a governed denom.
+func SortRoles(burners []types.Burner, minters []types.Minter, pausers []string, blockedChannels []types.BlockedChannel, pauseExemptions []types.PauseExemption) {
+	sort.Slice(burners, func(i, j int) bool { return burners[i].Address < burners[j].Address })
+	sort.Slice(minters, func(i, j int) bool { return minters[i].Address < minters[j].Address })
+	for _, burner := range burners {
+		sort.Strings(burner.Sources)
+	}
+	for _, minter := range minters {
+		sort.Strings(minter.Recipients)
+	}
+	sort.Strings(pausers)
+	sort.Slice(blockedChannels, func(i, j int) bool { return blockedChannels[i].Channel < blockedChannels[j].Channel })
+	sort.Slice(pauseExemptions, func(i, j int) bool { return pauseExemptions[i].Address < pauseExemptions[j].Address })
+}
@@ -0,0 +1,29 @@
+package aura
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the IBC transfer application, marking the callbacks of
+// packets sent from this chain, so that refunds of timed out or failed
+// transfers don't count towards the inbound quota of rate limited channels.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+}
+
+func NewIBCMiddleware(app porttypes.IBCModule) IBCMiddleware {
+	return IBCMiddleware{IBCModule: app}
+}
+
+func (m IBCMiddleware) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	return m.IBCModule.OnAcknowledgementPacket(sdk.UnwrapSDKContext(types.WithRefund(ctx)), packet, acknowledgement, relayer)
+}
+
+func (m IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return m.IBCModule.OnTimeoutPacket(sdk.UnwrapSDKContext(types.WithRefund(ctx)), packet, relayer)
+}
@@ -0,0 +1,124 @@
+package aura_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/ondoprotocol/usdy-noble/v2"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIBCMiddleware(t *testing.T) {
+	_, ctx := mocks.AuraKeeper()
+	app := &RefundRecorder{}
+	middleware := aura.NewIBCMiddleware(app)
+
+	// ACT: Acknowledge a packet sent from Noble.
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, channeltypes.Packet{}, nil, nil))
+	// ASSERT: The callback should've been marked as a refund.
+	require.True(t, app.Refund)
+
+	// ACT: Time out a packet sent from Noble.
+	app.Refund = false
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, channeltypes.Packet{}, nil))
+	// ASSERT: The callback should've been marked as a refund.
+	require.True(t, app.Refund)
+
+	// ACT: Receive a packet sent to Noble.
+	app.Refund = false
+	middleware.OnRecvPacket(ctx, channeltypes.Packet{}, nil)
+	// ASSERT: The callback shouldn't have been marked as a refund.
+	require.False(t, app.Refund)
+}
+
+func TestIBCMiddlewareRefundRateLimit(t *testing.T) {
+	user := utils.TestAccount()
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	one := math.NewInt(1_000_000_000_000_000_000)
+
+	// ARRANGE: Set a rate limit of 2 $USDY per hour on channel-0.
+	require.NoError(t, k.SetRateLimit(ctx, types.RateLimit{
+		Channel:       "channel-0",
+		Window:        time.Hour,
+		MaxAmount:     one.MulRaw(2),
+		MaxPercentage: math.LegacyZeroDec(),
+	}))
+
+	// ARRANGE: Transfer 2 $USDY out of Noble, exhausting the outbound quota.
+	_, err := k.SendRestrictionFn(ctx, user.Bytes, escrow, sdk.NewCoins(sdk.NewCoin(k.Denom, one.MulRaw(2))))
+	require.NoError(t, err)
+
+	// ACT: Time out the transfer, refunding 1 $USDY from the escrow account.
+	app := &Refunder{Keeper: k, From: escrow, To: user.Bytes, Amount: one}
+	require.NoError(t, aura.NewIBCMiddleware(app).OnTimeoutPacket(ctx, channeltypes.Packet{}, nil))
+	// ASSERT: The refund should've released the outflow, without counting as inflow.
+	flow := k.GetCurrentRateLimitFlow(ctx, k.GetRateLimit(ctx, "channel-0"))
+	require.Equal(t, one, flow.Outflow)
+	require.True(t, flow.Inflow.IsZero())
+
+	// ACT: Acknowledge a failed transfer, refunding 5 $USDY from the escrow account.
+	app.Amount = one.MulRaw(5)
+	require.NoError(t, aura.NewIBCMiddleware(app).OnAcknowledgementPacket(ctx, channeltypes.Packet{}, nil, nil))
+	// ASSERT: The refund should've released the outflow down to zero.
+	flow = k.GetCurrentRateLimitFlow(ctx, k.GetRateLimit(ctx, "channel-0"))
+	require.True(t, flow.Outflow.IsZero())
+	require.True(t, flow.Inflow.IsZero())
+}
+
+// Refunder is an IBC application that refunds a transfer out of an escrow
+// account in its acknowledgement and timeout callbacks.
+type Refunder struct {
+	porttypes.IBCModule
+	Keeper *keeper.Keeper
+	From   sdk.AccAddress
+	To     sdk.AccAddress
+	Amount math.Int
+}
+
+func (r *Refunder) OnAcknowledgementPacket(ctx sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress) error {
+	_, err := r.Keeper.SendRestrictionFn(ctx, r.From, r.To, sdk.NewCoins(sdk.NewCoin(r.Keeper.Denom, r.Amount)))
+	return err
+}
+
+func (r *Refunder) OnTimeoutPacket(ctx sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) error {
+	_, err := r.Keeper.SendRestrictionFn(ctx, r.From, r.To, sdk.NewCoins(sdk.NewCoin(r.Keeper.Denom, r.Amount)))
+	return err
+}
+
+// RefundRecorder is an IBC application that records whether its packet
+// callbacks were marked as refunds.
+type RefundRecorder struct {
+	porttypes.IBCModule
+	Refund bool
+}
+
+func (r *RefundRecorder) OnRecvPacket(ctx sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	r.Refund = types.IsRefund(ctx)
+	return channeltypes.NewResultAcknowledgement([]byte{1})
+}
+
+func (r *RefundRecorder) OnAcknowledgementPacket(ctx sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress) error {
+	r.Refund = types.IsRefund(ctx)
+	return nil
+}
+
+func (r *RefundRecorder) OnTimeoutPacket(ctx sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) error {
+	r.Refund = types.IsRefund(ctx)
+	return nil
+}
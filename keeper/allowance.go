@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+// getBurnAllowance returns the allowance of a burner, which is zero once it
+// has expired.
+func (k *Keeper) getBurnAllowance(ctx context.Context, denom string, burner []byte) math.Int {
+	if types.AllowanceExpired(k.GetBurnerExpiry(ctx, denom, burner), sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return math.ZeroInt()
+	}
+
+	return k.GetBurner(ctx, denom, burner)
+}
+
+// getMintAllowance returns the allowance of a minter, which is zero once it
+// has expired.
+func (k *Keeper) getMintAllowance(ctx context.Context, denom string, minter []byte) math.Int {
+	if types.AllowanceExpired(k.GetMinterExpiry(ctx, denom, minter), sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return math.ZeroInt()
+	}
+
+	return k.GetMinter(ctx, denom, minter)
+}
+
+// validateExpiry ensures that an optional allowance expiry is in the future.
+func (k *Keeper) validateExpiry(ctx context.Context, expiry time.Time) error {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if !expiry.IsZero() && !expiry.After(now) {
+		return fmt.Errorf("expiry must be after current time %s", now)
+	}
+
+	return nil
+}
+
+// expireAllowances resets the expired allowances of burners and minters to
+// zero, keeping their roles. Only expiries that are reached are visited, and
+// each of them is reset in isolation.
+func (k *Keeper) expireAllowances(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	rng := collections.NewPrefixUntilTripleRange[time.Time, string, []byte](sdkCtx.BlockTime())
+
+	// NOTE: Expiries are collected first, as they are removed below.
+	for _, key := range dueKeys(sdkCtx, k.BurnerExpiriesByTime, rng) {
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.expireBurnAllowance(cacheCtx, key.K2(), key.K3(), key.K1()); err != nil {
+			address, _ := k.addressCodec.BytesToString(key.K3())
+			sdkCtx.Logger().Error("unable to expire burn allowance", "denom", key.K2(), "burner", address, "err", err)
+			continue
+		}
+		write()
+	}
+
+	for _, key := range dueKeys(sdkCtx, k.MinterExpiriesByTime, rng) {
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.expireMintAllowance(cacheCtx, key.K2(), key.K3(), key.K1()); err != nil {
+			address, _ := k.addressCodec.BytesToString(key.K3())
+			sdkCtx.Logger().Error("unable to expire mint allowance", "denom", key.K2(), "minter", address, "err", err)
+			continue
+		}
+		write()
+	}
+}
+
+// expireBurnAllowance removes the expiry of a burner, and resets its allowance
+// to zero.
+func (k *Keeper) expireBurnAllowance(ctx context.Context, denom string, burner []byte, expiry time.Time) error {
+	allowance := k.GetBurner(ctx, denom, burner)
+	if err := k.DeleteBurnerExpiry(ctx, denom, burner); err != nil {
+		return err
+	}
+	if !k.HasBurner(ctx, denom, burner) {
+		return nil
+	}
+
+	if err := k.SetBurner(ctx, denom, burner, math.ZeroInt()); err != nil {
+		return err
+	}
+	address, _ := k.addressCodec.BytesToString(burner)
+	return k.eventService.EventManager(ctx).Emit(ctx, &types.BurnerAllowanceExpired{
+		Address:   address,
+		Allowance: allowance,
+		Denom:     denom,
+		Expiry:    expiry,
+	})
+}
+
+// expireMintAllowance removes the expiry of a minter, and resets its allowance
+// to zero.
+func (k *Keeper) expireMintAllowance(ctx context.Context, denom string, minter []byte, expiry time.Time) error {
+	allowance := k.GetMinter(ctx, denom, minter)
+	if err := k.DeleteMinterExpiry(ctx, denom, minter); err != nil {
+		return err
+	}
+	if !k.HasMinter(ctx, denom, minter) {
+		return nil
+	}
+
+	if err := k.SetMinter(ctx, denom, minter, math.ZeroInt()); err != nil {
+		return err
+	}
+	address, _ := k.addressCodec.BytesToString(minter)
+	return k.eventService.EventManager(ctx).Emit(ctx, &types.MinterAllowanceExpired{
+		Address:   address,
+		Allowance: allowance,
+		Denom:     denom,
+		Expiry:    expiry,
+	})
+}
@@ -3,67 +3,185 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/store"
+	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/ondoprotocol/usdy-noble/v2/types"
 	"github.com/ondoprotocol/usdy-noble/v2/types/blocklist"
 )
 
 type Keeper struct {
+	// Denom is the default denom governed by this module, i.e. USDY.
 	Denom string
+	// Denoms are all denoms governed by this module, starting with the default.
+	Denoms []string
+	// SharesDenom is the bank denom of shares of wrapped USDY, whose value
+	// in rUSDY is derived from the current price.
+	SharesDenom string
+
+	authority string
 
 	schema       collections.Schema
 	storeService store.KVStoreService
 	eventService event.Service
 
-	Paused          collections.Item[bool]
-	Owner           collections.Item[string]
-	PendingOwner    collections.Item[string]
-	Burners         collections.Map[string, math.Int]
-	Minters         collections.Map[string, math.Int]
-	Pausers         collections.Map[string, []byte]
-	BlockedChannels collections.Map[string, []byte]
+	Paused       collections.KeySet[collections.Pair[string, types.PauseScope]]
+	PauseWindows collections.Map[collections.Pair[string, types.PauseScope], types.PauseWindow]
+	// PauseWindowsByHeight and PauseWindowsByTime index the start and end
+	// bounds of pause windows, so that only due windows are processed.
+	PauseWindowsByHeight collections.KeySet[collections.Triple[int64, string, types.PauseScope]]
+	PauseWindowsByTime   collections.KeySet[collections.Triple[time.Time, string, types.PauseScope]]
+	PauseExemptions      collections.Map[collections.Pair[string, []byte], types.ExemptionDirection]
+	Owner                collections.Map[string, string]
+	PendingOwner         collections.Map[string, string]
+	Burners              collections.Map[collections.Pair[string, []byte], math.Int]
+	BurnerExpiries       collections.Map[collections.Pair[string, []byte], time.Time]
+	// BurnerExpiriesByTime indexes burner expiries, so that only due expiries
+	// are processed.
+	BurnerExpiriesByTime collections.KeySet[collections.Triple[time.Time, string, []byte]]
+	BurnerSources        collections.KeySet[collections.Triple[string, []byte, []byte]]
+	Minters              collections.Map[collections.Pair[string, []byte], math.Int]
+	MinterExpiries       collections.Map[collections.Pair[string, []byte], time.Time]
+	// MinterExpiriesByTime indexes minter expiries, so that only due expiries
+	// are processed.
+	MinterExpiriesByTime collections.KeySet[collections.Triple[time.Time, string, []byte]]
+	MinterRecipients     collections.KeySet[collections.Triple[string, []byte, []byte]]
+	Pausers              collections.Map[collections.Pair[string, []byte], []byte]
+	BlockedChannels      collections.Map[collections.Pair[string, string], types.ChannelDirection]
+	BlockedEscrows       collections.Map[collections.Pair[string, []byte], string]
+	BlocklistBinding     collections.KeySet[string]
+
+	ChannelMode     collections.Item[types.ChannelMode]
+	AllowedChannels collections.Map[string, []byte]
+	RateLimits      collections.Map[string, types.RateLimit]
+	RateLimitFlows  collections.Map[string, types.RateLimitFlow]
+	TransferEscrows collections.Map[[]byte, string]
+	IndexedChannels collections.Item[uint64]
+
+	PriceSetters      collections.Map[[]byte, []byte]
+	PriceRanges       collections.Map[uint64, types.PriceRange]
+	MaxPriceDeviation collections.Item[math.LegacyDec]
+
+	Stablecoin         collections.Item[types.Stablecoin]
+	Subscriptions      collections.Map[uint64, types.Subscription]
+	NextSubscriptionID collections.Sequence
+
+	Redemptions        collections.Map[uint64, types.Redemption]
+	PendingRedemptions collections.KeySet[uint64]
+	NextRedemptionID   collections.Sequence
+
+	SwapConfig    collections.Item[types.SwapConfig]
+	SwapsPaused   collections.Item[bool]
+	SwapLiquidity collections.Item[sdk.Coin]
+	SwapVolumes   collections.Map[[]byte, types.SwapVolume]
+
+	LockupPeriod collections.Item[int64]
+	LockedLots   collections.Map[collections.Pair[[]byte, time.Time], math.Int]
+
+	Attestors     collections.Map[[]byte, []byte]
+	Attestations  collections.Map[uint64, types.Attestation]
+	ReserveConfig collections.Item[types.ReserveConfig]
+
+	Recoverers collections.Map[collections.Pair[string, []byte], []byte]
 
 	BlocklistOwner        collections.Item[string]
 	BlocklistPendingOwner collections.Item[string]
 	BlockedAddresses      collections.Map[[]byte, []byte]
+	SuspendedAddresses    collections.KeySet[[]byte]
 
-	addressCodec address.Codec
-	bankKeeper   types.BankKeeper
+	addressCodec  address.Codec
+	bankKeeper    types.BankKeeper
+	channelKeeper types.ChannelKeeper
 }
 
 func NewKeeper(
 	denom string,
+	denoms []string,
+	authority string,
 	storeService store.KVStoreService,
 	eventService event.Service,
+	cdc codec.Codec,
 	addressCodec address.Codec,
 	bankKeeper types.BankKeeper,
 ) *Keeper {
 	builder := collections.NewSchemaBuilder(storeService)
 
 	keeper := &Keeper{
-		Denom: denom,
+		Denom:       denom,
+		Denoms:      append([]string{denom}, denoms...),
+		SharesDenom: types.SharesDenom(denom),
+
+		authority: authority,
 
 		storeService: storeService,
 		eventService: eventService,
 
-		Paused:          collections.NewItem(builder, types.PausedKey, "paused", collections.BoolValue),
-		Owner:           collections.NewItem(builder, types.OwnerKey, "owner", collections.StringValue),
-		PendingOwner:    collections.NewItem(builder, types.PendingOwnerKey, "pending_owner", collections.StringValue),
-		Burners:         collections.NewMap(builder, types.BurnerPrefix, "burners", collections.StringKey, sdk.IntValue),
-		Minters:         collections.NewMap(builder, types.MinterPrefix, "minters", collections.StringKey, sdk.IntValue),
-		Pausers:         collections.NewMap(builder, types.PauserPrefix, "pausers", collections.StringKey, collections.BytesValue),
-		BlockedChannels: collections.NewMap(builder, types.BlockedChannelPrefix, "blocked_channels", collections.StringKey, collections.BytesValue),
+		Paused:               collections.NewKeySet(builder, types.PausedPrefix, "paused", collections.PairKeyCodec(collections.StringKey, types.PauseScopeKey)),
+		PauseWindows:         collections.NewMap(builder, types.PauseWindowPrefix, "pause_windows", collections.PairKeyCodec(collections.StringKey, types.PauseScopeKey), codec.CollValue[types.PauseWindow](cdc)),
+		PauseWindowsByHeight: collections.NewKeySet(builder, types.PauseWindowHeightIndex, "pause_windows_by_height", collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, types.PauseScopeKey)),
+		PauseWindowsByTime:   collections.NewKeySet(builder, types.PauseWindowTimeIndex, "pause_windows_by_time", collections.TripleKeyCodec(sdk.TimeKey, collections.StringKey, types.PauseScopeKey)),
+		PauseExemptions:      collections.NewMap(builder, types.PauseExemptionPrefix, "pause_exemptions", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), types.ExemptionDirectionValue),
+		Owner:                collections.NewMap(builder, types.OwnerPrefix, "owner", collections.StringKey, collections.StringValue),
+		PendingOwner:         collections.NewMap(builder, types.PendingOwnerPrefix, "pending_owner", collections.StringKey, collections.StringValue),
+		Burners:              collections.NewMap(builder, types.BurnerPrefix, "burners", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), sdk.IntValue),
+		BurnerExpiries:       collections.NewMap(builder, types.BurnerExpiryPrefix, "burner_expiries", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), types.TimeValue),
+		BurnerExpiriesByTime: collections.NewKeySet(builder, types.BurnerExpiryTimeIndex, "burner_expiries_by_time", collections.TripleKeyCodec(sdk.TimeKey, collections.StringKey, collections.BytesKey)),
+		BurnerSources:        collections.NewKeySet(builder, types.BurnerSourcePrefix, "burner_sources", collections.TripleKeyCodec(collections.StringKey, collections.BytesKey, collections.BytesKey)),
+		Minters:              collections.NewMap(builder, types.MinterPrefix, "minters", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), sdk.IntValue),
+		MinterExpiries:       collections.NewMap(builder, types.MinterExpiryPrefix, "minter_expiries", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), types.TimeValue),
+		MinterExpiriesByTime: collections.NewKeySet(builder, types.MinterExpiryTimeIndex, "minter_expiries_by_time", collections.TripleKeyCodec(sdk.TimeKey, collections.StringKey, collections.BytesKey)),
+		MinterRecipients:     collections.NewKeySet(builder, types.MinterRecipientPrefix, "minter_recipients", collections.TripleKeyCodec(collections.StringKey, collections.BytesKey, collections.BytesKey)),
+		Pausers:              collections.NewMap(builder, types.PauserPrefix, "pausers", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), collections.BytesValue),
+		BlockedChannels:      collections.NewMap(builder, types.BlockedChannelPrefix, "blocked_channels", collections.PairKeyCodec(collections.StringKey, collections.StringKey), types.ChannelDirectionValue),
+		BlockedEscrows:       collections.NewMap(builder, types.BlockedEscrowPrefix, "blocked_escrows", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), collections.StringValue),
+		BlocklistBinding:     collections.NewKeySet(builder, types.BlocklistBindingPrefix, "blocklist_binding", collections.StringKey),
+
+		ChannelMode:     collections.NewItem(builder, types.ChannelModeKey, "channel_mode", types.ChannelModeValue),
+		AllowedChannels: collections.NewMap(builder, types.AllowedChannelPrefix, "allowed_channels", collections.StringKey, collections.BytesValue),
+		RateLimits:      collections.NewMap(builder, types.RateLimitPrefix, "rate_limits", collections.StringKey, codec.CollValue[types.RateLimit](cdc)),
+		RateLimitFlows:  collections.NewMap(builder, types.RateLimitFlowPrefix, "rate_limit_flows", collections.StringKey, codec.CollValue[types.RateLimitFlow](cdc)),
+		TransferEscrows: collections.NewMap(builder, types.TransferEscrowPrefix, "transfer_escrows", collections.BytesKey, collections.StringValue),
+		IndexedChannels: collections.NewItem(builder, types.IndexedChannelsKey, "indexed_channels", collections.Uint64Value),
+
+		PriceSetters:      collections.NewMap(builder, types.PriceSetterPrefix, "price_setters", collections.BytesKey, collections.BytesValue),
+		PriceRanges:       collections.NewMap(builder, types.PriceRangePrefix, "price_ranges", collections.Uint64Key, codec.CollValue[types.PriceRange](cdc)),
+		MaxPriceDeviation: collections.NewItem(builder, types.MaxPriceDeviationKey, "max_price_deviation", sdk.LegacyDecValue),
+
+		Stablecoin:         collections.NewItem(builder, types.StablecoinKey, "stablecoin", codec.CollValue[types.Stablecoin](cdc)),
+		Subscriptions:      collections.NewMap(builder, types.SubscriptionPrefix, "subscriptions", collections.Uint64Key, codec.CollValue[types.Subscription](cdc)),
+		NextSubscriptionID: collections.NewSequence(builder, types.NextSubscriptionIDKey, "next_subscription_id"),
+
+		Redemptions:        collections.NewMap(builder, types.RedemptionPrefix, "redemptions", collections.Uint64Key, codec.CollValue[types.Redemption](cdc)),
+		PendingRedemptions: collections.NewKeySet(builder, types.PendingRedemptionPrefix, "pending_redemptions", collections.Uint64Key),
+		NextRedemptionID:   collections.NewSequence(builder, types.NextRedemptionIDKey, "next_redemption_id"),
+
+		SwapConfig:    collections.NewItem(builder, types.SwapConfigKey, "swap_config", codec.CollValue[types.SwapConfig](cdc)),
+		SwapsPaused:   collections.NewItem(builder, types.SwapsPausedKey, "swaps_paused", collections.BoolValue),
+		SwapLiquidity: collections.NewItem(builder, types.SwapLiquidityKey, "swap_liquidity", codec.CollValue[sdk.Coin](cdc)),
+		SwapVolumes:   collections.NewMap(builder, types.SwapVolumePrefix, "swap_volumes", collections.BytesKey, codec.CollValue[types.SwapVolume](cdc)),
+
+		LockupPeriod: collections.NewItem(builder, types.LockupPeriodKey, "lockup_period", collections.Int64Value),
+		LockedLots:   collections.NewMap(builder, types.LockedLotPrefix, "locked_lots", collections.PairKeyCodec(collections.BytesKey, sdk.TimeKey), sdk.IntValue),
+
+		Attestors:     collections.NewMap(builder, types.AttestorPrefix, "attestors", collections.BytesKey, collections.BytesValue),
+		Attestations:  collections.NewMap(builder, types.AttestationPrefix, "attestations", collections.Uint64Key, codec.CollValue[types.Attestation](cdc)),
+		ReserveConfig: collections.NewItem(builder, types.ReserveConfigKey, "reserve_config", codec.CollValue[types.ReserveConfig](cdc)),
+
+		Recoverers: collections.NewMap(builder, types.RecovererPrefix, "recoverers", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), collections.BytesValue),
 
 		BlocklistOwner:        collections.NewItem(builder, blocklist.OwnerKey, "blocklist_owner", collections.StringValue),
 		BlocklistPendingOwner: collections.NewItem(builder, blocklist.PendingOwnerKey, "blocklist_pending_owner", collections.StringValue),
 		BlockedAddresses:      collections.NewMap(builder, blocklist.BlockedAddressPrefix, "blocked_address", collections.BytesKey, collections.BytesValue),
+		SuspendedAddresses:    collections.NewKeySet(builder, blocklist.SuspendedAddressPrefix, "suspended_address", collections.BytesKey),
 
 		addressCodec: addressCodec,
 		bankKeeper:   bankKeeper,
@@ -78,43 +196,348 @@ func NewKeeper(
 	return keeper
 }
 
+// GetAuthority returns the authority of this module, which can execute all
+// owner gated messages and force the ownership of this module.
+func (k *Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SetBankKeeper overwrites the bank keeper used in this module.
 func (k *Keeper) SetBankKeeper(bankKeeper types.BankKeeper) {
 	k.bankKeeper = bankKeeper
 }
 
-// SendRestrictionFn executes necessary checks against all USDY transfers.
+// SetChannelKeeper overwrites the channel keeper used in this module. It is
+// required to index the escrow accounts of all channels created on this chain,
+// without which transfers that can't be attributed to an indexed channel are
+// rejected in allowlist mode, while only IBC outflows are paused, and for
+// shares of wrapped USDY.
+func (k *Keeper) SetChannelKeeper(channelKeeper types.ChannelKeeper) {
+	k.channelKeeper = channelKeeper
+}
+
+// SendRestrictionFn executes necessary checks against all transfers of
+// governed denoms.
 func (k *Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error) {
-	if amount := amt.AmountOf(k.Denom); !amount.IsZero() {
-		burning := !fromAddr.Equals(types.ModuleAddress) && toAddr.Equals(types.ModuleAddress)
-		if burning {
-			return toAddr, nil
+	burning := !fromAddr.Equals(types.ModuleAddress) && toAddr.Equals(types.ModuleAddress)
+	if burning {
+		return toAddr, nil
+	}
+
+	// NOTE: Sends out of the module account are only mints when marked as such,
+	// otherwise they return balances held by the module, e.g. wrapped USDY.
+	minting := fromAddr.Equals(types.ModuleAddress) && !toAddr.Equals(types.ModuleAddress) && types.IsMinting(ctx)
+
+	for _, coin := range amt {
+		if coin.Amount.IsZero() || !k.HasDenom(coin.Denom) {
+			continue
 		}
 
-		if k.GetPaused(ctx) {
-			return toAddr, fmt.Errorf("%s transfers are paused", k.Denom)
+		if err := k.checkSend(ctx, coin.Denom, fromAddr, toAddr, minting); err != nil {
+			return toAddr, err
 		}
+	}
 
-		minting := fromAddr.Equals(types.ModuleAddress) && !toAddr.Equals(types.ModuleAddress)
+	// NOTE: Shares of wrapped USDY are subject to the pause and blocklist
+	// checks of USDY. As the channel allowlist and rate limits only apply to
+	// USDY, shares can't be transferred over IBC.
+	if amount := amt.AmountOf(k.SharesDenom); !amount.IsZero() {
+		if err := k.checkSend(ctx, k.Denom, fromAddr, toAddr, minting); err != nil {
+			return toAddr, err
+		}
+
+		channel, found, err := k.resolveTransferChannel(ctx, toAddr)
+		if err != nil {
+			return toAddr, sdkerrors.Wrapf(err, "unable to identify channel of %s transfer", k.SharesDenom)
+		}
+		if found {
+			return toAddr, fmt.Errorf("%s transfers are not allowed over IBC (%s)", k.SharesDenom, channel)
+		}
+	}
 
+	// NOTE: Lockups, the channel allowlist, and rate limits only apply to USDY.
+	if amount := amt.AmountOf(k.Denom); !amount.IsZero() {
 		if !minting {
-			if k.HasBlockedAddress(ctx, fromAddr) {
-				return toAddr, fmt.Errorf("%s is blocked from sending %s", fromAddr, k.Denom)
+			if err := k.checkLocked(ctx, fromAddr, amount); err != nil {
+				return toAddr, err
 			}
 		}
 
+		if k.GetChannelMode(ctx) == types.ChannelModeAllowlist {
+			channel, found, err := k.resolveTransferChannel(ctx, toAddr)
+			if err != nil {
+				return toAddr, sdkerrors.Wrapf(err, "unable to identify channel of %s transfer", k.Denom)
+			}
+			if found && !k.HasAllowedChannel(ctx, channel) {
+				return toAddr, fmt.Errorf("%s transfers are not allowed on %s", k.Denom, channel)
+			}
+		}
+
+		if err := k.checkRateLimits(ctx, fromAddr, toAddr, amount); err != nil {
+			return toAddr, err
+		}
+	}
+
+	return toAddr, nil
+}
+
+// checkSend executes the pause, blocklist, and blocked channel checks of a
+// governed denom against a transfer.
+func (k *Keeper) checkSend(ctx context.Context, denom string, fromAddr, toAddr sdk.AccAddress, minting bool) error {
+	if err := k.checkPaused(ctx, denom, fromAddr, toAddr, minting); err != nil {
+		return err
+	}
+
+	if k.IsBlocklistBound(ctx, denom) {
+		if !minting && k.HasBlockedAddress(ctx, fromAddr) {
+			return fmt.Errorf("%s is blocked from sending %s", fromAddr, denom)
+		}
+
 		if k.HasBlockedAddress(ctx, toAddr) {
-			return toAddr, fmt.Errorf("%s is blocked from receiving %s", toAddr, k.Denom)
+			return fmt.Errorf("%s is blocked from receiving %s", toAddr, denom)
 		}
+	}
 
-		for _, channel := range k.GetBlockedChannels(ctx) {
-			escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, channel)
+	if channel, found := k.GetBlockedEscrow(ctx, denom, toAddr); found && k.GetBlockedChannel(ctx, denom, channel).BlocksOutbound() {
+		return fmt.Errorf("%s transfers are blocked on %s", denom, channel)
+	}
 
-			if toAddr.Equals(escrow) {
-				return toAddr, fmt.Errorf("%s transfers are blocked on %s", k.Denom, channel)
-			}
+	if channel, found := k.GetBlockedEscrow(ctx, denom, fromAddr); found && k.GetBlockedChannel(ctx, denom, channel).BlocksInbound() {
+		return fmt.Errorf("inbound %s transfers are blocked on %s", denom, channel)
+	}
+
+	return nil
+}
+
+// checkPaused returns an error if the scope of a transfer of a governed denom
+// is paused. Mints out of the module account fall under the mint scope,
+// transfers into the escrow account of an IBC channel under the IBC outflows
+// scope, and all other transfers under the transfers scope. Paused transfers
+// are still allowed from and to addresses that are exempt.
+func (k *Keeper) checkPaused(ctx context.Context, denom string, fromAddr, toAddr sdk.AccAddress, minting bool) error {
+	if minting {
+		if k.IsPaused(ctx, denom, types.PauseScopeMint) {
+			return fmt.Errorf("minting %s is paused", denom)
 		}
+
+		return nil
 	}
 
-	return toAddr, nil
+	outflowsPaused := k.IsPaused(ctx, denom, types.PauseScopeIBCOutflows)
+	transfersPaused := k.IsPaused(ctx, denom, types.PauseScopeTransfers)
+	if !outflowsPaused && !transfersPaused {
+		return nil
+	}
+
+	reason := fmt.Sprintf("%s transfers are paused", denom)
+	if !outflowsPaused || !transfersPaused {
+		// NOTE: Transfers that can't be classified are treated as paused.
+		_, outflow, err := k.resolveTransferChannel(ctx, toAddr)
+		if err == nil && outflow != outflowsPaused {
+			return nil
+		}
+		if outflow {
+			reason = fmt.Sprintf("outbound IBC %s transfers are paused", denom)
+		}
+	}
+
+	return k.checkPauseExemption(ctx, denom, fromAddr, toAddr, reason)
+}
+
+// checkPauseExemption returns an error with the provided reason, unless the
+// sender is exempt from sending or the recipient is exempt from receiving.
+func (k *Keeper) checkPauseExemption(ctx context.Context, denom string, fromAddr, toAddr sdk.AccAddress, reason string) error {
+	if direction, found := k.GetPauseExemption(ctx, denom, fromAddr); found && direction.ExemptsSend() {
+		return nil
+	}
+	if direction, found := k.GetPauseExemption(ctx, denom, toAddr); found && direction.ExemptsReceive() {
+		return nil
+	}
+
+	return fmt.Errorf("%s, and neither is %s exempt from sending nor %s exempt from receiving", reason, fromAddr, toAddr)
+}
+
+// checkBurn returns an error if burning a governed denom is paused.
+func (k *Keeper) checkBurn(ctx context.Context, denom string) error {
+	if k.IsPaused(ctx, denom, types.PauseScopeBurn) {
+		return fmt.Errorf("burning %s is paused", denom)
+	}
+
+	return nil
+}
+
+// checkTransfer executes the same pause and blocklist checks that
+// SendRestrictionFn applies to USDY, for movements that bypass it such as
+// transfers into the module account.
+func (k *Keeper) checkTransfer(ctx context.Context, fromAddr, toAddr sdk.AccAddress) error {
+	if k.IsPaused(ctx, k.Denom, types.PauseScopeTransfers) {
+		if err := k.checkPauseExemption(ctx, k.Denom, fromAddr, toAddr, fmt.Sprintf("%s transfers are paused", k.Denom)); err != nil {
+			return err
+		}
+	}
+
+	if k.HasBlockedAddress(ctx, fromAddr) {
+		return fmt.Errorf("%s is blocked from sending %s", fromAddr, k.Denom)
+	}
+
+	if k.HasBlockedAddress(ctx, toAddr) {
+		return fmt.Errorf("%s is blocked from receiving %s", toAddr, k.Denom)
+	}
+
+	return nil
+}
+
+// HasDenom returns true if the provided denom is governed by this module.
+func (k *Keeper) HasDenom(denom string) bool {
+	for _, governed := range k.Denoms {
+		if governed == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolveDenom returns the governed denom targeted by a message, defaulting to
+// USDY when none is provided.
+func (k *Keeper) resolveDenom(denom string) (string, error) {
+	if denom == "" {
+		return k.Denom, nil
+	}
+	if !k.HasDenom(denom) {
+		return "", fmt.Errorf("%s is not governed by this module", denom)
+	}
+
+	return denom, nil
+}
+
+// checkOwner returns an error if the signer is neither the owner of a governed
+// denom, nor the authority of this module, comparing canonical encodings.
+func (k *Keeper) checkOwner(ctx context.Context, denom string, signer string) error {
+	// NOTE: An undecodable signer has no canonical encoding, and never matches.
+	_, canonical, _ := k.decodeAddress(signer)
+	if canonical == k.authority {
+		return nil
+	}
+
+	owner := k.GetOwner(ctx, denom)
+	if owner == "" {
+		return types.ErrNoOwner
+	}
+	if canonical != owner {
+		return sdkerrors.Wrapf(types.ErrInvalidOwner, "expected %s, got %s", owner, signer)
+	}
+
+	return nil
+}
+
+// checkBlocklistOwner returns an error if the signer is neither the blocklist
+// owner, nor the authority of this module, comparing canonical encodings.
+func (k *Keeper) checkBlocklistOwner(ctx context.Context, signer string) error {
+	_, canonical, _ := k.decodeAddress(signer)
+	if canonical == k.authority {
+		return nil
+	}
+
+	owner := k.GetBlocklistOwner(ctx)
+	if owner == "" {
+		return blocklist.ErrNoOwner
+	}
+	if canonical != owner {
+		return sdkerrors.Wrapf(blocklist.ErrInvalidOwner, "expected %s, got %s", owner, signer)
+	}
+
+	return nil
+}
+
+// isBlocked returns true if an address is blocked from holding the roles of a
+// governed denom, because it is on the blocklist that the denom is subject to,
+// or its roles were suspended on all denoms when it was blocked.
+func (k *Keeper) isBlocked(ctx context.Context, denom string, address []byte) bool {
+	if !k.HasBlockedAddress(ctx, address) {
+		return false
+	}
+
+	return k.IsBlocklistBound(ctx, denom) || k.HasSuspendedAddress(ctx, address)
+}
+
+// isPermittedRecipient returns true if a minter can mint to an address, which
+// is the case if the minter is bound to it, or isn't bound to any recipients.
+func (k *Keeper) isPermittedRecipient(ctx context.Context, denom string, minter []byte, recipient []byte) bool {
+	return k.HasMinterRecipient(ctx, denom, minter, recipient) || len(k.GetMinterRecipients(ctx, denom, minter)) == 0
+}
+
+// isPermittedSource returns true if a burner can burn from an address, which
+// is the case if the burner is bound to it, or isn't bound to any sources.
+func (k *Keeper) isPermittedSource(ctx context.Context, denom string, burner []byte, source []byte) bool {
+	return k.HasBurnerSource(ctx, denom, burner, source) || len(k.GetBurnerSources(ctx, denom, burner)) == 0
+}
+
+// decodeAddress returns both the bytes and the canonical encoding of an
+// address.
+func (k *Keeper) decodeAddress(address string) ([]byte, string, error) {
+	bz, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return nil, "", err
+	}
+
+	canonical, err := k.addressCodec.BytesToString(bz)
+	return bz, canonical, err
+}
+
+// GetDenomMetadata returns the bank metadata of a governed denom.
+func (k *Keeper) GetDenomMetadata(ctx context.Context, denom string) (banktypes.Metadata, bool) {
+	return k.bankKeeper.GetDenomMetaData(ctx, denom)
+}
+
+// SetDenomMetadata validates, and registers in the bank module, the metadata
+// of a governed denom.
+func (k *Keeper) SetDenomMetadata(ctx context.Context, metadata banktypes.Metadata) error {
+	if err := metadata.Validate(); err != nil {
+		return sdkerrors.Wrapf(err, "invalid metadata")
+	}
+	if !k.HasDenom(metadata.Base) {
+		return fmt.Errorf("%s is not governed by this module", metadata.Base)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return nil
+}
+
+// indexTransferChannels indexes the transfer escrow accounts of all channels
+// created on this chain since the last call, so that the channel of a transfer
+// is looked up without deriving the escrow account of every channel.
+func (k *Keeper) indexTransferChannels(ctx context.Context) error {
+	if k.channelKeeper == nil {
+		return nil
+	}
+
+	indexed := k.GetIndexedChannels(ctx)
+	sequence := k.channelKeeper.GetNextChannelSequence(sdk.UnwrapSDKContext(ctx))
+	if sequence <= indexed {
+		return nil
+	}
+
+	for i := indexed; i < sequence; i++ {
+		if err := k.SetTransferEscrow(ctx, channeltypes.FormatChannelIdentifier(i)); err != nil {
+			return err
+		}
+	}
+
+	return k.SetIndexedChannels(ctx, sequence)
+}
+
+// resolveTransferChannel returns the channel whose transfer escrow account is
+// the provided address. If the address isn't indexed, and no channel keeper is
+// set to index all channels, it fails closed as the address could belong to
+// an unindexed channel.
+func (k *Keeper) resolveTransferChannel(ctx context.Context, address []byte) (string, bool, error) {
+	if channel, found := k.GetTransferChannel(ctx, address); found {
+		return channel, true, nil
+	}
+	if k.channelKeeper == nil {
+		return "", false, types.ErrNoChannelKeeper
+	}
+
+	return "", false, nil
 }
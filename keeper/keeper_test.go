@@ -2,12 +2,19 @@ package keeper_test
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	"github.com/ondoprotocol/usdy-noble/v2/keeper"
 	"github.com/ondoprotocol/usdy-noble/v2/types"
@@ -56,7 +63,7 @@ func TestSendRestrictionBurn(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			// ARRANGE: Set paused state.
-			require.NoError(t, k.SetPaused(ctx, testCase.paused))
+			SetPaused(t, k, ctx, k.Denom, testCase.paused)
 			// ARRANGE: Set blocked state.
 			if testCase.blocked {
 				require.NoError(t, k.SetBlockedAddress(ctx, user.Bytes))
@@ -92,13 +99,13 @@ func TestSendRestrictionMint(t *testing.T) {
 			name:    "PausedAndBlocked",
 			paused:  true,
 			blocked: true,
-			err:     errors.New("transfers are paused"),
+			err:     errors.New("minting ausdy is paused"),
 		},
 		{
 			name:    "PausedAndUnblocked",
 			paused:  true,
 			blocked: false,
-			err:     errors.New("transfers are paused"),
+			err:     errors.New("minting ausdy is paused"),
 		},
 		{
 			name:    "UnpausedAndBlocked",
@@ -117,7 +124,7 @@ func TestSendRestrictionMint(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			// ARRANGE: Set paused state.
-			require.NoError(t, k.SetPaused(ctx, testCase.paused))
+			SetPaused(t, k, ctx, k.Denom, testCase.paused)
 			// ARRANGE: Set blocked state.
 			if testCase.blocked {
 				require.NoError(t, k.SetBlockedAddress(ctx, user.Bytes))
@@ -126,7 +133,7 @@ func TestSendRestrictionMint(t *testing.T) {
 			}
 
 			// ACT: Attempt to mint.
-			_, err := k.SendRestrictionFn(ctx, types.ModuleAddress, user.Bytes, coins)
+			_, err := k.SendRestrictionFn(types.WithMinting(ctx), types.ModuleAddress, user.Bytes, coins)
 
 			// ASSERT: Send restriction correctly handled test case.
 			if testCase.err != nil {
@@ -138,6 +145,13 @@ func TestSendRestrictionMint(t *testing.T) {
 	}
 }
 
+// SetPaused pauses or unpauses all scopes of a governed denom.
+func SetPaused(t *testing.T, k *keeper.Keeper, ctx sdk.Context, denom string, paused bool) {
+	for _, scope := range types.PauseScopes {
+		require.NoError(t, k.SetPaused(ctx, denom, scope, paused))
+	}
+}
+
 func TestSendRestrictionTransfer(t *testing.T) {
 	alice, bob := utils.TestAccount(), utils.TestAccount()
 	k, ctx := mocks.AuraKeeper()
@@ -228,7 +242,7 @@ func TestSendRestrictionTransfer(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			// ARRANGE: Set paused state.
-			require.NoError(t, k.SetPaused(ctx, testCase.paused))
+			SetPaused(t, k, ctx, k.Denom, testCase.paused)
 			// ARRANGE: Set sender blocked state.
 			if testCase.senderBlocked {
 				require.NoError(t, k.SetBlockedAddress(ctx, alice.Bytes))
@@ -259,35 +273,1049 @@ func TestSendRestrictionIBCTransfer(t *testing.T) {
 	user := utils.TestAccount()
 	k, ctx := mocks.AuraKeeper()
 	coins := sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+
+	testCases := []struct {
+		name        string
+		direction   types.ChannelDirection
+		outboundErr error
+		inboundErr  error
+	}{
+		{
+			name:        "Outbound",
+			direction:   types.ChannelDirectionOutbound,
+			outboundErr: errors.New("ausdy transfers are blocked on channel-0"),
+			inboundErr:  nil,
+		},
+		{
+			name:        "Inbound",
+			direction:   types.ChannelDirectionInbound,
+			outboundErr: nil,
+			inboundErr:  errors.New("inbound ausdy transfers are blocked on channel-0"),
+		},
+		{
+			name:        "Both",
+			direction:   types.ChannelDirectionBoth,
+			outboundErr: errors.New("ausdy transfers are blocked on channel-0"),
+			inboundErr:  errors.New("inbound ausdy transfers are blocked on channel-0"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// ARRANGE: Set a blocked channel in state.
+			require.NoError(t, k.SetBlockedChannel(ctx, k.Denom, "channel-0", testCase.direction))
 
-	// ARRANGE: Set a blocked channel in state.
-	require.NoError(t, k.SetBlockedChannel(ctx, "channel-0"))
+			// ACT: Attempt to transfer from user to escrow account.
+			// This is to mimic the underlying transfer that occurs when using IBC.
+			_, err := k.SendRestrictionFn(ctx, user.Bytes, escrow, coins)
+
+			// ASSERT: Send restriction correctly handled outbound transfer.
+			if testCase.outboundErr != nil {
+				require.ErrorContains(t, err, testCase.outboundErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			// ACT: Attempt to transfer from escrow account to user.
+			// This is to mimic the underlying transfer that occurs when receiving over IBC.
+			_, err = k.SendRestrictionFn(ctx, escrow, user.Bytes, coins)
+
+			// ASSERT: Send restriction correctly handled inbound transfer.
+			if testCase.inboundErr != nil {
+				require.ErrorContains(t, err, testCase.inboundErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSendRestrictionPauseScopes(t *testing.T) {
+	user := utils.TestAccount()
+	coins := sdk.NewCoins(sdk.NewCoin("ausdy", ONE))
 	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	module := authtypes.NewModuleAddress(types.ModuleName)
 
-	// ACT: Attempt to transfer from user to escrow account.
-	// This is to mimic the underlying transfer that occurs when using IBC.
+	testCases := []struct {
+		name        string
+		scopes      []types.PauseScope
+		transferErr error
+		outboundErr error
+		inboundErr  error
+		mintErr     error
+	}{
+		{
+			name:   "None",
+			scopes: nil,
+		},
+		{
+			name:        "IBCOutflows",
+			scopes:      []types.PauseScope{types.PauseScopeIBCOutflows},
+			outboundErr: errors.New("outbound IBC ausdy transfers are paused"),
+		},
+		{
+			name:        "Transfers",
+			scopes:      []types.PauseScope{types.PauseScopeTransfers},
+			transferErr: errors.New("ausdy transfers are paused"),
+			inboundErr:  errors.New("ausdy transfers are paused"),
+		},
+		{
+			name:        "IBCOutflowsAndTransfers",
+			scopes:      []types.PauseScope{types.PauseScopeIBCOutflows, types.PauseScopeTransfers},
+			transferErr: errors.New("ausdy transfers are paused"),
+			outboundErr: errors.New("ausdy transfers are paused"),
+			inboundErr:  errors.New("ausdy transfers are paused"),
+		},
+		{
+			name:    "Mint",
+			scopes:  []types.PauseScope{types.PauseScopeMint},
+			mintErr: errors.New("minting ausdy is paused"),
+		},
+		{
+			name:   "Burn",
+			scopes: []types.PauseScope{types.PauseScopeBurn},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// ARRANGE: Pause the scopes of the test case.
+			k, ctx := mocks.AuraKeeper()
+			k.SetChannelKeeper(mocks.ChannelKeeper{NextSequence: 1})
+			require.NoError(t, k.BeginBlock(ctx))
+			for _, scope := range testCase.scopes {
+				require.NoError(t, k.SetPaused(ctx, k.Denom, scope, true))
+			}
+
+			// ACT: Attempt to transfer between users.
+			_, err := k.SendRestrictionFn(ctx, user.Bytes, utils.TestAccount().Bytes, coins)
+			// ASSERT: Send restriction correctly handled the transfer.
+			requireErrorOrNil(t, testCase.transferErr, err)
+
+			// ACT: Attempt to transfer from user to escrow account.
+			_, err = k.SendRestrictionFn(ctx, user.Bytes, escrow, coins)
+			// ASSERT: Send restriction correctly handled the outbound transfer.
+			requireErrorOrNil(t, testCase.outboundErr, err)
+
+			// ACT: Attempt to transfer from escrow account to user.
+			_, err = k.SendRestrictionFn(ctx, escrow, user.Bytes, coins)
+			// ASSERT: Send restriction correctly handled the inbound transfer.
+			requireErrorOrNil(t, testCase.inboundErr, err)
+
+			// ACT: Attempt to mint from module to user.
+			_, err = k.SendRestrictionFn(types.WithMinting(ctx), module, user.Bytes, coins)
+			// ASSERT: Send restriction correctly handled the mint.
+			requireErrorOrNil(t, testCase.mintErr, err)
+
+			// ACT: Attempt to release from module to user.
+			_, err = k.SendRestrictionFn(ctx, module, user.Bytes, coins)
+			// ASSERT: Send restriction correctly handled the release.
+			requireErrorOrNil(t, testCase.transferErr, err)
+		})
+	}
+}
+
+func TestSendRestrictionPauseExemption(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	k.SetChannelKeeper(mocks.ChannelKeeper{NextSequence: 1})
+	require.NoError(t, k.BeginBlock(ctx))
+	coins := sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	user, sender, receiver, treasury := utils.TestAccount(), utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Pause transfers and IBC outflows, and exempt addresses.
+	require.NoError(t, k.SetPaused(ctx, k.Denom, types.PauseScopeTransfers, true))
+	require.NoError(t, k.SetPaused(ctx, k.Denom, types.PauseScopeIBCOutflows, true))
+	require.NoError(t, k.SetPauseExemption(ctx, k.Denom, sender.Bytes, types.ExemptionDirectionSend))
+	require.NoError(t, k.SetPauseExemption(ctx, k.Denom, receiver.Bytes, types.ExemptionDirectionReceive))
+	require.NoError(t, k.SetPauseExemption(ctx, k.Denom, treasury.Bytes, types.ExemptionDirectionBoth))
+
+	testCases := []struct {
+		name string
+		from sdk.AccAddress
+		to   sdk.AccAddress
+		err  error
+	}{
+		{name: "NotExempt", from: user.Bytes, to: utils.TestAccount().Bytes, err: errors.New("ausdy transfers are paused, and neither is")},
+		{name: "SenderExempt", from: sender.Bytes, to: user.Bytes},
+		{name: "SenderOnlyExemptFromSending", from: user.Bytes, to: sender.Bytes, err: errors.New("ausdy transfers are paused")},
+		{name: "RecipientExempt", from: user.Bytes, to: receiver.Bytes},
+		{name: "RecipientOnlyExemptFromReceiving", from: receiver.Bytes, to: user.Bytes, err: errors.New("ausdy transfers are paused")},
+		{name: "TreasurySending", from: treasury.Bytes, to: user.Bytes},
+		{name: "TreasuryReceiving", from: user.Bytes, to: treasury.Bytes},
+		{name: "TreasuryIBCOutflow", from: treasury.Bytes, to: escrow},
+		{name: "IBCOutflow", from: user.Bytes, to: escrow, err: errors.New("ausdy transfers are paused")},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// ACT: Attempt to transfer.
+			_, err := k.SendRestrictionFn(ctx, testCase.from, testCase.to, coins)
+			// ASSERT: Send restriction correctly handled the exemptions.
+			requireErrorOrNil(t, testCase.err, err)
+		})
+	}
+
+	// ARRANGE: Only pause IBC outflows.
+	require.NoError(t, k.SetPaused(ctx, k.Denom, types.PauseScopeTransfers, false))
+
+	// ACT: Attempt to transfer into an escrow account without exemption.
 	_, err := k.SendRestrictionFn(ctx, user.Bytes, escrow, coins)
+	// ASSERT: The action should've failed, explaining why.
+	require.ErrorContains(t, err, fmt.Sprintf("outbound IBC ausdy transfers are paused, and neither is %s exempt from sending nor %s exempt from receiving", user.Address, escrow))
+
+	// ACT: Attempt to transfer into an escrow account with exemption.
+	_, err = k.SendRestrictionFn(ctx, sender.Bytes, escrow, coins)
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+}
+
+func TestSendRestrictionSharesDenom(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	k.SetChannelKeeper(mocks.ChannelKeeper{})
+	coins := sdk.NewCoins(sdk.NewCoin(k.SharesDenom, ONE))
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+
+	// ACT: Attempt to transfer shares.
+	_, err := k.SendRestrictionFn(ctx, alice.Bytes, bob.Bytes, coins)
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ARRANGE: Block the recipient.
+	require.NoError(t, k.SetBlockedAddress(ctx, bob.Bytes))
+
+	// ACT: Attempt to transfer shares to a blocked recipient.
+	_, err = k.SendRestrictionFn(ctx, alice.Bytes, bob.Bytes, coins)
+	// ASSERT: The action should've failed due to the blocklist of USDY.
+	require.ErrorContains(t, err, "is blocked from receiving ausdy")
+
+	// ARRANGE: Unblock the recipient, and pause transfers of USDY.
+	require.NoError(t, k.DeleteBlockedAddress(ctx, bob.Bytes))
+	require.NoError(t, k.SetPaused(ctx, k.Denom, types.PauseScopeTransfers, true))
+
+	// ACT: Attempt to transfer shares while transfers of USDY are paused.
+	_, err = k.SendRestrictionFn(ctx, alice.Bytes, bob.Bytes, coins)
+	// ASSERT: The action should've failed due to the pause of USDY.
+	require.ErrorContains(t, err, "ausdy transfers are paused")
+}
+
+func TestSendRestrictionSharesDenomIBC(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	coins := sdk.NewCoins(sdk.NewCoin(k.SharesDenom, ONE))
+	user := utils.TestAccount()
+	allowed := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	unknown := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-1")
+
+	// ARRANGE: Allow channel-0, and switch to allowlist mode.
+	require.NoError(t, k.SetAllowedChannel(ctx, "channel-0"))
+	require.NoError(t, k.SetChannelMode(ctx, types.ChannelModeAllowlist))
+
+	// ACT: Attempt to transfer shares over the allowed channel.
+	_, err := k.SendRestrictionFn(ctx, user.Bytes, allowed, coins)
+	// ASSERT: The action should've failed, as shares can't be transferred over IBC.
+	require.ErrorContains(t, err, "shares/ausdy transfers are not allowed over IBC (channel-0)")
+
+	// ACT: Attempt to transfer shares to an address that can't be identified.
+	_, err = k.SendRestrictionFn(ctx, user.Bytes, unknown, coins)
+	// ASSERT: The action should've failed closed.
+	require.ErrorIs(t, err, types.ErrNoChannelKeeper)
+
+	// ARRANGE: Switch to denylist mode, set a channel keeper, and index all channels.
+	require.NoError(t, k.SetChannelMode(ctx, types.ChannelModeDenylist))
+	k.SetChannelKeeper(mocks.ChannelKeeper{NextSequence: 2})
+	require.NoError(t, k.BeginBlock(ctx))
+
+	// ACT: Attempt to transfer shares over a channel that is neither allowed nor rate limited.
+	_, err = k.SendRestrictionFn(ctx, user.Bytes, unknown, coins)
+	// ASSERT: The action should've failed, as shares can't be transferred over IBC.
+	require.ErrorContains(t, err, "shares/ausdy transfers are not allowed over IBC (channel-1)")
+
+	// ACT: Attempt to transfer shares to a regular account.
+	_, err = k.SendRestrictionFn(ctx, user.Bytes, utils.TestAccount().Bytes, coins)
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+}
+
+// requireErrorOrNil asserts that err contains expected, or is nil if no error
+// is expected.
+func requireErrorOrNil(t *testing.T, expected error, err error) {
+	if expected != nil {
+		require.ErrorContains(t, err, expected.Error())
+	} else {
+		require.NoError(t, err)
+	}
+}
+
+func TestBeginBlock(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	// ARRANGE: Pause minting until a block height, and burning indefinitely.
+	require.NoError(t, k.SetPaused(ctx, k.Denom, types.PauseScopeMint, true))
+	require.NoError(t, k.SetPauseWindow(ctx, types.PauseWindow{Denom: k.Denom, Scope: types.PauseScopeMint, EndHeight: 12}))
+	require.NoError(t, k.SetPaused(ctx, k.Denom, types.PauseScopeBurn, true))
+
+	// ARRANGE: Schedule a pause of transfers for a time window.
+	start := ctx.BlockTime().Add(time.Hour)
+	require.NoError(t, k.SetPauseWindow(ctx, types.PauseWindow{
+		Denom:     "atbill",
+		Scope:     types.PauseScopeTransfers,
+		StartTime: start,
+		EndTime:   start.Add(time.Hour),
+	}))
+
+	// ACT: Execute the block hook before any bound is reached.
+	require.NoError(t, k.BeginBlock(ctx))
+	// ASSERT: Nothing should've changed.
+	require.Equal(t, []types.PauseScope{types.PauseScopeMint, types.PauseScopeBurn}, k.GetPausedScopes(ctx, k.Denom))
+	require.Empty(t, k.GetPausedScopes(ctx, "atbill"))
+	require.Len(t, k.GetPauseWindows(ctx), 2)
+	require.Empty(t, ctx.EventManager().Events())
+
+	// ACT: Execute the block hook once the end height is reached.
+	ctx = ctx.WithBlockHeight(12)
+	require.NoError(t, k.BeginBlock(ctx))
+	// ASSERT: Minting should've been unpaused on behalf of the module.
+	require.Equal(t, []types.PauseScope{types.PauseScopeBurn}, k.GetPausedScopes(ctx, k.Denom))
+	_, found := k.GetPauseWindow(ctx, k.Denom, types.PauseScopeMint)
+	require.False(t, found)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, "aura.v1.Unpaused", ctx.EventManager().Events()[0].Type)
+	account, found := ctx.EventManager().Events()[0].GetAttribute("account")
+	require.True(t, found)
+	require.Equal(t, fmt.Sprintf("%q", types.ModuleAddress.String()), account.Value)
+
+	// ACT: Execute the block hook once the start time is reached.
+	ctx = ctx.WithBlockTime(start).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlock(ctx))
+	// ASSERT: Transfers of $TBILL should've been paused, keeping the end.
+	require.Equal(t, []types.PauseScope{types.PauseScopeTransfers}, k.GetPausedScopes(ctx, "atbill"))
+	window, found := k.GetPauseWindow(ctx, "atbill", types.PauseScopeTransfers)
+	require.True(t, found)
+	require.Equal(t, types.PauseWindow{Denom: "atbill", Scope: types.PauseScopeTransfers, EndTime: start.Add(time.Hour)}, window)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, "aura.v1.Paused", ctx.EventManager().Events()[0].Type)
+
+	// ACT: Execute the block hook once the end time is reached.
+	ctx = ctx.WithBlockTime(start.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlock(ctx))
+	// ASSERT: Transfers of $TBILL should've been unpaused, and burning of $USDY remains paused.
+	require.Empty(t, k.GetPausedScopes(ctx, "atbill"))
+	require.Equal(t, []types.PauseScope{types.PauseScopeBurn}, k.GetPausedScopes(ctx, k.Denom))
+	require.Empty(t, k.GetPauseWindows(ctx))
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, "aura.v1.Unpaused", ctx.EventManager().Events()[0].Type)
+}
+
+func TestBeginBlockFailure(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1_700_000_000, 0))
+	minter := utils.TestAccount()
+
+	// ARRANGE: At the next block, start a pause of minting, end a pause of
+	// $TBILL transfers, and expire a mint allowance. End a pause of burning
+	// later on.
+	require.NoError(t, k.SetPauseWindow(ctx, types.PauseWindow{Denom: k.Denom, Scope: types.PauseScopeMint, StartHeight: 11, EndHeight: 20}))
+	require.NoError(t, k.SetPauseWindow(ctx, types.PauseWindow{Denom: "atbill", Scope: types.PauseScopeTransfers, EndHeight: 11}))
+	require.NoError(t, k.SetPauseWindow(ctx, types.PauseWindow{Denom: k.Denom, Scope: types.PauseScopeBurn, EndHeight: 50}))
+	require.NoError(t, k.SetMinter(ctx, k.Denom, minter.Bytes, ONE))
+	require.NoError(t, k.SetMinterExpiry(ctx, k.Denom, minter.Bytes, ctx.BlockTime().Add(time.Second)))
+
+	// ARRANGE: Set up a failing collection store for the paused scopes.
+	k.Paused = collections.NewKeySet(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.PausedPrefix, "paused", collections.PairKeyCodec(collections.StringKey, types.PauseScopeKey),
+	)
+
+	// ACT: Execute the block hook once the bounds are reached.
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(ctx.BlockTime().Add(time.Second))
+	require.NoError(t, k.BeginBlock(ctx))
+
+	// ASSERT: The failing window should've been kept as is.
+	window, found := k.GetPauseWindow(ctx, k.Denom, types.PauseScopeMint)
+	require.True(t, found)
+	require.Equal(t, int64(11), window.StartHeight)
+	has, err := k.PauseWindowsByHeight.Has(ctx, collections.Join3(int64(11), k.Denom, types.PauseScopeMint))
+	require.NoError(t, err)
+	require.True(t, has)
+	// ASSERT: The other due entries should've been processed.
+	_, found = k.GetPauseWindow(ctx, "atbill", types.PauseScopeTransfers)
+	require.False(t, found)
+	require.True(t, k.GetMinter(ctx, k.Denom, minter.Bytes).IsZero())
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, "aura.v1.MinterAllowanceExpired", ctx.EventManager().Events()[0].Type)
+	// ASSERT: The entry that isn't due should've been kept.
+	_, found = k.GetPauseWindow(ctx, k.Denom, types.PauseScopeBurn)
+	require.True(t, found)
+	has, err = k.PauseWindowsByHeight.Has(ctx, collections.Join3(int64(50), k.Denom, types.PauseScopeBurn))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestAllowanceExpiry(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set owner in state, and generate burner, minter and user accounts.
+	owner, burner, minter, user := utils.TestAccount(), utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+	expiry := ctx.BlockTime().Add(time.Hour)
+
+	// ACT: Attempt to add minter with an expiry in the past.
+	_, err := server.AddMinter(ctx, &types.MsgAddMinter{
+		Signer:    owner.Address,
+		Minter:    minter.Address,
+		Allowance: ONE.MulRaw(2),
+		Expiry:    ctx.BlockTime(),
+	})
+	// ASSERT: The action should've failed due to invalid expiry.
+	require.ErrorContains(t, err, "expiry must be after current time")
+
+	// ACT: Attempt to add minter and burner with an expiry.
+	_, err = server.AddMinter(ctx, &types.MsgAddMinter{
+		Signer:    owner.Address,
+		Minter:    minter.Address,
+		Allowance: ONE.MulRaw(2),
+		Expiry:    expiry,
+	})
+	require.NoError(t, err)
+	_, err = server.AddBurner(ctx, &types.MsgAddBurner{
+		Signer:    owner.Address,
+		Burner:    burner.Address,
+		Allowance: ONE,
+		Expiry:    expiry,
+	})
+	require.NoError(t, err)
+	// ASSERT: The expiries should've been stored, and returned in queries.
+	require.Equal(t, expiry, k.GetMinterExpiry(ctx, k.Denom, minter.Bytes))
+	require.Equal(t, []types.Minter{{Address: minter.Address, Allowance: ONE.MulRaw(2), Expiry: expiry}}, k.GetMinters(ctx, k.Denom))
+	require.Equal(t, []types.Burner{{Address: burner.Address, Allowance: ONE, Expiry: expiry}}, k.GetBurners(ctx, k.Denom))
+
+	// ACT: Attempt to mint before the expiry.
+	_, err = server.Mint(ctx, &types.MsgMint{
+		Signer: minter.Address,
+		To:     user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ACT: Attempt to mint and burn once the expiry is reached.
+	ctx = ctx.WithBlockTime(expiry).WithEventManager(sdk.NewEventManager())
+	_, err = server.Mint(ctx, &types.MsgMint{
+		Signer: minter.Address,
+		To:     user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to expired allowance.
+	require.ErrorIs(t, err, types.ErrInsufficientAllowance)
+	_, err = server.Burn(ctx, &types.MsgBurn{
+		Signer: burner.Address,
+		From:   user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to expired allowance.
+	require.ErrorIs(t, err, types.ErrInsufficientAllowance)
+
+	// ACT: Execute the block hook once the expiry is reached.
+	require.NoError(t, k.BeginBlock(ctx))
+	// ASSERT: The allowances should've been reset, keeping the roles.
+	require.True(t, k.HasMinter(ctx, k.Denom, minter.Bytes))
+	require.True(t, k.GetMinter(ctx, k.Denom, minter.Bytes).IsZero())
+	require.True(t, k.GetMinterExpiry(ctx, k.Denom, minter.Bytes).IsZero())
+	require.True(t, k.HasBurner(ctx, k.Denom, burner.Bytes))
+	require.True(t, k.GetBurner(ctx, k.Denom, burner.Bytes).IsZero())
+	require.Empty(t, k.GetBurners(ctx, k.Denom)[0].Expiry)
+	require.Len(t, ctx.EventManager().Events(), 2)
+	require.Equal(t, "aura.v1.BurnerAllowanceExpired", ctx.EventManager().Events()[0].Type)
+	require.Equal(t, "aura.v1.MinterAllowanceExpired", ctx.EventManager().Events()[1].Type)
+
+	// ACT: Attempt to set minter allowance without an expiry.
+	_, err = server.SetMinterAllowance(ctx, &types.MsgSetMinterAllowance{
+		Signer:    owner.Address,
+		Minter:    minter.Address,
+		Allowance: ONE,
+	})
+	// ASSERT: The action should've succeeded, and the allowance shouldn't expire.
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(expiry.Add(time.Hour))
+	require.NoError(t, k.BeginBlock(ctx))
+	require.Equal(t, ONE, k.GetMinter(ctx, k.Denom, minter.Bytes))
+
+	// ARRANGE: Set a burner allowance with an expiry.
+	expiry = ctx.BlockTime().Add(time.Hour)
+	_, err = server.SetBurnerAllowance(ctx, &types.MsgSetBurnerAllowance{
+		Signer:    owner.Address,
+		Burner:    burner.Address,
+		Allowance: ONE,
+		Expiry:    expiry,
+	})
+	require.NoError(t, err)
+
+	// ACT: Attempt to set burner allowance without an expiry.
+	_, err = server.SetBurnerAllowance(ctx, &types.MsgSetBurnerAllowance{
+		Signer:    owner.Address,
+		Burner:    burner.Address,
+		Allowance: ONE.MulRaw(2),
+	})
+	// ASSERT: The action should've succeeded, and kept the existing expiry.
+	require.NoError(t, err)
+	require.Equal(t, ONE.MulRaw(2), k.GetBurner(ctx, k.Denom, burner.Bytes))
+	require.Equal(t, expiry, k.GetBurnerExpiry(ctx, k.Denom, burner.Bytes))
+
+	// ACT: Attempt to set burner allowance with an expiry while clearing it.
+	_, err = server.SetBurnerAllowance(ctx, &types.MsgSetBurnerAllowance{
+		Signer:      owner.Address,
+		Burner:      burner.Address,
+		Allowance:   ONE,
+		Expiry:      expiry,
+		ClearExpiry: true,
+	})
+	// ASSERT: The action should've failed due to conflicting expiry.
+	require.ErrorContains(t, err, "expiry cannot be provided when clearing it")
+
+	// ACT: Attempt to set burner allowance while clearing the expiry.
+	_, err = server.SetBurnerAllowance(ctx, &types.MsgSetBurnerAllowance{
+		Signer:      owner.Address,
+		Burner:      burner.Address,
+		Allowance:   ONE,
+		ClearExpiry: true,
+	})
+	// ASSERT: The action should've succeeded, and removed the expiry.
+	require.NoError(t, err)
+	require.True(t, k.GetBurnerExpiry(ctx, k.Denom, burner.Bytes).IsZero())
+
+	// ARRANGE: Set a minter allowance with an expiry.
+	_, err = server.SetMinterAllowance(ctx, &types.MsgSetMinterAllowance{
+		Signer:    owner.Address,
+		Minter:    minter.Address,
+		Allowance: ONE,
+		Expiry:    expiry,
+	})
+	require.NoError(t, err)
+
+	// ACT: Attempt to set minter allowance without an expiry.
+	_, err = server.SetMinterAllowance(ctx, &types.MsgSetMinterAllowance{
+		Signer:    owner.Address,
+		Minter:    minter.Address,
+		Allowance: ONE.MulRaw(2),
+	})
+	// ASSERT: The action should've succeeded, and kept the existing expiry.
+	require.NoError(t, err)
+	require.Equal(t, expiry, k.GetMinterExpiry(ctx, k.Denom, minter.Bytes))
+
+	// ACT: Attempt to set minter allowance while clearing the expiry.
+	_, err = server.SetMinterAllowance(ctx, &types.MsgSetMinterAllowance{
+		Signer:      owner.Address,
+		Minter:      minter.Address,
+		Allowance:   ONE,
+		ClearExpiry: true,
+	})
+	// ASSERT: The action should've succeeded, and removed the expiry.
+	require.NoError(t, err)
+	require.True(t, k.GetMinterExpiry(ctx, k.Denom, minter.Bytes).IsZero())
+}
+
+func TestSendRestrictionLegacyBlockedChannel(t *testing.T) {
+	user := utils.TestAccount()
+	k, ctx := mocks.AuraKeeper()
+	coins := sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+
+	// ARRANGE: Set a blocked channel in state using the legacy empty value.
+	store := utils.GetKVStore(ctx, types.ModuleName)
+	store.Set(append(types.LegacyBlockedChannelPrefix, []byte("channel-0")...), []byte{})
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+
+	// ARRANGE: Run the migrations that backfill the escrow address index, and
+	// move the blocked channel under the default denom.
+	migrator := keeper.NewMigrator(k)
+	require.NoError(t, migrator.Migrate1to2(ctx))
+	require.NoError(t, migrator.Migrate2to3(ctx))
+	require.Equal(t, types.ChannelDirectionOutbound, k.GetBlockedChannel(ctx, k.Denom, "channel-0"))
 
+	// ACT: Attempt to transfer from user to escrow account.
+	_, err := k.SendRestrictionFn(ctx, user.Bytes, escrow, coins)
 	// ASSERT: The action should've failed due to blocked channel.
 	require.ErrorContains(t, err, "transfers are blocked")
+
+	// ACT: Attempt to transfer from escrow account to user.
+	_, err = k.SendRestrictionFn(ctx, escrow, user.Bytes, coins)
+	// ASSERT: The action should've succeeded, legacy channels only block outbound.
+	require.NoError(t, err)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	owner, burner, pauser := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Set state in the legacy single denom layout.
+	store := utils.GetKVStore(ctx, types.ModuleName)
+	store.Set(types.LegacyPausedKey, []byte{1})
+	store.Set(types.LegacyOwnerKey, []byte(owner.Address))
+	store.Set(types.LegacyPendingOwnerKey, []byte(pauser.Address))
+	allowance, _ := ONE.Marshal()
+	store.Set(append(types.LegacyBurnerPrefix, []byte(burner.Address)...), allowance)
+	store.Set(append(types.LegacyMinterPrefix, []byte(burner.Address)...), allowance)
+	store.Set(append(types.LegacyPauserPrefix, []byte(pauser.Address)...), []byte{})
+	direction, _ := types.ChannelDirectionValue.Encode(types.ChannelDirectionBoth)
+	store.Set(append(types.LegacyBlockedChannelPrefix, []byte("channel-0")...), direction)
+
+	// ACT: Run the migration.
+	migrator := keeper.NewMigrator(k)
+	require.NoError(t, migrator.Migrate1to2(ctx))
+	require.NoError(t, migrator.Migrate2to3(ctx))
+	require.NoError(t, migrator.Migrate3to4(ctx))
+	require.NoError(t, migrator.Migrate4to5(ctx))
+
+	// ASSERT: The state should've moved under the default denom.
+	require.Equal(t, types.DefaultPauseScopes, k.GetPausedScopes(ctx, k.Denom))
+	require.Equal(t, owner.Address, k.GetOwner(ctx, k.Denom))
+	require.Equal(t, pauser.Address, k.GetPendingOwner(ctx, k.Denom))
+	require.Equal(t, ONE, k.GetBurner(ctx, k.Denom, burner.Bytes))
+	require.Equal(t, ONE, k.GetMinter(ctx, k.Denom, burner.Bytes))
+	require.True(t, k.HasPauser(ctx, k.Denom, pauser.Bytes))
+	require.Equal(t, types.ChannelDirectionBoth, k.GetBlockedChannel(ctx, k.Denom, "channel-0"))
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	channel, found := k.GetBlockedEscrow(ctx, k.Denom, escrow)
+	require.True(t, found)
+	require.Equal(t, "channel-0", channel)
+
+	// ASSERT: The legacy state should've been removed.
+	for _, key := range [][]byte{types.LegacyPausedKey, types.LegacyOwnerKey, types.LegacyPendingOwnerKey} {
+		require.False(t, store.Has(key))
+	}
+	for _, prefix := range [][]byte{types.LegacyBurnerPrefix, types.LegacyMinterPrefix, types.LegacyPauserPrefix, types.LegacyBlockedChannelPrefix, types.LegacyBlockedEscrowPrefix} {
+		iter := storetypes.KVStorePrefixIterator(store, prefix)
+		require.False(t, iter.Valid())
+		require.NoError(t, iter.Close())
+	}
+
+	// ASSERT: Other governed denoms should've been left untouched.
+	require.Empty(t, k.GetOwner(ctx, "atbill"))
+	require.Empty(t, k.GetBurners(ctx, "atbill"))
+}
+
+func TestMigrate3to4(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+
+	// ARRANGE: Set the legacy pause flag of both governed denoms.
+	store := utils.GetKVStore(ctx, types.ModuleName)
+	store.Set(append(types.LegacyDenomPausedPrefix, []byte(k.Denom)...), []byte{1})
+	store.Set(append(types.LegacyDenomPausedPrefix, []byte("atbill")...), []byte{0})
+
+	// ACT: Run the migration.
+	require.NoError(t, keeper.NewMigrator(k).Migrate3to4(ctx))
+
+	// ASSERT: All scopes but burning of paused denoms should be paused, and the legacy state removed.
+	require.Equal(t, types.DefaultPauseScopes, k.GetPausedScopes(ctx, k.Denom))
+	require.False(t, k.IsPaused(ctx, k.Denom, types.PauseScopeBurn))
+	require.Empty(t, k.GetPausedScopes(ctx, "atbill"))
+	iter := storetypes.KVStorePrefixIterator(store, types.LegacyDenomPausedPrefix)
+	require.False(t, iter.Valid())
+	require.NoError(t, iter.Close())
+}
+
+func TestMigrate4to5(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	burner, minter, pauser := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Set roles in state keyed by the address as provided, including
+	// an uppercase duplicate of a burner, and an undecodable pauser.
+	store := utils.GetKVStore(ctx, types.ModuleName)
+	setLegacyRole := func(prefix []byte, denom, address string, value []byte) {
+		key, err := collections.EncodeKeyWithPrefix(prefix, collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Join(denom, address))
+		require.NoError(t, err)
+		store.Set(key, value)
+	}
+	one, _ := ONE.Marshal()
+	two, _ := ONE.MulRaw(2).Marshal()
+	setLegacyRole(types.BurnerPrefix, k.Denom, strings.ToUpper(burner.Address), two)
+	setLegacyRole(types.BurnerPrefix, k.Denom, burner.Address, one)
+	setLegacyRole(types.MinterPrefix, TBILL, strings.ToUpper(minter.Address), one)
+	setLegacyRole(types.PauserPrefix, k.Denom, pauser.Address, []byte{})
+	setLegacyRole(types.PauserPrefix, k.Denom, "noble1pauser", []byte{})
+
+	// ACT: Run the migration.
+	require.NoError(t, keeper.NewMigrator(k).Migrate4to5(ctx))
+
+	// ASSERT: The roles should've been keyed by address bytes, preferring the
+	// canonical entry, and the legacy entries removed.
+	require.Equal(t, []types.Burner{{Address: burner.Address, Allowance: ONE}}, k.GetBurners(ctx, k.Denom))
+	require.Equal(t, []types.Minter{{Address: minter.Address, Allowance: ONE}}, k.GetMinters(ctx, TBILL))
+	require.Empty(t, k.GetMinters(ctx, k.Denom))
+	require.Equal(t, []string{pauser.Address}, k.GetPausers(ctx, k.Denom))
+	require.True(t, k.HasPauser(ctx, k.Denom, pauser.Bytes))
 }
 
 func TestNewKeeper(t *testing.T) {
-	// ARRANGE: Set the PausedKey to an already existing key
-	types.PausedKey = types.OwnerKey
+	// ARRANGE: Set the PausedPrefix to an already existing prefix
+	types.PausedPrefix = types.OwnerPrefix
 
 	// ACT: Attempt to create a new Keeper with overlapping prefixes
 	require.Panics(t, func() {
 		keeper.NewKeeper(
 			"ausdy",
+			nil,
+			mocks.Authority,
 			mocks.FailingStore(mocks.Set, nil),
 			runtime.ProvideEventService(),
+			mocks.Codec(),
 			address.NewBech32Codec("noble"),
 			mocks.BankKeeper{},
 		)
 	})
 	// ASSERT: The function should've panicked.
 
-	// ARRANGE: Restore the original PausedKey
-	types.PausedKey = []byte("paused")
+	// ARRANGE: Restore the original PausedPrefix
+	types.PausedPrefix = []byte("denom/paused/")
+}
+
+func TestSendRestrictionAllowlist(t *testing.T) {
+	user := utils.TestAccount()
+	k, ctx := mocks.AuraKeeper()
+	k.SetChannelKeeper(mocks.ChannelKeeper{NextSequence: 2})
+	coins := sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+	allowed := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	unknown := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-1")
+
+	// ARRANGE: Allow channel-0 while in denylist mode.
+	require.NoError(t, k.SetAllowedChannel(ctx, "channel-0"))
+
+	// ACT: Attempt to transfer over a channel that isn't allowed.
+	_, err := k.SendRestrictionFn(ctx, user.Bytes, unknown, coins)
+	// ASSERT: The action should've succeeded, denylist mode ignores allowed channels.
+	require.NoError(t, err)
+
+	// ARRANGE: Switch to allowlist mode, and index all channels.
+	require.NoError(t, k.SetChannelMode(ctx, types.ChannelModeAllowlist))
+	require.NoError(t, k.BeginBlock(ctx))
+
+	// ACT: Attempt to transfer over a channel that isn't allowed.
+	_, err = k.SendRestrictionFn(ctx, user.Bytes, unknown, coins)
+	// ASSERT: The action should've failed due to channel not being allowed.
+	require.ErrorContains(t, err, "ausdy transfers are not allowed on channel-1")
+
+	// ACT: Attempt to transfer over an allowed channel.
+	_, err = k.SendRestrictionFn(ctx, user.Bytes, allowed, coins)
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ACT: Attempt to transfer from escrow account of a channel that isn't allowed.
+	_, err = k.SendRestrictionFn(ctx, unknown, user.Bytes, coins)
+	// ASSERT: The action should've succeeded, allowlist mode only restricts outbound transfers.
+	require.NoError(t, err)
+
+	// ACT: Attempt to transfer to a regular account.
+	_, err = k.SendRestrictionFn(ctx, user.Bytes, utils.TestAccount().Bytes, coins)
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ARRANGE: Block outbound transfers on the allowed channel.
+	require.NoError(t, k.SetBlockedChannel(ctx, k.Denom, "channel-0", types.ChannelDirectionOutbound))
+
+	// ACT: Attempt to transfer over a blocked channel that is allowed.
+	_, err = k.SendRestrictionFn(ctx, user.Bytes, allowed, coins)
+	// ASSERT: The action should've failed, blocked channels take precedence.
+	require.ErrorContains(t, err, "ausdy transfers are blocked on channel-0")
+}
+
+func TestIndexTransferChannels(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+
+	// ARRANGE: Allow channel-5 before any channel keeper is set.
+	require.NoError(t, k.SetAllowedChannel(ctx, "channel-5"))
+
+	// ACT: Begin a block without a channel keeper.
+	require.NoError(t, k.BeginBlock(ctx))
+	// ASSERT: Only the allowed channel should've been indexed.
+	require.Zero(t, k.GetIndexedChannels(ctx))
+	channel, found := k.GetTransferChannel(ctx, transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-5"))
+	require.True(t, found)
+	require.Equal(t, "channel-5", channel)
+	_, found = k.GetTransferChannel(ctx, transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0"))
+	require.False(t, found)
+
+	// ARRANGE: Set a channel keeper with two channels.
+	k.SetChannelKeeper(mocks.ChannelKeeper{NextSequence: 2})
+
+	// ACT: Begin a block.
+	require.NoError(t, k.BeginBlock(ctx))
+	// ASSERT: Both channels should've been indexed.
+	require.Equal(t, uint64(2), k.GetIndexedChannels(ctx))
+	for _, id := range []string{"channel-0", "channel-1"} {
+		channel, found := k.GetTransferChannel(ctx, transfertypes.GetEscrowAddress(transfertypes.PortID, id))
+		require.True(t, found)
+		require.Equal(t, id, channel)
+	}
+	_, found = k.GetTransferChannel(ctx, transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-2"))
+	require.False(t, found)
+
+	// ARRANGE: Create a third channel, and set up a failing collection store for the index.
+	k.SetChannelKeeper(mocks.ChannelKeeper{NextSequence: 3})
+	tmp := k.TransferEscrows
+	k.TransferEscrows = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.TransferEscrowPrefix, "transfer_escrows", collections.BytesKey, collections.StringValue,
+	)
+
+	// ACT: Begin a block with failing TransferEscrows collection store.
+	err := k.BeginBlock(ctx)
+	// ASSERT: The action should've failed due to collection store setter error.
+	require.ErrorIs(t, err, mocks.ErrorStoreAccess)
+	k.TransferEscrows = tmp
+
+	// ACT: Begin a block.
+	require.NoError(t, k.BeginBlock(ctx))
+	// ASSERT: Only the new channel should've been indexed.
+	require.Equal(t, uint64(3), k.GetIndexedChannels(ctx))
+	channel, found = k.GetTransferChannel(ctx, transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-2"))
+	require.True(t, found)
+	require.Equal(t, "channel-2", channel)
+}
+
+func TestSendRestrictionNoChannelKeeper(t *testing.T) {
+	user := utils.TestAccount()
+	k, ctx := mocks.AuraKeeper()
+	coins := sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+	allowed := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	unknown := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-1")
+
+	// ARRANGE: Allow channel-0, and switch to allowlist mode.
+	require.NoError(t, k.SetAllowedChannel(ctx, "channel-0"))
+	require.NoError(t, k.SetChannelMode(ctx, types.ChannelModeAllowlist))
+
+	// ACT: Attempt to transfer over an unindexed channel.
+	_, err := k.SendRestrictionFn(ctx, user.Bytes, unknown, coins)
+	// ASSERT: The action should've failed, as the channel can't be identified.
+	require.ErrorIs(t, err, types.ErrNoChannelKeeper)
+
+	// ACT: Attempt to transfer over the allowed channel.
+	_, err = k.SendRestrictionFn(ctx, user.Bytes, allowed, coins)
+	// ASSERT: The action should've succeeded, as allowed channels are always indexed.
+	require.NoError(t, err)
+
+	// ARRANGE: Switch to denylist mode, and only pause IBC outflows.
+	require.NoError(t, k.SetChannelMode(ctx, types.ChannelModeDenylist))
+	require.NoError(t, k.SetPaused(ctx, k.Denom, types.PauseScopeIBCOutflows, true))
+
+	// ACT: Attempt to transfer to an unindexed address.
+	_, err = k.SendRestrictionFn(ctx, user.Bytes, utils.TestAccount().Bytes, coins)
+	// ASSERT: The action should've failed, as the transfer can't be classified.
+	require.ErrorContains(t, err, "ausdy transfers are paused")
+
+	// ACT: Attempt to transfer over the indexed channel.
+	_, err = k.SendRestrictionFn(ctx, user.Bytes, allowed, coins)
+	// ASSERT: The action should've failed, as IBC outflows are paused.
+	require.ErrorContains(t, err, "outbound IBC ausdy transfers are paused")
+}
+
+func TestSendRestrictionRateLimit(t *testing.T) {
+	user := utils.TestAccount()
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+
+	// ARRANGE: Set a rate limit of 2 $USDY per hour on channel-0.
+	require.NoError(t, k.SetRateLimit(ctx, types.RateLimit{
+		Channel:       "channel-0",
+		Window:        time.Hour,
+		MaxAmount:     ONE.MulRaw(2),
+		MaxPercentage: math.LegacyZeroDec(),
+	}))
+
+	// ACT: Attempt to transfer 1 $USDY out of Noble.
+	_, err := k.SendRestrictionFn(ctx, user.Bytes, escrow, sdk.NewCoins(sdk.NewCoin(k.Denom, ONE)))
+	// ASSERT: The action should've succeeded, and tracked the outflow.
+	require.NoError(t, err)
+	flow := k.GetCurrentRateLimitFlow(ctx, k.GetRateLimit(ctx, "channel-0"))
+	require.Equal(t, ONE, flow.Outflow)
+
+	// ACT: Attempt to transfer 2 $USDY out of Noble.
+	_, err = k.SendRestrictionFn(ctx, user.Bytes, escrow, sdk.NewCoins(sdk.NewCoin(k.Denom, ONE.MulRaw(2))))
+	// ASSERT: The action should've failed due to exceeded rate limit.
+	require.ErrorContains(t, err, "ausdy transfers on channel-0 exceed rate limit")
+
+	// ACT: Attempt to transfer 1 $USDY back to Noble.
+	_, err = k.SendRestrictionFn(ctx, escrow, user.Bytes, sdk.NewCoins(sdk.NewCoin(k.Denom, ONE)))
+	// ASSERT: The action should've succeeded, and netted out the outflow.
+	require.NoError(t, err)
+	flow = k.GetCurrentRateLimitFlow(ctx, k.GetRateLimit(ctx, "channel-0"))
+	require.Equal(t, ONE, flow.Inflow)
+	require.Equal(t, ONE.MulRaw(2), flow.Remaining(ONE.MulRaw(2), types.ChannelDirectionOutbound))
+
+	// ACT: Attempt to transfer 2 $USDY out of Noble.
+	_, err = k.SendRestrictionFn(ctx, user.Bytes, escrow, sdk.NewCoins(sdk.NewCoin(k.Denom, ONE.MulRaw(2))))
+	// ASSERT: The action should've succeeded, and emitted an event as the quota is reached.
+	require.NoError(t, err)
+	require.Equal(t, "aura.v1.RateLimitReached", ctx.EventManager().Events()[0].Type)
+
+	// ACT: Attempt to transfer 5 $USDY back to Noble.
+	_, err = k.SendRestrictionFn(ctx, escrow, user.Bytes, sdk.NewCoins(sdk.NewCoin(k.Denom, ONE.MulRaw(5))))
+	// ASSERT: The action should've failed due to exceeded rate limit.
+	require.ErrorContains(t, err, "inbound ausdy transfers on channel-0 exceed rate limit")
+
+	// ACT: Attempt to refund 5 $USDY of a timed out transfer out of Noble.
+	_, err = k.SendRestrictionFn(types.WithRefund(ctx), escrow, user.Bytes, sdk.NewCoins(sdk.NewCoin(k.Denom, ONE.MulRaw(5))))
+	// ASSERT: The action should've succeeded, as refunds don't count as inflow,
+	// and released the outflow down to zero.
+	require.NoError(t, err)
+	flow = k.GetCurrentRateLimitFlow(ctx, k.GetRateLimit(ctx, "channel-0"))
+	require.Equal(t, ONE, flow.Inflow)
+	require.True(t, flow.Outflow.IsZero())
+
+	// ARRANGE: Move to the next window.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+
+	// ACT: Attempt to transfer 2 $USDY out of Noble.
+	_, err = k.SendRestrictionFn(ctx, user.Bytes, escrow, sdk.NewCoins(sdk.NewCoin(k.Denom, ONE.MulRaw(2))))
+	// ASSERT: The action should've succeeded, as the window has been reset.
+	require.NoError(t, err)
+
+	// ACT: Attempt to transfer 1 $USDY to a regular account.
+	_, err = k.SendRestrictionFn(ctx, user.Bytes, utils.TestAccount().Bytes, sdk.NewCoins(sdk.NewCoin(k.Denom, ONE)))
+	// ASSERT: The action should've succeeded, as only escrow accounts are rate limited.
+	require.NoError(t, err)
+}
+
+func TestSendRestrictionRateLimitPercentage(t *testing.T) {
+	user := utils.TestAccount()
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+
+	// ARRANGE: Give user a balance of 100 $USDY, and set a rate limit of 10% of supply on channel-0.
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin(k.Denom, ONE.MulRaw(100)))
+	require.NoError(t, k.SetRateLimit(ctx, types.RateLimit{
+		Channel:       "channel-0",
+		Window:        time.Hour,
+		MaxAmount:     math.ZeroInt(),
+		MaxPercentage: math.LegacyNewDec(10),
+	}))
+
+	// ACT: Attempt to transfer 11 $USDY out of Noble.
+	_, err := k.SendRestrictionFn(ctx, user.Bytes, escrow, sdk.NewCoins(sdk.NewCoin(k.Denom, ONE.MulRaw(11))))
+	// ASSERT: The action should've failed due to exceeded rate limit.
+	require.ErrorContains(t, err, "remaining quota is 10000000000000000000")
+
+	// ARRANGE: Additionally cap the rate limit at 5 $USDY.
+	require.NoError(t, k.SetRateLimit(ctx, types.RateLimit{
+		Channel:       "channel-0",
+		Window:        time.Hour,
+		MaxAmount:     ONE.MulRaw(5),
+		MaxPercentage: math.LegacyNewDec(10),
+	}))
+
+	// ACT: Attempt to transfer 6 $USDY out of Noble.
+	_, err = k.SendRestrictionFn(ctx, user.Bytes, escrow, sdk.NewCoins(sdk.NewCoin(k.Denom, ONE.MulRaw(6))))
+	// ASSERT: The action should've failed, as the lower limit applies.
+	require.ErrorContains(t, err, "remaining quota is 5000000000000000000")
+}
+
+// BenchmarkSendRestrictionBlockedChannels demonstrates that the cost of a
+// transfer is independent of the number of blocked channels.
+func BenchmarkSendRestrictionBlockedChannels(b *testing.B) {
+	for _, count := range []int{1, 10, 100, 1000} {
+		b.Run(fmt.Sprintf("Channels=%d", count), func(b *testing.B) {
+			user := utils.TestAccount()
+			k, ctx, commit := SetupBenchmarkKeeper(b)
+			coins := sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+			escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+
+			for i := 1; i <= count; i++ {
+				require.NoError(b, k.SetBlockedChannel(ctx, k.Denom, fmt.Sprintf("channel-%d", i), types.ChannelDirectionBoth))
+			}
+			commit()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, _ = k.SendRestrictionFn(ctx, user.Bytes, escrow, coins)
+			}
+		})
+	}
+}
+
+// BenchmarkSendRestrictionRateLimits demonstrates that the cost of a transfer
+// is independent of the number of rate limited channels.
+func BenchmarkSendRestrictionRateLimits(b *testing.B) {
+	for _, count := range []int{1, 10, 100, 1000} {
+		b.Run(fmt.Sprintf("Channels=%d", count), func(b *testing.B) {
+			user := utils.TestAccount()
+			k, ctx, commit := SetupBenchmarkKeeper(b)
+			coins := sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+
+			for i := 1; i <= count; i++ {
+				require.NoError(b, k.SetRateLimit(ctx, types.RateLimit{Channel: fmt.Sprintf("channel-%d", i), Window: time.Hour}))
+			}
+			commit()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, _ = k.SendRestrictionFn(ctx, user.Bytes, utils.TestAccount().Bytes, coins)
+			}
+		})
+	}
+}
+
+// BenchmarkTransferChannelLookup compares looking up the channel of an escrow
+// account by deriving the escrow account of every channel, as was done before
+// the transfer escrow index, against looking it up in the index.
+func BenchmarkTransferChannelLookup(b *testing.B) {
+	for _, count := range []int{1, 10, 100, 1000} {
+		k, ctx, commit := SetupBenchmarkKeeper(b)
+		for i := 0; i < count; i++ {
+			require.NoError(b, k.SetRateLimit(ctx, types.RateLimit{Channel: fmt.Sprintf("channel-%d", i), Window: time.Hour}))
+		}
+		commit()
+
+		// NOTE: The last channel is looked up, which is the worst case when deriving.
+		escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, fmt.Sprintf("channel-%d", count-1))
+
+		b.Run(fmt.Sprintf("Derive/Channels=%d", count), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for _, rateLimit := range k.GetRateLimits(ctx) {
+					if escrow.Equals(transfertypes.GetEscrowAddress(transfertypes.PortID, rateLimit.Channel)) {
+						break
+					}
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("Index/Channels=%d", count), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = k.GetTransferChannel(ctx, escrow)
+			}
+		})
+	}
+}
+
+// SetupBenchmarkKeeper returns a keeper backed by a database, and a function that
+// commits its state so that iterators don't have to sort unsaved writes.
+func SetupBenchmarkKeeper(b *testing.B) (*keeper.Keeper, sdk.Context, func()) {
+	key := storetypes.NewKVStoreKey(types.ModuleName)
+	testCtx := testutil.DefaultContextWithDB(b, key, storetypes.NewTransientStoreKey("transient_aura"))
+	k := keeper.NewKeeper(
+		"ausdy",
+		nil,
+		mocks.Authority,
+		runtime.NewKVStoreService(key),
+		runtime.ProvideEventService(),
+		mocks.Codec(),
+		address.NewBech32Codec("noble"),
+		mocks.BankKeeper{},
+	)
+
+	return k, testCtx.Ctx, func() { testCtx.CMS.Commit() }
 }
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+// lockMinted locks newly minted USDY of a recipient for the configured
+// lockup period. Mints within the same block are merged into a single lot.
+func (k *Keeper) lockMinted(ctx context.Context, recipient []byte, amount math.Int) error {
+	period := k.GetLockupPeriod(ctx)
+	if period <= 0 {
+		return nil
+	}
+
+	unlockTime := sdk.UnwrapSDKContext(ctx).BlockTime().Add(period)
+	lot := k.GetLockedLot(ctx, recipient, unlockTime).Add(amount)
+	if err := k.SetLockedLot(ctx, recipient, unlockTime, lot); err != nil {
+		return err
+	}
+
+	account, _ := k.addressCodec.BytesToString(recipient)
+
+	return k.eventService.EventManager(ctx).Emit(ctx, &types.Locked{
+		Account:    account,
+		Amount:     amount,
+		UnlockTime: unlockTime,
+	})
+}
+
+// pruneLockedLots removes all lots of an address that have unlocked, and
+// returns the amount of USDY that is still locked.
+func (k *Keeper) pruneLockedLots(ctx context.Context, address []byte) (math.Int, error) {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	locked := math.ZeroInt()
+	for _, lot := range k.GetLockedLotsByAddress(ctx, address) {
+		if lot.UnlockTime.After(now) {
+			locked = locked.Add(lot.Amount)
+			continue
+		}
+
+		if err := k.DeleteLockedLot(ctx, address, lot.UnlockTime); err != nil {
+			return math.Int{}, err
+		}
+	}
+
+	return locked, nil
+}
+
+// consumeLockedLots reduces the lots of an address by an amount of USDY that
+// left its balance without passing the lockup check, i.e. was burned or force
+// transferred, consuming the newest lots first.
+func (k *Keeper) consumeLockedLots(ctx context.Context, address []byte, amount math.Int) error {
+	if _, err := k.pruneLockedLots(ctx, address); err != nil {
+		return err
+	}
+
+	lots := k.GetLockedLotsByAddress(ctx, address)
+	for i := len(lots) - 1; i >= 0 && amount.IsPositive(); i-- {
+		lot := lots[i]
+		consumed := math.MinInt(lot.Amount, amount)
+		amount = amount.Sub(consumed)
+
+		if consumed.Equal(lot.Amount) {
+			if err := k.DeleteLockedLot(ctx, address, lot.UnlockTime); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := k.SetLockedLot(ctx, address, lot.UnlockTime, lot.Amount.Sub(consumed)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkLocked returns an error if sending an amount of USDY from an address
+// would dip into its locked balance.
+func (k *Keeper) checkLocked(ctx context.Context, address sdk.AccAddress, amount math.Int) error {
+	locked, err := k.pruneLockedLots(ctx, address)
+	if err != nil {
+		return err
+	}
+	if locked.IsZero() {
+		return nil
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, address, k.Denom).Amount
+	if balance.Sub(amount).LT(locked) {
+		return fmt.Errorf("%s has %s%s locked", address, locked.String(), k.Denom)
+	}
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper *Keeper
+	legacy legacyState
+}
+
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper, legacy: newLegacyState(keeper)}
+}
+
+// legacyState contains the collections of state that was stored for a single
+// denom, before this module started governing multiple denoms, the pause flag
+// of each denom, before pausing was scoped to individual operations, and the
+// roles of each denom, before they were keyed by address bytes.
+type legacyState struct {
+	Paused          collections.Item[bool]
+	DenomPaused     collections.Map[string, bool]
+	Owner           collections.Item[string]
+	PendingOwner    collections.Item[string]
+	Burners         collections.Map[string, math.Int]
+	Minters         collections.Map[string, math.Int]
+	Pausers         collections.Map[string, []byte]
+	DenomBurners    collections.Map[collections.Pair[string, string], math.Int]
+	DenomMinters    collections.Map[collections.Pair[string, string], math.Int]
+	DenomPausers    collections.Map[collections.Pair[string, string], []byte]
+	BlockedChannels collections.Map[string, types.ChannelDirection]
+	BlockedEscrows  collections.Map[[]byte, string]
+}
+
+func newLegacyState(keeper *Keeper) legacyState {
+	builder := collections.NewSchemaBuilder(keeper.storeService)
+
+	return legacyState{
+		Paused:          collections.NewItem(builder, types.LegacyPausedKey, "paused", collections.BoolValue),
+		DenomPaused:     collections.NewMap(builder, types.LegacyDenomPausedPrefix, "denom_paused", collections.StringKey, collections.BoolValue),
+		Owner:           collections.NewItem(builder, types.LegacyOwnerKey, "owner", collections.StringValue),
+		PendingOwner:    collections.NewItem(builder, types.LegacyPendingOwnerKey, "pending_owner", collections.StringValue),
+		Burners:         collections.NewMap(builder, types.LegacyBurnerPrefix, "burners", collections.StringKey, sdk.IntValue),
+		Minters:         collections.NewMap(builder, types.LegacyMinterPrefix, "minters", collections.StringKey, sdk.IntValue),
+		Pausers:         collections.NewMap(builder, types.LegacyPauserPrefix, "pausers", collections.StringKey, collections.BytesValue),
+		DenomBurners:    collections.NewMap(builder, types.BurnerPrefix, "denom_burners", collections.PairKeyCodec(collections.StringKey, collections.StringKey), sdk.IntValue),
+		DenomMinters:    collections.NewMap(builder, types.MinterPrefix, "denom_minters", collections.PairKeyCodec(collections.StringKey, collections.StringKey), sdk.IntValue),
+		DenomPausers:    collections.NewMap(builder, types.PauserPrefix, "denom_pausers", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.BytesValue),
+		BlockedChannels: collections.NewMap(builder, types.LegacyBlockedChannelPrefix, "blocked_channels", collections.StringKey, types.ChannelDirectionValue),
+		BlockedEscrows:  collections.NewMap(builder, types.LegacyBlockedEscrowPrefix, "blocked_escrows", collections.BytesKey, collections.StringValue),
+	}
+}
+
+// Migrate1to2 migrates from version 1 to 2, backfilling the escrow address
+// index of blocked channels. Channels blocked before directions were
+// introduced are rewritten with an explicit outbound direction.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	channels, err := m.legacy.BlockedChannels.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	kvs, err := channels.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range kvs {
+		if err := m.legacy.BlockedEscrows.Set(ctx, transfertypes.GetEscrowAddress(transfertypes.PortID, kv.Key), kv.Key); err != nil {
+			return err
+		}
+		if err := m.legacy.BlockedChannels.Set(ctx, kv.Key, kv.Value.Normalize()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3, moving the owner, roles, pause
+// flag, and blocked channels of the module under the default denom.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	denom := m.keeper.Denom
+
+	if paused, err := m.legacy.Paused.Get(ctx); err == nil {
+		if err := m.legacy.DenomPaused.Set(ctx, denom, paused); err != nil {
+			return err
+		}
+	}
+	if err := m.legacy.Paused.Remove(ctx); err != nil {
+		return err
+	}
+
+	if owner, err := m.legacy.Owner.Get(ctx); err == nil {
+		if err := m.keeper.SetOwner(ctx, denom, owner); err != nil {
+			return err
+		}
+	}
+	if err := m.legacy.Owner.Remove(ctx); err != nil {
+		return err
+	}
+
+	if pendingOwner, err := m.legacy.PendingOwner.Get(ctx); err == nil {
+		if err := m.keeper.SetPendingOwner(ctx, denom, pendingOwner); err != nil {
+			return err
+		}
+	}
+	if err := m.legacy.PendingOwner.Remove(ctx); err != nil {
+		return err
+	}
+
+	if err := migrateMap(ctx, m.legacy.Burners, func(burner string, allowance math.Int) error {
+		return m.legacy.DenomBurners.Set(ctx, collections.Join(denom, burner), allowance)
+	}); err != nil {
+		return err
+	}
+	if err := migrateMap(ctx, m.legacy.Minters, func(minter string, allowance math.Int) error {
+		return m.legacy.DenomMinters.Set(ctx, collections.Join(denom, minter), allowance)
+	}); err != nil {
+		return err
+	}
+	if err := migrateMap(ctx, m.legacy.Pausers, func(pauser string, _ []byte) error {
+		return m.legacy.DenomPausers.Set(ctx, collections.Join(denom, pauser), []byte{})
+	}); err != nil {
+		return err
+	}
+	if err := migrateMap(ctx, m.legacy.BlockedChannels, func(channel string, direction types.ChannelDirection) error {
+		return m.keeper.SetBlockedChannel(ctx, denom, channel, direction)
+	}); err != nil {
+		return err
+	}
+
+	// NOTE: The escrow index is rebuilt when setting blocked channels above.
+	return migrateMap(ctx, m.legacy.BlockedEscrows, func([]byte, string) error { return nil })
+}
+
+// Migrate3to4 migrates from version 3 to 4, replacing the pause flag of each
+// governed denom with a set of paused scopes. Paused denoms have all scopes
+// paused except burning, which the pause flag never blocked.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return migrateMap(ctx, m.legacy.DenomPaused, func(denom string, paused bool) error {
+		if !paused {
+			return nil
+		}
+
+		for _, scope := range types.DefaultPauseScopes {
+			if err := m.keeper.SetPaused(ctx, denom, scope, true); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Migrate4to5 migrates from version 4 to 5, keying the burners, minters, and
+// pausers of each governed denom by address bytes instead of the address as
+// provided. Roles that were stored under several encodings of the same address
+// are merged, preferring the entry stored under the canonical encoding, and
+// roles of undecodable addresses are dropped.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	if err := migrateRoles(ctx, m, m.legacy.DenomBurners, m.keeper.Burners); err != nil {
+		return err
+	}
+	if err := migrateRoles(ctx, m, m.legacy.DenomMinters, m.keeper.Minters); err != nil {
+		return err
+	}
+
+	return migrateRoles(ctx, m, m.legacy.DenomPausers, m.keeper.Pausers)
+}
+
+// migrateRoles moves all entries of a legacy role map, keyed by denom and
+// address string, into a role map keyed by denom and address bytes.
+func migrateRoles[V any](ctx sdk.Context, m Migrator, legacy collections.Map[collections.Pair[string, string], V], roles collections.Map[collections.Pair[string, []byte], V]) error {
+	return migrateMap(ctx, legacy, func(key collections.Pair[string, string], value V) error {
+		address, canonical, err := m.keeper.decodeAddress(key.K2())
+		if err != nil {
+			ctx.Logger().Error("dropping role of undecodable address", "denom", key.K1(), "address", key.K2(), "err", err)
+			return nil
+		}
+
+		has, err := roles.Has(ctx, collections.Join(key.K1(), address))
+		if err != nil {
+			return err
+		}
+		if has && key.K2() != canonical {
+			return nil
+		}
+
+		return roles.Set(ctx, collections.Join(key.K1(), address), value)
+	})
+}
+
+// migrateMap calls the provided function on, and then removes, all entries of
+// a legacy map.
+func migrateMap[K, V any](ctx sdk.Context, legacy collections.Map[K, V], fn func(K, V) error) error {
+	iter, err := legacy.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range kvs {
+		if err := fn(kv.Key, kv.Value); err != nil {
+			return err
+		}
+		if err := legacy.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
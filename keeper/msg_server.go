@@ -6,8 +6,11 @@ import (
 	"fmt"
 
 	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types/blocklist"
 )
 
 var _ types.MsgServer = &msgServer{}
@@ -21,10 +24,22 @@ func NewMsgServer(keeper *Keeper) types.MsgServer {
 }
 
 func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
-	if !k.HasBurner(ctx, msg.Signer) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	burner, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode burner address %s", msg.Signer)
+	}
+	if !k.HasBurner(ctx, denom, burner) {
 		return nil, types.ErrInvalidBurner
 	}
-	allowance := k.GetBurner(ctx, msg.Signer)
+	if k.isBlocked(ctx, denom, burner) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockedAddress, "burner %s", msg.Signer)
+	}
+	allowance := k.getBurnAllowance(ctx, denom, burner)
 	if allowance.LT(msg.Amount) {
 		return nil, sdkerrors.Wrapf(types.ErrInsufficientAllowance, "burner %s has an allowance of %s", msg.Signer, allowance.String())
 	}
@@ -33,12 +48,19 @@ func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurn
 	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "unable to decode account address %s", msg.From)
 	}
+	if !k.isPermittedSource(ctx, denom, burner, from) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSource, "burner %s cannot burn from %s", msg.Signer, msg.From)
+	}
 
 	if !msg.Amount.IsPositive() {
 		return nil, errors.New("amount must be positive")
 	}
 
-	coins := sdk.NewCoins(sdk.NewCoin(k.Denom, msg.Amount))
+	if err := k.checkBurn(ctx, denom); err != nil {
+		return nil, err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, msg.Amount))
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, coins)
 	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "unable to transfer from user to module")
@@ -47,8 +69,13 @@ func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurn
 	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "unable to burn from module")
 	}
+	if denom == k.Denom {
+		if err := k.consumeLockedLots(ctx, from, msg.Amount); err != nil {
+			return nil, err
+		}
+	}
 
-	if err := k.SetBurner(ctx, msg.Signer, allowance.Sub(msg.Amount)); err != nil {
+	if err := k.SetBurner(ctx, denom, burner, allowance.Sub(msg.Amount)); err != nil {
 		return nil, err
 	}
 
@@ -57,10 +84,22 @@ func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurn
 }
 
 func (k msgServer) Mint(ctx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
-	if !k.HasMinter(ctx, msg.Signer) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	minter, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode minter address %s", msg.Signer)
+	}
+	if !k.HasMinter(ctx, denom, minter) {
 		return nil, types.ErrInvalidMinter
 	}
-	allowance := k.GetMinter(ctx, msg.Signer)
+	if k.isBlocked(ctx, denom, minter) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockedAddress, "minter %s", msg.Signer)
+	}
+	allowance := k.getMintAllowance(ctx, denom, minter)
 	if allowance.LT(msg.Amount) {
 		return nil, sdkerrors.Wrapf(types.ErrInsufficientAllowance, "minter %s has an allowance of %s", msg.Signer, allowance.String())
 	}
@@ -69,22 +108,36 @@ func (k msgServer) Mint(ctx context.Context, msg *types.MsgMint) (*types.MsgMint
 	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "unable to decode account address %s", msg.To)
 	}
+	if !k.isPermittedRecipient(ctx, denom, minter, to) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRecipient, "minter %s cannot mint to %s", msg.Signer, msg.To)
+	}
 
 	if !msg.Amount.IsPositive() {
 		return nil, errors.New("amount must be positive")
 	}
 
-	coins := sdk.NewCoins(sdk.NewCoin(k.Denom, msg.Amount))
+	if denom == k.Denom {
+		if err := k.checkReserves(ctx, msg.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, msg.Amount))
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "unable to mint to module")
 	}
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, coins)
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(types.WithMinting(ctx), types.ModuleName, to, coins)
 	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "unable to transfer from module to user")
 	}
+	if denom == k.Denom {
+		if err := k.lockMinted(ctx, to, msg.Amount); err != nil {
+			return nil, err
+		}
+	}
 
-	if err := k.SetMinter(ctx, msg.Signer, allowance.Sub(msg.Amount)); err != nil {
+	if err := k.SetMinter(ctx, denom, minter, allowance.Sub(msg.Amount)); err != nil {
 		return nil, err
 	}
 
@@ -93,331 +146,1198 @@ func (k msgServer) Mint(ctx context.Context, msg *types.MsgMint) (*types.MsgMint
 }
 
 func (k msgServer) Pause(ctx context.Context, msg *types.MsgPause) (*types.MsgPauseResponse, error) {
-	if !k.HasPauser(ctx, msg.Signer) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	pauser, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode pauser address %s", msg.Signer)
+	}
+	if !k.HasPauser(ctx, denom, pauser) {
 		return nil, types.ErrInvalidPauser
 	}
-	if k.GetPaused(ctx) {
+	if k.isBlocked(ctx, denom, pauser) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockedAddress, "pauser %s", msg.Signer)
+	}
+	if !msg.Scope.IsValid() {
+		return nil, fmt.Errorf("invalid pause scope (%d)", msg.Scope)
+	}
+
+	window := types.PauseWindow{Denom: denom, EndHeight: msg.EndHeight, EndTime: msg.EndTime}
+	if window.HasEnd() {
+		if err := k.validatePauseWindow(ctx, window); err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid pause end")
+		}
+	}
+
+	var scopes []types.PauseScope
+	for _, scope := range msg.Scope.Expand(types.DefaultPauseScopes) {
+		if !k.IsPaused(ctx, denom, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	if len(scopes) == 0 {
 		return nil, errors.New("module is already paused")
 	}
 
-	if err := k.SetPaused(ctx, true); err != nil {
-		return nil, err
+	for _, scope := range scopes {
+		if err := k.SetPaused(ctx, denom, scope, true); err != nil {
+			return nil, err
+		}
+
+		// NOTE: This replaces any previously scheduled pause of the scope.
+		window.Scope = scope
+		if err := k.updatePauseWindow(ctx, window); err != nil {
+			return nil, err
+		}
+
+		if err := k.eventService.EventManager(ctx).Emit(ctx, &types.Paused{
+			Account:   msg.Signer,
+			Denom:     denom,
+			Scope:     scope,
+			EndHeight: msg.EndHeight,
+			EndTime:   msg.EndTime,
+		}); err != nil {
+			return nil, err
+		}
 	}
 
-	return &types.MsgPauseResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.Paused{
-		Account: msg.Signer,
-	})
+	return &types.MsgPauseResponse{}, nil
 }
 
 func (k msgServer) Unpause(ctx context.Context, msg *types.MsgUnpause) (*types.MsgUnpauseResponse, error) {
-	owner := k.GetOwner(ctx)
-	if owner == "" {
-		return nil, types.ErrNoOwner
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
 	}
-	if msg.Signer != owner {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidOwner, "expected %s, got %s", owner, msg.Signer)
+	if !msg.Scope.IsValid() {
+		return nil, fmt.Errorf("invalid pause scope (%d)", msg.Scope)
 	}
 
-	if !k.GetPaused(ctx) {
+	var scopes, scheduled []types.PauseScope
+	for _, scope := range msg.Scope.Expand(types.PauseScopes) {
+		if k.IsPaused(ctx, denom, scope) {
+			scopes = append(scopes, scope)
+		} else if _, found := k.GetPauseWindow(ctx, denom, scope); found {
+			scheduled = append(scheduled, scope)
+		}
+	}
+	if len(scopes) == 0 && len(scheduled) == 0 {
 		return nil, errors.New("module is already unpaused")
 	}
 
-	if err := k.SetPaused(ctx, false); err != nil {
+	for _, scope := range scopes {
+		if err := k.SetPaused(ctx, denom, scope, false); err != nil {
+			return nil, err
+		}
+		if err := k.DeletePauseWindow(ctx, denom, scope); err != nil {
+			return nil, err
+		}
+
+		if err := k.eventService.EventManager(ctx).Emit(ctx, &types.Unpaused{
+			Account: msg.Signer,
+			Denom:   denom,
+			Scope:   scope,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, scope := range scheduled {
+		if err := k.DeletePauseWindow(ctx, denom, scope); err != nil {
+			return nil, err
+		}
+
+		if err := k.eventService.EventManager(ctx).Emit(ctx, &types.PauseScheduleCancelled{
+			Account: msg.Signer,
+			Denom:   denom,
+			Scope:   scope,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgUnpauseResponse{}, nil
+}
+
+func (k msgServer) SchedulePause(ctx context.Context, msg *types.MsgSchedulePause) (*types.MsgSchedulePauseResponse, error) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgUnpauseResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.Unpaused{
-		Account: msg.Signer,
-	})
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
+	}
+	if !msg.Scope.IsValid() {
+		return nil, fmt.Errorf("invalid pause scope (%d)", msg.Scope)
+	}
+
+	window := types.PauseWindow{
+		Denom:       denom,
+		StartHeight: msg.StartHeight,
+		StartTime:   msg.StartTime,
+		EndHeight:   msg.EndHeight,
+		EndTime:     msg.EndTime,
+	}
+	if !window.HasStart() {
+		return nil, errors.New("start height or time must be provided")
+	}
+	if err := k.validatePauseWindow(ctx, window); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid pause window")
+	}
+
+	// NOTE: Scopes that are currently paused are scheduled as well, as they
+	// can be unpaused before the window starts.
+	for _, scope := range msg.Scope.Expand(types.DefaultPauseScopes) {
+		// NOTE: This replaces any previously scheduled pause of the scope.
+		window.Scope = scope
+		if err := k.SetPauseWindow(ctx, window); err != nil {
+			return nil, err
+		}
+
+		if err := k.eventService.EventManager(ctx).Emit(ctx, &types.PauseScheduled{
+			Account:     msg.Signer,
+			Denom:       denom,
+			Scope:       scope,
+			StartHeight: msg.StartHeight,
+			StartTime:   msg.StartTime,
+			EndHeight:   msg.EndHeight,
+			EndTime:     msg.EndTime,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSchedulePauseResponse{}, nil
 }
 
 func (k msgServer) TransferOwnership(ctx context.Context, msg *types.MsgTransferOwnership) (*types.MsgTransferOwnershipResponse, error) {
-	owner := k.GetOwner(ctx)
-	if owner == "" {
-		return nil, types.ErrNoOwner
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
 	}
-	if msg.Signer != owner {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidOwner, "expected %s, got %s", owner, msg.Signer)
+
+	_, newOwner, err := k.decodeAddress(msg.NewOwner)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode owner address %s", msg.NewOwner)
 	}
 
-	if msg.NewOwner == owner {
+	owner := k.GetOwner(ctx, denom)
+	if newOwner == owner {
 		return nil, types.ErrSameOwner
 	}
 
-	if err := k.SetPendingOwner(ctx, msg.NewOwner); err != nil {
+	if err := k.SetPendingOwner(ctx, denom, newOwner); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgTransferOwnershipResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.OwnershipTransferStarted{
 		PreviousOwner: owner,
-		NewOwner:      msg.NewOwner,
+		NewOwner:      newOwner,
+		Denom:         denom,
 	})
 }
 
 func (k msgServer) AcceptOwnership(ctx context.Context, msg *types.MsgAcceptOwnership) (*types.MsgAcceptOwnershipResponse, error) {
-	pendingOwner := k.GetPendingOwner(ctx)
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	_, signer, _ := k.decodeAddress(msg.Signer)
+	pendingOwner := k.GetPendingOwner(ctx, denom)
 	if pendingOwner == "" {
 		return nil, types.ErrNoPendingOwner
 	}
-	if msg.Signer != pendingOwner {
+	if signer != pendingOwner {
 		return nil, sdkerrors.Wrapf(types.ErrInvalidPendingOwner, "expected %s, got %s", pendingOwner, msg.Signer)
 	}
 
-	owner := k.GetOwner(ctx)
+	owner := k.GetOwner(ctx, denom)
 
-	if err := k.SetOwner(ctx, msg.Signer); err != nil {
+	if err := k.SetOwner(ctx, denom, signer); err != nil {
 		return nil, err
 	}
-	if err := k.DeletePendingOwner(ctx); err != nil {
+	if err := k.DeletePendingOwner(ctx, denom); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgAcceptOwnershipResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.OwnershipTransferred{
 		PreviousOwner: owner,
-		NewOwner:      msg.Signer,
+		NewOwner:      signer,
+		Denom:         denom,
 	})
 }
 
+func (k msgServer) ForceOwnership(ctx context.Context, msg *types.MsgForceOwnership) (*types.MsgForceOwnershipResponse, error) {
+	if _, signer, _ := k.decodeAddress(msg.Signer); signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Owner == "" && msg.BlocklistOwner == "" {
+		return nil, errors.New("owner or blocklist owner must be provided")
+	}
+
+	if msg.Owner != "" {
+		_, newOwner, err := k.decodeAddress(msg.Owner)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "unable to decode owner address %s", msg.Owner)
+		}
+
+		owner := k.GetOwner(ctx, denom)
+		if err := k.SetOwner(ctx, denom, newOwner); err != nil {
+			return nil, err
+		}
+		if err := k.DeletePendingOwner(ctx, denom); err != nil {
+			return nil, err
+		}
+
+		if err := k.eventService.EventManager(ctx).Emit(ctx, &types.OwnershipTransferred{
+			PreviousOwner: owner,
+			NewOwner:      newOwner,
+			Denom:         denom,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.BlocklistOwner != "" {
+		_, newOwner, err := k.decodeAddress(msg.BlocklistOwner)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "unable to decode blocklist owner address %s", msg.BlocklistOwner)
+		}
+
+		owner := k.GetBlocklistOwner(ctx)
+		if err := k.SetBlocklistOwner(ctx, newOwner); err != nil {
+			return nil, err
+		}
+		if err := k.DeleteBlocklistPendingOwner(ctx); err != nil {
+			return nil, err
+		}
+
+		if err := k.eventService.EventManager(ctx).Emit(ctx, &blocklist.OwnershipTransferred{
+			PreviousOwner: owner,
+			NewOwner:      newOwner,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgForceOwnershipResponse{}, nil
+}
+
 func (k msgServer) AddBurner(ctx context.Context, msg *types.MsgAddBurner) (*types.MsgAddBurnerResponse, error) {
-	owner := k.GetOwner(ctx)
-	if owner == "" {
-		return nil, types.ErrNoOwner
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
 	}
-	if msg.Signer != owner {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidOwner, "expected %s, got %s", owner, msg.Signer)
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
 	}
 
-	if k.HasBurner(ctx, msg.Burner) {
-		return nil, fmt.Errorf("%s is already a burner", msg.Burner)
+	bz, burner, err := k.decodeAddress(msg.Burner)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode burner address %s", msg.Burner)
+	}
+
+	if k.HasBurner(ctx, denom, bz) {
+		return nil, fmt.Errorf("%s is already a burner", burner)
+	}
+	if k.isBlocked(ctx, denom, bz) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockedAddress, "%s cannot be a burner", burner)
 	}
 
 	if msg.Allowance.IsNegative() {
 		return nil, errors.New("allowance cannot be negative")
 	}
+	if err := k.validateExpiry(ctx, msg.Expiry); err != nil {
+		return nil, err
+	}
 
-	if err := k.SetBurner(ctx, msg.Burner, msg.Allowance); err != nil {
+	if err := k.SetBurner(ctx, denom, bz, msg.Allowance); err != nil {
+		return nil, err
+	}
+	if err := k.SetBurnerExpiry(ctx, denom, bz, msg.Expiry); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgAddBurnerResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.BurnerAdded{
-		Address:   msg.Burner,
+		Address:   burner,
 		Allowance: msg.Allowance,
+		Denom:     denom,
+		Expiry:    msg.Expiry,
 	})
 }
 
 func (k msgServer) RemoveBurner(ctx context.Context, msg *types.MsgRemoveBurner) (*types.MsgRemoveBurnerResponse, error) {
-	owner := k.GetOwner(ctx)
-	if owner == "" {
-		return nil, types.ErrNoOwner
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
 	}
-	if msg.Signer != owner {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidOwner, "expected %s, got %s", owner, msg.Signer)
+
+	bz, burner, err := k.decodeAddress(msg.Burner)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode burner address %s", msg.Burner)
 	}
 
-	if !k.HasBurner(ctx, msg.Burner) {
-		return nil, fmt.Errorf("%s is not a burner", msg.Burner)
+	if !k.HasBurner(ctx, denom, bz) {
+		return nil, fmt.Errorf("%s is not a burner", burner)
 	}
 
-	if err := k.DeleteBurner(ctx, msg.Burner); err != nil {
+	if err := k.DeleteBurner(ctx, denom, bz); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgRemoveBurnerResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.BurnerRemoved{
-		Address: msg.Burner,
+		Address: burner,
+		Denom:   denom,
 	})
 }
 
 func (k msgServer) SetBurnerAllowance(ctx context.Context, msg *types.MsgSetBurnerAllowance) (*types.MsgSetBurnerAllowanceResponse, error) {
-	owner := k.GetOwner(ctx)
-	if owner == "" {
-		return nil, types.ErrNoOwner
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
 	}
-	if msg.Signer != owner {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidOwner, "expected %s, got %s", owner, msg.Signer)
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
 	}
 
-	if !k.HasBurner(ctx, msg.Burner) {
-		return nil, fmt.Errorf("%s is not a burner", msg.Burner)
+	bz, burner, err := k.decodeAddress(msg.Burner)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode burner address %s", msg.Burner)
+	}
+
+	if !k.HasBurner(ctx, denom, bz) {
+		return nil, fmt.Errorf("%s is not a burner", burner)
 	}
 
 	if msg.Allowance.IsNegative() {
 		return nil, errors.New("allowance cannot be negative")
 	}
+	if msg.ClearExpiry && !msg.Expiry.IsZero() {
+		return nil, errors.New("expiry cannot be provided when clearing it")
+	}
+	if err := k.validateExpiry(ctx, msg.Expiry); err != nil {
+		return nil, err
+	}
+
+	// NOTE: The existing expiry is kept, unless a new one is provided or it
+	// is explicitly cleared.
+	expiry := msg.Expiry
+	if expiry.IsZero() && !msg.ClearExpiry {
+		expiry = k.GetBurnerExpiry(ctx, denom, bz)
+	}
 
-	allowance := k.GetBurner(ctx, msg.Burner)
-	if err := k.SetBurner(ctx, msg.Burner, msg.Allowance); err != nil {
+	allowance := k.GetBurner(ctx, denom, bz)
+	if err := k.SetBurner(ctx, denom, bz, msg.Allowance); err != nil {
+		return nil, err
+	}
+	if err := k.SetBurnerExpiry(ctx, denom, bz, expiry); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgSetBurnerAllowanceResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.BurnerUpdated{
-		Address:           msg.Burner,
+		Address:           burner,
 		PreviousAllowance: allowance,
 		NewAllowance:      msg.Allowance,
+		Denom:             denom,
+		Expiry:            expiry,
+	})
+}
+
+func (k msgServer) IncreaseBurnerAllowance(ctx context.Context, msg *types.MsgIncreaseBurnerAllowance) (*types.MsgIncreaseBurnerAllowanceResponse, error) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	bz, burner, err := k.decodeAddress(msg.Burner)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode burner address %s", msg.Burner)
+	}
+
+	if !k.HasBurner(ctx, denom, bz) {
+		return nil, fmt.Errorf("%s is not a burner", burner)
+	}
+
+	if !msg.Amount.IsPositive() {
+		return nil, errors.New("amount must be positive")
+	}
+
+	allowance := k.GetBurner(ctx, denom, bz)
+	newAllowance := allowance.Add(msg.Amount)
+	if err := k.SetBurner(ctx, denom, bz, newAllowance); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgIncreaseBurnerAllowanceResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.BurnerUpdated{
+		Address:           burner,
+		PreviousAllowance: allowance,
+		NewAllowance:      newAllowance,
+		Denom:             denom,
+		Expiry:            k.GetBurnerExpiry(ctx, denom, bz),
+	})
+}
+
+func (k msgServer) DecreaseBurnerAllowance(ctx context.Context, msg *types.MsgDecreaseBurnerAllowance) (*types.MsgDecreaseBurnerAllowanceResponse, error) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	bz, burner, err := k.decodeAddress(msg.Burner)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode burner address %s", msg.Burner)
+	}
+
+	if !k.HasBurner(ctx, denom, bz) {
+		return nil, fmt.Errorf("%s is not a burner", burner)
+	}
+
+	if !msg.Amount.IsPositive() {
+		return nil, errors.New("amount must be positive")
+	}
+
+	allowance := k.GetBurner(ctx, denom, bz)
+	if allowance.LT(msg.Amount) {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientAllowance, "burner %s has an allowance of %s", burner, allowance.String())
+	}
+	newAllowance := allowance.Sub(msg.Amount)
+	if err := k.SetBurner(ctx, denom, bz, newAllowance); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDecreaseBurnerAllowanceResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.BurnerUpdated{
+		Address:           burner,
+		PreviousAllowance: allowance,
+		NewAllowance:      newAllowance,
+		Denom:             denom,
+		Expiry:            k.GetBurnerExpiry(ctx, denom, bz),
+	})
+}
+
+func (k msgServer) AddBurnerSource(ctx context.Context, msg *types.MsgAddBurnerSource) (*types.MsgAddBurnerSourceResponse, error) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	bz, burner, err := k.decodeAddress(msg.Burner)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode burner address %s", msg.Burner)
+	}
+
+	if !k.HasBurner(ctx, denom, bz) {
+		return nil, fmt.Errorf("%s is not a burner", burner)
+	}
+
+	address, source, err := k.decodeAddress(msg.Source)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode source address %s", msg.Source)
+	}
+
+	if k.HasBurnerSource(ctx, denom, bz, address) {
+		return nil, fmt.Errorf("%s is already a source of burner %s", source, burner)
+	}
+
+	if err := k.SetBurnerSource(ctx, denom, bz, address); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddBurnerSourceResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.BurnerSourceAdded{
+		Burner: burner,
+		Source: source,
+		Denom:  denom,
+	})
+}
+
+func (k msgServer) RemoveBurnerSource(ctx context.Context, msg *types.MsgRemoveBurnerSource) (*types.MsgRemoveBurnerSourceResponse, error) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	bz, burner, err := k.decodeAddress(msg.Burner)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode burner address %s", msg.Burner)
+	}
+
+	address, source, err := k.decodeAddress(msg.Source)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode source address %s", msg.Source)
+	}
+
+	if !k.HasBurnerSource(ctx, denom, bz, address) {
+		return nil, fmt.Errorf("%s is not a source of burner %s", source, burner)
+	}
+
+	if err := k.DeleteBurnerSource(ctx, denom, bz, address); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveBurnerSourceResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.BurnerSourceRemoved{
+		Burner: burner,
+		Source: source,
+		Denom:  denom,
 	})
 }
 
 func (k msgServer) AddMinter(ctx context.Context, msg *types.MsgAddMinter) (*types.MsgAddMinterResponse, error) {
-	owner := k.GetOwner(ctx)
-	if owner == "" {
-		return nil, types.ErrNoOwner
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
 	}
-	if msg.Signer != owner {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidOwner, "expected %s, got %s", owner, msg.Signer)
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
 	}
 
-	if k.HasMinter(ctx, msg.Minter) {
-		return nil, fmt.Errorf("%s is already a minter", msg.Minter)
+	bz, minter, err := k.decodeAddress(msg.Minter)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode minter address %s", msg.Minter)
+	}
+
+	if k.HasMinter(ctx, denom, bz) {
+		return nil, fmt.Errorf("%s is already a minter", minter)
+	}
+	if k.isBlocked(ctx, denom, bz) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockedAddress, "%s cannot be a minter", minter)
 	}
 
 	if msg.Allowance.IsNegative() {
 		return nil, errors.New("allowance cannot be negative")
 	}
+	if err := k.validateExpiry(ctx, msg.Expiry); err != nil {
+		return nil, err
+	}
 
-	if err := k.SetMinter(ctx, msg.Minter, msg.Allowance); err != nil {
+	if err := k.SetMinter(ctx, denom, bz, msg.Allowance); err != nil {
+		return nil, err
+	}
+	if err := k.SetMinterExpiry(ctx, denom, bz, msg.Expiry); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgAddMinterResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.MinterAdded{
-		Address:   msg.Minter,
+		Address:   minter,
 		Allowance: msg.Allowance,
+		Denom:     denom,
+		Expiry:    msg.Expiry,
 	})
 }
 
 func (k msgServer) RemoveMinter(ctx context.Context, msg *types.MsgRemoveMinter) (*types.MsgRemoveMinterResponse, error) {
-	owner := k.GetOwner(ctx)
-	if owner == "" {
-		return nil, types.ErrNoOwner
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
 	}
-	if msg.Signer != owner {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidOwner, "expected %s, got %s", owner, msg.Signer)
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	bz, minter, err := k.decodeAddress(msg.Minter)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode minter address %s", msg.Minter)
 	}
 
-	if !k.HasMinter(ctx, msg.Minter) {
-		return nil, fmt.Errorf("%s is not a minter", msg.Minter)
+	if !k.HasMinter(ctx, denom, bz) {
+		return nil, fmt.Errorf("%s is not a minter", minter)
 	}
 
-	if err := k.DeleteMinter(ctx, msg.Minter); err != nil {
+	if err := k.DeleteMinter(ctx, denom, bz); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgRemoveMinterResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.MinterRemoved{
-		Address: msg.Minter,
+		Address: minter,
+		Denom:   denom,
 	})
 }
 
 func (k msgServer) SetMinterAllowance(ctx context.Context, msg *types.MsgSetMinterAllowance) (*types.MsgSetMinterAllowanceResponse, error) {
-	owner := k.GetOwner(ctx)
-	if owner == "" {
-		return nil, types.ErrNoOwner
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
 	}
-	if msg.Signer != owner {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidOwner, "expected %s, got %s", owner, msg.Signer)
+
+	bz, minter, err := k.decodeAddress(msg.Minter)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode minter address %s", msg.Minter)
 	}
 
-	if !k.HasMinter(ctx, msg.Minter) {
-		return nil, fmt.Errorf("%s is not a minter", msg.Minter)
+	if !k.HasMinter(ctx, denom, bz) {
+		return nil, fmt.Errorf("%s is not a minter", minter)
 	}
 
 	if msg.Allowance.IsNegative() {
 		return nil, errors.New("allowance cannot be negative")
 	}
+	if msg.ClearExpiry && !msg.Expiry.IsZero() {
+		return nil, errors.New("expiry cannot be provided when clearing it")
+	}
+	if err := k.validateExpiry(ctx, msg.Expiry); err != nil {
+		return nil, err
+	}
 
-	allowance := k.GetMinter(ctx, msg.Minter)
-	if err := k.SetMinter(ctx, msg.Minter, msg.Allowance); err != nil {
+	// NOTE: The existing expiry is kept, unless a new one is provided or it
+	// is explicitly cleared.
+	expiry := msg.Expiry
+	if expiry.IsZero() && !msg.ClearExpiry {
+		expiry = k.GetMinterExpiry(ctx, denom, bz)
+	}
+
+	allowance := k.GetMinter(ctx, denom, bz)
+	if err := k.SetMinter(ctx, denom, bz, msg.Allowance); err != nil {
+		return nil, err
+	}
+	if err := k.SetMinterExpiry(ctx, denom, bz, expiry); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgSetMinterAllowanceResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.MinterUpdated{
-		Address:           msg.Minter,
+		Address:           minter,
 		PreviousAllowance: allowance,
 		NewAllowance:      msg.Allowance,
+		Denom:             denom,
+		Expiry:            expiry,
+	})
+}
+
+func (k msgServer) IncreaseMinterAllowance(ctx context.Context, msg *types.MsgIncreaseMinterAllowance) (*types.MsgIncreaseMinterAllowanceResponse, error) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	bz, minter, err := k.decodeAddress(msg.Minter)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode minter address %s", msg.Minter)
+	}
+
+	if !k.HasMinter(ctx, denom, bz) {
+		return nil, fmt.Errorf("%s is not a minter", minter)
+	}
+
+	if !msg.Amount.IsPositive() {
+		return nil, errors.New("amount must be positive")
+	}
+
+	allowance := k.GetMinter(ctx, denom, bz)
+	newAllowance := allowance.Add(msg.Amount)
+	if err := k.SetMinter(ctx, denom, bz, newAllowance); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgIncreaseMinterAllowanceResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.MinterUpdated{
+		Address:           minter,
+		PreviousAllowance: allowance,
+		NewAllowance:      newAllowance,
+		Denom:             denom,
+		Expiry:            k.GetMinterExpiry(ctx, denom, bz),
+	})
+}
+
+func (k msgServer) DecreaseMinterAllowance(ctx context.Context, msg *types.MsgDecreaseMinterAllowance) (*types.MsgDecreaseMinterAllowanceResponse, error) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	bz, minter, err := k.decodeAddress(msg.Minter)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode minter address %s", msg.Minter)
+	}
+
+	if !k.HasMinter(ctx, denom, bz) {
+		return nil, fmt.Errorf("%s is not a minter", minter)
+	}
+
+	if !msg.Amount.IsPositive() {
+		return nil, errors.New("amount must be positive")
+	}
+
+	allowance := k.GetMinter(ctx, denom, bz)
+	if allowance.LT(msg.Amount) {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientAllowance, "minter %s has an allowance of %s", minter, allowance.String())
+	}
+	newAllowance := allowance.Sub(msg.Amount)
+	if err := k.SetMinter(ctx, denom, bz, newAllowance); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDecreaseMinterAllowanceResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.MinterUpdated{
+		Address:           minter,
+		PreviousAllowance: allowance,
+		NewAllowance:      newAllowance,
+		Denom:             denom,
+		Expiry:            k.GetMinterExpiry(ctx, denom, bz),
+	})
+}
+
+func (k msgServer) AddMinterRecipient(ctx context.Context, msg *types.MsgAddMinterRecipient) (*types.MsgAddMinterRecipientResponse, error) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	bz, minter, err := k.decodeAddress(msg.Minter)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode minter address %s", msg.Minter)
+	}
+
+	if !k.HasMinter(ctx, denom, bz) {
+		return nil, fmt.Errorf("%s is not a minter", minter)
+	}
+
+	address, recipient, err := k.decodeAddress(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode recipient address %s", msg.Recipient)
+	}
+
+	if k.HasMinterRecipient(ctx, denom, bz, address) {
+		return nil, fmt.Errorf("%s is already a recipient of minter %s", recipient, minter)
+	}
+
+	if err := k.SetMinterRecipient(ctx, denom, bz, address); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddMinterRecipientResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.MinterRecipientAdded{
+		Minter:    minter,
+		Recipient: recipient,
+		Denom:     denom,
+	})
+}
+
+func (k msgServer) RemoveMinterRecipient(ctx context.Context, msg *types.MsgRemoveMinterRecipient) (*types.MsgRemoveMinterRecipientResponse, error) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	bz, minter, err := k.decodeAddress(msg.Minter)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode minter address %s", msg.Minter)
+	}
+
+	address, recipient, err := k.decodeAddress(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode recipient address %s", msg.Recipient)
+	}
+
+	if !k.HasMinterRecipient(ctx, denom, bz, address) {
+		return nil, fmt.Errorf("%s is not a recipient of minter %s", recipient, minter)
+	}
+
+	if err := k.DeleteMinterRecipient(ctx, denom, bz, address); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveMinterRecipientResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.MinterRecipientRemoved{
+		Minter:    minter,
+		Recipient: recipient,
+		Denom:     denom,
 	})
 }
 
 func (k msgServer) AddPauser(ctx context.Context, msg *types.MsgAddPauser) (*types.MsgAddPauserResponse, error) {
-	owner := k.GetOwner(ctx)
-	if owner == "" {
-		return nil, types.ErrNoOwner
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
 	}
-	if msg.Signer != owner {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidOwner, "expected %s, got %s", owner, msg.Signer)
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
 	}
 
-	if k.HasPauser(ctx, msg.Pauser) {
-		return nil, fmt.Errorf("%s is already a pauser", msg.Pauser)
+	bz, pauser, err := k.decodeAddress(msg.Pauser)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode pauser address %s", msg.Pauser)
 	}
 
-	if err := k.SetPauser(ctx, msg.Pauser); err != nil {
+	if k.HasPauser(ctx, denom, bz) {
+		return nil, fmt.Errorf("%s is already a pauser", pauser)
+	}
+	if k.isBlocked(ctx, denom, bz) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockedAddress, "%s cannot be a pauser", pauser)
+	}
+
+	if err := k.SetPauser(ctx, denom, bz); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgAddPauserResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.PauserAdded{
-		Address: msg.Pauser,
+		Address: pauser,
+		Denom:   denom,
 	})
 }
 
 func (k msgServer) RemovePauser(ctx context.Context, msg *types.MsgRemovePauser) (*types.MsgRemovePauserResponse, error) {
-	owner := k.GetOwner(ctx)
-	if owner == "" {
-		return nil, types.ErrNoOwner
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
 	}
-	if msg.Signer != owner {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidOwner, "expected %s, got %s", owner, msg.Signer)
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
 	}
 
-	if !k.HasPauser(ctx, msg.Pauser) {
-		return nil, fmt.Errorf("%s is not a pauser", msg.Pauser)
+	bz, pauser, err := k.decodeAddress(msg.Pauser)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode pauser address %s", msg.Pauser)
 	}
 
-	if err := k.DeletePauser(ctx, msg.Pauser); err != nil {
+	if !k.HasPauser(ctx, denom, bz) {
+		return nil, fmt.Errorf("%s is not a pauser", pauser)
+	}
+
+	if err := k.DeletePauser(ctx, denom, bz); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgRemovePauserResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.PauserRemoved{
-		Address: msg.Pauser,
+		Address: pauser,
+		Denom:   denom,
 	})
 }
 
 func (k msgServer) AddBlockedChannel(ctx context.Context, msg *types.MsgAddBlockedChannel) (*types.MsgAddBlockedChannelResponse, error) {
-	owner := k.GetOwner(ctx)
-	if owner == "" {
-		return nil, types.ErrNoOwner
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
 	}
-	if msg.Signer != owner {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidOwner, "expected %s, got %s", owner, msg.Signer)
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
 	}
 
-	if k.HasBlockedChannel(ctx, msg.Channel) {
+	if k.HasBlockedChannel(ctx, denom, msg.Channel) {
 		return nil, fmt.Errorf("%s is already blocked", msg.Channel)
 	}
 
-	if err := k.SetBlockedChannel(ctx, msg.Channel); err != nil {
+	if !msg.Direction.IsValid() {
+		return nil, fmt.Errorf("invalid channel direction %d", msg.Direction)
+	}
+	direction := msg.Direction.Normalize()
+
+	if err := k.SetBlockedChannel(ctx, denom, msg.Channel, direction); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgAddBlockedChannelResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.BlockedChannelAdded{
-		Channel: msg.Channel,
+		Channel:   msg.Channel,
+		Direction: direction,
+		Denom:     denom,
 	})
 }
 
 func (k msgServer) RemoveBlockedChannel(ctx context.Context, msg *types.MsgRemoveBlockedChannel) (*types.MsgRemoveBlockedChannelResponse, error) {
-	owner := k.GetOwner(ctx)
-	if owner == "" {
-		return nil, types.ErrNoOwner
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
 	}
-	if msg.Signer != owner {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidOwner, "expected %s, got %s", owner, msg.Signer)
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
 	}
 
-	if !k.HasBlockedChannel(ctx, msg.Channel) {
+	if !k.HasBlockedChannel(ctx, denom, msg.Channel) {
 		return nil, fmt.Errorf("%s is not blocked", msg.Channel)
 	}
 
-	if err := k.DeleteBlockedChannel(ctx, msg.Channel); err != nil {
+	if err := k.DeleteBlockedChannel(ctx, denom, msg.Channel); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgRemoveBlockedChannelResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.BlockedChannelRemoved{
 		Channel: msg.Channel,
+		Denom:   denom,
+	})
+}
+
+func (k msgServer) AddPauseExemption(ctx context.Context, msg *types.MsgAddPauseExemption) (*types.MsgAddPauseExemptionResponse, error) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	address, exempt, err := k.decodeAddress(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode exempt address %s", msg.Address)
+	}
+	if _, found := k.GetPauseExemption(ctx, denom, address); found {
+		return nil, fmt.Errorf("%s is already exempt", exempt)
+	}
+
+	if !msg.Direction.IsValid() {
+		return nil, fmt.Errorf("invalid exemption direction %d", msg.Direction)
+	}
+	direction := msg.Direction.Normalize()
+
+	if err := k.SetPauseExemption(ctx, denom, address, direction); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddPauseExemptionResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.PauseExemptionAdded{
+		Address:   exempt,
+		Direction: direction,
+		Denom:     denom,
+	})
+}
+
+func (k msgServer) RemovePauseExemption(ctx context.Context, msg *types.MsgRemovePauseExemption) (*types.MsgRemovePauseExemptionResponse, error) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	address, exempt, err := k.decodeAddress(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode exempt address %s", msg.Address)
+	}
+	if _, found := k.GetPauseExemption(ctx, denom, address); !found {
+		return nil, fmt.Errorf("%s is not exempt", exempt)
+	}
+
+	if err := k.DeletePauseExemption(ctx, denom, address); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemovePauseExemptionResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.PauseExemptionRemoved{
+		Address: exempt,
+		Denom:   denom,
+	})
+}
+
+func (k msgServer) SetChannelMode(ctx context.Context, msg *types.MsgSetChannelMode) (*types.MsgSetChannelModeResponse, error) {
+	if err := k.checkOwner(ctx, k.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if !msg.Mode.IsValid() {
+		return nil, fmt.Errorf("invalid channel mode %d", msg.Mode)
+	}
+	mode := msg.Mode.Normalize()
+
+	previousMode := k.GetChannelMode(ctx)
+	if err := k.Keeper.SetChannelMode(ctx, mode); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetChannelModeResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.ChannelModeUpdated{
+		PreviousMode: previousMode,
+		NewMode:      mode,
+	})
+}
+
+func (k msgServer) AddAllowedChannel(ctx context.Context, msg *types.MsgAddAllowedChannel) (*types.MsgAddAllowedChannelResponse, error) {
+	if err := k.checkOwner(ctx, k.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if !channeltypes.IsValidChannelID(msg.Channel) {
+		return nil, fmt.Errorf("invalid channel %s", msg.Channel)
+	}
+
+	if k.HasAllowedChannel(ctx, msg.Channel) {
+		return nil, fmt.Errorf("%s is already allowed", msg.Channel)
+	}
+
+	if err := k.SetAllowedChannel(ctx, msg.Channel); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddAllowedChannelResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.AllowedChannelAdded{
+		Channel: msg.Channel,
+	})
+}
+
+func (k msgServer) RemoveAllowedChannel(ctx context.Context, msg *types.MsgRemoveAllowedChannel) (*types.MsgRemoveAllowedChannelResponse, error) {
+	if err := k.checkOwner(ctx, k.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if !k.HasAllowedChannel(ctx, msg.Channel) {
+		return nil, fmt.Errorf("%s is not allowed", msg.Channel)
+	}
+
+	if err := k.DeleteAllowedChannel(ctx, msg.Channel); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAllowedChannelResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.AllowedChannelRemoved{
+		Channel: msg.Channel,
+	})
+}
+
+func (k msgServer) SetRateLimit(ctx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if err := k.checkOwner(ctx, k.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if !channeltypes.IsValidChannelID(msg.Channel) {
+		return nil, fmt.Errorf("invalid channel %s", msg.Channel)
+	}
+
+	rateLimit := types.RateLimit{
+		Channel:       msg.Channel,
+		Window:        msg.Window,
+		MaxAmount:     msg.MaxAmount,
+		MaxPercentage: msg.MaxPercentage,
+	}
+	if rateLimit.MaxAmount.IsNil() {
+		rateLimit.MaxAmount = math.ZeroInt()
+	}
+	if rateLimit.MaxPercentage.IsNil() {
+		rateLimit.MaxPercentage = math.LegacyZeroDec()
+	}
+	if err := rateLimit.Validate(); err != nil {
+		return nil, sdkerrors.Wrapf(err, "invalid rate limit")
+	}
+
+	if err := k.Keeper.SetRateLimit(ctx, rateLimit); err != nil {
+		return nil, err
+	}
+	// NOTE: Updating a rate limit starts a new window.
+	if err := k.DeleteRateLimitFlow(ctx, msg.Channel); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRateLimitResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.RateLimitSet{
+		Channel:       rateLimit.Channel,
+		Window:        rateLimit.Window,
+		MaxAmount:     rateLimit.MaxAmount,
+		MaxPercentage: rateLimit.MaxPercentage,
+	})
+}
+
+func (k msgServer) RemoveRateLimit(ctx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if err := k.checkOwner(ctx, k.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if !k.HasRateLimit(ctx, msg.Channel) {
+		return nil, fmt.Errorf("%s is not rate limited", msg.Channel)
+	}
+
+	if err := k.DeleteRateLimit(ctx, msg.Channel); err != nil {
+		return nil, err
+	}
+	if err := k.DeleteRateLimitFlow(ctx, msg.Channel); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveRateLimitResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.RateLimitRemoved{
+		Channel: msg.Channel,
+	})
+}
+
+func (k msgServer) SetBlocklistBinding(ctx context.Context, msg *types.MsgSetBlocklistBinding) (*types.MsgSetBlocklistBindingResponse, error) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if denom == k.Denom {
+		return nil, fmt.Errorf("%s is always bound to the blocklist", denom)
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetBlocklistBinding(ctx, denom, msg.Bound); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBlocklistBindingResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.BlocklistBindingUpdated{
+		Denom: denom,
+		Bound: msg.Bound,
+	})
+}
+
+func (k msgServer) SetDenomMetadata(ctx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	denom, err := k.resolveDenom(msg.Metadata.Base)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetDenomMetadata(ctx, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomMetadataResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.DenomMetadataUpdated{
+		Metadata: msg.Metadata,
 	})
 }
@@ -18,39 +18,42 @@ func NewBlocklistMsgServer(keeper *Keeper) blocklist.MsgServer {
 }
 
 func (k blocklistMsgServer) TransferOwnership(ctx context.Context, msg *blocklist.MsgTransferOwnership) (*blocklist.MsgTransferOwnershipResponse, error) {
-	owner := k.GetBlocklistOwner(ctx)
-	if owner == "" {
-		return nil, blocklist.ErrNoOwner
+	if err := k.checkBlocklistOwner(ctx, msg.Signer); err != nil {
+		return nil, err
 	}
-	if msg.Signer != owner {
-		return nil, errors.Wrapf(blocklist.ErrInvalidOwner, "expected %s, got %s", owner, msg.Signer)
+
+	_, newOwner, err := k.decodeAddress(msg.NewOwner)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode owner address %s", msg.NewOwner)
 	}
 
-	if msg.NewOwner == owner {
+	owner := k.GetBlocklistOwner(ctx)
+	if newOwner == owner {
 		return nil, blocklist.ErrSameOwner
 	}
 
-	if err := k.SetBlocklistPendingOwner(ctx, msg.NewOwner); err != nil {
+	if err := k.SetBlocklistPendingOwner(ctx, newOwner); err != nil {
 		return nil, err
 	}
 
 	return &blocklist.MsgTransferOwnershipResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &blocklist.OwnershipTransferStarted{
 		PreviousOwner: owner,
-		NewOwner:      msg.NewOwner,
+		NewOwner:      newOwner,
 	})
 }
 
 func (k blocklistMsgServer) AcceptOwnership(ctx context.Context, msg *blocklist.MsgAcceptOwnership) (*blocklist.MsgAcceptOwnershipResponse, error) {
+	_, signer, _ := k.decodeAddress(msg.Signer)
 	pendingOwner := k.GetBlocklistPendingOwner(ctx)
 	if pendingOwner == "" {
 		return nil, blocklist.ErrNoPendingOwner
 	}
-	if msg.Signer != pendingOwner {
+	if signer != pendingOwner {
 		return nil, errors.Wrapf(blocklist.ErrInvalidPendingOwner, "expected %s, got %s", pendingOwner, msg.Signer)
 	}
 
 	owner := k.GetBlocklistOwner(ctx)
-	if err := k.SetBlocklistOwner(ctx, msg.Signer); err != nil {
+	if err := k.SetBlocklistOwner(ctx, signer); err != nil {
 		return nil, err
 	}
 	if err := k.DeleteBlocklistPendingOwner(ctx); err != nil {
@@ -59,17 +62,13 @@ func (k blocklistMsgServer) AcceptOwnership(ctx context.Context, msg *blocklist.
 
 	return &blocklist.MsgAcceptOwnershipResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &blocklist.OwnershipTransferred{
 		PreviousOwner: owner,
-		NewOwner:      msg.Signer,
+		NewOwner:      signer,
 	})
 }
 
 func (k blocklistMsgServer) AddToBlocklist(ctx context.Context, msg *blocklist.MsgAddToBlocklist) (*blocklist.MsgAddToBlocklistResponse, error) {
-	owner := k.GetBlocklistOwner(ctx)
-	if owner == "" {
-		return nil, blocklist.ErrNoOwner
-	}
-	if msg.Signer != owner {
-		return nil, errors.Wrapf(blocklist.ErrInvalidOwner, "expected %s, got %s", owner, msg.Signer)
+	if err := k.checkBlocklistOwner(ctx, msg.Signer); err != nil {
+		return nil, err
 	}
 
 	for _, account := range msg.Accounts {
@@ -81,20 +80,22 @@ func (k blocklistMsgServer) AddToBlocklist(ctx context.Context, msg *blocklist.M
 		if err := k.SetBlockedAddress(ctx, address); err != nil {
 			return nil, err
 		}
+		if msg.SuspendRoles {
+			if err := k.SetSuspendedAddress(ctx, address); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	return &blocklist.MsgAddToBlocklistResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &blocklist.BlockedAddressesAdded{
-		Accounts: msg.Accounts,
+		Accounts:       msg.Accounts,
+		RolesSuspended: msg.SuspendRoles,
 	})
 }
 
 func (k blocklistMsgServer) RemoveFromBlocklist(ctx context.Context, msg *blocklist.MsgRemoveFromBlocklist) (*blocklist.MsgRemoveFromBlocklistResponse, error) {
-	owner := k.GetBlocklistOwner(ctx)
-	if owner == "" {
-		return nil, blocklist.ErrNoOwner
-	}
-	if msg.Signer != owner {
-		return nil, errors.Wrapf(blocklist.ErrInvalidOwner, "expected %s, got %s", owner, msg.Signer)
+	if err := k.checkBlocklistOwner(ctx, msg.Signer); err != nil {
+		return nil, err
 	}
 
 	for _, account := range msg.Accounts {
@@ -106,6 +107,9 @@ func (k blocklistMsgServer) RemoveFromBlocklist(ctx context.Context, msg *blockl
 		if err := k.DeleteBlockedAddress(ctx, address); err != nil {
 			return nil, err
 		}
+		if err := k.DeleteSuspendedAddress(ctx, address); err != nil {
+			return nil, err
+		}
 	}
 
 	return &blocklist.MsgRemoveFromBlocklistResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &blocklist.BlockedAddressesRemoved{
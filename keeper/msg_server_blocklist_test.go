@@ -186,6 +186,98 @@ func TestAddToBlocklist(t *testing.T) {
 	require.True(t, k.HasBlockedAddress(ctx, user.Bytes))
 }
 
+func TestBlocklistKeepsRoles(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewBlocklistMsgServer(k)
+	msgServer := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set blocklist owner in state, and give a user roles on both the
+	// default denom and a denom that isn't subject to the blocklist.
+	owner, user := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetBlocklistOwner(ctx, owner.Address))
+	require.NoError(t, k.SetBurner(ctx, k.Denom, user.Bytes, ONE))
+	require.NoError(t, k.SetMinter(ctx, k.Denom, user.Bytes, ONE))
+	require.NoError(t, k.SetPauser(ctx, k.Denom, user.Bytes))
+	require.NoError(t, k.SetPauser(ctx, TBILL, user.Bytes))
+
+	// ACT: Attempt to add the user to the blocklist.
+	_, err := server.AddToBlocklist(ctx, &blocklist.MsgAddToBlocklist{
+		Signer:   owner.Address,
+		Accounts: []string{user.Address},
+	})
+	// ASSERT: The action should've succeeded, and kept the roles of the user.
+	require.NoError(t, err)
+	require.Equal(t, ONE, k.GetBurner(ctx, k.Denom, user.Bytes))
+	require.Equal(t, ONE, k.GetMinter(ctx, k.Denom, user.Bytes))
+	require.True(t, k.HasPauser(ctx, k.Denom, user.Bytes))
+
+	// ACT: Attempt to pause the default denom as the blocked pauser.
+	_, err = msgServer.Pause(ctx, &types.MsgPause{Signer: user.Address})
+	// ASSERT: The action should've failed due to blocked pauser.
+	require.ErrorIs(t, err, types.ErrBlockedAddress)
+
+	// ACT: Attempt to pause a denom that isn't subject to the blocklist.
+	_, err = msgServer.Pause(ctx, &types.MsgPause{Signer: user.Address, Denom: TBILL})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ACT: Attempt to remove the user from the blocklist.
+	_, err = server.RemoveFromBlocklist(ctx, &blocklist.MsgRemoveFromBlocklist{
+		Signer:   owner.Address,
+		Accounts: []string{user.Address},
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ACT: Attempt to pause the default denom as the unblocked pauser.
+	_, err = msgServer.Pause(ctx, &types.MsgPause{Signer: user.Address})
+	// ASSERT: The action should've succeeded, as the role was kept.
+	require.NoError(t, err)
+}
+
+func TestBlocklistSuspendsRoles(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewBlocklistMsgServer(k)
+	msgServer := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set blocklist owner in state, and give a user the pauser role
+	// on a denom that isn't subject to the blocklist.
+	owner, user := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetBlocklistOwner(ctx, owner.Address))
+	require.NoError(t, k.SetPauser(ctx, TBILL, user.Bytes))
+
+	// ACT: Attempt to add the user to the blocklist, suspending their roles.
+	_, err := server.AddToBlocklist(ctx, &blocklist.MsgAddToBlocklist{
+		Signer:       owner.Address,
+		Accounts:     []string{user.Address},
+		SuspendRoles: true,
+	})
+	// ASSERT: The action should've succeeded, and suspended the roles of the user.
+	require.NoError(t, err)
+	require.True(t, k.HasBlockedAddress(ctx, user.Bytes))
+	require.True(t, k.HasSuspendedAddress(ctx, user.Bytes))
+	require.True(t, k.HasPauser(ctx, TBILL, user.Bytes))
+
+	// ACT: Attempt to pause a denom that isn't subject to the blocklist.
+	_, err = msgServer.Pause(ctx, &types.MsgPause{Signer: user.Address, Denom: TBILL})
+	// ASSERT: The action should've failed due to suspended pauser.
+	require.ErrorIs(t, err, types.ErrBlockedAddress)
+
+	// ACT: Attempt to remove the user from the blocklist.
+	_, err = server.RemoveFromBlocklist(ctx, &blocklist.MsgRemoveFromBlocklist{
+		Signer:   owner.Address,
+		Accounts: []string{user.Address},
+	})
+	// ASSERT: The action should've succeeded, and lifted the suspension.
+	require.NoError(t, err)
+	require.False(t, k.HasSuspendedAddress(ctx, user.Bytes))
+
+	// ACT: Attempt to pause a denom that isn't subject to the blocklist.
+	_, err = msgServer.Pause(ctx, &types.MsgPause{Signer: user.Address, Denom: TBILL})
+	// ASSERT: The action should've succeeded, as the role was kept.
+	require.NoError(t, err)
+}
+
 func TestRemoveFromBlocklist(t *testing.T) {
 	k, ctx := mocks.AuraKeeper()
 	server := keeper.NewBlocklistMsgServer(k)
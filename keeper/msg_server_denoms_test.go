@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+const TBILL = "atbill"
+
+func TestMultiDenom(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	server := keeper.NewMsgServer(k)
+	bank = bank.WithSendCoinsRestriction(k.SendRestrictionFn)
+
+	// ARRANGE: Set a minter and pauser of $TBILL, and a minter of $USDY in state.
+	minter, pauser, usdyMinter, alice, bob := utils.TestAccount(), utils.TestAccount(), utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetMinter(ctx, TBILL, minter.Bytes, ONE.MulRaw(10)))
+	require.NoError(t, k.SetPauser(ctx, TBILL, pauser.Bytes))
+	require.NoError(t, k.SetMinter(ctx, k.Denom, usdyMinter.Bytes, ONE.MulRaw(10)))
+
+	// ACT: Attempt to mint an ungoverned denom.
+	_, err := server.Mint(ctx, &types.MsgMint{
+		Signer: minter.Address,
+		To:     alice.Address,
+		Amount: ONE,
+		Denom:  "uusdc",
+	})
+	// ASSERT: The action should've failed due to ungoverned denom.
+	require.ErrorContains(t, err, "uusdc is not governed by this module")
+
+	// ACT: Attempt to mint $TBILL with a minter of $USDY.
+	_, err = server.Mint(ctx, &types.MsgMint{
+		Signer: usdyMinter.Address,
+		To:     alice.Address,
+		Amount: ONE,
+		Denom:  TBILL,
+	})
+	// ASSERT: The action should've failed due to invalid minter.
+	require.ErrorIs(t, err, types.ErrInvalidMinter)
+
+	// ACT: Attempt to mint $TBILL.
+	_, err = server.Mint(ctx, &types.MsgMint{
+		Signer: minter.Address,
+		To:     alice.Address,
+		Amount: ONE.MulRaw(2),
+		Denom:  TBILL,
+	})
+	// ASSERT: The action should've succeeded, and only reduced the $TBILL allowance.
+	require.NoError(t, err)
+	require.Equal(t, ONE.MulRaw(2), bank.Balances[alice.Address].AmountOf(TBILL))
+	require.Equal(t, ONE.MulRaw(8), k.GetMinter(ctx, TBILL, minter.Bytes))
+	require.Equal(t, ONE.MulRaw(10), k.GetMinter(ctx, k.Denom, usdyMinter.Bytes))
+
+	// ARRANGE: Give alice some $USDY.
+	bank.Balances[alice.Address] = bank.Balances[alice.Address].Add(sdk.NewCoin(k.Denom, ONE.MulRaw(2)))
+
+	// ACT: Attempt to pause $TBILL.
+	_, err = server.Pause(ctx, &types.MsgPause{
+		Signer: pauser.Address,
+		Denom:  TBILL,
+	})
+	// ASSERT: The action should've succeeded, and only paused $TBILL.
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultPauseScopes, k.GetPausedScopes(ctx, TBILL))
+	require.Empty(t, k.GetPausedScopes(ctx, k.Denom))
+
+	// ACT: Attempt to transfer $TBILL.
+	err = bank.SendCoins(ctx, alice.Bytes, bob.Bytes, sdk.NewCoins(sdk.NewCoin(TBILL, ONE)))
+	// ASSERT: The action should've failed due to paused $TBILL.
+	require.ErrorContains(t, err, "atbill transfers are paused")
+
+	// ACT: Attempt to transfer $USDY.
+	err = bank.SendCoins(ctx, alice.Bytes, bob.Bytes, sdk.NewCoins(sdk.NewCoin(k.Denom, ONE)))
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ACT: Attempt to pause $USDY with a pauser of $TBILL.
+	_, err = server.Pause(ctx, &types.MsgPause{
+		Signer: pauser.Address,
+	})
+	// ASSERT: The action should've failed due to invalid pauser.
+	require.ErrorIs(t, err, types.ErrInvalidPauser)
+}
+
+func TestSetBlocklistBinding(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	server := keeper.NewMsgServer(k)
+	bank = bank.WithSendCoinsRestriction(k.SendRestrictionFn)
+
+	// ACT: Attempt to set blocklist binding with no owner set.
+	_, err := server.SetBlocklistBinding(ctx, &types.MsgSetBlocklistBinding{Denom: TBILL})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorIs(t, err, types.ErrNoOwner)
+
+	// ARRANGE: Set owners of $USDY and $TBILL in state.
+	owner, tbillOwner := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+	require.NoError(t, k.SetOwner(ctx, TBILL, tbillOwner.Address))
+
+	// ACT: Attempt to set blocklist binding with the owner of $USDY.
+	_, err = server.SetBlocklistBinding(ctx, &types.MsgSetBlocklistBinding{
+		Signer: owner.Address,
+		Denom:  TBILL,
+		Bound:  true,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ACT: Attempt to unbind $USDY from the blocklist.
+	_, err = server.SetBlocklistBinding(ctx, &types.MsgSetBlocklistBinding{
+		Signer: owner.Address,
+		Denom:  k.Denom,
+	})
+	// ASSERT: The action should've failed due to default denom.
+	require.ErrorContains(t, err, "is always bound to the blocklist")
+
+	// ARRANGE: Block alice, and give her some $TBILL.
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetBlockedAddress(ctx, alice.Bytes))
+	bank.Balances[alice.Address] = sdk.NewCoins(sdk.NewCoin(TBILL, ONE.MulRaw(2)))
+
+	// ACT: Attempt to transfer unbound $TBILL from a blocked address.
+	err = bank.SendCoins(ctx, alice.Bytes, bob.Bytes, sdk.NewCoins(sdk.NewCoin(TBILL, ONE)))
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ACT: Attempt to bind $TBILL to the blocklist.
+	_, err = server.SetBlocklistBinding(ctx, &types.MsgSetBlocklistBinding{
+		Signer: tbillOwner.Address,
+		Denom:  TBILL,
+		Bound:  true,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.True(t, k.IsBlocklistBound(ctx, TBILL))
+
+	// ACT: Attempt to transfer bound $TBILL from a blocked address.
+	err = bank.SendCoins(ctx, alice.Bytes, bob.Bytes, sdk.NewCoins(sdk.NewCoin(TBILL, ONE)))
+	// ASSERT: The action should've failed due to blocked sender.
+	require.ErrorContains(t, err, "is blocked from sending atbill")
+}
+
+func TestSetDenomMetadata(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+	metadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "ausdy", Exponent: 0},
+			{Denom: "usdy", Exponent: 18},
+		},
+		Base:    "ausdy",
+		Display: "usdy",
+		Name:    "Ondo US Dollar Yield",
+		Symbol:  "USDY",
+	}
+
+	// ACT: Attempt to set denom metadata with no owner set.
+	_, err := server.SetDenomMetadata(ctx, &types.MsgSetDenomMetadata{Metadata: metadata})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorIs(t, err, types.ErrNoOwner)
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to set denom metadata with invalid signer.
+	_, err = server.SetDenomMetadata(ctx, &types.MsgSetDenomMetadata{
+		Signer:   utils.TestAccount().Address,
+		Metadata: metadata,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ACT: Attempt to set denom metadata of an ungoverned denom.
+	_, err = server.SetDenomMetadata(ctx, &types.MsgSetDenomMetadata{
+		Signer:   owner.Address,
+		Metadata: banktypes.Metadata{Base: "uusdc"},
+	})
+	// ASSERT: The action should've failed due to ungoverned denom.
+	require.ErrorContains(t, err, "uusdc is not governed by this module")
+
+	// ACT: Attempt to set invalid denom metadata.
+	_, err = server.SetDenomMetadata(ctx, &types.MsgSetDenomMetadata{
+		Signer:   owner.Address,
+		Metadata: banktypes.Metadata{Base: "ausdy"},
+	})
+	// ASSERT: The action should've failed due to invalid metadata.
+	require.ErrorContains(t, err, "invalid metadata")
+
+	// ACT: Attempt to set denom metadata.
+	_, err = server.SetDenomMetadata(ctx, &types.MsgSetDenomMetadata{
+		Signer:   owner.Address,
+		Metadata: metadata,
+	})
+	// ASSERT: The action should've succeeded, and registered the metadata.
+	require.NoError(t, err)
+	res, found := k.GetDenomMetadata(ctx, k.Denom)
+	require.True(t, found)
+	require.Equal(t, metadata, res)
+}
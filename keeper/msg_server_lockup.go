@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k msgServer) SetLockupPeriod(ctx context.Context, msg *types.MsgSetLockupPeriod) (*types.MsgSetLockupPeriodResponse, error) {
+	if err := k.checkOwner(ctx, k.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if msg.LockupPeriod < 0 {
+		return nil, errors.New("lockup period can't be negative")
+	}
+
+	if err := k.Keeper.SetLockupPeriod(ctx, msg.LockupPeriod); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetLockupPeriodResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.LockupPeriodUpdated{
+		LockupPeriod: msg.LockupPeriod,
+	})
+}
@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetLockupPeriod(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to set lockup period with no owner set.
+	_, err := server.SetLockupPeriod(ctx, &types.MsgSetLockupPeriod{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to set lockup period with invalid signer.
+	_, err = server.SetLockupPeriod(ctx, &types.MsgSetLockupPeriod{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ACT: Attempt to set negative lockup period.
+	_, err = server.SetLockupPeriod(ctx, &types.MsgSetLockupPeriod{
+		Signer:       owner.Address,
+		LockupPeriod: -time.Hour,
+	})
+	// ASSERT: The action should've failed due to negative lockup period.
+	require.ErrorContains(t, err, "lockup period can't be negative")
+
+	// ACT: Attempt to set lockup period.
+	_, err = server.SetLockupPeriod(ctx, &types.MsgSetLockupPeriod{
+		Signer:       owner.Address,
+		LockupPeriod: 40 * types.Day,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, 40*types.Day, k.GetLockupPeriod(ctx))
+}
+
+func TestLockup(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	server := keeper.NewMsgServer(k)
+	bank = bank.WithSendCoinsRestriction(k.SendRestrictionFn)
+
+	// ARRANGE: Set a lockup period of 40 days, and a minter in state.
+	require.NoError(t, k.SetLockupPeriod(ctx, 40*types.Day))
+	minter, alice, bob := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetMinter(ctx, k.Denom, minter.Bytes, ONE.MulRaw(10)))
+
+	// ARRANGE: Give alice 1 unlocked $USDY.
+	bank.Balances[alice.Address] = sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+
+	// ACT: Mint 2 $USDY to alice.
+	_, err := server.Mint(ctx, &types.MsgMint{
+		Signer: minter.Address,
+		To:     alice.Address,
+		Amount: ONE.MulRaw(2),
+	})
+	// ASSERT: The action should've succeeded, and locked the minted $USDY.
+	require.NoError(t, err)
+	require.Equal(t, ONE.MulRaw(2), k.GetLockedLot(ctx, alice.Bytes, ctx.BlockTime().Add(40*types.Day)))
+
+	// ACT: Attempt to transfer locked $USDY.
+	err = bank.SendCoins(ctx, alice.Bytes, bob.Bytes, sdk.NewCoins(sdk.NewCoin(k.Denom, ONE.MulRaw(2))))
+	// ASSERT: The action should've failed due to locked $USDY.
+	require.ErrorContains(t, err, "locked")
+
+	// ACT: Attempt to wrap locked $USDY.
+	_, err = server.Wrap(ctx, &types.MsgWrap{
+		Signer: alice.Address,
+		Amount: ONE.MulRaw(2),
+	})
+	// ASSERT: The action should've failed due to locked $USDY.
+	require.ErrorContains(t, err, "locked")
+
+	// ACT: Attempt to transfer unlocked $USDY.
+	err = bank.SendCoins(ctx, alice.Bytes, bob.Bytes, sdk.NewCoins(sdk.NewCoin(k.Denom, ONE)))
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, ONE, bank.Balances[bob.Address].AmountOf(k.Denom))
+
+	// ARRANGE: Advance past the lockup period.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(40 * types.Day))
+
+	// ACT: Attempt to transfer previously locked $USDY.
+	err = bank.SendCoins(ctx, alice.Bytes, bob.Bytes, sdk.NewCoins(sdk.NewCoin(k.Denom, ONE.MulRaw(2))))
+	// ASSERT: The action should've succeeded, and pruned the unlocked lot.
+	require.NoError(t, err)
+	require.Equal(t, ONE.MulRaw(3), bank.Balances[bob.Address].AmountOf(k.Denom))
+	require.Empty(t, k.GetLockedLotsByAddress(ctx, alice.Bytes))
+}
+
+func TestLockupConsumption(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	server := keeper.NewMsgServer(k)
+	bank = bank.WithSendCoinsRestriction(k.SendRestrictionFn)
+	k.SetBankKeeper(bank)
+
+	// ARRANGE: Set a lockup period of 40 days, and an owner, burner, and minter in state.
+	require.NoError(t, k.SetLockupPeriod(ctx, 40*types.Day))
+	owner, burner, minter := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+	require.NoError(t, k.SetBurner(ctx, k.Denom, burner.Bytes, ONE.MulRaw(10)))
+	require.NoError(t, k.SetMinter(ctx, k.Denom, minter.Bytes, ONE.MulRaw(10)))
+	alice, recovery := utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Mint 2 $USDY to alice, and 2 $USDY a day later.
+	first, second := ctx.BlockTime().Add(40*types.Day), ctx.BlockTime().Add(41*types.Day)
+	_, err := server.Mint(ctx, &types.MsgMint{Signer: minter.Address, To: alice.Address, Amount: ONE.MulRaw(2)})
+	require.NoError(t, err)
+	_, err = server.Mint(ctx.WithBlockTime(ctx.BlockTime().Add(types.Day)), &types.MsgMint{Signer: minter.Address, To: alice.Address, Amount: ONE.MulRaw(2)})
+	require.NoError(t, err)
+
+	// ACT: Attempt to burn 1 $USDY from alice.
+	_, err = server.Burn(ctx, &types.MsgBurn{Signer: burner.Address, From: alice.Address, Amount: ONE})
+	// ASSERT: The action should've succeeded, and consumed the newest lot first.
+	require.NoError(t, err)
+	require.Equal(t, ONE.MulRaw(2), k.GetLockedLot(ctx, alice.Bytes, first))
+	require.Equal(t, ONE, k.GetLockedLot(ctx, alice.Bytes, second))
+
+	// ARRANGE: Add alice to blocklist.
+	require.NoError(t, k.SetBlockedAddress(ctx, alice.Bytes))
+
+	// ACT: Attempt to force transfer 2 $USDY from alice.
+	_, err = server.ForceTransfer(ctx, &types.MsgForceTransfer{
+		Signer:        owner.Address,
+		From:          alice.Address,
+		To:            recovery.Address,
+		Amount:        ONE.MulRaw(2),
+		CaseReference: "case-1",
+	})
+	// ASSERT: The action should've succeeded, consumed the newest lot, and
+	// reduced the oldest lot to the remaining balance.
+	require.NoError(t, err)
+	require.Equal(t, []types.LockedLot{{Address: alice.Address, Amount: ONE, UnlockTime: first}}, k.GetLockedLotsByAddress(ctx, alice.Bytes))
+	require.Equal(t, ONE, bank.Balances[alice.Address].AmountOf(k.Denom))
+	require.Equal(t, ONE.MulRaw(2), bank.Balances[recovery.Address].AmountOf(k.Denom))
+	require.Empty(t, k.GetLockedLotsByAddress(ctx, recovery.Bytes))
+}
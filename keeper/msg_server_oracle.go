@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k msgServer) AddPriceSetter(ctx context.Context, msg *types.MsgAddPriceSetter) (*types.MsgAddPriceSetterResponse, error) {
+	if err := k.checkOwner(ctx, k.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	bz, priceSetter, err := k.decodeAddress(msg.PriceSetter)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode price setter address %s", msg.PriceSetter)
+	}
+
+	if k.HasPriceSetter(ctx, bz) {
+		return nil, fmt.Errorf("%s is already a price setter", priceSetter)
+	}
+
+	if err := k.SetPriceSetter(ctx, bz); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddPriceSetterResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.PriceSetterAdded{
+		Address: priceSetter,
+	})
+}
+
+func (k msgServer) RemovePriceSetter(ctx context.Context, msg *types.MsgRemovePriceSetter) (*types.MsgRemovePriceSetterResponse, error) {
+	if err := k.checkOwner(ctx, k.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	bz, priceSetter, err := k.decodeAddress(msg.PriceSetter)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode price setter address %s", msg.PriceSetter)
+	}
+
+	if !k.HasPriceSetter(ctx, bz) {
+		return nil, fmt.Errorf("%s is not a price setter", priceSetter)
+	}
+
+	if err := k.DeletePriceSetter(ctx, bz); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemovePriceSetterResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.PriceSetterRemoved{
+		Address: priceSetter,
+	})
+}
+
+func (k msgServer) SetPriceRange(ctx context.Context, msg *types.MsgSetPriceRange) (*types.MsgSetPriceRangeResponse, error) {
+	signer, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode signer address %s", msg.Signer)
+	}
+	if !k.HasPriceSetter(ctx, signer) {
+		return nil, types.ErrInvalidPriceSetter
+	}
+
+	if msg.DailyRate.IsNil() || !msg.DailyRate.IsPositive() {
+		return nil, errors.New("daily rate must be positive")
+	}
+
+	maxPriceDeviation := k.GetMaxPriceDeviation(ctx)
+	if deviation := msg.DailyRate.Sub(math.LegacyOneDec()).Abs(); deviation.GT(maxPriceDeviation) {
+		return nil, fmt.Errorf("daily rate deviates %s, exceeding maximum of %s", deviation, maxPriceDeviation)
+	}
+
+	priceRange := types.PriceRange{
+		Start:      sdk.UnwrapSDKContext(ctx).BlockTime(),
+		End:        msg.End,
+		DailyRate:  msg.DailyRate,
+		StartPrice: msg.StartPrice,
+	}
+
+	// NOTE: Ranges are contiguous, a new range starts at the end of the latest
+	// range, and by default continues from its close price.
+	latest, found := k.GetLatestPriceRange(ctx)
+	if found {
+		priceRange.Index = latest.Index + 1
+		priceRange.Start = latest.End
+
+		closePrice := latest.ClosePrice()
+		if priceRange.StartPrice.IsNil() || priceRange.StartPrice.IsZero() {
+			priceRange.StartPrice = closePrice
+		} else if deviation := types.Deviation(priceRange.StartPrice, closePrice); deviation.GT(maxPriceDeviation) {
+			return nil, fmt.Errorf("start price deviates %s from close price of previous range, exceeding maximum of %s", deviation, maxPriceDeviation)
+		}
+	}
+
+	if priceRange.StartPrice.IsNil() || !priceRange.StartPrice.IsPositive() {
+		return nil, errors.New("start price must be positive")
+	}
+
+	if !priceRange.End.After(priceRange.Start) {
+		return nil, fmt.Errorf("end must be after start of range (%s)", priceRange.Start)
+	}
+
+	if err := k.Keeper.SetPriceRange(ctx, priceRange); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPriceRangeResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.PriceRangeSet{
+		Index:      priceRange.Index,
+		Start:      priceRange.Start,
+		End:        priceRange.End,
+		DailyRate:  priceRange.DailyRate,
+		StartPrice: priceRange.StartPrice,
+	})
+}
+
+func (k msgServer) SetMaxPriceDeviation(ctx context.Context, msg *types.MsgSetMaxPriceDeviation) (*types.MsgSetMaxPriceDeviationResponse, error) {
+	if err := k.checkOwner(ctx, k.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if msg.MaxPriceDeviation.IsNil() || !msg.MaxPriceDeviation.IsPositive() || msg.MaxPriceDeviation.GT(math.LegacyOneDec()) {
+		return nil, errors.New("max price deviation must be between 0 and 1")
+	}
+
+	previousMaxPriceDeviation := k.GetMaxPriceDeviation(ctx)
+	if err := k.Keeper.SetMaxPriceDeviation(ctx, msg.MaxPriceDeviation); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMaxPriceDeviationResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.MaxPriceDeviationUpdated{
+		PreviousMaxPriceDeviation: previousMaxPriceDeviation,
+		NewMaxPriceDeviation:      msg.MaxPriceDeviation,
+	})
+}
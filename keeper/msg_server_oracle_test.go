@@ -0,0 +1,296 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddPriceSetter(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to add price setter with no owner set.
+	_, err := server.AddPriceSetter(ctx, &types.MsgAddPriceSetter{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to add price setter with invalid signer.
+	_, err = server.AddPriceSetter(ctx, &types.MsgAddPriceSetter{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate two price setter accounts, add one to state.
+	priceSetter1, priceSetter2 := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetPriceSetter(ctx, priceSetter2.Bytes))
+
+	// ACT: Attempt to add price setter that already exists.
+	_, err = server.AddPriceSetter(ctx, &types.MsgAddPriceSetter{
+		Signer:      owner.Address,
+		PriceSetter: priceSetter2.Address,
+	})
+	// ASSERT: The action should've failed due to existing price setter.
+	require.ErrorContains(t, err, "is already a price setter")
+
+	// ACT: Attempt to add price setter with invalid address.
+	_, err = server.AddPriceSetter(ctx, &types.MsgAddPriceSetter{
+		Signer:      owner.Address,
+		PriceSetter: priceSetter1.Invalid,
+	})
+	// ASSERT: The action should've failed due to invalid address.
+	require.ErrorContains(t, err, "unable to decode price setter address")
+
+	// ARRANGE: Set up a failing collection store for the attribute setter.
+	tmp := k.PriceSetters
+	k.PriceSetters = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.PriceSetterPrefix, "price_setters", collections.BytesKey, collections.BytesValue,
+	)
+
+	// ACT: Attempt to add price setter with failing PriceSetters collection store.
+	_, err = server.AddPriceSetter(ctx, &types.MsgAddPriceSetter{
+		Signer:      owner.Address,
+		PriceSetter: priceSetter1.Address,
+	})
+	// ASSERT: The action should've failed due to collection store setter error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.PriceSetters = tmp
+
+	// ACT: Attempt to add price setter.
+	_, err = server.AddPriceSetter(ctx, &types.MsgAddPriceSetter{
+		Signer:      owner.Address,
+		PriceSetter: priceSetter1.Address,
+	})
+	// ASSERT: The action should've succeeded, and set price setter in state.
+	require.NoError(t, err)
+	require.True(t, k.HasPriceSetter(ctx, priceSetter1.Bytes))
+}
+
+func TestRemovePriceSetter(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to remove price setter with no owner set.
+	_, err := server.RemovePriceSetter(ctx, &types.MsgRemovePriceSetter{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to remove price setter with invalid signer.
+	_, err = server.RemovePriceSetter(ctx, &types.MsgRemovePriceSetter{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate a price setter account.
+	priceSetter := utils.TestAccount()
+
+	// ACT: Attempt to remove price setter that does not exist.
+	_, err = server.RemovePriceSetter(ctx, &types.MsgRemovePriceSetter{
+		Signer:      owner.Address,
+		PriceSetter: priceSetter.Address,
+	})
+	// ASSERT: The action should've failed due to non-existing price setter.
+	require.ErrorContains(t, err, "is not a price setter")
+
+	// ARRANGE: Set price setter in state.
+	require.NoError(t, k.SetPriceSetter(ctx, priceSetter.Bytes))
+
+	// ARRANGE: Set up a failing collection store for the attribute delete.
+	tmp := k.PriceSetters
+	k.PriceSetters = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Delete, utils.GetKVStore(ctx, types.ModuleName))),
+		types.PriceSetterPrefix, "price_setters", collections.BytesKey, collections.BytesValue,
+	)
+
+	// ACT: Attempt to remove price setter with failing PriceSetters collection store.
+	_, err = server.RemovePriceSetter(ctx, &types.MsgRemovePriceSetter{
+		Signer:      owner.Address,
+		PriceSetter: priceSetter.Address,
+	})
+	// ASSERT: The action should've failed due to collection store delete error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.PriceSetters = tmp
+
+	// ACT: Attempt to remove price setter.
+	_, err = server.RemovePriceSetter(ctx, &types.MsgRemovePriceSetter{
+		Signer:      owner.Address,
+		PriceSetter: priceSetter.Address,
+	})
+	// ASSERT: The action should've succeeded, and removed price setter from state.
+	require.NoError(t, err)
+	require.False(t, k.HasPriceSetter(ctx, priceSetter.Bytes))
+}
+
+func TestSetPriceRange(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx = ctx.WithBlockTime(now)
+	rate := math.LegacyMustNewDecFromStr("1.0001")
+
+	// ACT: Attempt to set price range with invalid signer.
+	_, err := server.SetPriceRange(ctx, &types.MsgSetPriceRange{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidPriceSetter)
+
+	// ARRANGE: Set price setter in state.
+	priceSetter := utils.TestAccount()
+	require.NoError(t, k.SetPriceSetter(ctx, priceSetter.Bytes))
+
+	// ACT: Attempt to set price range with no daily rate.
+	_, err = server.SetPriceRange(ctx, &types.MsgSetPriceRange{
+		Signer: priceSetter.Address,
+	})
+	// ASSERT: The action should've failed due to invalid daily rate.
+	require.ErrorContains(t, err, "daily rate must be positive")
+
+	// ACT: Attempt to set price range with a daily rate exceeding the deviation.
+	_, err = server.SetPriceRange(ctx, &types.MsgSetPriceRange{
+		Signer:    priceSetter.Address,
+		DailyRate: math.LegacyMustNewDecFromStr("1.02"),
+	})
+	// ASSERT: The action should've failed due to large daily rate.
+	require.ErrorContains(t, err, "daily rate deviates 0.020000000000000000, exceeding maximum of 0.010000000000000000")
+
+	// ACT: Attempt to set first price range without a start price.
+	_, err = server.SetPriceRange(ctx, &types.MsgSetPriceRange{
+		Signer:    priceSetter.Address,
+		End:       now.Add(30 * types.Day),
+		DailyRate: rate,
+	})
+	// ASSERT: The action should've failed due to missing start price.
+	require.ErrorContains(t, err, "start price must be positive")
+
+	// ACT: Attempt to set first price range ending in the past.
+	_, err = server.SetPriceRange(ctx, &types.MsgSetPriceRange{
+		Signer:     priceSetter.Address,
+		End:        now.Add(-time.Hour),
+		DailyRate:  rate,
+		StartPrice: math.LegacyOneDec(),
+	})
+	// ASSERT: The action should've failed due to invalid end.
+	require.ErrorContains(t, err, "end must be after start of range")
+
+	// ACT: Attempt to set first price range.
+	_, err = server.SetPriceRange(ctx, &types.MsgSetPriceRange{
+		Signer:     priceSetter.Address,
+		End:        now.Add(30 * types.Day),
+		DailyRate:  rate,
+		StartPrice: math.LegacyOneDec(),
+	})
+	// ASSERT: The action should've succeeded, and started the range at the current block time.
+	require.NoError(t, err)
+	first, found := k.GetLatestPriceRange(ctx)
+	require.True(t, found)
+	require.Equal(t, uint64(0), first.Index)
+	require.Equal(t, now, first.Start)
+
+	// ACT: Attempt to set a price range with a start price exceeding the deviation.
+	_, err = server.SetPriceRange(ctx, &types.MsgSetPriceRange{
+		Signer:     priceSetter.Address,
+		End:        now.Add(60 * types.Day),
+		DailyRate:  rate,
+		StartPrice: math.LegacyMustNewDecFromStr("1.1"),
+	})
+	// ASSERT: The action should've failed due to large price jump.
+	require.ErrorContains(t, err, "start price deviates")
+
+	// ACT: Attempt to set a price range ending before the latest range.
+	_, err = server.SetPriceRange(ctx, &types.MsgSetPriceRange{
+		Signer:    priceSetter.Address,
+		End:       now.Add(20 * types.Day),
+		DailyRate: rate,
+	})
+	// ASSERT: The action should've failed due to invalid end.
+	require.ErrorContains(t, err, "end must be after start of range")
+
+	// ARRANGE: Set up a failing collection store for the attribute setter.
+	tmp := k.PriceRanges
+	k.PriceRanges = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.PriceRangePrefix, "price_ranges", collections.Uint64Key, codec.CollValue[types.PriceRange](mocks.Codec()),
+	)
+
+	// ACT: Attempt to set a price range with failing PriceRanges collection store.
+	_, err = server.SetPriceRange(ctx, &types.MsgSetPriceRange{
+		Signer:    priceSetter.Address,
+		End:       now.Add(60 * types.Day),
+		DailyRate: rate,
+	})
+	// ASSERT: The action should've failed due to collection store setter error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.PriceRanges = tmp
+
+	// ACT: Attempt to set a price range continuing from the latest range.
+	_, err = server.SetPriceRange(ctx, &types.MsgSetPriceRange{
+		Signer:    priceSetter.Address,
+		End:       now.Add(60 * types.Day),
+		DailyRate: rate,
+	})
+	// ASSERT: The action should've succeeded, and continued from the close price of the latest range.
+	require.NoError(t, err)
+	second, found := k.GetLatestPriceRange(ctx)
+	require.True(t, found)
+	require.Equal(t, uint64(1), second.Index)
+	require.Equal(t, first.End, second.Start)
+	require.Equal(t, first.ClosePrice(), second.StartPrice)
+}
+
+func TestSetMaxPriceDeviation(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to set max price deviation with no owner set.
+	_, err := server.SetMaxPriceDeviation(ctx, &types.MsgSetMaxPriceDeviation{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to set max price deviation with invalid signer.
+	_, err = server.SetMaxPriceDeviation(ctx, &types.MsgSetMaxPriceDeviation{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ACT: Attempt to set max price deviation above one.
+	_, err = server.SetMaxPriceDeviation(ctx, &types.MsgSetMaxPriceDeviation{
+		Signer:            owner.Address,
+		MaxPriceDeviation: math.LegacyNewDec(2),
+	})
+	// ASSERT: The action should've failed due to invalid deviation.
+	require.ErrorContains(t, err, "max price deviation must be between 0 and 1")
+
+	// ACT: Attempt to set max price deviation.
+	_, err = server.SetMaxPriceDeviation(ctx, &types.MsgSetMaxPriceDeviation{
+		Signer:            owner.Address,
+		MaxPriceDeviation: math.LegacyNewDecWithPrec(5, 2),
+	})
+	// ASSERT: The action should've succeeded, and set deviation in state.
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 2), k.GetMaxPriceDeviation(ctx))
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k msgServer) AddRecoverer(ctx context.Context, msg *types.MsgAddRecoverer) (*types.MsgAddRecovererResponse, error) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	bz, recoverer, err := k.decodeAddress(msg.Recoverer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode recoverer address %s", msg.Recoverer)
+	}
+
+	if k.HasRecoverer(ctx, denom, bz) {
+		return nil, fmt.Errorf("%s is already a recoverer", recoverer)
+	}
+	if k.isBlocked(ctx, denom, bz) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockedAddress, "%s cannot be a recoverer", recoverer)
+	}
+
+	if err := k.SetRecoverer(ctx, denom, bz); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddRecovererResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.RecovererAdded{
+		Address: recoverer,
+		Denom:   denom,
+	})
+}
+
+func (k msgServer) RemoveRecoverer(ctx context.Context, msg *types.MsgRemoveRecoverer) (*types.MsgRemoveRecovererResponse, error) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkOwner(ctx, denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	bz, recoverer, err := k.decodeAddress(msg.Recoverer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode recoverer address %s", msg.Recoverer)
+	}
+
+	if !k.HasRecoverer(ctx, denom, bz) {
+		return nil, fmt.Errorf("%s is not a recoverer", recoverer)
+	}
+
+	if err := k.DeleteRecoverer(ctx, denom, bz); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveRecovererResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.RecovererRemoved{
+		Address: recoverer,
+		Denom:   denom,
+	})
+}
+
+func (k msgServer) ForceTransfer(ctx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	denom, err := k.resolveDenom(msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode signer address %s", msg.Signer)
+	}
+	if k.checkOwner(ctx, denom, msg.Signer) != nil {
+		if !k.HasRecoverer(ctx, denom, signer) {
+			return nil, types.ErrInvalidRecoverer
+		}
+		if k.isBlocked(ctx, denom, signer) {
+			return nil, sdkerrors.Wrapf(types.ErrBlockedAddress, "recoverer %s", msg.Signer)
+		}
+	}
+
+	if !k.IsBlocklistBound(ctx, denom) {
+		return nil, fmt.Errorf("%s is not bound to the blocklist", denom)
+	}
+
+	from, err := k.addressCodec.StringToBytes(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode account address %s", msg.From)
+	}
+	if !k.HasBlockedAddress(ctx, from) {
+		return nil, fmt.Errorf("%s is not blocked", msg.From)
+	}
+
+	to, err := k.addressCodec.StringToBytes(msg.To)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode account address %s", msg.To)
+	}
+	if k.HasBlockedAddress(ctx, to) {
+		return nil, fmt.Errorf("%s is blocked from receiving %s", msg.To, denom)
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return nil, errors.New("amount must be positive")
+	}
+	if msg.CaseReference == "" {
+		return nil, errors.New("case reference must be provided")
+	}
+	if k.IsPaused(ctx, denom, types.PauseScopeTransfers) {
+		return nil, fmt.Errorf("%s transfers are paused", denom)
+	}
+
+	// NOTE: Funds are routed through the module account. Transfers into it
+	// skip the send restriction, and transfers out of it are sent by the
+	// module account, so only the checks against the recipient are executed,
+	// and the locked lots of the sender are consumed afterwards.
+	coins := sdk.NewCoins(sdk.NewCoin(denom, msg.Amount))
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, coins)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to transfer from user to module")
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, coins)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to transfer from module to user")
+	}
+	if denom == k.Denom {
+		if err := k.consumeLockedLots(ctx, from, msg.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgForceTransferResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.ForceTransferred{
+		Denom:         denom,
+		From:          msg.From,
+		To:            msg.To,
+		Amount:        msg.Amount,
+		CaseReference: msg.CaseReference,
+		Signer:        msg.Signer,
+	})
+}
@@ -0,0 +1,315 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddRecoverer(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to add recoverer with no owner set.
+	_, err := server.AddRecoverer(ctx, &types.MsgAddRecoverer{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to add recoverer with invalid signer.
+	_, err = server.AddRecoverer(ctx, &types.MsgAddRecoverer{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate two recoverer accounts, add one to state.
+	recoverer1, recoverer2 := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetRecoverer(ctx, k.Denom, recoverer2.Bytes))
+
+	// ACT: Attempt to add recoverer that already exists.
+	_, err = server.AddRecoverer(ctx, &types.MsgAddRecoverer{
+		Signer:    owner.Address,
+		Recoverer: recoverer2.Address,
+	})
+	// ASSERT: The action should've failed due to existing recoverer.
+	require.ErrorContains(t, err, "is already a recoverer")
+
+	// ACT: Attempt to add recoverer with invalid address.
+	_, err = server.AddRecoverer(ctx, &types.MsgAddRecoverer{
+		Signer:    owner.Address,
+		Recoverer: recoverer1.Invalid,
+	})
+	// ASSERT: The action should've failed due to invalid address.
+	require.ErrorContains(t, err, "unable to decode recoverer address")
+
+	// ARRANGE: Add recoverer to blocklist.
+	require.NoError(t, k.SetBlockedAddress(ctx, recoverer1.Bytes))
+
+	// ACT: Attempt to add blocked recoverer.
+	_, err = server.AddRecoverer(ctx, &types.MsgAddRecoverer{
+		Signer:    owner.Address,
+		Recoverer: recoverer1.Address,
+	})
+	// ASSERT: The action should've failed due to blocked recoverer.
+	require.ErrorIs(t, err, types.ErrBlockedAddress)
+
+	// ARRANGE: Remove recoverer from blocklist.
+	require.NoError(t, k.DeleteBlockedAddress(ctx, recoverer1.Bytes))
+
+	// ACT: Attempt to add recoverer.
+	_, err = server.AddRecoverer(ctx, &types.MsgAddRecoverer{
+		Signer:    owner.Address,
+		Recoverer: recoverer1.Address,
+	})
+	// ASSERT: The action should've succeeded, and set recoverer in state.
+	require.NoError(t, err)
+	require.True(t, k.HasRecoverer(ctx, k.Denom, recoverer1.Bytes))
+	require.False(t, k.HasRecoverer(ctx, TBILL, recoverer1.Bytes))
+
+	// ACT: Attempt to add recoverer of an additional denom with invalid signer.
+	_, err = server.AddRecoverer(ctx, &types.MsgAddRecoverer{
+		Signer:    owner.Address,
+		Recoverer: recoverer1.Address,
+		Denom:     TBILL,
+	})
+	// ASSERT: The action should've failed due to no owner of the denom.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner of the additional denom in state.
+	require.NoError(t, k.SetOwner(ctx, TBILL, owner.Address))
+
+	// ACT: Attempt to add recoverer of an additional denom.
+	_, err = server.AddRecoverer(ctx, &types.MsgAddRecoverer{
+		Signer:    owner.Address,
+		Recoverer: recoverer1.Address,
+		Denom:     TBILL,
+	})
+	// ASSERT: The action should've succeeded, and set recoverer of the denom in state.
+	require.NoError(t, err)
+	require.True(t, k.HasRecoverer(ctx, TBILL, recoverer1.Bytes))
+}
+
+func TestRemoveRecoverer(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to remove recoverer with invalid signer.
+	_, err := server.RemoveRecoverer(ctx, &types.MsgRemoveRecoverer{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate a recoverer account.
+	recoverer := utils.TestAccount()
+
+	// ACT: Attempt to remove recoverer that does not exist.
+	_, err = server.RemoveRecoverer(ctx, &types.MsgRemoveRecoverer{
+		Signer:    owner.Address,
+		Recoverer: recoverer.Address,
+	})
+	// ASSERT: The action should've failed due to non-existing recoverer.
+	require.ErrorContains(t, err, "is not a recoverer")
+
+	// ARRANGE: Set recoverer in state.
+	require.NoError(t, k.SetRecoverer(ctx, k.Denom, recoverer.Bytes))
+	require.NoError(t, k.SetRecoverer(ctx, TBILL, recoverer.Bytes))
+
+	// ACT: Attempt to remove recoverer.
+	_, err = server.RemoveRecoverer(ctx, &types.MsgRemoveRecoverer{
+		Signer:    owner.Address,
+		Recoverer: recoverer.Address,
+	})
+	// ASSERT: The action should've succeeded, and removed recoverer from state.
+	require.NoError(t, err)
+	require.False(t, k.HasRecoverer(ctx, k.Denom, recoverer.Bytes))
+	require.True(t, k.HasRecoverer(ctx, TBILL, recoverer.Bytes))
+}
+
+func TestForceTransfer(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	bank = bank.WithSendCoinsRestriction(k.SendRestrictionFn)
+	k.SetBankKeeper(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set owner and recoverer in state, and fund a user.
+	owner, recoverer := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+	require.NoError(t, k.SetRecoverer(ctx, k.Denom, recoverer.Bytes))
+	user, recovery := utils.TestAccount(), utils.TestAccount()
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin(k.Denom, ONE.MulRaw(2)), sdk.NewCoin(TBILL, ONE))
+
+	// ACT: Attempt to force transfer with invalid signer.
+	_, err := server.ForceTransfer(ctx, &types.MsgForceTransfer{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidRecoverer)
+
+	// ACT: Attempt to force transfer an ungoverned denom.
+	_, err = server.ForceTransfer(ctx, &types.MsgForceTransfer{
+		Signer: recoverer.Address,
+		Denom:  "uusdc",
+	})
+	// ASSERT: The action should've failed due to ungoverned denom.
+	require.ErrorContains(t, err, "is not governed by this module")
+
+	// ACT: Attempt to force transfer from an account that isn't blocked.
+	_, err = server.ForceTransfer(ctx, &types.MsgForceTransfer{
+		Signer:        recoverer.Address,
+		From:          user.Address,
+		To:            recovery.Address,
+		Amount:        ONE,
+		CaseReference: "case-1",
+	})
+	// ASSERT: The action should've failed due to unblocked account.
+	require.ErrorContains(t, err, "is not blocked")
+
+	// ARRANGE: Add user to blocklist.
+	require.NoError(t, k.SetBlockedAddress(ctx, user.Bytes))
+
+	// ACT: Attempt to force transfer a denom the recoverer isn't a recoverer of.
+	_, err = server.ForceTransfer(ctx, &types.MsgForceTransfer{
+		Signer:        recoverer.Address,
+		Denom:         TBILL,
+		From:          user.Address,
+		To:            recovery.Address,
+		Amount:        ONE,
+		CaseReference: "case-1",
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidRecoverer)
+
+	// ARRANGE: Set recoverer of the additional denom in state.
+	require.NoError(t, k.SetRecoverer(ctx, TBILL, recoverer.Bytes))
+
+	// ACT: Attempt to force transfer a denom that isn't bound to the blocklist.
+	_, err = server.ForceTransfer(ctx, &types.MsgForceTransfer{
+		Signer:        recoverer.Address,
+		Denom:         TBILL,
+		From:          user.Address,
+		To:            recovery.Address,
+		Amount:        ONE,
+		CaseReference: "case-1",
+	})
+	// ASSERT: The action should've failed due to unbound denom.
+	require.ErrorContains(t, err, "is not bound to the blocklist")
+
+	// ACT: Attempt to force transfer with invalid recipient address.
+	_, err = server.ForceTransfer(ctx, &types.MsgForceTransfer{
+		Signer:        recoverer.Address,
+		From:          user.Address,
+		To:            recovery.Invalid,
+		Amount:        ONE,
+		CaseReference: "case-1",
+	})
+	// ASSERT: The action should've failed due to invalid recipient address.
+	require.ErrorContains(t, err, "unable to decode account address")
+
+	// ACT: Attempt to force transfer without a case reference.
+	_, err = server.ForceTransfer(ctx, &types.MsgForceTransfer{
+		Signer: recoverer.Address,
+		From:   user.Address,
+		To:     recovery.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to missing case reference.
+	require.ErrorContains(t, err, "case reference must be provided")
+
+	// ACT: Attempt to force transfer to a blocked recipient.
+	_, err = server.ForceTransfer(ctx, &types.MsgForceTransfer{
+		Signer:        recoverer.Address,
+		From:          user.Address,
+		To:            user.Address,
+		Amount:        ONE,
+		CaseReference: "case-1",
+	})
+	// ASSERT: The action should've failed due to blocked recipient.
+	require.ErrorContains(t, err, "blocked from receiving")
+
+	// ARRANGE: Add recoverer to blocklist.
+	require.NoError(t, k.SetBlockedAddress(ctx, recoverer.Bytes))
+
+	// ACT: Attempt to force transfer as a blocked recoverer.
+	_, err = server.ForceTransfer(ctx, &types.MsgForceTransfer{
+		Signer:        recoverer.Address,
+		From:          user.Address,
+		To:            recovery.Address,
+		Amount:        ONE,
+		CaseReference: "case-1",
+	})
+	// ASSERT: The action should've failed due to blocked recoverer.
+	require.ErrorIs(t, err, types.ErrBlockedAddress)
+
+	// ARRANGE: Remove recoverer from blocklist.
+	require.NoError(t, k.DeleteBlockedAddress(ctx, recoverer.Bytes))
+
+	// ACT: Attempt to force transfer as a recoverer.
+	_, err = server.ForceTransfer(ctx, &types.MsgForceTransfer{
+		Signer:        recoverer.Address,
+		From:          user.Address,
+		To:            recovery.Address,
+		Amount:        ONE,
+		CaseReference: "case-1",
+	})
+	// ASSERT: The action should've succeeded, despite the user being blocked.
+	require.NoError(t, err)
+	require.Equal(t, ONE, bank.Balances[user.Address].AmountOf(k.Denom))
+	require.Equal(t, ONE, bank.Balances[recovery.Address].AmountOf(k.Denom))
+
+	// ACT: Attempt to force transfer as the owner.
+	_, err = server.ForceTransfer(ctx, &types.MsgForceTransfer{
+		Signer:        owner.Address,
+		From:          user.Address,
+		To:            recovery.Address,
+		Amount:        ONE,
+		CaseReference: "case-2",
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.True(t, bank.Balances[user.Address].AmountOf(k.Denom).IsZero())
+	require.Equal(t, ONE.MulRaw(2), bank.Balances[recovery.Address].AmountOf(k.Denom))
+
+	// ACT: Attempt to force transfer more than the blocked balance.
+	_, err = server.ForceTransfer(ctx, &types.MsgForceTransfer{
+		Signer:        owner.Address,
+		From:          user.Address,
+		To:            recovery.Address,
+		Amount:        ONE,
+		CaseReference: "case-3",
+	})
+	// ASSERT: The action should've failed due to insufficient funds.
+	require.ErrorContains(t, err, "unable to transfer from user to module")
+
+	// ARRANGE: Pause USDY, and move the user's balance back.
+	SetPaused(t, k, ctx, k.Denom, true)
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+
+	// ACT: Attempt to force transfer while paused.
+	_, err = server.ForceTransfer(ctx, &types.MsgForceTransfer{
+		Signer:        owner.Address,
+		From:          user.Address,
+		To:            recovery.Address,
+		Amount:        ONE,
+		CaseReference: "case-3",
+	})
+	// ASSERT: The action should've failed due to paused transfers.
+	require.ErrorContains(t, err, "transfers are paused")
+}
@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k msgServer) RequestRedemption(ctx context.Context, msg *types.MsgRequestRedemption) (*types.MsgRequestRedemptionResponse, error) {
+	user, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode signer address %s", msg.Signer)
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return nil, errors.New("amount must be positive")
+	}
+
+	if err := k.checkTransfer(ctx, user, user); err != nil {
+		return nil, err
+	}
+	if err := k.checkLocked(ctx, user, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.Denom, msg.Amount))
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, user, types.ModuleName, coins)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to transfer from user to module")
+	}
+
+	id, err := k.NextRedemptionID.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SetRedemption(ctx, types.Redemption{
+		Id:        id,
+		User:      msg.Signer,
+		Amount:    msg.Amount,
+		Status:    types.RequestStatusPending,
+		Payout:    sdk.Coin{Amount: math.ZeroInt()},
+		CreatedAt: sdk.UnwrapSDKContext(ctx).BlockTime(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRequestRedemptionResponse{Id: id}, k.eventService.EventManager(ctx).Emit(ctx, &types.RedemptionRequested{
+		Id:     id,
+		User:   msg.Signer,
+		Amount: msg.Amount,
+	})
+}
+
+func (k msgServer) CancelRedemption(ctx context.Context, msg *types.MsgCancelRedemption) (*types.MsgCancelRedemptionResponse, error) {
+	redemption, err := k.getPendingRedemption(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Signer != redemption.User {
+		return nil, fmt.Errorf("redemption %d was not requested by %s", msg.Id, msg.Signer)
+	}
+
+	if err := k.refundRedemption(ctx, redemption, types.RequestStatusCancelled); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelRedemptionResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.RedemptionCancelled{
+		Id:   redemption.Id,
+		User: redemption.User,
+	})
+}
+
+func (k msgServer) SettleRedemption(ctx context.Context, msg *types.MsgSettleRedemption) (*types.MsgSettleRedemptionResponse, error) {
+	burner, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode burner address %s", msg.Signer)
+	}
+	if !k.HasBurner(ctx, k.Denom, burner) {
+		return nil, types.ErrInvalidBurner
+	}
+	if k.isBlocked(ctx, k.Denom, burner) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockedAddress, "burner %s", msg.Signer)
+	}
+
+	redemption, err := k.getPendingRedemption(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if next, _ := k.GetNextPendingRedemption(ctx); next != msg.Id {
+		return nil, fmt.Errorf("redemptions must be settled in order, next is %d", next)
+	}
+
+	allowance := k.getBurnAllowance(ctx, k.Denom, burner)
+	if allowance.LT(redemption.Amount) {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientAllowance, "burner %s has an allowance of %s", msg.Signer, allowance.String())
+	}
+	if err := k.checkBurn(ctx, k.Denom); err != nil {
+		return nil, err
+	}
+
+	user, err := k.addressCodec.StringToBytes(redemption.User)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode user address %s", redemption.User)
+	}
+	if !k.isPermittedSource(ctx, k.Denom, burner, user) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSource, "burner %s cannot burn from %s", msg.Signer, redemption.User)
+	}
+
+	if !msg.Payout.IsNil() && !msg.Payout.IsZero() {
+		if msg.Payout.IsNegative() {
+			return nil, errors.New("payout can't be negative")
+		}
+
+		stablecoin := k.GetStablecoin(ctx)
+		if !stablecoin.IsSet() {
+			return nil, errors.New("no stablecoin is configured")
+		}
+
+		redemption.Payout = sdk.NewCoin(stablecoin.Denom, msg.Payout)
+		err = k.bankKeeper.SendCoins(ctx, burner, user, sdk.NewCoins(redemption.Payout))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "unable to transfer payout from burner to user")
+		}
+	}
+
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(k.Denom, redemption.Amount)))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to burn from module")
+	}
+
+	if err := k.SetBurner(ctx, k.Denom, burner, allowance.Sub(redemption.Amount)); err != nil {
+		return nil, err
+	}
+
+	redemption.Status = types.RequestStatusFulfilled
+	if err := k.SetRedemption(ctx, redemption); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSettleRedemptionResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.RedemptionSettled{
+		Id:     redemption.Id,
+		User:   redemption.User,
+		Amount: redemption.Amount,
+		Payout: redemption.Payout,
+	})
+}
+
+func (k msgServer) RejectRedemption(ctx context.Context, msg *types.MsgRejectRedemption) (*types.MsgRejectRedemptionResponse, error) {
+	burner, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode burner address %s", msg.Signer)
+	}
+	if !k.HasBurner(ctx, k.Denom, burner) {
+		return nil, types.ErrInvalidBurner
+	}
+	if k.isBlocked(ctx, k.Denom, burner) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockedAddress, "burner %s", msg.Signer)
+	}
+
+	redemption, err := k.getPendingRedemption(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.refundRedemption(ctx, redemption, types.RequestStatusRejected); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRejectRedemptionResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.RedemptionRejected{
+		Id:   redemption.Id,
+		User: redemption.User,
+	})
+}
+
+// getPendingRedemption returns a redemption request, ensuring that it is
+// still awaiting settlement.
+func (k msgServer) getPendingRedemption(ctx context.Context, id uint64) (types.Redemption, error) {
+	redemption, found := k.GetRedemption(ctx, id)
+	if !found {
+		return types.Redemption{}, fmt.Errorf("redemption %d does not exist", id)
+	}
+	if redemption.Status != types.RequestStatusPending {
+		return types.Redemption{}, fmt.Errorf("redemption %d is not pending", id)
+	}
+
+	return redemption, nil
+}
+
+// refundRedemption returns the escrowed USDY of a redemption request to the
+// user, and removes it from the queue with the provided status.
+func (k msgServer) refundRedemption(ctx context.Context, redemption types.Redemption, status types.RequestStatus) error {
+	user, err := k.addressCodec.StringToBytes(redemption.User)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "unable to decode user address %s", redemption.User)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.Denom, redemption.Amount))
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, user, coins)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "unable to transfer from module to user")
+	}
+
+	redemption.Status = status
+	return k.SetRedemption(ctx, redemption)
+}
@@ -0,0 +1,306 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestRedemption(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Generate a user account.
+	user := utils.TestAccount()
+
+	// ACT: Attempt to request redemption with invalid amount.
+	_, err := server.RequestRedemption(ctx, &types.MsgRequestRedemption{
+		Signer: user.Address,
+		Amount: ONE.Neg(),
+	})
+	// ASSERT: The action should've failed due to invalid amount.
+	require.ErrorContains(t, err, "amount must be positive")
+
+	// ACT: Attempt to request redemption with insufficient funds.
+	_, err = server.RequestRedemption(ctx, &types.MsgRequestRedemption{
+		Signer: user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to insufficient funds.
+	require.ErrorContains(t, err, "unable to transfer from user to module")
+
+	// ARRANGE: Give user 1 $USDY, and pause the module.
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+	SetPaused(t, k, ctx, k.Denom, true)
+
+	// ACT: Attempt to request redemption when paused.
+	_, err = server.RequestRedemption(ctx, &types.MsgRequestRedemption{
+		Signer: user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to module being paused.
+	require.ErrorContains(t, err, "ausdy transfers are paused")
+
+	// ARRANGE: Unpause the module, and block the user.
+	SetPaused(t, k, ctx, k.Denom, false)
+	require.NoError(t, k.SetBlockedAddress(ctx, user.Bytes))
+
+	// ACT: Attempt to request redemption when blocked.
+	_, err = server.RequestRedemption(ctx, &types.MsgRequestRedemption{
+		Signer: user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to blocked user.
+	require.ErrorContains(t, err, "is blocked from sending ausdy")
+
+	// ARRANGE: Unblock the user.
+	require.NoError(t, k.DeleteBlockedAddress(ctx, user.Bytes))
+
+	// ACT: Attempt to request redemption.
+	res, err := server.RequestRedemption(ctx, &types.MsgRequestRedemption{
+		Signer: user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've succeeded, and escrowed the USDY.
+	require.NoError(t, err)
+	require.True(t, bank.Balances[user.Address].IsZero())
+	require.Equal(t, ONE, bank.Balances[types.ModuleAddress.String()].AmountOf(k.Denom))
+	redemption, found := k.GetRedemption(ctx, res.Id)
+	require.True(t, found)
+	require.Equal(t, types.RequestStatusPending, redemption.Status)
+	next, found := k.GetNextPendingRedemption(ctx)
+	require.True(t, found)
+	require.Equal(t, res.Id, next)
+}
+
+func TestCancelRedemption(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Request a redemption of 1 $USDY.
+	user := utils.TestAccount()
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+	res, err := server.RequestRedemption(ctx, &types.MsgRequestRedemption{
+		Signer: user.Address,
+		Amount: ONE,
+	})
+	require.NoError(t, err)
+
+	// ACT: Attempt to cancel non-existent redemption.
+	_, err = server.CancelRedemption(ctx, &types.MsgCancelRedemption{
+		Signer: user.Address,
+		Id:     1,
+	})
+	// ASSERT: The action should've failed due to non-existent redemption.
+	require.ErrorContains(t, err, "redemption 1 does not exist")
+
+	// ACT: Attempt to cancel redemption of another user.
+	_, err = server.CancelRedemption(ctx, &types.MsgCancelRedemption{
+		Signer: utils.TestAccount().Address,
+		Id:     res.Id,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, "redemption 0 was not requested by")
+
+	// ACT: Attempt to cancel redemption.
+	_, err = server.CancelRedemption(ctx, &types.MsgCancelRedemption{
+		Signer: user.Address,
+		Id:     res.Id,
+	})
+	// ASSERT: The action should've succeeded, and refunded the USDY.
+	require.NoError(t, err)
+	require.Equal(t, ONE, bank.Balances[user.Address].AmountOf(k.Denom))
+	redemption, _ := k.GetRedemption(ctx, res.Id)
+	require.Equal(t, types.RequestStatusCancelled, redemption.Status)
+	_, found := k.GetNextPendingRedemption(ctx)
+	require.False(t, found)
+
+	// ACT: Attempt to cancel redemption again.
+	_, err = server.CancelRedemption(ctx, &types.MsgCancelRedemption{
+		Signer: user.Address,
+		Id:     res.Id,
+	})
+	// ASSERT: The action should've failed due to redemption no longer pending.
+	require.ErrorContains(t, err, "redemption 0 is not pending")
+}
+
+func TestSettleRedemption(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Request two redemptions of 1 $USDY each.
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+	for _, user := range []utils.Account{alice, bob} {
+		bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+		_, err := server.RequestRedemption(ctx, &types.MsgRequestRedemption{
+			Signer: user.Address,
+			Amount: ONE,
+		})
+		require.NoError(t, err)
+	}
+
+	// ACT: Attempt to settle redemption with invalid signer.
+	_, err := server.SettleRedemption(ctx, &types.MsgSettleRedemption{
+		Signer: utils.TestAccount().Address,
+		Id:     0,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidBurner)
+
+	// ARRANGE: Set burner in state, with an allowance of 0.5 $USDY.
+	burner := utils.TestAccount()
+	require.NoError(t, k.SetBurner(ctx, k.Denom, burner.Bytes, ONE.QuoRaw(2)))
+
+	// ACT: Attempt to settle redemption out of order.
+	_, err = server.SettleRedemption(ctx, &types.MsgSettleRedemption{
+		Signer: burner.Address,
+		Id:     1,
+	})
+	// ASSERT: The action should've failed due to FIFO ordering.
+	require.ErrorContains(t, err, "redemptions must be settled in order, next is 0")
+
+	// ACT: Attempt to settle redemption with insufficient allowance.
+	_, err = server.SettleRedemption(ctx, &types.MsgSettleRedemption{
+		Signer: burner.Address,
+		Id:     0,
+	})
+	// ASSERT: The action should've failed due to insufficient allowance.
+	require.ErrorIs(t, err, types.ErrInsufficientAllowance)
+
+	// ARRANGE: Increase the burner's allowance to 2 $USDY, and bind it to Bob.
+	require.NoError(t, k.SetBurner(ctx, k.Denom, burner.Bytes, ONE.MulRaw(2)))
+	require.NoError(t, k.SetBurnerSource(ctx, k.Denom, burner.Bytes, bob.Bytes))
+
+	// ACT: Attempt to settle redemption of a user the burner isn't bound to.
+	_, err = server.SettleRedemption(ctx, &types.MsgSettleRedemption{
+		Signer: burner.Address,
+		Id:     0,
+	})
+	// ASSERT: The action should've failed due to invalid source.
+	require.ErrorIs(t, err, types.ErrInvalidSource)
+
+	// ARRANGE: Bind the burner to Alice as well.
+	require.NoError(t, k.SetBurnerSource(ctx, k.Denom, burner.Bytes, alice.Bytes))
+
+	// ACT: Attempt to settle redemption with a payout and no stablecoin configured.
+	_, err = server.SettleRedemption(ctx, &types.MsgSettleRedemption{
+		Signer: burner.Address,
+		Id:     0,
+		Payout: math.NewInt(1_050_000),
+	})
+	// ASSERT: The action should've failed due to no stablecoin.
+	require.ErrorContains(t, err, "no stablecoin is configured")
+
+	// ARRANGE: Set stablecoin in state.
+	require.NoError(t, k.SetStablecoin(ctx, USDC))
+
+	// ACT: Attempt to settle redemption with a payout and insufficient funds.
+	_, err = server.SettleRedemption(ctx, &types.MsgSettleRedemption{
+		Signer: burner.Address,
+		Id:     0,
+		Payout: math.NewInt(1_050_000),
+	})
+	// ASSERT: The action should've failed due to insufficient funds.
+	require.ErrorContains(t, err, "unable to transfer payout from burner to user")
+
+	// ARRANGE: Give burner 1.05 $USDC.
+	bank.Balances[burner.Address] = sdk.NewCoins(sdk.NewInt64Coin(USDC.Denom, 1_050_000))
+
+	// ACT: Attempt to settle redemption with a payout.
+	_, err = server.SettleRedemption(ctx, &types.MsgSettleRedemption{
+		Signer: burner.Address,
+		Id:     0,
+		Payout: math.NewInt(1_050_000),
+	})
+	// ASSERT: The action should've succeeded, burning the USDY and paying out the user.
+	require.NoError(t, err)
+	require.Equal(t, int64(1_050_000), bank.Balances[alice.Address].AmountOf(USDC.Denom).Int64())
+	require.Equal(t, ONE, bank.Balances[types.ModuleAddress.String()].AmountOf(k.Denom))
+	require.Equal(t, ONE, k.GetBurner(ctx, k.Denom, burner.Bytes))
+	redemption, _ := k.GetRedemption(ctx, 0)
+	require.Equal(t, types.RequestStatusFulfilled, redemption.Status)
+	require.Equal(t, sdk.NewInt64Coin(USDC.Denom, 1_050_000), redemption.Payout)
+
+	// ACT: Attempt to settle next redemption without a payout.
+	_, err = server.SettleRedemption(ctx, &types.MsgSettleRedemption{
+		Signer: burner.Address,
+		Id:     1,
+	})
+	// ASSERT: The action should've succeeded, and emptied the queue.
+	require.NoError(t, err)
+	require.True(t, bank.Balances[types.ModuleAddress.String()].IsZero())
+	require.True(t, bank.Balances[bob.Address].IsZero())
+	_, found := k.GetNextPendingRedemption(ctx)
+	require.False(t, found)
+}
+
+func TestRejectRedemption(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Request two redemptions of 1 $USDY each.
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+	for _, user := range []utils.Account{alice, bob} {
+		bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+		_, err := server.RequestRedemption(ctx, &types.MsgRequestRedemption{
+			Signer: user.Address,
+			Amount: ONE,
+		})
+		require.NoError(t, err)
+	}
+
+	// ACT: Attempt to reject redemption with invalid signer.
+	_, err := server.RejectRedemption(ctx, &types.MsgRejectRedemption{
+		Signer: utils.TestAccount().Address,
+		Id:     1,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidBurner)
+
+	// ARRANGE: Set burner in state.
+	burner := utils.TestAccount()
+	require.NoError(t, k.SetBurner(ctx, k.Denom, burner.Bytes, ONE))
+
+	// ACT: Attempt to reject a redemption that isn't next in the queue.
+	_, err = server.RejectRedemption(ctx, &types.MsgRejectRedemption{
+		Signer: burner.Address,
+		Id:     1,
+	})
+	// ASSERT: The action should've succeeded, and refunded the USDY.
+	require.NoError(t, err)
+	require.Equal(t, ONE, bank.Balances[bob.Address].AmountOf(k.Denom))
+	redemption, _ := k.GetRedemption(ctx, 1)
+	require.Equal(t, types.RequestStatusRejected, redemption.Status)
+	next, _ := k.GetNextPendingRedemption(ctx)
+	require.Equal(t, uint64(0), next)
+
+	// ACT: Attempt to reject redemption again.
+	_, err = server.RejectRedemption(ctx, &types.MsgRejectRedemption{
+		Signer: burner.Address,
+		Id:     1,
+	})
+	// ASSERT: The action should've failed due to redemption no longer pending.
+	require.ErrorContains(t, err, "redemption 1 is not pending")
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k msgServer) AddAttestor(ctx context.Context, msg *types.MsgAddAttestor) (*types.MsgAddAttestorResponse, error) {
+	if err := k.checkOwner(ctx, k.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	bz, attestor, err := k.decodeAddress(msg.Attestor)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode attestor address %s", msg.Attestor)
+	}
+
+	if k.HasAttestor(ctx, bz) {
+		return nil, fmt.Errorf("%s is already an attestor", attestor)
+	}
+
+	if err := k.SetAttestor(ctx, bz); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddAttestorResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.AttestorAdded{
+		Address: attestor,
+	})
+}
+
+func (k msgServer) RemoveAttestor(ctx context.Context, msg *types.MsgRemoveAttestor) (*types.MsgRemoveAttestorResponse, error) {
+	if err := k.checkOwner(ctx, k.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	bz, attestor, err := k.decodeAddress(msg.Attestor)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode attestor address %s", msg.Attestor)
+	}
+
+	if !k.HasAttestor(ctx, bz) {
+		return nil, fmt.Errorf("%s is not an attestor", attestor)
+	}
+
+	if err := k.DeleteAttestor(ctx, bz); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAttestorResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.AttestorRemoved{
+		Address: attestor,
+	})
+}
+
+func (k msgServer) PostAttestation(ctx context.Context, msg *types.MsgPostAttestation) (*types.MsgPostAttestationResponse, error) {
+	signer, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode signer address %s", msg.Signer)
+	}
+	if !k.HasAttestor(ctx, signer) {
+		return nil, types.ErrInvalidAttestor
+	}
+
+	if msg.Amount.IsNil() || msg.Amount.IsNegative() {
+		return nil, errors.New("amount must be non-negative")
+	}
+	if msg.ReportHash == "" {
+		return nil, errors.New("report hash must be provided")
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if msg.AsOf.After(now) {
+		return nil, fmt.Errorf("as of time cannot be after the current block time (%s)", now)
+	}
+
+	attestation := types.Attestation{
+		Attestor:   msg.Signer,
+		Amount:     msg.Amount,
+		AsOf:       msg.AsOf,
+		ReportHash: msg.ReportHash,
+		PostedAt:   now,
+	}
+
+	// NOTE: Attestations are ordered, a new attestation must be more recent
+	// than the latest one.
+	latest, found := k.GetLatestAttestation(ctx)
+	if found {
+		if !attestation.AsOf.After(latest.AsOf) {
+			return nil, fmt.Errorf("as of time must be after the latest attestation (%s)", latest.AsOf)
+		}
+
+		attestation.Index = latest.Index + 1
+	}
+
+	if err := k.SetAttestation(ctx, attestation); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPostAttestationResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.AttestationPosted{
+		Index:      attestation.Index,
+		Attestor:   attestation.Attestor,
+		Amount:     attestation.Amount,
+		AsOf:       attestation.AsOf,
+		ReportHash: attestation.ReportHash,
+	})
+}
+
+func (k msgServer) SetReserveConfig(ctx context.Context, msg *types.MsgSetReserveConfig) (*types.MsgSetReserveConfigResponse, error) {
+	if err := k.checkOwner(ctx, k.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	config := types.ReserveConfig{
+		CollateralRatio: msg.CollateralRatio,
+		MaxStaleness:    msg.MaxStaleness,
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetReserveConfig(ctx, config); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetReserveConfigResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.ReserveConfigUpdated{
+		CollateralRatio: config.CollateralRatio,
+		MaxStaleness:    config.MaxStaleness,
+	})
+}
@@ -0,0 +1,341 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddAttestor(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to add attestor with no owner set.
+	_, err := server.AddAttestor(ctx, &types.MsgAddAttestor{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to add attestor with invalid signer.
+	_, err = server.AddAttestor(ctx, &types.MsgAddAttestor{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate two attestor accounts, add one to state.
+	attestor1, attestor2 := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetAttestor(ctx, attestor2.Bytes))
+
+	// ACT: Attempt to add attestor that already exists.
+	_, err = server.AddAttestor(ctx, &types.MsgAddAttestor{
+		Signer:   owner.Address,
+		Attestor: attestor2.Address,
+	})
+	// ASSERT: The action should've failed due to existing attestor.
+	require.ErrorContains(t, err, "is already an attestor")
+
+	// ACT: Attempt to add attestor with invalid address.
+	_, err = server.AddAttestor(ctx, &types.MsgAddAttestor{
+		Signer:   owner.Address,
+		Attestor: attestor1.Invalid,
+	})
+	// ASSERT: The action should've failed due to invalid address.
+	require.ErrorContains(t, err, "unable to decode attestor address")
+
+	// ACT: Attempt to add attestor.
+	_, err = server.AddAttestor(ctx, &types.MsgAddAttestor{
+		Signer:   owner.Address,
+		Attestor: attestor1.Address,
+	})
+	// ASSERT: The action should've succeeded, and set attestor in state.
+	require.NoError(t, err)
+	require.True(t, k.HasAttestor(ctx, attestor1.Bytes))
+}
+
+func TestRemoveAttestor(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to remove attestor with invalid signer.
+	_, err := server.RemoveAttestor(ctx, &types.MsgRemoveAttestor{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate an attestor account.
+	attestor := utils.TestAccount()
+
+	// ACT: Attempt to remove attestor that does not exist.
+	_, err = server.RemoveAttestor(ctx, &types.MsgRemoveAttestor{
+		Signer:   owner.Address,
+		Attestor: attestor.Address,
+	})
+	// ASSERT: The action should've failed due to non-existing attestor.
+	require.ErrorContains(t, err, "is not an attestor")
+
+	// ARRANGE: Set attestor in state.
+	require.NoError(t, k.SetAttestor(ctx, attestor.Bytes))
+
+	// ACT: Attempt to remove attestor.
+	_, err = server.RemoveAttestor(ctx, &types.MsgRemoveAttestor{
+		Signer:   owner.Address,
+		Attestor: attestor.Address,
+	})
+	// ASSERT: The action should've succeeded, and removed attestor from state.
+	require.NoError(t, err)
+	require.False(t, k.HasAttestor(ctx, attestor.Bytes))
+}
+
+func TestPostAttestation(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	// ARRANGE: Set attestor in state.
+	attestor := utils.TestAccount()
+	require.NoError(t, k.SetAttestor(ctx, attestor.Bytes))
+
+	// ACT: Attempt to post attestation with invalid signer.
+	_, err := server.PostAttestation(ctx, &types.MsgPostAttestation{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidAttestor)
+
+	// ACT: Attempt to post attestation with negative amount.
+	_, err = server.PostAttestation(ctx, &types.MsgPostAttestation{
+		Signer: attestor.Address,
+		Amount: ONE.Neg(),
+	})
+	// ASSERT: The action should've failed due to negative amount.
+	require.ErrorContains(t, err, "amount must be non-negative")
+
+	// ACT: Attempt to post attestation without report hash.
+	_, err = server.PostAttestation(ctx, &types.MsgPostAttestation{
+		Signer: attestor.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to missing report hash.
+	require.ErrorContains(t, err, "report hash must be provided")
+
+	// ACT: Attempt to post attestation as of a future time.
+	_, err = server.PostAttestation(ctx, &types.MsgPostAttestation{
+		Signer:     attestor.Address,
+		Amount:     ONE,
+		AsOf:       now.Add(time.Hour),
+		ReportHash: "hash1",
+	})
+	// ASSERT: The action should've failed due to future as of time.
+	require.ErrorContains(t, err, "cannot be after the current block time")
+
+	// ACT: Attempt to post attestation.
+	_, err = server.PostAttestation(ctx, &types.MsgPostAttestation{
+		Signer:     attestor.Address,
+		Amount:     ONE,
+		AsOf:       now.Add(-time.Hour),
+		ReportHash: "hash1",
+	})
+	// ASSERT: The action should've succeeded, and set attestation in state.
+	require.NoError(t, err)
+	latest, found := k.GetLatestAttestation(ctx)
+	require.True(t, found)
+	require.Equal(t, types.Attestation{
+		Index:      0,
+		Attestor:   attestor.Address,
+		Amount:     ONE,
+		AsOf:       now.Add(-time.Hour),
+		ReportHash: "hash1",
+		PostedAt:   now,
+	}, latest)
+
+	// ACT: Attempt to post attestation as of the same time as the latest.
+	_, err = server.PostAttestation(ctx, &types.MsgPostAttestation{
+		Signer:     attestor.Address,
+		Amount:     ONE,
+		AsOf:       now.Add(-time.Hour),
+		ReportHash: "hash2",
+	})
+	// ASSERT: The action should've failed due to outdated as of time.
+	require.ErrorContains(t, err, "must be after the latest attestation")
+
+	// ACT: Attempt to post a second attestation.
+	_, err = server.PostAttestation(ctx, &types.MsgPostAttestation{
+		Signer:     attestor.Address,
+		Amount:     ONE.MulRaw(2),
+		AsOf:       now,
+		ReportHash: "hash2",
+	})
+	// ASSERT: The action should've succeeded, and appended attestation to the history.
+	require.NoError(t, err)
+	attestations := k.GetAttestations(ctx)
+	require.Len(t, attestations, 2)
+	require.Equal(t, uint64(1), attestations[1].Index)
+	require.Equal(t, ONE.MulRaw(2), attestations[1].Amount)
+}
+
+func TestSetReserveConfig(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to set reserve config with no owner set.
+	_, err := server.SetReserveConfig(ctx, &types.MsgSetReserveConfig{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to set reserve config with invalid signer.
+	_, err = server.SetReserveConfig(ctx, &types.MsgSetReserveConfig{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ACT: Attempt to set reserve config with negative collateral ratio.
+	_, err = server.SetReserveConfig(ctx, &types.MsgSetReserveConfig{
+		Signer:          owner.Address,
+		CollateralRatio: math.LegacyNewDec(-1),
+	})
+	// ASSERT: The action should've failed due to negative collateral ratio.
+	require.ErrorContains(t, err, "collateral ratio must be non-negative")
+
+	// ACT: Attempt to set reserve config with negative max staleness.
+	_, err = server.SetReserveConfig(ctx, &types.MsgSetReserveConfig{
+		Signer:          owner.Address,
+		CollateralRatio: math.LegacyOneDec(),
+		MaxStaleness:    -time.Hour,
+	})
+	// ASSERT: The action should've failed due to negative max staleness.
+	require.ErrorContains(t, err, "max staleness must be non-negative")
+
+	// ACT: Attempt to set reserve config.
+	_, err = server.SetReserveConfig(ctx, &types.MsgSetReserveConfig{
+		Signer:          owner.Address,
+		CollateralRatio: math.LegacyOneDec(),
+		MaxStaleness:    24 * time.Hour,
+	})
+	// ASSERT: The action should've succeeded, and set reserve config in state.
+	require.NoError(t, err)
+	require.Equal(t, types.ReserveConfig{
+		CollateralRatio: math.LegacyOneDec(),
+		MaxStaleness:    24 * time.Hour,
+	}, k.GetReserveConfig(ctx))
+}
+
+func TestMintWithReserves(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	server := keeper.NewMsgServer(k)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	// ARRANGE: Set minter in state, and require reserves of 100% valid for a day.
+	minter, user := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetMinter(ctx, k.Denom, minter.Bytes, ONE.MulRaw(10)))
+	require.NoError(t, k.SetReserveConfig(ctx, types.ReserveConfig{
+		CollateralRatio: math.LegacyOneDec(),
+		MaxStaleness:    24 * time.Hour,
+	}))
+
+	// ACT: Attempt to mint without any attestation.
+	_, err := server.Mint(ctx, &types.MsgMint{
+		Signer: minter.Address,
+		To:     user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to missing attestation.
+	require.ErrorIs(t, err, types.ErrInsufficientReserves)
+
+	// ARRANGE: Set a stale attestation of 2 USDY in state.
+	require.NoError(t, k.SetAttestation(ctx, types.Attestation{
+		Index:  0,
+		Amount: ONE.MulRaw(2),
+		AsOf:   now.Add(-25 * time.Hour),
+	}))
+
+	// ACT: Attempt to mint with a stale attestation.
+	_, err = server.Mint(ctx, &types.MsgMint{
+		Signer: minter.Address,
+		To:     user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to stale attestation.
+	require.ErrorContains(t, err, "is stale")
+
+	// ARRANGE: Set a fresh attestation of 2 USDY in state.
+	require.NoError(t, k.SetAttestation(ctx, types.Attestation{
+		Index:  1,
+		Amount: ONE.MulRaw(2),
+		AsOf:   now.Add(-time.Hour),
+	}))
+
+	// ACT: Attempt to mint within attested reserves.
+	_, err = server.Mint(ctx, &types.MsgMint{
+		Signer: minter.Address,
+		To:     user.Address,
+		Amount: ONE.MulRaw(2),
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, ONE.MulRaw(2), bank.Balances[user.Address].AmountOf(k.Denom))
+
+	// ACT: Attempt to mint beyond attested reserves.
+	_, err = server.Mint(ctx, &types.MsgMint{
+		Signer: minter.Address,
+		To:     user.Address,
+		Amount: math.NewInt(1),
+	})
+	// ASSERT: The action should've failed due to insufficient reserves.
+	require.ErrorContains(t, err, "would exceed capacity")
+
+	// ARRANGE: Overcollateralize by only allowing a supply of 50% of reserves.
+	require.NoError(t, k.SetReserveConfig(ctx, types.ReserveConfig{
+		CollateralRatio: math.LegacyNewDecWithPrec(5, 1),
+	}))
+	require.NoError(t, k.SetAttestation(ctx, types.Attestation{
+		Index:  2,
+		Amount: ONE.MulRaw(6),
+		AsOf:   now,
+	}))
+
+	// ACT: Attempt to mint beyond the collateral ratio.
+	_, err = server.Mint(ctx, &types.MsgMint{
+		Signer: minter.Address,
+		To:     user.Address,
+		Amount: ONE.MulRaw(2),
+	})
+	// ASSERT: The action should've failed due to insufficient reserves.
+	require.ErrorIs(t, err, types.ErrInsufficientReserves)
+
+	// ACT: Attempt to mint up to the collateral ratio.
+	_, err = server.Mint(ctx, &types.MsgMint{
+		Signer: minter.Address,
+		To:     user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, ONE.MulRaw(3), bank.Balances[user.Address].AmountOf(k.Denom))
+}
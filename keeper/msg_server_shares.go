@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k msgServer) Wrap(ctx context.Context, msg *types.MsgWrap) (*types.MsgWrapResponse, error) {
+	signer, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode signer address %s", msg.Signer)
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return nil, errors.New("amount must be positive")
+	}
+
+	if err := k.checkTransfer(ctx, signer, signer); err != nil {
+		return nil, err
+	}
+	if err := k.checkLocked(ctx, signer, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.Denom, msg.Amount))
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, signer, types.ModuleName, coins)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to transfer from user to module")
+	}
+
+	// NOTE: Shares are credited one-to-one with the amount of USDY locked.
+	if err := k.MintShares(ctx, signer, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrapResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.Wrapped{
+		Account: msg.Signer,
+		Amount:  msg.Amount,
+		Shares:  msg.Amount,
+	})
+}
+
+func (k msgServer) Unwrap(ctx context.Context, msg *types.MsgUnwrap) (*types.MsgUnwrapResponse, error) {
+	signer, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode signer address %s", msg.Signer)
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return nil, errors.New("amount must be positive")
+	}
+
+	if err := k.checkTransfer(ctx, signer, signer); err != nil {
+		return nil, err
+	}
+
+	shares, err := k.getSharesForAmount(ctx, signer, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, signer, types.ModuleName, sdk.NewCoins(sdk.NewCoin(k.SharesDenom, shares)))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to transfer shares from user to module")
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(k.SharesDenom, shares)))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to burn shares from module")
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.Denom, shares))
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, signer, coins)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to transfer from module to user")
+	}
+
+	return &types.MsgUnwrapResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.Unwrapped{
+		Account: msg.Signer,
+		Amount:  msg.Amount,
+		Shares:  shares,
+	})
+}
+
+func (k msgServer) TransferRebasing(ctx context.Context, msg *types.MsgTransferRebasing) (*types.MsgTransferRebasingResponse, error) {
+	signer, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode signer address %s", msg.Signer)
+	}
+	recipient, err := k.addressCodec.StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode recipient address %s", msg.Recipient)
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return nil, errors.New("amount must be positive")
+	}
+
+	shares, err := k.getSharesForAmount(ctx, signer, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: The send restriction applies the pause and blocklist checks of
+	// USDY to transfers of the share denom.
+	err = k.bankKeeper.SendCoins(ctx, signer, recipient, sdk.NewCoins(sdk.NewCoin(k.SharesDenom, shares)))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to transfer shares from sender to recipient")
+	}
+
+	return &types.MsgTransferRebasingResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.RebasingTransfer{
+		From:   msg.Signer,
+		To:     msg.Recipient,
+		Amount: msg.Amount,
+		Shares: shares,
+	})
+}
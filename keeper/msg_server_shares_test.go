@@ -0,0 +1,280 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// SetPrice sets a single price range in state that holds the provided price
+// for a year, starting at the current block time.
+func SetPrice(t *testing.T, k *keeper.Keeper, ctx sdk.Context, price math.LegacyDec) {
+	require.NoError(t, k.SetPriceRange(ctx, types.PriceRange{
+		Index:      0,
+		Start:      ctx.BlockTime(),
+		End:        ctx.BlockTime().Add(365 * types.Day),
+		DailyRate:  math.LegacyOneDec(),
+		StartPrice: price,
+	}))
+}
+
+func TestWrap(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	k.SetChannelKeeper(mocks.ChannelKeeper{})
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Generate a user account.
+	user := utils.TestAccount()
+
+	// ACT: Attempt to wrap with invalid signer address.
+	_, err := server.Wrap(ctx, &types.MsgWrap{
+		Signer: user.Invalid,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to invalid signer address.
+	require.ErrorContains(t, err, "unable to decode signer address")
+
+	// ACT: Attempt to wrap invalid amount.
+	_, err = server.Wrap(ctx, &types.MsgWrap{
+		Signer: user.Address,
+		Amount: ONE.Neg(),
+	})
+	// ASSERT: The action should've failed due to invalid amount.
+	require.ErrorContains(t, err, "amount must be positive")
+
+	// ACT: Attempt to wrap with insufficient funds.
+	_, err = server.Wrap(ctx, &types.MsgWrap{
+		Signer: user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to insufficient funds.
+	require.ErrorContains(t, err, "unable to transfer from user to module")
+
+	// ARRANGE: Give user 1 $USDY, and pause the module.
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+	SetPaused(t, k, ctx, k.Denom, true)
+
+	// ACT: Attempt to wrap when paused.
+	_, err = server.Wrap(ctx, &types.MsgWrap{
+		Signer: user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to module being paused.
+	require.ErrorContains(t, err, "ausdy transfers are paused")
+
+	// ARRANGE: Unpause the module, and block the user.
+	SetPaused(t, k, ctx, k.Denom, false)
+	require.NoError(t, k.SetBlockedAddress(ctx, user.Bytes))
+
+	// ACT: Attempt to wrap when blocked.
+	_, err = server.Wrap(ctx, &types.MsgWrap{
+		Signer: user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to blocked user.
+	require.ErrorContains(t, err, "is blocked from sending ausdy")
+
+	// ARRANGE: Unblock the user, and lock their balance.
+	require.NoError(t, k.DeleteBlockedAddress(ctx, user.Bytes))
+	require.NoError(t, k.SetLockedLot(ctx, user.Bytes, ctx.BlockTime().Add(time.Hour), ONE))
+
+	// ACT: Attempt to wrap locked USDY.
+	_, err = server.Wrap(ctx, &types.MsgWrap{
+		Signer: user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to locked USDY.
+	require.ErrorContains(t, err, "locked")
+
+	// ARRANGE: Unlock the balance of the user.
+	require.NoError(t, k.DeleteLockedLot(ctx, user.Bytes, ctx.BlockTime().Add(time.Hour)))
+
+	// ACT: Attempt to wrap.
+	_, err = server.Wrap(ctx, &types.MsgWrap{
+		Signer: user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've succeeded, and credited shares to user as share denom balance.
+	require.NoError(t, err)
+	require.True(t, bank.Balances[user.Address].AmountOf(k.Denom).IsZero())
+	require.Equal(t, ONE, bank.Balances[user.Address].AmountOf(k.SharesDenom))
+	require.Equal(t, ONE, bank.Balances[types.ModuleAddress.String()].AmountOf(k.Denom))
+	require.Equal(t, ONE, k.GetShares(ctx, user.Bytes))
+	require.Equal(t, ONE, k.GetTotalShares(ctx))
+}
+
+func TestUnwrap(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	k.SetChannelKeeper(mocks.ChannelKeeper{})
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Generate a user account, with 1 $USDY (and some dust) wrapped.
+	user := utils.TestAccount()
+	shares := ONE.AddRaw(1)
+	bank.Balances[types.ModuleAddress.String()] = sdk.NewCoins(sdk.NewCoin(k.Denom, shares))
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin(k.SharesDenom, shares))
+
+	// ACT: Attempt to unwrap with no price.
+	_, err := server.Unwrap(ctx, &types.MsgUnwrap{
+		Signer: user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to no price.
+	require.ErrorContains(t, err, "no price range has started")
+
+	// ARRANGE: Set price to 1.05.
+	SetPrice(t, k, ctx, math.LegacyMustNewDecFromStr("1.05"))
+
+	// ACT: Attempt to unwrap invalid amount.
+	_, err = server.Unwrap(ctx, &types.MsgUnwrap{
+		Signer: user.Address,
+		Amount: math.ZeroInt(),
+	})
+	// ASSERT: The action should've failed due to invalid amount.
+	require.ErrorContains(t, err, "amount must be positive")
+
+	// ACT: Attempt to unwrap amount worth less than a share.
+	_, err = server.Unwrap(ctx, &types.MsgUnwrap{
+		Signer: user.Address,
+		Amount: math.OneInt(),
+	})
+	// ASSERT: The action should've failed due to amount rounding to zero shares.
+	require.ErrorContains(t, err, "amount must be worth at least one share")
+
+	// ACT: Attempt to unwrap more than balance.
+	_, err = server.Unwrap(ctx, &types.MsgUnwrap{
+		Signer: user.Address,
+		Amount: ONE.MulRaw(2),
+	})
+	// ASSERT: The action should've failed due to insufficient shares.
+	require.ErrorIs(t, err, types.ErrInsufficientShares)
+
+	// ARRANGE: Block the user.
+	require.NoError(t, k.SetBlockedAddress(ctx, user.Bytes))
+
+	// ACT: Attempt to unwrap when blocked.
+	_, err = server.Unwrap(ctx, &types.MsgUnwrap{
+		Signer: user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to blocked user.
+	require.ErrorContains(t, err, "is blocked from sending ausdy")
+
+	// ARRANGE: Unblock the user, and pause minting.
+	require.NoError(t, k.DeleteBlockedAddress(ctx, user.Bytes))
+	require.NoError(t, k.SetPaused(ctx, k.Denom, types.PauseScopeMint, true))
+
+	// ACT: Attempt to unwrap 0.525 $rUSDY.
+	_, err = server.Unwrap(ctx, &types.MsgUnwrap{
+		Signer: user.Address,
+		Amount: math.NewInt(525_000_000_000_000_000),
+	})
+	// ASSERT: The action should've succeeded, as releasing $USDY isn't minting, and released 0.5 $USDY.
+	require.NoError(t, err)
+	half := math.NewInt(500_000_000_000_000_000)
+	require.Equal(t, half, bank.Balances[user.Address].AmountOf(k.Denom))
+	require.Equal(t, half.AddRaw(1), k.GetShares(ctx, user.Bytes))
+	require.Equal(t, half.AddRaw(1), k.GetTotalShares(ctx))
+
+	// ACT: Attempt to unwrap the remaining balance of 0.525 $rUSDY.
+	_, err = server.Unwrap(ctx, &types.MsgUnwrap{
+		Signer: user.Address,
+		Amount: math.NewInt(525_000_000_000_000_001),
+	})
+	// ASSERT: The action should've succeeded, and released all remaining shares.
+	require.NoError(t, err)
+	require.Equal(t, shares, bank.Balances[user.Address].AmountOf(k.Denom))
+	require.True(t, k.GetShares(ctx, user.Bytes).IsZero())
+	require.True(t, k.GetTotalShares(ctx).IsZero())
+	require.True(t, bank.Balances[types.ModuleAddress.String()].IsZero())
+}
+
+func TestTransferRebasing(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	k.SetChannelKeeper(mocks.ChannelKeeper{})
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Generate two user accounts, one with 1 $USDY wrapped.
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+	bank.Balances[alice.Address] = sdk.NewCoins(sdk.NewCoin(k.SharesDenom, ONE))
+
+	// ACT: Attempt to transfer with invalid recipient address.
+	_, err := server.TransferRebasing(ctx, &types.MsgTransferRebasing{
+		Signer:    alice.Address,
+		Recipient: bob.Invalid,
+		Amount:    ONE,
+	})
+	// ASSERT: The action should've failed due to invalid recipient address.
+	require.ErrorContains(t, err, "unable to decode recipient address")
+
+	// ARRANGE: Set price to 1.05, and block the recipient.
+	SetPrice(t, k, ctx, math.LegacyMustNewDecFromStr("1.05"))
+	require.NoError(t, k.SetBlockedAddress(ctx, bob.Bytes))
+
+	// ACT: Attempt to transfer to blocked recipient.
+	_, err = server.TransferRebasing(ctx, &types.MsgTransferRebasing{
+		Signer:    alice.Address,
+		Recipient: bob.Address,
+		Amount:    ONE,
+	})
+	// ASSERT: The action should've failed due to blocked recipient.
+	require.ErrorContains(t, err, "is blocked from receiving ausdy")
+
+	// ARRANGE: Unblock the recipient, and pause the module.
+	require.NoError(t, k.DeleteBlockedAddress(ctx, bob.Bytes))
+	SetPaused(t, k, ctx, k.Denom, true)
+
+	// ACT: Attempt to transfer when paused.
+	_, err = server.TransferRebasing(ctx, &types.MsgTransferRebasing{
+		Signer:    alice.Address,
+		Recipient: bob.Address,
+		Amount:    ONE,
+	})
+	// ASSERT: The action should've failed due to module being paused.
+	require.ErrorContains(t, err, "ausdy transfers are paused")
+
+	// ARRANGE: Unpause the module.
+	SetPaused(t, k, ctx, k.Denom, false)
+
+	// ACT: Attempt to transfer more than balance.
+	_, err = server.TransferRebasing(ctx, &types.MsgTransferRebasing{
+		Signer:    alice.Address,
+		Recipient: bob.Address,
+		Amount:    ONE.MulRaw(2),
+	})
+	// ASSERT: The action should've failed due to insufficient shares.
+	require.ErrorIs(t, err, types.ErrInsufficientShares)
+
+	// ACT: Attempt to transfer 1.05 $rUSDY.
+	_, err = server.TransferRebasing(ctx, &types.MsgTransferRebasing{
+		Signer:    alice.Address,
+		Recipient: bob.Address,
+		Amount:    math.NewInt(1_050_000_000_000_000_000),
+	})
+	// ASSERT: The action should've succeeded, and moved all shares.
+	require.NoError(t, err)
+	require.True(t, k.GetShares(ctx, alice.Bytes).IsZero())
+	require.Equal(t, ONE, k.GetShares(ctx, bob.Bytes))
+	require.Equal(t, ONE, k.GetTotalShares(ctx))
+}
@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k msgServer) SetStablecoin(ctx context.Context, msg *types.MsgSetStablecoin) (*types.MsgSetStablecoinResponse, error) {
+	if err := k.checkOwner(ctx, k.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	stablecoin := types.Stablecoin{Denom: msg.Denom, Exponent: msg.Exponent}
+	if err := stablecoin.Validate(); err != nil {
+		return nil, sdkerrors.Wrapf(err, "invalid stablecoin")
+	}
+	if stablecoin.Denom == k.Denom {
+		return nil, fmt.Errorf("stablecoin can't be %s", k.Denom)
+	}
+	if liquidity := k.GetSwapLiquidity(ctx); liquidity.IsPositive() && liquidity.Denom != stablecoin.Denom {
+		return nil, fmt.Errorf("swap facility still holds %s", liquidity.String())
+	}
+
+	if err := k.Keeper.SetStablecoin(ctx, stablecoin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetStablecoinResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.StablecoinUpdated{
+		Denom:    stablecoin.Denom,
+		Exponent: stablecoin.Exponent,
+	})
+}
+
+func (k msgServer) RequestSubscription(ctx context.Context, msg *types.MsgRequestSubscription) (*types.MsgRequestSubscriptionResponse, error) {
+	user, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode signer address %s", msg.Signer)
+	}
+
+	stablecoin := k.GetStablecoin(ctx)
+	if !stablecoin.IsSet() {
+		return nil, errors.New("no stablecoin is configured")
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return nil, errors.New("amount must be positive")
+	}
+
+	if k.HasBlockedAddress(ctx, user) {
+		return nil, fmt.Errorf("%s is blocked from receiving %s", msg.Signer, k.Denom)
+	}
+
+	deposit := sdk.NewCoin(stablecoin.Denom, msg.Amount)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, user, types.ModuleName, sdk.NewCoins(deposit))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to transfer from user to module")
+	}
+
+	id, err := k.NextSubscriptionID.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SetSubscription(ctx, types.Subscription{
+		Id:        id,
+		User:      msg.Signer,
+		Deposit:   deposit,
+		Status:    types.RequestStatusPending,
+		Price:     math.LegacyZeroDec(),
+		Minted:    math.ZeroInt(),
+		CreatedAt: sdk.UnwrapSDKContext(ctx).BlockTime(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRequestSubscriptionResponse{Id: id}, k.eventService.EventManager(ctx).Emit(ctx, &types.SubscriptionRequested{
+		Id:      id,
+		User:    msg.Signer,
+		Deposit: deposit,
+	})
+}
+
+func (k msgServer) FulfillSubscription(ctx context.Context, msg *types.MsgFulfillSubscription) (*types.MsgFulfillSubscriptionResponse, error) {
+	minter, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode minter address %s", msg.Signer)
+	}
+	if !k.HasMinter(ctx, k.Denom, minter) {
+		return nil, types.ErrInvalidMinter
+	}
+	if k.isBlocked(ctx, k.Denom, minter) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockedAddress, "minter %s", msg.Signer)
+	}
+
+	subscription, err := k.getPendingSubscription(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	stablecoin := k.GetStablecoin(ctx)
+	if subscription.Deposit.Denom != stablecoin.Denom {
+		return nil, fmt.Errorf("subscription %d was made in %s, which is no longer the configured stablecoin", msg.Id, subscription.Deposit.Denom)
+	}
+
+	minted, err := stablecoin.ToUSDY(subscription.Deposit.Amount, msg.Price)
+	if err != nil {
+		return nil, err
+	}
+	if !minted.IsPositive() {
+		return nil, errors.New("minted amount must be positive")
+	}
+
+	allowance := k.getMintAllowance(ctx, k.Denom, minter)
+	if allowance.LT(minted) {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientAllowance, "minter %s has an allowance of %s", msg.Signer, allowance.String())
+	}
+	if err := k.checkReserves(ctx, minted); err != nil {
+		return nil, err
+	}
+
+	user, err := k.addressCodec.StringToBytes(subscription.User)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode user address %s", subscription.User)
+	}
+	if !k.isPermittedRecipient(ctx, k.Denom, minter, user) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRecipient, "minter %s cannot mint to %s", msg.Signer, subscription.User)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.Denom, minted))
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to mint to module")
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(types.WithMinting(ctx), types.ModuleName, user, coins)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to transfer from module to user")
+	}
+	if err := k.lockMinted(ctx, user, minted); err != nil {
+		return nil, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, minter, sdk.NewCoins(subscription.Deposit))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to transfer from module to minter")
+	}
+
+	if err := k.SetMinter(ctx, k.Denom, minter, allowance.Sub(minted)); err != nil {
+		return nil, err
+	}
+
+	subscription.Status = types.RequestStatusFulfilled
+	subscription.Price = msg.Price
+	subscription.Minted = minted
+	if err := k.SetSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFulfillSubscriptionResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.SubscriptionFulfilled{
+		Id:     subscription.Id,
+		User:   subscription.User,
+		Price:  subscription.Price,
+		Minted: subscription.Minted,
+	})
+}
+
+func (k msgServer) RejectSubscription(ctx context.Context, msg *types.MsgRejectSubscription) (*types.MsgRejectSubscriptionResponse, error) {
+	minter, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode minter address %s", msg.Signer)
+	}
+	if !k.HasMinter(ctx, k.Denom, minter) {
+		return nil, types.ErrInvalidMinter
+	}
+	if k.isBlocked(ctx, k.Denom, minter) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockedAddress, "minter %s", msg.Signer)
+	}
+
+	subscription, err := k.getPendingSubscription(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := k.addressCodec.StringToBytes(subscription.User)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode user address %s", subscription.User)
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, user, sdk.NewCoins(subscription.Deposit))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to transfer from module to user")
+	}
+
+	subscription.Status = types.RequestStatusRejected
+	if err := k.SetSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRejectSubscriptionResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.SubscriptionRejected{
+		Id:   subscription.Id,
+		User: subscription.User,
+	})
+}
+
+// getPendingSubscription returns a subscription request, ensuring that it is
+// still awaiting fulfillment.
+func (k msgServer) getPendingSubscription(ctx context.Context, id uint64) (types.Subscription, error) {
+	subscription, found := k.GetSubscription(ctx, id)
+	if !found {
+		return types.Subscription{}, fmt.Errorf("subscription %d does not exist", id)
+	}
+	if subscription.Status != types.RequestStatusPending {
+		return types.Subscription{}, fmt.Errorf("subscription %d is not pending", id)
+	}
+
+	return subscription, nil
+}
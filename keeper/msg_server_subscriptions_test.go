@@ -0,0 +1,303 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+var USDC = types.Stablecoin{Denom: "uusdc", Exponent: 6}
+
+func TestSetStablecoin(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to set stablecoin with no owner set.
+	_, err := server.SetStablecoin(ctx, &types.MsgSetStablecoin{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to set stablecoin with invalid signer.
+	_, err = server.SetStablecoin(ctx, &types.MsgSetStablecoin{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ACT: Attempt to set stablecoin with invalid denom.
+	_, err = server.SetStablecoin(ctx, &types.MsgSetStablecoin{
+		Signer: owner.Address,
+		Denom:  "!",
+	})
+	// ASSERT: The action should've failed due to invalid denom.
+	require.ErrorContains(t, err, "invalid stablecoin")
+
+	// ACT: Attempt to set stablecoin with too many decimals.
+	_, err = server.SetStablecoin(ctx, &types.MsgSetStablecoin{
+		Signer:   owner.Address,
+		Denom:    "uusdc",
+		Exponent: 19,
+	})
+	// ASSERT: The action should've failed due to invalid exponent.
+	require.ErrorContains(t, err, "exponent can't exceed 18")
+
+	// ACT: Attempt to set stablecoin to USDY.
+	_, err = server.SetStablecoin(ctx, &types.MsgSetStablecoin{
+		Signer: owner.Address,
+		Denom:  k.Denom,
+	})
+	// ASSERT: The action should've failed due to stablecoin being USDY.
+	require.ErrorContains(t, err, "stablecoin can't be ausdy")
+
+	// ACT: Attempt to set stablecoin.
+	_, err = server.SetStablecoin(ctx, &types.MsgSetStablecoin{
+		Signer:   owner.Address,
+		Denom:    USDC.Denom,
+		Exponent: USDC.Exponent,
+	})
+	// ASSERT: The action should've succeeded, and set stablecoin in state.
+	require.NoError(t, err)
+	require.Equal(t, USDC, k.GetStablecoin(ctx))
+}
+
+func TestRequestSubscription(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Generate a user account.
+	user := utils.TestAccount()
+
+	// ACT: Attempt to request subscription with no stablecoin configured.
+	_, err := server.RequestSubscription(ctx, &types.MsgRequestSubscription{
+		Signer: user.Address,
+		Amount: math.NewInt(1_000_000),
+	})
+	// ASSERT: The action should've failed due to no stablecoin.
+	require.ErrorContains(t, err, "no stablecoin is configured")
+
+	// ARRANGE: Set stablecoin in state.
+	require.NoError(t, k.SetStablecoin(ctx, USDC))
+
+	// ACT: Attempt to request subscription with invalid amount.
+	_, err = server.RequestSubscription(ctx, &types.MsgRequestSubscription{
+		Signer: user.Address,
+		Amount: math.ZeroInt(),
+	})
+	// ASSERT: The action should've failed due to invalid amount.
+	require.ErrorContains(t, err, "amount must be positive")
+
+	// ACT: Attempt to request subscription with insufficient funds.
+	_, err = server.RequestSubscription(ctx, &types.MsgRequestSubscription{
+		Signer: user.Address,
+		Amount: math.NewInt(1_000_000),
+	})
+	// ASSERT: The action should've failed due to insufficient funds.
+	require.ErrorContains(t, err, "unable to transfer from user to module")
+
+	// ARRANGE: Give user 1 $USDC, and block the user.
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewInt64Coin(USDC.Denom, 1_000_000))
+	require.NoError(t, k.SetBlockedAddress(ctx, user.Bytes))
+
+	// ACT: Attempt to request subscription when blocked.
+	_, err = server.RequestSubscription(ctx, &types.MsgRequestSubscription{
+		Signer: user.Address,
+		Amount: math.NewInt(1_000_000),
+	})
+	// ASSERT: The action should've failed due to blocked user.
+	require.ErrorContains(t, err, "is blocked from receiving ausdy")
+
+	// ARRANGE: Unblock the user.
+	require.NoError(t, k.DeleteBlockedAddress(ctx, user.Bytes))
+
+	// ACT: Attempt to request subscription.
+	res, err := server.RequestSubscription(ctx, &types.MsgRequestSubscription{
+		Signer: user.Address,
+		Amount: math.NewInt(1_000_000),
+	})
+	// ASSERT: The action should've succeeded, and escrowed the deposit.
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Id)
+	require.True(t, bank.Balances[user.Address].IsZero())
+	require.Equal(t, int64(1_000_000), bank.Balances[types.ModuleAddress.String()].AmountOf(USDC.Denom).Int64())
+	subscription, found := k.GetSubscription(ctx, res.Id)
+	require.True(t, found)
+	require.Equal(t, user.Address, subscription.User)
+	require.Equal(t, types.RequestStatusPending, subscription.Status)
+	require.Equal(t, uint64(1), k.GetNextSubscriptionID(ctx))
+}
+
+func TestFulfillSubscription(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set stablecoin in state, and request a subscription of 1.05 $USDC.
+	require.NoError(t, k.SetStablecoin(ctx, USDC))
+	user := utils.TestAccount()
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewInt64Coin(USDC.Denom, 1_050_000))
+	res, err := server.RequestSubscription(ctx, &types.MsgRequestSubscription{
+		Signer: user.Address,
+		Amount: math.NewInt(1_050_000),
+	})
+	require.NoError(t, err)
+
+	// ACT: Attempt to fulfill subscription with invalid signer.
+	_, err = server.FulfillSubscription(ctx, &types.MsgFulfillSubscription{
+		Signer: utils.TestAccount().Address,
+		Id:     res.Id,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidMinter)
+
+	// ARRANGE: Set minter in state, with an allowance of 0.5 $USDY.
+	minter := utils.TestAccount()
+	require.NoError(t, k.SetMinter(ctx, k.Denom, minter.Bytes, ONE.QuoRaw(2)))
+
+	// ACT: Attempt to fulfill non-existent subscription.
+	_, err = server.FulfillSubscription(ctx, &types.MsgFulfillSubscription{
+		Signer: minter.Address,
+		Id:     1,
+		Price:  math.LegacyMustNewDecFromStr("1.05"),
+	})
+	// ASSERT: The action should've failed due to non-existent subscription.
+	require.ErrorContains(t, err, "subscription 1 does not exist")
+
+	// ACT: Attempt to fulfill subscription with invalid price.
+	_, err = server.FulfillSubscription(ctx, &types.MsgFulfillSubscription{
+		Signer: minter.Address,
+		Id:     res.Id,
+		Price:  math.LegacyZeroDec(),
+	})
+	// ASSERT: The action should've failed due to invalid price.
+	require.ErrorContains(t, err, "price must be positive")
+
+	// ACT: Attempt to fulfill subscription with insufficient allowance.
+	_, err = server.FulfillSubscription(ctx, &types.MsgFulfillSubscription{
+		Signer: minter.Address,
+		Id:     res.Id,
+		Price:  math.LegacyMustNewDecFromStr("1.05"),
+	})
+	// ASSERT: The action should've failed due to insufficient allowance.
+	require.ErrorIs(t, err, types.ErrInsufficientAllowance)
+
+	// ARRANGE: Increase the minter's allowance to 2 $USDY, and change the stablecoin.
+	require.NoError(t, k.SetMinter(ctx, k.Denom, minter.Bytes, ONE.MulRaw(2)))
+	require.NoError(t, k.SetStablecoin(ctx, types.Stablecoin{Denom: "uusdt", Exponent: 6}))
+
+	// ACT: Attempt to fulfill subscription made in a previous stablecoin.
+	_, err = server.FulfillSubscription(ctx, &types.MsgFulfillSubscription{
+		Signer: minter.Address,
+		Id:     res.Id,
+		Price:  math.LegacyMustNewDecFromStr("1.05"),
+	})
+	// ASSERT: The action should've failed due to stablecoin mismatch.
+	require.ErrorContains(t, err, "which is no longer the configured stablecoin")
+
+	// ARRANGE: Restore the stablecoin, and bind the minter to another recipient.
+	require.NoError(t, k.SetStablecoin(ctx, USDC))
+	require.NoError(t, k.SetMinterRecipient(ctx, k.Denom, minter.Bytes, utils.TestAccount().Bytes))
+
+	// ACT: Attempt to fulfill subscription of a user the minter isn't bound to.
+	_, err = server.FulfillSubscription(ctx, &types.MsgFulfillSubscription{
+		Signer: minter.Address,
+		Id:     res.Id,
+		Price:  math.LegacyMustNewDecFromStr("1.05"),
+	})
+	// ASSERT: The action should've failed due to invalid recipient.
+	require.ErrorIs(t, err, types.ErrInvalidRecipient)
+
+	// ARRANGE: Bind the minter to the user as well.
+	require.NoError(t, k.SetMinterRecipient(ctx, k.Denom, minter.Bytes, user.Bytes))
+
+	// ACT: Attempt to fulfill subscription.
+	_, err = server.FulfillSubscription(ctx, &types.MsgFulfillSubscription{
+		Signer: minter.Address,
+		Id:     res.Id,
+		Price:  math.LegacyMustNewDecFromStr("1.05"),
+	})
+	// ASSERT: The action should've succeeded, minting 1 $USDY to user and releasing deposit to minter.
+	require.NoError(t, err)
+	require.Equal(t, ONE, bank.Balances[user.Address].AmountOf(k.Denom))
+	require.Equal(t, int64(1_050_000), bank.Balances[minter.Address].AmountOf(USDC.Denom).Int64())
+	require.True(t, bank.Balances[types.ModuleAddress.String()].IsZero())
+	require.Equal(t, ONE, k.GetMinter(ctx, k.Denom, minter.Bytes))
+	subscription, _ := k.GetSubscription(ctx, res.Id)
+	require.Equal(t, types.RequestStatusFulfilled, subscription.Status)
+	require.Equal(t, ONE, subscription.Minted)
+
+	// ACT: Attempt to fulfill subscription again.
+	_, err = server.FulfillSubscription(ctx, &types.MsgFulfillSubscription{
+		Signer: minter.Address,
+		Id:     res.Id,
+		Price:  math.LegacyMustNewDecFromStr("1.05"),
+	})
+	// ASSERT: The action should've failed due to subscription no longer pending.
+	require.ErrorContains(t, err, "subscription 0 is not pending")
+}
+
+func TestRejectSubscription(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set stablecoin in state, and request a subscription of 1 $USDC.
+	require.NoError(t, k.SetStablecoin(ctx, USDC))
+	user := utils.TestAccount()
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewInt64Coin(USDC.Denom, 1_000_000))
+	res, err := server.RequestSubscription(ctx, &types.MsgRequestSubscription{
+		Signer: user.Address,
+		Amount: math.NewInt(1_000_000),
+	})
+	require.NoError(t, err)
+
+	// ACT: Attempt to reject subscription with invalid signer.
+	_, err = server.RejectSubscription(ctx, &types.MsgRejectSubscription{
+		Signer: utils.TestAccount().Address,
+		Id:     res.Id,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidMinter)
+
+	// ARRANGE: Set minter in state.
+	minter := utils.TestAccount()
+	require.NoError(t, k.SetMinter(ctx, k.Denom, minter.Bytes, ONE))
+
+	// ACT: Attempt to reject subscription.
+	_, err = server.RejectSubscription(ctx, &types.MsgRejectSubscription{
+		Signer: minter.Address,
+		Id:     res.Id,
+	})
+	// ASSERT: The action should've succeeded, and refunded the deposit.
+	require.NoError(t, err)
+	require.Equal(t, int64(1_000_000), bank.Balances[user.Address].AmountOf(USDC.Denom).Int64())
+	subscription, _ := k.GetSubscription(ctx, res.Id)
+	require.Equal(t, types.RequestStatusRejected, subscription.Status)
+
+	// ACT: Attempt to reject subscription again.
+	_, err = server.RejectSubscription(ctx, &types.MsgRejectSubscription{
+		Signer: minter.Address,
+		Id:     res.Id,
+	})
+	// ASSERT: The action should've failed due to subscription no longer pending.
+	require.ErrorContains(t, err, "subscription 0 is not pending")
+}
@@ -0,0 +1,287 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k msgServer) Swap(ctx context.Context, msg *types.MsgSwap) (*types.MsgSwapResponse, error) {
+	user, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode signer address %s", msg.Signer)
+	}
+
+	if k.GetSwapsPaused(ctx) {
+		return nil, errors.New("swaps are paused")
+	}
+	if msg.Amount.Amount.IsNil() || !msg.Amount.Amount.IsPositive() {
+		return nil, errors.New("amount must be positive")
+	}
+	if err := k.checkTransfer(ctx, user, user); err != nil {
+		return nil, err
+	}
+
+	stablecoin := k.GetStablecoin(ctx)
+	if !stablecoin.IsSet() {
+		return nil, errors.New("no stablecoin is configured")
+	}
+	price, found := k.GetPrice(ctx)
+	if !found {
+		return nil, errors.New("no price range has started")
+	}
+
+	config := k.GetSwapConfig(ctx)
+	facility := k.SwapFacilityAddress()
+	liquidity := k.GetSwapLiquidity(ctx)
+
+	var amountOut sdk.Coin
+	var volume, allowance math.Int
+
+	switch msg.Amount.Denom {
+	case stablecoin.Denom:
+		amount, err := stablecoin.ToUSDY(msg.Amount.Amount, price.Mul(math.LegacyOneDec().Add(config.Fee)))
+		if err != nil {
+			return nil, err
+		}
+		amountOut, volume = sdk.NewCoin(k.Denom, amount), amount
+
+		allowance = k.getMintAllowance(ctx, k.Denom, facility)
+		if allowance.LT(volume) {
+			return nil, sdkerrors.Wrapf(types.ErrInsufficientAllowance, "swap facility has a minter allowance of %s", allowance.String())
+		}
+		if !k.isPermittedRecipient(ctx, k.Denom, facility, user) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidRecipient, "swap facility cannot mint to %s", msg.Signer)
+		}
+	case k.Denom:
+		if err := k.checkBurn(ctx, k.Denom); err != nil {
+			return nil, err
+		}
+
+		amount := stablecoin.FromUSDY(msg.Amount.Amount, price.Mul(math.LegacyOneDec().Sub(config.Fee)))
+		amountOut, volume = sdk.NewCoin(stablecoin.Denom, amount), msg.Amount.Amount
+
+		allowance = k.getBurnAllowance(ctx, k.Denom, facility)
+		if allowance.LT(volume) {
+			return nil, sdkerrors.Wrapf(types.ErrInsufficientAllowance, "swap facility has a burner allowance of %s", allowance.String())
+		}
+		if !k.isPermittedSource(ctx, k.Denom, facility, user) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidSource, "swap facility cannot burn from %s", msg.Signer)
+		}
+		if liquidity.Amount.LT(amount) {
+			return nil, fmt.Errorf("swap facility has insufficient liquidity of %s", liquidity.Amount.String())
+		}
+		if err := k.checkLocked(ctx, user, msg.Amount.Amount); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unable to swap %s", msg.Amount.Denom)
+	}
+
+	if !amountOut.IsPositive() {
+		return nil, errors.New("amount out must be positive")
+	}
+	if !msg.MinAmountOut.IsNil() && amountOut.Amount.LT(msg.MinAmountOut) {
+		return nil, fmt.Errorf("amount out %s is less than minimum %s", amountOut.Amount.String(), msg.MinAmountOut.String())
+	}
+
+	total := k.GetSwapVolume(ctx, user).Add(volume)
+	if config.DailyLimit.IsPositive() && total.GT(config.DailyLimit) {
+		return nil, fmt.Errorf("swap exceeds daily limit of %s", config.DailyLimit.String())
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, user, types.ModuleName, sdk.NewCoins(msg.Amount))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to transfer from user to module")
+	}
+
+	if msg.Amount.Denom == k.Denom {
+		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(msg.Amount))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "unable to burn from module")
+		}
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, user, sdk.NewCoins(amountOut))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "unable to transfer from module to user")
+		}
+
+		if err := k.SetBurner(ctx, k.Denom, facility, allowance.Sub(volume)); err != nil {
+			return nil, err
+		}
+		if err := k.SetSwapLiquidity(ctx, liquidity.Sub(amountOut)); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := k.checkReserves(ctx, amountOut.Amount); err != nil {
+			return nil, err
+		}
+
+		err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amountOut))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "unable to mint to module")
+		}
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(types.WithMinting(ctx), types.ModuleName, user, sdk.NewCoins(amountOut))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "unable to transfer from module to user")
+		}
+		if err := k.lockMinted(ctx, user, amountOut.Amount); err != nil {
+			return nil, err
+		}
+
+		if err := k.SetMinter(ctx, k.Denom, facility, allowance.Sub(volume)); err != nil {
+			return nil, err
+		}
+		if err := k.SetSwapLiquidity(ctx, sdk.NewCoin(stablecoin.Denom, liquidity.Amount.Add(msg.Amount.Amount))); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.SetSwapVolume(ctx, user, types.SwapVolume{
+		Address: msg.Signer,
+		Day:     types.SwapDay(sdk.UnwrapSDKContext(ctx).BlockTime()),
+		Volume:  total,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSwapResponse{AmountOut: amountOut}, k.eventService.EventManager(ctx).Emit(ctx, &types.Swapped{
+		Account:   msg.Signer,
+		AmountIn:  msg.Amount,
+		AmountOut: amountOut,
+		Price:     price,
+	})
+}
+
+func (k msgServer) SetSwapConfig(ctx context.Context, msg *types.MsgSetSwapConfig) (*types.MsgSetSwapConfigResponse, error) {
+	if err := k.checkOwner(ctx, k.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	config := types.SwapConfig{Fee: msg.Fee, DailyLimit: msg.DailyLimit}
+	if err := config.Validate(); err != nil {
+		return nil, sdkerrors.Wrapf(err, "invalid swap config")
+	}
+
+	if err := k.Keeper.SetSwapConfig(ctx, config); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSwapConfigResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.SwapConfigUpdated{
+		Fee:        config.Fee,
+		DailyLimit: config.DailyLimit,
+	})
+}
+
+func (k msgServer) FundSwapFacility(ctx context.Context, msg *types.MsgFundSwapFacility) (*types.MsgFundSwapFacilityResponse, error) {
+	if err := k.checkOwner(ctx, k.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	signer, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode signer address %s", msg.Signer)
+	}
+
+	stablecoin := k.GetStablecoin(ctx)
+	if !stablecoin.IsSet() {
+		return nil, errors.New("no stablecoin is configured")
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return nil, errors.New("amount must be positive")
+	}
+
+	amount := sdk.NewCoin(stablecoin.Denom, msg.Amount)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, signer, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to transfer from owner to module")
+	}
+
+	liquidity := k.GetSwapLiquidity(ctx)
+	if err := k.SetSwapLiquidity(ctx, amount.AddAmount(liquidity.Amount)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundSwapFacilityResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.SwapFacilityFunded{
+		Amount: amount,
+	})
+}
+
+func (k msgServer) WithdrawSwapFacility(ctx context.Context, msg *types.MsgWithdrawSwapFacility) (*types.MsgWithdrawSwapFacilityResponse, error) {
+	if err := k.checkOwner(ctx, k.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	signer, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode signer address %s", msg.Signer)
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return nil, errors.New("amount must be positive")
+	}
+	liquidity := k.GetSwapLiquidity(ctx)
+	if liquidity.Amount.LT(msg.Amount) {
+		return nil, fmt.Errorf("swap facility has insufficient liquidity of %s", liquidity.Amount.String())
+	}
+
+	amount := sdk.NewCoin(liquidity.Denom, msg.Amount)
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, signer, sdk.NewCoins(amount))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to transfer from module to owner")
+	}
+
+	if err := k.SetSwapLiquidity(ctx, liquidity.Sub(amount)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawSwapFacilityResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.SwapFacilityWithdrawn{
+		Amount: amount,
+	})
+}
+
+func (k msgServer) PauseSwaps(ctx context.Context, msg *types.MsgPauseSwaps) (*types.MsgPauseSwapsResponse, error) {
+	pauser, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode pauser address %s", msg.Signer)
+	}
+	if !k.HasPauser(ctx, k.Denom, pauser) {
+		return nil, types.ErrInvalidPauser
+	}
+	if k.isBlocked(ctx, k.Denom, pauser) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockedAddress, "pauser %s", msg.Signer)
+	}
+	if k.GetSwapsPaused(ctx) {
+		return nil, errors.New("swaps are already paused")
+	}
+
+	if err := k.SetSwapsPaused(ctx, true); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPauseSwapsResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.SwapsPaused{
+		Account: msg.Signer,
+	})
+}
+
+func (k msgServer) UnpauseSwaps(ctx context.Context, msg *types.MsgUnpauseSwaps) (*types.MsgUnpauseSwapsResponse, error) {
+	if err := k.checkOwner(ctx, k.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if !k.GetSwapsPaused(ctx) {
+		return nil, errors.New("swaps are already unpaused")
+	}
+
+	if err := k.SetSwapsPaused(ctx, false); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnpauseSwapsResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.SwapsUnpaused{
+		Account: msg.Signer,
+	})
+}
@@ -0,0 +1,470 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwap(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Generate a user account.
+	user := utils.TestAccount()
+
+	// ACT: Attempt to swap with invalid signer address.
+	_, err := server.Swap(ctx, &types.MsgSwap{
+		Signer: user.Invalid,
+		Amount: sdk.NewCoin(USDC.Denom, math.NewInt(1_060_500)),
+	})
+	// ASSERT: The action should've failed due to invalid signer address.
+	require.ErrorContains(t, err, "unable to decode signer address")
+
+	// ARRANGE: Pause the swap facility.
+	require.NoError(t, k.SetSwapsPaused(ctx, true))
+
+	// ACT: Attempt to swap when swaps are paused.
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: user.Address,
+		Amount: sdk.NewCoin(USDC.Denom, math.NewInt(1_060_500)),
+	})
+	// ASSERT: The action should've failed due to swaps being paused.
+	require.ErrorContains(t, err, "swaps are paused")
+
+	// ARRANGE: Unpause the swap facility.
+	require.NoError(t, k.SetSwapsPaused(ctx, false))
+
+	// ACT: Attempt to swap with invalid amount.
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: user.Address,
+		Amount: sdk.Coin{Denom: USDC.Denom, Amount: math.ZeroInt()},
+	})
+	// ASSERT: The action should've failed due to invalid amount.
+	require.ErrorContains(t, err, "amount must be positive")
+
+	// ARRANGE: Block the user.
+	require.NoError(t, k.SetBlockedAddress(ctx, user.Bytes))
+
+	// ACT: Attempt to swap when blocked.
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: user.Address,
+		Amount: sdk.NewCoin(USDC.Denom, math.NewInt(1_060_500)),
+	})
+	// ASSERT: The action should've failed due to blocked user.
+	require.ErrorContains(t, err, "is blocked from sending ausdy")
+
+	// ARRANGE: Unblock the user.
+	require.NoError(t, k.DeleteBlockedAddress(ctx, user.Bytes))
+
+	// ACT: Attempt to swap with no stablecoin configured.
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: user.Address,
+		Amount: sdk.NewCoin(USDC.Denom, math.NewInt(1_060_500)),
+	})
+	// ASSERT: The action should've failed due to no stablecoin.
+	require.ErrorContains(t, err, "no stablecoin is configured")
+
+	// ARRANGE: Set stablecoin in state.
+	require.NoError(t, k.SetStablecoin(ctx, USDC))
+
+	// ACT: Attempt to swap with no price.
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: user.Address,
+		Amount: sdk.NewCoin(USDC.Denom, math.NewInt(1_060_500)),
+	})
+	// ASSERT: The action should've failed due to no price.
+	require.ErrorContains(t, err, "no price range has started")
+
+	// ARRANGE: Set a price of 1.05, a fee of 1%, and a daily limit of 1.5 $USDY.
+	SetPrice(t, k, ctx, math.LegacyMustNewDecFromStr("1.05"))
+	require.NoError(t, k.SetSwapConfig(ctx, types.SwapConfig{
+		Fee:        math.LegacyMustNewDecFromStr("0.01"),
+		DailyLimit: ONE.MulRaw(3).QuoRaw(2),
+	}))
+
+	// ACT: Attempt to swap an unsupported denom.
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: user.Address,
+		Amount: sdk.NewCoin("uatom", math.NewInt(1_000_000)),
+	})
+	// ASSERT: The action should've failed due to unsupported denom.
+	require.ErrorContains(t, err, "unable to swap uatom")
+
+	// ACT: Attempt to swap with no minter allowance.
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: user.Address,
+		Amount: sdk.NewCoin(USDC.Denom, math.NewInt(1_060_500)),
+	})
+	// ASSERT: The action should've failed due to insufficient allowance.
+	require.ErrorIs(t, err, types.ErrInsufficientAllowance)
+
+	// ARRANGE: Give the swap facility a minter and burner allowance of 2 $USDY.
+	require.NoError(t, k.SetMinter(ctx, k.Denom, k.SwapFacilityAddress(), ONE.MulRaw(2)))
+	require.NoError(t, k.SetBurner(ctx, k.Denom, k.SwapFacilityAddress(), ONE.MulRaw(2)))
+
+	// ARRANGE: Bind the swap facility to another recipient.
+	other := utils.TestAccount()
+	require.NoError(t, k.SetMinterRecipient(ctx, k.Denom, k.SwapFacilityAddress(), other.Bytes))
+
+	// ACT: Attempt to swap when the swap facility can't mint to the user.
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: user.Address,
+		Amount: sdk.NewCoin(USDC.Denom, math.NewInt(1_060_500)),
+	})
+	// ASSERT: The action should've failed due to invalid recipient.
+	require.ErrorIs(t, err, types.ErrInvalidRecipient)
+
+	// ARRANGE: Unbind the swap facility from the other recipient.
+	require.NoError(t, k.DeleteMinterRecipient(ctx, k.Denom, k.SwapFacilityAddress(), other.Bytes))
+
+	// ACT: Attempt to swap with too high a minimum amount out.
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer:       user.Address,
+		Amount:       sdk.NewCoin(USDC.Denom, math.NewInt(1_060_500)),
+		MinAmountOut: ONE.MulRaw(2),
+	})
+	// ASSERT: The action should've failed due to slippage protection.
+	require.ErrorContains(t, err, "is less than minimum")
+
+	// ACT: Attempt to swap with insufficient funds.
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: user.Address,
+		Amount: sdk.NewCoin(USDC.Denom, math.NewInt(1_060_500)),
+	})
+	// ASSERT: The action should've failed due to insufficient funds.
+	require.ErrorContains(t, err, "unable to transfer from user to module")
+
+	// ARRANGE: Give user 1.0605 $USDC.
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin(USDC.Denom, math.NewInt(1_060_500)))
+
+	// ACT: Attempt to swap $USDC for $USDY.
+	res, err := server.Swap(ctx, &types.MsgSwap{
+		Signer:       user.Address,
+		Amount:       sdk.NewCoin(USDC.Denom, math.NewInt(1_060_500)),
+		MinAmountOut: ONE,
+	})
+	// ASSERT: The action should've succeeded, and minted 1 $USDY at 1.05 plus fees.
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(k.Denom, ONE), res.AmountOut)
+	require.Equal(t, ONE, bank.Balances[user.Address].AmountOf(k.Denom))
+	require.True(t, bank.Balances[user.Address].AmountOf(USDC.Denom).IsZero())
+	require.Equal(t, sdk.NewCoin(USDC.Denom, math.NewInt(1_060_500)), k.GetSwapLiquidity(ctx))
+	require.Equal(t, ONE, k.GetMinter(ctx, k.Denom, k.SwapFacilityAddress()))
+	require.Equal(t, ONE, k.GetSwapVolume(ctx, user.Bytes))
+
+	// ACT: Attempt to swap beyond the daily limit.
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: user.Address,
+		Amount: sdk.NewCoin(k.Denom, ONE),
+	})
+	// ASSERT: The action should've failed due to the daily limit.
+	require.ErrorContains(t, err, "swap exceeds daily limit")
+
+	// ARRANGE: Advance to the next day.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.Day))
+	require.True(t, k.GetSwapVolume(ctx, user.Bytes).IsZero())
+
+	// ARRANGE: Bind the swap facility to another source.
+	require.NoError(t, k.SetBurnerSource(ctx, k.Denom, k.SwapFacilityAddress(), other.Bytes))
+
+	// ACT: Attempt to swap when the swap facility can't burn from the user.
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: user.Address,
+		Amount: sdk.NewCoin(k.Denom, ONE),
+	})
+	// ASSERT: The action should've failed due to invalid source.
+	require.ErrorIs(t, err, types.ErrInvalidSource)
+
+	// ARRANGE: Unbind the swap facility from the other source.
+	require.NoError(t, k.DeleteBurnerSource(ctx, k.Denom, k.SwapFacilityAddress(), other.Bytes))
+
+	// ACT: Attempt to swap $USDY for $USDC.
+	res, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: user.Address,
+		Amount: sdk.NewCoin(k.Denom, ONE),
+	})
+	// ASSERT: The action should've succeeded, and paid out $USDC at the price less fees.
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(USDC.Denom, math.NewInt(1_039_500)), res.AmountOut)
+	require.Equal(t, math.NewInt(1_039_500), bank.Balances[user.Address].AmountOf(USDC.Denom))
+	require.True(t, bank.Balances[user.Address].AmountOf(k.Denom).IsZero())
+	require.Equal(t, sdk.NewCoin(USDC.Denom, math.NewInt(21_000)), k.GetSwapLiquidity(ctx))
+	require.Equal(t, ONE, k.GetBurner(ctx, k.Denom, k.SwapFacilityAddress()))
+
+	// ARRANGE: Give user 0.1 $USDY.
+	bank.Balances[user.Address] = bank.Balances[user.Address].Add(sdk.NewCoin(k.Denom, ONE.QuoRaw(10)))
+
+	// ACT: Attempt to swap with insufficient liquidity.
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: user.Address,
+		Amount: sdk.NewCoin(k.Denom, ONE.QuoRaw(10)),
+	})
+	// ASSERT: The action should've failed due to insufficient liquidity.
+	require.ErrorContains(t, err, "swap facility has insufficient liquidity of 21000")
+}
+
+func TestSetSwapConfig(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to set swap config with no owner set.
+	_, err := server.SetSwapConfig(ctx, &types.MsgSetSwapConfig{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to set swap config with invalid signer.
+	_, err = server.SetSwapConfig(ctx, &types.MsgSetSwapConfig{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ACT: Attempt to set swap config with a fee of 100%.
+	_, err = server.SetSwapConfig(ctx, &types.MsgSetSwapConfig{
+		Signer:     owner.Address,
+		Fee:        math.LegacyOneDec(),
+		DailyLimit: math.ZeroInt(),
+	})
+	// ASSERT: The action should've failed due to invalid fee.
+	require.ErrorContains(t, err, "fee must be in the range [0, 1)")
+
+	// ACT: Attempt to set swap config with a negative daily limit.
+	_, err = server.SetSwapConfig(ctx, &types.MsgSetSwapConfig{
+		Signer:     owner.Address,
+		Fee:        math.LegacyZeroDec(),
+		DailyLimit: ONE.Neg(),
+	})
+	// ASSERT: The action should've failed due to invalid daily limit.
+	require.ErrorContains(t, err, "daily limit must be non-negative")
+
+	// ACT: Attempt to set swap config.
+	_, err = server.SetSwapConfig(ctx, &types.MsgSetSwapConfig{
+		Signer:     owner.Address,
+		Fee:        math.LegacyMustNewDecFromStr("0.01"),
+		DailyLimit: ONE,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	config := k.GetSwapConfig(ctx)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.01"), config.Fee)
+	require.Equal(t, ONE, config.DailyLimit)
+}
+
+func TestFundSwapFacility(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to fund with no owner set.
+	_, err := server.FundSwapFacility(ctx, &types.MsgFundSwapFacility{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to fund with invalid signer.
+	_, err = server.FundSwapFacility(ctx, &types.MsgFundSwapFacility{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ACT: Attempt to fund with no stablecoin configured.
+	_, err = server.FundSwapFacility(ctx, &types.MsgFundSwapFacility{
+		Signer: owner.Address,
+		Amount: math.NewInt(1_000_000),
+	})
+	// ASSERT: The action should've failed due to no stablecoin.
+	require.ErrorContains(t, err, "no stablecoin is configured")
+
+	// ARRANGE: Set stablecoin in state.
+	require.NoError(t, k.SetStablecoin(ctx, USDC))
+
+	// ACT: Attempt to fund with invalid amount.
+	_, err = server.FundSwapFacility(ctx, &types.MsgFundSwapFacility{
+		Signer: owner.Address,
+		Amount: math.ZeroInt(),
+	})
+	// ASSERT: The action should've failed due to invalid amount.
+	require.ErrorContains(t, err, "amount must be positive")
+
+	// ACT: Attempt to fund with insufficient funds.
+	_, err = server.FundSwapFacility(ctx, &types.MsgFundSwapFacility{
+		Signer: owner.Address,
+		Amount: math.NewInt(1_000_000),
+	})
+	// ASSERT: The action should've failed due to insufficient funds.
+	require.ErrorContains(t, err, "unable to transfer from owner to module")
+
+	// ARRANGE: Give owner 1 $USDC.
+	bank.Balances[owner.Address] = sdk.NewCoins(sdk.NewCoin(USDC.Denom, math.NewInt(1_000_000)))
+
+	// ACT: Attempt to fund.
+	_, err = server.FundSwapFacility(ctx, &types.MsgFundSwapFacility{
+		Signer: owner.Address,
+		Amount: math.NewInt(1_000_000),
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.True(t, bank.Balances[owner.Address].IsZero())
+	require.Equal(t, sdk.NewCoin(USDC.Denom, math.NewInt(1_000_000)), k.GetSwapLiquidity(ctx))
+
+	// ACT: Attempt to change the stablecoin while the facility holds liquidity.
+	_, err = server.SetStablecoin(ctx, &types.MsgSetStablecoin{
+		Signer:   owner.Address,
+		Denom:    "uusdt",
+		Exponent: 6,
+	})
+	// ASSERT: The action should've failed due to remaining liquidity.
+	require.ErrorContains(t, err, "swap facility still holds 1000000uusdc")
+}
+
+func TestWithdrawSwapFacility(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to withdraw with no owner set.
+	_, err := server.WithdrawSwapFacility(ctx, &types.MsgWithdrawSwapFacility{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state, and fund the swap facility with 1 $USDC.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+	require.NoError(t, k.SetStablecoin(ctx, USDC))
+	bank.Balances[owner.Address] = sdk.NewCoins(sdk.NewCoin(USDC.Denom, math.NewInt(1_000_000)))
+	_, err = server.FundSwapFacility(ctx, &types.MsgFundSwapFacility{
+		Signer: owner.Address,
+		Amount: math.NewInt(1_000_000),
+	})
+	require.NoError(t, err)
+
+	// ACT: Attempt to withdraw with invalid signer.
+	_, err = server.WithdrawSwapFacility(ctx, &types.MsgWithdrawSwapFacility{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ACT: Attempt to withdraw with invalid amount.
+	_, err = server.WithdrawSwapFacility(ctx, &types.MsgWithdrawSwapFacility{
+		Signer: owner.Address,
+		Amount: math.ZeroInt(),
+	})
+	// ASSERT: The action should've failed due to invalid amount.
+	require.ErrorContains(t, err, "amount must be positive")
+
+	// ACT: Attempt to withdraw more than the liquidity.
+	_, err = server.WithdrawSwapFacility(ctx, &types.MsgWithdrawSwapFacility{
+		Signer: owner.Address,
+		Amount: math.NewInt(2_000_000),
+	})
+	// ASSERT: The action should've failed due to insufficient liquidity.
+	require.ErrorContains(t, err, "swap facility has insufficient liquidity of 1000000")
+
+	// ACT: Attempt to withdraw.
+	_, err = server.WithdrawSwapFacility(ctx, &types.MsgWithdrawSwapFacility{
+		Signer: owner.Address,
+		Amount: math.NewInt(1_000_000),
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1_000_000), bank.Balances[owner.Address].AmountOf(USDC.Denom))
+	require.True(t, k.GetSwapLiquidity(ctx).IsZero())
+}
+
+func TestPauseSwaps(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set pauser in state.
+	pauser := utils.TestAccount()
+	require.NoError(t, k.SetPauser(ctx, k.Denom, pauser.Bytes))
+
+	// ACT: Attempt to pause swaps with invalid signer.
+	_, err := server.PauseSwaps(ctx, &types.MsgPauseSwaps{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidPauser.Error())
+
+	// ACT: Attempt to pause swaps.
+	_, err = server.PauseSwaps(ctx, &types.MsgPauseSwaps{
+		Signer: pauser.Address,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.True(t, k.GetSwapsPaused(ctx))
+
+	// ACT: Attempt to pause swaps again.
+	_, err = server.PauseSwaps(ctx, &types.MsgPauseSwaps{
+		Signer: pauser.Address,
+	})
+	// ASSERT: The action should've failed due to swaps already being paused.
+	require.ErrorContains(t, err, "swaps are already paused")
+}
+
+func TestUnpauseSwaps(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to unpause swaps with no owner set.
+	_, err := server.UnpauseSwaps(ctx, &types.MsgUnpauseSwaps{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to unpause swaps with invalid signer.
+	_, err = server.UnpauseSwaps(ctx, &types.MsgUnpauseSwaps{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ACT: Attempt to unpause swaps when not paused.
+	_, err = server.UnpauseSwaps(ctx, &types.MsgUnpauseSwaps{
+		Signer: owner.Address,
+	})
+	// ASSERT: The action should've failed due to swaps already being unpaused.
+	require.ErrorContains(t, err, "swaps are already unpaused")
+
+	// ARRANGE: Pause swaps.
+	require.NoError(t, k.SetSwapsPaused(ctx, true))
+
+	// ACT: Attempt to unpause swaps.
+	_, err = server.UnpauseSwaps(ctx, &types.MsgUnpauseSwaps{
+		Signer: owner.Address,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.False(t, k.GetSwapsPaused(ctx))
+}
@@ -1,13 +1,17 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ondoprotocol/usdy-noble/v2/keeper"
 	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types/blocklist"
 	"github.com/ondoprotocol/usdy-noble/v2/utils"
 	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
 	"github.com/stretchr/testify/require"
@@ -25,7 +29,7 @@ func TestBurn(t *testing.T) {
 
 	// ARRANGE: Set burner in state, with enough allowance for a single burn.
 	burner := utils.TestAccount()
-	require.NoError(t, k.SetBurner(ctx, burner.Address, ONE))
+	require.NoError(t, k.SetBurner(ctx, k.Denom, burner.Bytes, ONE))
 
 	// ACT: Attempt to burn with invalid signer.
 	_, err := server.Burn(ctx, &types.MsgBurn{
@@ -64,14 +68,25 @@ func TestBurn(t *testing.T) {
 	// ASSERT: The action should've failed due to insufficient funds.
 	require.ErrorContains(t, err, "unable to transfer from user to module")
 
-	// ARRANGE: Give user 1 $USDY.
+	// ARRANGE: Give user 1 $USDY, and pause burning.
 	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+	require.NoError(t, k.SetPaused(ctx, k.Denom, types.PauseScopeBurn, true))
+
+	// ACT: Attempt to burn while burning is paused.
+	_, err = server.Burn(ctx, &types.MsgBurn{
+		Signer: burner.Address,
+		From:   user.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to paused burning.
+	require.ErrorContains(t, err, "burning ausdy is paused")
+	require.NoError(t, k.SetPaused(ctx, k.Denom, types.PauseScopeBurn, false))
 
 	// ARRANGE: Set up a failing collection store for the attribute setter.
 	tmp := k.Burners
 	k.Burners = collections.NewMap(
 		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
-		types.BurnerPrefix, "burners", collections.StringKey, sdk.IntValue,
+		types.BurnerPrefix, "burners", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), sdk.IntValue,
 	)
 
 	// ACT: Attempt to burn with failing Burners collection store.
@@ -97,7 +112,7 @@ func TestBurn(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, bank.Balances[user.Address].IsZero())
 	require.True(t, bank.Balances[types.ModuleName].IsZero())
-	require.True(t, k.GetBurner(ctx, burner.Address).IsZero())
+	require.True(t, k.GetBurner(ctx, k.Denom, burner.Bytes).IsZero())
 
 	// ACT: Attempt another burn with insufficient allowance.
 	_, err = server.Burn(ctx, &types.MsgBurn{
@@ -119,7 +134,7 @@ func TestMint(t *testing.T) {
 
 	// ARRANGE: Set minter in state, with enough allowance for a single mint.
 	minter := utils.TestAccount()
-	require.NoError(t, k.SetMinter(ctx, minter.Address, ONE))
+	require.NoError(t, k.SetMinter(ctx, k.Denom, minter.Bytes, ONE))
 
 	// ACT: Attempt to mint with invalid signer.
 	_, err := server.Mint(ctx, &types.MsgMint{
@@ -166,7 +181,7 @@ func TestMint(t *testing.T) {
 	tmp := k.Minters
 	k.Minters = collections.NewMap(
 		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
-		types.MinterPrefix, "minters", collections.StringKey, sdk.IntValue,
+		types.MinterPrefix, "minters", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), sdk.IntValue,
 	)
 
 	// ACT: Attempt to mint with failing Minters collection store.
@@ -189,7 +204,7 @@ func TestMint(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, ONE.MulRaw(2), bank.Balances[user.Address].AmountOf(k.Denom))
 	require.True(t, bank.Balances[types.ModuleName].IsZero())
-	require.True(t, k.GetMinter(ctx, minter.Address).IsZero())
+	require.True(t, k.GetMinter(ctx, k.Denom, minter.Bytes).IsZero())
 
 	// ACT: Attempt another mint with insufficient allowance.
 	_, err = server.Mint(ctx, &types.MsgMint{
@@ -207,7 +222,7 @@ func TestPause(t *testing.T) {
 
 	// ARRANGE: Set pauser in state.
 	pauser := utils.TestAccount()
-	require.NoError(t, k.SetPauser(ctx, pauser.Address))
+	require.NoError(t, k.SetPauser(ctx, k.Denom, pauser.Bytes))
 
 	// ACT: Attempt to pause with invalid signer.
 	_, err := server.Pause(ctx, &types.MsgPause{
@@ -215,13 +230,13 @@ func TestPause(t *testing.T) {
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorContains(t, err, types.ErrInvalidPauser.Error())
-	require.False(t, k.GetPaused(ctx))
+	require.Empty(t, k.GetPausedScopes(ctx, k.Denom))
 
 	// ARRANGE: Set up a failing collection store for the attribute setter.
 	tmp := k.Paused
-	k.Paused = collections.NewItem(
+	k.Paused = collections.NewKeySet(
 		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
-		types.PausedKey, "paused", collections.BoolValue,
+		types.PausedPrefix, "paused", collections.PairKeyCodec(collections.StringKey, types.PauseScopeKey),
 	)
 
 	// ACT: Attempt to pause with failing Paused collection store.
@@ -236,9 +251,10 @@ func TestPause(t *testing.T) {
 	_, err = server.Pause(ctx, &types.MsgPause{
 		Signer: pauser.Address,
 	})
-	// ASSERT: The action should've succeeded.
+	// ASSERT: The action should've succeeded, and paused all scopes but burning.
 	require.NoError(t, err)
-	require.True(t, k.GetPaused(ctx))
+	require.Equal(t, types.DefaultPauseScopes, k.GetPausedScopes(ctx, k.Denom))
+	require.False(t, k.IsPaused(ctx, k.Denom, types.PauseScopeBurn))
 
 	// ACT: Attempt to pause again.
 	_, err = server.Pause(ctx, &types.MsgPause{
@@ -246,7 +262,80 @@ func TestPause(t *testing.T) {
 	})
 	// ASSERT: The action should've failed due to module being paused already.
 	require.ErrorContains(t, err, "module is already paused")
-	require.True(t, k.GetPaused(ctx))
+	require.Equal(t, types.DefaultPauseScopes, k.GetPausedScopes(ctx, k.Denom))
+}
+
+func TestPauseScope(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set pauser in state.
+	pauser := utils.TestAccount()
+	require.NoError(t, k.SetPauser(ctx, k.Denom, pauser.Bytes))
+
+	// ACT: Attempt to pause with invalid scope.
+	_, err := server.Pause(ctx, &types.MsgPause{
+		Signer: pauser.Address,
+		Scope:  types.PauseScope(99),
+	})
+	// ASSERT: The action should've failed due to invalid scope.
+	require.ErrorContains(t, err, "invalid pause scope (99)")
+	require.Empty(t, k.GetPausedScopes(ctx, k.Denom))
+
+	// ACT: Attempt to pause burning.
+	_, err = server.Pause(ctx, &types.MsgPause{
+		Signer: pauser.Address,
+		Scope:  types.PauseScopeBurn,
+	})
+	// ASSERT: The action should've succeeded, and only paused burning.
+	require.NoError(t, err)
+	require.Equal(t, []types.PauseScope{types.PauseScopeBurn}, k.GetPausedScopes(ctx, k.Denom))
+
+	// ACT: Attempt to pause burning again.
+	_, err = server.Pause(ctx, &types.MsgPause{
+		Signer: pauser.Address,
+		Scope:  types.PauseScopeBurn,
+	})
+	// ASSERT: The action should've failed due to burning being paused already.
+	require.ErrorContains(t, err, "module is already paused")
+
+	// ACT: Attempt to pause all scopes.
+	_, err = server.Pause(ctx, &types.MsgPause{
+		Signer: pauser.Address,
+	})
+	// ASSERT: The action should've succeeded, and paused the remaining scopes.
+	require.NoError(t, err)
+	require.Equal(t, types.PauseScopes, k.GetPausedScopes(ctx, k.Denom))
+	require.Len(t, ctx.EventManager().Events(), len(types.PauseScopes))
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to unpause with invalid scope.
+	_, err = server.Unpause(ctx, &types.MsgUnpause{
+		Signer: owner.Address,
+		Scope:  types.PauseScope(99),
+	})
+	// ASSERT: The action should've failed due to invalid scope.
+	require.ErrorContains(t, err, "invalid pause scope (99)")
+
+	// ACT: Attempt to unpause IBC outflows.
+	_, err = server.Unpause(ctx, &types.MsgUnpause{
+		Signer: owner.Address,
+		Scope:  types.PauseScopeIBCOutflows,
+	})
+	// ASSERT: The action should've succeeded, and only unpaused IBC outflows.
+	require.NoError(t, err)
+	require.Equal(t, []types.PauseScope{types.PauseScopeTransfers, types.PauseScopeMint, types.PauseScopeBurn}, k.GetPausedScopes(ctx, k.Denom))
+
+	// ACT: Attempt to unpause IBC outflows again.
+	_, err = server.Unpause(ctx, &types.MsgUnpause{
+		Signer: owner.Address,
+		Scope:  types.PauseScopeIBCOutflows,
+	})
+	// ASSERT: The action should've failed due to IBC outflows being unpaused already.
+	require.ErrorContains(t, err, "module is already unpaused")
 }
 
 func TestUnpause(t *testing.T) {
@@ -254,17 +343,17 @@ func TestUnpause(t *testing.T) {
 	server := keeper.NewMsgServer(k)
 
 	// ARRANGE: Set paused state to true.
-	require.NoError(t, k.SetPaused(ctx, true))
+	SetPaused(t, k, ctx, k.Denom, true)
 
 	// ACT: Attempt to unpause with no owner set.
 	_, err := server.Unpause(ctx, &types.MsgUnpause{})
 	// ASSERT: The action should've failed due to no owner set.
 	require.ErrorContains(t, err, "there is no owner")
-	require.True(t, k.GetPaused(ctx))
+	require.Equal(t, types.PauseScopes, k.GetPausedScopes(ctx, k.Denom))
 
 	// ARRANGE: Set owner in state.
 	owner := utils.TestAccount()
-	require.NoError(t, k.SetOwner(ctx, owner.Address))
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
 
 	// ACT: Attempt to unpause with invalid signer.
 	_, err = server.Unpause(ctx, &types.MsgUnpause{
@@ -272,20 +361,20 @@ func TestUnpause(t *testing.T) {
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
-	require.True(t, k.GetPaused(ctx))
+	require.Equal(t, types.PauseScopes, k.GetPausedScopes(ctx, k.Denom))
 
-	// ARRANGE: Set up a failing collection store for the attribute setter.
+	// ARRANGE: Set up a failing collection store for the attribute remover.
 	tmp := k.Paused
-	k.Paused = collections.NewItem(
-		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
-		types.PausedKey, "paused", collections.BoolValue,
+	k.Paused = collections.NewKeySet(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Delete, utils.GetKVStore(ctx, types.ModuleName))),
+		types.PausedPrefix, "paused", collections.PairKeyCodec(collections.StringKey, types.PauseScopeKey),
 	)
 
 	// ACT: Attempt to unpause with failing Paused collection store.
 	_, err = server.Unpause(ctx, &types.MsgUnpause{
 		Signer: owner.Address,
 	})
-	// ASSERT: The action should've failed due to collection store setter error.
+	// ASSERT: The action should've failed due to collection store remover error.
 	require.Error(t, err, mocks.ErrorStoreAccess)
 	k.Paused = tmp
 
@@ -295,7 +384,7 @@ func TestUnpause(t *testing.T) {
 	})
 	// ASSERT: The action should've succeeded.
 	require.NoError(t, err)
-	require.False(t, k.GetPaused(ctx))
+	require.Empty(t, k.GetPausedScopes(ctx, k.Denom))
 
 	// ACT: Attempt to unpause again.
 	_, err = server.Unpause(ctx, &types.MsgUnpause{
@@ -303,7 +392,162 @@ func TestUnpause(t *testing.T) {
 	})
 	// ASSERT: The action should've failed due to module being unpaused already.
 	require.ErrorContains(t, err, "module is already unpaused")
-	require.False(t, k.GetPaused(ctx))
+	require.Empty(t, k.GetPausedScopes(ctx, k.Denom))
+}
+
+func TestPauseWithEnd(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1_700_000_000, 0))
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set pauser in state.
+	pauser := utils.TestAccount()
+	require.NoError(t, k.SetPauser(ctx, k.Denom, pauser.Bytes))
+
+	// ACT: Attempt to pause with an end height in the past.
+	_, err := server.Pause(ctx, &types.MsgPause{
+		Signer:    pauser.Address,
+		Scope:     types.PauseScopeMint,
+		EndHeight: 10,
+	})
+	// ASSERT: The action should've failed due to invalid end height.
+	require.ErrorContains(t, err, "end height must be after current height 10")
+
+	// ACT: Attempt to pause with an end time in the past.
+	_, err = server.Pause(ctx, &types.MsgPause{
+		Signer:  pauser.Address,
+		Scope:   types.PauseScopeMint,
+		EndTime: ctx.BlockTime().Add(-time.Hour),
+	})
+	// ASSERT: The action should've failed due to invalid end time.
+	require.ErrorContains(t, err, "end time must be after current time")
+
+	// ARRANGE: Schedule a pause of minting.
+	require.NoError(t, k.SetPauseWindow(ctx, types.PauseWindow{Denom: k.Denom, Scope: types.PauseScopeMint, StartHeight: 20}))
+
+	// ACT: Attempt to pause minting until a block height.
+	_, err = server.Pause(ctx, &types.MsgPause{
+		Signer:    pauser.Address,
+		Scope:     types.PauseScopeMint,
+		EndHeight: 15,
+	})
+	// ASSERT: The action should've succeeded, and replaced the scheduled pause.
+	require.NoError(t, err)
+	require.True(t, k.IsPaused(ctx, k.Denom, types.PauseScopeMint))
+	window, found := k.GetPauseWindow(ctx, k.Denom, types.PauseScopeMint)
+	require.True(t, found)
+	require.Equal(t, types.PauseWindow{Denom: k.Denom, Scope: types.PauseScopeMint, EndHeight: 15}, window)
+
+	// ACT: Attempt to pause burning without an end.
+	_, err = server.Pause(ctx, &types.MsgPause{
+		Signer: pauser.Address,
+		Scope:  types.PauseScopeBurn,
+	})
+	// ASSERT: The action should've succeeded, without storing a window.
+	require.NoError(t, err)
+	require.True(t, k.IsPaused(ctx, k.Denom, types.PauseScopeBurn))
+	_, found = k.GetPauseWindow(ctx, k.Denom, types.PauseScopeBurn)
+	require.False(t, found)
+}
+
+func TestSchedulePause(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1_700_000_000, 0))
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to schedule a pause with no owner set.
+	_, err := server.SchedulePause(ctx, &types.MsgSchedulePause{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to schedule a pause with invalid signer.
+	_, err = server.SchedulePause(ctx, &types.MsgSchedulePause{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ACT: Attempt to schedule a pause with invalid scope.
+	_, err = server.SchedulePause(ctx, &types.MsgSchedulePause{
+		Signer:      owner.Address,
+		Scope:       types.PauseScope(99),
+		StartHeight: 20,
+	})
+	// ASSERT: The action should've failed due to invalid scope.
+	require.ErrorContains(t, err, "invalid pause scope (99)")
+
+	// ACT: Attempt to schedule a pause without a start.
+	_, err = server.SchedulePause(ctx, &types.MsgSchedulePause{
+		Signer:    owner.Address,
+		EndHeight: 20,
+	})
+	// ASSERT: The action should've failed due to missing start.
+	require.ErrorContains(t, err, "start height or time must be provided")
+
+	// ACT: Attempt to schedule a pause starting in the past.
+	_, err = server.SchedulePause(ctx, &types.MsgSchedulePause{
+		Signer:    owner.Address,
+		StartTime: ctx.BlockTime(),
+	})
+	// ASSERT: The action should've failed due to invalid start time.
+	require.ErrorContains(t, err, "start time must be after current time")
+
+	// ACT: Attempt to schedule a pause ending before it starts.
+	_, err = server.SchedulePause(ctx, &types.MsgSchedulePause{
+		Signer:      owner.Address,
+		StartHeight: 20,
+		EndHeight:   15,
+	})
+	// ASSERT: The action should've failed due to invalid end height.
+	require.ErrorContains(t, err, "end height must be after start height")
+
+	// ARRANGE: Pause minting.
+	require.NoError(t, k.SetPaused(ctx, k.Denom, types.PauseScopeMint, true))
+
+	// ACT: Attempt to schedule a pause of minting.
+	_, err = server.SchedulePause(ctx, &types.MsgSchedulePause{
+		Signer:      owner.Address,
+		Scope:       types.PauseScopeMint,
+		StartHeight: 20,
+	})
+	// ASSERT: The action should've succeeded, even though minting is paused already.
+	require.NoError(t, err)
+	window, found := k.GetPauseWindow(ctx, k.Denom, types.PauseScopeMint)
+	require.True(t, found)
+	require.Equal(t, int64(20), window.StartHeight)
+
+	// ACT: Attempt to schedule a pause of all scopes.
+	_, err = server.SchedulePause(ctx, &types.MsgSchedulePause{
+		Signer:      owner.Address,
+		StartHeight: 20,
+		EndTime:     ctx.BlockTime().Add(time.Hour),
+	})
+	// ASSERT: The action should've succeeded, and scheduled all scopes.
+	require.NoError(t, err)
+	require.Len(t, k.GetPauseWindows(ctx), len(types.DefaultPauseScopes))
+	window, found = k.GetPauseWindow(ctx, k.Denom, types.PauseScopeTransfers)
+	require.True(t, found)
+	require.Equal(t, int64(20), window.StartHeight)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), window.EndTime)
+	window, found = k.GetPauseWindow(ctx, k.Denom, types.PauseScopeMint)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), window.EndTime)
+	require.False(t, k.IsPaused(ctx, k.Denom, types.PauseScopeTransfers))
+
+	// ACT: Attempt to cancel the scheduled pause of transfers.
+	_, err = server.Unpause(ctx, &types.MsgUnpause{
+		Signer: owner.Address,
+		Scope:  types.PauseScopeTransfers,
+	})
+	// ASSERT: The action should've succeeded, and removed the scheduled pause.
+	require.NoError(t, err)
+	_, found = k.GetPauseWindow(ctx, k.Denom, types.PauseScopeTransfers)
+	require.False(t, found)
+	require.Len(t, k.GetPauseWindows(ctx), len(types.DefaultPauseScopes)-1)
 }
 
 func TestTransferOwnership(t *testing.T) {
@@ -317,7 +561,7 @@ func TestTransferOwnership(t *testing.T) {
 
 	// ARRANGE: Set owner in state.
 	owner := utils.TestAccount()
-	require.NoError(t, k.SetOwner(ctx, owner.Address))
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
 
 	// ACT: Attempt to transfer ownership with invalid signer.
 	_, err = server.TransferOwnership(ctx, &types.MsgTransferOwnership{
@@ -339,9 +583,9 @@ func TestTransferOwnership(t *testing.T) {
 
 	// ARRANGE: Set up a failing collection store for the attribute setter.
 	tmp := k.PendingOwner
-	k.PendingOwner = collections.NewItem(
+	k.PendingOwner = collections.NewMap(
 		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
-		types.PendingOwnerKey, "pending_owner", collections.StringValue,
+		types.PendingOwnerPrefix, "pending_owner", collections.StringKey, collections.StringValue,
 	)
 
 	// ACT: Attempt to transfer ownership with failing PendingOwner collection store.
@@ -360,7 +604,7 @@ func TestTransferOwnership(t *testing.T) {
 	})
 	// ASSERT: The action should've succeeded, and set a pending owner in state.
 	require.NoError(t, err)
-	require.Equal(t, pendingOwner.Address, k.GetPendingOwner(ctx))
+	require.Equal(t, pendingOwner.Address, k.GetPendingOwner(ctx, k.Denom))
 }
 
 func TestAcceptOwnership(t *testing.T) {
@@ -374,7 +618,7 @@ func TestAcceptOwnership(t *testing.T) {
 
 	// ARRANGE: Set pending owner in state.
 	pendingOwner := utils.TestAccount()
-	require.NoError(t, k.SetPendingOwner(ctx, pendingOwner.Address))
+	require.NoError(t, k.SetPendingOwner(ctx, k.Denom, pendingOwner.Address))
 
 	// ACT: Attempt to accept ownership with invalid signer.
 	_, err = server.AcceptOwnership(ctx, &types.MsgAcceptOwnership{
@@ -385,9 +629,9 @@ func TestAcceptOwnership(t *testing.T) {
 
 	// ARRANGE: Set up a failing collection store for the attribute setter.
 	tmp := k.Owner
-	k.Owner = collections.NewItem(
+	k.Owner = collections.NewMap(
 		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
-		types.OwnerKey, "owner", collections.StringValue,
+		types.OwnerPrefix, "owner", collections.StringKey, collections.StringValue,
 	)
 
 	// ACT: Attempt to accept ownership with failing Owner collection store.
@@ -400,9 +644,9 @@ func TestAcceptOwnership(t *testing.T) {
 
 	// ARRANGE: Set up a failing collection store for the attribute delete.
 	tmp = k.PendingOwner
-	k.PendingOwner = collections.NewItem(
+	k.PendingOwner = collections.NewMap(
 		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Delete, utils.GetKVStore(ctx, types.ModuleName))),
-		types.PendingOwnerKey, "pending_owner", collections.StringValue,
+		types.PendingOwnerPrefix, "pending_owner", collections.StringKey, collections.StringValue,
 	)
 
 	// ACT: Attempt to accept ownership with failing PendingOwner collection store.
@@ -419,8 +663,162 @@ func TestAcceptOwnership(t *testing.T) {
 	})
 	// ASSERT: The action should've succeeded, and updated the owner in state.
 	require.NoError(t, err)
-	require.Equal(t, pendingOwner.Address, k.GetOwner(ctx))
-	require.Empty(t, k.GetPendingOwner(ctx))
+	require.Equal(t, pendingOwner.Address, k.GetOwner(ctx, k.Denom))
+	require.Empty(t, k.GetPendingOwner(ctx, k.Denom))
+}
+
+func TestForceOwnership(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set owner, pending owner, blocklist owner, and blocklist pending owner in state.
+	owner, pendingOwner := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+	require.NoError(t, k.SetPendingOwner(ctx, k.Denom, pendingOwner.Address))
+	require.NoError(t, k.SetBlocklistOwner(ctx, owner.Address))
+	require.NoError(t, k.SetBlocklistPendingOwner(ctx, pendingOwner.Address))
+
+	// ACT: Attempt to force ownership with the owner as signer.
+	_, err := server.ForceOwnership(ctx, &types.MsgForceOwnership{
+		Signer: owner.Address,
+		Owner:  pendingOwner.Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidAuthority.Error())
+
+	// ACT: Attempt to force ownership of an ungoverned denom.
+	_, err = server.ForceOwnership(ctx, &types.MsgForceOwnership{
+		Signer: mocks.Authority,
+		Denom:  "uusdc",
+		Owner:  pendingOwner.Address,
+	})
+	// ASSERT: The action should've failed due to ungoverned denom.
+	require.ErrorContains(t, err, "uusdc is not governed by this module")
+
+	// ACT: Attempt to force ownership without any owner.
+	_, err = server.ForceOwnership(ctx, &types.MsgForceOwnership{
+		Signer: mocks.Authority,
+	})
+	// ASSERT: The action should've failed due to no owner provided.
+	require.ErrorContains(t, err, "owner or blocklist owner must be provided")
+
+	// ACT: Attempt to force ownership with an invalid owner address.
+	_, err = server.ForceOwnership(ctx, &types.MsgForceOwnership{
+		Signer: mocks.Authority,
+		Owner:  "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+	})
+	// ASSERT: The action should've failed due to invalid owner address.
+	require.ErrorContains(t, err, "unable to decode owner address")
+
+	// ACT: Attempt to force ownership with an invalid blocklist owner address.
+	_, err = server.ForceOwnership(ctx, &types.MsgForceOwnership{
+		Signer:         mocks.Authority,
+		BlocklistOwner: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+	})
+	// ASSERT: The action should've failed due to invalid blocklist owner address.
+	require.ErrorContains(t, err, "unable to decode blocklist owner address")
+
+	// ARRANGE: Set up a failing collection store for the attribute setter.
+	tmp := k.Owner
+	k.Owner = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.OwnerPrefix, "owner", collections.StringKey, collections.StringValue,
+	)
+
+	// ACT: Attempt to force ownership with failing Owner collection store.
+	_, err = server.ForceOwnership(ctx, &types.MsgForceOwnership{
+		Signer: mocks.Authority,
+		Owner:  pendingOwner.Address,
+	})
+	// ASSERT: The action should've failed due to collection store setter error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.Owner = tmp
+
+	// ARRANGE: Generate a new owner account.
+	newOwner := utils.TestAccount()
+
+	// ACT: Attempt to force ownership of USDY.
+	_, err = server.ForceOwnership(ctx, &types.MsgForceOwnership{
+		Signer: mocks.Authority,
+		Owner:  newOwner.Address,
+	})
+	// ASSERT: The action should've succeeded, and only updated the owner of USDY.
+	require.NoError(t, err)
+	require.Equal(t, newOwner.Address, k.GetOwner(ctx, k.Denom))
+	require.Empty(t, k.GetPendingOwner(ctx, k.Denom))
+	require.Empty(t, k.GetOwner(ctx, TBILL))
+	require.Equal(t, owner.Address, k.GetBlocklistOwner(ctx))
+	require.Equal(t, pendingOwner.Address, k.GetBlocklistPendingOwner(ctx))
+
+	// ACT: Attempt to force ownership of TBILL and the blocklist.
+	_, err = server.ForceOwnership(ctx, &types.MsgForceOwnership{
+		Signer:         mocks.Authority,
+		Denom:          TBILL,
+		Owner:          newOwner.Address,
+		BlocklistOwner: newOwner.Address,
+	})
+	// ASSERT: The action should've succeeded, and updated both owners in state.
+	require.NoError(t, err)
+	require.Equal(t, newOwner.Address, k.GetOwner(ctx, TBILL))
+	require.Equal(t, newOwner.Address, k.GetBlocklistOwner(ctx))
+	require.Empty(t, k.GetBlocklistPendingOwner(ctx))
+}
+
+func TestAuthorityFallbackOwner(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+	blocklistServer := keeper.NewBlocklistMsgServer(k)
+	pauser, user := utils.TestAccount(), utils.TestAccount()
+
+	// ACT: Attempt to add pauser with the authority and no owner set.
+	_, err := server.AddPauser(ctx, &types.MsgAddPauser{
+		Signer: mocks.Authority,
+		Pauser: pauser.Address,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.True(t, k.HasPauser(ctx, k.Denom, pauser.Bytes))
+
+	// ACT: Attempt to add to blocklist with the authority and no blocklist owner set.
+	_, err = blocklistServer.AddToBlocklist(ctx, &blocklist.MsgAddToBlocklist{
+		Signer:   mocks.Authority,
+		Accounts: []string{user.Address},
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.True(t, k.HasBlockedAddress(ctx, user.Bytes))
+
+	// ARRANGE: Set owner and blocklist owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+	require.NoError(t, k.SetBlocklistOwner(ctx, owner.Address))
+
+	// ACT: Attempt to remove pauser with the authority.
+	_, err = server.RemovePauser(ctx, &types.MsgRemovePauser{
+		Signer: mocks.Authority,
+		Pauser: pauser.Address,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.False(t, k.HasPauser(ctx, k.Denom, pauser.Bytes))
+
+	// ACT: Attempt to remove from blocklist with the authority.
+	_, err = blocklistServer.RemoveFromBlocklist(ctx, &blocklist.MsgRemoveFromBlocklist{
+		Signer:   mocks.Authority,
+		Accounts: []string{user.Address},
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.False(t, k.HasBlockedAddress(ctx, user.Bytes))
+
+	// ACT: Attempt to transfer ownership with the authority.
+	_, err = server.TransferOwnership(ctx, &types.MsgTransferOwnership{
+		Signer:   mocks.Authority,
+		NewOwner: user.Address,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, user.Address, k.GetPendingOwner(ctx, k.Denom))
 }
 
 func TestAddBurner(t *testing.T) {
@@ -434,7 +832,7 @@ func TestAddBurner(t *testing.T) {
 
 	// ARRANGE: Set owner in state.
 	owner := utils.TestAccount()
-	require.NoError(t, k.SetOwner(ctx, owner.Address))
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
 
 	// ACT: Attempt to add burner with invalid signer.
 	_, err = server.AddBurner(ctx, &types.MsgAddBurner{
@@ -445,7 +843,7 @@ func TestAddBurner(t *testing.T) {
 
 	// ARRANGE: Generate two burner accounts, add one to state.
 	burner1, burner2 := utils.TestAccount(), utils.TestAccount()
-	require.NoError(t, k.SetBurner(ctx, burner2.Address, ONE))
+	require.NoError(t, k.SetBurner(ctx, k.Denom, burner2.Bytes, ONE))
 
 	// ACT: Attempt to add burner that already exists.
 	_, err = server.AddBurner(ctx, &types.MsgAddBurner{
@@ -469,7 +867,7 @@ func TestAddBurner(t *testing.T) {
 	tmp := k.Burners
 	k.Burners = collections.NewMap(
 		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
-		types.BurnerPrefix, "burners", collections.StringKey, sdk.IntValue,
+		types.BurnerPrefix, "burners", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), sdk.IntValue,
 	)
 
 	// ACT: Attempt to add burner with failing Burners collection store.
@@ -490,7 +888,7 @@ func TestAddBurner(t *testing.T) {
 	})
 	// ASSERT: The action should've succeeded, and set burner in state.
 	require.NoError(t, err)
-	require.Equal(t, ONE, k.GetBurner(ctx, burner1.Address))
+	require.Equal(t, ONE, k.GetBurner(ctx, k.Denom, burner1.Bytes))
 }
 
 func TestRemoveBurner(t *testing.T) {
@@ -504,7 +902,7 @@ func TestRemoveBurner(t *testing.T) {
 
 	// ARRANGE: Set owner in state.
 	owner := utils.TestAccount()
-	require.NoError(t, k.SetOwner(ctx, owner.Address))
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
 
 	// ACT: Attempt to remove burner with invalid signer.
 	_, err = server.RemoveBurner(ctx, &types.MsgRemoveBurner{
@@ -525,13 +923,13 @@ func TestRemoveBurner(t *testing.T) {
 	require.ErrorContains(t, err, "is not a burner")
 
 	// ARRANGE: Set burner in state.
-	require.NoError(t, k.SetBurner(ctx, burner.Address, ONE))
+	require.NoError(t, k.SetBurner(ctx, k.Denom, burner.Bytes, ONE))
 
 	// ARRANGE: Set up a failing collection store for the attribute delete.
 	tmp := k.Burners
 	k.Burners = collections.NewMap(
 		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Delete, utils.GetKVStore(ctx, types.ModuleName))),
-		types.BurnerPrefix, "burners", collections.StringKey, sdk.IntValue,
+		types.BurnerPrefix, "burners", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), sdk.IntValue,
 	)
 
 	// ACT: Attempt to remove burner with failing Burners collection store.
@@ -550,7 +948,7 @@ func TestRemoveBurner(t *testing.T) {
 	})
 	// ASSERT: The action should've succeeded, and removed burner in state.
 	require.NoError(t, err)
-	require.False(t, k.HasBurner(ctx, burner.Address))
+	require.False(t, k.HasBurner(ctx, k.Denom, burner.Bytes))
 }
 
 func TestSetBurnerAllowance(t *testing.T) {
@@ -564,7 +962,7 @@ func TestSetBurnerAllowance(t *testing.T) {
 
 	// ARRANGE: Set owner in state.
 	owner := utils.TestAccount()
-	require.NoError(t, k.SetOwner(ctx, owner.Address))
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
 
 	// ACT: Attempt to set burner allowance with invalid signer.
 	_, err = server.SetBurnerAllowance(ctx, &types.MsgSetBurnerAllowance{
@@ -586,7 +984,7 @@ func TestSetBurnerAllowance(t *testing.T) {
 	require.ErrorContains(t, err, "is not a burner")
 
 	// ARRANGE: Set burner in state.
-	require.NoError(t, k.SetBurner(ctx, burner.Address, math.ZeroInt()))
+	require.NoError(t, k.SetBurner(ctx, k.Denom, burner.Bytes, math.ZeroInt()))
 
 	// ACT: Attempt to set burner allowance with invalid allowance.
 	_, err = server.SetBurnerAllowance(ctx, &types.MsgSetBurnerAllowance{
@@ -601,7 +999,7 @@ func TestSetBurnerAllowance(t *testing.T) {
 	tmp := k.Burners
 	k.Burners = collections.NewMap(
 		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
-		types.BurnerPrefix, "burners", collections.StringKey, sdk.IntValue,
+		types.BurnerPrefix, "burners", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), sdk.IntValue,
 	)
 
 	// ACT: Attempt to set burner allowance with failing Burners collection store.
@@ -615,7 +1013,7 @@ func TestSetBurnerAllowance(t *testing.T) {
 	k.Burners = tmp
 
 	// ARRANGE: Set invalid un-decodable burn allowance in state.
-	key, _ := collections.EncodeKeyWithPrefix(types.BurnerPrefix, collections.StringKey, burner.Address)
+	key, _ := collections.EncodeKeyWithPrefix(types.BurnerPrefix, collections.PairKeyCodec(collections.StringKey, collections.BytesKey), collections.Join(k.Denom, burner.Bytes))
 	utils.GetKVStore(ctx, types.ModuleName).Set(key, []byte("invalid"))
 
 	// ACT: Attempt to set burner allowance.
@@ -626,118 +1024,406 @@ func TestSetBurnerAllowance(t *testing.T) {
 	})
 	// ASSERT: The action should've succeeded, and set burner allowance in state.
 	require.NoError(t, err)
-	require.Equal(t, ONE, k.GetBurner(ctx, burner.Address))
+	require.Equal(t, ONE, k.GetBurner(ctx, k.Denom, burner.Bytes))
 }
 
-func TestAddMinter(t *testing.T) {
+func TestIncreaseBurnerAllowance(t *testing.T) {
 	k, ctx := mocks.AuraKeeper()
 	server := keeper.NewMsgServer(k)
 
-	// ACT: Attempt to add minter with no owner set.
-	_, err := server.AddMinter(ctx, &types.MsgAddMinter{})
+	// ACT: Attempt to increase burner allowance with no owner set.
+	_, err := server.IncreaseBurnerAllowance(ctx, &types.MsgIncreaseBurnerAllowance{})
 	// ASSERT: The action should've failed due to no owner set.
 	require.ErrorContains(t, err, "there is no owner")
 
 	// ARRANGE: Set owner in state.
 	owner := utils.TestAccount()
-	require.NoError(t, k.SetOwner(ctx, owner.Address))
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
 
-	// ACT: Attempt to add minter with invalid signer.
-	_, err = server.AddMinter(ctx, &types.MsgAddMinter{
+	// ACT: Attempt to increase burner allowance with invalid signer.
+	_, err = server.IncreaseBurnerAllowance(ctx, &types.MsgIncreaseBurnerAllowance{
 		Signer: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
 
-	// ARRANGE: Generate two minter accounts, add one to state.
-	minter1, minter2 := utils.TestAccount(), utils.TestAccount()
-	require.NoError(t, k.SetMinter(ctx, minter2.Address, ONE))
-
-	// ACT: Attempt to add minter that already exists.
-	_, err = server.AddMinter(ctx, &types.MsgAddMinter{
-		Signer:    owner.Address,
-		Minter:    minter2.Address,
-		Allowance: ONE,
-	})
-	// ASSERT: The action should've failed due to existing minter.
-	require.ErrorContains(t, err, "is already a minter")
+	// ARRANGE: Generate a burner account.
+	burner := utils.TestAccount()
 
-	// ACT: Attempt to add minter with invalid allowance.
-	_, err = server.AddMinter(ctx, &types.MsgAddMinter{
-		Signer:    owner.Address,
-		Minter:    minter1.Address,
-		Allowance: ONE.Neg(),
+	// ACT: Attempt to increase burner allowance that does not exist.
+	_, err = server.IncreaseBurnerAllowance(ctx, &types.MsgIncreaseBurnerAllowance{
+		Signer: owner.Address,
+		Burner: burner.Address,
+		Amount: ONE,
 	})
-	// ASSERT: The action should've failed due to negative allowance.
-	require.ErrorContains(t, err, "allowance cannot be negative")
+	// ASSERT: The action should've failed due to non existent burner.
+	require.ErrorContains(t, err, "is not a burner")
 
-	// ARRANGE: Set up a failing collection store for the attribute setter.
-	tmp := k.Minters
-	k.Minters = collections.NewMap(
-		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
-		types.MinterPrefix, "minters", collections.StringKey, sdk.IntValue,
-	)
+	// ARRANGE: Set burner in state.
+	require.NoError(t, k.SetBurner(ctx, k.Denom, burner.Bytes, ONE.MulRaw(2)))
 
-	// ACT: Attempt to add minter with failing Minters collection store.
-	_, err = server.AddMinter(ctx, &types.MsgAddMinter{
-		Signer:    owner.Address,
-		Minter:    minter1.Address,
-		Allowance: ONE,
+	// ACT: Attempt to increase burner allowance with invalid amount.
+	_, err = server.IncreaseBurnerAllowance(ctx, &types.MsgIncreaseBurnerAllowance{
+		Signer: owner.Address,
+		Burner: burner.Address,
+		Amount: math.ZeroInt(),
 	})
-	// ASSERT: The action should've failed due to collection store setter error.
-	require.Error(t, err, mocks.ErrorStoreAccess)
-	k.Minters = tmp
+	// ASSERT: The action should've failed due to non-positive amount.
+	require.ErrorContains(t, err, "amount must be positive")
 
-	// ACT: Attempt to add minter.
-	_, err = server.AddMinter(ctx, &types.MsgAddMinter{
-		Signer:    owner.Address,
-		Minter:    minter1.Address,
-		Allowance: ONE,
+	// ACT: Attempt to increase burner allowance.
+	_, err = server.IncreaseBurnerAllowance(ctx, &types.MsgIncreaseBurnerAllowance{
+		Signer: owner.Address,
+		Burner: burner.Address,
+		Amount: ONE,
 	})
-	// ASSERT: The action should've succeeded, and set minter in state.
+	// ASSERT: The action should've succeeded, and added to the burner allowance in state.
 	require.NoError(t, err)
-	require.Equal(t, ONE, k.GetMinter(ctx, minter1.Address))
+	require.Equal(t, ONE.MulRaw(3), k.GetBurner(ctx, k.Denom, burner.Bytes))
 }
 
-func TestRemoveMinter(t *testing.T) {
+func TestDecreaseBurnerAllowance(t *testing.T) {
 	k, ctx := mocks.AuraKeeper()
 	server := keeper.NewMsgServer(k)
 
-	// ACT: Attempt to remove minter with no owner set.
-	_, err := server.RemoveMinter(ctx, &types.MsgRemoveMinter{})
+	// ACT: Attempt to decrease burner allowance with no owner set.
+	_, err := server.DecreaseBurnerAllowance(ctx, &types.MsgDecreaseBurnerAllowance{})
 	// ASSERT: The action should've failed due to no owner set.
 	require.ErrorContains(t, err, "there is no owner")
 
 	// ARRANGE: Set owner in state.
 	owner := utils.TestAccount()
-	require.NoError(t, k.SetOwner(ctx, owner.Address))
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
 
-	// ACT: Attempt to remove minter with invalid signer.
-	_, err = server.RemoveMinter(ctx, &types.MsgRemoveMinter{
+	// ACT: Attempt to decrease burner allowance with invalid signer.
+	_, err = server.DecreaseBurnerAllowance(ctx, &types.MsgDecreaseBurnerAllowance{
 		Signer: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
 
-	// ARRANGE: Generate a minter account.
-	minter := utils.TestAccount()
+	// ARRANGE: Generate a burner account.
+	burner := utils.TestAccount()
 
-	// ACT: Attempt to remove minter that does not exist.
-	_, err = server.RemoveMinter(ctx, &types.MsgRemoveMinter{
+	// ACT: Attempt to decrease burner allowance that does not exist.
+	_, err = server.DecreaseBurnerAllowance(ctx, &types.MsgDecreaseBurnerAllowance{
 		Signer: owner.Address,
-		Minter: minter.Address,
+		Burner: burner.Address,
+		Amount: ONE,
 	})
-	// ASSERT: The action should've failed due to non-existent minter.
-	require.ErrorContains(t, err, "is not a minter")
+	// ASSERT: The action should've failed due to non existent burner.
+	require.ErrorContains(t, err, "is not a burner")
 
-	// ARRANGE: Set minter in state.
-	require.NoError(t, k.SetMinter(ctx, minter.Address, ONE))
+	// ARRANGE: Set burner in state.
+	require.NoError(t, k.SetBurner(ctx, k.Denom, burner.Bytes, ONE.MulRaw(2)))
 
-	// ARRANGE: Set up a failing collection store for the attribute delete.
-	tmp := k.Minters
-	k.Minters = collections.NewMap(
-		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Delete, utils.GetKVStore(ctx, types.ModuleName))),
-		types.MinterPrefix, "minters", collections.StringKey, sdk.IntValue,
+	// ACT: Attempt to decrease burner allowance with invalid amount.
+	_, err = server.DecreaseBurnerAllowance(ctx, &types.MsgDecreaseBurnerAllowance{
+		Signer: owner.Address,
+		Burner: burner.Address,
+		Amount: math.ZeroInt(),
+	})
+	// ASSERT: The action should've failed due to non-positive amount.
+	require.ErrorContains(t, err, "amount must be positive")
+
+	// ACT: Attempt to decrease burner allowance by more than its allowance.
+	_, err = server.DecreaseBurnerAllowance(ctx, &types.MsgDecreaseBurnerAllowance{
+		Signer: owner.Address,
+		Burner: burner.Address,
+		Amount: ONE.MulRaw(3),
+	})
+	// ASSERT: The action should've failed due to insufficient allowance.
+	require.ErrorIs(t, err, types.ErrInsufficientAllowance)
+	require.Equal(t, ONE.MulRaw(2), k.GetBurner(ctx, k.Denom, burner.Bytes))
+
+	// ACT: Attempt to decrease burner allowance.
+	_, err = server.DecreaseBurnerAllowance(ctx, &types.MsgDecreaseBurnerAllowance{
+		Signer: owner.Address,
+		Burner: burner.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've succeeded, and subtracted from the burner allowance in state.
+	require.NoError(t, err)
+	require.Equal(t, ONE, k.GetBurner(ctx, k.Denom, burner.Bytes))
+}
+
+func TestAddBurnerSource(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to add burner source with no owner set.
+	_, err := server.AddBurnerSource(ctx, &types.MsgAddBurnerSource{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to add burner source with invalid signer.
+	_, err = server.AddBurnerSource(ctx, &types.MsgAddBurnerSource{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate burner, source and other accounts.
+	burner, source, other := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ACT: Attempt to add burner source for a burner that does not exist.
+	_, err = server.AddBurnerSource(ctx, &types.MsgAddBurnerSource{
+		Signer: owner.Address,
+		Burner: burner.Address,
+		Source: source.Address,
+	})
+	// ASSERT: The action should've failed due to non existent burner.
+	require.ErrorContains(t, err, "is not a burner")
+
+	// ARRANGE: Set burner in state.
+	require.NoError(t, k.SetBurner(ctx, k.Denom, burner.Bytes, ONE.MulRaw(2)))
+
+	// ACT: Attempt to add burner source with an invalid address.
+	_, err = server.AddBurnerSource(ctx, &types.MsgAddBurnerSource{
+		Signer: owner.Address,
+		Burner: burner.Address,
+		Source: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+	})
+	// ASSERT: The action should've failed due to invalid address.
+	require.ErrorContains(t, err, "unable to decode source address")
+
+	// ACT: Attempt to add burner source.
+	_, err = server.AddBurnerSource(ctx, &types.MsgAddBurnerSource{
+		Signer: owner.Address,
+		Burner: burner.Address,
+		Source: source.Address,
+	})
+	// ASSERT: The action should've succeeded, and set the source in state.
+	require.NoError(t, err)
+	require.True(t, k.HasBurnerSource(ctx, k.Denom, burner.Bytes, source.Bytes))
+	require.Equal(t, []string{source.Address}, k.GetBurners(ctx, k.Denom)[0].Sources)
+
+	// ACT: Attempt to add burner source again.
+	_, err = server.AddBurnerSource(ctx, &types.MsgAddBurnerSource{
+		Signer: owner.Address,
+		Burner: burner.Address,
+		Source: source.Address,
+	})
+	// ASSERT: The action should've failed due to existing source.
+	require.ErrorContains(t, err, "is already a source")
+
+	// ARRANGE: Give both accounts 1 $USDY.
+	bank.Balances[source.Address] = sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+	bank.Balances[other.Address] = sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+
+	// ACT: Attempt to burn from an address that isn't permitted.
+	_, err = server.Burn(ctx, &types.MsgBurn{
+		Signer: burner.Address,
+		From:   other.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to invalid source.
+	require.ErrorIs(t, err, types.ErrInvalidSource)
+
+	// ACT: Attempt to burn from the permitted source.
+	_, err = server.Burn(ctx, &types.MsgBurn{
+		Signer: burner.Address,
+		From:   source.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, ONE, k.GetBurner(ctx, k.Denom, burner.Bytes))
+}
+
+func TestRemoveBurnerSource(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to remove burner source with no owner set.
+	_, err := server.RemoveBurnerSource(ctx, &types.MsgRemoveBurnerSource{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to remove burner source with invalid signer.
+	_, err = server.RemoveBurnerSource(ctx, &types.MsgRemoveBurnerSource{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate burner, source and other accounts.
+	burner, source, other := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ACT: Attempt to remove burner source that does not exist.
+	_, err = server.RemoveBurnerSource(ctx, &types.MsgRemoveBurnerSource{
+		Signer: owner.Address,
+		Burner: burner.Address,
+		Source: source.Address,
+	})
+	// ASSERT: The action should've failed due to non existent source.
+	require.ErrorContains(t, err, "is not a source")
+
+	// ARRANGE: Set burner in state, bound to the source.
+	require.NoError(t, k.SetBurner(ctx, k.Denom, burner.Bytes, ONE))
+	require.NoError(t, k.SetBurnerSource(ctx, k.Denom, burner.Bytes, source.Bytes))
+
+	// ACT: Attempt to remove burner source.
+	_, err = server.RemoveBurnerSource(ctx, &types.MsgRemoveBurnerSource{
+		Signer: owner.Address,
+		Burner: burner.Address,
+		Source: source.Address,
+	})
+	// ASSERT: The action should've succeeded, and removed the source from state.
+	require.NoError(t, err)
+	require.False(t, k.HasBurnerSource(ctx, k.Denom, burner.Bytes, source.Bytes))
+	require.Empty(t, k.GetBurners(ctx, k.Denom)[0].Sources)
+
+	// ARRANGE: Give the other account 1 $USDY.
+	bank.Balances[other.Address] = sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+
+	// ACT: Attempt to burn from any address, now that the burner isn't bound.
+	_, err = server.Burn(ctx, &types.MsgBurn{
+		Signer: burner.Address,
+		From:   other.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ARRANGE: Bind the burner to the source again.
+	require.NoError(t, k.SetBurnerSource(ctx, k.Denom, burner.Bytes, source.Bytes))
+
+	// ACT: Attempt to remove the burner.
+	_, err = server.RemoveBurner(ctx, &types.MsgRemoveBurner{
+		Signer: owner.Address,
+		Burner: burner.Address,
+	})
+	// ASSERT: The action should've succeeded, and removed the sources from state.
+	require.NoError(t, err)
+	require.False(t, k.HasBurnerSource(ctx, k.Denom, burner.Bytes, source.Bytes))
+}
+
+func TestAddMinter(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to add minter with no owner set.
+	_, err := server.AddMinter(ctx, &types.MsgAddMinter{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to add minter with invalid signer.
+	_, err = server.AddMinter(ctx, &types.MsgAddMinter{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate two minter accounts, add one to state.
+	minter1, minter2 := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetMinter(ctx, k.Denom, minter2.Bytes, ONE))
+
+	// ACT: Attempt to add minter that already exists.
+	_, err = server.AddMinter(ctx, &types.MsgAddMinter{
+		Signer:    owner.Address,
+		Minter:    minter2.Address,
+		Allowance: ONE,
+	})
+	// ASSERT: The action should've failed due to existing minter.
+	require.ErrorContains(t, err, "is already a minter")
+
+	// ACT: Attempt to add minter with invalid allowance.
+	_, err = server.AddMinter(ctx, &types.MsgAddMinter{
+		Signer:    owner.Address,
+		Minter:    minter1.Address,
+		Allowance: ONE.Neg(),
+	})
+	// ASSERT: The action should've failed due to negative allowance.
+	require.ErrorContains(t, err, "allowance cannot be negative")
+
+	// ARRANGE: Set up a failing collection store for the attribute setter.
+	tmp := k.Minters
+	k.Minters = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.MinterPrefix, "minters", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), sdk.IntValue,
+	)
+
+	// ACT: Attempt to add minter with failing Minters collection store.
+	_, err = server.AddMinter(ctx, &types.MsgAddMinter{
+		Signer:    owner.Address,
+		Minter:    minter1.Address,
+		Allowance: ONE,
+	})
+	// ASSERT: The action should've failed due to collection store setter error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.Minters = tmp
+
+	// ACT: Attempt to add minter.
+	_, err = server.AddMinter(ctx, &types.MsgAddMinter{
+		Signer:    owner.Address,
+		Minter:    minter1.Address,
+		Allowance: ONE,
+	})
+	// ASSERT: The action should've succeeded, and set minter in state.
+	require.NoError(t, err)
+	require.Equal(t, ONE, k.GetMinter(ctx, k.Denom, minter1.Bytes))
+}
+
+func TestRemoveMinter(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to remove minter with no owner set.
+	_, err := server.RemoveMinter(ctx, &types.MsgRemoveMinter{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to remove minter with invalid signer.
+	_, err = server.RemoveMinter(ctx, &types.MsgRemoveMinter{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate a minter account.
+	minter := utils.TestAccount()
+
+	// ACT: Attempt to remove minter that does not exist.
+	_, err = server.RemoveMinter(ctx, &types.MsgRemoveMinter{
+		Signer: owner.Address,
+		Minter: minter.Address,
+	})
+	// ASSERT: The action should've failed due to non-existent minter.
+	require.ErrorContains(t, err, "is not a minter")
+
+	// ARRANGE: Set minter in state.
+	require.NoError(t, k.SetMinter(ctx, k.Denom, minter.Bytes, ONE))
+
+	// ARRANGE: Set up a failing collection store for the attribute delete.
+	tmp := k.Minters
+	k.Minters = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Delete, utils.GetKVStore(ctx, types.ModuleName))),
+		types.MinterPrefix, "minters", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), sdk.IntValue,
 	)
 
 	// ACT: Attempt to remove minter with failing Minters collection store.
@@ -754,278 +1440,928 @@ func TestRemoveMinter(t *testing.T) {
 		Signer: owner.Address,
 		Minter: minter.Address,
 	})
-	// ASSERT: The action should've succeeded, and removed minter in state.
+	// ASSERT: The action should've succeeded, and removed minter in state.
+	require.NoError(t, err)
+	require.False(t, k.HasMinter(ctx, k.Denom, minter.Bytes))
+}
+
+func TestSetMinterAllowance(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to set minter allowance with no owner set.
+	_, err := server.SetMinterAllowance(ctx, &types.MsgSetMinterAllowance{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to set minter allowance with invalid signer.
+	_, err = server.SetMinterAllowance(ctx, &types.MsgSetMinterAllowance{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate a minter account.
+	minter := utils.TestAccount()
+
+	// ACT: Attempt to set minter allowance that does not exist.
+	_, err = server.SetMinterAllowance(ctx, &types.MsgSetMinterAllowance{
+		Signer:    owner.Address,
+		Minter:    minter.Address,
+		Allowance: ONE,
+	})
+	// ASSERT: The action should've failed due to non-existent minter.
+	require.ErrorContains(t, err, "is not a minter")
+
+	// ARRANGE: Set minters in state.
+	require.NoError(t, k.SetMinter(ctx, k.Denom, minter.Bytes, math.ZeroInt()))
+
+	// ACT: Attempt to set minter allowance with invalid allowance.
+	_, err = server.SetMinterAllowance(ctx, &types.MsgSetMinterAllowance{
+		Signer:    owner.Address,
+		Minter:    minter.Address,
+		Allowance: ONE.Neg(),
+	})
+	// ASSERT: The action should've failed due to negative allowance.
+	require.ErrorContains(t, err, "allowance cannot be negative")
+
+	// ARRANGE: Set up a failing collection store for the attribute setter.
+	tmp := k.Minters
+	k.Minters = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.MinterPrefix, "minters", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), sdk.IntValue,
+	)
+
+	// ACT: Attempt to set minter allowance with failing Minters collection store.
+	_, err = server.SetMinterAllowance(ctx, &types.MsgSetMinterAllowance{
+		Signer:    owner.Address,
+		Minter:    minter.Address,
+		Allowance: ONE,
+	})
+	// ASSERT: The action should've failed due to collection store setter error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.Minters = tmp
+
+	// ARRANGE: Set invalid un-decodable mint allowance in state.
+	key, _ := collections.EncodeKeyWithPrefix(types.MinterPrefix, collections.PairKeyCodec(collections.StringKey, collections.BytesKey), collections.Join(k.Denom, minter.Bytes))
+	utils.GetKVStore(ctx, types.ModuleName).Set(key, []byte("invalid"))
+
+	// ACT: Attempt to set minter allowance.
+	_, err = server.SetMinterAllowance(ctx, &types.MsgSetMinterAllowance{
+		Signer:    owner.Address,
+		Minter:    minter.Address,
+		Allowance: ONE,
+	})
+	// ASSERT: The action should've succeeded, and set minter allowance in state.
+	require.NoError(t, err)
+	require.Equal(t, ONE, k.GetMinter(ctx, k.Denom, minter.Bytes))
+}
+
+func TestIncreaseMinterAllowance(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to increase minter allowance with no owner set.
+	_, err := server.IncreaseMinterAllowance(ctx, &types.MsgIncreaseMinterAllowance{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to increase minter allowance with invalid signer.
+	_, err = server.IncreaseMinterAllowance(ctx, &types.MsgIncreaseMinterAllowance{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate a minter account.
+	minter := utils.TestAccount()
+
+	// ACT: Attempt to increase minter allowance that does not exist.
+	_, err = server.IncreaseMinterAllowance(ctx, &types.MsgIncreaseMinterAllowance{
+		Signer: owner.Address,
+		Minter: minter.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to non existent minter.
+	require.ErrorContains(t, err, "is not a minter")
+
+	// ARRANGE: Set minter in state.
+	require.NoError(t, k.SetMinter(ctx, k.Denom, minter.Bytes, ONE.MulRaw(2)))
+
+	// ACT: Attempt to increase minter allowance with invalid amount.
+	_, err = server.IncreaseMinterAllowance(ctx, &types.MsgIncreaseMinterAllowance{
+		Signer: owner.Address,
+		Minter: minter.Address,
+		Amount: math.ZeroInt(),
+	})
+	// ASSERT: The action should've failed due to non-positive amount.
+	require.ErrorContains(t, err, "amount must be positive")
+
+	// ACT: Attempt to increase minter allowance.
+	_, err = server.IncreaseMinterAllowance(ctx, &types.MsgIncreaseMinterAllowance{
+		Signer: owner.Address,
+		Minter: minter.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've succeeded, and added to the minter allowance in state.
+	require.NoError(t, err)
+	require.Equal(t, ONE.MulRaw(3), k.GetMinter(ctx, k.Denom, minter.Bytes))
+}
+
+func TestDecreaseMinterAllowance(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to decrease minter allowance with no owner set.
+	_, err := server.DecreaseMinterAllowance(ctx, &types.MsgDecreaseMinterAllowance{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to decrease minter allowance with invalid signer.
+	_, err = server.DecreaseMinterAllowance(ctx, &types.MsgDecreaseMinterAllowance{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate a minter account.
+	minter := utils.TestAccount()
+
+	// ACT: Attempt to decrease minter allowance that does not exist.
+	_, err = server.DecreaseMinterAllowance(ctx, &types.MsgDecreaseMinterAllowance{
+		Signer: owner.Address,
+		Minter: minter.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to non existent minter.
+	require.ErrorContains(t, err, "is not a minter")
+
+	// ARRANGE: Set minter in state.
+	require.NoError(t, k.SetMinter(ctx, k.Denom, minter.Bytes, ONE.MulRaw(2)))
+
+	// ACT: Attempt to decrease minter allowance with invalid amount.
+	_, err = server.DecreaseMinterAllowance(ctx, &types.MsgDecreaseMinterAllowance{
+		Signer: owner.Address,
+		Minter: minter.Address,
+		Amount: math.ZeroInt(),
+	})
+	// ASSERT: The action should've failed due to non-positive amount.
+	require.ErrorContains(t, err, "amount must be positive")
+
+	// ACT: Attempt to decrease minter allowance by more than its allowance.
+	_, err = server.DecreaseMinterAllowance(ctx, &types.MsgDecreaseMinterAllowance{
+		Signer: owner.Address,
+		Minter: minter.Address,
+		Amount: ONE.MulRaw(3),
+	})
+	// ASSERT: The action should've failed due to insufficient allowance.
+	require.ErrorIs(t, err, types.ErrInsufficientAllowance)
+	require.Equal(t, ONE.MulRaw(2), k.GetMinter(ctx, k.Denom, minter.Bytes))
+
+	// ACT: Attempt to decrease minter allowance.
+	_, err = server.DecreaseMinterAllowance(ctx, &types.MsgDecreaseMinterAllowance{
+		Signer: owner.Address,
+		Minter: minter.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've succeeded, and subtracted from the minter allowance in state.
+	require.NoError(t, err)
+	require.Equal(t, ONE, k.GetMinter(ctx, k.Denom, minter.Bytes))
+}
+
+func TestAddMinterRecipient(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to add minter recipient with no owner set.
+	_, err := server.AddMinterRecipient(ctx, &types.MsgAddMinterRecipient{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to add minter recipient with invalid signer.
+	_, err = server.AddMinterRecipient(ctx, &types.MsgAddMinterRecipient{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate minter, recipient and other accounts.
+	minter, recipient, other := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ACT: Attempt to add minter recipient for a minter that does not exist.
+	_, err = server.AddMinterRecipient(ctx, &types.MsgAddMinterRecipient{
+		Signer:    owner.Address,
+		Minter:    minter.Address,
+		Recipient: recipient.Address,
+	})
+	// ASSERT: The action should've failed due to non existent minter.
+	require.ErrorContains(t, err, "is not a minter")
+
+	// ARRANGE: Set minter in state.
+	require.NoError(t, k.SetMinter(ctx, k.Denom, minter.Bytes, ONE.MulRaw(2)))
+
+	// ACT: Attempt to add minter recipient with an invalid address.
+	_, err = server.AddMinterRecipient(ctx, &types.MsgAddMinterRecipient{
+		Signer:    owner.Address,
+		Minter:    minter.Address,
+		Recipient: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+	})
+	// ASSERT: The action should've failed due to invalid address.
+	require.ErrorContains(t, err, "unable to decode recipient address")
+
+	// ACT: Attempt to add minter recipient.
+	_, err = server.AddMinterRecipient(ctx, &types.MsgAddMinterRecipient{
+		Signer:    owner.Address,
+		Minter:    minter.Address,
+		Recipient: recipient.Address,
+	})
+	// ASSERT: The action should've succeeded, and set the recipient in state.
+	require.NoError(t, err)
+	require.True(t, k.HasMinterRecipient(ctx, k.Denom, minter.Bytes, recipient.Bytes))
+	require.Equal(t, []string{recipient.Address}, k.GetMinters(ctx, k.Denom)[0].Recipients)
+
+	// ACT: Attempt to add minter recipient again.
+	_, err = server.AddMinterRecipient(ctx, &types.MsgAddMinterRecipient{
+		Signer:    owner.Address,
+		Minter:    minter.Address,
+		Recipient: recipient.Address,
+	})
+	// ASSERT: The action should've failed due to existing recipient.
+	require.ErrorContains(t, err, "is already a recipient")
+
+	// ACT: Attempt to mint to an address that isn't permitted.
+	_, err = server.Mint(ctx, &types.MsgMint{
+		Signer: minter.Address,
+		To:     other.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to invalid recipient.
+	require.ErrorIs(t, err, types.ErrInvalidRecipient)
+
+	// ACT: Attempt to mint to the permitted recipient.
+	_, err = server.Mint(ctx, &types.MsgMint{
+		Signer: minter.Address,
+		To:     recipient.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, ONE, k.GetMinter(ctx, k.Denom, minter.Bytes))
+}
+
+func TestRemoveMinterRecipient(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to remove minter recipient with no owner set.
+	_, err := server.RemoveMinterRecipient(ctx, &types.MsgRemoveMinterRecipient{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to remove minter recipient with invalid signer.
+	_, err = server.RemoveMinterRecipient(ctx, &types.MsgRemoveMinterRecipient{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate minter, recipient and other accounts.
+	minter, recipient, other := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ACT: Attempt to remove minter recipient that does not exist.
+	_, err = server.RemoveMinterRecipient(ctx, &types.MsgRemoveMinterRecipient{
+		Signer:    owner.Address,
+		Minter:    minter.Address,
+		Recipient: recipient.Address,
+	})
+	// ASSERT: The action should've failed due to non existent recipient.
+	require.ErrorContains(t, err, "is not a recipient")
+
+	// ARRANGE: Set minter in state, bound to the recipient.
+	require.NoError(t, k.SetMinter(ctx, k.Denom, minter.Bytes, ONE))
+	require.NoError(t, k.SetMinterRecipient(ctx, k.Denom, minter.Bytes, recipient.Bytes))
+
+	// ACT: Attempt to remove minter recipient.
+	_, err = server.RemoveMinterRecipient(ctx, &types.MsgRemoveMinterRecipient{
+		Signer:    owner.Address,
+		Minter:    minter.Address,
+		Recipient: recipient.Address,
+	})
+	// ASSERT: The action should've succeeded, and removed the recipient from state.
+	require.NoError(t, err)
+	require.False(t, k.HasMinterRecipient(ctx, k.Denom, minter.Bytes, recipient.Bytes))
+	require.Empty(t, k.GetMinters(ctx, k.Denom)[0].Recipients)
+
+	// ACT: Attempt to mint to any address, now that the minter isn't bound.
+	_, err = server.Mint(ctx, &types.MsgMint{
+		Signer: minter.Address,
+		To:     other.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ARRANGE: Bind the minter to the recipient again.
+	require.NoError(t, k.SetMinterRecipient(ctx, k.Denom, minter.Bytes, recipient.Bytes))
+
+	// ACT: Attempt to remove the minter.
+	_, err = server.RemoveMinter(ctx, &types.MsgRemoveMinter{
+		Signer: owner.Address,
+		Minter: minter.Address,
+	})
+	// ASSERT: The action should've succeeded, and removed the recipients from state.
+	require.NoError(t, err)
+	require.False(t, k.HasMinterRecipient(ctx, k.Denom, minter.Bytes, recipient.Bytes))
+}
+
+func TestAddPauser(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to add pauser with no owner set.
+	_, err := server.AddPauser(ctx, &types.MsgAddPauser{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to add pauser with invalid signer.
+	_, err = server.AddPauser(ctx, &types.MsgAddPauser{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate two pauser accounts, add one to state.
+	pauser1, pauser2 := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetPauser(ctx, k.Denom, pauser2.Bytes))
+
+	// ACT: Attempt to add pauser that already exists.
+	_, err = server.AddPauser(ctx, &types.MsgAddPauser{
+		Signer: owner.Address,
+		Pauser: pauser2.Address,
+	})
+	// ASSERT: The action should've failed due to existing pauser.
+	require.ErrorContains(t, err, "is already a pauser")
+
+	// ARRANGE: Set up a failing collection store for the attribute setter.
+	tmp := k.Pausers
+	k.Pausers = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.PauserPrefix, "pausers", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), collections.BytesValue,
+	)
+
+	// ACT: Attempt to add pauser with failing Pausers collection store.
+	_, err = server.AddPauser(ctx, &types.MsgAddPauser{
+		Signer: owner.Address,
+		Pauser: pauser1.Address,
+	})
+	// ASSERT: The action should've failed due to collection store setter error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.Pausers = tmp
+
+	// ACT: Attempt to add pauser.
+	_, err = server.AddPauser(ctx, &types.MsgAddPauser{
+		Signer: owner.Address,
+		Pauser: pauser1.Address,
+	})
+	// ASSERT: The action should've succeeded, and set pauser in state.
+	require.NoError(t, err)
+	require.True(t, k.HasPauser(ctx, k.Denom, pauser1.Bytes))
+}
+
+func TestRemovePauser(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to remove pauser with no owner set.
+	_, err := server.RemovePauser(ctx, &types.MsgRemovePauser{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to remove pauser with invalid signer.
+	_, err = server.RemovePauser(ctx, &types.MsgRemovePauser{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate a pauser account.
+	pauser := utils.TestAccount()
+
+	// ACT: Attempt to remove pauser that does not exist.
+	_, err = server.RemovePauser(ctx, &types.MsgRemovePauser{
+		Signer: owner.Address,
+		Pauser: pauser.Address,
+	})
+	// ASSERT: The action should've failed due to non-existent pauser.
+	require.ErrorContains(t, err, "is not a pauser")
+
+	// ARRANGE: Set pauser in state.
+	require.NoError(t, k.SetPauser(ctx, k.Denom, pauser.Bytes))
+
+	// ARRANGE: Set up a failing collection store for the attribute delete.
+	tmp := k.Pausers
+	k.Pausers = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Delete, utils.GetKVStore(ctx, types.ModuleName))),
+		types.PauserPrefix, "pausers", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), collections.BytesValue,
+	)
+
+	// ACT: Attempt to remove pauser with failing Pausers collection store.
+	_, err = server.RemovePauser(ctx, &types.MsgRemovePauser{
+		Signer: owner.Address,
+		Pauser: pauser.Address,
+	})
+	// ASSERT: The action should've failed due to collection store delete error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.Pausers = tmp
+
+	// ACT: Attempt to remove pauser.
+	_, err = server.RemovePauser(ctx, &types.MsgRemovePauser{
+		Signer: owner.Address,
+		Pauser: pauser.Address,
+	})
+	// ASSERT: The action should've succeeded, and removed pauser in state.
+	require.NoError(t, err)
+	require.False(t, k.HasPauser(ctx, k.Denom, pauser.Bytes))
+}
+
+func TestAddBlockedChannel(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to add blocked channel with no owner set.
+	_, err := server.AddBlockedChannel(ctx, &types.MsgAddBlockedChannel{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to add blocked channel with invalid signer.
+	_, err = server.AddBlockedChannel(ctx, &types.MsgAddBlockedChannel{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate two channel, add one to state.
+	channel1, channel2 := "channel-0", "channel-1"
+	require.NoError(t, k.SetBlockedChannel(ctx, k.Denom, channel2, types.ChannelDirectionOutbound))
+
+	// ACT: Attempt to add blocked channel that is blocked.
+	_, err = server.AddBlockedChannel(ctx, &types.MsgAddBlockedChannel{
+		Signer:  owner.Address,
+		Channel: channel2,
+	})
+	// ASSERT: The action should've failed due to blocked channel.
+	require.ErrorContains(t, err, "is already blocked")
+
+	// ARRANGE: Set up a failing collection store for the attribute setter.
+	tmp := k.BlockedChannels
+	k.BlockedChannels = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.BlockedChannelPrefix, "blocked_channels", collections.PairKeyCodec(collections.StringKey, collections.StringKey), types.ChannelDirectionValue,
+	)
+
+	// ACT: Attempt to add blocked channel with failing BlockedChannels collection store.
+	_, err = server.AddBlockedChannel(ctx, &types.MsgAddBlockedChannel{
+		Signer:  owner.Address,
+		Channel: channel1,
+	})
+	// ASSERT: The action should've failed due to collection store setter error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.BlockedChannels = tmp
+
+	// ACT: Attempt to add blocked channel with invalid direction.
+	_, err = server.AddBlockedChannel(ctx, &types.MsgAddBlockedChannel{
+		Signer:    owner.Address,
+		Channel:   channel1,
+		Direction: types.ChannelDirection(10),
+	})
+	// ASSERT: The action should've failed due to invalid direction.
+	require.ErrorContains(t, err, "invalid channel direction")
+
+	// ACT: Attempt to add blocked channel.
+	_, err = server.AddBlockedChannel(ctx, &types.MsgAddBlockedChannel{
+		Signer:  owner.Address,
+		Channel: channel1,
+	})
+	// ASSERT: The action should've succeeded, and set channel in state as outbound.
+	require.NoError(t, err)
+	require.True(t, k.HasBlockedChannel(ctx, k.Denom, channel1))
+	require.Equal(t, types.ChannelDirectionOutbound, k.GetBlockedChannel(ctx, k.Denom, channel1))
+
+	// ARRANGE: Generate a third channel.
+	channel3 := "channel-2"
+
+	// ACT: Attempt to add blocked channel with inbound direction.
+	_, err = server.AddBlockedChannel(ctx, &types.MsgAddBlockedChannel{
+		Signer:    owner.Address,
+		Channel:   channel3,
+		Direction: types.ChannelDirectionInbound,
+	})
+	// ASSERT: The action should've succeeded, and set channel in state as inbound.
+	require.NoError(t, err)
+	require.Equal(t, types.ChannelDirectionInbound, k.GetBlockedChannel(ctx, k.Denom, channel3))
+}
+
+func TestRemoveBlockedChannel(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to remove blocked channel with no owner set.
+	_, err := server.RemoveBlockedChannel(ctx, &types.MsgRemoveBlockedChannel{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to remove blocked channel with invalid signer.
+	_, err = server.RemoveBlockedChannel(ctx, &types.MsgRemoveBlockedChannel{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ARRANGE: Generate a channel.
+	channel := "channel-0"
+
+	// ACT: Attempt to remove blocked channel that isn't blocked.
+	_, err = server.RemoveBlockedChannel(ctx, &types.MsgRemoveBlockedChannel{
+		Signer:  owner.Address,
+		Channel: channel,
+	})
+	// ASSERT: The action should've failed due to allowed channel.
+	require.ErrorContains(t, err, "is not blocked")
+
+	// ARRANGE: Set channel in state.
+	require.NoError(t, k.SetBlockedChannel(ctx, k.Denom, channel, types.ChannelDirectionOutbound))
+
+	// ARRANGE: Set up a failing collection store for the attribute delete.
+	tmp := k.BlockedChannels
+	k.BlockedChannels = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Delete, utils.GetKVStore(ctx, types.ModuleName))),
+		types.BlockedChannelPrefix, "blocked_channels", collections.PairKeyCodec(collections.StringKey, collections.StringKey), types.ChannelDirectionValue,
+	)
+
+	// ACT: Attempt to remove blocked channel with failing BlockedChannels collection store.
+	_, err = server.RemoveBlockedChannel(ctx, &types.MsgRemoveBlockedChannel{
+		Signer:  owner.Address,
+		Channel: channel,
+	})
+	// ASSERT: The action should've failed due to collection store delete error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.BlockedChannels = tmp
+
+	// ACT: Attempt to remove blocked channel.
+	_, err = server.RemoveBlockedChannel(ctx, &types.MsgRemoveBlockedChannel{
+		Signer:  owner.Address,
+		Channel: channel,
+	})
+	// ASSERT: The action should've succeeded, and removed channel in state.
 	require.NoError(t, err)
-	require.False(t, k.HasMinter(ctx, minter.Address))
+	require.False(t, k.HasBlockedChannel(ctx, k.Denom, channel))
 }
 
-func TestSetMinterAllowance(t *testing.T) {
+func TestAddPauseExemption(t *testing.T) {
 	k, ctx := mocks.AuraKeeper()
 	server := keeper.NewMsgServer(k)
 
-	// ACT: Attempt to set minter allowance with no owner set.
-	_, err := server.SetMinterAllowance(ctx, &types.MsgSetMinterAllowance{})
+	// ACT: Attempt to add pause exemption with no owner set.
+	_, err := server.AddPauseExemption(ctx, &types.MsgAddPauseExemption{})
 	// ASSERT: The action should've failed due to no owner set.
 	require.ErrorContains(t, err, "there is no owner")
 
 	// ARRANGE: Set owner in state.
 	owner := utils.TestAccount()
-	require.NoError(t, k.SetOwner(ctx, owner.Address))
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
 
-	// ACT: Attempt to set minter allowance with invalid signer.
-	_, err = server.SetMinterAllowance(ctx, &types.MsgSetMinterAllowance{
+	// ACT: Attempt to add pause exemption with invalid signer.
+	_, err = server.AddPauseExemption(ctx, &types.MsgAddPauseExemption{
 		Signer: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
 
-	// ARRANGE: Generate a minter account.
-	minter := utils.TestAccount()
-
-	// ACT: Attempt to set minter allowance that does not exist.
-	_, err = server.SetMinterAllowance(ctx, &types.MsgSetMinterAllowance{
-		Signer:    owner.Address,
-		Minter:    minter.Address,
-		Allowance: ONE,
+	// ACT: Attempt to add pause exemption with invalid address.
+	_, err = server.AddPauseExemption(ctx, &types.MsgAddPauseExemption{
+		Signer:  owner.Address,
+		Address: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
 	})
-	// ASSERT: The action should've failed due to non-existent minter.
-	require.ErrorContains(t, err, "is not a minter")
+	// ASSERT: The action should've failed due to invalid address.
+	require.ErrorContains(t, err, "unable to decode exempt address")
 
-	// ARRANGE: Set minters in state.
-	require.NoError(t, k.SetMinter(ctx, minter.Address, math.ZeroInt()))
+	// ARRANGE: Generate two accounts, exempt one in state.
+	treasury, exempt := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetPauseExemption(ctx, k.Denom, exempt.Bytes, types.ExemptionDirectionSend))
 
-	// ACT: Attempt to set minter allowance with invalid allowance.
-	_, err = server.SetMinterAllowance(ctx, &types.MsgSetMinterAllowance{
+	// ACT: Attempt to add pause exemption that exists.
+	_, err = server.AddPauseExemption(ctx, &types.MsgAddPauseExemption{
+		Signer:  owner.Address,
+		Address: exempt.Address,
+	})
+	// ASSERT: The action should've failed due to existing exemption.
+	require.ErrorContains(t, err, "is already exempt")
+
+	// ACT: Attempt to add pause exemption with invalid direction.
+	_, err = server.AddPauseExemption(ctx, &types.MsgAddPauseExemption{
 		Signer:    owner.Address,
-		Minter:    minter.Address,
-		Allowance: ONE.Neg(),
+		Address:   treasury.Address,
+		Direction: types.ExemptionDirection(10),
 	})
-	// ASSERT: The action should've failed due to negative allowance.
-	require.ErrorContains(t, err, "allowance cannot be negative")
+	// ASSERT: The action should've failed due to invalid direction.
+	require.ErrorContains(t, err, "invalid exemption direction")
 
 	// ARRANGE: Set up a failing collection store for the attribute setter.
-	tmp := k.Minters
-	k.Minters = collections.NewMap(
+	tmp := k.PauseExemptions
+	k.PauseExemptions = collections.NewMap(
 		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
-		types.MinterPrefix, "minters", collections.StringKey, sdk.IntValue,
+		types.PauseExemptionPrefix, "pause_exemptions", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), types.ExemptionDirectionValue,
 	)
 
-	// ACT: Attempt to set minter allowance with failing Minters collection store.
-	_, err = server.SetMinterAllowance(ctx, &types.MsgSetMinterAllowance{
-		Signer:    owner.Address,
-		Minter:    minter.Address,
-		Allowance: ONE,
+	// ACT: Attempt to add pause exemption with failing PauseExemptions collection store.
+	_, err = server.AddPauseExemption(ctx, &types.MsgAddPauseExemption{
+		Signer:  owner.Address,
+		Address: treasury.Address,
 	})
 	// ASSERT: The action should've failed due to collection store setter error.
 	require.Error(t, err, mocks.ErrorStoreAccess)
-	k.Minters = tmp
+	k.PauseExemptions = tmp
 
-	// ARRANGE: Set invalid un-decodable mint allowance in state.
-	key, _ := collections.EncodeKeyWithPrefix(types.MinterPrefix, collections.StringKey, minter.Address)
-	utils.GetKVStore(ctx, types.ModuleName).Set(key, []byte("invalid"))
-
-	// ACT: Attempt to set minter allowance.
-	_, err = server.SetMinterAllowance(ctx, &types.MsgSetMinterAllowance{
-		Signer:    owner.Address,
-		Minter:    minter.Address,
-		Allowance: ONE,
+	// ACT: Attempt to add pause exemption with an uppercase address.
+	_, err = server.AddPauseExemption(ctx, &types.MsgAddPauseExemption{
+		Signer:  owner.Address,
+		Address: strings.ToUpper(treasury.Address),
 	})
-	// ASSERT: The action should've succeeded, and set minter allowance in state.
+	// ASSERT: The action should've succeeded, exempted both directions, and
+	// emitted the canonical address.
 	require.NoError(t, err)
-	require.Equal(t, ONE, k.GetMinter(ctx, minter.Address))
+	direction, found := k.GetPauseExemption(ctx, k.Denom, treasury.Bytes)
+	require.True(t, found)
+	require.Equal(t, types.ExemptionDirectionBoth, direction)
+	events := ctx.EventManager().Events()
+	address, found := events[len(events)-1].GetAttribute("address")
+	require.True(t, found)
+	require.Equal(t, `"`+treasury.Address+`"`, address.Value)
+
+	// ACT: Attempt to add pause exemption for receiving $TBILL.
+	_, err = server.AddPauseExemption(ctx, &types.MsgAddPauseExemption{
+		Signer:    owner.Address,
+		Address:   treasury.Address,
+		Direction: types.ExemptionDirectionReceive,
+		Denom:     TBILL,
+	})
+	// ASSERT: The action should've failed due to no owner set for $TBILL.
+	require.ErrorContains(t, err, "there is no owner")
 }
 
-func TestAddPauser(t *testing.T) {
+func TestRemovePauseExemption(t *testing.T) {
 	k, ctx := mocks.AuraKeeper()
 	server := keeper.NewMsgServer(k)
 
-	// ACT: Attempt to add pauser with no owner set.
-	_, err := server.AddPauser(ctx, &types.MsgAddPauser{})
+	// ACT: Attempt to remove pause exemption with no owner set.
+	_, err := server.RemovePauseExemption(ctx, &types.MsgRemovePauseExemption{})
 	// ASSERT: The action should've failed due to no owner set.
 	require.ErrorContains(t, err, "there is no owner")
 
 	// ARRANGE: Set owner in state.
 	owner := utils.TestAccount()
-	require.NoError(t, k.SetOwner(ctx, owner.Address))
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
 
-	// ACT: Attempt to add pauser with invalid signer.
-	_, err = server.AddPauser(ctx, &types.MsgAddPauser{
+	// ACT: Attempt to remove pause exemption with invalid signer.
+	_, err = server.RemovePauseExemption(ctx, &types.MsgRemovePauseExemption{
 		Signer: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
 
-	// ARRANGE: Generate two pauser accounts, add one to state.
-	pauser1, pauser2 := utils.TestAccount(), utils.TestAccount()
-	require.NoError(t, k.SetPauser(ctx, pauser2.Address))
+	// ARRANGE: Generate an account.
+	treasury := utils.TestAccount()
 
-	// ACT: Attempt to add pauser that already exists.
-	_, err = server.AddPauser(ctx, &types.MsgAddPauser{
-		Signer: owner.Address,
-		Pauser: pauser2.Address,
+	// ACT: Attempt to remove pause exemption that doesn't exist.
+	_, err = server.RemovePauseExemption(ctx, &types.MsgRemovePauseExemption{
+		Signer:  owner.Address,
+		Address: treasury.Address,
 	})
-	// ASSERT: The action should've failed due to existing pauser.
-	require.ErrorContains(t, err, "is already a pauser")
+	// ASSERT: The action should've failed due to missing exemption.
+	require.ErrorContains(t, err, "is not exempt")
 
-	// ARRANGE: Set up a failing collection store for the attribute setter.
-	tmp := k.Pausers
-	k.Pausers = collections.NewMap(
-		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
-		types.PauserPrefix, "pausers", collections.StringKey, collections.BytesValue,
+	// ARRANGE: Exempt the account in state.
+	require.NoError(t, k.SetPauseExemption(ctx, k.Denom, treasury.Bytes, types.ExemptionDirectionBoth))
+
+	// ARRANGE: Set up a failing collection store for the attribute remover.
+	tmp := k.PauseExemptions
+	k.PauseExemptions = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Delete, utils.GetKVStore(ctx, types.ModuleName))),
+		types.PauseExemptionPrefix, "pause_exemptions", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), types.ExemptionDirectionValue,
 	)
 
-	// ACT: Attempt to add pauser with failing Pausers collection store.
-	_, err = server.AddPauser(ctx, &types.MsgAddPauser{
-		Signer: owner.Address,
-		Pauser: pauser1.Address,
+	// ACT: Attempt to remove pause exemption with failing PauseExemptions collection store.
+	_, err = server.RemovePauseExemption(ctx, &types.MsgRemovePauseExemption{
+		Signer:  owner.Address,
+		Address: treasury.Address,
 	})
-	// ASSERT: The action should've failed due to collection store setter error.
+	// ASSERT: The action should've failed due to collection store remover error.
 	require.Error(t, err, mocks.ErrorStoreAccess)
-	k.Pausers = tmp
+	k.PauseExemptions = tmp
 
-	// ACT: Attempt to add pauser.
-	_, err = server.AddPauser(ctx, &types.MsgAddPauser{
-		Signer: owner.Address,
-		Pauser: pauser1.Address,
+	// ACT: Attempt to remove pause exemption with an uppercase address.
+	_, err = server.RemovePauseExemption(ctx, &types.MsgRemovePauseExemption{
+		Signer:  owner.Address,
+		Address: strings.ToUpper(treasury.Address),
 	})
-	// ASSERT: The action should've succeeded, and set pauser in state.
+	// ASSERT: The action should've succeeded, removed the exemption, and
+	// emitted the canonical address.
 	require.NoError(t, err)
-	require.True(t, k.HasPauser(ctx, pauser1.Address))
+	_, found := k.GetPauseExemption(ctx, k.Denom, treasury.Bytes)
+	require.False(t, found)
+	events := ctx.EventManager().Events()
+	address, found := events[len(events)-1].GetAttribute("address")
+	require.True(t, found)
+	require.Equal(t, `"`+treasury.Address+`"`, address.Value)
 }
 
-func TestRemovePauser(t *testing.T) {
+func TestSetChannelMode(t *testing.T) {
 	k, ctx := mocks.AuraKeeper()
 	server := keeper.NewMsgServer(k)
 
-	// ACT: Attempt to remove pauser with no owner set.
-	_, err := server.RemovePauser(ctx, &types.MsgRemovePauser{})
+	// ACT: Attempt to set channel mode with no owner set.
+	_, err := server.SetChannelMode(ctx, &types.MsgSetChannelMode{})
 	// ASSERT: The action should've failed due to no owner set.
 	require.ErrorContains(t, err, "there is no owner")
 
 	// ARRANGE: Set owner in state.
 	owner := utils.TestAccount()
-	require.NoError(t, k.SetOwner(ctx, owner.Address))
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
 
-	// ACT: Attempt to remove pauser with invalid signer.
-	_, err = server.RemovePauser(ctx, &types.MsgRemovePauser{
+	// ACT: Attempt to set channel mode with invalid signer.
+	_, err = server.SetChannelMode(ctx, &types.MsgSetChannelMode{
 		Signer: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
 
-	// ARRANGE: Generate a pauser account.
-	pauser := utils.TestAccount()
-
-	// ACT: Attempt to remove pauser that does not exist.
-	_, err = server.RemovePauser(ctx, &types.MsgRemovePauser{
+	// ACT: Attempt to set channel mode with invalid mode.
+	_, err = server.SetChannelMode(ctx, &types.MsgSetChannelMode{
 		Signer: owner.Address,
-		Pauser: pauser.Address,
+		Mode:   types.ChannelMode(10),
 	})
-	// ASSERT: The action should've failed due to non-existent pauser.
-	require.ErrorContains(t, err, "is not a pauser")
-
-	// ARRANGE: Set pauser in state.
-	require.NoError(t, k.SetPauser(ctx, pauser.Address))
+	// ASSERT: The action should've failed due to invalid mode.
+	require.ErrorContains(t, err, "invalid channel mode")
 
-	// ARRANGE: Set up a failing collection store for the attribute delete.
-	tmp := k.Pausers
-	k.Pausers = collections.NewMap(
-		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Delete, utils.GetKVStore(ctx, types.ModuleName))),
-		types.PauserPrefix, "pausers", collections.StringKey, collections.BytesValue,
+	// ARRANGE: Set up a failing collection store for the attribute setter.
+	tmp := k.ChannelMode
+	k.ChannelMode = collections.NewItem(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.ChannelModeKey, "channel_mode", types.ChannelModeValue,
 	)
 
-	// ACT: Attempt to remove pauser with failing Pausers collection store.
-	_, err = server.RemovePauser(ctx, &types.MsgRemovePauser{
+	// ACT: Attempt to set channel mode with failing ChannelMode collection store.
+	_, err = server.SetChannelMode(ctx, &types.MsgSetChannelMode{
 		Signer: owner.Address,
-		Pauser: pauser.Address,
+		Mode:   types.ChannelModeAllowlist,
 	})
-	// ASSERT: The action should've failed due to collection store delete error.
+	// ASSERT: The action should've failed due to collection store setter error.
 	require.Error(t, err, mocks.ErrorStoreAccess)
-	k.Pausers = tmp
+	k.ChannelMode = tmp
 
-	// ACT: Attempt to remove pauser.
-	_, err = server.RemovePauser(ctx, &types.MsgRemovePauser{
+	// ACT: Attempt to set channel mode.
+	_, err = server.SetChannelMode(ctx, &types.MsgSetChannelMode{
 		Signer: owner.Address,
-		Pauser: pauser.Address,
+		Mode:   types.ChannelModeAllowlist,
 	})
-	// ASSERT: The action should've succeeded, and removed pauser in state.
+	// ASSERT: The action should've succeeded, and set mode in state.
+	require.NoError(t, err)
+	require.Equal(t, types.ChannelModeAllowlist, k.GetChannelMode(ctx))
+
+	// ACT: Attempt to set channel mode to unspecified.
+	_, err = server.SetChannelMode(ctx, &types.MsgSetChannelMode{
+		Signer: owner.Address,
+	})
+	// ASSERT: The action should've succeeded, and set mode in state as denylist.
 	require.NoError(t, err)
-	require.False(t, k.HasPauser(ctx, pauser.Address))
+	require.Equal(t, types.ChannelModeDenylist, k.GetChannelMode(ctx))
 }
 
-func TestAddBlockedChannel(t *testing.T) {
+func TestAddAllowedChannel(t *testing.T) {
 	k, ctx := mocks.AuraKeeper()
 	server := keeper.NewMsgServer(k)
 
-	// ACT: Attempt to add blocked channel with no owner set.
-	_, err := server.AddBlockedChannel(ctx, &types.MsgAddBlockedChannel{})
+	// ACT: Attempt to add allowed channel with no owner set.
+	_, err := server.AddAllowedChannel(ctx, &types.MsgAddAllowedChannel{})
 	// ASSERT: The action should've failed due to no owner set.
 	require.ErrorContains(t, err, "there is no owner")
 
 	// ARRANGE: Set owner in state.
 	owner := utils.TestAccount()
-	require.NoError(t, k.SetOwner(ctx, owner.Address))
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
 
-	// ACT: Attempt to add blocked channel with invalid signer.
-	_, err = server.AddBlockedChannel(ctx, &types.MsgAddBlockedChannel{
+	// ACT: Attempt to add allowed channel with invalid signer.
+	_, err = server.AddAllowedChannel(ctx, &types.MsgAddAllowedChannel{
 		Signer: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
 
+	// ACT: Attempt to add allowed channel with invalid channel.
+	_, err = server.AddAllowedChannel(ctx, &types.MsgAddAllowedChannel{
+		Signer:  owner.Address,
+		Channel: "channel0",
+	})
+	// ASSERT: The action should've failed due to invalid channel.
+	require.ErrorContains(t, err, "invalid channel")
+
 	// ARRANGE: Generate two channel, add one to state.
 	channel1, channel2 := "channel-0", "channel-1"
-	require.NoError(t, k.SetBlockedChannel(ctx, channel2))
+	require.NoError(t, k.SetAllowedChannel(ctx, channel2))
 
-	// ACT: Attempt to add blocked channel that is blocked.
-	_, err = server.AddBlockedChannel(ctx, &types.MsgAddBlockedChannel{
+	// ACT: Attempt to add allowed channel that is allowed.
+	_, err = server.AddAllowedChannel(ctx, &types.MsgAddAllowedChannel{
 		Signer:  owner.Address,
 		Channel: channel2,
 	})
-	// ASSERT: The action should've failed due to blocked channel.
-	require.ErrorContains(t, err, "is already blocked")
+	// ASSERT: The action should've failed due to allowed channel.
+	require.ErrorContains(t, err, "is already allowed")
 
 	// ARRANGE: Set up a failing collection store for the attribute setter.
-	tmp := k.BlockedChannels
-	k.BlockedChannels = collections.NewMap(
+	tmp := k.AllowedChannels
+	k.AllowedChannels = collections.NewMap(
 		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
-		types.BlockedChannelPrefix, "blocked_channels", collections.StringKey, collections.BytesValue,
+		types.AllowedChannelPrefix, "allowed_channels", collections.StringKey, collections.BytesValue,
 	)
 
-	// ACT: Attempt to add blocked channel with failing BlockedChannels collection store.
-	_, err = server.AddBlockedChannel(ctx, &types.MsgAddBlockedChannel{
+	// ACT: Attempt to add allowed channel with failing AllowedChannels collection store.
+	_, err = server.AddAllowedChannel(ctx, &types.MsgAddAllowedChannel{
 		Signer:  owner.Address,
 		Channel: channel1,
 	})
 	// ASSERT: The action should've failed due to collection store setter error.
 	require.Error(t, err, mocks.ErrorStoreAccess)
-	k.BlockedChannels = tmp
+	k.AllowedChannels = tmp
 
-	// ACT: Attempt to add blocked channel.
-	_, err = server.AddBlockedChannel(ctx, &types.MsgAddBlockedChannel{
+	// ACT: Attempt to add allowed channel.
+	_, err = server.AddAllowedChannel(ctx, &types.MsgAddAllowedChannel{
 		Signer:  owner.Address,
 		Channel: channel1,
 	})
 	// ASSERT: The action should've succeeded, and set channel in state.
 	require.NoError(t, err)
-	require.True(t, k.HasBlockedChannel(ctx, channel1))
+	require.True(t, k.HasAllowedChannel(ctx, channel1))
 }
 
-func TestRemoveBlockedChannel(t *testing.T) {
+func TestRemoveAllowedChannel(t *testing.T) {
 	k, ctx := mocks.AuraKeeper()
 	server := keeper.NewMsgServer(k)
 
-	// ACT: Attempt to remove blocked channel with no owner set.
-	_, err := server.RemoveBlockedChannel(ctx, &types.MsgRemoveBlockedChannel{})
+	// ACT: Attempt to remove allowed channel with no owner set.
+	_, err := server.RemoveAllowedChannel(ctx, &types.MsgRemoveAllowedChannel{})
 	// ASSERT: The action should've failed due to no owner set.
 	require.ErrorContains(t, err, "there is no owner")
 
 	// ARRANGE: Set owner in state.
 	owner := utils.TestAccount()
-	require.NoError(t, k.SetOwner(ctx, owner.Address))
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
 
-	// ACT: Attempt to remove blocked channel with invalid signer.
-	_, err = server.RemoveBlockedChannel(ctx, &types.MsgRemoveBlockedChannel{
+	// ACT: Attempt to remove allowed channel with invalid signer.
+	_, err = server.RemoveAllowedChannel(ctx, &types.MsgRemoveAllowedChannel{
 		Signer: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
@@ -1034,39 +2370,261 @@ func TestRemoveBlockedChannel(t *testing.T) {
 	// ARRANGE: Generate a channel.
 	channel := "channel-0"
 
-	// ACT: Attempt to remove blocked channel that isn't blocked.
-	_, err = server.RemoveBlockedChannel(ctx, &types.MsgRemoveBlockedChannel{
+	// ACT: Attempt to remove allowed channel that isn't allowed.
+	_, err = server.RemoveAllowedChannel(ctx, &types.MsgRemoveAllowedChannel{
 		Signer:  owner.Address,
 		Channel: channel,
 	})
-	// ASSERT: The action should've failed due to allowed channel.
-	require.ErrorContains(t, err, "is not blocked")
+	// ASSERT: The action should've failed due to channel not being allowed.
+	require.ErrorContains(t, err, "is not allowed")
 
 	// ARRANGE: Set channel in state.
-	require.NoError(t, k.SetBlockedChannel(ctx, channel))
+	require.NoError(t, k.SetAllowedChannel(ctx, channel))
 
 	// ARRANGE: Set up a failing collection store for the attribute delete.
-	tmp := k.BlockedChannels
-	k.BlockedChannels = collections.NewMap(
+	tmp := k.AllowedChannels
+	k.AllowedChannels = collections.NewMap(
 		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Delete, utils.GetKVStore(ctx, types.ModuleName))),
-		types.BlockedChannelPrefix, "blocked_channels", collections.StringKey, collections.BytesValue,
+		types.AllowedChannelPrefix, "allowed_channels", collections.StringKey, collections.BytesValue,
 	)
 
-	// ACT: Attempt to remove blocked channel with failing BlockedChannels collection store.
-	_, err = server.RemoveBlockedChannel(ctx, &types.MsgRemoveBlockedChannel{
+	// ACT: Attempt to remove allowed channel with failing AllowedChannels collection store.
+	_, err = server.RemoveAllowedChannel(ctx, &types.MsgRemoveAllowedChannel{
 		Signer:  owner.Address,
 		Channel: channel,
 	})
 	// ASSERT: The action should've failed due to collection store delete error.
 	require.Error(t, err, mocks.ErrorStoreAccess)
-	k.BlockedChannels = tmp
+	k.AllowedChannels = tmp
 
-	// ACT: Attempt to remove blocked channel.
-	_, err = server.RemoveBlockedChannel(ctx, &types.MsgRemoveBlockedChannel{
+	// ACT: Attempt to remove allowed channel.
+	_, err = server.RemoveAllowedChannel(ctx, &types.MsgRemoveAllowedChannel{
 		Signer:  owner.Address,
 		Channel: channel,
 	})
 	// ASSERT: The action should've succeeded, and removed channel in state.
 	require.NoError(t, err)
-	require.False(t, k.HasBlockedChannel(ctx, channel))
+	require.False(t, k.HasAllowedChannel(ctx, channel))
+}
+
+func TestSetRateLimit(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to set rate limit with no owner set.
+	_, err := server.SetRateLimit(ctx, &types.MsgSetRateLimit{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to set rate limit with invalid signer.
+	_, err = server.SetRateLimit(ctx, &types.MsgSetRateLimit{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ACT: Attempt to set rate limit with invalid channel.
+	_, err = server.SetRateLimit(ctx, &types.MsgSetRateLimit{
+		Signer:  owner.Address,
+		Channel: "channel0",
+	})
+	// ASSERT: The action should've failed due to invalid channel.
+	require.ErrorContains(t, err, "invalid channel")
+
+	// ACT: Attempt to set rate limit with no window.
+	_, err = server.SetRateLimit(ctx, &types.MsgSetRateLimit{
+		Signer:    owner.Address,
+		Channel:   "channel-0",
+		MaxAmount: ONE,
+	})
+	// ASSERT: The action should've failed due to invalid window.
+	require.ErrorContains(t, err, "window must be positive")
+
+	// ACT: Attempt to set rate limit with no limits.
+	_, err = server.SetRateLimit(ctx, &types.MsgSetRateLimit{
+		Signer:  owner.Address,
+		Channel: "channel-0",
+		Window:  time.Hour,
+	})
+	// ASSERT: The action should've failed due to no limits.
+	require.ErrorContains(t, err, "either max amount or max percentage must be set")
+
+	// ACT: Attempt to set rate limit with negative amount.
+	_, err = server.SetRateLimit(ctx, &types.MsgSetRateLimit{
+		Signer:    owner.Address,
+		Channel:   "channel-0",
+		Window:    time.Hour,
+		MaxAmount: ONE.Neg(),
+	})
+	// ASSERT: The action should've failed due to negative amount.
+	require.ErrorContains(t, err, "max amount must be non-negative")
+
+	// ACT: Attempt to set rate limit with a percentage above 100.
+	_, err = server.SetRateLimit(ctx, &types.MsgSetRateLimit{
+		Signer:        owner.Address,
+		Channel:       "channel-0",
+		Window:        time.Hour,
+		MaxPercentage: math.LegacyNewDec(101),
+	})
+	// ASSERT: The action should've failed due to invalid percentage.
+	require.ErrorContains(t, err, "max percentage must be between 0 and 100")
+
+	// ARRANGE: Set up a failing collection store for the attribute setter.
+	tmp := k.RateLimits
+	k.RateLimits = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.RateLimitPrefix, "rate_limits", collections.StringKey, codec.CollValue[types.RateLimit](mocks.Codec()),
+	)
+
+	// ACT: Attempt to set rate limit with failing RateLimits collection store.
+	_, err = server.SetRateLimit(ctx, &types.MsgSetRateLimit{
+		Signer:    owner.Address,
+		Channel:   "channel-0",
+		Window:    time.Hour,
+		MaxAmount: ONE,
+	})
+	// ASSERT: The action should've failed due to collection store setter error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.RateLimits = tmp
+
+	// ARRANGE: Set a flow for the channel in state.
+	require.NoError(t, k.SetRateLimitFlow(ctx, types.RateLimitFlow{
+		Channel: "channel-0",
+		Inflow:  math.ZeroInt(),
+		Outflow: ONE,
+		Supply:  math.ZeroInt(),
+	}))
+
+	// ACT: Attempt to set rate limit.
+	_, err = server.SetRateLimit(ctx, &types.MsgSetRateLimit{
+		Signer:    owner.Address,
+		Channel:   "channel-0",
+		Window:    time.Hour,
+		MaxAmount: ONE,
+	})
+	// ASSERT: The action should've succeeded, set rate limit in state, and reset the flow.
+	require.NoError(t, err)
+	require.True(t, k.HasRateLimit(ctx, "channel-0"))
+	rateLimit := k.GetRateLimit(ctx, "channel-0")
+	require.Equal(t, time.Hour, rateLimit.Window)
+	require.Equal(t, ONE, rateLimit.MaxAmount)
+	require.True(t, rateLimit.MaxPercentage.IsZero())
+	require.Empty(t, k.GetRateLimitFlows(ctx))
+}
+
+func TestRemoveRateLimit(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to remove rate limit with no owner set.
+	_, err := server.RemoveRateLimit(ctx, &types.MsgRemoveRateLimit{})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorContains(t, err, "there is no owner")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to remove rate limit with invalid signer.
+	_, err = server.RemoveRateLimit(ctx, &types.MsgRemoveRateLimit{
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorContains(t, err, types.ErrInvalidOwner.Error())
+
+	// ACT: Attempt to remove rate limit that doesn't exist.
+	_, err = server.RemoveRateLimit(ctx, &types.MsgRemoveRateLimit{
+		Signer:  owner.Address,
+		Channel: "channel-0",
+	})
+	// ASSERT: The action should've failed due to channel not being rate limited.
+	require.ErrorContains(t, err, "is not rate limited")
+
+	// ARRANGE: Set rate limit in state.
+	require.NoError(t, k.SetRateLimit(ctx, types.RateLimit{
+		Channel:       "channel-0",
+		Window:        time.Hour,
+		MaxAmount:     ONE,
+		MaxPercentage: math.LegacyZeroDec(),
+	}))
+
+	// ARRANGE: Set up a failing collection store for the attribute delete.
+	tmp := k.RateLimits
+	k.RateLimits = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Delete, utils.GetKVStore(ctx, types.ModuleName))),
+		types.RateLimitPrefix, "rate_limits", collections.StringKey, codec.CollValue[types.RateLimit](mocks.Codec()),
+	)
+
+	// ACT: Attempt to remove rate limit with failing RateLimits collection store.
+	_, err = server.RemoveRateLimit(ctx, &types.MsgRemoveRateLimit{
+		Signer:  owner.Address,
+		Channel: "channel-0",
+	})
+	// ASSERT: The action should've failed due to collection store delete error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.RateLimits = tmp
+
+	// ACT: Attempt to remove rate limit.
+	_, err = server.RemoveRateLimit(ctx, &types.MsgRemoveRateLimit{
+		Signer:  owner.Address,
+		Channel: "channel-0",
+	})
+	// ASSERT: The action should've succeeded, and removed rate limit in state.
+	require.NoError(t, err)
+	require.False(t, k.HasRateLimit(ctx, "channel-0"))
+}
+
+func TestBlockedRoles(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set owner in state, and a blocked user with roles inherited
+	// from before the blocklist was checked.
+	owner, user := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+	require.NoError(t, k.SetOwner(ctx, TBILL, owner.Address))
+	require.NoError(t, k.SetBlockedAddress(ctx, user.Bytes))
+	require.NoError(t, k.SetMinter(ctx, k.Denom, user.Bytes, ONE))
+	require.NoError(t, k.SetBurner(ctx, k.Denom, user.Bytes, ONE))
+
+	// ACT: Attempt to add blocked user as a pauser.
+	_, err := server.AddPauser(ctx, &types.MsgAddPauser{
+		Signer: owner.Address,
+		Pauser: user.Address,
+	})
+	// ASSERT: The action should've failed due to blocked address.
+	require.ErrorIs(t, err, types.ErrBlockedAddress)
+
+	// ACT: Attempt to add blocked user as a pauser of a denom not subject to the blocklist.
+	_, err = server.AddPauser(ctx, &types.MsgAddPauser{
+		Signer: owner.Address,
+		Pauser: user.Address,
+		Denom:  TBILL,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ACT: Attempt to mint as blocked minter.
+	_, err = server.Mint(ctx, &types.MsgMint{
+		Signer: user.Address,
+		To:     owner.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to blocked address.
+	require.ErrorIs(t, err, types.ErrBlockedAddress)
+
+	// ACT: Attempt to burn as blocked burner.
+	_, err = server.Burn(ctx, &types.MsgBurn{
+		Signer: user.Address,
+		From:   owner.Address,
+		Amount: ONE,
+	})
+	// ASSERT: The action should've failed due to blocked address.
+	require.ErrorIs(t, err, types.ErrBlockedAddress)
+	require.Equal(t, ONE, k.GetMinter(ctx, k.Denom, user.Bytes))
+	require.Equal(t, ONE, k.GetBurner(ctx, k.Denom, user.Bytes))
 }
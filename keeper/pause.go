@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+// BeginBlock indexes newly created transfer channels, starts scheduled pauses
+// whose start is reached, lifts pauses whose end is reached, and resets
+// expired allowances. Failures to update a single pause window or allowance
+// are logged, so that they cannot halt the chain.
+func (k *Keeper) BeginBlock(ctx context.Context) error {
+	// NOTE: A channel can only carry transfers once its handshake completes,
+	// which is never in the block it was created in.
+	if err := k.indexTransferChannels(ctx); err != nil {
+		return err
+	}
+
+	k.updatePauseWindows(ctx)
+	k.expireAllowances(ctx)
+
+	return nil
+}
+
+// updatePauseWindows starts scheduled pauses whose start is reached, and lifts
+// pauses whose end is reached. Only windows with a bound reached are visited,
+// and each of them is updated in isolation. The resulting events are emitted
+// on behalf of the module account.
+func (k *Keeper) updatePauseWindows(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height, now := sdkCtx.BlockHeight(), sdkCtx.BlockTime()
+
+	// NOTE: Due windows are collected first, as they are modified below.
+	due := make(map[collections.Pair[string, types.PauseScope]]struct{})
+	for _, key := range dueKeys(sdkCtx, k.PauseWindowsByHeight, collections.NewPrefixUntilTripleRange[int64, string, types.PauseScope](height)) {
+		due[collections.Join(key.K2(), key.K3())] = struct{}{}
+	}
+	for _, key := range dueKeys(sdkCtx, k.PauseWindowsByTime, collections.NewPrefixUntilTripleRange[time.Time, string, types.PauseScope](now)) {
+		due[collections.Join(key.K2(), key.K3())] = struct{}{}
+	}
+	if len(due) == 0 {
+		return
+	}
+
+	keys := make([]collections.Pair[string, types.PauseScope], 0, len(due))
+	for key := range due {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].K1() != keys[j].K1() {
+			return keys[i].K1() < keys[j].K1()
+		}
+		return keys[i].K2() < keys[j].K2()
+	})
+
+	for _, key := range keys {
+		window, found := k.GetPauseWindow(ctx, key.K1(), key.K2())
+		if !found {
+			continue
+		}
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.updatePauseWindowState(cacheCtx, window, height, now); err != nil {
+			sdkCtx.Logger().Error("unable to update pause window", "denom", window.Denom, "scope", window.Scope.String(), "err", err)
+			continue
+		}
+		write()
+	}
+}
+
+// updatePauseWindowState lifts the pause of a window whose end is reached, or
+// starts the pause of a window whose start is reached.
+func (k *Keeper) updatePauseWindowState(ctx context.Context, window types.PauseWindow, height int64, now time.Time) error {
+	account, err := k.addressCodec.BytesToString(types.ModuleAddress)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case window.Ended(height, now):
+		if err := k.DeletePauseWindow(ctx, window.Denom, window.Scope); err != nil {
+			return err
+		}
+		if !k.IsPaused(ctx, window.Denom, window.Scope) {
+			return nil
+		}
+
+		if err := k.SetPaused(ctx, window.Denom, window.Scope, false); err != nil {
+			return err
+		}
+		return k.eventService.EventManager(ctx).Emit(ctx, &types.Unpaused{
+			Account: account,
+			Denom:   window.Denom,
+			Scope:   window.Scope,
+		})
+	case window.HasStart() && window.Started(height, now):
+		// NOTE: Once started, only the end of the window is kept.
+		window.StartHeight, window.StartTime = 0, time.Time{}
+		if err := k.updatePauseWindow(ctx, window); err != nil {
+			return err
+		}
+		if k.IsPaused(ctx, window.Denom, window.Scope) {
+			return nil
+		}
+
+		if err := k.SetPaused(ctx, window.Denom, window.Scope, true); err != nil {
+			return err
+		}
+		return k.eventService.EventManager(ctx).Emit(ctx, &types.Paused{
+			Account:   account,
+			Denom:     window.Denom,
+			Scope:     window.Scope,
+			EndHeight: window.EndHeight,
+			EndTime:   window.EndTime,
+		})
+	}
+
+	return nil
+}
+
+// dueKeys returns the keys of an index within the provided range. Keys that
+// cannot be decoded are logged and skipped.
+func dueKeys[K any](ctx sdk.Context, index collections.KeySet[K], rng collections.Ranger[K]) (keys []K) {
+	iter, err := index.Iterate(ctx, rng)
+	if err != nil {
+		ctx.Logger().Error("unable to iterate index", "err", err)
+		return nil
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			ctx.Logger().Error("unable to decode index key", "err", err)
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+
+	return
+}
+
+// updatePauseWindow stores a pause window, or removes it if it no longer has
+// a start or an end.
+func (k *Keeper) updatePauseWindow(ctx context.Context, window types.PauseWindow) error {
+	if !window.HasStart() && !window.HasEnd() {
+		return k.DeletePauseWindow(ctx, window.Denom, window.Scope)
+	}
+
+	return k.SetPauseWindow(ctx, window)
+}
+
+// validatePauseWindow returns an error if the bounds of a pause window are
+// invalid, or any of them is not in the future.
+func (k *Keeper) validatePauseWindow(ctx context.Context, window types.PauseWindow) error {
+	if err := window.ValidateBounds(); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height, now := sdkCtx.BlockHeight(), sdkCtx.BlockTime()
+	if window.StartHeight > 0 && window.StartHeight <= height {
+		return fmt.Errorf("start height must be after current height %d", height)
+	}
+	if !window.StartTime.IsZero() && !window.StartTime.After(now) {
+		return fmt.Errorf("start time must be after current time %s", now)
+	}
+	if window.EndHeight > 0 && window.EndHeight <= height {
+		return fmt.Errorf("end height must be after current height %d", height)
+	}
+	if !window.EndTime.IsZero() && !window.EndTime.After(now) {
+		return fmt.Errorf("end time must be after current time %s", now)
+	}
+
+	return nil
+}
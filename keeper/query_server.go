@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	sdkerrors "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ondoprotocol/usdy-noble/v2/types"
 )
@@ -25,12 +26,38 @@ func (k queryServer) Denom(_ context.Context, req *types.QueryDenom) (*types.Que
 	return &types.QueryDenomResponse{Denom: k.Keeper.Denom}, nil
 }
 
+func (k queryServer) Denoms(ctx context.Context, req *types.QueryDenoms) (*types.QueryDenomsResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	var bound []string
+	for _, denom := range k.Keeper.Denoms {
+		if k.IsBlocklistBound(ctx, denom) {
+			bound = append(bound, denom)
+		}
+	}
+
+	return &types.QueryDenomsResponse{Denoms: k.Keeper.Denoms, BlocklistBound: bound}, nil
+}
+
 func (k queryServer) Paused(ctx context.Context, req *types.QueryPaused) (*types.QueryPausedResponse, error) {
 	if req == nil {
 		return nil, errors.ErrInvalidRequest
 	}
 
-	return &types.QueryPausedResponse{Paused: k.GetPaused(ctx)}, nil
+	denom, err := k.resolveDenom(req.Denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(errors.ErrInvalidRequest, err.Error())
+	}
+
+	scopes := k.GetPausedScopes(ctx, denom)
+
+	return &types.QueryPausedResponse{
+		Paused:  len(scopes) > 0,
+		Scopes:  scopes,
+		Windows: k.GetPauseWindowsByDenom(ctx, denom),
+	}, nil
 }
 
 func (k queryServer) Owner(ctx context.Context, req *types.QueryOwner) (*types.QueryOwnerResponse, error) {
@@ -38,9 +65,14 @@ func (k queryServer) Owner(ctx context.Context, req *types.QueryOwner) (*types.Q
 		return nil, errors.ErrInvalidRequest
 	}
 
+	denom, err := k.resolveDenom(req.Denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(errors.ErrInvalidRequest, err.Error())
+	}
+
 	return &types.QueryOwnerResponse{
-		Owner:        k.GetOwner(ctx),
-		PendingOwner: k.GetPendingOwner(ctx),
+		Owner:        k.GetOwner(ctx, denom),
+		PendingOwner: k.GetPendingOwner(ctx, denom),
 	}, nil
 }
 
@@ -49,7 +81,12 @@ func (k queryServer) Burners(ctx context.Context, req *types.QueryBurners) (*typ
 		return nil, errors.ErrInvalidRequest
 	}
 
-	return &types.QueryBurnersResponse{Burners: k.GetBurners(ctx)}, nil
+	denom, err := k.resolveDenom(req.Denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(errors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryBurnersResponse{Burners: k.GetBurners(ctx, denom)}, nil
 }
 
 func (k queryServer) Minters(ctx context.Context, req *types.QueryMinters) (*types.QueryMintersResponse, error) {
@@ -57,7 +94,12 @@ func (k queryServer) Minters(ctx context.Context, req *types.QueryMinters) (*typ
 		return nil, errors.ErrInvalidRequest
 	}
 
-	return &types.QueryMintersResponse{Minters: k.GetMinters(ctx)}, nil
+	denom, err := k.resolveDenom(req.Denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(errors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryMintersResponse{Minters: k.GetMinters(ctx, denom)}, nil
 }
 
 func (k queryServer) Pausers(ctx context.Context, req *types.QueryPausers) (*types.QueryPausersResponse, error) {
@@ -65,7 +107,12 @@ func (k queryServer) Pausers(ctx context.Context, req *types.QueryPausers) (*typ
 		return nil, errors.ErrInvalidRequest
 	}
 
-	return &types.QueryPausersResponse{Pausers: k.GetPausers(ctx)}, nil
+	denom, err := k.resolveDenom(req.Denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(errors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryPausersResponse{Pausers: k.GetPausers(ctx, denom)}, nil
 }
 
 func (k queryServer) BlockedChannels(ctx context.Context, req *types.QueryBlockedChannels) (*types.QueryBlockedChannelsResponse, error) {
@@ -73,5 +120,69 @@ func (k queryServer) BlockedChannels(ctx context.Context, req *types.QueryBlocke
 		return nil, errors.ErrInvalidRequest
 	}
 
-	return &types.QueryBlockedChannelsResponse{BlockedChannels: k.GetBlockedChannels(ctx)}, nil
+	denom, err := k.resolveDenom(req.Denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(errors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryBlockedChannelsResponse{BlockedChannels: k.GetBlockedChannels(ctx, denom)}, nil
+}
+
+func (k queryServer) PauseExemptions(ctx context.Context, req *types.QueryPauseExemptions) (*types.QueryPauseExemptionsResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	denom, err := k.resolveDenom(req.Denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(errors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryPauseExemptionsResponse{PauseExemptions: k.GetPauseExemptions(ctx, denom)}, nil
+}
+
+func (k queryServer) ChannelMode(ctx context.Context, req *types.QueryChannelMode) (*types.QueryChannelModeResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &types.QueryChannelModeResponse{Mode: k.GetChannelMode(ctx)}, nil
+}
+
+func (k queryServer) AllowedChannels(ctx context.Context, req *types.QueryAllowedChannels) (*types.QueryAllowedChannelsResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &types.QueryAllowedChannelsResponse{AllowedChannels: k.GetAllowedChannels(ctx)}, nil
+}
+
+func (k queryServer) RateLimits(ctx context.Context, req *types.QueryRateLimits) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &types.QueryRateLimitsResponse{RateLimits: k.GetRateLimits(ctx)}, nil
+}
+
+func (k queryServer) RateLimitUsage(ctx context.Context, req *types.QueryRateLimitUsage) (*types.QueryRateLimitUsageResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	if !k.HasRateLimit(ctx, req.Channel) {
+		return nil, sdkerrors.Wrapf(errors.ErrNotFound, "%s is not rate limited", req.Channel)
+	}
+
+	rateLimit := k.GetRateLimit(ctx, req.Channel)
+	flow := k.GetCurrentRateLimitFlow(ctx, rateLimit)
+	quota := rateLimit.Quota(flow.Supply)
+
+	return &types.QueryRateLimitUsageResponse{
+		RateLimit:        rateLimit,
+		Flow:             flow,
+		Quota:            quota,
+		RemainingOutflow: flow.Remaining(quota, types.ChannelDirectionOutbound),
+		RemainingInflow:  flow.Remaining(quota, types.ChannelDirectionInbound),
+	}, nil
 }
@@ -63,6 +63,16 @@ func (k blocklistQueryServer) Address(ctx context.Context, req *blocklist.QueryA
 		return nil, errors.Wrapf(err, "unable to decode address %s", req.Address)
 	}
 
-	blocked := k.HasBlockedAddress(ctx, address)
-	return &blocklist.QueryAddressResponse{Blocked: blocked}, nil
+	return &blocklist.QueryAddressResponse{
+		Blocked:        k.HasBlockedAddress(ctx, address),
+		RolesSuspended: k.HasSuspendedAddress(ctx, address),
+	}, nil
+}
+
+func (k blocklistQueryServer) RoleConflicts(ctx context.Context, req *blocklist.QueryRoleConflicts) (*blocklist.QueryRoleConflictsResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	return &blocklist.QueryRoleConflictsResponse{Conflicts: k.GetRoleConflicts(ctx)}, nil
 }
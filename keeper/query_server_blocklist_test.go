@@ -109,4 +109,70 @@ func TestBlocklistAddressQuery(t *testing.T) {
 	// ASSERT: The query should've succeeded.
 	require.NoError(t, err)
 	require.True(t, res.Blocked)
+	require.False(t, res.RolesSuspended)
+
+	// ARRANGE: Suspend the roles of the blocked address in state.
+	require.NoError(t, k.SetSuspendedAddress(ctx, user.Bytes))
+
+	// ACT: Attempt to query blocked state of suspended address.
+	res, err = server.Address(ctx, &blocklist.QueryAddress{
+		Address: user.Address,
+	})
+	// ASSERT: The query should've succeeded.
+	require.NoError(t, err)
+	require.True(t, res.Blocked)
+	require.True(t, res.RolesSuspended)
+}
+
+func TestBlocklistRoleConflictsQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewBlocklistQueryServer(k)
+
+	// ACT: Attempt to query role conflicts with invalid request.
+	_, err := server.RoleConflicts(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ARRANGE: Set roles of a blocked and an unblocked user in state.
+	blocked, user := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetBlockedAddress(ctx, blocked.Bytes))
+	require.NoError(t, k.SetBurner(ctx, k.Denom, blocked.Bytes, ONE))
+	require.NoError(t, k.SetPauser(ctx, k.Denom, blocked.Bytes))
+	require.NoError(t, k.SetMinter(ctx, TBILL, blocked.Bytes, ONE))
+	require.NoError(t, k.SetMinter(ctx, k.Denom, user.Bytes, ONE))
+
+	// ACT: Attempt to query role conflicts.
+	res, err := server.RoleConflicts(ctx, &blocklist.QueryRoleConflicts{})
+	// ASSERT: The query should've succeeded, and only returned the roles of
+	// the blocked user on denoms subject to the blocklist.
+	require.NoError(t, err)
+	require.Equal(t, []blocklist.RoleConflict{
+		{Address: blocked.Address, Denom: k.Denom, Role: "burner"},
+		{Address: blocked.Address, Denom: k.Denom, Role: "pauser"},
+	}, res.Conflicts)
+
+	// ARRANGE: Bind the other governed denom to the blocklist.
+	require.NoError(t, k.SetBlocklistBinding(ctx, TBILL, true))
+
+	// ACT: Attempt to query role conflicts.
+	res, err = server.RoleConflicts(ctx, &blocklist.QueryRoleConflicts{})
+	// ASSERT: The query should've succeeded, and included the roles on the bound denom.
+	require.NoError(t, err)
+	require.Len(t, res.Conflicts, 3)
+	require.Contains(t, res.Conflicts, blocklist.RoleConflict{Address: blocked.Address, Denom: TBILL, Role: "minter"})
+
+	// ARRANGE: Block and suspend the roles of a user on an unbound denom.
+	require.NoError(t, k.SetBlocklistBinding(ctx, TBILL, false))
+	suspended := utils.TestAccount()
+	require.NoError(t, k.SetBlockedAddress(ctx, suspended.Bytes))
+	require.NoError(t, k.SetSuspendedAddress(ctx, suspended.Bytes))
+	require.NoError(t, k.SetPauser(ctx, TBILL, suspended.Bytes))
+
+	// ACT: Attempt to query role conflicts.
+	res, err = server.RoleConflicts(ctx, &blocklist.QueryRoleConflicts{})
+	// ASSERT: The query should've succeeded, and included the roles of the
+	// suspended user on the unbound denom.
+	require.NoError(t, err)
+	require.Len(t, res.Conflicts, 3)
+	require.Contains(t, res.Conflicts, blocklist.RoleConflict{Address: suspended.Address, Denom: TBILL, Role: "pauser"})
 }
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k queryServer) LockupPeriod(ctx context.Context, req *types.QueryLockupPeriod) (*types.QueryLockupPeriodResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &types.QueryLockupPeriodResponse{LockupPeriod: k.GetLockupPeriod(ctx)}, nil
+}
+
+func (k queryServer) LockedBalance(ctx context.Context, req *types.QueryLockedBalance) (*types.QueryLockedBalanceResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	address, err := k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode address %s", req.Address)
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	var lots []types.LockedLot
+	locked := math.ZeroInt()
+	for _, lot := range k.GetLockedLotsByAddress(ctx, address) {
+		if lot.UnlockTime.After(now) {
+			lots = append(lots, lot)
+			locked = locked.Add(lot.Amount)
+		}
+	}
+
+	unlocked := k.bankKeeper.GetBalance(ctx, address, k.Keeper.Denom).Amount.Sub(locked)
+	if unlocked.IsNegative() {
+		unlocked = math.ZeroInt()
+	}
+
+	return &types.QueryLockedBalanceResponse{
+		Locked:   locked,
+		Unlocked: unlocked,
+		Lots:     lots,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockupPeriodQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query lockup period with invalid request.
+	_, err := server.LockupPeriod(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ARRANGE: Set lockup period in state.
+	require.NoError(t, k.SetLockupPeriod(ctx, 40*types.Day))
+
+	// ACT: Attempt to query lockup period.
+	res, err := server.LockupPeriod(ctx, &types.QueryLockupPeriod{})
+	// ASSERT: The query should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, 40*types.Day, res.LockupPeriod)
+}
+
+func TestLockedBalanceQuery(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query locked balance with invalid request.
+	_, err := server.LockedBalance(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ARRANGE: Generate a user account.
+	user := utils.TestAccount()
+
+	// ACT: Attempt to query locked balance with invalid address.
+	_, err = server.LockedBalance(ctx, &types.QueryLockedBalance{Address: user.Invalid})
+	// ASSERT: The query should've failed due to invalid address.
+	require.ErrorContains(t, err, "unable to decode address")
+
+	// ARRANGE: Give user 3 $USDY, with 1 $USDY unlocking in 1 day, 1 in 2 days, and an expired lot.
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin(k.Denom, ONE.MulRaw(3)))
+	require.NoError(t, k.SetLockedLot(ctx, user.Bytes, ctx.BlockTime().Add(-types.Day), ONE))
+	require.NoError(t, k.SetLockedLot(ctx, user.Bytes, ctx.BlockTime().Add(types.Day), ONE))
+	require.NoError(t, k.SetLockedLot(ctx, user.Bytes, ctx.BlockTime().Add(2*types.Day), ONE))
+
+	// ACT: Attempt to query locked balance.
+	res, err := server.LockedBalance(ctx, &types.QueryLockedBalance{Address: user.Address})
+	// ASSERT: The query should've succeeded, and ignored the expired lot.
+	require.NoError(t, err)
+	require.Equal(t, ONE.MulRaw(2), res.Locked)
+	require.Equal(t, ONE, res.Unlocked)
+	require.Len(t, res.Lots, 2)
+	require.Equal(t, ctx.BlockTime().Add(types.Day), res.Lots[0].UnlockTime)
+
+	// ARRANGE: Advance past all lots.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * types.Day))
+
+	// ACT: Attempt to query locked balance.
+	res, err = server.LockedBalance(ctx, &types.QueryLockedBalance{Address: user.Address})
+	// ASSERT: The query should've succeeded, and returned everything as unlocked.
+	require.NoError(t, err)
+	require.True(t, res.Locked.IsZero())
+	require.Equal(t, ONE.MulRaw(3), res.Unlocked)
+	require.Empty(t, res.Lots)
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k queryServer) PriceSetters(ctx context.Context, req *types.QueryPriceSetters) (*types.QueryPriceSettersResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &types.QueryPriceSettersResponse{PriceSetters: k.GetPriceSetters(ctx)}, nil
+}
+
+func (k queryServer) PriceRanges(ctx context.Context, req *types.QueryPriceRanges) (*types.QueryPriceRangesResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &types.QueryPriceRangesResponse{
+		PriceRanges:       k.GetPriceRanges(ctx),
+		MaxPriceDeviation: k.GetMaxPriceDeviation(ctx),
+	}, nil
+}
+
+func (k queryServer) Price(ctx context.Context, req *types.QueryPrice) (*types.QueryPriceResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	price, found := k.GetPrice(ctx)
+	if !found {
+		return nil, sdkerrors.Wrap(errors.ErrNotFound, "no price range has started")
+	}
+
+	return &types.QueryPriceResponse{Price: price}, nil
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceSettersQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query price setters with invalid request.
+	_, err := server.PriceSetters(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ARRANGE: Set price setter in state.
+	priceSetter := utils.TestAccount()
+	require.NoError(t, k.SetPriceSetter(ctx, priceSetter.Bytes))
+
+	// ACT: Attempt to query price setters.
+	res, err := server.PriceSetters(ctx, &types.QueryPriceSetters{})
+	// ASSERT: The query should've succeeded, and returned price setters.
+	require.NoError(t, err)
+	require.Equal(t, []string{priceSetter.Address}, res.PriceSetters)
+}
+
+func TestPriceRangesQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query price ranges with invalid request.
+	_, err := server.PriceRanges(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query price ranges with no state.
+	res, err := server.PriceRanges(ctx, &types.QueryPriceRanges{})
+	// ASSERT: The query should've succeeded, and returned the default max price deviation.
+	require.NoError(t, err)
+	require.Empty(t, res.PriceRanges)
+	require.Equal(t, types.DefaultMaxPriceDeviation, res.MaxPriceDeviation)
+}
+
+func TestPriceQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+	start := time.Unix(1_700_000_000, 0).UTC()
+
+	// ACT: Attempt to query price with invalid request.
+	_, err := server.Price(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query price with no state.
+	_, err = server.Price(ctx, &types.QueryPrice{})
+	// ASSERT: The query should've failed due to no price ranges.
+	require.ErrorContains(t, err, "no price range has started")
+
+	// ARRANGE: Set two contiguous price ranges in state.
+	first := types.PriceRange{
+		Index:      0,
+		Start:      start,
+		End:        start.Add(10 * types.Day),
+		DailyRate:  math.LegacyMustNewDecFromStr("1.001"),
+		StartPrice: math.LegacyOneDec(),
+	}
+	second := types.PriceRange{
+		Index:      1,
+		Start:      first.End,
+		End:        first.End.Add(10 * types.Day),
+		DailyRate:  math.LegacyMustNewDecFromStr("1.002"),
+		StartPrice: first.ClosePrice(),
+	}
+	require.NoError(t, k.SetPriceRange(ctx, first))
+	require.NoError(t, k.SetPriceRange(ctx, second))
+
+	testCases := []struct {
+		name  string
+		time  time.Time
+		price string
+	}{
+		{name: "BeforeFirstRange", time: start.Add(-time.Second), price: ""},
+		{name: "StartOfFirstRange", time: start, price: "1.000000000000000000"},
+		{name: "WithinFirstDay", time: start.Add(23 * time.Hour), price: "1.000000000000000000"},
+		{name: "AfterTwoDays", time: start.Add(2 * types.Day), price: "1.002001000000000000"},
+		{name: "StartOfSecondRange", time: second.Start, price: "1.010045120210252210"},
+		{name: "AfterOneDayOfSecondRange", time: second.Start.Add(types.Day), price: "1.012065210450672714"},
+		{name: "AfterAllRanges", time: second.End.Add(types.Day), price: second.ClosePrice().String()},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// ACT: Attempt to query price at the given time.
+			res, err := server.Price(ctx.WithBlockTime(testCase.time), &types.QueryPrice{})
+
+			// ASSERT: The query should've returned the interpolated price.
+			if testCase.price == "" {
+				require.ErrorContains(t, err, "no price range has started")
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.price, res.Price.String())
+			}
+		})
+	}
+}
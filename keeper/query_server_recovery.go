@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k queryServer) Recoverers(ctx context.Context, req *types.QueryRecoverers) (*types.QueryRecoverersResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	denom, err := k.resolveDenom(req.Denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(errors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryRecoverersResponse{
+		Recoverers: k.GetRecoverers(ctx, denom),
+	}, nil
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoverersQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query recoverers with invalid request.
+	_, err := server.Recoverers(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query recoverers of an ungoverned denom.
+	_, err = server.Recoverers(ctx, &types.QueryRecoverers{Denom: "uusdc"})
+	// ASSERT: The query should've failed due to ungoverned denom.
+	require.ErrorContains(t, err, "is not governed by this module")
+
+	// ARRANGE: Set recoverers of both denoms in state.
+	recoverer1, recoverer2 := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetRecoverer(ctx, k.Denom, recoverer1.Bytes))
+	require.NoError(t, k.SetRecoverer(ctx, TBILL, recoverer2.Bytes))
+
+	// ACT: Attempt to query recoverers.
+	res, err := server.Recoverers(ctx, &types.QueryRecoverers{})
+	// ASSERT: The query should've succeeded, and returned recoverers of the default denom.
+	require.NoError(t, err)
+	require.Equal(t, []string{recoverer1.Address}, res.Recoverers)
+
+	// ACT: Attempt to query recoverers of the additional denom.
+	res, err = server.Recoverers(ctx, &types.QueryRecoverers{Denom: TBILL})
+	// ASSERT: The query should've succeeded, and returned recoverers of the additional denom.
+	require.NoError(t, err)
+	require.Equal(t, []string{recoverer2.Address}, res.Recoverers)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k queryServer) Redemption(ctx context.Context, req *types.QueryRedemption) (*types.QueryRedemptionResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	redemption, found := k.GetRedemption(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(errors.ErrNotFound, "redemption %d does not exist", req.Id)
+	}
+
+	return &types.QueryRedemptionResponse{Redemption: redemption}, nil
+}
+
+func (k queryServer) Redemptions(ctx context.Context, req *types.QueryRedemptions) (*types.QueryRedemptionsResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	redemptions, pagination, err := query.CollectionFilteredPaginate(
+		ctx, k.Keeper.Redemptions, req.Pagination,
+		func(_ uint64, redemption types.Redemption) (bool, error) {
+			return (req.User == "" || redemption.User == req.User) &&
+				(req.Status == types.RequestStatusUnspecified || redemption.Status == req.Status), nil
+		},
+		func(_ uint64, redemption types.Redemption) (types.Redemption, error) {
+			return redemption, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRedemptionsResponse{
+		Redemptions: redemptions,
+		Pagination:  pagination,
+	}, nil
+}
+
+func (k queryServer) RedemptionQueue(ctx context.Context, req *types.QueryRedemptionQueue) (*types.QueryRedemptionQueueResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	redemptions, pagination, err := query.CollectionPaginate(
+		ctx, k.PendingRedemptions, req.Pagination,
+		func(id uint64, _ collections.NoValue) (types.Redemption, error) {
+			return k.Keeper.Redemptions.Get(ctx, id)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRedemptionQueueResponse{
+		Redemptions: redemptions,
+		Pagination:  pagination,
+	}, nil
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// SetRedemptions sets redemptions of the provided users and statuses in
+// state, with sequential identifiers.
+func SetRedemptions(t *testing.T, k *keeper.Keeper, ctx sdk.Context, users []string, statuses []types.RequestStatus) {
+	for id := range users {
+		require.NoError(t, k.SetRedemption(ctx, types.Redemption{
+			Id:     uint64(id),
+			User:   users[id],
+			Amount: ONE,
+			Status: statuses[id],
+			Payout: sdk.Coin{Amount: math.ZeroInt()},
+		}))
+	}
+}
+
+func TestRedemptionQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query redemption with invalid request.
+	_, err := server.Redemption(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query non-existent redemption.
+	_, err = server.Redemption(ctx, &types.QueryRedemption{Id: 0})
+	// ASSERT: The query should've failed due to non-existent redemption.
+	require.ErrorContains(t, err, "redemption 0 does not exist")
+
+	// ARRANGE: Set redemption in state.
+	user := utils.TestAccount()
+	SetRedemptions(t, k, ctx, []string{user.Address}, []types.RequestStatus{types.RequestStatusPending})
+
+	// ACT: Attempt to query redemption.
+	res, err := server.Redemption(ctx, &types.QueryRedemption{Id: 0})
+	// ASSERT: The query should've succeeded, and returned redemption.
+	require.NoError(t, err)
+	require.Equal(t, user.Address, res.Redemption.User)
+	require.Equal(t, ONE, res.Redemption.Amount)
+}
+
+func TestRedemptionsQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query redemptions with invalid request.
+	_, err := server.Redemptions(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ARRANGE: Set redemptions of two users with different statuses in state.
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+	SetRedemptions(t, k, ctx,
+		[]string{alice.Address, bob.Address, alice.Address, bob.Address},
+		[]types.RequestStatus{types.RequestStatusFulfilled, types.RequestStatusPending, types.RequestStatusPending, types.RequestStatusCancelled},
+	)
+
+	testCases := []struct {
+		name   string
+		req    types.QueryRedemptions
+		expect []uint64
+	}{
+		{name: "All", req: types.QueryRedemptions{}, expect: []uint64{0, 1, 2, 3}},
+		{name: "ByUser", req: types.QueryRedemptions{User: bob.Address}, expect: []uint64{1, 3}},
+		{name: "ByStatus", req: types.QueryRedemptions{Status: types.RequestStatusPending}, expect: []uint64{1, 2}},
+		{name: "ByUserAndStatus", req: types.QueryRedemptions{User: bob.Address, Status: types.RequestStatusCancelled}, expect: []uint64{3}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// ACT: Attempt to query redemptions.
+			res, err := server.Redemptions(ctx, &testCase.req)
+
+			// ASSERT: The query should've succeeded, and returned the matching redemptions.
+			require.NoError(t, err)
+			var ids []uint64
+			for _, redemption := range res.Redemptions {
+				ids = append(ids, redemption.Id)
+			}
+			require.Equal(t, testCase.expect, ids)
+		})
+	}
+}
+
+func TestRedemptionQueueQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query redemption queue with invalid request.
+	_, err := server.RedemptionQueue(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ARRANGE: Set redemptions with different statuses in state.
+	user := utils.TestAccount()
+	SetRedemptions(t, k, ctx,
+		[]string{user.Address, user.Address, user.Address, user.Address},
+		[]types.RequestStatus{types.RequestStatusFulfilled, types.RequestStatusPending, types.RequestStatusRejected, types.RequestStatusPending},
+	)
+
+	// ACT: Attempt to query redemption queue.
+	res, err := server.RedemptionQueue(ctx, &types.QueryRedemptionQueue{})
+	// ASSERT: The query should've succeeded, and returned only pending redemptions in order.
+	require.NoError(t, err)
+	require.Len(t, res.Redemptions, 2)
+	require.Equal(t, uint64(1), res.Redemptions[0].Id)
+	require.Equal(t, uint64(3), res.Redemptions[1].Id)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k queryServer) Attestors(ctx context.Context, req *types.QueryAttestors) (*types.QueryAttestorsResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &types.QueryAttestorsResponse{
+		Attestors: k.GetAttestors(ctx),
+	}, nil
+}
+
+func (k queryServer) Attestations(ctx context.Context, req *types.QueryAttestations) (*types.QueryAttestationsResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &types.QueryAttestationsResponse{
+		Attestations: k.GetAttestations(ctx),
+	}, nil
+}
+
+func (k queryServer) Reserves(ctx context.Context, req *types.QueryReserves) (*types.QueryReservesResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	config := k.GetReserveConfig(ctx)
+	res := &types.QueryReservesResponse{
+		Config: config,
+		Supply: k.bankKeeper.GetSupply(ctx, k.Keeper.Denom).Amount,
+	}
+
+	latest, found := k.GetLatestAttestation(ctx)
+	if found {
+		res.Latest = &latest
+		res.Stale = config.IsStale(latest, sdk.UnwrapSDKContext(ctx).BlockTime())
+	}
+
+	if config.Enabled() {
+		remaining := math.ZeroInt()
+		if found && !res.Stale {
+			remaining = config.Capacity(latest).Sub(res.Supply)
+			if remaining.IsNegative() {
+				remaining = math.ZeroInt()
+			}
+		}
+		res.RemainingCapacity = &remaining
+	}
+
+	return res, nil
+}
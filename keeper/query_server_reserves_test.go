@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttestorsQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query attestors with invalid request.
+	_, err := server.Attestors(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ARRANGE: Set attestor in state.
+	attestor := utils.TestAccount()
+	require.NoError(t, k.SetAttestor(ctx, attestor.Bytes))
+
+	// ACT: Attempt to query attestors.
+	res, err := server.Attestors(ctx, &types.QueryAttestors{})
+	// ASSERT: The query should've succeeded, and returned attestors.
+	require.NoError(t, err)
+	require.Equal(t, []string{attestor.Address}, res.Attestors)
+}
+
+func TestAttestationsQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+	now := time.Unix(1_700_000_000, 0).UTC()
+
+	// ACT: Attempt to query attestations with invalid request.
+	_, err := server.Attestations(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ARRANGE: Set two attestations in state.
+	attestations := []types.Attestation{
+		{Index: 0, Attestor: utils.TestAccount().Address, Amount: ONE, AsOf: now, ReportHash: "hash1", PostedAt: now},
+		{Index: 1, Attestor: utils.TestAccount().Address, Amount: ONE.MulRaw(2), AsOf: now.Add(time.Hour), ReportHash: "hash2", PostedAt: now.Add(time.Hour)},
+	}
+	for _, attestation := range attestations {
+		require.NoError(t, k.SetAttestation(ctx, attestation))
+	}
+
+	// ACT: Attempt to query attestations.
+	res, err := server.Attestations(ctx, &types.QueryAttestations{})
+	// ASSERT: The query should've succeeded, and returned the history in order.
+	require.NoError(t, err)
+	require.Equal(t, attestations, res.Attestations)
+}
+
+func TestReservesQuery(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	server := keeper.NewQueryServer(k)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	// ACT: Attempt to query reserves with invalid request.
+	_, err := server.Reserves(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query reserves with no state.
+	res, err := server.Reserves(ctx, &types.QueryReserves{})
+	// ASSERT: The query should've succeeded, and returned an unlimited capacity.
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultReserveConfig(), res.Config)
+	require.Nil(t, res.Latest)
+	require.Nil(t, res.RemainingCapacity)
+
+	// ARRANGE: Require reserves, set an attestation and an existing supply.
+	user := utils.TestAccount()
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin(k.Denom, ONE))
+	require.NoError(t, k.SetReserveConfig(ctx, types.ReserveConfig{
+		CollateralRatio: math.LegacyOneDec(),
+		MaxStaleness:    time.Hour,
+	}))
+	attestation := types.Attestation{Index: 0, Amount: ONE.MulRaw(3), AsOf: now}
+	require.NoError(t, k.SetAttestation(ctx, attestation))
+
+	// ACT: Attempt to query reserves.
+	res, err = server.Reserves(ctx, &types.QueryReserves{})
+	// ASSERT: The query should've succeeded, and returned the remaining capacity.
+	require.NoError(t, err)
+	require.Equal(t, &attestation, res.Latest)
+	require.False(t, res.Stale)
+	require.Equal(t, ONE, res.Supply)
+	require.Equal(t, ONE.MulRaw(2), *res.RemainingCapacity)
+
+	// ACT: Attempt to query reserves once the attestation is stale.
+	res, err = server.Reserves(ctx.WithBlockTime(now.Add(2*time.Hour)), &types.QueryReserves{})
+	// ASSERT: The query should've succeeded, and returned no remaining capacity.
+	require.NoError(t, err)
+	require.True(t, res.Stale)
+	require.Equal(t, math.ZeroInt(), *res.RemainingCapacity)
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k queryServer) Shares(ctx context.Context, req *types.QueryShares) (*types.QuerySharesResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	address, err := k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode address %s", req.Address)
+	}
+
+	shares := k.GetShares(ctx, address)
+
+	return &types.QuerySharesResponse{
+		Shares:  shares,
+		Balance: k.getRebasingAmount(ctx, shares),
+	}, nil
+}
+
+func (k queryServer) TotalShares(ctx context.Context, req *types.QueryTotalShares) (*types.QueryTotalSharesResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	totalShares := k.GetTotalShares(ctx)
+
+	return &types.QueryTotalSharesResponse{
+		TotalShares: totalShares,
+		TotalSupply: k.getRebasingAmount(ctx, totalShares),
+	}, nil
+}
+
+// getRebasingAmount converts shares into rUSDY at the current price, which is
+// zero if no price range has started yet.
+func (k queryServer) getRebasingAmount(ctx context.Context, shares math.Int) math.Int {
+	price, found := k.GetPrice(ctx)
+	if !found {
+		return math.ZeroInt()
+	}
+
+	return types.SharesToAmount(shares, price)
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharesQuery(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query shares with invalid request.
+	_, err := server.Shares(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ARRANGE: Generate a user account.
+	user := utils.TestAccount()
+
+	// ACT: Attempt to query shares with invalid address.
+	_, err = server.Shares(ctx, &types.QueryShares{Address: user.Invalid})
+	// ASSERT: The query should've failed due to invalid address.
+	require.ErrorContains(t, err, "unable to decode address")
+
+	// ARRANGE: Give user 1 share.
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin(k.SharesDenom, ONE))
+
+	// ACT: Attempt to query shares with no price.
+	res, err := server.Shares(ctx, &types.QueryShares{Address: user.Address})
+	// ASSERT: The query should've succeeded, and returned a zero balance.
+	require.NoError(t, err)
+	require.Equal(t, ONE, res.Shares)
+	require.True(t, res.Balance.IsZero())
+
+	// ARRANGE: Set price to 1.05.
+	SetPrice(t, k, ctx, math.LegacyMustNewDecFromStr("1.05"))
+
+	// ACT: Attempt to query shares.
+	res, err = server.Shares(ctx, &types.QueryShares{Address: user.Address})
+	// ASSERT: The query should've succeeded, and returned the rebased balance.
+	require.NoError(t, err)
+	require.Equal(t, ONE, res.Shares)
+	require.Equal(t, math.NewInt(1_050_000_000_000_000_000), res.Balance)
+}
+
+func TestTotalSharesQuery(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.AuraKeeperWithBank(bank)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query total shares with invalid request.
+	_, err := server.TotalShares(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ARRANGE: Give two users 1 share each, and set price to 1.05 in state.
+	for _, user := range []utils.Account{utils.TestAccount(), utils.TestAccount()} {
+		bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin(k.SharesDenom, ONE))
+	}
+	SetPrice(t, k, ctx, math.LegacyMustNewDecFromStr("1.05"))
+
+	// ACT: Attempt to query total shares.
+	res, err := server.TotalShares(ctx, &types.QueryTotalShares{})
+	// ASSERT: The query should've succeeded, and returned the rebased supply.
+	require.NoError(t, err)
+	require.Equal(t, ONE.MulRaw(2), res.TotalShares)
+	require.Equal(t, math.NewInt(2_100_000_000_000_000_000), res.TotalSupply)
+}
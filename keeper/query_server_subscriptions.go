@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k queryServer) Stablecoin(ctx context.Context, req *types.QueryStablecoin) (*types.QueryStablecoinResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &types.QueryStablecoinResponse{Stablecoin: k.GetStablecoin(ctx)}, nil
+}
+
+func (k queryServer) Subscription(ctx context.Context, req *types.QuerySubscription) (*types.QuerySubscriptionResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	subscription, found := k.GetSubscription(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(errors.ErrNotFound, "subscription %d does not exist", req.Id)
+	}
+
+	return &types.QuerySubscriptionResponse{Subscription: subscription}, nil
+}
+
+func (k queryServer) Subscriptions(ctx context.Context, req *types.QuerySubscriptions) (*types.QuerySubscriptionsResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	subscriptions, pagination, err := query.CollectionFilteredPaginate(
+		ctx, k.Keeper.Subscriptions, req.Pagination,
+		func(_ uint64, subscription types.Subscription) (bool, error) {
+			return (req.User == "" || subscription.User == req.User) &&
+				(req.Status == types.RequestStatusUnspecified || subscription.Status == req.Status), nil
+		},
+		func(_ uint64, subscription types.Subscription) (types.Subscription, error) {
+			return subscription, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySubscriptionsResponse{
+		Subscriptions: subscriptions,
+		Pagination:    pagination,
+	}, nil
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStablecoinQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query stablecoin with invalid request.
+	_, err := server.Stablecoin(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ARRANGE: Set stablecoin in state.
+	require.NoError(t, k.SetStablecoin(ctx, USDC))
+
+	// ACT: Attempt to query stablecoin.
+	res, err := server.Stablecoin(ctx, &types.QueryStablecoin{})
+	// ASSERT: The query should've succeeded, and returned stablecoin.
+	require.NoError(t, err)
+	require.Equal(t, USDC, res.Stablecoin)
+}
+
+func TestSubscriptionQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query subscription with invalid request.
+	_, err := server.Subscription(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query non-existent subscription.
+	_, err = server.Subscription(ctx, &types.QuerySubscription{Id: 0})
+	// ASSERT: The query should've failed due to non-existent subscription.
+	require.ErrorContains(t, err, "subscription 0 does not exist")
+
+	// ARRANGE: Set subscription in state.
+	subscription := types.Subscription{
+		Id:      0,
+		User:    utils.TestAccount().Address,
+		Deposit: sdk.NewInt64Coin(USDC.Denom, 1_000_000),
+		Status:  types.RequestStatusPending,
+		Price:   math.LegacyZeroDec(),
+		Minted:  math.ZeroInt(),
+	}
+	require.NoError(t, k.SetSubscription(ctx, subscription))
+
+	// ACT: Attempt to query subscription.
+	res, err := server.Subscription(ctx, &types.QuerySubscription{Id: 0})
+	// ASSERT: The query should've succeeded, and returned subscription.
+	require.NoError(t, err)
+	require.Equal(t, subscription.User, res.Subscription.User)
+	require.Equal(t, subscription.Deposit, res.Subscription.Deposit)
+}
+
+func TestSubscriptionsQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query subscriptions with invalid request.
+	_, err := server.Subscriptions(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ARRANGE: Set subscriptions of two users with different statuses in state.
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+	for id, subscription := range []struct {
+		user   string
+		status types.RequestStatus
+	}{
+		{alice.Address, types.RequestStatusPending},
+		{bob.Address, types.RequestStatusPending},
+		{alice.Address, types.RequestStatusFulfilled},
+		{alice.Address, types.RequestStatusRejected},
+	} {
+		require.NoError(t, k.SetSubscription(ctx, types.Subscription{
+			Id:      uint64(id),
+			User:    subscription.user,
+			Deposit: sdk.NewInt64Coin(USDC.Denom, 1_000_000),
+			Status:  subscription.status,
+			Price:   math.LegacyZeroDec(),
+			Minted:  math.ZeroInt(),
+		}))
+	}
+
+	testCases := []struct {
+		name   string
+		req    types.QuerySubscriptions
+		expect []uint64
+	}{
+		{name: "All", req: types.QuerySubscriptions{}, expect: []uint64{0, 1, 2, 3}},
+		{name: "ByUser", req: types.QuerySubscriptions{User: alice.Address}, expect: []uint64{0, 2, 3}},
+		{name: "ByStatus", req: types.QuerySubscriptions{Status: types.RequestStatusPending}, expect: []uint64{0, 1}},
+		{name: "ByUserAndStatus", req: types.QuerySubscriptions{User: alice.Address, Status: types.RequestStatusRejected}, expect: []uint64{3}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// ACT: Attempt to query subscriptions.
+			res, err := server.Subscriptions(ctx, &testCase.req)
+
+			// ASSERT: The query should've succeeded, and returned the matching subscriptions.
+			require.NoError(t, err)
+			var ids []uint64
+			for _, subscription := range res.Subscriptions {
+				ids = append(ids, subscription.Id)
+			}
+			require.Equal(t, testCase.expect, ids)
+		})
+	}
+}
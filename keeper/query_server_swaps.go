@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k queryServer) SwapFacility(ctx context.Context, req *types.QuerySwapFacility) (*types.QuerySwapFacilityResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	facility := k.SwapFacilityAddress()
+
+	return &types.QuerySwapFacilityResponse{
+		Config:          k.GetSwapConfig(ctx),
+		Paused:          k.GetSwapsPaused(ctx),
+		Liquidity:       k.GetSwapLiquidity(ctx),
+		MinterAllowance: k.getMintAllowance(ctx, k.Keeper.Denom, facility),
+		BurnerAllowance: k.getBurnAllowance(ctx, k.Keeper.Denom, facility),
+	}, nil
+}
+
+func (k queryServer) SwapVolume(ctx context.Context, req *types.QuerySwapVolume) (*types.QuerySwapVolumeResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	address, err := k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode address %s", req.Address)
+	}
+
+	volume := k.GetSwapVolume(ctx, address)
+	res := &types.QuerySwapVolumeResponse{Volume: volume}
+
+	if limit := k.GetSwapConfig(ctx).DailyLimit; limit.IsPositive() {
+		remaining := limit.Sub(volume)
+		if remaining.IsNegative() {
+			remaining = math.ZeroInt()
+		}
+		res.Remaining = &remaining
+	}
+
+	return res, nil
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwapFacilityQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query swap facility with invalid request.
+	_, err := server.SwapFacility(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query swap facility with empty state.
+	res, err := server.SwapFacility(ctx, &types.QuerySwapFacility{})
+	// ASSERT: The query should've succeeded, and returned defaults.
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultSwapConfig(), res.Config)
+	require.False(t, res.Paused)
+	require.True(t, res.Liquidity.IsZero())
+	require.True(t, res.MinterAllowance.IsZero())
+	require.True(t, res.BurnerAllowance.IsZero())
+
+	// ARRANGE: Set swap facility state.
+	config := types.SwapConfig{Fee: math.LegacyMustNewDecFromStr("0.01"), DailyLimit: ONE}
+	require.NoError(t, k.SetSwapConfig(ctx, config))
+	require.NoError(t, k.SetSwapsPaused(ctx, true))
+	require.NoError(t, k.SetSwapLiquidity(ctx, sdk.NewCoin(USDC.Denom, math.NewInt(1_000_000))))
+	require.NoError(t, k.SetMinter(ctx, k.Denom, k.SwapFacilityAddress(), ONE))
+	require.NoError(t, k.SetBurner(ctx, k.Denom, k.SwapFacilityAddress(), ONE.MulRaw(2)))
+
+	// ACT: Attempt to query swap facility.
+	res, err = server.SwapFacility(ctx, &types.QuerySwapFacility{})
+	// ASSERT: The query should've succeeded, and returned state.
+	require.NoError(t, err)
+	require.Equal(t, config, res.Config)
+	require.True(t, res.Paused)
+	require.Equal(t, sdk.NewCoin(USDC.Denom, math.NewInt(1_000_000)), res.Liquidity)
+	require.Equal(t, ONE, res.MinterAllowance)
+	require.Equal(t, ONE.MulRaw(2), res.BurnerAllowance)
+}
+
+func TestSwapVolumeQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query swap volume with invalid request.
+	_, err := server.SwapVolume(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ARRANGE: Generate a user account.
+	user := utils.TestAccount()
+
+	// ACT: Attempt to query swap volume with invalid address.
+	_, err = server.SwapVolume(ctx, &types.QuerySwapVolume{Address: user.Invalid})
+	// ASSERT: The query should've failed due to invalid address.
+	require.ErrorContains(t, err, "unable to decode address")
+
+	// ARRANGE: Set a swap volume of 1 $USDY today.
+	require.NoError(t, k.SetSwapVolume(ctx, user.Bytes, types.SwapVolume{
+		Address: user.Address,
+		Day:     types.SwapDay(ctx.BlockTime()),
+		Volume:  ONE,
+	}))
+
+	// ACT: Attempt to query swap volume with no daily limit.
+	res, err := server.SwapVolume(ctx, &types.QuerySwapVolume{Address: user.Address})
+	// ASSERT: The query should've succeeded, and returned no remaining limit.
+	require.NoError(t, err)
+	require.Equal(t, ONE, res.Volume)
+	require.Nil(t, res.Remaining)
+
+	// ARRANGE: Set a daily limit of 3 $USDY.
+	require.NoError(t, k.SetSwapConfig(ctx, types.SwapConfig{Fee: math.LegacyZeroDec(), DailyLimit: ONE.MulRaw(3)}))
+
+	// ACT: Attempt to query swap volume.
+	res, err = server.SwapVolume(ctx, &types.QuerySwapVolume{Address: user.Address})
+	// ASSERT: The query should've succeeded, and returned the remaining limit.
+	require.NoError(t, err)
+	require.Equal(t, ONE, res.Volume)
+	require.Equal(t, ONE.MulRaw(2), *res.Remaining)
+
+	// ARRANGE: Advance to the next day.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.Day))
+
+	// ACT: Attempt to query swap volume.
+	res, err = server.SwapVolume(ctx, &types.QuerySwapVolume{Address: user.Address})
+	// ASSERT: The query should've succeeded, and reset the volume.
+	require.NoError(t, err)
+	require.True(t, res.Volume.IsZero())
+	require.Equal(t, ONE.MulRaw(3), *res.Remaining)
+}
@@ -2,7 +2,9 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ondoprotocol/usdy-noble/v2/keeper"
 	"github.com/ondoprotocol/usdy-noble/v2/types"
@@ -43,13 +45,75 @@ func TestPausedQuery(t *testing.T) {
 	require.False(t, res.Paused)
 
 	// ARRANGE: Set paused state to true.
-	require.NoError(t, k.SetPaused(ctx, true))
+	SetPaused(t, k, ctx, k.Denom, true)
 
 	// ACT: Attempt to query paused state with state.
 	res, err = server.Paused(ctx, &types.QueryPaused{})
 	// ASSERT: The query should've succeeded, and returned true.
 	require.NoError(t, err)
 	require.True(t, res.Paused)
+	require.Equal(t, types.PauseScopes, res.Scopes)
+
+	// ARRANGE: Only pause IBC outflows.
+	SetPaused(t, k, ctx, k.Denom, false)
+	require.NoError(t, k.SetPaused(ctx, k.Denom, types.PauseScopeIBCOutflows, true))
+
+	// ACT: Attempt to query paused state with a single paused scope.
+	res, err = server.Paused(ctx, &types.QueryPaused{})
+	// ASSERT: The query should've succeeded, and returned the paused scope.
+	require.NoError(t, err)
+	require.True(t, res.Paused)
+	require.Equal(t, []types.PauseScope{types.PauseScopeIBCOutflows}, res.Scopes)
+
+	// ARRANGE: Lift the pause of IBC outflows at a block height, and schedule a pause of minting.
+	outflows := types.PauseWindow{Denom: k.Denom, Scope: types.PauseScopeIBCOutflows, EndHeight: 100}
+	require.NoError(t, k.SetPauseWindow(ctx, outflows))
+	mint := types.PauseWindow{Denom: k.Denom, Scope: types.PauseScopeMint, StartHeight: 50, EndHeight: 100}
+	require.NoError(t, k.SetPauseWindow(ctx, mint))
+
+	// ACT: Attempt to query paused state with scheduled pauses.
+	res, err = server.Paused(ctx, &types.QueryPaused{})
+	// ASSERT: The query should've succeeded, and returned the pause windows.
+	require.NoError(t, err)
+	require.Equal(t, []types.PauseScope{types.PauseScopeIBCOutflows}, res.Scopes)
+	require.Equal(t, []types.PauseWindow{outflows, mint}, res.Windows)
+
+	// ACT: Attempt to query paused state of another governed denom.
+	res, err = server.Paused(ctx, &types.QueryPaused{Denom: "atbill"})
+	// ASSERT: The query should've succeeded, and returned false.
+	require.NoError(t, err)
+	require.False(t, res.Paused)
+
+	// ACT: Attempt to query paused state of an ungoverned denom.
+	_, err = server.Paused(ctx, &types.QueryPaused{Denom: "uusdc"})
+	// ASSERT: The query should've failed due to ungoverned denom.
+	require.ErrorContains(t, err, "uusdc is not governed by this module")
+}
+
+func TestDenomsQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query denoms with invalid request.
+	_, err := server.Denoms(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query denoms.
+	res, err := server.Denoms(ctx, &types.QueryDenoms{})
+	// ASSERT: The query should've succeeded, and only returned the default denom as bound.
+	require.NoError(t, err)
+	require.Equal(t, []string{"ausdy", "atbill"}, res.Denoms)
+	require.Equal(t, []string{"ausdy"}, res.BlocklistBound)
+
+	// ARRANGE: Bind the additional denom to the blocklist.
+	require.NoError(t, k.SetBlocklistBinding(ctx, "atbill", true))
+
+	// ACT: Attempt to query denoms.
+	res, err = server.Denoms(ctx, &types.QueryDenoms{})
+	// ASSERT: The query should've succeeded, and returned both denoms as bound.
+	require.NoError(t, err)
+	require.Equal(t, []string{"ausdy", "atbill"}, res.BlocklistBound)
 }
 
 func TestOwnerQuery(t *testing.T) {
@@ -63,7 +127,7 @@ func TestOwnerQuery(t *testing.T) {
 
 	// ARRANGE: Set owner in state.
 	owner := utils.TestAccount()
-	require.NoError(t, k.SetOwner(ctx, owner.Address))
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
 
 	// ACT: Attempt to query owner with state.
 	res, err := server.Owner(ctx, &types.QueryOwner{})
@@ -74,7 +138,7 @@ func TestOwnerQuery(t *testing.T) {
 
 	// ARRANGE: Set pending owner in state.
 	pendingOwner := utils.TestAccount()
-	require.NoError(t, k.SetPendingOwner(ctx, pendingOwner.Address))
+	require.NoError(t, k.SetPendingOwner(ctx, k.Denom, pendingOwner.Address))
 
 	// ACT: Attempt to query owner with state.
 	res, err = server.Owner(ctx, &types.QueryOwner{})
@@ -101,8 +165,8 @@ func TestBurnersQuery(t *testing.T) {
 
 	// ARRANGE: Set burners in state.
 	burner1, burner2 := utils.TestAccount(), utils.TestAccount()
-	require.NoError(t, k.SetBurner(ctx, burner1.Address, ONE))
-	require.NoError(t, k.SetBurner(ctx, burner2.Address, ONE.MulRaw(2)))
+	require.NoError(t, k.SetBurner(ctx, k.Denom, burner1.Bytes, ONE))
+	require.NoError(t, k.SetBurner(ctx, k.Denom, burner2.Bytes, ONE.MulRaw(2)))
 
 	// ACT: Attempt to query burners with state.
 	res, err = server.Burners(ctx, &types.QueryBurners{})
@@ -136,8 +200,8 @@ func TestMintersQuery(t *testing.T) {
 
 	// ARRANGE: Set minters in state.
 	minter1, minter2 := utils.TestAccount(), utils.TestAccount()
-	require.NoError(t, k.SetMinter(ctx, minter1.Address, ONE))
-	require.NoError(t, k.SetMinter(ctx, minter2.Address, ONE.MulRaw(2)))
+	require.NoError(t, k.SetMinter(ctx, k.Denom, minter1.Bytes, ONE))
+	require.NoError(t, k.SetMinter(ctx, k.Denom, minter2.Bytes, ONE.MulRaw(2)))
 
 	// ACT: Attempt to query minters with state.
 	res, err = server.Minters(ctx, &types.QueryMinters{})
@@ -171,8 +235,8 @@ func TestPausersQuery(t *testing.T) {
 
 	// ARRANGE: Set pausers in state.
 	pauser1, pauser2 := utils.TestAccount(), utils.TestAccount()
-	require.NoError(t, k.SetPauser(ctx, pauser1.Address))
-	require.NoError(t, k.SetPauser(ctx, pauser2.Address))
+	require.NoError(t, k.SetPauser(ctx, k.Denom, pauser1.Bytes))
+	require.NoError(t, k.SetPauser(ctx, k.Denom, pauser2.Bytes))
 
 	// ACT: Attempt to query pausers with state.
 	res, err = server.Pausers(ctx, &types.QueryPausers{})
@@ -200,14 +264,194 @@ func TestBlockedChannelsQuery(t *testing.T) {
 
 	// ARRANGE: Set blocked channels in state.
 	channel1, channel2 := "channel-0", "channel-1"
-	require.NoError(t, k.SetBlockedChannel(ctx, channel1))
-	require.NoError(t, k.SetBlockedChannel(ctx, channel2))
+	require.NoError(t, k.SetBlockedChannel(ctx, k.Denom, channel1, types.ChannelDirectionOutbound))
+	require.NoError(t, k.SetBlockedChannel(ctx, k.Denom, channel2, types.ChannelDirectionBoth))
 
 	// ACT: Attempt to query blocked channels with state.
 	res, err = server.BlockedChannels(ctx, &types.QueryBlockedChannels{})
 	// ASSERT: The query should've succeeded, and returned channels.
 	require.NoError(t, err)
 	require.Len(t, res.BlockedChannels, 2)
-	require.Contains(t, res.BlockedChannels, channel1)
-	require.Contains(t, res.BlockedChannels, channel2)
+	require.Contains(t, res.BlockedChannels, types.BlockedChannel{
+		Channel:   channel1,
+		Direction: types.ChannelDirectionOutbound,
+	})
+	require.Contains(t, res.BlockedChannels, types.BlockedChannel{
+		Channel:   channel2,
+		Direction: types.ChannelDirectionBoth,
+	})
+}
+
+func TestPauseExemptionsQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query pause exemptions with invalid request.
+	_, err := server.PauseExemptions(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query pause exemptions with no state.
+	res, err := server.PauseExemptions(ctx, &types.QueryPauseExemptions{})
+	// ASSERT: The query should've succeeded, and returned no exemptions.
+	require.NoError(t, err)
+	require.Empty(t, res.PauseExemptions)
+
+	// ARRANGE: Set pause exemptions in state.
+	treasury, custodian := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetPauseExemption(ctx, k.Denom, treasury.Bytes, types.ExemptionDirectionUnspecified))
+	require.NoError(t, k.SetPauseExemption(ctx, k.Denom, custodian.Bytes, types.ExemptionDirectionReceive))
+
+	// ACT: Attempt to query pause exemptions with state.
+	res, err = server.PauseExemptions(ctx, &types.QueryPauseExemptions{})
+	// ASSERT: The query should've succeeded, and returned exemptions.
+	require.NoError(t, err)
+	require.Len(t, res.PauseExemptions, 2)
+	require.Contains(t, res.PauseExemptions, types.PauseExemption{
+		Address:   treasury.Address,
+		Direction: types.ExemptionDirectionBoth,
+	})
+	require.Contains(t, res.PauseExemptions, types.PauseExemption{
+		Address:   custodian.Address,
+		Direction: types.ExemptionDirectionReceive,
+	})
+
+	// ACT: Attempt to query pause exemptions of an ungoverned denom.
+	_, err = server.PauseExemptions(ctx, &types.QueryPauseExemptions{Denom: "uusdc"})
+	// ASSERT: The query should've failed due to ungoverned denom.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+}
+
+func TestChannelModeQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query channel mode with invalid request.
+	_, err := server.ChannelMode(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query channel mode with no state.
+	res, err := server.ChannelMode(ctx, &types.QueryChannelMode{})
+	// ASSERT: The query should've succeeded, and returned denylist mode.
+	require.NoError(t, err)
+	require.Equal(t, types.ChannelModeDenylist, res.Mode)
+
+	// ARRANGE: Set channel mode in state.
+	require.NoError(t, k.SetChannelMode(ctx, types.ChannelModeAllowlist))
+
+	// ACT: Attempt to query channel mode with state.
+	res, err = server.ChannelMode(ctx, &types.QueryChannelMode{})
+	// ASSERT: The query should've succeeded, and returned allowlist mode.
+	require.NoError(t, err)
+	require.Equal(t, types.ChannelModeAllowlist, res.Mode)
+}
+
+func TestAllowedChannelsQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query allowed channels with invalid request.
+	_, err := server.AllowedChannels(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query allowed channels with no state.
+	res, err := server.AllowedChannels(ctx, &types.QueryAllowedChannels{})
+	// ASSERT: The query should've succeeded, and returned no channels.
+	require.NoError(t, err)
+	require.Empty(t, res.AllowedChannels)
+
+	// ARRANGE: Set allowed channels in state.
+	channel1, channel2 := "channel-0", "channel-1"
+	require.NoError(t, k.SetAllowedChannel(ctx, channel1))
+	require.NoError(t, k.SetAllowedChannel(ctx, channel2))
+
+	// ACT: Attempt to query allowed channels with state.
+	res, err = server.AllowedChannels(ctx, &types.QueryAllowedChannels{})
+	// ASSERT: The query should've succeeded, and returned channels.
+	require.NoError(t, err)
+	require.Len(t, res.AllowedChannels, 2)
+	require.Contains(t, res.AllowedChannels, channel1)
+	require.Contains(t, res.AllowedChannels, channel2)
+}
+
+func TestRateLimitsQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query rate limits with invalid request.
+	_, err := server.RateLimits(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query rate limits with no state.
+	res, err := server.RateLimits(ctx, &types.QueryRateLimits{})
+	// ASSERT: The query should've succeeded, and returned no rate limits.
+	require.NoError(t, err)
+	require.Empty(t, res.RateLimits)
+
+	// ARRANGE: Set rate limit in state.
+	rateLimit := types.RateLimit{
+		Channel:       "channel-0",
+		Window:        time.Hour,
+		MaxAmount:     ONE,
+		MaxPercentage: math.LegacyZeroDec(),
+	}
+	require.NoError(t, k.SetRateLimit(ctx, rateLimit))
+
+	// ACT: Attempt to query rate limits with state.
+	res, err = server.RateLimits(ctx, &types.QueryRateLimits{})
+	// ASSERT: The query should've succeeded, and returned rate limits.
+	require.NoError(t, err)
+	require.Len(t, res.RateLimits, 1)
+	require.Equal(t, rateLimit.Channel, res.RateLimits[0].Channel)
+	require.Equal(t, rateLimit.MaxAmount, res.RateLimits[0].MaxAmount)
+}
+
+func TestRateLimitUsageQuery(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewQueryServer(k)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	// ACT: Attempt to query rate limit usage with invalid request.
+	_, err := server.RateLimitUsage(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query rate limit usage of a channel that isn't rate limited.
+	_, err = server.RateLimitUsage(ctx, &types.QueryRateLimitUsage{Channel: "channel-0"})
+	// ASSERT: The query should've failed due to channel not being rate limited.
+	require.ErrorContains(t, err, "is not rate limited")
+
+	// ARRANGE: Set rate limit and flow in state.
+	require.NoError(t, k.SetRateLimit(ctx, types.RateLimit{
+		Channel:       "channel-0",
+		Window:        time.Hour,
+		MaxAmount:     ONE.MulRaw(2),
+		MaxPercentage: math.LegacyZeroDec(),
+	}))
+	require.NoError(t, k.SetRateLimitFlow(ctx, types.RateLimitFlow{
+		Channel:     "channel-0",
+		WindowStart: ctx.BlockTime(),
+		Inflow:      math.ZeroInt(),
+		Outflow:     ONE,
+		Supply:      math.ZeroInt(),
+	}))
+
+	// ACT: Attempt to query rate limit usage.
+	res, err := server.RateLimitUsage(ctx, &types.QueryRateLimitUsage{Channel: "channel-0"})
+	// ASSERT: The query should've succeeded, and returned usage.
+	require.NoError(t, err)
+	require.Equal(t, ONE, res.Flow.Outflow)
+	require.Equal(t, ONE.MulRaw(2), res.Quota)
+	require.Equal(t, ONE, res.RemainingOutflow)
+	require.Equal(t, ONE.MulRaw(3), res.RemainingInflow)
+
+	// ACT: Attempt to query rate limit usage after the window has expired.
+	res, err = server.RateLimitUsage(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)), &types.QueryRateLimitUsage{Channel: "channel-0"})
+	// ASSERT: The query should've succeeded, and returned an empty window.
+	require.NoError(t, err)
+	require.True(t, res.Flow.Outflow.IsZero())
+	require.Equal(t, ONE.MulRaw(2), res.RemainingOutflow)
 }
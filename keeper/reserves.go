@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+// checkReserves returns an error if minting an amount of USDY would increase
+// its total supply beyond the latest non-stale attestation multiplied by the
+// configured collateral ratio. Nothing is checked if no ratio is configured.
+func (k *Keeper) checkReserves(ctx context.Context, amount math.Int) error {
+	config := k.GetReserveConfig(ctx)
+	if !config.Enabled() {
+		return nil
+	}
+
+	latest, found := k.GetLatestAttestation(ctx)
+	if !found {
+		return sdkerrors.Wrap(types.ErrInsufficientReserves, "no reserves have been attested")
+	}
+	if config.IsStale(latest, sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInsufficientReserves, "latest attestation as of %s is stale", latest.AsOf)
+	}
+
+	capacity := config.Capacity(latest)
+	supply := k.bankKeeper.GetSupply(ctx, k.Denom).Amount
+	if supply.Add(amount).GT(capacity) {
+		return sdkerrors.Wrapf(types.ErrInsufficientReserves, "supply of %s would exceed capacity of %s", supply.Add(amount), capacity)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+// getSharesForAmount converts an amount of rUSDY into the shares debited from
+// an account at the current price. An amount equal to the full rUSDY balance
+// of the account always debits all of its shares, so that no dust is left
+// behind due to rounding.
+func (k *Keeper) getSharesForAmount(ctx context.Context, address sdk.AccAddress, amount math.Int) (math.Int, error) {
+	price, found := k.GetPrice(ctx)
+	if !found {
+		return math.Int{}, errors.New("no price range has started")
+	}
+
+	balance := k.GetShares(ctx, address)
+	shares := types.AmountToShares(amount, price)
+	if amount.Equal(types.SharesToAmount(balance, price)) {
+		shares = balance
+	}
+
+	if !shares.IsPositive() {
+		return math.Int{}, errors.New("amount must be worth at least one share")
+	}
+	if balance.LT(shares) {
+		return math.Int{}, sdkerrors.Wrapf(types.ErrInsufficientShares, "%s has %s shares, requires %s", address, balance, shares)
+	}
+
+	return shares, nil
+}
+
+// GetShares returns the shares held by an account, which are its bank balance
+// of the share denom.
+func (k *Keeper) GetShares(ctx context.Context, address []byte) math.Int {
+	return k.bankKeeper.GetBalance(ctx, address, k.SharesDenom).Amount
+}
+
+// GetTotalShares returns the shares held by all accounts, which are the bank
+// supply of the share denom.
+func (k *Keeper) GetTotalShares(ctx context.Context) math.Int {
+	return k.bankKeeper.GetSupply(ctx, k.SharesDenom).Amount
+}
+
+// MintShares mints shares to an account as bank balance of the share denom. The USDY that
+// backs them must already be held by the module.
+func (k *Keeper) MintShares(ctx context.Context, address []byte, shares math.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin(k.SharesDenom, shares))
+	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "unable to mint shares to module")
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, coins)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "unable to transfer shares from module to user")
+	}
+
+	return nil
+}
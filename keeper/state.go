@@ -2,56 +2,195 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	"github.com/ondoprotocol/usdy-noble/v2/types"
 )
 
 //
 
-func (k *Keeper) GetPaused(ctx context.Context) bool {
-	paused, _ := k.Paused.Get(ctx)
-	return paused
+func (k *Keeper) GetPausedScopes(ctx context.Context, denom string) (scopes []types.PauseScope) {
+	rng := collections.NewPrefixedPairRange[string, types.PauseScope](denom)
+	_ = k.Paused.Walk(ctx, rng, func(key collections.Pair[string, types.PauseScope]) (stop bool, err error) {
+		scopes = append(scopes, key.K2())
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) IsPaused(ctx context.Context, denom string, scope types.PauseScope) bool {
+	has, _ := k.Paused.Has(ctx, collections.Join(denom, scope))
+	return has
+}
+
+func (k *Keeper) SetPaused(ctx context.Context, denom string, scope types.PauseScope, paused bool) error {
+	if paused {
+		return k.Paused.Set(ctx, collections.Join(denom, scope))
+	}
+
+	return k.Paused.Remove(ctx, collections.Join(denom, scope))
+}
+
+//
+
+func (k *Keeper) DeletePauseWindow(ctx context.Context, denom string, scope types.PauseScope) error {
+	if window, found := k.GetPauseWindow(ctx, denom, scope); found {
+		if err := k.indexPauseWindow(ctx, window, false); err != nil {
+			return err
+		}
+	}
+
+	return k.PauseWindows.Remove(ctx, collections.Join(denom, scope))
+}
+
+func (k *Keeper) GetPauseWindow(ctx context.Context, denom string, scope types.PauseScope) (types.PauseWindow, bool) {
+	window, err := k.PauseWindows.Get(ctx, collections.Join(denom, scope))
+	return window, err == nil
+}
+
+func (k *Keeper) GetPauseWindows(ctx context.Context) (windows []types.PauseWindow) {
+	_ = k.PauseWindows.Walk(ctx, nil, func(_ collections.Pair[string, types.PauseScope], window types.PauseWindow) (stop bool, err error) {
+		windows = append(windows, window)
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) GetPauseWindowsByDenom(ctx context.Context, denom string) (windows []types.PauseWindow) {
+	rng := collections.NewPrefixedPairRange[string, types.PauseScope](denom)
+	_ = k.PauseWindows.Walk(ctx, rng, func(_ collections.Pair[string, types.PauseScope], window types.PauseWindow) (stop bool, err error) {
+		windows = append(windows, window)
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) SetPauseWindow(ctx context.Context, window types.PauseWindow) error {
+	if err := k.DeletePauseWindow(ctx, window.Denom, window.Scope); err != nil {
+		return err
+	}
+	if err := k.indexPauseWindow(ctx, window, true); err != nil {
+		return err
+	}
+
+	return k.PauseWindows.Set(ctx, collections.Join(window.Denom, window.Scope), window)
+}
+
+// indexPauseWindow adds the non-zero bounds of a pause window to, or removes
+// them from, the height and time indexes.
+func (k *Keeper) indexPauseWindow(ctx context.Context, window types.PauseWindow, index bool) error {
+	for _, height := range []int64{window.StartHeight, window.EndHeight} {
+		if height <= 0 {
+			continue
+		}
+
+		key := collections.Join3(height, window.Denom, window.Scope)
+		update := k.PauseWindowsByHeight.Remove
+		if index {
+			update = k.PauseWindowsByHeight.Set
+		}
+		if err := update(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	for _, bound := range []time.Time{window.StartTime, window.EndTime} {
+		if bound.IsZero() {
+			continue
+		}
+
+		key := collections.Join3(bound, window.Denom, window.Scope)
+		update := k.PauseWindowsByTime.Remove
+		if index {
+			update = k.PauseWindowsByTime.Set
+		}
+		if err := update(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+
+func (k *Keeper) DeletePauseExemption(ctx context.Context, denom string, address []byte) error {
+	return k.PauseExemptions.Remove(ctx, collections.Join(denom, address))
+}
+
+// GetPauseExemption returns the exempt direction of an address, if any.
+func (k *Keeper) GetPauseExemption(ctx context.Context, denom string, address []byte) (types.ExemptionDirection, bool) {
+	direction, err := k.PauseExemptions.Get(ctx, collections.Join(denom, address))
+	return direction, err == nil
+}
+
+func (k *Keeper) GetPauseExemptions(ctx context.Context, denom string) (exemptions []types.PauseExemption) {
+	rng := collections.NewPrefixedPairRange[string, []byte](denom)
+	_ = k.PauseExemptions.Walk(ctx, rng, func(key collections.Pair[string, []byte], direction types.ExemptionDirection) (stop bool, err error) {
+		address, _ := k.addressCodec.BytesToString(key.K2())
+		exemptions = append(exemptions, types.PauseExemption{
+			Address:   address,
+			Direction: direction,
+		})
+
+		return false, nil
+	})
+
+	return
 }
 
-func (k *Keeper) SetPaused(ctx context.Context, paused bool) error {
-	return k.Paused.Set(ctx, paused)
+func (k *Keeper) SetPauseExemption(ctx context.Context, denom string, address []byte, direction types.ExemptionDirection) error {
+	return k.PauseExemptions.Set(ctx, collections.Join(denom, address), direction.Normalize())
 }
 
 //
 
-func (k *Keeper) GetOwner(ctx context.Context) string {
-	owner, _ := k.Owner.Get(ctx)
+func (k *Keeper) GetOwner(ctx context.Context, denom string) string {
+	owner, _ := k.Owner.Get(ctx, denom)
 	return owner
 }
 
-func (k *Keeper) SetOwner(ctx context.Context, owner string) error {
-	return k.Owner.Set(ctx, owner)
+func (k *Keeper) SetOwner(ctx context.Context, denom, owner string) error {
+	return k.Owner.Set(ctx, denom, owner)
 }
 
 //
 
-func (k *Keeper) DeletePendingOwner(ctx context.Context) error {
-	return k.PendingOwner.Remove(ctx)
+func (k *Keeper) DeletePendingOwner(ctx context.Context, denom string) error {
+	return k.PendingOwner.Remove(ctx, denom)
 }
 
-func (k *Keeper) GetPendingOwner(ctx context.Context) string {
-	pendingOwner, _ := k.PendingOwner.Get(ctx)
+func (k *Keeper) GetPendingOwner(ctx context.Context, denom string) string {
+	pendingOwner, _ := k.PendingOwner.Get(ctx, denom)
 	return pendingOwner
 }
 
-func (k *Keeper) SetPendingOwner(ctx context.Context, pendingOwner string) error {
-	return k.PendingOwner.Set(ctx, pendingOwner)
+func (k *Keeper) SetPendingOwner(ctx context.Context, denom, pendingOwner string) error {
+	return k.PendingOwner.Set(ctx, denom, pendingOwner)
 }
 
 //
 
-func (k *Keeper) DeleteBurner(ctx context.Context, burner string) error {
-	return k.Burners.Remove(ctx, burner)
+func (k *Keeper) DeleteBurner(ctx context.Context, denom string, burner []byte) error {
+	if err := k.DeleteBurnerExpiry(ctx, denom, burner); err != nil {
+		return err
+	}
+	if err := k.BurnerSources.Clear(ctx, collections.NewSuperPrefixedTripleRange[string, []byte, []byte](denom, burner)); err != nil {
+		return err
+	}
+
+	return k.Burners.Remove(ctx, collections.Join(denom, burner))
 }
 
-func (k *Keeper) GetBurner(ctx context.Context, burner string) (allowance math.Int) {
-	allowance, err := k.Burners.Get(ctx, burner)
+func (k *Keeper) GetBurner(ctx context.Context, denom string, burner []byte) (allowance math.Int) {
+	allowance, err := k.Burners.Get(ctx, collections.Join(denom, burner))
 	if err != nil {
 		return math.ZeroInt()
 	}
@@ -59,11 +198,15 @@ func (k *Keeper) GetBurner(ctx context.Context, burner string) (allowance math.I
 	return
 }
 
-func (k *Keeper) GetBurners(ctx context.Context) (burners []types.Burner) {
-	_ = k.Burners.Walk(ctx, nil, func(burner string, allowance math.Int) (stop bool, err error) {
+func (k *Keeper) GetBurners(ctx context.Context, denom string) (burners []types.Burner) {
+	rng := collections.NewPrefixedPairRange[string, []byte](denom)
+	_ = k.Burners.Walk(ctx, rng, func(key collections.Pair[string, []byte], allowance math.Int) (stop bool, err error) {
+		address, _ := k.addressCodec.BytesToString(key.K2())
 		burners = append(burners, types.Burner{
-			Address:   burner,
+			Address:   address,
 			Allowance: allowance,
+			Expiry:    k.GetBurnerExpiry(ctx, denom, key.K2()),
+			Sources:   k.GetBurnerSources(ctx, denom, key.K2()),
 		})
 
 		return false, nil
@@ -72,23 +215,99 @@ func (k *Keeper) GetBurners(ctx context.Context) (burners []types.Burner) {
 	return
 }
 
-func (k *Keeper) HasBurner(ctx context.Context, burner string) bool {
-	has, _ := k.Burners.Has(ctx, burner)
+func (k *Keeper) HasBurner(ctx context.Context, denom string, burner []byte) bool {
+	has, _ := k.Burners.Has(ctx, collections.Join(denom, burner))
+	return has
+}
+
+func (k *Keeper) SetBurner(ctx context.Context, denom string, burner []byte, allowance math.Int) error {
+	return k.Burners.Set(ctx, collections.Join(denom, burner), allowance)
+}
+
+//
+
+func (k *Keeper) DeleteBurnerExpiry(ctx context.Context, denom string, burner []byte) error {
+	expiry, err := k.BurnerExpiries.Get(ctx, collections.Join(denom, burner))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.BurnerExpiriesByTime.Remove(ctx, collections.Join3(expiry, denom, burner)); err != nil {
+		return err
+	}
+
+	return k.BurnerExpiries.Remove(ctx, collections.Join(denom, burner))
+}
+
+// GetBurnerExpiry returns the time at which the allowance of a burner expires,
+// or the zero time if it doesn't.
+func (k *Keeper) GetBurnerExpiry(ctx context.Context, denom string, burner []byte) time.Time {
+	expiry, _ := k.BurnerExpiries.Get(ctx, collections.Join(denom, burner))
+	return expiry
+}
+
+// SetBurnerExpiry sets the time at which the allowance of a burner expires, or
+// removes it if the provided time is zero.
+func (k *Keeper) SetBurnerExpiry(ctx context.Context, denom string, burner []byte, expiry time.Time) error {
+	if expiry.IsZero() {
+		return k.DeleteBurnerExpiry(ctx, denom, burner)
+	}
+
+	if err := k.DeleteBurnerExpiry(ctx, denom, burner); err != nil {
+		return err
+	}
+	if err := k.BurnerExpiriesByTime.Set(ctx, collections.Join3(expiry, denom, burner)); err != nil {
+		return err
+	}
+
+	return k.BurnerExpiries.Set(ctx, collections.Join(denom, burner), expiry)
+}
+
+//
+
+func (k *Keeper) DeleteBurnerSource(ctx context.Context, denom string, burner []byte, source []byte) error {
+	return k.BurnerSources.Remove(ctx, collections.Join3(denom, burner, source))
+}
+
+// GetBurnerSources returns the addresses that a burner is permitted to burn from.
+func (k *Keeper) GetBurnerSources(ctx context.Context, denom string, burner []byte) (sources []string) {
+	rng := collections.NewSuperPrefixedTripleRange[string, []byte, []byte](denom, burner)
+	_ = k.BurnerSources.Walk(ctx, rng, func(key collections.Triple[string, []byte, []byte]) (stop bool, err error) {
+		address, _ := k.addressCodec.BytesToString(key.K3())
+		sources = append(sources, address)
+
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) HasBurnerSource(ctx context.Context, denom string, burner []byte, source []byte) bool {
+	has, _ := k.BurnerSources.Has(ctx, collections.Join3(denom, burner, source))
 	return has
 }
 
-func (k *Keeper) SetBurner(ctx context.Context, burner string, allowance math.Int) error {
-	return k.Burners.Set(ctx, burner, allowance)
+func (k *Keeper) SetBurnerSource(ctx context.Context, denom string, burner []byte, source []byte) error {
+	return k.BurnerSources.Set(ctx, collections.Join3(denom, burner, source))
 }
 
 //
 
-func (k *Keeper) DeleteMinter(ctx context.Context, minter string) error {
-	return k.Minters.Remove(ctx, minter)
+func (k *Keeper) DeleteMinter(ctx context.Context, denom string, minter []byte) error {
+	if err := k.DeleteMinterExpiry(ctx, denom, minter); err != nil {
+		return err
+	}
+	if err := k.MinterRecipients.Clear(ctx, collections.NewSuperPrefixedTripleRange[string, []byte, []byte](denom, minter)); err != nil {
+		return err
+	}
+
+	return k.Minters.Remove(ctx, collections.Join(denom, minter))
 }
 
-func (k *Keeper) GetMinter(ctx context.Context, minter string) (allowance math.Int) {
-	allowance, err := k.Minters.Get(ctx, minter)
+func (k *Keeper) GetMinter(ctx context.Context, denom string, minter []byte) (allowance math.Int) {
+	allowance, err := k.Minters.Get(ctx, collections.Join(denom, minter))
 	if err != nil {
 		return math.ZeroInt()
 	}
@@ -96,11 +315,15 @@ func (k *Keeper) GetMinter(ctx context.Context, minter string) (allowance math.I
 	return
 }
 
-func (k *Keeper) GetMinters(ctx context.Context) (minters []types.Minter) {
-	_ = k.Minters.Walk(ctx, nil, func(minter string, allowance math.Int) (stop bool, err error) {
+func (k *Keeper) GetMinters(ctx context.Context, denom string) (minters []types.Minter) {
+	rng := collections.NewPrefixedPairRange[string, []byte](denom)
+	_ = k.Minters.Walk(ctx, rng, func(key collections.Pair[string, []byte], allowance math.Int) (stop bool, err error) {
+		address, _ := k.addressCodec.BytesToString(key.K2())
 		minters = append(minters, types.Minter{
-			Address:   minter,
-			Allowance: allowance,
+			Address:    address,
+			Allowance:  allowance,
+			Expiry:     k.GetMinterExpiry(ctx, denom, key.K2()),
+			Recipients: k.GetMinterRecipients(ctx, denom, key.K2()),
 		})
 
 		return false, nil
@@ -109,47 +332,202 @@ func (k *Keeper) GetMinters(ctx context.Context) (minters []types.Minter) {
 	return
 }
 
-func (k *Keeper) HasMinter(ctx context.Context, minter string) bool {
-	has, _ := k.Minters.Has(ctx, minter)
+func (k *Keeper) HasMinter(ctx context.Context, denom string, minter []byte) bool {
+	has, _ := k.Minters.Has(ctx, collections.Join(denom, minter))
 	return has
 }
 
-func (k *Keeper) SetMinter(ctx context.Context, minter string, allowance math.Int) error {
-	return k.Minters.Set(ctx, minter, allowance)
+func (k *Keeper) SetMinter(ctx context.Context, denom string, minter []byte, allowance math.Int) error {
+	return k.Minters.Set(ctx, collections.Join(denom, minter), allowance)
 }
 
 //
 
-func (k *Keeper) DeletePauser(ctx context.Context, pauser string) error {
-	return k.Pausers.Remove(ctx, pauser)
+func (k *Keeper) DeleteMinterExpiry(ctx context.Context, denom string, minter []byte) error {
+	expiry, err := k.MinterExpiries.Get(ctx, collections.Join(denom, minter))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.MinterExpiriesByTime.Remove(ctx, collections.Join3(expiry, denom, minter)); err != nil {
+		return err
+	}
+
+	return k.MinterExpiries.Remove(ctx, collections.Join(denom, minter))
+}
+
+// GetMinterExpiry returns the time at which the allowance of a minter expires,
+// or the zero time if it doesn't.
+func (k *Keeper) GetMinterExpiry(ctx context.Context, denom string, minter []byte) time.Time {
+	expiry, _ := k.MinterExpiries.Get(ctx, collections.Join(denom, minter))
+	return expiry
 }
 
-func (k *Keeper) GetPausers(ctx context.Context) (pausers []string) {
-	_ = k.Pausers.Walk(ctx, nil, func(pauser string, _ []byte) (stop bool, err error) {
-		pausers = append(pausers, pauser)
+// SetMinterExpiry sets the time at which the allowance of a minter expires, or
+// removes it if the provided time is zero.
+func (k *Keeper) SetMinterExpiry(ctx context.Context, denom string, minter []byte, expiry time.Time) error {
+	if expiry.IsZero() {
+		return k.DeleteMinterExpiry(ctx, denom, minter)
+	}
+
+	if err := k.DeleteMinterExpiry(ctx, denom, minter); err != nil {
+		return err
+	}
+	if err := k.MinterExpiriesByTime.Set(ctx, collections.Join3(expiry, denom, minter)); err != nil {
+		return err
+	}
+
+	return k.MinterExpiries.Set(ctx, collections.Join(denom, minter), expiry)
+}
+
+//
+
+func (k *Keeper) DeleteMinterRecipient(ctx context.Context, denom string, minter []byte, recipient []byte) error {
+	return k.MinterRecipients.Remove(ctx, collections.Join3(denom, minter, recipient))
+}
+
+// GetMinterRecipients returns the addresses that a minter is permitted to mint to.
+func (k *Keeper) GetMinterRecipients(ctx context.Context, denom string, minter []byte) (recipients []string) {
+	rng := collections.NewSuperPrefixedTripleRange[string, []byte, []byte](denom, minter)
+	_ = k.MinterRecipients.Walk(ctx, rng, func(key collections.Triple[string, []byte, []byte]) (stop bool, err error) {
+		address, _ := k.addressCodec.BytesToString(key.K3())
+		recipients = append(recipients, address)
+
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) HasMinterRecipient(ctx context.Context, denom string, minter []byte, recipient []byte) bool {
+	has, _ := k.MinterRecipients.Has(ctx, collections.Join3(denom, minter, recipient))
+	return has
+}
+
+func (k *Keeper) SetMinterRecipient(ctx context.Context, denom string, minter []byte, recipient []byte) error {
+	return k.MinterRecipients.Set(ctx, collections.Join3(denom, minter, recipient))
+}
+
+//
+
+func (k *Keeper) DeletePauser(ctx context.Context, denom string, pauser []byte) error {
+	return k.Pausers.Remove(ctx, collections.Join(denom, pauser))
+}
+
+func (k *Keeper) GetPausers(ctx context.Context, denom string) (pausers []string) {
+	rng := collections.NewPrefixedPairRange[string, []byte](denom)
+	_ = k.Pausers.Walk(ctx, rng, func(key collections.Pair[string, []byte], _ []byte) (stop bool, err error) {
+		address, _ := k.addressCodec.BytesToString(key.K2())
+		pausers = append(pausers, address)
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) HasPauser(ctx context.Context, denom string, pauser []byte) bool {
+	has, _ := k.Pausers.Has(ctx, collections.Join(denom, pauser))
+	return has
+}
+
+func (k *Keeper) SetPauser(ctx context.Context, denom string, pauser []byte) error {
+	return k.Pausers.Set(ctx, collections.Join(denom, pauser), []byte{})
+}
+
+//
+
+func (k *Keeper) DeleteBlockedChannel(ctx context.Context, denom, channel string) error {
+	if err := k.BlockedEscrows.Remove(ctx, collections.Join(denom, transfertypes.GetEscrowAddress(transfertypes.PortID, channel).Bytes())); err != nil {
+		return err
+	}
+
+	return k.BlockedChannels.Remove(ctx, collections.Join(denom, channel))
+}
+
+func (k *Keeper) GetBlockedChannel(ctx context.Context, denom, channel string) types.ChannelDirection {
+	direction, _ := k.BlockedChannels.Get(ctx, collections.Join(denom, channel))
+	return direction
+}
+
+func (k *Keeper) GetBlockedChannels(ctx context.Context, denom string) (channels []types.BlockedChannel) {
+	rng := collections.NewPrefixedPairRange[string, string](denom)
+	_ = k.BlockedChannels.Walk(ctx, rng, func(key collections.Pair[string, string], direction types.ChannelDirection) (stop bool, err error) {
+		channels = append(channels, types.BlockedChannel{
+			Channel:   key.K2(),
+			Direction: direction,
+		})
+
 		return false, nil
 	})
 
 	return
 }
 
-func (k *Keeper) HasPauser(ctx context.Context, pauser string) bool {
-	has, _ := k.Pausers.Has(ctx, pauser)
+func (k *Keeper) HasBlockedChannel(ctx context.Context, denom, channel string) bool {
+	has, _ := k.BlockedChannels.Has(ctx, collections.Join(denom, channel))
 	return has
 }
 
-func (k *Keeper) SetPauser(ctx context.Context, pauser string) error {
-	return k.Pausers.Set(ctx, pauser, []byte{})
+func (k *Keeper) SetBlockedChannel(ctx context.Context, denom, channel string, direction types.ChannelDirection) error {
+	if err := k.SetTransferEscrow(ctx, channel); err != nil {
+		return err
+	}
+	if err := k.BlockedEscrows.Set(ctx, collections.Join(denom, transfertypes.GetEscrowAddress(transfertypes.PortID, channel).Bytes()), channel); err != nil {
+		return err
+	}
+
+	return k.BlockedChannels.Set(ctx, collections.Join(denom, channel), direction.Normalize())
+}
+
+// GetBlockedEscrow returns the blocked channel of a denom whose transfer
+// escrow account is the provided address.
+func (k *Keeper) GetBlockedEscrow(ctx context.Context, denom string, escrow []byte) (string, bool) {
+	channel, err := k.BlockedEscrows.Get(ctx, collections.Join(denom, escrow))
+	return channel, err == nil
 }
 
 //
 
-func (k *Keeper) DeleteBlockedChannel(ctx context.Context, channel string) error {
-	return k.BlockedChannels.Remove(ctx, channel)
+// IsBlocklistBound returns true if the shared blocklist is enforced on
+// transfers of the provided denom. It always is for the default denom.
+func (k *Keeper) IsBlocklistBound(ctx context.Context, denom string) bool {
+	if denom == k.Denom {
+		return true
+	}
+
+	has, _ := k.BlocklistBinding.Has(ctx, denom)
+	return has
+}
+
+func (k *Keeper) SetBlocklistBinding(ctx context.Context, denom string, bound bool) error {
+	if bound {
+		return k.BlocklistBinding.Set(ctx, denom)
+	}
+
+	return k.BlocklistBinding.Remove(ctx, denom)
 }
 
-func (k *Keeper) GetBlockedChannels(ctx context.Context) (channels []string) {
-	_ = k.BlockedChannels.Walk(ctx, nil, func(channel string, _ []byte) (stop bool, err error) {
+//
+
+func (k *Keeper) GetChannelMode(ctx context.Context) types.ChannelMode {
+	mode, _ := k.ChannelMode.Get(ctx)
+	return mode.Normalize()
+}
+
+func (k *Keeper) SetChannelMode(ctx context.Context, mode types.ChannelMode) error {
+	return k.ChannelMode.Set(ctx, mode.Normalize())
+}
+
+//
+
+func (k *Keeper) DeleteAllowedChannel(ctx context.Context, channel string) error {
+	return k.AllowedChannels.Remove(ctx, channel)
+}
+
+func (k *Keeper) GetAllowedChannels(ctx context.Context) (channels []string) {
+	_ = k.AllowedChannels.Walk(ctx, nil, func(channel string, _ []byte) (stop bool, err error) {
 		channels = append(channels, channel)
 		return false, nil
 	})
@@ -157,11 +535,90 @@ func (k *Keeper) GetBlockedChannels(ctx context.Context) (channels []string) {
 	return
 }
 
-func (k *Keeper) HasBlockedChannel(ctx context.Context, channel string) bool {
-	has, _ := k.BlockedChannels.Has(ctx, channel)
+func (k *Keeper) HasAllowedChannel(ctx context.Context, channel string) bool {
+	has, _ := k.AllowedChannels.Has(ctx, channel)
 	return has
 }
 
-func (k *Keeper) SetBlockedChannel(ctx context.Context, channel string) error {
-	return k.BlockedChannels.Set(ctx, channel, []byte{})
+func (k *Keeper) SetAllowedChannel(ctx context.Context, channel string) error {
+	if err := k.SetTransferEscrow(ctx, channel); err != nil {
+		return err
+	}
+
+	return k.AllowedChannels.Set(ctx, channel, []byte{})
+}
+
+//
+
+func (k *Keeper) DeleteRateLimit(ctx context.Context, channel string) error {
+	return k.RateLimits.Remove(ctx, channel)
+}
+
+func (k *Keeper) GetRateLimit(ctx context.Context, channel string) types.RateLimit {
+	rateLimit, _ := k.RateLimits.Get(ctx, channel)
+	return rateLimit
+}
+
+func (k *Keeper) GetRateLimits(ctx context.Context) (rateLimits []types.RateLimit) {
+	_ = k.RateLimits.Walk(ctx, nil, func(_ string, rateLimit types.RateLimit) (stop bool, err error) {
+		rateLimits = append(rateLimits, rateLimit)
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) HasRateLimit(ctx context.Context, channel string) bool {
+	has, _ := k.RateLimits.Has(ctx, channel)
+	return has
+}
+
+func (k *Keeper) SetRateLimit(ctx context.Context, rateLimit types.RateLimit) error {
+	if err := k.SetTransferEscrow(ctx, rateLimit.Channel); err != nil {
+		return err
+	}
+
+	return k.RateLimits.Set(ctx, rateLimit.Channel, rateLimit)
+}
+
+//
+
+func (k *Keeper) DeleteRateLimitFlow(ctx context.Context, channel string) error {
+	return k.RateLimitFlows.Remove(ctx, channel)
+}
+
+func (k *Keeper) GetRateLimitFlows(ctx context.Context) (flows []types.RateLimitFlow) {
+	_ = k.RateLimitFlows.Walk(ctx, nil, func(_ string, flow types.RateLimitFlow) (stop bool, err error) {
+		flows = append(flows, flow)
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) SetRateLimitFlow(ctx context.Context, flow types.RateLimitFlow) error {
+	return k.RateLimitFlows.Set(ctx, flow.Channel, flow)
+}
+
+//
+
+// GetTransferChannel returns the indexed channel whose transfer escrow account
+// is the provided address.
+func (k *Keeper) GetTransferChannel(ctx context.Context, escrow []byte) (string, bool) {
+	channel, err := k.TransferEscrows.Get(ctx, escrow)
+	return channel, err == nil
+}
+
+// SetTransferEscrow indexes the transfer escrow account of a channel.
+func (k *Keeper) SetTransferEscrow(ctx context.Context, channel string) error {
+	return k.TransferEscrows.Set(ctx, transfertypes.GetEscrowAddress(transfertypes.PortID, channel), channel)
+}
+
+func (k *Keeper) GetIndexedChannels(ctx context.Context) uint64 {
+	indexed, _ := k.IndexedChannels.Get(ctx)
+	return indexed
+}
+
+func (k *Keeper) SetIndexedChannels(ctx context.Context, indexed uint64) error {
+	return k.IndexedChannels.Set(ctx, indexed)
 }
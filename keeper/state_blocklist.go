@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/ondoprotocol/usdy-noble/v2/types/blocklist"
 )
 
 //
@@ -55,3 +58,64 @@ func (k *Keeper) HasBlockedAddress(ctx context.Context, address []byte) bool {
 func (k *Keeper) SetBlockedAddress(ctx context.Context, address []byte) error {
 	return k.BlockedAddresses.Set(ctx, address, []byte{})
 }
+
+//
+
+func (k *Keeper) DeleteSuspendedAddress(ctx context.Context, address []byte) error {
+	return k.SuspendedAddresses.Remove(ctx, address)
+}
+
+func (k *Keeper) GetSuspendedAddresses(ctx context.Context) (addresses []string) {
+	_ = k.SuspendedAddresses.Walk(ctx, nil, func(rawAddress []byte) (stop bool, err error) {
+		address, _ := k.addressCodec.BytesToString(rawAddress)
+		addresses = append(addresses, address)
+
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) HasSuspendedAddress(ctx context.Context, address []byte) bool {
+	has, _ := k.SuspendedAddresses.Has(ctx, address)
+	return has
+}
+
+func (k *Keeper) SetSuspendedAddress(ctx context.Context, address []byte) error {
+	return k.SuspendedAddresses.Set(ctx, address)
+}
+
+//
+
+// GetRoleConflicts returns the burner, minter, and pauser roles that blocked
+// addresses hold on denoms subject to the blocklist, and that addresses with
+// suspended roles hold on any denom.
+func (k *Keeper) GetRoleConflicts(ctx context.Context) (conflicts []blocklist.RoleConflict) {
+	for _, denom := range k.Denoms {
+		conflicts = append(conflicts, getRoleConflicts(ctx, k, k.Burners, denom, "burner")...)
+		conflicts = append(conflicts, getRoleConflicts(ctx, k, k.Minters, denom, "minter")...)
+		conflicts = append(conflicts, getRoleConflicts(ctx, k, k.Pausers, denom, "pauser")...)
+	}
+
+	return
+}
+
+// getRoleConflicts returns the entries of a role map of a denom that are held
+// by addresses blocked from holding them.
+func getRoleConflicts[V any](ctx context.Context, k *Keeper, roles collections.Map[collections.Pair[string, []byte], V], denom string, role string) (conflicts []blocklist.RoleConflict) {
+	rng := collections.NewPrefixedPairRange[string, []byte](denom)
+	_ = roles.Walk(ctx, rng, func(key collections.Pair[string, []byte], _ V) (stop bool, err error) {
+		if k.isBlocked(ctx, denom, key.K2()) {
+			address, _ := k.addressCodec.BytesToString(key.K2())
+			conflicts = append(conflicts, blocklist.RoleConflict{
+				Address: address,
+				Denom:   denom,
+				Role:    role,
+			})
+		}
+
+		return false, nil
+	})
+
+	return
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k *Keeper) GetLockupPeriod(ctx context.Context) time.Duration {
+	period, _ := k.LockupPeriod.Get(ctx)
+	return time.Duration(period)
+}
+
+func (k *Keeper) SetLockupPeriod(ctx context.Context, period time.Duration) error {
+	return k.LockupPeriod.Set(ctx, int64(period))
+}
+
+//
+
+// GetLockedLot returns the amount of USDY of an address that unlocks at the
+// provided time.
+func (k *Keeper) GetLockedLot(ctx context.Context, address []byte, unlockTime time.Time) math.Int {
+	amount, err := k.LockedLots.Get(ctx, collections.Join(address, unlockTime))
+	if err != nil {
+		return math.ZeroInt()
+	}
+
+	return amount
+}
+
+func (k *Keeper) GetLockedLots(ctx context.Context) (lots []types.LockedLot) {
+	_ = k.LockedLots.Walk(ctx, nil, func(key collections.Pair[[]byte, time.Time], amount math.Int) (stop bool, err error) {
+		address, _ := k.addressCodec.BytesToString(key.K1())
+
+		lots = append(lots, types.LockedLot{
+			Address:    address,
+			Amount:     amount,
+			UnlockTime: key.K2(),
+		})
+
+		return false, nil
+	})
+
+	return
+}
+
+// GetLockedLotsByAddress returns the lots of an address in order of unlock
+// time, including lots that have unlocked but are yet to be pruned.
+func (k *Keeper) GetLockedLotsByAddress(ctx context.Context, address []byte) (lots []types.LockedLot) {
+	bech32, _ := k.addressCodec.BytesToString(address)
+	rng := collections.NewPrefixedPairRange[[]byte, time.Time](address)
+
+	_ = k.LockedLots.Walk(ctx, rng, func(key collections.Pair[[]byte, time.Time], amount math.Int) (stop bool, err error) {
+		lots = append(lots, types.LockedLot{
+			Address:    bech32,
+			Amount:     amount,
+			UnlockTime: key.K2(),
+		})
+
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) SetLockedLot(ctx context.Context, address []byte, unlockTime time.Time, amount math.Int) error {
+	return k.LockedLots.Set(ctx, collections.Join(address, unlockTime), amount)
+}
+
+func (k *Keeper) DeleteLockedLot(ctx context.Context, address []byte, unlockTime time.Time) error {
+	return k.LockedLots.Remove(ctx, collections.Join(address, unlockTime))
+}
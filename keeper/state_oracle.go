@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k *Keeper) DeletePriceSetter(ctx context.Context, priceSetter []byte) error {
+	return k.PriceSetters.Remove(ctx, priceSetter)
+}
+
+func (k *Keeper) GetPriceSetters(ctx context.Context) (priceSetters []string) {
+	_ = k.PriceSetters.Walk(ctx, nil, func(priceSetter []byte, _ []byte) (stop bool, err error) {
+		address, _ := k.addressCodec.BytesToString(priceSetter)
+		priceSetters = append(priceSetters, address)
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) HasPriceSetter(ctx context.Context, priceSetter []byte) bool {
+	has, _ := k.PriceSetters.Has(ctx, priceSetter)
+	return has
+}
+
+func (k *Keeper) SetPriceSetter(ctx context.Context, priceSetter []byte) error {
+	return k.PriceSetters.Set(ctx, priceSetter, []byte{})
+}
+
+//
+
+// GetLatestPriceRange returns the price range with the highest index.
+func (k *Keeper) GetLatestPriceRange(ctx context.Context) (priceRange types.PriceRange, found bool) {
+	_ = k.PriceRanges.Walk(ctx, new(collections.Range[uint64]).Descending(), func(_ uint64, value types.PriceRange) (stop bool, err error) {
+		priceRange, found = value, true
+		return true, nil
+	})
+
+	return
+}
+
+func (k *Keeper) GetPriceRanges(ctx context.Context) (priceRanges []types.PriceRange) {
+	_ = k.PriceRanges.Walk(ctx, nil, func(_ uint64, priceRange types.PriceRange) (stop bool, err error) {
+		priceRanges = append(priceRanges, priceRange)
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) SetPriceRange(ctx context.Context, priceRange types.PriceRange) error {
+	return k.PriceRanges.Set(ctx, priceRange.Index, priceRange)
+}
+
+//
+
+func (k *Keeper) GetMaxPriceDeviation(ctx context.Context) math.LegacyDec {
+	maxPriceDeviation, err := k.MaxPriceDeviation.Get(ctx)
+	if err != nil {
+		return types.DefaultMaxPriceDeviation
+	}
+
+	return maxPriceDeviation
+}
+
+func (k *Keeper) SetMaxPriceDeviation(ctx context.Context, maxPriceDeviation math.LegacyDec) error {
+	return k.MaxPriceDeviation.Set(ctx, maxPriceDeviation)
+}
+
+//
+
+// GetPrice returns the USDY price at the current block time, derived from the
+// latest price range that has started. If the block time is after the end of
+// all ranges, the close price of the latest range is returned.
+func (k *Keeper) GetPrice(ctx context.Context) (price math.LegacyDec, found bool) {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	_ = k.PriceRanges.Walk(ctx, new(collections.Range[uint64]).Descending(), func(_ uint64, priceRange types.PriceRange) (stop bool, err error) {
+		if priceRange.Start.After(now) {
+			return false, nil
+		}
+
+		price, found = priceRange.PriceAt(now), true
+		return true, nil
+	})
+
+	return
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+)
+
+func (k *Keeper) DeleteRecoverer(ctx context.Context, denom string, recoverer []byte) error {
+	return k.Recoverers.Remove(ctx, collections.Join(denom, recoverer))
+}
+
+func (k *Keeper) GetRecoverers(ctx context.Context, denom string) (recoverers []string) {
+	rng := collections.NewPrefixedPairRange[string, []byte](denom)
+	_ = k.Recoverers.Walk(ctx, rng, func(key collections.Pair[string, []byte], _ []byte) (stop bool, err error) {
+		address, _ := k.addressCodec.BytesToString(key.K2())
+		recoverers = append(recoverers, address)
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) HasRecoverer(ctx context.Context, denom string, recoverer []byte) bool {
+	has, _ := k.Recoverers.Has(ctx, collections.Join(denom, recoverer))
+	return has
+}
+
+func (k *Keeper) SetRecoverer(ctx context.Context, denom string, recoverer []byte) error {
+	return k.Recoverers.Set(ctx, collections.Join(denom, recoverer), []byte{})
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k *Keeper) GetRedemption(ctx context.Context, id uint64) (types.Redemption, bool) {
+	redemption, err := k.Redemptions.Get(ctx, id)
+	return redemption, err == nil
+}
+
+func (k *Keeper) GetRedemptions(ctx context.Context) (redemptions []types.Redemption) {
+	_ = k.Redemptions.Walk(ctx, nil, func(_ uint64, redemption types.Redemption) (stop bool, err error) {
+		redemptions = append(redemptions, redemption)
+		return false, nil
+	})
+
+	return
+}
+
+// SetRedemption stores a redemption request, keeping the queue of pending
+// requests in sync with its status.
+func (k *Keeper) SetRedemption(ctx context.Context, redemption types.Redemption) error {
+	if err := k.Redemptions.Set(ctx, redemption.Id, redemption); err != nil {
+		return err
+	}
+
+	if redemption.Status == types.RequestStatusPending {
+		return k.PendingRedemptions.Set(ctx, redemption.Id)
+	}
+
+	return k.PendingRedemptions.Remove(ctx, redemption.Id)
+}
+
+//
+
+// GetNextPendingRedemption returns the identifier of the oldest pending
+// redemption request, which is the next one to be settled.
+func (k *Keeper) GetNextPendingRedemption(ctx context.Context) (id uint64, found bool) {
+	_ = k.PendingRedemptions.Walk(ctx, nil, func(key uint64) (stop bool, err error) {
+		id, found = key, true
+		return true, nil
+	})
+
+	return
+}
+
+//
+
+func (k *Keeper) GetNextRedemptionID(ctx context.Context) uint64 {
+	id, _ := k.NextRedemptionID.Peek(ctx)
+	return id
+}
+
+func (k *Keeper) SetNextRedemptionID(ctx context.Context, id uint64) error {
+	return k.NextRedemptionID.Set(ctx, id)
+}
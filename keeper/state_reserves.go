@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k *Keeper) DeleteAttestor(ctx context.Context, attestor []byte) error {
+	return k.Attestors.Remove(ctx, attestor)
+}
+
+func (k *Keeper) GetAttestors(ctx context.Context) (attestors []string) {
+	_ = k.Attestors.Walk(ctx, nil, func(attestor []byte, _ []byte) (stop bool, err error) {
+		address, _ := k.addressCodec.BytesToString(attestor)
+		attestors = append(attestors, address)
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) HasAttestor(ctx context.Context, attestor []byte) bool {
+	has, _ := k.Attestors.Has(ctx, attestor)
+	return has
+}
+
+func (k *Keeper) SetAttestor(ctx context.Context, attestor []byte) error {
+	return k.Attestors.Set(ctx, attestor, []byte{})
+}
+
+//
+
+// GetLatestAttestation returns the attestation with the highest index.
+func (k *Keeper) GetLatestAttestation(ctx context.Context) (attestation types.Attestation, found bool) {
+	_ = k.Attestations.Walk(ctx, new(collections.Range[uint64]).Descending(), func(_ uint64, value types.Attestation) (stop bool, err error) {
+		attestation, found = value, true
+		return true, nil
+	})
+
+	return
+}
+
+func (k *Keeper) GetAttestations(ctx context.Context) (attestations []types.Attestation) {
+	_ = k.Attestations.Walk(ctx, nil, func(_ uint64, attestation types.Attestation) (stop bool, err error) {
+		attestations = append(attestations, attestation)
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) SetAttestation(ctx context.Context, attestation types.Attestation) error {
+	return k.Attestations.Set(ctx, attestation.Index, attestation)
+}
+
+//
+
+func (k *Keeper) GetReserveConfig(ctx context.Context) types.ReserveConfig {
+	config, err := k.ReserveConfig.Get(ctx)
+	if err != nil {
+		return types.DefaultReserveConfig()
+	}
+
+	return config
+}
+
+func (k *Keeper) SetReserveConfig(ctx context.Context, config types.ReserveConfig) error {
+	return k.ReserveConfig.Set(ctx, config)
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k *Keeper) GetStablecoin(ctx context.Context) types.Stablecoin {
+	stablecoin, _ := k.Stablecoin.Get(ctx)
+	return stablecoin
+}
+
+func (k *Keeper) SetStablecoin(ctx context.Context, stablecoin types.Stablecoin) error {
+	return k.Stablecoin.Set(ctx, stablecoin)
+}
+
+//
+
+func (k *Keeper) GetSubscription(ctx context.Context, id uint64) (types.Subscription, bool) {
+	subscription, err := k.Subscriptions.Get(ctx, id)
+	return subscription, err == nil
+}
+
+func (k *Keeper) GetSubscriptions(ctx context.Context) (subscriptions []types.Subscription) {
+	_ = k.Subscriptions.Walk(ctx, nil, func(_ uint64, subscription types.Subscription) (stop bool, err error) {
+		subscriptions = append(subscriptions, subscription)
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) SetSubscription(ctx context.Context, subscription types.Subscription) error {
+	return k.Subscriptions.Set(ctx, subscription.Id, subscription)
+}
+
+//
+
+func (k *Keeper) GetNextSubscriptionID(ctx context.Context) uint64 {
+	id, _ := k.NextSubscriptionID.Peek(ctx)
+	return id
+}
+
+func (k *Keeper) SetNextSubscriptionID(ctx context.Context, id uint64) error {
+	return k.NextSubscriptionID.Set(ctx, id)
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+func (k *Keeper) GetSwapConfig(ctx context.Context) types.SwapConfig {
+	config, err := k.SwapConfig.Get(ctx)
+	if err != nil {
+		return types.DefaultSwapConfig()
+	}
+
+	return config
+}
+
+func (k *Keeper) SetSwapConfig(ctx context.Context, config types.SwapConfig) error {
+	return k.SwapConfig.Set(ctx, config)
+}
+
+//
+
+func (k *Keeper) GetSwapsPaused(ctx context.Context) bool {
+	paused, _ := k.SwapsPaused.Get(ctx)
+	return paused
+}
+
+func (k *Keeper) SetSwapsPaused(ctx context.Context, paused bool) error {
+	return k.SwapsPaused.Set(ctx, paused)
+}
+
+//
+
+func (k *Keeper) GetSwapLiquidity(ctx context.Context) sdk.Coin {
+	liquidity, err := k.SwapLiquidity.Get(ctx)
+	if err != nil {
+		return sdk.Coin{Amount: math.ZeroInt()}
+	}
+
+	return liquidity
+}
+
+func (k *Keeper) SetSwapLiquidity(ctx context.Context, liquidity sdk.Coin) error {
+	return k.SwapLiquidity.Set(ctx, liquidity)
+}
+
+//
+
+// GetSwapVolume returns the amount of USDY an address has swapped on the
+// current day. Volumes recorded on previous days are treated as zero.
+func (k *Keeper) GetSwapVolume(ctx context.Context, address []byte) math.Int {
+	volume, err := k.SwapVolumes.Get(ctx, address)
+	if err != nil || volume.Day != types.SwapDay(sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return math.ZeroInt()
+	}
+
+	return volume.Volume
+}
+
+func (k *Keeper) GetSwapVolumes(ctx context.Context) (volumes []types.SwapVolume) {
+	_ = k.SwapVolumes.Walk(ctx, nil, func(_ []byte, volume types.SwapVolume) (stop bool, err error) {
+		volumes = append(volumes, volume)
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) SetSwapVolume(ctx context.Context, address []byte, volume types.SwapVolume) error {
+	return k.SwapVolumes.Set(ctx, address, volume)
+}
+
+// SwapFacilityAddress returns the address that the minter and burner
+// allowances of the swap facility are tracked under, the module account.
+func (k *Keeper) SwapFacilityAddress() sdk.AccAddress {
+	return types.ModuleAddress
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+	sdkerrors "cosmossdk.io/errors"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"google.golang.org/grpc"
+)
+
+var _ gogogrpc.Server = &msgValidator{}
+
+// methodHandler is the signature of grpc.MethodDesc handlers.
+type methodHandler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+// msgValidator wraps a message service registrar, so that all messages of
+// registered services are statelessly validated before they are executed.
+type msgValidator struct {
+	gogogrpc.Server
+
+	addressCodec address.Codec
+}
+
+// NewMsgValidator returns a message service registrar that validates all
+// messages implementing types.ValidatableMsg with the provided address codec,
+// before handing them to the registered message server.
+func NewMsgValidator(server gogogrpc.Server, addressCodec address.Codec) gogogrpc.Server {
+	return &msgValidator{Server: server, addressCodec: addressCodec}
+}
+
+func (v *msgValidator) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	desc := *sd
+	desc.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		desc.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler:    v.wrapHandler(method.Handler),
+		}
+	}
+
+	v.Server.RegisterService(&desc, ss)
+}
+
+// wrapHandler returns a method handler that validates the message passed to
+// the underlying message server. The validation is done in the innermost
+// handler, as the router intercepts requests to replace the decoded message.
+func (v *msgValidator) wrapHandler(handler methodHandler) methodHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		return handler(srv, ctx, dec, func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (interface{}, error) {
+			validated := func(ctx context.Context, req interface{}) (interface{}, error) {
+				if msg, ok := req.(types.ValidatableMsg); ok {
+					if err := msg.Validate(v.addressCodec); err != nil {
+						return nil, sdkerrors.Wrap(errortypes.ErrInvalidRequest, err.Error())
+					}
+				}
+
+				return next(ctx, req)
+			}
+
+			if interceptor == nil {
+				return validated(ctx, req)
+			}
+			return interceptor(ctx, req, info, validated)
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ondoprotocol/usdy-noble/v2/keeper"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types/blocklist"
+	"github.com/ondoprotocol/usdy-noble/v2/utils"
+	"github.com/ondoprotocol/usdy-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgValidator(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+
+	// ARRANGE: Register the message servers via the validator.
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	blocklist.RegisterInterfaces(registry)
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(registry)
+	server := keeper.NewMsgValidator(router, address.NewBech32Codec("noble"))
+	types.RegisterMsgServer(server, keeper.NewMsgServer(k))
+	blocklist.RegisterMsgServer(server, keeper.NewBlocklistMsgServer(k))
+
+	// ARRANGE: Set owner and blocklist owner in state.
+	owner, pauser := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+	require.NoError(t, k.SetBlocklistOwner(ctx, owner.Address))
+
+	testCases := []struct {
+		name string
+		msg  sdk.Msg
+		err  string
+	}{
+		{
+			name: "InvalidSigner",
+			msg:  &types.MsgAddPauser{Signer: "noble1signer", Pauser: pauser.Address},
+			err:  "invalid signer address",
+		},
+		{
+			name: "InvalidPauser",
+			msg:  &types.MsgAddPauser{Signer: owner.Address, Pauser: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"},
+			err:  "invalid pauser address",
+		},
+		{
+			name: "InvalidDenom",
+			msg:  &types.MsgAddPauser{Signer: owner.Address, Pauser: pauser.Address, Denom: "!"},
+			err:  "invalid denom",
+		},
+		{
+			name: "InvalidRecovererDenom",
+			msg:  &types.MsgAddRecoverer{Signer: owner.Address, Recoverer: pauser.Address, Denom: "!"},
+			err:  "invalid denom",
+		},
+		{
+			name: "NegativeAllowance",
+			msg:  &types.MsgAddMinter{Signer: owner.Address, Minter: pauser.Address, Allowance: ONE.Neg()},
+			err:  "allowance must be non-negative",
+		},
+		{
+			name: "NonPositiveAmount",
+			msg:  &types.MsgMint{Signer: owner.Address, To: pauser.Address, Amount: math.ZeroInt()},
+			err:  "amount must be positive",
+		},
+		{
+			name: "InvalidChannel",
+			msg:  &types.MsgAddAllowedChannel{Signer: owner.Address, Channel: "transfer"},
+			err:  "invalid channel",
+		},
+		{
+			name: "NoAccounts",
+			msg:  &blocklist.MsgAddToBlocklist{Signer: owner.Address},
+			err:  "accounts must be provided",
+		},
+		{
+			name: "InvalidAccount",
+			msg:  &blocklist.MsgAddToBlocklist{Signer: owner.Address, Accounts: []string{pauser.Address, "noble1account"}},
+			err:  "invalid account address",
+		},
+		{
+			name: "InvalidPauseScope",
+			msg:  &types.MsgSchedulePause{Signer: owner.Address, Scope: 99, StartHeight: 10},
+			err:  "invalid pause scope",
+		},
+		{
+			name: "NoPauseStart",
+			msg:  &types.MsgSchedulePause{Signer: owner.Address, EndHeight: 10},
+			err:  "start height or time must be provided",
+		},
+		{
+			name: "ConflictingExpiry",
+			msg:  &types.MsgSetMinterAllowance{Signer: owner.Address, Minter: pauser.Address, Allowance: ONE, Expiry: time.Unix(1, 0), ClearExpiry: true},
+			err:  "expiry cannot be provided when clearing it",
+		},
+		{
+			name: "Valid",
+			msg:  &types.MsgAddPauser{Signer: owner.Address, Pauser: pauser.Address},
+		},
+		{
+			name: "ValidScopelessSchedule",
+			msg:  &types.MsgSchedulePause{Signer: owner.Address, StartHeight: 10},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// ACT: Attempt to execute the message via the router.
+			handler := router.Handler(testCase.msg)
+			require.NotNil(t, handler)
+			_, err := handler(ctx, testCase.msg)
+
+			// ASSERT: The message should've been validated.
+			if testCase.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+				require.ErrorContains(t, err, testCase.err)
+			}
+		})
+	}
+
+	// ASSERT: Only the valid message should've been executed.
+	require.True(t, k.HasPauser(ctx, k.Denom, pauser.Bytes))
+	require.False(t, k.HasMinter(ctx, k.Denom, pauser.Bytes))
+	require.False(t, k.HasBlockedAddress(ctx, pauser.Bytes))
+	for _, scope := range types.DefaultPauseScopes {
+		window, err := k.PauseWindows.Get(ctx, collections.Join(k.Denom, scope))
+		require.NoError(t, err)
+		require.Equal(t, int64(10), window.StartHeight)
+	}
+}
+
+func TestCanonicalRoleAddresses(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set owner in state, and generate a pauser account.
+	owner, pauser := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+
+	// ACT: Attempt to add pauser with an uppercase address.
+	_, err := server.AddPauser(ctx, &types.MsgAddPauser{
+		Signer: owner.Address,
+		Pauser: strings.ToUpper(pauser.Address),
+	})
+	// ASSERT: The action should've succeeded, and stored the canonical address.
+	require.NoError(t, err)
+	require.True(t, k.HasPauser(ctx, k.Denom, pauser.Bytes))
+
+	// ACT: Attempt to add pauser again with the canonical address.
+	_, err = server.AddPauser(ctx, &types.MsgAddPauser{
+		Signer: owner.Address,
+		Pauser: pauser.Address,
+	})
+	// ASSERT: The action should've failed due to existing pauser.
+	require.ErrorContains(t, err, "is already a pauser")
+
+	// ACT: Attempt to remove pauser with an invalid address.
+	_, err = server.RemovePauser(ctx, &types.MsgRemovePauser{
+		Signer: owner.Address,
+		Pauser: "noble1pauser",
+	})
+	// ASSERT: The action should've failed due to invalid address.
+	require.ErrorContains(t, err, "unable to decode pauser address")
+
+	// ACT: Attempt to add burner with an uppercase address.
+	_, err = server.AddBurner(ctx, &types.MsgAddBurner{
+		Signer:    owner.Address,
+		Burner:    strings.ToUpper(pauser.Address),
+		Allowance: ONE,
+	})
+	// ASSERT: The action should've succeeded, and stored the canonical address.
+	require.NoError(t, err)
+	require.Equal(t, ONE, k.GetBurner(ctx, k.Denom, pauser.Bytes))
+}
+
+func TestCanonicalOwnerAddresses(t *testing.T) {
+	k, ctx := mocks.AuraKeeper()
+	server := keeper.NewMsgServer(k)
+	blocklistServer := keeper.NewBlocklistMsgServer(k)
+
+	// ARRANGE: Set owner and blocklist owner in state, and generate a new owner account.
+	owner, newOwner := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, k.Denom, owner.Address))
+	require.NoError(t, k.SetBlocklistOwner(ctx, owner.Address))
+
+	// ACT: Attempt to transfer ownership with uppercase addresses.
+	_, err := server.TransferOwnership(ctx, &types.MsgTransferOwnership{
+		Signer:   strings.ToUpper(owner.Address),
+		NewOwner: strings.ToUpper(newOwner.Address),
+	})
+	// ASSERT: The action should've succeeded, and stored the canonical address.
+	require.NoError(t, err)
+	require.Equal(t, newOwner.Address, k.GetPendingOwner(ctx, k.Denom))
+
+	// ACT: Attempt to accept ownership with an uppercase address.
+	_, err = server.AcceptOwnership(ctx, &types.MsgAcceptOwnership{
+		Signer: strings.ToUpper(newOwner.Address),
+	})
+	// ASSERT: The action should've succeeded, and stored the canonical address.
+	require.NoError(t, err)
+	require.Equal(t, newOwner.Address, k.GetOwner(ctx, k.Denom))
+
+	// ACT: Attempt to transfer ownership to the current owner with an uppercase address.
+	_, err = server.TransferOwnership(ctx, &types.MsgTransferOwnership{
+		Signer:   newOwner.Address,
+		NewOwner: strings.ToUpper(newOwner.Address),
+	})
+	// ASSERT: The action should've failed due to same owner.
+	require.ErrorIs(t, err, types.ErrSameOwner)
+
+	// ACT: Attempt to force ownership with uppercase addresses.
+	_, err = server.ForceOwnership(ctx, &types.MsgForceOwnership{
+		Signer:         strings.ToUpper(mocks.Authority),
+		Owner:          strings.ToUpper(owner.Address),
+		BlocklistOwner: strings.ToUpper(newOwner.Address),
+	})
+	// ASSERT: The action should've succeeded, and stored the canonical addresses.
+	require.NoError(t, err)
+	require.Equal(t, owner.Address, k.GetOwner(ctx, k.Denom))
+	require.Equal(t, newOwner.Address, k.GetBlocklistOwner(ctx))
+
+	// ACT: Attempt to transfer blocklist ownership with uppercase addresses.
+	_, err = blocklistServer.TransferOwnership(ctx, &blocklist.MsgTransferOwnership{
+		Signer:   strings.ToUpper(newOwner.Address),
+		NewOwner: strings.ToUpper(owner.Address),
+	})
+	// ASSERT: The action should've succeeded, and stored the canonical address.
+	require.NoError(t, err)
+	require.Equal(t, owner.Address, k.GetBlocklistPendingOwner(ctx))
+
+	// ACT: Attempt to accept blocklist ownership with an uppercase address.
+	_, err = blocklistServer.AcceptOwnership(ctx, &blocklist.MsgAcceptOwnership{
+		Signer: strings.ToUpper(owner.Address),
+	})
+	// ASSERT: The action should've succeeded, and stored the canonical address.
+	require.NoError(t, err)
+	require.Equal(t, owner.Address, k.GetBlocklistOwner(ctx))
+}
@@ -16,7 +16,9 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	blocklistv1 "github.com/ondoprotocol/usdy-noble/v2/api/blocklist/v1"
 	modulev1 "github.com/ondoprotocol/usdy-noble/v2/api/module/v1"
@@ -27,11 +29,12 @@ import (
 )
 
 // ConsensusVersion defines the current x/aura module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 5
 
 var (
 	_ module.AppModuleBasic      = AppModule{}
 	_ appmodule.AppModule        = AppModule{}
+	_ appmodule.HasBeginBlocker  = AppModule{}
 	_ module.HasConsensusVersion = AppModule{}
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasGenesisBasics    = AppModuleBasic{}
@@ -102,6 +105,10 @@ func (AppModule) IsAppModule() {}
 
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+func (m AppModule) BeginBlock(ctx context.Context) error {
+	return m.keeper.BeginBlock(ctx)
+}
+
 func (m AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) {
 	var genesis types.GenesisState
 	cdc.MustUnmarshalJSON(bz, &genesis)
@@ -115,11 +122,27 @@ func (m AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawM
 }
 
 func (m AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(m.keeper))
+	msgServer := keeper.NewMsgValidator(cfg.MsgServer(), m.addressCodec)
+
+	types.RegisterMsgServer(msgServer, keeper.NewMsgServer(m.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(m.keeper))
 
-	blocklist.RegisterMsgServer(cfg.MsgServer(), keeper.NewBlocklistMsgServer(m.keeper))
+	blocklist.RegisterMsgServer(msgServer, keeper.NewBlocklistMsgServer(m.keeper))
 	blocklist.RegisterQueryServer(cfg.QueryServer(), keeper.NewBlocklistQueryServer(m.keeper))
+
+	migrator := keeper.NewMigrator(m.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, migrator.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, migrator.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
+	}
 }
 
 //
@@ -151,11 +174,19 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "Pause",
 					Use:       "pause",
 					Short:     "Transaction that pauses the module",
+					Long:      "Transaction that pauses the module, or a single operation with --scope (PAUSE_SCOPE_IBC_OUTFLOWS, PAUSE_SCOPE_TRANSFERS, PAUSE_SCOPE_MINT, PAUSE_SCOPE_BURN), optionally lifted automatically at --end-height or --end-time",
 				},
 				{
 					RpcMethod: "Unpause",
 					Use:       "unpause",
 					Short:     "Transaction that unpauses the module",
+					Long:      "Transaction that unpauses the module, or a single operation with --scope (PAUSE_SCOPE_IBC_OUTFLOWS, PAUSE_SCOPE_TRANSFERS, PAUSE_SCOPE_MINT, PAUSE_SCOPE_BURN), also cancelling scheduled pauses",
+				},
+				{
+					RpcMethod: "SchedulePause",
+					Use:       "schedule-pause",
+					Short:     "Transaction that schedules a future pause of the module",
+					Long:      "Transaction that pauses the module, or a single operation with --scope, once --start-height or --start-time is reached, optionally lifted automatically at --end-height or --end-time",
 				},
 				{
 					RpcMethod:      "TransferOwnership",
@@ -169,6 +200,12 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Accept ownership of module",
 					Long:      "Accept ownership of module, assuming there is an pending ownership transfer",
 				},
+				{
+					RpcMethod: "ForceOwnership",
+					Use:       "force-ownership",
+					Short:     "Force the owner and blocklist owner of module",
+					Long:      "Force the owner of module with --owner, and the blocklist owner with --blocklist-owner, restricted to the module authority, e.g. via a governance proposal",
+				},
 				{
 					RpcMethod: "AddBurner",
 					Use:       "add-burner [burner] [allowance]",
@@ -193,6 +230,43 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "allowance"},
 					},
 				},
+				{
+					RpcMethod: "IncreaseBurnerAllowance",
+					Use:       "increase-burner-allowance [burner] [amount]",
+					Short:     "Increase an existing burner's allowance by an amount",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "burner"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "DecreaseBurnerAllowance",
+					Use:       "decrease-burner-allowance [burner] [amount]",
+					Short:     "Decrease an existing burner's allowance by an amount",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "burner"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "AddBurnerSource",
+					Use:       "add-burner-source [burner] [source]",
+					Short:     "Permit an existing burner to burn from a source",
+					Long:      "Permit an existing burner to burn from a source, after which it can only burn from its permitted sources",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "burner"},
+						{ProtoField: "source"},
+					},
+				},
+				{
+					RpcMethod: "RemoveBurnerSource",
+					Use:       "remove-burner-source [burner] [source]",
+					Short:     "Remove a permitted source of a burner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "burner"},
+						{ProtoField: "source"},
+					},
+				},
 				{
 					RpcMethod: "AddMinter",
 					Use:       "add-minter [minter] [allowance]",
@@ -217,6 +291,43 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "allowance"},
 					},
 				},
+				{
+					RpcMethod: "IncreaseMinterAllowance",
+					Use:       "increase-minter-allowance [minter] [amount]",
+					Short:     "Increase an existing minter's allowance by an amount",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "minter"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "DecreaseMinterAllowance",
+					Use:       "decrease-minter-allowance [minter] [amount]",
+					Short:     "Decrease an existing minter's allowance by an amount",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "minter"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "AddMinterRecipient",
+					Use:       "add-minter-recipient [minter] [recipient]",
+					Short:     "Permit an existing minter to mint to a recipient",
+					Long:      "Permit an existing minter to mint to a recipient, after which it can only mint to its permitted recipients",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "minter"},
+						{ProtoField: "recipient"},
+					},
+				},
+				{
+					RpcMethod: "RemoveMinterRecipient",
+					Use:       "remove-minter-recipient [minter] [recipient]",
+					Short:     "Remove a permitted recipient of a minter",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "minter"},
+						{ProtoField: "recipient"},
+					},
+				},
 				{
 					RpcMethod:      "AddPauser",
 					Use:            "add-pauser [pauser]",
@@ -233,6 +344,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod:      "AddBlockedChannel",
 					Use:            "add-blocked-channel [channel]",
 					Short:          "Add a new blocked channel",
+					Long:           "Add a new blocked channel, optionally specifying the blocked direction (defaults to outbound)",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel"}},
 				},
 				{
@@ -241,6 +353,274 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Remove an existing blocked channel",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel"}},
 				},
+				{
+					RpcMethod:      "AddPauseExemption",
+					Use:            "add-pause-exemption [address]",
+					Short:          "Exempt an address from paused transfers",
+					Long:           "Exempt an address from paused transfers, optionally specifying the exempt --direction (defaults to both)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "RemovePauseExemption",
+					Use:            "remove-pause-exemption [address]",
+					Short:          "Remove an exemption from paused transfers",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "SetChannelMode",
+					Use:            "set-channel-mode [mode]",
+					Short:          "Set the channel mode (denylist or allowlist)",
+					Long:           "Set the channel mode, in allowlist mode outbound transfers are only possible over allowed channels",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "mode"}},
+				},
+				{
+					RpcMethod:      "AddAllowedChannel",
+					Use:            "add-allowed-channel [channel]",
+					Short:          "Add a new allowed channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel"}},
+				},
+				{
+					RpcMethod:      "RemoveAllowedChannel",
+					Use:            "remove-allowed-channel [channel]",
+					Short:          "Remove an existing allowed channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel"}},
+				},
+				{
+					RpcMethod: "SetRateLimit",
+					Use:       "set-rate-limit [channel] [window]",
+					Short:     "Set the rate limit of a channel",
+					Long:      "Set the maximum net flow of a channel per window, as an absolute amount (--max-amount) and/or as a percentage of supply (--max-percentage)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel"},
+						{ProtoField: "window"},
+					},
+				},
+				{
+					RpcMethod:      "RemoveRateLimit",
+					Use:            "remove-rate-limit [channel]",
+					Short:          "Remove the rate limit of a channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel"}},
+				},
+				{
+					RpcMethod:      "AddPriceSetter",
+					Use:            "add-price-setter [price-setter]",
+					Short:          "Add a new price setter",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "price_setter"}},
+				},
+				{
+					RpcMethod:      "RemovePriceSetter",
+					Use:            "remove-price-setter [price-setter]",
+					Short:          "Remove an existing price setter",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "price_setter"}},
+				},
+				{
+					RpcMethod: "SetPriceRange",
+					Use:       "set-price-range [end] [daily-rate]",
+					Short:     "Set a new price range",
+					Long:      "Set a new price range, starting at the end of the latest range and by default continuing from its close price (--start-price is required for the first range)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "end"},
+						{ProtoField: "daily_rate"},
+					},
+				},
+				{
+					RpcMethod:      "SetMaxPriceDeviation",
+					Use:            "set-max-price-deviation [max-price-deviation]",
+					Short:          "Set the maximum price deviation",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "max_price_deviation"}},
+				},
+				{
+					RpcMethod:      "Wrap",
+					Use:            "wrap [amount]",
+					Short:          "Wrap USDY into rUSDY",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "Unwrap",
+					Use:            "unwrap [amount]",
+					Short:          "Unwrap rUSDY into USDY",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod: "TransferRebasing",
+					Use:       "transfer-rebasing [recipient] [amount]",
+					Short:     "Transfer rUSDY",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "recipient"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "SetStablecoin",
+					Use:       "set-stablecoin [denom] [exponent]",
+					Short:     "Set the stablecoin used for subscriptions",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "exponent"},
+					},
+				},
+				{
+					RpcMethod:      "RequestSubscription",
+					Use:            "request-subscription [amount]",
+					Short:          "Request a subscription by depositing stablecoin",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod: "FulfillSubscription",
+					Use:       "fulfill-subscription [id] [price]",
+					Short:     "Fulfill a pending subscription at the given price",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+						{ProtoField: "price"},
+					},
+				},
+				{
+					RpcMethod:      "RejectSubscription",
+					Use:            "reject-subscription [id]",
+					Short:          "Reject and refund a pending subscription",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "RequestRedemption",
+					Use:            "request-redemption [amount]",
+					Short:          "Request a redemption by escrowing USDY",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "CancelRedemption",
+					Use:            "cancel-redemption [id]",
+					Short:          "Cancel a pending redemption",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "SettleRedemption",
+					Use:            "settle-redemption [id]",
+					Short:          "Settle the next pending redemption",
+					Long:           "Settle the next pending redemption by burning the escrowed USDY, optionally paying out stablecoin to the user with --payout",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "RejectRedemption",
+					Use:            "reject-redemption [id]",
+					Short:          "Reject and refund a pending redemption",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "Swap",
+					Use:            "swap [amount]",
+					Short:          "Swap stablecoin for USDY, or USDY for stablecoin, at the oracle price",
+					Long:           "Swap stablecoin for USDY, or USDY for stablecoin, at the oracle price plus fees, optionally enforcing slippage protection with --min-amount-out",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod: "SetSwapConfig",
+					Use:       "set-swap-config [fee] [daily_limit]",
+					Short:     "Set the fee and per-account daily limit of the swap facility",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "fee"},
+						{ProtoField: "daily_limit"},
+					},
+				},
+				{
+					RpcMethod:      "FundSwapFacility",
+					Use:            "fund-swap-facility [amount]",
+					Short:          "Deposit stablecoin liquidity into the swap facility",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "WithdrawSwapFacility",
+					Use:            "withdraw-swap-facility [amount]",
+					Short:          "Withdraw stablecoin liquidity from the swap facility",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod: "PauseSwaps",
+					Use:       "pause-swaps",
+					Short:     "Pause the swap facility",
+				},
+				{
+					RpcMethod: "UnpauseSwaps",
+					Use:       "unpause-swaps",
+					Short:     "Unpause the swap facility",
+				},
+				{
+					RpcMethod:      "SetLockupPeriod",
+					Use:            "set-lockup-period [lockup_period]",
+					Short:          "Set the holding period during which newly minted USDY is non-transferable",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "lockup_period"}},
+				},
+				{
+					RpcMethod: "SetBlocklistBinding",
+					Use:       "set-blocklist-binding [denom] [bound]",
+					Short:     "Bind or unbind an additional denom to the shared blocklist",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "bound"},
+					},
+				},
+				{
+					RpcMethod:      "SetDenomMetadata",
+					Use:            "set-denom-metadata [metadata]",
+					Short:          "Set the bank metadata of a governed denom",
+					Long:           "Set the bank metadata of a governed denom, provided as JSON, e.g. '{\"base\":\"ausdy\",\"display\":\"usdy\",...}'",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metadata"}},
+				},
+				{
+					RpcMethod:      "AddAttestor",
+					Use:            "add-attestor [attestor]",
+					Short:          "Add a new reserve attestor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "attestor"}},
+				},
+				{
+					RpcMethod:      "RemoveAttestor",
+					Use:            "remove-attestor [attestor]",
+					Short:          "Remove an existing reserve attestor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "attestor"}},
+				},
+				{
+					RpcMethod: "PostAttestation",
+					Use:       "post-attestation [amount] [as-of] [report-hash]",
+					Short:     "Post an attestation of the reserves backing USDY",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "amount"},
+						{ProtoField: "as_of"},
+						{ProtoField: "report_hash"},
+					},
+				},
+				{
+					RpcMethod: "SetReserveConfig",
+					Use:       "set-reserve-config [collateral-ratio] [max-staleness]",
+					Short:     "Set the collateral ratio and maximum staleness of reserve attestations",
+					Long:      "Set the collateral ratio and maximum staleness of reserve attestations, a zero collateral ratio disables limiting minting by attested reserves",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "collateral_ratio"},
+						{ProtoField: "max_staleness"},
+					},
+				},
+				{
+					RpcMethod:      "AddRecoverer",
+					Use:            "add-recoverer [recoverer]",
+					Short:          "Add a new recoverer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recoverer"}},
+				},
+				{
+					RpcMethod:      "RemoveRecoverer",
+					Use:            "remove-recoverer [recoverer]",
+					Short:          "Remove an existing recoverer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recoverer"}},
+				},
+				{
+					RpcMethod: "ForceTransfer",
+					Use:       "force-transfer [from] [to] [amount] [case-reference]",
+					Short:     "Force transfer funds out of a blocked account",
+					Long:      "Force transfer funds out of a blocked account to a recovery address, optionally of an additional governed denom with --denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "from"},
+						{ProtoField: "to"},
+						{ProtoField: "amount"},
+						{ProtoField: "case_reference"},
+					},
+				},
 			},
 			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
 				"blocklist": {
@@ -287,10 +667,15 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "denom",
 					Short:     "Query the module's denom",
 				},
+				{
+					RpcMethod: "Denoms",
+					Use:       "denoms",
+					Short:     "Query all denoms governed by the module",
+				},
 				{
 					RpcMethod: "Paused",
 					Use:       "paused",
-					Short:     "Query if the module is paused",
+					Short:     "Query if the module is paused, which operations, and any scheduled pauses",
 				},
 				{
 					RpcMethod: "Owner",
@@ -317,6 +702,132 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "blocked-channels",
 					Short:     "Query the blocked channels",
 				},
+				{
+					RpcMethod: "PauseExemptions",
+					Use:       "pause-exemptions",
+					Short:     "Query the addresses exempt from paused transfers",
+				},
+				{
+					RpcMethod: "ChannelMode",
+					Use:       "channel-mode",
+					Short:     "Query the channel mode",
+				},
+				{
+					RpcMethod: "AllowedChannels",
+					Use:       "allowed-channels",
+					Short:     "Query the allowed channels",
+				},
+				{
+					RpcMethod: "RateLimits",
+					Use:       "rate-limits",
+					Short:     "Query the rate limited channels",
+				},
+				{
+					RpcMethod:      "RateLimitUsage",
+					Use:            "rate-limit-usage [channel]",
+					Short:          "Query the current usage and remaining quota of a rate limited channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel"}},
+				},
+				{
+					RpcMethod: "PriceSetters",
+					Use:       "price-setters",
+					Short:     "Query the price setters",
+				},
+				{
+					RpcMethod: "PriceRanges",
+					Use:       "price-ranges",
+					Short:     "Query the price ranges",
+				},
+				{
+					RpcMethod: "Price",
+					Use:       "price",
+					Short:     "Query the current price",
+				},
+				{
+					RpcMethod:      "Shares",
+					Use:            "shares [address]",
+					Short:          "Query the shares and rUSDY balance of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "TotalShares",
+					Use:       "total-shares",
+					Short:     "Query the total shares and rUSDY supply",
+				},
+				{
+					RpcMethod: "Stablecoin",
+					Use:       "stablecoin",
+					Short:     "Query the stablecoin used for subscriptions",
+				},
+				{
+					RpcMethod:      "Subscription",
+					Use:            "subscription [id]",
+					Short:          "Query a subscription request",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "Subscriptions",
+					Use:       "subscriptions",
+					Short:     "Query subscription requests, optionally filtered by --user and --status",
+				},
+				{
+					RpcMethod:      "Redemption",
+					Use:            "redemption [id]",
+					Short:          "Query a redemption request",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "Redemptions",
+					Use:       "redemptions",
+					Short:     "Query redemption requests, optionally filtered by --user and --status",
+				},
+				{
+					RpcMethod: "RedemptionQueue",
+					Use:       "redemption-queue",
+					Short:     "Query pending redemption requests in settlement order",
+				},
+				{
+					RpcMethod: "SwapFacility",
+					Use:       "swap-facility",
+					Short:     "Query the configuration, liquidity, and allowances of the swap facility",
+				},
+				{
+					RpcMethod:      "SwapVolume",
+					Use:            "swap-volume [address]",
+					Short:          "Query the amount of USDY an account has swapped today",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "LockupPeriod",
+					Use:       "lockup-period",
+					Short:     "Query the holding period of newly minted USDY",
+				},
+				{
+					RpcMethod:      "LockedBalance",
+					Use:            "locked-balance [address]",
+					Short:          "Query the locked and unlocked USDY balance of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "Attestors",
+					Use:       "attestors",
+					Short:     "Query the reserve attestors",
+				},
+				{
+					RpcMethod: "Attestations",
+					Use:       "attestations",
+					Short:     "Query the history of reserve attestations",
+				},
+				{
+					RpcMethod: "Reserves",
+					Use:       "reserves",
+					Short:     "Query the latest reserve attestation and remaining minting capacity",
+				},
+				{
+					RpcMethod: "Recoverers",
+					Use:       "recoverers",
+					Short:     "Query the recoverers",
+				},
 			},
 			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
 				"blocklist": {
@@ -339,6 +850,11 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							Short:          "Query if an address is blocked",
 							PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 						},
+						{
+							RpcMethod: "RoleConflicts",
+							Use:       "role-conflicts",
+							Short:     "Query for roles held by blocked addresses",
+						},
 					},
 				},
 			},
@@ -364,6 +880,8 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 	BankKeeper   types.BankKeeper
+
+	ChannelKeeper types.ChannelKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -375,13 +893,32 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
+	if err := types.ValidateDenoms(in.Config.Denom, in.Config.Denoms); err != nil {
+		panic(err)
+	}
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+	authorityAddress, err := in.AddressCodec.BytesToString(authority)
+	if err != nil {
+		panic(err)
+	}
+
 	k := keeper.NewKeeper(
 		in.Config.Denom,
+		in.Config.Denoms,
+		authorityAddress,
 		in.StoreService,
 		in.EventService,
+		in.Cdc,
 		in.AddressCodec,
 		in.BankKeeper,
 	)
+	if in.ChannelKeeper != nil {
+		k.SetChannelKeeper(in.ChannelKeeper)
+	}
 	m := NewAppModule(in.AddressCodec, k)
 
 	return ModuleOutputs{Keeper: k, Module: m, Restriction: k.SendRestrictionFn}
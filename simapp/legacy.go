@@ -15,6 +15,7 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	tendermint "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/ondoprotocol/usdy-noble/v2"
 )
 
 func (app *SimApp) RegisterLegacyModules() error {
@@ -62,9 +63,11 @@ func (app *SimApp) RegisterLegacyModules() error {
 	)
 
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(transfertypes.ModuleName, transfer.NewIBCModule(app.TransferKeeper))
+	ibcRouter.AddRoute(transfertypes.ModuleName, aura.NewIBCMiddleware(transfer.NewIBCModule(app.TransferKeeper)))
 	app.IBCKeeper.SetRouter(ibcRouter)
 
+	app.AuraKeeper.SetChannelKeeper(app.IBCKeeper.ChannelKeeper)
+
 	return app.RegisterModules(
 		capability.NewAppModule(app.appCodec, *app.CapabilityKeeper, true),
 		ibc.NewAppModule(app.IBCKeeper),
@@ -0,0 +1,17 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/collections/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TimeValue is the value codec of the expiry times of allowances.
+var TimeValue = codec.KeyToValueCodec(sdk.TimeKey)
+
+// AllowanceExpired returns true if an allowance with the provided expiry has
+// expired at the provided time. A zero expiry never expires.
+func AllowanceExpired(expiry time.Time, now time.Time) bool {
+	return !expiry.IsZero() && !now.Before(expiry)
+}
@@ -136,6 +136,8 @@ func (m *OwnershipTransferred) GetNewOwner() string {
 type BlockedAddressesAdded struct {
 	// accounts is the list of addresses that were added to the blocklist.
 	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// roles_suspended is whether the roles of the accounts were suspended on all governed denoms.
+	RolesSuspended bool `protobuf:"varint,2,opt,name=roles_suspended,json=rolesSuspended,proto3" json:"roles_suspended,omitempty"`
 }
 
 func (m *BlockedAddressesAdded) Reset()         { *m = BlockedAddressesAdded{} }
@@ -178,6 +180,13 @@ func (m *BlockedAddressesAdded) GetAccounts() []string {
 	return nil
 }
 
+func (m *BlockedAddressesAdded) GetRolesSuspended() bool {
+	if m != nil {
+		return m.RolesSuspended
+	}
+	return false
+}
+
 // BlockedAddressesRemoved is emitted whenever addresses are removed from the blocklist.
 type BlockedAddressesRemoved struct {
 	// accounts is the list of addresses that were removed from the blocklist.
@@ -234,25 +243,26 @@ func init() {
 func init() { proto.RegisterFile("aura/blocklist/v1/events.proto", fileDescriptor_d01e1c8e4c279093) }
 
 var fileDescriptor_d01e1c8e4c279093 = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x86, 0xbb, 0x0a, 0xd2, 0x2e, 0x28, 0x18, 0x14, 0x83, 0xc2, 0x52, 0x02, 0x82, 0x17, 0xb3,
-	0xd4, 0xd2, 0x07, 0x68, 0x5f, 0xa0, 0x50, 0x3d, 0xf5, 0xa0, 0x24, 0xd9, 0xd1, 0x06, 0xd3, 0x9d,
-	0xb0, 0xb3, 0xd9, 0xd0, 0xb7, 0xf0, 0xb1, 0x3c, 0xf6, 0xe8, 0x51, 0x92, 0x17, 0x91, 0x2c, 0x56,
-	0x41, 0xc1, 0x93, 0xc7, 0xdd, 0xff, 0xfb, 0x7e, 0x98, 0x19, 0x2e, 0x92, 0xca, 0x24, 0x32, 0x2d,
-	0x30, 0x7b, 0x2e, 0x72, 0xb2, 0xd2, 0x8d, 0x24, 0x38, 0xd0, 0x96, 0xe2, 0xd2, 0xa0, 0xc5, 0xe0,
-	0xb8, 0xcb, 0xe3, 0xaf, 0x3c, 0x76, 0xa3, 0xe8, 0x9e, 0x87, 0xf3, 0x5a, 0x83, 0xa1, 0x55, 0x5e,
-	0xde, 0x99, 0x44, 0xd3, 0x23, 0x98, 0x5b, 0x9b, 0x18, 0x0b, 0x2a, 0xb8, 0xe4, 0x47, 0xa5, 0x01,
-	0x97, 0x63, 0x45, 0x0f, 0xd8, 0x41, 0x21, 0x1b, 0xb2, 0xab, 0xc1, 0xe2, 0x70, 0xf7, 0xeb, 0xcd,
-	0xe0, 0x82, 0x0f, 0x34, 0xd4, 0x9f, 0xc4, 0x9e, 0x27, 0xfa, 0x1a, 0x6a, 0x1f, 0x46, 0x4b, 0x7e,
-	0xf2, 0xab, 0xdf, 0xfc, 0x53, 0xf7, 0x98, 0x9f, 0xce, 0xba, 0x59, 0x40, 0x4d, 0x95, 0x32, 0x40,
-	0x04, 0x34, 0x55, 0x0a, 0x54, 0x70, 0xce, 0xfb, 0x49, 0x96, 0x61, 0xa5, 0x2d, 0x85, 0x6c, 0xb8,
-	0xdf, 0x49, 0xbb, 0x77, 0x34, 0xe1, 0x67, 0x3f, 0xa5, 0x05, 0xac, 0xd1, 0xfd, 0xad, 0xcd, 0xe6,
-	0xaf, 0x8d, 0x60, 0xdb, 0x46, 0xb0, 0xf7, 0x46, 0xb0, 0x97, 0x56, 0xf4, 0xb6, 0xad, 0xe8, 0xbd,
-	0xb5, 0xa2, 0xb7, 0x9c, 0x3c, 0xe5, 0x76, 0x55, 0xa5, 0x71, 0x86, 0x6b, 0x89, 0x5a, 0xa1, 0x5f,
-	0x75, 0x86, 0x85, 0xac, 0x48, 0x6d, 0xae, 0x35, 0xa6, 0x05, 0x48, 0x77, 0x23, 0xed, 0xa6, 0x04,
-	0xfa, 0xbe, 0x4d, 0x7a, 0xe0, 0xb9, 0xf1, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x73, 0x29, 0xf8,
-	0x47, 0xb4, 0x01, 0x00, 0x00,
+	// 299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0xc1, 0x4a, 0x33, 0x31,
+	0x14, 0x85, 0x9b, 0xff, 0x07, 0x69, 0x03, 0x56, 0x1c, 0x14, 0x8b, 0x42, 0x28, 0x05, 0xd1, 0x8d,
+	0x13, 0xaa, 0xf4, 0x01, 0xda, 0x17, 0x28, 0xb4, 0xae, 0x8a, 0x58, 0x66, 0x26, 0x57, 0x3b, 0x38,
+	0xcd, 0x1d, 0x72, 0x93, 0x94, 0xbe, 0x85, 0x8f, 0xe5, 0xb2, 0x4b, 0x97, 0xd2, 0xbe, 0x88, 0x4c,
+	0x70, 0x14, 0x14, 0x5c, 0xb9, 0xcc, 0x39, 0xdf, 0xfd, 0x20, 0x1c, 0x2e, 0x12, 0x67, 0x12, 0x99,
+	0x16, 0x98, 0x3d, 0x15, 0x39, 0x59, 0xe9, 0xfb, 0x12, 0x3c, 0x68, 0x4b, 0x71, 0x69, 0xd0, 0x62,
+	0x74, 0x58, 0xf5, 0xf1, 0x67, 0x1f, 0xfb, 0x7e, 0xef, 0x9e, 0x77, 0xc6, 0x2b, 0x0d, 0x86, 0x16,
+	0x79, 0x79, 0x6b, 0x12, 0x4d, 0x0f, 0x60, 0xa6, 0x36, 0x31, 0x16, 0x54, 0x74, 0xce, 0xdb, 0xa5,
+	0x01, 0x9f, 0xa3, 0xa3, 0x39, 0x56, 0x50, 0x87, 0x75, 0xd9, 0x65, 0x6b, 0xb2, 0x5f, 0xa7, 0xe1,
+	0x32, 0x3a, 0xe3, 0x2d, 0x0d, 0xab, 0x0f, 0xe2, 0x5f, 0x20, 0x9a, 0x1a, 0x56, 0xa1, 0xec, 0xcd,
+	0xf8, 0xd1, 0x0f, 0xbf, 0xf9, 0x23, 0xf7, 0x1d, 0x3f, 0x1e, 0x55, 0x7f, 0x01, 0x35, 0x54, 0xca,
+	0x00, 0x11, 0xd0, 0x50, 0x29, 0x50, 0xd1, 0x29, 0x6f, 0x26, 0x59, 0x86, 0x4e, 0x5b, 0xea, 0xb0,
+	0xee, 0xff, 0xea, 0xa8, 0x7e, 0x47, 0x17, 0xfc, 0xc0, 0x60, 0x01, 0x34, 0x27, 0x47, 0x25, 0x68,
+	0x05, 0x2a, 0x78, 0x9b, 0x93, 0x76, 0x88, 0xa7, 0x75, 0xda, 0x1b, 0xf0, 0x93, 0xef, 0xf6, 0x09,
+	0x2c, 0xd1, 0xff, 0xee, 0x1f, 0x8d, 0x5f, 0xb6, 0x82, 0x6d, 0xb6, 0x82, 0xbd, 0x6d, 0x05, 0x7b,
+	0xde, 0x89, 0xc6, 0x66, 0x27, 0x1a, 0xaf, 0x3b, 0xd1, 0x98, 0x0d, 0x1e, 0x73, 0xbb, 0x70, 0x69,
+	0x9c, 0xe1, 0x52, 0xa2, 0x56, 0x18, 0x36, 0xc9, 0xb0, 0x90, 0x8e, 0xd4, 0xfa, 0x4a, 0x63, 0x5a,
+	0x80, 0xf4, 0xd7, 0xd2, 0xae, 0x4b, 0xa0, 0xaf, 0x11, 0xd3, 0xbd, 0xc0, 0xdd, 0xbc, 0x07, 0x00,
+	0x00, 0xff, 0xff, 0xf3, 0xa5, 0x1e, 0xc3, 0xdd, 0x01, 0x00, 0x00,
 }
 
 func (m *OwnershipTransferStarted) Marshal() (dAtA []byte, err error) {
@@ -349,6 +359,16 @@ func (m *BlockedAddressesAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RolesSuspended {
+		i--
+		if m.RolesSuspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Accounts[iNdEx])
@@ -450,6 +470,9 @@ func (m *BlockedAddressesAdded) Size() (n int) {
 			n += 1 + l + sovEvents(uint64(l))
 		}
 	}
+	if m.RolesSuspended {
+		n += 2
+	}
 	return n
 }
 
@@ -763,6 +786,26 @@ func (m *BlockedAddressesAdded) Unmarshal(dAtA []byte) error {
 			}
 			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolesSuspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RolesSuspended = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -23,10 +23,23 @@ func (gs *GenesisState) Validate(cdc address.Codec) error {
 		}
 	}
 
+	blocked := make(map[string]bool)
 	for _, address := range gs.BlockedAddresses {
-		if _, err := cdc.StringToBytes(address); err != nil {
+		bz, err := cdc.StringToBytes(address)
+		if err != nil {
 			return fmt.Errorf("invalid blocked address (%s): %s", address, err)
 		}
+		blocked[string(bz)] = true
+	}
+
+	for _, address := range gs.SuspendedAddresses {
+		bz, err := cdc.StringToBytes(address)
+		if err != nil {
+			return fmt.Errorf("invalid suspended address (%s): %s", address, err)
+		}
+		if !blocked[string(bz)] {
+			return fmt.Errorf("suspended address (%s) is not blocked", address)
+		}
 	}
 
 	return nil
@@ -29,6 +29,9 @@ type GenesisState struct {
 	PendingOwner string `protobuf:"bytes,2,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
 	// blocked_addresses is a list of blocked user addresses.
 	BlockedAddresses []string `protobuf:"bytes,3,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses,omitempty"`
+	// suspended_addresses is a list of blocked user addresses whose roles are
+	// suspended on all governed denoms.
+	SuspendedAddresses []string `protobuf:"bytes,4,rep,name=suspended_addresses,json=suspendedAddresses,proto3" json:"suspended_addresses,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -85,6 +88,13 @@ func (m *GenesisState) GetBlockedAddresses() []string {
 	return nil
 }
 
+func (m *GenesisState) GetSuspendedAddresses() []string {
+	if m != nil {
+		return m.SuspendedAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "aura.blocklist.v1.GenesisState")
 }
@@ -92,22 +102,23 @@ func init() {
 func init() { proto.RegisterFile("aura/blocklist/v1/genesis.proto", fileDescriptor_aa89c9bc7ace69b1) }
 
 var fileDescriptor_aa89c9bc7ace69b1 = []byte{
-	// 226 bytes of a gzipped FileDescriptorProto
+	// 247 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x2c, 0x2d, 0x4a,
 	0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xce, 0xce, 0xc9, 0x2c, 0x2e, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f,
 	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x29, 0xd0,
-	0x83, 0x2b, 0xd0, 0x2b, 0x33, 0x54, 0x2a, 0xe3, 0xe2, 0x71, 0x87, 0xa8, 0x09, 0x2e, 0x49, 0x2c,
-	0x49, 0x15, 0x12, 0xe1, 0x62, 0xcd, 0x2f, 0xcf, 0x4b, 0x2d, 0x92, 0x60, 0x54, 0x60, 0xd4, 0xe0,
-	0x0c, 0x82, 0x70, 0x84, 0x94, 0xb9, 0x78, 0x0b, 0x52, 0xf3, 0x52, 0x32, 0xf3, 0xd2, 0xe3, 0x21,
-	0xb2, 0x4c, 0x60, 0x59, 0x1e, 0xa8, 0xa0, 0x3f, 0x58, 0x91, 0x36, 0x97, 0x20, 0xd8, 0xe8, 0xd4,
-	0x94, 0xf8, 0xc4, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xd4, 0x62, 0x09, 0x66, 0x05, 0x66, 0x0d,
-	0xce, 0x20, 0x01, 0xa8, 0x84, 0x23, 0x4c, 0xdc, 0xc9, 0xff, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0xf3, 0xf3, 0x52, 0xf2, 0xc1, 0x4e, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x2d, 0x4e, 0xa9, 0xd4, 0xcd,
-	0xcb, 0x4f, 0xca, 0x49, 0xd5, 0x2f, 0x33, 0xd2, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0x46, 0x78, 0x36,
-	0x89, 0x0d, 0xac, 0xce, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xc5, 0x78, 0x2f, 0x05, 0x01,
-	0x00, 0x00,
+	0x83, 0x2b, 0xd0, 0x2b, 0x33, 0x54, 0x5a, 0xce, 0xc8, 0xc5, 0xe3, 0x0e, 0x51, 0x14, 0x5c, 0x92,
+	0x58, 0x92, 0x2a, 0x24, 0xc2, 0xc5, 0x9a, 0x5f, 0x9e, 0x97, 0x5a, 0x24, 0xc1, 0xa8, 0xc0, 0xa8,
+	0xc1, 0x19, 0x04, 0xe1, 0x08, 0x29, 0x73, 0xf1, 0x16, 0xa4, 0xe6, 0xa5, 0x64, 0xe6, 0xa5, 0xc7,
+	0x43, 0x64, 0x99, 0xc0, 0xb2, 0x3c, 0x50, 0x41, 0x7f, 0xb0, 0x22, 0x6d, 0x2e, 0x41, 0xb0, 0xd9,
+	0xa9, 0x29, 0xf1, 0x89, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xa9, 0xc5, 0x12, 0xcc, 0x0a, 0xcc,
+	0x1a, 0x9c, 0x41, 0x02, 0x50, 0x09, 0x47, 0x98, 0xb8, 0x90, 0x3e, 0x97, 0x70, 0x71, 0x69, 0x31,
+	0x48, 0x3f, 0x8a, 0x72, 0x16, 0xb0, 0x72, 0x21, 0xb8, 0x14, 0x5c, 0x83, 0x93, 0xff, 0x89, 0x47,
+	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85,
+	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9,
+	0x25, 0xe7, 0xe7, 0xea, 0xe7, 0xe7, 0xa5, 0xe4, 0x83, 0x3d, 0x9b, 0x9c, 0x9f, 0xa3, 0x5f, 0x5a,
+	0x9c, 0x52, 0xa9, 0x9b, 0x97, 0x9f, 0x94, 0x93, 0xaa, 0x5f, 0x66, 0xa4, 0x5f, 0x52, 0x59, 0x90,
+	0x5a, 0x8c, 0x08, 0x9e, 0x24, 0x36, 0xb0, 0x3a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x78,
+	0x10, 0x60, 0xb3, 0x37, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -130,6 +141,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SuspendedAddresses) > 0 {
+		for iNdEx := len(m.SuspendedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SuspendedAddresses[iNdEx])
+			copy(dAtA[i:], m.SuspendedAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SuspendedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.BlockedAddresses) > 0 {
 		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.BlockedAddresses[iNdEx])
@@ -187,6 +207,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SuspendedAddresses) > 0 {
+		for _, s := range m.SuspendedAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -321,6 +347,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.BlockedAddresses = append(m.BlockedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuspendedAddresses = append(m.SuspendedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,7 +3,8 @@ package blocklist
 const SubmoduleName = "aura-blocklist"
 
 var (
-	OwnerKey             = []byte("blocklist/owner")
-	PendingOwnerKey      = []byte("blocklist/pending_owner")
-	BlockedAddressPrefix = []byte("blocklist/blocked_address/")
+	OwnerKey               = []byte("blocklist/owner")
+	PendingOwnerKey        = []byte("blocklist/pending_owner")
+	BlockedAddressPrefix   = []byte("blocklist/blocked_address/")
+	SuspendedAddressPrefix = []byte("blocklist/suspended_address/")
 )
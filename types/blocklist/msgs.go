@@ -0,0 +1,60 @@
+package blocklist
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+func (msg *MsgTransferOwnership) Validate(cdc address.Codec) error {
+	if err := validateAddress(cdc, "signer", msg.Signer); err != nil {
+		return err
+	}
+
+	return validateAddress(cdc, "blocklist owner", msg.NewOwner)
+}
+
+func (msg *MsgAcceptOwnership) Validate(cdc address.Codec) error {
+	return validateAddress(cdc, "signer", msg.Signer)
+}
+
+func (msg *MsgAddToBlocklist) Validate(cdc address.Codec) error {
+	if err := validateAddress(cdc, "signer", msg.Signer); err != nil {
+		return err
+	}
+
+	return validateAccounts(cdc, msg.Accounts)
+}
+
+func (msg *MsgRemoveFromBlocklist) Validate(cdc address.Codec) error {
+	if err := validateAddress(cdc, "signer", msg.Signer); err != nil {
+		return err
+	}
+
+	return validateAccounts(cdc, msg.Accounts)
+}
+
+//
+
+func validateAddress(cdc address.Codec, name string, address string) error {
+	if _, err := cdc.StringToBytes(address); err != nil {
+		return fmt.Errorf("invalid %s address (%s): %s", name, address, err)
+	}
+
+	return nil
+}
+
+func validateAccounts(cdc address.Codec, accounts []string) error {
+	if len(accounts) == 0 {
+		return errors.New("accounts must be provided")
+	}
+
+	for _, account := range accounts {
+		if err := validateAddress(cdc, "account", account); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -258,7 +259,8 @@ func (m *QueryAddress) GetAddress() string {
 }
 
 type QueryAddressResponse struct {
-	Blocked bool `protobuf:"varint,1,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	Blocked        bool `protobuf:"varint,1,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	RolesSuspended bool `protobuf:"varint,2,opt,name=roles_suspended,json=rolesSuspended,proto3" json:"roles_suspended,omitempty"`
 }
 
 func (m *QueryAddressResponse) Reset()         { *m = QueryAddressResponse{} }
@@ -301,6 +303,155 @@ func (m *QueryAddressResponse) GetBlocked() bool {
 	return false
 }
 
+func (m *QueryAddressResponse) GetRolesSuspended() bool {
+	if m != nil {
+		return m.RolesSuspended
+	}
+	return false
+}
+
+type QueryRoleConflicts struct {
+}
+
+func (m *QueryRoleConflicts) Reset()         { *m = QueryRoleConflicts{} }
+func (m *QueryRoleConflicts) String() string { return proto.CompactTextString(m) }
+func (*QueryRoleConflicts) ProtoMessage()    {}
+func (*QueryRoleConflicts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_518edfc9f1ab70f2, []int{6}
+}
+func (m *QueryRoleConflicts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoleConflicts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoleConflicts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoleConflicts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoleConflicts.Merge(m, src)
+}
+func (m *QueryRoleConflicts) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoleConflicts) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoleConflicts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoleConflicts proto.InternalMessageInfo
+
+type QueryRoleConflictsResponse struct {
+	Conflicts []RoleConflict `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts"`
+}
+
+func (m *QueryRoleConflictsResponse) Reset()         { *m = QueryRoleConflictsResponse{} }
+func (m *QueryRoleConflictsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoleConflictsResponse) ProtoMessage()    {}
+func (*QueryRoleConflictsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_518edfc9f1ab70f2, []int{7}
+}
+func (m *QueryRoleConflictsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoleConflictsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoleConflictsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoleConflictsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoleConflictsResponse.Merge(m, src)
+}
+func (m *QueryRoleConflictsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoleConflictsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoleConflictsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoleConflictsResponse proto.InternalMessageInfo
+
+func (m *QueryRoleConflictsResponse) GetConflicts() []RoleConflict {
+	if m != nil {
+		return m.Conflicts
+	}
+	return nil
+}
+
+// RoleConflict is a burner, minter, or pauser role held by a blocked address
+// on a denom subject to the blocklist.
+type RoleConflict struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Role    string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (m *RoleConflict) Reset()         { *m = RoleConflict{} }
+func (m *RoleConflict) String() string { return proto.CompactTextString(m) }
+func (*RoleConflict) ProtoMessage()    {}
+func (*RoleConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_518edfc9f1ab70f2, []int{8}
+}
+func (m *RoleConflict) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleConflict.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleConflict.Merge(m, src)
+}
+func (m *RoleConflict) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleConflict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleConflict proto.InternalMessageInfo
+
+func (m *RoleConflict) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RoleConflict) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RoleConflict) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryOwner)(nil), "aura.blocklist.v1.QueryOwner")
 	proto.RegisterType((*QueryOwnerResponse)(nil), "aura.blocklist.v1.QueryOwnerResponse")
@@ -308,43 +459,55 @@ func init() {
 	proto.RegisterType((*QueryAddressesResponse)(nil), "aura.blocklist.v1.QueryAddressesResponse")
 	proto.RegisterType((*QueryAddress)(nil), "aura.blocklist.v1.QueryAddress")
 	proto.RegisterType((*QueryAddressResponse)(nil), "aura.blocklist.v1.QueryAddressResponse")
+	proto.RegisterType((*QueryRoleConflicts)(nil), "aura.blocklist.v1.QueryRoleConflicts")
+	proto.RegisterType((*QueryRoleConflictsResponse)(nil), "aura.blocklist.v1.QueryRoleConflictsResponse")
+	proto.RegisterType((*RoleConflict)(nil), "aura.blocklist.v1.RoleConflict")
 }
 
 func init() { proto.RegisterFile("aura/blocklist/v1/query.proto", fileDescriptor_518edfc9f1ab70f2) }
 
 var fileDescriptor_518edfc9f1ab70f2 = []byte{
-	// 492 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0x6e, 0xba, 0xd4, 0xda, 0x67, 0x15, 0x1c, 0x16, 0x09, 0xb1, 0x1b, 0xd7, 0xe8, 0xee, 0x76,
-	0x17, 0x9c, 0x31, 0x15, 0x7f, 0x80, 0x1e, 0xf4, 0x58, 0xcd, 0x49, 0xbc, 0xc8, 0xa4, 0x19, 0x62,
-	0x30, 0x3b, 0x93, 0xcd, 0x24, 0x95, 0x22, 0x22, 0x88, 0x88, 0x17, 0x41, 0xf0, 0x8f, 0xf8, 0x33,
-	0x3c, 0x2e, 0x78, 0xf1, 0x28, 0xad, 0xe0, 0xdf, 0x90, 0xce, 0x24, 0x99, 0x96, 0x75, 0xed, 0x2d,
-	0xef, 0x7d, 0xdf, 0xfb, 0xbe, 0x6f, 0xe6, 0x4d, 0x60, 0x87, 0x96, 0x39, 0x25, 0x61, 0x2a, 0x26,
-	0xaf, 0xd2, 0x44, 0x16, 0x64, 0xea, 0x93, 0x93, 0x92, 0xe5, 0x33, 0x9c, 0xe5, 0xa2, 0x10, 0xe8,
-	0xea, 0x12, 0xc6, 0x0d, 0x8c, 0xa7, 0xbe, 0x73, 0x34, 0x11, 0xf2, 0x58, 0x48, 0x12, 0x52, 0xc9,
-	0x34, 0x97, 0x4c, 0xfd, 0x90, 0x15, 0xd4, 0x27, 0x19, 0x8d, 0x13, 0x4e, 0x8b, 0x44, 0x70, 0x3d,
-	0xee, 0x5c, 0xaf, 0xb8, 0x35, 0x6d, 0x55, 0xdb, 0x19, 0xc4, 0x42, 0xc4, 0x29, 0x23, 0x34, 0x4b,
-	0x08, 0xe5, 0x5c, 0x14, 0x6a, 0x52, 0x6a, 0xd4, 0xeb, 0x03, 0x3c, 0x5d, 0x92, 0xc7, 0xaf, 0x39,
-	0xcb, 0xbd, 0x31, 0x20, 0x53, 0x05, 0x4c, 0x66, 0x82, 0x4b, 0x86, 0xb6, 0xa1, 0x23, 0x96, 0x0d,
-	0xdb, 0xda, 0xb5, 0x86, 0xbd, 0x40, 0x17, 0xe8, 0x16, 0x5c, 0xce, 0x18, 0x8f, 0x12, 0x1e, 0xbf,
-	0xd0, 0x68, 0x5b, 0xa1, 0xfd, 0xaa, 0xa9, 0x05, 0x9f, 0xc1, 0x15, 0x25, 0xf8, 0x20, 0x8a, 0x72,
-	0x26, 0x25, 0x93, 0xe8, 0x11, 0x80, 0xc9, 0xaf, 0x14, 0x2f, 0x8d, 0xf6, 0xb1, 0x3e, 0x00, 0x5e,
-	0x1e, 0x16, 0xeb, 0xf0, 0xd5, 0x61, 0xf1, 0x13, 0x1a, 0xb3, 0x80, 0x9d, 0x94, 0x4c, 0x16, 0xc1,
-	0xca, 0xa4, 0xf7, 0x0e, 0xae, 0xad, 0x2b, 0x37, 0x71, 0x07, 0xd0, 0xa3, 0x75, 0xd3, 0xb6, 0x76,
-	0xb7, 0x86, 0xbd, 0xc0, 0x34, 0xd0, 0xe3, 0x35, 0xff, 0xb6, 0xf2, 0x3f, 0xd8, 0xe8, 0xaf, 0xa5,
-	0xd7, 0x02, 0x0c, 0xa1, 0xbf, 0x1a, 0x00, 0xd9, 0xd0, 0xad, 0x5c, 0xaa, 0x7b, 0xaa, 0x4b, 0xef,
-	0x2e, 0x6c, 0xaf, 0x32, 0x9b, 0xa0, 0x36, 0x74, 0xd5, 0xca, 0x59, 0xa4, 0x26, 0x2e, 0x06, 0x75,
-	0x39, 0xfa, 0xbc, 0x05, 0x1d, 0x35, 0x82, 0x0a, 0xe8, 0xa8, 0x9b, 0x44, 0x3b, 0xf8, 0xcc, 0x1b,
-	0xc1, 0x66, 0x57, 0xce, 0xde, 0x7f, 0xe1, 0xda, 0xd2, 0xdb, 0xfb, 0xf4, 0xe7, 0xdb, 0x91, 0xf5,
-	0xfe, 0xc7, 0xef, 0xaf, 0x6d, 0x07, 0xd9, 0xe4, 0xec, 0xab, 0xd4, 0xbb, 0xfd, 0x60, 0x41, 0xcf,
-	0xac, 0xec, 0xe6, 0x79, 0xda, 0x0d, 0xc5, 0x39, 0xdc, 0x48, 0x69, 0x22, 0x1c, 0x9a, 0x08, 0x2e,
-	0x1a, 0xfc, 0x23, 0x82, 0xd9, 0xd5, 0x47, 0x0b, 0xba, 0xf5, 0xf5, 0xde, 0xd8, 0xe0, 0xe0, 0x1c,
-	0x6c, 0x20, 0x34, 0x01, 0x7c, 0x13, 0x60, 0x1f, 0xdd, 0x3e, 0x3f, 0x00, 0x79, 0x53, 0x7d, 0xbc,
-	0x7d, 0x38, 0xfe, 0x3e, 0x77, 0xad, 0xd3, 0xb9, 0x6b, 0xfd, 0x9a, 0xbb, 0xd6, 0x97, 0x85, 0xdb,
-	0x3a, 0x5d, 0xb8, 0xad, 0x9f, 0x0b, 0xb7, 0xf5, 0xfc, 0x7e, 0x9c, 0x14, 0x2f, 0xcb, 0x10, 0x4f,
-	0xc4, 0x31, 0x11, 0x3c, 0x12, 0xea, 0xaf, 0x9a, 0x88, 0x94, 0x94, 0x32, 0x9a, 0xdd, 0xe1, 0x22,
-	0x4c, 0x19, 0x99, 0x8e, 0x48, 0x31, 0xcb, 0x98, 0x34, 0x2e, 0xe1, 0x05, 0xc5, 0xbb, 0xf7, 0x37,
-	0x00, 0x00, 0xff, 0xff, 0x71, 0xac, 0x3e, 0xa4, 0x18, 0x04, 0x00, 0x00,
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6a, 0xd4, 0x50,
+	0x14, 0x9e, 0xf4, 0xc7, 0x76, 0x4e, 0xa7, 0x15, 0x2f, 0x83, 0x84, 0xd8, 0xa6, 0x6d, 0x6a, 0x7f,
+	0xa1, 0xb9, 0xcc, 0x88, 0x0f, 0x60, 0x0b, 0xba, 0xac, 0xc6, 0x8d, 0xba, 0x29, 0xf9, 0xb9, 0xc6,
+	0x60, 0x7a, 0x6f, 0x9a, 0x9b, 0x8c, 0x14, 0x11, 0x41, 0x44, 0x5c, 0x0a, 0xe2, 0x23, 0xb8, 0xf7,
+	0x31, 0xba, 0x2c, 0xb8, 0x71, 0x25, 0x32, 0x23, 0xf8, 0x1a, 0x92, 0x7b, 0xf3, 0x37, 0xcc, 0x8c,
+	0xe3, 0xee, 0x9e, 0xef, 0x7c, 0xe7, 0x9c, 0xef, 0x9c, 0xf9, 0x26, 0xb0, 0x66, 0xa7, 0xb1, 0x8d,
+	0x9d, 0x90, 0xb9, 0x2f, 0xc3, 0x80, 0x27, 0xb8, 0xd7, 0xc1, 0xe7, 0x29, 0x89, 0x2f, 0xcc, 0x28,
+	0x66, 0x09, 0x43, 0x37, 0xb2, 0xb4, 0x59, 0xa6, 0xcd, 0x5e, 0x47, 0x3b, 0x70, 0x19, 0x3f, 0x63,
+	0x1c, 0x3b, 0x36, 0x27, 0x92, 0x8b, 0x7b, 0x1d, 0x87, 0x24, 0x76, 0x07, 0x47, 0xb6, 0x1f, 0x50,
+	0x3b, 0x09, 0x18, 0x95, 0xe5, 0xda, 0xad, 0x9c, 0x5b, 0xd0, 0xea, 0xbd, 0xb5, 0xb6, 0xcf, 0x7c,
+	0x26, 0x9e, 0x38, 0x7b, 0xe5, 0xe8, 0xaa, 0xcf, 0x98, 0x1f, 0x12, 0x6c, 0x47, 0x01, 0xb6, 0x29,
+	0x65, 0x89, 0xe8, 0xc7, 0x65, 0xd6, 0x68, 0x01, 0x3c, 0xca, 0x5a, 0x9c, 0xbc, 0xa2, 0x24, 0x36,
+	0x4e, 0x00, 0x55, 0x91, 0x45, 0x78, 0xc4, 0x28, 0x27, 0xa8, 0x0d, 0xf3, 0x2c, 0x03, 0x54, 0x65,
+	0x43, 0xd9, 0x6b, 0x5a, 0x32, 0x40, 0x5b, 0xb0, 0x1c, 0x11, 0xea, 0x05, 0xd4, 0x3f, 0x95, 0xd9,
+	0x19, 0x91, 0x6d, 0xe5, 0xa0, 0x6c, 0xf8, 0x04, 0x56, 0x44, 0xc3, 0x7b, 0x9e, 0x17, 0x13, 0xce,
+	0x09, 0x47, 0xf7, 0x01, 0xaa, 0xad, 0x44, 0xc7, 0xa5, 0xee, 0x8e, 0x29, 0xd7, 0x32, 0xb3, 0x13,
+	0x98, 0x72, 0xa5, 0xfc, 0x04, 0xe6, 0x43, 0xdb, 0x27, 0x16, 0x39, 0x4f, 0x09, 0x4f, 0xac, 0x5a,
+	0xa5, 0xf1, 0x16, 0x6e, 0x0e, 0x77, 0x2e, 0xe5, 0xae, 0x42, 0xd3, 0x2e, 0x40, 0x55, 0xd9, 0x98,
+	0xdd, 0x6b, 0x5a, 0x15, 0x80, 0x1e, 0x0c, 0xcd, 0x9f, 0x11, 0xf3, 0x77, 0xa7, 0xce, 0x97, 0xad,
+	0x87, 0x04, 0xec, 0x41, 0xab, 0x2e, 0x00, 0xa9, 0xb0, 0x90, 0x4f, 0xc9, 0xef, 0x54, 0x84, 0xc6,
+	0x53, 0x68, 0xd7, 0x99, 0xa5, 0x50, 0x15, 0x16, 0x84, 0x11, 0x88, 0x27, 0x2a, 0x16, 0xad, 0x22,
+	0x44, 0xbb, 0x70, 0x3d, 0x66, 0x21, 0xe1, 0xa7, 0x3c, 0xe5, 0xd9, 0x3d, 0x89, 0x27, 0x94, 0x2e,
+	0x5a, 0x2b, 0x02, 0x7e, 0x5c, 0xa0, 0x46, 0x3b, 0xff, 0xc1, 0x2c, 0x16, 0x92, 0x63, 0x46, 0x9f,
+	0x87, 0x81, 0x9b, 0x70, 0xc3, 0x06, 0x6d, 0x14, 0x2d, 0xc7, 0x1e, 0x43, 0xd3, 0x2d, 0x40, 0x71,
+	0x9f, 0xa5, 0xee, 0xba, 0x39, 0x62, 0x4b, 0xb3, 0x5e, 0x7c, 0x34, 0x77, 0xf9, 0x73, 0xbd, 0x61,
+	0x55, 0x75, 0x86, 0x05, 0xad, 0x3a, 0x61, 0xf2, 0xf6, 0x99, 0x7b, 0x3c, 0x42, 0xd9, 0x59, 0xee,
+	0x0f, 0x19, 0x20, 0x04, 0x73, 0xd9, 0x2a, 0xea, 0xac, 0x00, 0xc5, 0xbb, 0xfb, 0x75, 0x0e, 0xe6,
+	0x85, 0x6e, 0x94, 0xc0, 0xbc, 0xf0, 0x0f, 0x5a, 0x1b, 0x23, 0xac, 0x72, 0xa8, 0xb6, 0xfd, 0xcf,
+	0x74, 0xb1, 0xb1, 0xb1, 0xfd, 0xf1, 0xcf, 0xb7, 0x03, 0xe5, 0xdd, 0xf7, 0xdf, 0x9f, 0x67, 0x34,
+	0xa4, 0xe2, 0xd1, 0x7f, 0xa8, 0x74, 0xf4, 0x7b, 0x05, 0x9a, 0x95, 0x51, 0x37, 0x27, 0xf5, 0x2e,
+	0x29, 0xda, 0xfe, 0x54, 0x4a, 0x29, 0x61, 0xbf, 0x92, 0xa0, 0xa3, 0xd5, 0x31, 0x12, 0x2a, 0x87,
+	0x7e, 0x50, 0x60, 0xa1, 0x30, 0xd5, 0xfa, 0x94, 0x09, 0xda, 0xee, 0x14, 0x42, 0x29, 0xa0, 0x53,
+	0x09, 0xd8, 0x41, 0xb7, 0x27, 0x0b, 0xc0, 0xaf, 0xf3, 0xc7, 0x1b, 0xf4, 0x45, 0x81, 0xe5, 0x21,
+	0x0b, 0xa1, 0x89, 0xf7, 0x1e, 0xa2, 0x69, 0x87, 0xff, 0x45, 0x2b, 0xa5, 0x99, 0x95, 0xb4, 0x2d,
+	0xb4, 0x39, 0x46, 0x5a, 0xe6, 0x8e, 0xd3, 0xd2, 0x7b, 0x47, 0x27, 0x97, 0x7d, 0x5d, 0xb9, 0xea,
+	0xeb, 0xca, 0xaf, 0xbe, 0xae, 0x7c, 0x1a, 0xe8, 0x8d, 0xab, 0x81, 0xde, 0xf8, 0x31, 0xd0, 0x1b,
+	0xcf, 0xee, 0xfa, 0x41, 0xf2, 0x22, 0x75, 0x4c, 0x97, 0x9d, 0x61, 0x46, 0x3d, 0xf9, 0x31, 0x74,
+	0x59, 0x88, 0x53, 0xee, 0x5d, 0x1c, 0x52, 0xe6, 0x84, 0x04, 0xf7, 0xba, 0x38, 0xb9, 0x88, 0x08,
+	0xaf, 0x46, 0x38, 0xd7, 0x04, 0xef, 0xce, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x57, 0x64,
+	0xa6, 0xbc, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -362,6 +525,7 @@ type QueryClient interface {
 	Owner(ctx context.Context, in *QueryOwner, opts ...grpc.CallOption) (*QueryOwnerResponse, error)
 	Addresses(ctx context.Context, in *QueryAddresses, opts ...grpc.CallOption) (*QueryAddressesResponse, error)
 	Address(ctx context.Context, in *QueryAddress, opts ...grpc.CallOption) (*QueryAddressResponse, error)
+	RoleConflicts(ctx context.Context, in *QueryRoleConflicts, opts ...grpc.CallOption) (*QueryRoleConflictsResponse, error)
 }
 
 type queryClient struct {
@@ -399,11 +563,21 @@ func (c *queryClient) Address(ctx context.Context, in *QueryAddress, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) RoleConflicts(ctx context.Context, in *QueryRoleConflicts, opts ...grpc.CallOption) (*QueryRoleConflictsResponse, error) {
+	out := new(QueryRoleConflictsResponse)
+	err := c.cc.Invoke(ctx, "/aura.blocklist.v1.Query/RoleConflicts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Owner(context.Context, *QueryOwner) (*QueryOwnerResponse, error)
 	Addresses(context.Context, *QueryAddresses) (*QueryAddressesResponse, error)
 	Address(context.Context, *QueryAddress) (*QueryAddressResponse, error)
+	RoleConflicts(context.Context, *QueryRoleConflicts) (*QueryRoleConflictsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -419,6 +593,9 @@ func (*UnimplementedQueryServer) Addresses(ctx context.Context, req *QueryAddres
 func (*UnimplementedQueryServer) Address(ctx context.Context, req *QueryAddress) (*QueryAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Address not implemented")
 }
+func (*UnimplementedQueryServer) RoleConflicts(ctx context.Context, req *QueryRoleConflicts) (*QueryRoleConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleConflicts not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -478,6 +655,24 @@ func _Query_Address_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RoleConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoleConflicts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoleConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.blocklist.v1.Query/RoleConflicts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoleConflicts(ctx, req.(*QueryRoleConflicts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "aura.blocklist.v1.Query",
@@ -495,6 +690,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Address",
 			Handler:    _Query_Address_Handler,
 		},
+		{
+			MethodName: "RoleConflicts",
+			Handler:    _Query_RoleConflicts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "aura/blocklist/v1/query.proto",
@@ -689,6 +888,16 @@ func (m *QueryAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RolesSuspended {
+		i--
+		if m.RolesSuspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Blocked {
 		i--
 		if m.Blocked {
@@ -702,6 +911,110 @@ func (m *QueryAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRoleConflicts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoleConflicts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoleConflicts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoleConflictsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoleConflictsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoleConflictsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Conflicts) > 0 {
+		for iNdEx := len(m.Conflicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conflicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleConflict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleConflict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleConflict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -793,6 +1106,54 @@ func (m *QueryAddressResponse) Size() (n int) {
 	if m.Blocked {
 		n += 2
 	}
+	if m.RolesSuspended {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRoleConflicts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRoleConflictsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Conflicts) > 0 {
+		for _, e := range m.Conflicts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RoleConflict) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1301,6 +1662,306 @@ func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Blocked = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolesSuspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RolesSuspended = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoleConflicts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoleConflicts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoleConflicts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoleConflictsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoleConflictsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoleConflictsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conflicts = append(m.Conflicts, RoleConflict{})
+			if err := m.Conflicts[len(m.Conflicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleConflict) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleConflict: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleConflict: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -186,6 +186,10 @@ var xxx_messageInfo_MsgAcceptOwnershipResponse proto.InternalMessageInfo
 type MsgAddToBlocklist struct {
 	Signer   string   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	Accounts []string `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// suspend_roles rejects the roles that the accounts hold on all governed
+	// denoms, rather than only on denoms subject to the blocklist, until they
+	// are removed from the blocklist.
+	SuspendRoles bool `protobuf:"varint,3,opt,name=suspend_roles,json=suspendRoles,proto3" json:"suspend_roles,omitempty"`
 }
 
 func (m *MsgAddToBlocklist) Reset()         { *m = MsgAddToBlocklist{} }
@@ -348,40 +352,42 @@ func init() {
 func init() { proto.RegisterFile("aura/blocklist/v1/tx.proto", fileDescriptor_fc6ef81a8ac3a817) }
 
 var fileDescriptor_fc6ef81a8ac3a817 = []byte{
-	// 526 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xb1, 0x6f, 0xd3, 0x40,
-	0x14, 0xc6, 0xe3, 0x46, 0x54, 0xcd, 0x0d, 0xa0, 0x98, 0x08, 0xd2, 0xa3, 0xb8, 0x91, 0x05, 0xa2,
-	0x44, 0xc4, 0x47, 0x8a, 0x82, 0x50, 0xb6, 0x66, 0x60, 0x8b, 0x2a, 0x85, 0x4e, 0x2c, 0x95, 0x63,
-	0x1f, 0x57, 0x8b, 0xf8, 0x9e, 0xe5, 0xe7, 0x24, 0x74, 0x41, 0x88, 0x09, 0x31, 0x31, 0x32, 0x56,
-	0xfc, 0x05, 0x19, 0x58, 0x98, 0x58, 0x19, 0x2b, 0x26, 0x46, 0x94, 0x0c, 0xe1, 0xcf, 0x40, 0x8e,
-	0x1d, 0xa3, 0xda, 0x8e, 0x88, 0x40, 0x5d, 0x2c, 0xbf, 0x7b, 0xdf, 0x7d, 0xdf, 0xef, 0x4e, 0xcf,
-	0x26, 0xd4, 0x1c, 0xfa, 0x26, 0xeb, 0x0f, 0xc0, 0x7a, 0x39, 0x70, 0x30, 0x60, 0xa3, 0x26, 0x0b,
-	0x5e, 0x19, 0x9e, 0x0f, 0x01, 0xa8, 0xe5, 0xb0, 0x67, 0x24, 0x3d, 0x63, 0xd4, 0xa4, 0x65, 0xd3,
-	0x75, 0x24, 0xb0, 0xc5, 0x33, 0x52, 0xd1, 0x9b, 0x16, 0xa0, 0x0b, 0xc8, 0x5c, 0x14, 0xe1, 0x6e,
-	0x17, 0x45, 0xdc, 0xd8, 0x8e, 0x1a, 0xc7, 0x8b, 0x8a, 0x45, 0x45, 0xdc, 0xaa, 0x08, 0x10, 0x10,
-	0xad, 0x87, 0x6f, 0xd1, 0xaa, 0xfe, 0x45, 0x21, 0x95, 0x2e, 0x8a, 0x23, 0xdf, 0x94, 0xf8, 0x82,
-	0xfb, 0x87, 0x63, 0xc9, 0x7d, 0x3c, 0x71, 0x3c, 0xf5, 0x21, 0xd9, 0x44, 0x47, 0x48, 0xee, 0x57,
-	0x95, 0x9a, 0xb2, 0x57, 0xea, 0x54, 0xbf, 0x7f, 0x6e, 0x54, 0x62, 0xc3, 0x03, 0xdb, 0xf6, 0x39,
-	0xe2, 0xb3, 0xc0, 0x77, 0xa4, 0xe8, 0xc5, 0x3a, 0xb5, 0x45, 0x4a, 0x92, 0x8f, 0x8f, 0x21, 0xb4,
-	0xa8, 0x6e, 0xfc, 0x65, 0xd3, 0x96, 0xe4, 0xe3, 0x45, 0x58, 0xfb, 0xc9, 0xbb, 0xb3, 0xdd, 0xc2,
-	0xaf, 0xb3, 0xdd, 0xc2, 0xdb, 0xf9, 0xa4, 0x1e, 0x7b, 0xbd, 0x9f, 0x4f, 0xea, 0xb5, 0xd4, 0x2d,
-	0x65, 0x10, 0x75, 0x8d, 0xec, 0xe4, 0xa1, 0xf7, 0x38, 0x7a, 0x20, 0x91, 0xeb, 0xaf, 0x89, 0xda,
-	0x45, 0x71, 0x60, 0x59, 0xdc, 0x0b, 0xfe, 0xe3, 0x60, 0xed, 0xc7, 0x2b, 0x08, 0xb5, 0x14, 0x61,
-	0x2a, 0x49, 0xdf, 0x21, 0x34, 0x9b, 0x9f, 0xd0, 0x7d, 0x54, 0x48, 0x39, 0x6c, 0xdb, 0xf6, 0x11,
-	0x74, 0x96, 0x1e, 0xff, 0x70, 0xed, 0x94, 0x6c, 0x99, 0x96, 0x05, 0x43, 0x19, 0x60, 0x75, 0xa3,
-	0x56, 0xdc, 0x2b, 0xf5, 0x92, 0xba, 0xdd, 0x5a, 0x41, 0x7e, 0x3b, 0x4d, 0x7e, 0x01, 0x42, 0xbf,
-	0x45, 0xb6, 0x33, 0x64, 0x09, 0xf7, 0x27, 0x85, 0xdc, 0xe8, 0xa2, 0xe8, 0x71, 0x17, 0x46, 0xfc,
-	0xa9, 0x0f, 0xee, 0x65, 0xc1, 0xb7, 0x57, 0xc0, 0xeb, 0x29, 0xf8, 0x1c, 0x12, 0xbd, 0x46, 0xb4,
-	0x7c, 0xc6, 0xe5, 0x31, 0xf6, 0xbf, 0x16, 0x49, 0xb1, 0x8b, 0x42, 0x75, 0x49, 0x39, 0x3b, 0xfc,
-	0xf7, 0x8c, 0xcc, 0x67, 0x68, 0xe4, 0x8d, 0x1a, 0x65, 0x6b, 0x0a, 0x97, 0xb1, 0xaa, 0x20, 0xd7,
-	0xd2, 0x03, 0x79, 0x37, 0xdf, 0x23, 0x25, 0xa3, 0x8d, 0xb5, 0x64, 0x49, 0x90, 0x4d, 0xae, 0xa6,
-	0x46, 0xeb, 0xce, 0x0a, 0x83, 0x0b, 0x2a, 0xfa, 0x60, 0x1d, 0x55, 0x92, 0x82, 0xe4, 0x7a, 0xde,
-	0x20, 0xdc, 0xcf, 0x37, 0xc9, 0x91, 0xd2, 0xe6, 0xda, 0xd2, 0x65, 0x28, 0xbd, 0xf2, 0x66, 0x3e,
-	0xa9, 0x2b, 0x9d, 0xc3, 0x6f, 0x53, 0x4d, 0x39, 0x9f, 0x6a, 0xca, 0xcf, 0xa9, 0xa6, 0x7c, 0x98,
-	0x69, 0x85, 0xf3, 0x99, 0x56, 0xf8, 0x31, 0xd3, 0x0a, 0xcf, 0x5b, 0xc2, 0x09, 0x4e, 0x86, 0x7d,
-	0xc3, 0x02, 0x97, 0x81, 0xb4, 0xa3, 0xbf, 0x9e, 0x05, 0x03, 0x36, 0x44, 0xfb, 0xb4, 0x21, 0xa1,
-	0x3f, 0xe0, 0x6c, 0xb4, 0xcf, 0x82, 0x53, 0x8f, 0xe3, 0x9f, 0x41, 0xea, 0x6f, 0x2e, 0x74, 0x8f,
-	0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x23, 0x12, 0xb8, 0x08, 0xa0, 0x05, 0x00, 0x00,
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x8f, 0xd2, 0x40,
+	0x14, 0xa7, 0x4b, 0xdc, 0xc0, 0xc4, 0x3f, 0xa1, 0x12, 0x65, 0xc7, 0xb5, 0x4b, 0xaa, 0x46, 0x24,
+	0xd2, 0x91, 0x35, 0x18, 0xc3, 0x6d, 0x39, 0x78, 0x23, 0x9b, 0xd4, 0x3d, 0x79, 0x21, 0xd0, 0x8e,
+	0xb3, 0x8d, 0x74, 0xa6, 0x99, 0x57, 0xc0, 0xbd, 0x18, 0xe3, 0xc9, 0x78, 0xf2, 0x23, 0x6c, 0xfc,
+	0x04, 0x1c, 0xbc, 0x78, 0x30, 0x5e, 0x3d, 0x6e, 0x3c, 0x79, 0x34, 0x70, 0xc0, 0x8f, 0x61, 0x4a,
+	0x4b, 0xcd, 0xb6, 0x25, 0x12, 0x8d, 0x17, 0xc2, 0x7b, 0xef, 0x37, 0xbf, 0x3f, 0x93, 0x37, 0x45,
+	0xb8, 0x3f, 0x92, 0x7d, 0x32, 0x18, 0x0a, 0xeb, 0xc5, 0xd0, 0x01, 0x9f, 0x8c, 0x9b, 0xc4, 0x7f,
+	0x69, 0x78, 0x52, 0xf8, 0x42, 0x2d, 0x05, 0x33, 0x23, 0x9e, 0x19, 0xe3, 0x26, 0x2e, 0xf5, 0x5d,
+	0x87, 0x0b, 0xb2, 0xfc, 0x0d, 0x51, 0xf8, 0xba, 0x25, 0xc0, 0x15, 0x40, 0x5c, 0x60, 0xc1, 0x69,
+	0x17, 0x58, 0x34, 0xd8, 0x09, 0x07, 0xbd, 0x65, 0x45, 0xc2, 0x22, 0x1a, 0x95, 0x99, 0x60, 0x22,
+	0xec, 0x07, 0xff, 0xc2, 0xae, 0xfe, 0x49, 0x41, 0xe5, 0x2e, 0xb0, 0x23, 0xd9, 0xe7, 0xf0, 0x9c,
+	0xca, 0xc3, 0x09, 0xa7, 0x12, 0x8e, 0x1d, 0x4f, 0x7d, 0x80, 0xb6, 0xc1, 0x61, 0x9c, 0xca, 0x8a,
+	0x52, 0x55, 0x6a, 0xc5, 0x4e, 0xe5, 0xdb, 0xc7, 0x46, 0x39, 0x22, 0x3c, 0xb0, 0x6d, 0x49, 0x01,
+	0x9e, 0xfa, 0xd2, 0xe1, 0xcc, 0x8c, 0x70, 0x6a, 0x0b, 0x15, 0x39, 0x9d, 0xf4, 0x44, 0x40, 0x51,
+	0xd9, 0xfa, 0xc3, 0xa1, 0x02, 0xa7, 0x93, 0xa5, 0x58, 0xfb, 0xf1, 0xdb, 0xd3, 0xbd, 0xdc, 0xcf,
+	0xd3, 0xbd, 0xdc, 0x9b, 0xc5, 0xb4, 0x1e, 0x71, 0xbd, 0x5b, 0x4c, 0xeb, 0xd5, 0xc4, 0x2d, 0xa5,
+	0x2c, 0xea, 0x1a, 0xda, 0xcd, 0xb2, 0x6e, 0x52, 0xf0, 0x04, 0x07, 0xaa, 0xbf, 0x42, 0x6a, 0x17,
+	0xd8, 0x81, 0x65, 0x51, 0xcf, 0xff, 0x87, 0x60, 0xed, 0x47, 0x6b, 0x1c, 0x6a, 0x09, 0x87, 0x09,
+	0x25, 0x7d, 0x17, 0xe1, 0xb4, 0x7e, 0xec, 0xee, 0xb3, 0x82, 0x4a, 0xc1, 0xd8, 0xb6, 0x8f, 0x44,
+	0x67, 0xc5, 0xf1, 0x17, 0xd7, 0x8e, 0x51, 0xa1, 0x6f, 0x59, 0x62, 0xc4, 0x7d, 0xa8, 0x6c, 0x55,
+	0xf3, 0xb5, 0xa2, 0x19, 0xd7, 0xea, 0x2d, 0x74, 0x09, 0x46, 0xe0, 0x51, 0x6e, 0xf7, 0xa4, 0x18,
+	0x52, 0xa8, 0xe4, 0xab, 0x4a, 0xad, 0x60, 0x5e, 0x8c, 0x9a, 0x66, 0xd0, 0x6b, 0xb7, 0xd6, 0xc4,
+	0xbb, 0x99, 0x8c, 0x77, 0xce, 0xa9, 0x7e, 0x03, 0xed, 0xa4, 0xec, 0xc7, 0xe1, 0x3e, 0x28, 0xe8,
+	0x5a, 0x17, 0x98, 0x49, 0x5d, 0x31, 0xa6, 0x4f, 0xa4, 0x70, 0xff, 0x53, 0xc2, 0x76, 0x7b, 0x8d,
+	0x79, 0x3d, 0x61, 0x3e, 0xc3, 0x89, 0x5e, 0x45, 0x5a, 0xb6, 0xc7, 0x55, 0x8c, 0xfd, 0x2f, 0x79,
+	0x94, 0xef, 0x02, 0x53, 0x5d, 0x54, 0x4a, 0xbf, 0x90, 0xbb, 0x46, 0xea, 0xad, 0x1a, 0x59, 0xfb,
+	0x88, 0xc9, 0x86, 0xc0, 0x95, 0xac, 0xca, 0xd0, 0x95, 0xe4, 0xd6, 0xde, 0xc9, 0xe6, 0x48, 0xc0,
+	0x70, 0x63, 0x23, 0x58, 0x2c, 0x64, 0xa3, 0xcb, 0x89, 0xfd, 0xbb, 0xbd, 0x86, 0xe0, 0x1c, 0x0a,
+	0xdf, 0xdf, 0x04, 0x15, 0xab, 0x00, 0xba, 0x9a, 0xb5, 0x08, 0xf7, 0xb2, 0x49, 0x32, 0xa0, 0xb8,
+	0xb9, 0x31, 0x74, 0x25, 0x8a, 0x2f, 0xbc, 0x5e, 0x4c, 0xeb, 0x4a, 0xe7, 0xf0, 0xeb, 0x4c, 0x53,
+	0xce, 0x66, 0x9a, 0xf2, 0x63, 0xa6, 0x29, 0xef, 0xe7, 0x5a, 0xee, 0x6c, 0xae, 0xe5, 0xbe, 0xcf,
+	0xb5, 0xdc, 0xb3, 0x16, 0x73, 0xfc, 0xe3, 0xd1, 0xc0, 0xb0, 0x84, 0x4b, 0x04, 0xb7, 0xc3, 0x4f,
+	0xa3, 0x25, 0x86, 0x64, 0x04, 0xf6, 0x49, 0x83, 0x8b, 0xc1, 0x90, 0x92, 0xf1, 0x3e, 0xf1, 0x4f,
+	0x3c, 0x0a, 0xbf, 0x17, 0x69, 0xb0, 0xbd, 0xc4, 0x3d, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0x0f,
+	0xb7, 0x75, 0xae, 0xc5, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -706,6 +712,16 @@ func (m *MsgAddToBlocklist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SuspendRoles {
+		i--
+		if m.SuspendRoles {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Accounts[iNdEx])
@@ -885,6 +901,9 @@ func (m *MsgAddToBlocklist) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.SuspendRoles {
+		n += 2
+	}
 	return n
 }
 
@@ -1320,6 +1339,26 @@ func (m *MsgAddToBlocklist) Unmarshal(dAtA []byte) error {
 			}
 			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendRoles", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuspendRoles = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// ChannelDirectionValue is the collections value codec used for blocked
+// channels. Channels blocked before directions were introduced were stored
+// with an empty value, these are decoded as outbound.
+var ChannelDirectionValue = codec.NewAltValueCodec(
+	codec.KeyToValueCodec(codec.NewInt32Key[ChannelDirection]()),
+	func(bz []byte) (ChannelDirection, error) {
+		if len(bz) == 0 {
+			return ChannelDirectionOutbound, nil
+		}
+
+		return ChannelDirectionUnspecified, errors.New("invalid channel direction")
+	},
+)
+
+// ChannelModeValue is the collections value codec used for the channel mode.
+var ChannelModeValue = codec.KeyToValueCodec(codec.NewInt32Key[ChannelMode]())
+
+// IsValid returns true if the direction is a known value.
+func (d ChannelDirection) IsValid() bool {
+	_, ok := ChannelDirection_name[int32(d)]
+	return ok
+}
+
+// Normalize maps an unspecified direction to outbound.
+func (d ChannelDirection) Normalize() ChannelDirection {
+	if d == ChannelDirectionUnspecified {
+		return ChannelDirectionOutbound
+	}
+
+	return d
+}
+
+// BlocksOutbound returns true if transfers from Noble are blocked.
+func (d ChannelDirection) BlocksOutbound() bool {
+	d = d.Normalize()
+	return d == ChannelDirectionOutbound || d == ChannelDirectionBoth
+}
+
+// BlocksInbound returns true if transfers to Noble are blocked.
+func (d ChannelDirection) BlocksInbound() bool {
+	return d == ChannelDirectionInbound || d == ChannelDirectionBoth
+}
+
+//
+
+// IsValid returns true if the mode is a known value.
+func (m ChannelMode) IsValid() bool {
+	_, ok := ChannelMode_name[int32(m)]
+	return ok
+}
+
+// Normalize maps an unspecified mode to denylist.
+func (m ChannelMode) Normalize() ChannelMode {
+	if m == ChannelModeUnspecified {
+		return ChannelModeDenylist
+	}
+
+	return m
+}
@@ -16,23 +16,79 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 	cdc.RegisterConcrete(&MsgPause{}, "aura/Pause", nil)
 	cdc.RegisterConcrete(&MsgUnpause{}, "aura/Unpause", nil)
+	cdc.RegisterConcrete(&MsgSchedulePause{}, "aura/SchedulePause", nil)
 
 	cdc.RegisterConcrete(&MsgTransferOwnership{}, "aura/TransferOwnership", nil)
 	cdc.RegisterConcrete(&MsgAcceptOwnership{}, "aura/AcceptOwnership", nil)
+	cdc.RegisterConcrete(&MsgForceOwnership{}, "aura/ForceOwnership", nil)
 
 	cdc.RegisterConcrete(&MsgAddBurner{}, "aura/AddBurner", nil)
 	cdc.RegisterConcrete(&MsgRemoveBurner{}, "aura/RemoveBurner", nil)
 	cdc.RegisterConcrete(&MsgSetBurnerAllowance{}, "aura/SetBurnerAllowance", nil)
+	cdc.RegisterConcrete(&MsgIncreaseBurnerAllowance{}, "aura/IncreaseBurnerAllowance", nil)
+	cdc.RegisterConcrete(&MsgDecreaseBurnerAllowance{}, "aura/DecreaseBurnerAllowance", nil)
+	cdc.RegisterConcrete(&MsgAddBurnerSource{}, "aura/AddBurnerSource", nil)
+	cdc.RegisterConcrete(&MsgRemoveBurnerSource{}, "aura/RemoveBurnerSource", nil)
 
 	cdc.RegisterConcrete(&MsgAddMinter{}, "aura/AddMinter", nil)
 	cdc.RegisterConcrete(&MsgRemoveMinter{}, "aura/RemoveMinter", nil)
 	cdc.RegisterConcrete(&MsgSetMinterAllowance{}, "aura/SetMinterAllowance", nil)
+	cdc.RegisterConcrete(&MsgIncreaseMinterAllowance{}, "aura/IncreaseMinterAllowance", nil)
+	cdc.RegisterConcrete(&MsgDecreaseMinterAllowance{}, "aura/DecreaseMinterAllowance", nil)
+	cdc.RegisterConcrete(&MsgAddMinterRecipient{}, "aura/AddMinterRecipient", nil)
+	cdc.RegisterConcrete(&MsgRemoveMinterRecipient{}, "aura/RemoveMinterRecipient", nil)
 
 	cdc.RegisterConcrete(&MsgAddPauser{}, "aura/AddPauser", nil)
 	cdc.RegisterConcrete(&MsgRemovePauser{}, "aura/RemovePauser", nil)
 
 	cdc.RegisterConcrete(&MsgAddBlockedChannel{}, "aura/AddBlockedChannel", nil)
 	cdc.RegisterConcrete(&MsgRemoveBlockedChannel{}, "aura/RemoveBlockedChannel", nil)
+	cdc.RegisterConcrete(&MsgAddPauseExemption{}, "aura/AddPauseExemption", nil)
+	cdc.RegisterConcrete(&MsgRemovePauseExemption{}, "aura/RemovePauseExemption", nil)
+	cdc.RegisterConcrete(&MsgSetChannelMode{}, "aura/SetChannelMode", nil)
+	cdc.RegisterConcrete(&MsgAddAllowedChannel{}, "aura/AddAllowedChannel", nil)
+	cdc.RegisterConcrete(&MsgRemoveAllowedChannel{}, "aura/RemoveAllowedChannel", nil)
+	cdc.RegisterConcrete(&MsgSetRateLimit{}, "aura/SetRateLimit", nil)
+	cdc.RegisterConcrete(&MsgRemoveRateLimit{}, "aura/RemoveRateLimit", nil)
+	cdc.RegisterConcrete(&MsgAddPriceSetter{}, "aura/AddPriceSetter", nil)
+	cdc.RegisterConcrete(&MsgRemovePriceSetter{}, "aura/RemovePriceSetter", nil)
+	cdc.RegisterConcrete(&MsgSetPriceRange{}, "aura/SetPriceRange", nil)
+	cdc.RegisterConcrete(&MsgSetMaxPriceDeviation{}, "aura/SetMaxPriceDeviation", nil)
+
+	cdc.RegisterConcrete(&MsgWrap{}, "aura/Wrap", nil)
+	cdc.RegisterConcrete(&MsgUnwrap{}, "aura/Unwrap", nil)
+	cdc.RegisterConcrete(&MsgTransferRebasing{}, "aura/TransferRebasing", nil)
+
+	cdc.RegisterConcrete(&MsgSetStablecoin{}, "aura/SetStablecoin", nil)
+	cdc.RegisterConcrete(&MsgRequestSubscription{}, "aura/RequestSubscription", nil)
+	cdc.RegisterConcrete(&MsgFulfillSubscription{}, "aura/FulfillSubscription", nil)
+	cdc.RegisterConcrete(&MsgRejectSubscription{}, "aura/RejectSubscription", nil)
+
+	cdc.RegisterConcrete(&MsgRequestRedemption{}, "aura/RequestRedemption", nil)
+	cdc.RegisterConcrete(&MsgCancelRedemption{}, "aura/CancelRedemption", nil)
+	cdc.RegisterConcrete(&MsgSettleRedemption{}, "aura/SettleRedemption", nil)
+	cdc.RegisterConcrete(&MsgRejectRedemption{}, "aura/RejectRedemption", nil)
+
+	cdc.RegisterConcrete(&MsgSwap{}, "aura/Swap", nil)
+	cdc.RegisterConcrete(&MsgSetSwapConfig{}, "aura/SetSwapConfig", nil)
+	cdc.RegisterConcrete(&MsgFundSwapFacility{}, "aura/FundSwapFacility", nil)
+	cdc.RegisterConcrete(&MsgWithdrawSwapFacility{}, "aura/WithdrawSwapFacility", nil)
+	cdc.RegisterConcrete(&MsgPauseSwaps{}, "aura/PauseSwaps", nil)
+	cdc.RegisterConcrete(&MsgUnpauseSwaps{}, "aura/UnpauseSwaps", nil)
+
+	cdc.RegisterConcrete(&MsgSetLockupPeriod{}, "aura/SetLockupPeriod", nil)
+
+	cdc.RegisterConcrete(&MsgSetBlocklistBinding{}, "aura/SetBlocklistBinding", nil)
+	cdc.RegisterConcrete(&MsgSetDenomMetadata{}, "aura/SetDenomMetadata", nil)
+
+	cdc.RegisterConcrete(&MsgAddAttestor{}, "aura/AddAttestor", nil)
+	cdc.RegisterConcrete(&MsgRemoveAttestor{}, "aura/RemoveAttestor", nil)
+	cdc.RegisterConcrete(&MsgPostAttestation{}, "aura/PostAttestation", nil)
+	cdc.RegisterConcrete(&MsgSetReserveConfig{}, "aura/SetReserveConfig", nil)
+
+	cdc.RegisterConcrete(&MsgAddRecoverer{}, "aura/AddRecoverer", nil)
+	cdc.RegisterConcrete(&MsgRemoveRecoverer{}, "aura/RemoveRecoverer", nil)
+	cdc.RegisterConcrete(&MsgForceTransfer{}, "aura/ForceTransfer", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -43,23 +99,79 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgPause{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUnpause{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSchedulePause{})
 
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTransferOwnership{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAcceptOwnership{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgForceOwnership{})
 
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAddBurner{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemoveBurner{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetBurnerAllowance{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgIncreaseBurnerAllowance{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgDecreaseBurnerAllowance{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAddBurnerSource{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemoveBurnerSource{})
 
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAddMinter{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemoveMinter{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetMinterAllowance{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgIncreaseMinterAllowance{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgDecreaseMinterAllowance{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAddMinterRecipient{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemoveMinterRecipient{})
 
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAddPauser{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemovePauser{})
 
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAddBlockedChannel{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemoveBlockedChannel{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAddPauseExemption{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemovePauseExemption{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetChannelMode{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAddAllowedChannel{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemoveAllowedChannel{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetRateLimit{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemoveRateLimit{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAddPriceSetter{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemovePriceSetter{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetPriceRange{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetMaxPriceDeviation{})
+
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgWrap{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUnwrap{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTransferRebasing{})
+
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetStablecoin{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRequestSubscription{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgFulfillSubscription{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRejectSubscription{})
+
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRequestRedemption{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCancelRedemption{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSettleRedemption{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRejectRedemption{})
+
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSwap{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetSwapConfig{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgFundSwapFacility{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgWithdrawSwapFacility{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgPauseSwaps{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUnpauseSwaps{})
+
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetLockupPeriod{})
+
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetBlocklistBinding{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetDenomMetadata{})
+
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAddAttestor{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemoveAttestor{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgPostAttestation{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetReserveConfig{})
+
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAddRecoverer{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemoveRecoverer{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgForceTransfer{})
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateDenoms validates the default denom and the additional denoms that
+// are governed by this module, none of which may be the share denom.
+func ValidateDenoms(denom string, denoms []string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return fmt.Errorf("invalid denom (%s): %s", denom, err)
+	}
+
+	seen := map[string]bool{denom: true, SharesDenom(denom): true}
+	for _, governed := range denoms {
+		if err := sdk.ValidateDenom(governed); err != nil {
+			return fmt.Errorf("invalid denom (%s): %s", governed, err)
+		}
+
+		if seen[governed] {
+			return fmt.Errorf("duplicate denom (%s)", governed)
+		}
+		seen[governed] = true
+	}
+
+	return nil
+}
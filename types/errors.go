@@ -12,4 +12,14 @@ var (
 	ErrInvalidMinter         = errors.Register(ModuleName, 7, "signer is not a minter")
 	ErrInvalidPauser         = errors.Register(ModuleName, 8, "signer is not a pauser")
 	ErrInsufficientAllowance = errors.Register(ModuleName, 9, "insufficient allowance")
+	ErrInvalidPriceSetter    = errors.Register(ModuleName, 10, "signer is not a price setter")
+	ErrInsufficientShares    = errors.Register(ModuleName, 11, "insufficient shares")
+	ErrInvalidAttestor       = errors.Register(ModuleName, 12, "signer is not an attestor")
+	ErrInsufficientReserves  = errors.Register(ModuleName, 13, "insufficient attested reserves")
+	ErrInvalidRecoverer      = errors.Register(ModuleName, 14, "signer is not owner or a recoverer")
+	ErrInvalidAuthority      = errors.Register(ModuleName, 15, "signer is not authority")
+	ErrBlockedAddress        = errors.Register(ModuleName, 16, "address is blocked")
+	ErrInvalidRecipient      = errors.Register(ModuleName, 17, "recipient is not permitted for minter")
+	ErrInvalidSource         = errors.Register(ModuleName, 18, "source is not permitted for burner")
+	ErrNoChannelKeeper       = errors.Register(ModuleName, 19, "no channel keeper is set to index transfer channels")
 )
@@ -2,8 +2,11 @@ package types
 
 import (
 	"fmt"
+	"slices"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/ondoprotocol/usdy-noble/v2/types/blocklist"
 )
@@ -11,7 +14,6 @@ import (
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		BlocklistState: blocklist.DefaultGenesisState(),
-		Paused:         false,
 	}
 }
 
@@ -20,19 +22,303 @@ func (gs *GenesisState) Validate(cdc address.Codec) error {
 		return err
 	}
 
-	if gs.Owner != "" {
-		if _, err := cdc.StringToBytes(gs.Owner); err != nil {
-			return fmt.Errorf("invalid owner address (%s): %s", gs.Owner, err)
+	state := gs.DefaultDenomState("")
+	if err := validateDenomState(cdc, state.PausedScopes, state.Owner, state.PendingOwner, state.Burners, state.Minters, state.Pausers, state.BlockedChannels, state.PauseExemptions, state.Recoverers); err != nil {
+		return err
+	}
+
+	denoms := make(map[string]bool)
+	for _, state := range gs.Denoms {
+		if err := sdk.ValidateDenom(state.Denom); err != nil {
+			return fmt.Errorf("invalid denom (%s): %s", state.Denom, err)
+		}
+
+		if denoms[state.Denom] {
+			return fmt.Errorf("duplicate denom state (%s)", state.Denom)
+		}
+		denoms[state.Denom] = true
+
+		if err := validateDenomState(cdc, state.PausedScopes, state.Owner, state.PendingOwner, state.Burners, state.Minters, state.Pausers, state.BlockedChannels, state.PauseExemptions, state.Recoverers); err != nil {
+			return fmt.Errorf("invalid denom state (%s): %s", state.Denom, err)
+		}
+	}
+
+	metadata := make(map[string]bool)
+	for _, denomMetadata := range gs.DenomMetadata {
+		if err := denomMetadata.Validate(); err != nil {
+			return fmt.Errorf("invalid denom metadata (%s): %s", denomMetadata.Base, err)
+		}
+
+		if metadata[denomMetadata.Base] {
+			return fmt.Errorf("duplicate denom metadata (%s)", denomMetadata.Base)
+		}
+		metadata[denomMetadata.Base] = true
+	}
+
+	if !gs.ChannelMode.IsValid() {
+		return fmt.Errorf("invalid channel mode (%d)", gs.ChannelMode)
+	}
+
+	for _, channel := range gs.AllowedChannels {
+		if !channeltypes.IsValidChannelID(channel) {
+			return fmt.Errorf("invalid allowed channel (%s)", channel)
+		}
+	}
+
+	for _, rateLimit := range gs.RateLimits {
+		if !channeltypes.IsValidChannelID(rateLimit.Channel) {
+			return fmt.Errorf("invalid rate limited channel (%s)", rateLimit.Channel)
+		}
+
+		if err := rateLimit.Validate(); err != nil {
+			return fmt.Errorf("invalid rate limit (%s): %s", rateLimit.Channel, err)
+		}
+	}
+
+	for _, flow := range gs.RateLimitFlows {
+		if !channeltypes.IsValidChannelID(flow.Channel) {
+			return fmt.Errorf("invalid rate limited channel (%s)", flow.Channel)
+		}
+
+		if flow.Inflow.IsNil() || flow.Inflow.IsNegative() || flow.Outflow.IsNil() || flow.Outflow.IsNegative() || flow.Supply.IsNil() || flow.Supply.IsNegative() {
+			return fmt.Errorf("invalid rate limit flow (%s)", flow.Channel)
+		}
+	}
+
+	for _, priceSetter := range gs.PriceSetters {
+		if _, err := cdc.StringToBytes(priceSetter); err != nil {
+			return fmt.Errorf("invalid price setter address (%s): %s", priceSetter, err)
+		}
+	}
+
+	for i, priceRange := range gs.PriceRanges {
+		if priceRange.Index != uint64(i) {
+			return fmt.Errorf("invalid price range index (%d), expected %d", priceRange.Index, i)
+		}
+
+		if !priceRange.End.After(priceRange.Start) {
+			return fmt.Errorf("invalid price range (%d): end must be after start", priceRange.Index)
+		}
+
+		if priceRange.DailyRate.IsNil() || !priceRange.DailyRate.IsPositive() || priceRange.StartPrice.IsNil() || !priceRange.StartPrice.IsPositive() {
+			return fmt.Errorf("invalid price range (%d): daily rate and start price must be positive", priceRange.Index)
+		}
+
+		if i > 0 && !priceRange.Start.Equal(gs.PriceRanges[i-1].End) {
+			return fmt.Errorf("invalid price range (%d): start must equal end of previous range", priceRange.Index)
+		}
+	}
+
+	if !gs.MaxPriceDeviation.IsNil() && (gs.MaxPriceDeviation.IsNegative() || gs.MaxPriceDeviation.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("invalid max price deviation (%s)", gs.MaxPriceDeviation)
+	}
+
+	if gs.Stablecoin.IsSet() {
+		if err := gs.Stablecoin.Validate(); err != nil {
+			return fmt.Errorf("invalid stablecoin (%s): %s", gs.Stablecoin.Denom, err)
+		}
+	}
+
+	for _, subscription := range gs.Subscriptions {
+		if subscription.Id >= gs.NextSubscriptionId {
+			return fmt.Errorf("invalid subscription id (%d), next id is %d", subscription.Id, gs.NextSubscriptionId)
+		}
+
+		if _, err := cdc.StringToBytes(subscription.User); err != nil {
+			return fmt.Errorf("invalid subscription user address (%s): %s", subscription.User, err)
+		}
+
+		if err := subscription.Deposit.Validate(); err != nil || !subscription.Deposit.IsPositive() {
+			return fmt.Errorf("invalid subscription deposit (%d)", subscription.Id)
+		}
+
+		if subscription.Status == RequestStatusUnspecified || !subscription.Status.IsValid() {
+			return fmt.Errorf("invalid subscription status (%d)", subscription.Id)
+		}
+	}
+
+	for _, redemption := range gs.Redemptions {
+		if redemption.Id >= gs.NextRedemptionId {
+			return fmt.Errorf("invalid redemption id (%d), next id is %d", redemption.Id, gs.NextRedemptionId)
+		}
+
+		if _, err := cdc.StringToBytes(redemption.User); err != nil {
+			return fmt.Errorf("invalid redemption user address (%s): %s", redemption.User, err)
+		}
+
+		if redemption.Amount.IsNil() || !redemption.Amount.IsPositive() {
+			return fmt.Errorf("invalid redemption amount (%d)", redemption.Id)
+		}
+
+		if redemption.Status == RequestStatusUnspecified || !redemption.Status.IsValid() {
+			return fmt.Errorf("invalid redemption status (%d)", redemption.Id)
 		}
 	}
 
-	if gs.PendingOwner != "" {
-		if _, err := cdc.StringToBytes(gs.PendingOwner); err != nil {
-			return fmt.Errorf("invalid pending owner address (%s): %s", gs.PendingOwner, err)
+	if !gs.SwapConfig.Fee.IsNil() {
+		if err := gs.SwapConfig.Validate(); err != nil {
+			return fmt.Errorf("invalid swap config: %s", err)
 		}
 	}
 
-	for _, burner := range gs.Burners {
+	if gs.SwapLiquidity.Denom != "" {
+		if err := gs.SwapLiquidity.Validate(); err != nil {
+			return fmt.Errorf("invalid swap liquidity: %s", err)
+		}
+
+		if gs.SwapLiquidity.Denom != gs.Stablecoin.Denom {
+			return fmt.Errorf("invalid swap liquidity denom (%s), expected %s", gs.SwapLiquidity.Denom, gs.Stablecoin.Denom)
+		}
+	}
+
+	for _, volume := range gs.SwapVolumes {
+		if _, err := cdc.StringToBytes(volume.Address); err != nil {
+			return fmt.Errorf("invalid swap volume address (%s): %s", volume.Address, err)
+		}
+
+		if volume.Volume.IsNil() || !volume.Volume.IsPositive() {
+			return fmt.Errorf("invalid swap volume (%s)", volume.Address)
+		}
+	}
+
+	if gs.LockupPeriod < 0 {
+		return fmt.Errorf("invalid lockup period (%s)", gs.LockupPeriod)
+	}
+
+	lots := make(map[string]bool)
+	for _, lot := range gs.LockedLots {
+		if _, err := cdc.StringToBytes(lot.Address); err != nil {
+			return fmt.Errorf("invalid locked lot address (%s): %s", lot.Address, err)
+		}
+
+		if lot.Amount.IsNil() || !lot.Amount.IsPositive() {
+			return fmt.Errorf("invalid locked lot amount (%s)", lot.Address)
+		}
+
+		key := fmt.Sprintf("%s/%d", lot.Address, lot.UnlockTime.UnixNano())
+		if lots[key] {
+			return fmt.Errorf("duplicate locked lot (%s, %s)", lot.Address, lot.UnlockTime)
+		}
+		lots[key] = true
+	}
+
+	for _, attestor := range gs.Attestors {
+		if _, err := cdc.StringToBytes(attestor); err != nil {
+			return fmt.Errorf("invalid attestor address (%s): %s", attestor, err)
+		}
+	}
+
+	for i, attestation := range gs.Attestations {
+		if attestation.Index != uint64(i) {
+			return fmt.Errorf("invalid attestation index (%d), expected %d", attestation.Index, i)
+		}
+
+		if _, err := cdc.StringToBytes(attestation.Attestor); err != nil {
+			return fmt.Errorf("invalid attestation attestor address (%s): %s", attestation.Attestor, err)
+		}
+
+		if attestation.Amount.IsNil() || attestation.Amount.IsNegative() {
+			return fmt.Errorf("invalid attestation amount (%d)", attestation.Index)
+		}
+
+		if attestation.ReportHash == "" {
+			return fmt.Errorf("invalid attestation (%d): report hash must be provided", attestation.Index)
+		}
+
+		if i > 0 && !attestation.AsOf.After(gs.Attestations[i-1].AsOf) {
+			return fmt.Errorf("invalid attestation (%d): as of time must be after previous attestation", attestation.Index)
+		}
+	}
+
+	if !gs.ReserveConfig.CollateralRatio.IsNil() {
+		if err := gs.ReserveConfig.Validate(); err != nil {
+			return fmt.Errorf("invalid reserve config: %s", err)
+		}
+	}
+
+	windows := make(map[string]bool)
+	for _, window := range gs.PauseWindows {
+		if err := sdk.ValidateDenom(window.Denom); err != nil {
+			return fmt.Errorf("invalid pause window denom (%s): %s", window.Denom, err)
+		}
+
+		if err := window.Validate(); err != nil {
+			return fmt.Errorf("invalid pause window (%s, %s): %s", window.Denom, window.Scope, err)
+		}
+
+		key := fmt.Sprintf("%s/%s", window.Denom, window.Scope)
+		if windows[key] {
+			return fmt.Errorf("duplicate pause window (%s, %s)", window.Denom, window.Scope)
+		}
+		windows[key] = true
+	}
+
+	return nil
+}
+
+// DefaultDenomState returns the state of the default denom stored at the top
+// level, converting the deprecated paused flag into all scopes but burning,
+// and the deprecated blocked channels into outbound blocked channels.
+func (gs *GenesisState) DefaultDenomState(denom string) DenomState {
+	pausedScopes := gs.PausedScopes
+	if gs.Paused {
+		for _, scope := range DefaultPauseScopes {
+			if !slices.Contains(pausedScopes, scope) {
+				pausedScopes = append(pausedScopes, scope)
+			}
+		}
+	}
+
+	blockedChannels := gs.DirectionalBlockedChannels
+	for _, channel := range gs.BlockedChannels {
+		blockedChannels = append(blockedChannels, BlockedChannel{
+			Channel:   channel,
+			Direction: ChannelDirectionOutbound,
+		})
+	}
+
+	return DenomState{
+		Denom:           denom,
+		PausedScopes:    pausedScopes,
+		Owner:           gs.Owner,
+		PendingOwner:    gs.PendingOwner,
+		Burners:         gs.Burners,
+		Minters:         gs.Minters,
+		Pausers:         gs.Pausers,
+		BlockedChannels: blockedChannels,
+		PauseExemptions: gs.PauseExemptions,
+		Recoverers:      gs.Recoverers,
+	}
+}
+
+// validateDenomState validates the paused scopes, owner, roles, blocked
+// channels, pause exemptions, and recoverers of a governed denom.
+func validateDenomState(cdc address.Codec, pausedScopes []PauseScope, owner, pendingOwner string, burners []Burner, minters []Minter, pausers []string, blockedChannels []BlockedChannel, pauseExemptions []PauseExemption, recoverers []string) error {
+	scopes := make(map[PauseScope]bool)
+	for _, scope := range pausedScopes {
+		if scope == PauseScopeUnspecified || !scope.IsValid() {
+			return fmt.Errorf("invalid paused scope (%d)", scope)
+		}
+
+		if scopes[scope] {
+			return fmt.Errorf("duplicate paused scope (%s)", scope)
+		}
+		scopes[scope] = true
+	}
+
+	if owner != "" {
+		if _, err := cdc.StringToBytes(owner); err != nil {
+			return fmt.Errorf("invalid owner address (%s): %s", owner, err)
+		}
+	}
+
+	if pendingOwner != "" {
+		if _, err := cdc.StringToBytes(pendingOwner); err != nil {
+			return fmt.Errorf("invalid pending owner address (%s): %s", pendingOwner, err)
+		}
+	}
+
+	for _, burner := range burners {
 		if _, err := cdc.StringToBytes(burner.Address); err != nil {
 			return fmt.Errorf("invalid burner address (%s): %s", burner.Address, err)
 		}
@@ -40,9 +326,21 @@ func (gs *GenesisState) Validate(cdc address.Codec) error {
 		if burner.Allowance.IsNegative() {
 			return fmt.Errorf("invalid burner allowance (%s)", burner.Address)
 		}
+
+		sources := make(map[string]bool)
+		for _, source := range burner.Sources {
+			if _, err := cdc.StringToBytes(source); err != nil {
+				return fmt.Errorf("invalid burner source address (%s): %s", source, err)
+			}
+
+			if sources[source] {
+				return fmt.Errorf("duplicate burner source (%s)", source)
+			}
+			sources[source] = true
+		}
 	}
 
-	for _, minter := range gs.Minters {
+	for _, minter := range minters {
 		if _, err := cdc.StringToBytes(minter.Address); err != nil {
 			return fmt.Errorf("invalid minter address (%s): %s", minter.Address, err)
 		}
@@ -50,17 +348,61 @@ func (gs *GenesisState) Validate(cdc address.Codec) error {
 		if minter.Allowance.IsNegative() {
 			return fmt.Errorf("invalid minter allowance (%s)", minter.Address)
 		}
+
+		recipients := make(map[string]bool)
+		for _, recipient := range minter.Recipients {
+			if _, err := cdc.StringToBytes(recipient); err != nil {
+				return fmt.Errorf("invalid minter recipient address (%s): %s", recipient, err)
+			}
+
+			if recipients[recipient] {
+				return fmt.Errorf("duplicate minter recipient (%s)", recipient)
+			}
+			recipients[recipient] = true
+		}
 	}
 
-	for _, pauser := range gs.Pausers {
+	for _, pauser := range pausers {
 		if _, err := cdc.StringToBytes(pauser); err != nil {
 			return fmt.Errorf("invalid pauser address (%s): %s", pauser, err)
 		}
 	}
 
-	for _, channel := range gs.BlockedChannels {
-		if !channeltypes.IsValidChannelID(channel) {
-			return fmt.Errorf("invalid blocked channel (%s)", channel)
+	channels := make(map[string]bool)
+	for _, channel := range blockedChannels {
+		if !channeltypes.IsValidChannelID(channel.Channel) {
+			return fmt.Errorf("invalid blocked channel (%s)", channel.Channel)
+		}
+
+		if !channel.Direction.IsValid() {
+			return fmt.Errorf("invalid blocked channel direction (%s)", channel.Channel)
+		}
+
+		if channels[channel.Channel] {
+			return fmt.Errorf("duplicate blocked channel (%s)", channel.Channel)
+		}
+		channels[channel.Channel] = true
+	}
+
+	exemptions := make(map[string]bool)
+	for _, exemption := range pauseExemptions {
+		if _, err := cdc.StringToBytes(exemption.Address); err != nil {
+			return fmt.Errorf("invalid pause exemption address (%s): %s", exemption.Address, err)
+		}
+
+		if !exemption.Direction.IsValid() {
+			return fmt.Errorf("invalid pause exemption direction (%s)", exemption.Address)
+		}
+
+		if exemptions[exemption.Address] {
+			return fmt.Errorf("duplicate pause exemption (%s)", exemption.Address)
+		}
+		exemptions[exemption.Address] = true
+	}
+
+	for _, recoverer := range recoverers {
+		if _, err := cdc.StringToBytes(recoverer); err != nil {
+			return fmt.Errorf("invalid recoverer address (%s): %s", recoverer, err)
 		}
 	}
 
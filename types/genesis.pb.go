@@ -7,19 +7,26 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	blocklist "github.com/ondoprotocol/usdy-noble/v2/types/blocklist"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,11 +34,197 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ChannelDirection defines which IBC transfers are blocked on a channel.
+type ChannelDirection int32
+
+const (
+	// CHANNEL_DIRECTION_UNSPECIFIED is treated as CHANNEL_DIRECTION_OUTBOUND.
+	ChannelDirectionUnspecified ChannelDirection = 0
+	// CHANNEL_DIRECTION_OUTBOUND blocks transfers from Noble to the counterparty.
+	ChannelDirectionOutbound ChannelDirection = 1
+	// CHANNEL_DIRECTION_INBOUND blocks transfers from the counterparty to Noble.
+	ChannelDirectionInbound ChannelDirection = 2
+	// CHANNEL_DIRECTION_BOTH blocks transfers in both directions.
+	ChannelDirectionBoth ChannelDirection = 3
+)
+
+var ChannelDirection_name = map[int32]string{
+	0: "CHANNEL_DIRECTION_UNSPECIFIED",
+	1: "CHANNEL_DIRECTION_OUTBOUND",
+	2: "CHANNEL_DIRECTION_INBOUND",
+	3: "CHANNEL_DIRECTION_BOTH",
+}
+
+var ChannelDirection_value = map[string]int32{
+	"CHANNEL_DIRECTION_UNSPECIFIED": 0,
+	"CHANNEL_DIRECTION_OUTBOUND":    1,
+	"CHANNEL_DIRECTION_INBOUND":     2,
+	"CHANNEL_DIRECTION_BOTH":        3,
+}
+
+func (x ChannelDirection) String() string {
+	return proto.EnumName(ChannelDirection_name, int32(x))
+}
+
+func (ChannelDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{0}
+}
+
+// ExemptionDirection defines which transfers of an address are exempt from
+// paused transfers.
+type ExemptionDirection int32
+
+const (
+	// EXEMPTION_DIRECTION_UNSPECIFIED is treated as EXEMPTION_DIRECTION_BOTH.
+	ExemptionDirectionUnspecified ExemptionDirection = 0
+	// EXEMPTION_DIRECTION_SEND exempts transfers from the address.
+	ExemptionDirectionSend ExemptionDirection = 1
+	// EXEMPTION_DIRECTION_RECEIVE exempts transfers to the address.
+	ExemptionDirectionReceive ExemptionDirection = 2
+	// EXEMPTION_DIRECTION_BOTH exempts transfers from and to the address.
+	ExemptionDirectionBoth ExemptionDirection = 3
+)
+
+var ExemptionDirection_name = map[int32]string{
+	0: "EXEMPTION_DIRECTION_UNSPECIFIED",
+	1: "EXEMPTION_DIRECTION_SEND",
+	2: "EXEMPTION_DIRECTION_RECEIVE",
+	3: "EXEMPTION_DIRECTION_BOTH",
+}
+
+var ExemptionDirection_value = map[string]int32{
+	"EXEMPTION_DIRECTION_UNSPECIFIED": 0,
+	"EXEMPTION_DIRECTION_SEND":        1,
+	"EXEMPTION_DIRECTION_RECEIVE":     2,
+	"EXEMPTION_DIRECTION_BOTH":        3,
+}
+
+func (x ExemptionDirection) String() string {
+	return proto.EnumName(ExemptionDirection_name, int32(x))
+}
+
+func (ExemptionDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{1}
+}
+
+// ChannelMode defines how IBC channels are restricted.
+type ChannelMode int32
+
+const (
+	// CHANNEL_MODE_UNSPECIFIED is treated as CHANNEL_MODE_DENYLIST.
+	ChannelModeUnspecified ChannelMode = 0
+	// CHANNEL_MODE_DENYLIST allows outbound transfers on all channels except blocked ones.
+	ChannelModeDenylist ChannelMode = 1
+	// CHANNEL_MODE_ALLOWLIST only allows outbound transfers on allowed channels.
+	ChannelModeAllowlist ChannelMode = 2
+)
+
+var ChannelMode_name = map[int32]string{
+	0: "CHANNEL_MODE_UNSPECIFIED",
+	1: "CHANNEL_MODE_DENYLIST",
+	2: "CHANNEL_MODE_ALLOWLIST",
+}
+
+var ChannelMode_value = map[string]int32{
+	"CHANNEL_MODE_UNSPECIFIED": 0,
+	"CHANNEL_MODE_DENYLIST":    1,
+	"CHANNEL_MODE_ALLOWLIST":   2,
+}
+
+func (x ChannelMode) String() string {
+	return proto.EnumName(ChannelMode_name, int32(x))
+}
+
+func (ChannelMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{2}
+}
+
+// PauseScope defines the operations of a governed denom that can be paused.
+type PauseScope int32
+
+const (
+	// PAUSE_SCOPE_UNSPECIFIED is used in messages to target all scopes, except
+	// for burning when pausing.
+	PauseScopeUnspecified PauseScope = 0
+	// PAUSE_SCOPE_IBC_OUTFLOWS pauses transfers into the escrow account of IBC channels.
+	PauseScopeIBCOutflows PauseScope = 1
+	// PAUSE_SCOPE_TRANSFERS pauses all other transfers between accounts.
+	PauseScopeTransfers PauseScope = 2
+	// PAUSE_SCOPE_MINT pauses minting.
+	PauseScopeMint PauseScope = 3
+	// PAUSE_SCOPE_BURN pauses burning.
+	PauseScopeBurn PauseScope = 4
+)
+
+var PauseScope_name = map[int32]string{
+	0: "PAUSE_SCOPE_UNSPECIFIED",
+	1: "PAUSE_SCOPE_IBC_OUTFLOWS",
+	2: "PAUSE_SCOPE_TRANSFERS",
+	3: "PAUSE_SCOPE_MINT",
+	4: "PAUSE_SCOPE_BURN",
+}
+
+var PauseScope_value = map[string]int32{
+	"PAUSE_SCOPE_UNSPECIFIED":  0,
+	"PAUSE_SCOPE_IBC_OUTFLOWS": 1,
+	"PAUSE_SCOPE_TRANSFERS":    2,
+	"PAUSE_SCOPE_MINT":         3,
+	"PAUSE_SCOPE_BURN":         4,
+}
+
+func (x PauseScope) String() string {
+	return proto.EnumName(PauseScope_name, int32(x))
+}
+
+func (PauseScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{3}
+}
+
+type RequestStatus int32
+
+const (
+	// REQUEST_STATUS_UNSPECIFIED is the default value, used to match any status in queries.
+	RequestStatusUnspecified RequestStatus = 0
+	// REQUEST_STATUS_PENDING is the status of requests awaiting fulfillment.
+	RequestStatusPending RequestStatus = 1
+	// REQUEST_STATUS_FULFILLED is the status of fulfilled requests.
+	RequestStatusFulfilled RequestStatus = 2
+	// REQUEST_STATUS_REJECTED is the status of rejected and refunded requests.
+	RequestStatusRejected RequestStatus = 3
+	// REQUEST_STATUS_CANCELLED is the status of requests cancelled and refunded by the user.
+	RequestStatusCancelled RequestStatus = 4
+)
+
+var RequestStatus_name = map[int32]string{
+	0: "REQUEST_STATUS_UNSPECIFIED",
+	1: "REQUEST_STATUS_PENDING",
+	2: "REQUEST_STATUS_FULFILLED",
+	3: "REQUEST_STATUS_REJECTED",
+	4: "REQUEST_STATUS_CANCELLED",
+}
+
+var RequestStatus_value = map[string]int32{
+	"REQUEST_STATUS_UNSPECIFIED": 0,
+	"REQUEST_STATUS_PENDING":     1,
+	"REQUEST_STATUS_FULFILLED":   2,
+	"REQUEST_STATUS_REJECTED":    3,
+	"REQUEST_STATUS_CANCELLED":   4,
+}
+
+func (x RequestStatus) String() string {
+	return proto.EnumName(RequestStatus_name, int32(x))
+}
+
+func (RequestStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{4}
+}
+
 type GenesisState struct {
 	// blocklist_state is the genesis state of the blocklist submodule.
 	BlocklistState blocklist.GenesisState `protobuf:"bytes,1,opt,name=blocklist_state,json=blocklistState,proto3" json:"blocklist_state"`
-	// paused is the paused state of this module.
-	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	// paused is the legacy paused state of USDY, imported as a pause of all
+	// scopes but burning. Deprecated: use paused_scopes instead.
+	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"` // Deprecated: Do not use.
 	// owner is the address that can control this module.
 	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
 	// pending_owner is the address of the new owner during an ownership transfer.
@@ -42,8 +235,68 @@ type GenesisState struct {
 	Minters []Minter `protobuf:"bytes,6,rep,name=minters,proto3" json:"minters"`
 	// pausers is the list of addresses that can pause USDY.
 	Pausers []string `protobuf:"bytes,7,rep,name=pausers,proto3" json:"pausers,omitempty"`
-	// blocked_channels is the list of IBC channels where transfers are blocked.
-	BlockedChannels []string `protobuf:"bytes,8,rep,name=blocked_channels,json=blockedChannels,proto3" json:"blocked_channels,omitempty"`
+	// blocked_channels is the legacy list of IBC channels where outbound
+	// transfers are blocked. Deprecated: use directional_blocked_channels instead.
+	BlockedChannels []string `protobuf:"bytes,8,rep,name=blocked_channels,json=blockedChannels,proto3" json:"blocked_channels,omitempty"` // Deprecated: Do not use.
+	// channel_mode is the mode used to restrict IBC transfers.
+	ChannelMode ChannelMode `protobuf:"varint,9,opt,name=channel_mode,json=channelMode,proto3,enum=aura.v1.ChannelMode" json:"channel_mode,omitempty"`
+	// allowed_channels is the list of IBC channels where outbound transfers are allowed in allowlist mode.
+	AllowedChannels []string `protobuf:"bytes,10,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty"`
+	// rate_limits is the list of IBC channels where the net flow of transfers is limited.
+	RateLimits []RateLimit `protobuf:"bytes,11,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	// rate_limit_flows is the list of transfer flows in the current window of rate limited channels.
+	RateLimitFlows []RateLimitFlow `protobuf:"bytes,12,rep,name=rate_limit_flows,json=rateLimitFlows,proto3" json:"rate_limit_flows"`
+	// price_setters is the list of addresses that can set USDY price ranges.
+	PriceSetters []string `protobuf:"bytes,13,rep,name=price_setters,json=priceSetters,proto3" json:"price_setters,omitempty"`
+	// price_ranges is the list of price ranges used to derive the USDY price.
+	PriceRanges []PriceRange `protobuf:"bytes,14,rep,name=price_ranges,json=priceRanges,proto3" json:"price_ranges"`
+	// max_price_deviation is the maximum relative price change allowed between consecutive days.
+	MaxPriceDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,15,opt,name=max_price_deviation,json=maxPriceDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_deviation"`
+	// stablecoin is the stablecoin used to subscribe to USDY.
+	Stablecoin Stablecoin `protobuf:"bytes,16,opt,name=stablecoin,proto3" json:"stablecoin"`
+	// subscriptions is the list of all subscription requests.
+	Subscriptions []Subscription `protobuf:"bytes,17,rep,name=subscriptions,proto3" json:"subscriptions"`
+	// next_subscription_id is the id assigned to the next subscription request.
+	NextSubscriptionId uint64 `protobuf:"varint,18,opt,name=next_subscription_id,json=nextSubscriptionId,proto3" json:"next_subscription_id,omitempty"`
+	// redemptions is the list of all redemption requests.
+	Redemptions []Redemption `protobuf:"bytes,19,rep,name=redemptions,proto3" json:"redemptions"`
+	// next_redemption_id is the id assigned to the next redemption request.
+	NextRedemptionId uint64 `protobuf:"varint,20,opt,name=next_redemption_id,json=nextRedemptionId,proto3" json:"next_redemption_id,omitempty"`
+	// swap_config is the configuration of the swap facility.
+	SwapConfig SwapConfig `protobuf:"bytes,21,opt,name=swap_config,json=swapConfig,proto3" json:"swap_config"`
+	// swaps_paused is the paused state of the swap facility.
+	SwapsPaused bool `protobuf:"varint,22,opt,name=swaps_paused,json=swapsPaused,proto3" json:"swaps_paused,omitempty"`
+	// swap_liquidity is the amount of stablecoin held by the swap facility.
+	SwapLiquidity types.Coin `protobuf:"bytes,23,opt,name=swap_liquidity,json=swapLiquidity,proto3" json:"swap_liquidity"`
+	// swap_volumes is the list of daily swap volumes of accounts.
+	SwapVolumes []SwapVolume `protobuf:"bytes,24,rep,name=swap_volumes,json=swapVolumes,proto3" json:"swap_volumes"`
+	// lockup_period is the holding period during which newly minted USDY is non-transferable.
+	LockupPeriod time.Duration `protobuf:"bytes,25,opt,name=lockup_period,json=lockupPeriod,proto3,stdduration" json:"lockup_period"`
+	// locked_lots is the list of outstanding locked lots of newly minted USDY.
+	LockedLots []LockedLot `protobuf:"bytes,26,rep,name=locked_lots,json=lockedLots,proto3" json:"locked_lots"`
+	// denoms is the state of the additional denoms governed by this module.
+	Denoms []DenomState `protobuf:"bytes,27,rep,name=denoms,proto3" json:"denoms"`
+	// denom_metadata is the list of bank metadata of governed denoms.
+	DenomMetadata []types1.Metadata `protobuf:"bytes,28,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
+	// attestors is the list of addresses that can post reserve attestations.
+	Attestors []string `protobuf:"bytes,29,rep,name=attestors,proto3" json:"attestors,omitempty"`
+	// attestations is the history of posted reserve attestations.
+	Attestations []Attestation `protobuf:"bytes,30,rep,name=attestations,proto3" json:"attestations"`
+	// reserve_config is the configuration of how attested reserves limit minting.
+	ReserveConfig ReserveConfig `protobuf:"bytes,31,opt,name=reserve_config,json=reserveConfig,proto3" json:"reserve_config"`
+	// recoverers is the list of addresses that can force transfer funds of the
+	// default denom out of blocked accounts.
+	Recoverers []string `protobuf:"bytes,32,rep,name=recoverers,proto3" json:"recoverers,omitempty"`
+	// paused_scopes are the paused operations of the default denom.
+	PausedScopes []PauseScope `protobuf:"varint,33,rep,packed,name=paused_scopes,json=pausedScopes,proto3,enum=aura.v1.PauseScope" json:"paused_scopes,omitempty"`
+	// pause_windows are the scheduled and expiring pauses of governed denoms.
+	PauseWindows []PauseWindow `protobuf:"bytes,34,rep,name=pause_windows,json=pauseWindows,proto3" json:"pause_windows"`
+	// pause_exemptions are the addresses that can transfer the default denom
+	// while its transfers are paused.
+	PauseExemptions []PauseExemption `protobuf:"bytes,35,rep,name=pause_exemptions,json=pauseExemptions,proto3" json:"pause_exemptions"`
+	// directional_blocked_channels is the list of IBC channels where transfers
+	// are blocked, and the direction of transfers that are blocked.
+	DirectionalBlockedChannels []BlockedChannel `protobuf:"bytes,36,rep,name=directional_blocked_channels,json=directionalBlockedChannels,proto3" json:"directional_blocked_channels"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,6 +339,7 @@ func (m *GenesisState) GetBlocklistState() blocklist.GenesisState {
 	return blocklist.GenesisState{}
 }
 
+// Deprecated: Do not use.
 func (m *GenesisState) GetPaused() bool {
 	if m != nil {
 		return m.Paused
@@ -128,6 +382,7 @@ func (m *GenesisState) GetPausers() []string {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *GenesisState) GetBlockedChannels() []string {
 	if m != nil {
 		return m.BlockedChannels
@@ -135,9 +390,202 @@ func (m *GenesisState) GetBlockedChannels() []string {
 	return nil
 }
 
+func (m *GenesisState) GetChannelMode() ChannelMode {
+	if m != nil {
+		return m.ChannelMode
+	}
+	return ChannelModeUnspecified
+}
+
+func (m *GenesisState) GetAllowedChannels() []string {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimitFlows() []RateLimitFlow {
+	if m != nil {
+		return m.RateLimitFlows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceSetters() []string {
+	if m != nil {
+		return m.PriceSetters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceRanges() []PriceRange {
+	if m != nil {
+		return m.PriceRanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStablecoin() Stablecoin {
+	if m != nil {
+		return m.Stablecoin
+	}
+	return Stablecoin{}
+}
+
+func (m *GenesisState) GetSubscriptions() []Subscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextSubscriptionId() uint64 {
+	if m != nil {
+		return m.NextSubscriptionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRedemptions() []Redemption {
+	if m != nil {
+		return m.Redemptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextRedemptionId() uint64 {
+	if m != nil {
+		return m.NextRedemptionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSwapConfig() SwapConfig {
+	if m != nil {
+		return m.SwapConfig
+	}
+	return SwapConfig{}
+}
+
+func (m *GenesisState) GetSwapsPaused() bool {
+	if m != nil {
+		return m.SwapsPaused
+	}
+	return false
+}
+
+func (m *GenesisState) GetSwapLiquidity() types.Coin {
+	if m != nil {
+		return m.SwapLiquidity
+	}
+	return types.Coin{}
+}
+
+func (m *GenesisState) GetSwapVolumes() []SwapVolume {
+	if m != nil {
+		return m.SwapVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockupPeriod() time.Duration {
+	if m != nil {
+		return m.LockupPeriod
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLockedLots() []LockedLot {
+	if m != nil {
+		return m.LockedLots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenoms() []DenomState {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomMetadata() []types1.Metadata {
+	if m != nil {
+		return m.DenomMetadata
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestors() []string {
+	if m != nil {
+		return m.Attestors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestations() []Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReserveConfig() ReserveConfig {
+	if m != nil {
+		return m.ReserveConfig
+	}
+	return ReserveConfig{}
+}
+
+func (m *GenesisState) GetRecoverers() []string {
+	if m != nil {
+		return m.Recoverers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedScopes() []PauseScope {
+	if m != nil {
+		return m.PausedScopes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPauseWindows() []PauseWindow {
+	if m != nil {
+		return m.PauseWindows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPauseExemptions() []PauseExemption {
+	if m != nil {
+		return m.PauseExemptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDirectionalBlockedChannels() []BlockedChannel {
+	if m != nil {
+		return m.DirectionalBlockedChannels
+	}
+	return nil
+}
+
 type Burner struct {
 	Address   string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Allowance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=allowance,proto3,customtype=cosmossdk.io/math.Int" json:"allowance"`
+	// expiry is the optional time at which the allowance expires.
+	Expiry time.Time `protobuf:"bytes,3,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	// sources are the only addresses the burner can burn from, unrestricted if empty.
+	Sources []string `protobuf:"bytes,4,rep,name=sources,proto3" json:"sources,omitempty"`
 }
 
 func (m *Burner) Reset()         { *m = Burner{} }
@@ -180,9 +628,27 @@ func (m *Burner) GetAddress() string {
 	return ""
 }
 
+func (m *Burner) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+func (m *Burner) GetSources() []string {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
 type Minter struct {
 	Address   string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Allowance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=allowance,proto3,customtype=cosmossdk.io/math.Int" json:"allowance"`
+	// expiry is the optional time at which the allowance expires.
+	Expiry time.Time `protobuf:"bytes,3,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	// recipients are the only addresses the minter can mint to, unrestricted if empty.
+	Recipients []string `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients,omitempty"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -225,324 +691,7129 @@ func (m *Minter) GetAddress() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "aura.v1.GenesisState")
-	proto.RegisterType((*Burner)(nil), "aura.v1.Burner")
-	proto.RegisterType((*Minter)(nil), "aura.v1.Minter")
+func (m *Minter) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
 }
 
-func init() { proto.RegisterFile("aura/v1/genesis.proto", fileDescriptor_dffbbeb9813c8a98) }
+func (m *Minter) GetRecipients() []string {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
 
-var fileDescriptor_dffbbeb9813c8a98 = []byte{
-	// 454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0x4f, 0x6f, 0xd3, 0x30,
-	0x1c, 0xad, 0xd7, 0xad, 0x5d, 0xbc, 0x41, 0x21, 0xda, 0x50, 0xe8, 0x21, 0x8d, 0xc6, 0x25, 0x80,
-	0x66, 0xd3, 0xf2, 0x0d, 0x8a, 0x10, 0xda, 0x81, 0x21, 0x85, 0x1b, 0x97, 0xca, 0x89, 0xad, 0x34,
-	0x5a, 0x62, 0x57, 0xb6, 0xd3, 0x69, 0xdf, 0x82, 0x8f, 0xc1, 0x91, 0x03, 0x1f, 0x62, 0xc7, 0x89,
-	0xd3, 0xc4, 0x61, 0x42, 0xed, 0x81, 0xaf, 0x81, 0xfc, 0xa7, 0x05, 0xc1, 0x7d, 0x97, 0xaa, 0xef,
-	0xbd, 0xdf, 0x7b, 0x7e, 0xfe, 0x39, 0xf0, 0x98, 0xb4, 0x92, 0xe0, 0xe5, 0x18, 0x97, 0x8c, 0x33,
-	0x55, 0x29, 0xb4, 0x90, 0x42, 0x8b, 0xb0, 0x6f, 0x68, 0xb4, 0x1c, 0x0f, 0x1f, 0x93, 0xa6, 0xe2,
-	0x02, 0xdb, 0x5f, 0xa7, 0x0d, 0x47, 0xd6, 0x92, 0xd7, 0xa2, 0xb8, 0xa8, 0x2b, 0xa5, 0xff, 0x33,
-	0x0f, 0x9f, 0x16, 0x42, 0x35, 0x42, 0xcd, 0x2c, 0xc2, 0x0e, 0x78, 0xe9, 0xa8, 0x14, 0xa5, 0x70,
-	0xbc, 0xf9, 0xe7, 0xd8, 0x93, 0xdb, 0x1d, 0x78, 0xf8, 0xce, 0x45, 0x7c, 0xd4, 0x44, 0xb3, 0xf0,
-	0x1c, 0x0e, 0xb6, 0xf9, 0x33, 0x65, 0xa8, 0x08, 0x24, 0x20, 0x3d, 0x98, 0x8c, 0x90, 0x2d, 0xb6,
-	0x15, 0xd1, 0x72, 0x8c, 0xfe, 0x76, 0x4e, 0x77, 0xaf, 0xef, 0x46, 0x9d, 0xec, 0xe1, 0x76, 0xc0,
-	0xe5, 0x3d, 0x81, 0xbd, 0x05, 0x69, 0x15, 0xa3, 0xd1, 0x4e, 0x02, 0xd2, 0xfd, 0xcc, 0xa3, 0xf0,
-	0x08, 0xee, 0x89, 0x4b, 0xce, 0x64, 0xd4, 0x4d, 0x40, 0x1a, 0x64, 0x0e, 0x84, 0xcf, 0xe0, 0x83,
-	0x05, 0xe3, 0xb4, 0xe2, 0xe5, 0xcc, 0xa9, 0xbb, 0x56, 0x3d, 0xf4, 0xe4, 0x07, 0x3b, 0x84, 0x61,
-	0x3f, 0x6f, 0x25, 0x67, 0x52, 0x45, 0x7b, 0x49, 0x37, 0x3d, 0x98, 0x0c, 0x90, 0xdf, 0x19, 0x9a,
-	0x5a, 0xde, 0x57, 0xd9, 0x4c, 0x19, 0x43, 0x53, 0x71, 0x6d, 0x0c, 0xbd, 0x7f, 0x0c, 0xef, 0x2d,
-	0xbf, 0x31, 0xf8, 0xa9, 0x30, 0x82, 0x7d, 0x5b, 0x53, 0xaa, 0xa8, 0x9f, 0x74, 0xd3, 0x20, 0xdb,
-	0xc0, 0xf0, 0x39, 0x7c, 0x64, 0x2f, 0xc8, 0xe8, 0xac, 0x98, 0x13, 0xce, 0x59, 0xad, 0xa2, 0x7d,
-	0x3b, 0x32, 0xf0, 0xfc, 0x1b, 0x4f, 0x9f, 0x48, 0xd8, 0x73, 0x75, 0x4c, 0x1c, 0xa1, 0x54, 0x32,
-	0xa5, 0xec, 0x2e, 0x83, 0x6c, 0x03, 0xc3, 0x73, 0x18, 0x90, 0xba, 0x16, 0x97, 0x84, 0x17, 0xcc,
-	0x2e, 0x28, 0x98, 0xbe, 0x32, 0x55, 0x7e, 0xdc, 0x8d, 0x8e, 0xdd, 0xeb, 0x29, 0x7a, 0x81, 0x2a,
-	0x81, 0x1b, 0xa2, 0xe7, 0xe8, 0x8c, 0xeb, 0xef, 0xdf, 0x4e, 0xa1, 0x7f, 0xd6, 0x33, 0xae, 0xbf,
-	0xfc, 0xfa, 0xfa, 0x02, 0x64, 0x7f, 0x22, 0xcc, 0x99, 0xee, 0x46, 0xf7, 0x77, 0xe6, 0xf4, 0xed,
-	0xf5, 0x2a, 0x06, 0x37, 0xab, 0x18, 0xfc, 0x5c, 0xc5, 0xe0, 0xf3, 0x3a, 0xee, 0xdc, 0xac, 0xe3,
-	0xce, 0xed, 0x3a, 0xee, 0x7c, 0x7a, 0x59, 0x56, 0x7a, 0xde, 0xe6, 0xa8, 0x10, 0x0d, 0x16, 0x9c,
-	0xba, 0xaf, 0xaf, 0x10, 0x35, 0x6e, 0x15, 0xbd, 0x3a, 0xe5, 0x22, 0xaf, 0x19, 0x5e, 0x4e, 0xb0,
-	0xbe, 0x5a, 0x30, 0x95, 0xf7, 0xac, 0xfa, 0xfa, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x35, 0x87,
-	0xed, 0x35, 0x17, 0x03, 0x00, 0x00,
+type BlockedChannel struct {
+	Channel   string           `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Direction ChannelDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=aura.v1.ChannelDirection" json:"direction,omitempty"`
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BlockedChannel) Reset()         { *m = BlockedChannel{} }
+func (m *BlockedChannel) String() string { return proto.CompactTextString(m) }
+func (*BlockedChannel) ProtoMessage()    {}
+func (*BlockedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{3}
+}
+func (m *BlockedChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockedChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *BlockedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockedChannel.Merge(m, src)
+}
+func (m *BlockedChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockedChannel.DiscardUnknown(m)
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_BlockedChannel proto.InternalMessageInfo
+
+func (m *BlockedChannel) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlockedChannels) > 0 {
-		for iNdEx := len(m.BlockedChannels) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BlockedChannels[iNdEx])
-			copy(dAtA[i:], m.BlockedChannels[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BlockedChannels[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
+func (m *BlockedChannel) GetDirection() ChannelDirection {
+	if m != nil {
+		return m.Direction
 	}
-	if len(m.Pausers) > 0 {
-		for iNdEx := len(m.Pausers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Pausers[iNdEx])
-			copy(dAtA[i:], m.Pausers[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Pausers[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.Minters) > 0 {
-		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.Burners) > 0 {
-		for iNdEx := len(m.Burners) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Burners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.PendingOwner) > 0 {
-		i -= len(m.PendingOwner)
-		copy(dAtA[i:], m.PendingOwner)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PendingOwner)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Paused {
-		i--
-		if m.Paused {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.BlocklistState.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ChannelDirectionUnspecified
 }
 
-func (m *Burner) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type PauseExemption struct {
+	Address   string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Direction ExemptionDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=aura.v1.ExemptionDirection" json:"direction,omitempty"`
 }
 
-func (m *Burner) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PauseExemption) Reset()         { *m = PauseExemption{} }
+func (m *PauseExemption) String() string { return proto.CompactTextString(m) }
+func (*PauseExemption) ProtoMessage()    {}
+func (*PauseExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{4}
 }
-
-func (m *Burner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Allowance.Size()
-		i -= size
-		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *PauseExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *PauseExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseExemption.Merge(m, src)
+}
+func (m *PauseExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseExemption proto.InternalMessageInfo
+
+func (m *PauseExemption) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Minter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PauseExemption) GetDirection() ExemptionDirection {
+	if m != nil {
+		return m.Direction
 	}
-	return dAtA[:n], nil
+	return ExemptionDirectionUnspecified
 }
 
-func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DenomState struct {
+	Denom           string           `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	PausedScopes    []PauseScope     `protobuf:"varint,2,rep,packed,name=paused_scopes,json=pausedScopes,proto3,enum=aura.v1.PauseScope" json:"paused_scopes,omitempty"`
+	Owner           string           `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	PendingOwner    string           `protobuf:"bytes,4,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+	Burners         []Burner         `protobuf:"bytes,5,rep,name=burners,proto3" json:"burners"`
+	Minters         []Minter         `protobuf:"bytes,6,rep,name=minters,proto3" json:"minters"`
+	Pausers         []string         `protobuf:"bytes,7,rep,name=pausers,proto3" json:"pausers,omitempty"`
+	BlockedChannels []BlockedChannel `protobuf:"bytes,8,rep,name=blocked_channels,json=blockedChannels,proto3" json:"blocked_channels"`
+	BlocklistBound  bool             `protobuf:"varint,9,opt,name=blocklist_bound,json=blocklistBound,proto3" json:"blocklist_bound,omitempty"`
+	PauseExemptions []PauseExemption `protobuf:"bytes,10,rep,name=pause_exemptions,json=pauseExemptions,proto3" json:"pause_exemptions"`
+	Recoverers      []string         `protobuf:"bytes,11,rep,name=recoverers,proto3" json:"recoverers,omitempty"`
 }
 
-func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Allowance.Size()
-		i -= size
-		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *DenomState) Reset()         { *m = DenomState{} }
+func (m *DenomState) String() string { return proto.CompactTextString(m) }
+func (*DenomState) ProtoMessage()    {}
+func (*DenomState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{5}
+}
+func (m *DenomState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *DenomState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomState.Merge(m, src)
+}
+func (m *DenomState) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomState) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomState proto.InternalMessageInfo
+
+func (m *DenomState) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DenomState) GetPausedScopes() []PauseScope {
+	if m != nil {
+		return m.PausedScopes
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *DenomState) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	var l int
-	_ = l
-	l = m.BlocklistState.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.Paused {
-		n += 2
+	return ""
+}
+
+func (m *DenomState) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return ""
+}
+
+func (m *DenomState) GetBurners() []Burner {
+	if m != nil {
+		return m.Burners
 	}
-	l = len(m.PendingOwner)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+func (m *DenomState) GetMinters() []Minter {
+	if m != nil {
+		return m.Minters
 	}
-	if len(m.Burners) > 0 {
-		for _, e := range m.Burners {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return nil
+}
+
+func (m *DenomState) GetPausers() []string {
+	if m != nil {
+		return m.Pausers
 	}
-	if len(m.Minters) > 0 {
-		for _, e := range m.Minters {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return nil
+}
+
+func (m *DenomState) GetBlockedChannels() []BlockedChannel {
+	if m != nil {
+		return m.BlockedChannels
 	}
-	if len(m.Pausers) > 0 {
-		for _, s := range m.Pausers {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return nil
+}
+
+func (m *DenomState) GetBlocklistBound() bool {
+	if m != nil {
+		return m.BlocklistBound
 	}
-	if len(m.BlockedChannels) > 0 {
-		for _, s := range m.BlockedChannels {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return false
+}
+
+func (m *DenomState) GetPauseExemptions() []PauseExemption {
+	if m != nil {
+		return m.PauseExemptions
 	}
-	return n
+	return nil
 }
 
-func (m *Burner) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DenomState) GetRecoverers() []string {
+	if m != nil {
+		return m.Recoverers
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+type RateLimit struct {
+	// channel is the id of the rate limited channel.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// window is the duration after which the flow of transfers is reset.
+	Window time.Duration `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window"`
+	// max_amount is the maximum net flow per window, zero if disabled.
+	MaxAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount"`
+	// max_percentage is the maximum net flow per window as a percentage of supply, zero if disabled.
+	MaxPercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_percentage,json=maxPercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_percentage"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{6}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Allowance.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
 }
 
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return ""
+}
+
+func (m *RateLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
 	}
-	l = m.Allowance.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return 0
+}
+
+type RateLimitFlow struct {
+	// channel is the id of the rate limited channel.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// window_start is the time at which the current window started.
+	WindowStart time.Time `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	// inflow is the amount transferred to Noble in the current window.
+	Inflow cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=inflow,proto3,customtype=cosmossdk.io/math.Int" json:"inflow"`
+	// outflow is the amount transferred from Noble in the current window.
+	Outflow cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=outflow,proto3,customtype=cosmossdk.io/math.Int" json:"outflow"`
+	// supply is the supply at the start of the current window.
+	Supply cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+}
+
+func (m *RateLimitFlow) Reset()         { *m = RateLimitFlow{} }
+func (m *RateLimitFlow) String() string { return proto.CompactTextString(m) }
+func (*RateLimitFlow) ProtoMessage()    {}
+func (*RateLimitFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{7}
+}
+func (m *RateLimitFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitFlow.Merge(m, src)
+}
+func (m *RateLimitFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitFlow proto.InternalMessageInfo
+
+func (m *RateLimitFlow) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *RateLimitFlow) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+type PriceRange struct {
+	// index is the position of the range, ranges are contiguous and ordered by index.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// start is the time at which the range starts.
+	Start time.Time `protobuf:"bytes,2,opt,name=start,proto3,stdtime" json:"start"`
+	// end is the time at which the range ends.
+	End time.Time `protobuf:"bytes,3,opt,name=end,proto3,stdtime" json:"end"`
+	// daily_rate is the factor the price is multiplied by for every full day elapsed in the range.
+	DailyRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=daily_rate,json=dailyRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"daily_rate"`
+	// start_price is the price at the start of the range.
+	StartPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=start_price,json=startPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"start_price"`
+}
+
+func (m *PriceRange) Reset()         { *m = PriceRange{} }
+func (m *PriceRange) String() string { return proto.CompactTextString(m) }
+func (*PriceRange) ProtoMessage()    {}
+func (*PriceRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{8}
+}
+func (m *PriceRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceRange.Merge(m, src)
+}
+func (m *PriceRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceRange proto.InternalMessageInfo
+
+func (m *PriceRange) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *PriceRange) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *PriceRange) GetEnd() time.Time {
+	if m != nil {
+		return m.End
+	}
+	return time.Time{}
+}
+
+// PauseWindow bounds the pause of a scope of a governed denom. A pause starts
+// once either start bound is reached, and is lifted once either end bound is
+// reached. Bounds that are zero are not set.
+type PauseWindow struct {
+	// denom is the governed denom the pause applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// scope is the paused operation.
+	Scope PauseScope `protobuf:"varint,2,opt,name=scope,proto3,enum=aura.v1.PauseScope" json:"scope,omitempty"`
+	// start_height is the block height at which the pause starts.
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// start_time is the block time at which the pause starts.
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_height is the block height at which the pause is lifted.
+	EndHeight int64 `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// end_time is the block time at which the pause is lifted.
+	EndTime time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *PauseWindow) Reset()         { *m = PauseWindow{} }
+func (m *PauseWindow) String() string { return proto.CompactTextString(m) }
+func (*PauseWindow) ProtoMessage()    {}
+func (*PauseWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{9}
+}
+func (m *PauseWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWindow.Merge(m, src)
+}
+func (m *PauseWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseWindow proto.InternalMessageInfo
+
+func (m *PauseWindow) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PauseWindow) GetScope() PauseScope {
+	if m != nil {
+		return m.Scope
+	}
+	return PauseScopeUnspecified
+}
+
+func (m *PauseWindow) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *PauseWindow) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *PauseWindow) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *PauseWindow) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+type Stablecoin struct {
+	// denom is the base denom of the stablecoin.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// exponent is the number of decimals of the stablecoin's base denom.
+	Exponent uint32 `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (m *Stablecoin) Reset()         { *m = Stablecoin{} }
+func (m *Stablecoin) String() string { return proto.CompactTextString(m) }
+func (*Stablecoin) ProtoMessage()    {}
+func (*Stablecoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{10}
+}
+func (m *Stablecoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stablecoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Stablecoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Stablecoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stablecoin.Merge(m, src)
+}
+func (m *Stablecoin) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stablecoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stablecoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stablecoin proto.InternalMessageInfo
+
+func (m *Stablecoin) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Stablecoin) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+type Subscription struct {
+	// id is the unique identifier of the request.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// user is the address of the account that requested the subscription.
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// deposit is the amount of stablecoin escrowed in the module.
+	Deposit types.Coin `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit"`
+	// status is the current status of the request.
+	Status RequestStatus `protobuf:"varint,4,opt,name=status,proto3,enum=aura.v1.RequestStatus" json:"status,omitempty"`
+	// price is the USDY price the request was fulfilled at, zero if not fulfilled.
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// minted is the amount of USDY minted to the user, zero if not fulfilled.
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+	// created_at is the time at which the request was made.
+	CreatedAt time.Time `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+}
+
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{11}
+}
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(m, src)
+}
+func (m *Subscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
+
+func (m *Subscription) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Subscription) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *Subscription) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+func (m *Subscription) GetStatus() RequestStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RequestStatusUnspecified
+}
+
+func (m *Subscription) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+type Redemption struct {
+	// id is the unique identifier of the request.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// user is the address of the account that requested the redemption.
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// amount is the amount of USDY escrowed in the module.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// status is the current status of the request.
+	Status RequestStatus `protobuf:"varint,4,opt,name=status,proto3,enum=aura.v1.RequestStatus" json:"status,omitempty"`
+	// payout is the amount of stablecoin paid to the user on settlement, if any.
+	Payout types.Coin `protobuf:"bytes,5,opt,name=payout,proto3" json:"payout"`
+	// created_at is the time at which the request was made.
+	CreatedAt time.Time `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+}
+
+func (m *Redemption) Reset()         { *m = Redemption{} }
+func (m *Redemption) String() string { return proto.CompactTextString(m) }
+func (*Redemption) ProtoMessage()    {}
+func (*Redemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{12}
+}
+func (m *Redemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Redemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Redemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Redemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redemption.Merge(m, src)
+}
+func (m *Redemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *Redemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Redemption proto.InternalMessageInfo
+
+func (m *Redemption) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Redemption) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *Redemption) GetStatus() RequestStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RequestStatusUnspecified
+}
+
+func (m *Redemption) GetPayout() types.Coin {
+	if m != nil {
+		return m.Payout
+	}
+	return types.Coin{}
+}
+
+func (m *Redemption) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+type SwapConfig struct {
+	// fee is the fraction of the oracle price charged on swaps.
+	Fee cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=fee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee"`
+	// daily_limit is the maximum amount of USDY an account can swap per day, zero if disabled.
+	DailyLimit cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=daily_limit,json=dailyLimit,proto3,customtype=cosmossdk.io/math.Int" json:"daily_limit"`
+}
+
+func (m *SwapConfig) Reset()         { *m = SwapConfig{} }
+func (m *SwapConfig) String() string { return proto.CompactTextString(m) }
+func (*SwapConfig) ProtoMessage()    {}
+func (*SwapConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{13}
+}
+func (m *SwapConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapConfig.Merge(m, src)
+}
+func (m *SwapConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapConfig proto.InternalMessageInfo
+
+type SwapVolume struct {
+	// address is the address of the account that swapped.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// day is the number of days since the unix epoch the volume was swapped on.
+	Day uint64 `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	// volume is the amount of USDY swapped on that day.
+	Volume cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume"`
+}
+
+func (m *SwapVolume) Reset()         { *m = SwapVolume{} }
+func (m *SwapVolume) String() string { return proto.CompactTextString(m) }
+func (*SwapVolume) ProtoMessage()    {}
+func (*SwapVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{14}
+}
+func (m *SwapVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapVolume.Merge(m, src)
+}
+func (m *SwapVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapVolume proto.InternalMessageInfo
+
+func (m *SwapVolume) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SwapVolume) GetDay() uint64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+type LockedLot struct {
+	// address is the address of the account that received the minted USDY.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the amount of USDY that is locked.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// unlock_time is the time at which the USDY becomes transferable.
+	UnlockTime time.Time `protobuf:"bytes,3,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
+}
+
+func (m *LockedLot) Reset()         { *m = LockedLot{} }
+func (m *LockedLot) String() string { return proto.CompactTextString(m) }
+func (*LockedLot) ProtoMessage()    {}
+func (*LockedLot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{15}
+}
+func (m *LockedLot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockedLot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockedLot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockedLot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedLot.Merge(m, src)
+}
+func (m *LockedLot) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockedLot) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedLot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedLot proto.InternalMessageInfo
+
+func (m *LockedLot) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LockedLot) GetUnlockTime() time.Time {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return time.Time{}
+}
+
+type Attestation struct {
+	// index is the position of the attestation in the history.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// attestor is the address that posted the attestation.
+	Attestor string `protobuf:"bytes,2,opt,name=attestor,proto3" json:"attestor,omitempty"`
+	// amount is the amount of reserves backing USDY, denominated in USDY.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// as_of is the time at which the reserves were attested.
+	AsOf time.Time `protobuf:"bytes,4,opt,name=as_of,json=asOf,proto3,stdtime" json:"as_of"`
+	// report_hash is the hash of the off-chain attestation report.
+	ReportHash string `protobuf:"bytes,5,opt,name=report_hash,json=reportHash,proto3" json:"report_hash,omitempty"`
+	// posted_at is the time at which the attestation was posted.
+	PostedAt time.Time `protobuf:"bytes,6,opt,name=posted_at,json=postedAt,proto3,stdtime" json:"posted_at"`
+}
+
+func (m *Attestation) Reset()         { *m = Attestation{} }
+func (m *Attestation) String() string { return proto.CompactTextString(m) }
+func (*Attestation) ProtoMessage()    {}
+func (*Attestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{16}
+}
+func (m *Attestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Attestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Attestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Attestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attestation.Merge(m, src)
+}
+func (m *Attestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Attestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attestation proto.InternalMessageInfo
+
+func (m *Attestation) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Attestation) GetAttestor() string {
+	if m != nil {
+		return m.Attestor
+	}
+	return ""
+}
+
+func (m *Attestation) GetAsOf() time.Time {
+	if m != nil {
+		return m.AsOf
+	}
+	return time.Time{}
+}
+
+func (m *Attestation) GetReportHash() string {
+	if m != nil {
+		return m.ReportHash
+	}
+	return ""
+}
+
+func (m *Attestation) GetPostedAt() time.Time {
+	if m != nil {
+		return m.PostedAt
+	}
+	return time.Time{}
+}
+
+type ReserveConfig struct {
+	// collateral_ratio is the factor applied to the latest attested reserves to
+	// cap the supply of USDY, zero if minting isn't limited by reserves.
+	CollateralRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=collateral_ratio,json=collateralRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"collateral_ratio"`
+	// max_staleness is how long an attestation is valid for after its as of
+	// time, zero if attestations don't become stale.
+	MaxStaleness time.Duration `protobuf:"bytes,2,opt,name=max_staleness,json=maxStaleness,proto3,stdduration" json:"max_staleness"`
+}
+
+func (m *ReserveConfig) Reset()         { *m = ReserveConfig{} }
+func (m *ReserveConfig) String() string { return proto.CompactTextString(m) }
+func (*ReserveConfig) ProtoMessage()    {}
+func (*ReserveConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dffbbeb9813c8a98, []int{17}
+}
+func (m *ReserveConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReserveConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReserveConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReserveConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveConfig.Merge(m, src)
+}
+func (m *ReserveConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReserveConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveConfig proto.InternalMessageInfo
+
+func (m *ReserveConfig) GetMaxStaleness() time.Duration {
+	if m != nil {
+		return m.MaxStaleness
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("aura.v1.ChannelDirection", ChannelDirection_name, ChannelDirection_value)
+	proto.RegisterEnum("aura.v1.ExemptionDirection", ExemptionDirection_name, ExemptionDirection_value)
+	proto.RegisterEnum("aura.v1.ChannelMode", ChannelMode_name, ChannelMode_value)
+	proto.RegisterEnum("aura.v1.PauseScope", PauseScope_name, PauseScope_value)
+	proto.RegisterEnum("aura.v1.RequestStatus", RequestStatus_name, RequestStatus_value)
+	proto.RegisterType((*GenesisState)(nil), "aura.v1.GenesisState")
+	proto.RegisterType((*Burner)(nil), "aura.v1.Burner")
+	proto.RegisterType((*Minter)(nil), "aura.v1.Minter")
+	proto.RegisterType((*BlockedChannel)(nil), "aura.v1.BlockedChannel")
+	proto.RegisterType((*PauseExemption)(nil), "aura.v1.PauseExemption")
+	proto.RegisterType((*DenomState)(nil), "aura.v1.DenomState")
+	proto.RegisterType((*RateLimit)(nil), "aura.v1.RateLimit")
+	proto.RegisterType((*RateLimitFlow)(nil), "aura.v1.RateLimitFlow")
+	proto.RegisterType((*PriceRange)(nil), "aura.v1.PriceRange")
+	proto.RegisterType((*PauseWindow)(nil), "aura.v1.PauseWindow")
+	proto.RegisterType((*Stablecoin)(nil), "aura.v1.Stablecoin")
+	proto.RegisterType((*Subscription)(nil), "aura.v1.Subscription")
+	proto.RegisterType((*Redemption)(nil), "aura.v1.Redemption")
+	proto.RegisterType((*SwapConfig)(nil), "aura.v1.SwapConfig")
+	proto.RegisterType((*SwapVolume)(nil), "aura.v1.SwapVolume")
+	proto.RegisterType((*LockedLot)(nil), "aura.v1.LockedLot")
+	proto.RegisterType((*Attestation)(nil), "aura.v1.Attestation")
+	proto.RegisterType((*ReserveConfig)(nil), "aura.v1.ReserveConfig")
+}
+
+func init() { proto.RegisterFile("aura/v1/genesis.proto", fileDescriptor_dffbbeb9813c8a98) }
+
+var fileDescriptor_dffbbeb9813c8a98 = []byte{
+	// 2584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xbd, 0x6f, 0x23, 0xd7,
+	0x11, 0x3f, 0x52, 0x12, 0x25, 0x0e, 0x25, 0x8a, 0x7e, 0xf7, 0xb5, 0xe2, 0xdd, 0x49, 0x34, 0x1d,
+	0x20, 0xb2, 0x13, 0x93, 0x3e, 0xc5, 0xb0, 0x7d, 0xf6, 0xe1, 0x0c, 0x7e, 0xac, 0x2c, 0x3a, 0x14,
+	0x29, 0x2f, 0x25, 0x5f, 0x12, 0x20, 0x58, 0xac, 0x76, 0x9f, 0xa4, 0xcd, 0x2d, 0x77, 0xd7, 0xfb,
+	0x76, 0xf5, 0xd1, 0x06, 0x29, 0x02, 0x25, 0x85, 0x91, 0xc2, 0x48, 0xa3, 0x2a, 0x8d, 0x53, 0x04,
+	0x48, 0x91, 0x8f, 0x26, 0x75, 0x60, 0xa4, 0x32, 0x5c, 0x05, 0x29, 0x9c, 0xc0, 0x57, 0xa4, 0xcd,
+	0x7f, 0x90, 0xe0, 0x7d, 0xec, 0x07, 0x97, 0xd2, 0x45, 0xe2, 0xb9, 0x71, 0x23, 0xf0, 0xcd, 0xcc,
+	0x6f, 0x66, 0xde, 0xbc, 0x99, 0x79, 0xf3, 0x56, 0x70, 0x53, 0x0b, 0x3c, 0xad, 0x7e, 0x78, 0xbf,
+	0xbe, 0x8f, 0x6d, 0x4c, 0x4c, 0x52, 0x73, 0x3d, 0xc7, 0x77, 0xd0, 0x2c, 0x25, 0xd7, 0x0e, 0xef,
+	0x97, 0x5f, 0xd0, 0x86, 0xa6, 0xed, 0xd4, 0xd9, 0x5f, 0xce, 0x2b, 0xaf, 0x30, 0xc8, 0xae, 0xe5,
+	0xe8, 0x4f, 0x2c, 0x93, 0xf8, 0x63, 0xe0, 0xf2, 0xb2, 0xee, 0x90, 0xa1, 0x43, 0xea, 0xbb, 0x9a,
+	0xfd, 0xa4, 0x7e, 0x78, 0x7f, 0x17, 0xfb, 0xda, 0x7d, 0xb6, 0x18, 0xe3, 0x13, 0x1c, 0xf1, 0x75,
+	0xc7, 0xb4, 0x05, 0x7f, 0x89, 0xf3, 0x55, 0xb6, 0xaa, 0xf3, 0x85, 0x60, 0xdd, 0xd8, 0x77, 0xf6,
+	0x1d, 0x4e, 0xa7, 0xbf, 0x42, 0x85, 0xfb, 0x8e, 0xb3, 0x6f, 0xe1, 0x3a, 0x5b, 0xed, 0x06, 0x7b,
+	0x75, 0x23, 0xf0, 0x34, 0xdf, 0x74, 0x42, 0x85, 0x2b, 0x69, 0xbe, 0x6f, 0x0e, 0x31, 0xf1, 0xb5,
+	0xa1, 0xcb, 0x05, 0xaa, 0x3f, 0x2b, 0xc1, 0xfc, 0x7b, 0x7c, 0x0f, 0x03, 0x5f, 0xf3, 0x31, 0xea,
+	0xc1, 0x62, 0xb4, 0x41, 0x95, 0x50, 0x92, 0x94, 0xa9, 0x64, 0x56, 0x0b, 0x6b, 0x2b, 0x35, 0x16,
+	0x99, 0x88, 0x59, 0x3b, 0xbc, 0x5f, 0x4b, 0x22, 0x9b, 0xd3, 0x9f, 0x7d, 0xb9, 0x72, 0x4d, 0x29,
+	0x46, 0x02, 0x5c, 0x5f, 0x19, 0x72, 0xae, 0x16, 0x10, 0x6c, 0x48, 0xd9, 0x4a, 0x66, 0x75, 0xae,
+	0x99, 0x95, 0x32, 0x8a, 0xa0, 0xa0, 0x1b, 0x30, 0xe3, 0x1c, 0xd9, 0xd8, 0x93, 0xa6, 0x2a, 0x99,
+	0xd5, 0xbc, 0xc2, 0x17, 0xe8, 0x25, 0x58, 0x70, 0xb1, 0x6d, 0x98, 0xf6, 0xbe, 0xca, 0xb9, 0xd3,
+	0x8c, 0x3b, 0x2f, 0x88, 0x7d, 0x26, 0x54, 0x87, 0xd9, 0xdd, 0xc0, 0xb3, 0xb1, 0x47, 0xa4, 0x99,
+	0xca, 0xd4, 0x6a, 0x61, 0x6d, 0xb1, 0x26, 0x0e, 0xae, 0xd6, 0x64, 0x74, 0xe1, 0x4e, 0x28, 0x45,
+	0x01, 0x43, 0xd3, 0xf6, 0x29, 0x20, 0x97, 0x02, 0x6c, 0x32, 0x7a, 0x08, 0x10, 0x52, 0x48, 0x82,
+	0x59, 0xe6, 0xa6, 0x47, 0xa4, 0xd9, 0xca, 0xd4, 0x6a, 0x5e, 0x09, 0x97, 0xe8, 0x55, 0x28, 0xb1,
+	0x4d, 0x62, 0x43, 0xd5, 0x0f, 0x34, 0xdb, 0xc6, 0x16, 0x91, 0xe6, 0xa8, 0x08, 0xdb, 0xdc, 0xa2,
+	0xe0, 0xb5, 0x04, 0x0b, 0xbd, 0x09, 0xf3, 0x42, 0x4c, 0x1d, 0x3a, 0x06, 0x96, 0xf2, 0x95, 0xcc,
+	0x6a, 0x71, 0xed, 0x46, 0x64, 0x5e, 0x08, 0x6e, 0x3a, 0x06, 0x56, 0x0a, 0x7a, 0xbc, 0x40, 0x2f,
+	0x43, 0x49, 0xb3, 0x2c, 0xe7, 0x28, 0x69, 0x07, 0x98, 0x2b, 0x8b, 0x82, 0x1e, 0xd9, 0x78, 0x00,
+	0x05, 0x4f, 0xf3, 0xb1, 0x6a, 0x99, 0x43, 0xd3, 0x27, 0x52, 0x81, 0xed, 0x10, 0x45, 0x26, 0x14,
+	0xcd, 0xc7, 0x5d, 0xca, 0x12, 0x9b, 0x04, 0x2f, 0x24, 0x10, 0xb4, 0x0e, 0xa5, 0x18, 0xaa, 0xee,
+	0x59, 0xce, 0x11, 0x91, 0xe6, 0x19, 0xfe, 0xd6, 0x38, 0x7e, 0xdd, 0x72, 0x8e, 0xc2, 0x83, 0xf6,
+	0x92, 0x44, 0xc2, 0x8e, 0xcd, 0x33, 0x75, 0xac, 0x12, 0xec, 0xb3, 0x30, 0x2f, 0x30, 0x57, 0xe7,
+	0x19, 0x71, 0xc0, 0x69, 0xe8, 0x21, 0xf0, 0xb5, 0xea, 0x69, 0xf6, 0x3e, 0x26, 0x52, 0x91, 0x19,
+	0xba, 0x1e, 0x19, 0xda, 0xa2, 0x4c, 0x85, 0xf2, 0x84, 0x95, 0x82, 0x1b, 0x51, 0x08, 0xda, 0x83,
+	0xeb, 0x43, 0xed, 0x58, 0xe5, 0x1a, 0x0c, 0x7c, 0x68, 0xb2, 0x54, 0x97, 0x16, 0x69, 0x7e, 0x34,
+	0xdf, 0xa0, 0xf2, 0xff, 0xf8, 0x72, 0xe5, 0x0e, 0x2f, 0x1b, 0x62, 0x3c, 0xa9, 0x99, 0x4e, 0x7d,
+	0xa8, 0xf9, 0x07, 0xb5, 0x2e, 0xde, 0xd7, 0xf4, 0x93, 0x36, 0xd6, 0xbf, 0xf8, 0xc3, 0xab, 0x20,
+	0xaa, 0xaa, 0x8d, 0xf5, 0x4f, 0xff, 0xfd, 0xfb, 0x57, 0x32, 0xca, 0x0b, 0x43, 0xed, 0x98, 0x99,
+	0x6d, 0x87, 0x0a, 0xd1, 0x03, 0x00, 0xe2, 0x6b, 0xbb, 0x16, 0xa6, 0xa5, 0x29, 0x95, 0x58, 0xfa,
+	0xc7, 0x3e, 0x0e, 0x22, 0x56, 0x18, 0xcd, 0x58, 0x18, 0x35, 0x60, 0x81, 0x04, 0xbb, 0x44, 0xf7,
+	0x4c, 0x97, 0xaa, 0x22, 0xd2, 0x0b, 0x6c, 0x87, 0x37, 0x63, 0x74, 0x82, 0x2b, 0xf0, 0xa3, 0x08,
+	0xf4, 0x1a, 0xdc, 0xb0, 0xf1, 0xb1, 0xaf, 0x26, 0xa9, 0xaa, 0x69, 0x48, 0xa8, 0x92, 0x59, 0x9d,
+	0x56, 0x10, 0xe5, 0x25, 0x95, 0x74, 0x0c, 0xf4, 0x0e, 0x14, 0x3c, 0x6c, 0xe0, 0xa1, 0x30, 0x79,
+	0x3d, 0x15, 0x54, 0x25, 0xe2, 0x85, 0x41, 0x4d, 0x48, 0xa3, 0xef, 0x02, 0x53, 0xa9, 0xc6, 0x34,
+	0x6a, 0xec, 0x06, 0x33, 0x56, 0xa2, 0x9c, 0x18, 0xde, 0x31, 0xd0, 0xdb, 0x50, 0x20, 0x47, 0x9a,
+	0xab, 0xea, 0x8e, 0xbd, 0x67, 0xee, 0x4b, 0x37, 0xd3, 0xb1, 0x39, 0xd2, 0xdc, 0x16, 0x63, 0x45,
+	0xb1, 0x89, 0x28, 0xe8, 0x45, 0x98, 0xa7, 0x2b, 0xa2, 0x8a, 0x86, 0x70, 0x8b, 0x36, 0x04, 0x85,
+	0xe9, 0x23, 0x5b, 0xbc, 0x23, 0x7c, 0x1f, 0x8a, 0x4c, 0xbd, 0x65, 0x7e, 0x14, 0x98, 0x86, 0xe9,
+	0x9f, 0x48, 0xb7, 0x99, 0x85, 0xa5, 0x9a, 0x38, 0x36, 0xda, 0x39, 0x6b, 0xa2, 0x73, 0xd6, 0x5a,
+	0xf4, 0x0c, 0xf2, 0xd4, 0x0e, 0x3f, 0xca, 0x05, 0x8a, 0xed, 0x86, 0x50, 0x9a, 0x6c, 0x4c, 0xd9,
+	0xa1, 0x63, 0x05, 0x43, 0x4c, 0x24, 0x29, 0x15, 0x17, 0xea, 0xec, 0x87, 0x8c, 0x17, 0xc6, 0x85,
+	0x44, 0x14, 0x82, 0x36, 0x60, 0x81, 0x16, 0x72, 0xe0, 0xaa, 0x2e, 0xf6, 0x4c, 0xc7, 0x90, 0x96,
+	0x84, 0x27, 0xbc, 0xa5, 0xd6, 0xc2, 0x96, 0x5a, 0x6b, 0x8b, 0x96, 0xdb, 0x9c, 0xa3, 0x4a, 0x7e,
+	0xfd, 0xcf, 0x95, 0x8c, 0x32, 0xcf, 0x91, 0x5b, 0x0c, 0x48, 0x8b, 0x53, 0xb4, 0x0b, 0xcb, 0xf1,
+	0x89, 0x54, 0x4e, 0x15, 0x67, 0x97, 0xf1, 0xba, 0x4e, 0x54, 0x9c, 0x56, 0x48, 0x20, 0xe8, 0x3e,
+	0xe4, 0x0c, 0x6c, 0x3b, 0x43, 0x22, 0xdd, 0x49, 0x39, 0xdf, 0xa6, 0xe4, 0x64, 0xe3, 0x15, 0x82,
+	0xe8, 0x7d, 0x28, 0xb2, 0x5f, 0xea, 0x10, 0xfb, 0x9a, 0xa1, 0xf9, 0x9a, 0x74, 0x97, 0x41, 0xef,
+	0xc5, 0x21, 0xb4, 0x9f, 0x44, 0x21, 0xdc, 0x14, 0x42, 0x61, 0x2a, 0x32, 0x68, 0x48, 0x44, 0x77,
+	0x21, 0xaf, 0xf9, 0x3e, 0x26, 0xbe, 0xe3, 0x11, 0xe9, 0x1e, 0xab, 0xe7, 0x98, 0x80, 0x1e, 0xc1,
+	0x3c, 0x5f, 0x68, 0x3c, 0xef, 0x96, 0x99, 0x9d, 0xb8, 0xb1, 0x35, 0x62, 0xa6, 0x50, 0x3f, 0x22,
+	0x8f, 0x5a, 0x50, 0xf4, 0x30, 0xc1, 0xde, 0x21, 0x0e, 0xd3, 0x69, 0x85, 0x85, 0x38, 0xd1, 0x77,
+	0x38, 0x7b, 0x24, 0xa3, 0x16, 0xbc, 0x24, 0x11, 0x2d, 0x03, 0x78, 0x58, 0x77, 0x0e, 0xb1, 0x47,
+	0x7b, 0x4e, 0x85, 0xf9, 0x98, 0xa0, 0xa0, 0xb7, 0x60, 0x81, 0xa7, 0x9b, 0x4a, 0x74, 0xc7, 0xc5,
+	0x44, 0x7a, 0xb1, 0x32, 0xb5, 0x5a, 0x4c, 0xb6, 0x1c, 0xca, 0x1d, 0x50, 0x9e, 0x32, 0xcf, 0x25,
+	0xd9, 0x82, 0xa0, 0x77, 0x05, 0x52, 0x3d, 0x32, 0x6d, 0x83, 0x76, 0xc5, 0x6a, 0x6a, 0x7f, 0x0c,
+	0xf9, 0x98, 0x31, 0xc3, 0xfd, 0xb9, 0x31, 0x89, 0x66, 0x50, 0x89, 0x2b, 0xc0, 0xc7, 0x51, 0x6d,
+	0xbe, 0xc4, 0x74, 0xdc, 0x1e, 0xd5, 0x21, 0x1f, 0x8f, 0xd6, 0xe7, 0xa2, 0x3b, 0x42, 0x25, 0x48,
+	0x85, 0xbb, 0x86, 0xe9, 0x61, 0x9d, 0xae, 0x34, 0x4b, 0x1d, 0xbb, 0x7d, 0xbe, 0x95, 0xd2, 0xda,
+	0x1c, 0xb9, 0x82, 0x84, 0xd6, 0x72, 0x42, 0xc5, 0xa8, 0x00, 0xa9, 0xfe, 0x2d, 0x03, 0x39, 0x7e,
+	0x6f, 0xd2, 0x7b, 0x4f, 0x33, 0x0c, 0x0f, 0x13, 0xc2, 0x2e, 0xfe, 0xbc, 0x12, 0x2e, 0x51, 0x0f,
+	0xf2, 0xec, 0xde, 0xd1, 0x6c, 0x1d, 0xb3, 0xdb, 0x3c, 0xdf, 0x7c, 0x4d, 0x34, 0xdd, 0x9b, 0xe3,
+	0x4d, 0xb7, 0x63, 0xfb, 0x89, 0x76, 0xdb, 0xb1, 0x7d, 0x5e, 0xa3, 0xb1, 0x0a, 0xf4, 0x10, 0x72,
+	0xf8, 0xd8, 0x35, 0xbd, 0x13, 0x76, 0xff, 0x17, 0xd6, 0xca, 0x63, 0xa5, 0xb5, 0x1d, 0x4e, 0x2b,
+	0xbc, 0xb6, 0x3e, 0xa6, 0xb5, 0x25, 0x30, 0xd4, 0x4f, 0xe2, 0x04, 0x9e, 0x8e, 0x89, 0x34, 0xcd,
+	0xef, 0x67, 0xb1, 0xac, 0x7e, 0x9e, 0x81, 0x1c, 0xbf, 0xd3, 0xbf, 0x31, 0x9b, 0xe1, 0x59, 0x6c,
+	0xba, 0x26, 0xb6, 0xfd, 0x70, 0x3f, 0x09, 0x4a, 0x55, 0x87, 0xe2, 0xe8, 0x91, 0xd1, 0x9d, 0x89,
+	0xe3, 0x0f, 0x77, 0x26, 0x96, 0xe8, 0x4d, 0xc8, 0x47, 0x27, 0xcd, 0x76, 0x56, 0x5c, 0x5b, 0x4a,
+	0x0f, 0x1b, 0xed, 0x50, 0x40, 0x89, 0x65, 0xab, 0x18, 0x8a, 0xa3, 0xe9, 0xf8, 0x8c, 0xf0, 0x3d,
+	0x18, 0x37, 0x72, 0x27, 0x32, 0x12, 0x29, 0x38, 0xd7, 0xcc, 0x7f, 0xa6, 0x00, 0xe2, 0xee, 0x45,
+	0x87, 0x40, 0xd6, 0x74, 0x84, 0x05, 0xbe, 0x18, 0x2f, 0xdb, 0xec, 0x65, 0xcb, 0xf6, 0x1b, 0x3a,
+	0x54, 0x6e, 0x5c, 0x30, 0x54, 0xfe, 0xdf, 0xb2, 0x1e, 0x9b, 0x37, 0xbf, 0x9d, 0x9c, 0xe0, 0x77,
+	0x9d, 0xc0, 0x36, 0xd8, 0xc8, 0x39, 0x97, 0x18, 0xcd, 0x9b, 0x94, 0x7a, 0x6e, 0x7f, 0x82, 0x89,
+	0xfa, 0xd3, 0x68, 0x13, 0x2e, 0xa4, 0x9b, 0x70, 0xf5, 0x57, 0x59, 0xc8, 0x47, 0x33, 0xe4, 0x33,
+	0x52, 0xf7, 0x1d, 0xc8, 0xf1, 0x66, 0xcb, 0x52, 0xea, 0x92, 0x97, 0xad, 0x80, 0xa0, 0x3e, 0x00,
+	0x9d, 0x0e, 0xb5, 0xa1, 0x13, 0xd8, 0x3e, 0x3f, 0xfd, 0x49, 0x4a, 0x7a, 0xa8, 0x1d, 0x37, 0x98,
+	0x0a, 0xf4, 0x63, 0x28, 0xb2, 0x71, 0x13, 0x7b, 0x3a, 0xb6, 0x7d, 0x6d, 0x1f, 0xf3, 0xa4, 0x99,
+	0x78, 0xd2, 0x5c, 0xa0, 0x93, 0x66, 0xa4, 0xac, 0xfa, 0x45, 0x16, 0x16, 0x46, 0x06, 0xeb, 0x67,
+	0x04, 0xe6, 0x3d, 0x98, 0xe7, 0xbb, 0xa4, 0x4f, 0x32, 0xcf, 0x17, 0xe1, 0xb9, 0x5c, 0x8f, 0x29,
+	0x70, 0xe4, 0x80, 0x02, 0xd1, 0x06, 0xe4, 0x4c, 0x9b, 0x0e, 0xf9, 0x13, 0x07, 0x48, 0xe0, 0xd1,
+	0xfb, 0x30, 0xeb, 0x04, 0x3e, 0x53, 0x35, 0x3d, 0xa1, 0xaa, 0x50, 0x01, 0xf5, 0x8a, 0x04, 0xae,
+	0x6b, 0x9d, 0x48, 0x33, 0x93, 0x7a, 0xc5, 0xf1, 0xd5, 0xbf, 0x66, 0x01, 0xe2, 0x47, 0x04, 0x6d,
+	0x06, 0xa6, 0x6d, 0xe0, 0x63, 0x16, 0xcf, 0x69, 0x85, 0x2f, 0xd0, 0xdb, 0x30, 0x73, 0xf5, 0x30,
+	0x72, 0x08, 0x7a, 0x03, 0xa6, 0xb0, 0x6d, 0x5c, 0xa9, 0xc9, 0x53, 0x00, 0xda, 0x01, 0x30, 0x34,
+	0xd3, 0x3a, 0x51, 0xe9, 0xb3, 0xe9, 0x39, 0x13, 0x29, 0xcf, 0x34, 0xd1, 0xdc, 0x41, 0x8f, 0xa1,
+	0xc0, 0xfc, 0xe2, 0x8f, 0x22, 0x11, 0xbe, 0x49, 0xf5, 0x02, 0x53, 0xc5, 0xc2, 0x57, 0xfd, 0x24,
+	0x0b, 0x85, 0xc4, 0x80, 0x73, 0x41, 0x9b, 0x7e, 0x19, 0x66, 0x58, 0x7f, 0x16, 0x57, 0xc0, 0xb9,
+	0xed, 0x99, 0x4b, 0xb0, 0xe9, 0x9f, 0x79, 0x7a, 0x80, 0xcd, 0xfd, 0x03, 0x5e, 0xa0, 0x53, 0x0a,
+	0xf7, 0x7e, 0x83, 0x91, 0x50, 0x0b, 0xb8, 0x07, 0xaa, 0x6f, 0x0e, 0x79, 0x8c, 0x2e, 0x1b, 0xe2,
+	0x3c, 0xc3, 0x51, 0x0e, 0xba, 0x07, 0x80, 0x6d, 0x23, 0xb4, 0x32, 0xc3, 0xac, 0xe4, 0xb1, 0x6d,
+	0x08, 0x1b, 0xef, 0xc2, 0x1c, 0x65, 0x33, 0x0b, 0xb9, 0x2b, 0x58, 0x98, 0xc5, 0xb6, 0x41, 0xe9,
+	0xd5, 0x47, 0x00, 0xf1, 0x0b, 0xf0, 0x82, 0xb0, 0x94, 0x61, 0x0e, 0x1f, 0xbb, 0x8e, 0x8d, 0x6d,
+	0x9e, 0x63, 0x0b, 0x4a, 0xb4, 0xae, 0xfe, 0x37, 0x0b, 0xf3, 0xc9, 0xf7, 0x1b, 0x2a, 0x42, 0xd6,
+	0x34, 0x44, 0x82, 0x66, 0x4d, 0x03, 0x21, 0x98, 0xa6, 0x57, 0x02, 0x1f, 0x4a, 0x14, 0xf6, 0x1b,
+	0x3d, 0x82, 0x59, 0x03, 0xbb, 0x0e, 0x31, 0x7d, 0x91, 0x79, 0x97, 0x7b, 0x10, 0x85, 0x20, 0x54,
+	0x83, 0x1c, 0x1d, 0xbb, 0x03, 0xc2, 0xa2, 0x5a, 0x1c, 0x19, 0xb1, 0x3f, 0x0a, 0x30, 0xff, 0x58,
+	0x13, 0x10, 0x45, 0x48, 0xa1, 0x2e, 0xcc, 0x7c, 0x1d, 0x09, 0xc5, 0x95, 0xd0, 0xf2, 0x66, 0x17,
+	0xa0, 0xc1, 0x22, 0x3e, 0x51, 0x79, 0x73, 0x3c, 0xcd, 0x10, 0xdd, 0xc3, 0x9a, 0x8f, 0x0d, 0x55,
+	0xf3, 0xa5, 0xd9, 0xab, 0x64, 0x88, 0xc0, 0x35, 0xfc, 0xea, 0xef, 0xb2, 0x00, 0xf1, 0xa3, 0xf6,
+	0x52, 0xf1, 0xdf, 0x80, 0xdc, 0x73, 0xde, 0x2b, 0x02, 0x7f, 0xe5, 0x93, 0x78, 0x08, 0x39, 0x57,
+	0x3b, 0x71, 0x02, 0x9e, 0xca, 0x97, 0x3d, 0x78, 0x81, 0x49, 0xc5, 0x2b, 0x37, 0x59, 0xbc, 0x7e,
+	0x9b, 0x01, 0x88, 0x1f, 0xf6, 0x68, 0x03, 0xa6, 0xf6, 0x30, 0xff, 0x2a, 0x38, 0x79, 0x66, 0x50,
+	0x15, 0xe8, 0x03, 0x28, 0xf0, 0x9e, 0xc8, 0xbe, 0x3d, 0x4d, 0x3c, 0x85, 0xf3, 0xc6, 0xca, 0xae,
+	0xd1, 0xea, 0x4f, 0x85, 0xaf, 0xfc, 0x15, 0xff, 0x8c, 0x01, 0xb6, 0x04, 0x53, 0x86, 0x76, 0xc2,
+	0x6c, 0x4e, 0x2b, 0xf4, 0x27, 0x3d, 0x63, 0xfe, 0xa5, 0x60, 0xf2, 0x33, 0xe6, 0xf8, 0xea, 0x9f,
+	0x32, 0x90, 0x8f, 0x5e, 0xf5, 0xcf, 0xf0, 0x21, 0xce, 0xaa, 0xec, 0x73, 0x66, 0x95, 0x0c, 0x85,
+	0xc0, 0xa6, 0xd3, 0x1d, 0x6f, 0x6c, 0x57, 0xb9, 0x9d, 0x80, 0x03, 0x59, 0x6f, 0xfb, 0x34, 0x0b,
+	0x85, 0xc4, 0xab, 0xfd, 0x82, 0xeb, 0xb3, 0x0c, 0x73, 0xe1, 0x47, 0x00, 0x51, 0x24, 0xd1, 0xfa,
+	0x6b, 0x2c, 0x94, 0x07, 0x30, 0xa3, 0x11, 0xd5, 0xd9, 0xbb, 0xd2, 0x3d, 0x30, 0xad, 0x91, 0xfe,
+	0x1e, 0x5a, 0x81, 0x82, 0x87, 0x5d, 0x87, 0xde, 0x35, 0x1a, 0x39, 0xe0, 0x3d, 0x8c, 0xce, 0xa3,
+	0x94, 0xb4, 0xa1, 0x91, 0x03, 0xd4, 0x80, 0xbc, 0xeb, 0x90, 0x09, 0xaa, 0x62, 0x8e, 0xc3, 0x1a,
+	0x7e, 0xf5, 0x2f, 0x19, 0x58, 0x18, 0xf9, 0x3c, 0x81, 0x34, 0x28, 0xe9, 0x8e, 0x65, 0x69, 0x3e,
+	0xf6, 0x34, 0x4b, 0x65, 0x63, 0xea, 0x73, 0x16, 0xc9, 0x62, 0xac, 0x4f, 0xa1, 0xea, 0xd0, 0x06,
+	0xd0, 0x19, 0x92, 0xce, 0x80, 0x16, 0xb6, 0x69, 0x42, 0x5d, 0x61, 0x4c, 0x9e, 0x1f, 0x6a, 0xc7,
+	0x83, 0x10, 0xf8, 0xca, 0x2f, 0xb3, 0x50, 0x4a, 0xbf, 0x05, 0x51, 0x13, 0xee, 0xb5, 0x36, 0x1a,
+	0xbd, 0x9e, 0xdc, 0x55, 0xdb, 0x1d, 0x45, 0x6e, 0x6d, 0x77, 0xfa, 0x3d, 0x75, 0xa7, 0x37, 0xd8,
+	0x92, 0x5b, 0x9d, 0xf5, 0x8e, 0xdc, 0x2e, 0x5d, 0x2b, 0xaf, 0x9c, 0x9e, 0x55, 0xee, 0xa4, 0x81,
+	0x3b, 0x36, 0x71, 0xb1, 0x6e, 0xee, 0x99, 0xd8, 0x40, 0x0f, 0xa1, 0x3c, 0xae, 0xa3, 0xbf, 0xb3,
+	0xdd, 0xec, 0xef, 0xf4, 0xda, 0xa5, 0x4c, 0xf9, 0xee, 0xe9, 0x59, 0x45, 0x4a, 0x2b, 0xe8, 0x07,
+	0x3e, 0x7b, 0xa8, 0xa0, 0xb7, 0x61, 0x69, 0x1c, 0xdd, 0xe9, 0x71, 0x70, 0xb6, 0x7c, 0xe7, 0xf4,
+	0xac, 0x72, 0x3b, 0x0d, 0xee, 0xd8, 0x1c, 0xfb, 0x3a, 0xdc, 0x1a, 0xc7, 0x36, 0xfb, 0xdb, 0x1b,
+	0xa5, 0xa9, 0xb2, 0x74, 0x7a, 0x56, 0xb9, 0x91, 0x06, 0x36, 0x1d, 0xff, 0xa0, 0x3c, 0xfd, 0xf3,
+	0xdf, 0x2c, 0x5f, 0x7b, 0xe5, 0x93, 0x2c, 0xa0, 0xf1, 0x57, 0x2b, 0x5a, 0x87, 0x15, 0xf9, 0x07,
+	0xf2, 0xe6, 0x16, 0x53, 0x75, 0x51, 0x48, 0x5e, 0x3c, 0x3d, 0xab, 0xdc, 0x1b, 0x07, 0x27, 0x83,
+	0xf2, 0x16, 0x48, 0xe7, 0xe9, 0x19, 0xc8, 0x2c, 0x24, 0xe5, 0xd3, 0xb3, 0xca, 0xad, 0x71, 0x05,
+	0x03, 0x3a, 0x36, 0x3e, 0x82, 0x3b, 0xe7, 0x21, 0x15, 0xb9, 0x25, 0x77, 0x3e, 0x94, 0x4b, 0xd9,
+	0xf2, 0xbd, 0xd3, 0xb3, 0xca, 0xd2, 0x39, 0x0f, 0x6e, 0xac, 0x63, 0xf3, 0x10, 0x5f, 0x64, 0x59,
+	0x84, 0xe5, 0x02, 0xcb, 0x89, 0xc0, 0xfc, 0x39, 0x03, 0x85, 0xc4, 0x3f, 0x28, 0xa8, 0xbe, 0x30,
+	0xc8, 0x9b, 0xfd, 0xb6, 0x9c, 0x0a, 0x05, 0xd3, 0x97, 0x10, 0x4f, 0xc6, 0x60, 0x0d, 0x6e, 0x8e,
+	0x20, 0xdb, 0x72, 0xef, 0x87, 0xdd, 0xce, 0x60, 0xbb, 0x94, 0x29, 0xdf, 0x3e, 0x3d, 0xab, 0x5c,
+	0x4f, 0xc0, 0xda, 0xd8, 0x3e, 0xa1, 0xcf, 0xd4, 0xe4, 0x91, 0x32, 0x4c, 0xa3, 0xdb, 0xed, 0x3f,
+	0x66, 0xa0, 0xec, 0xc8, 0x91, 0x52, 0x50, 0xc3, 0xb2, 0x9c, 0x23, 0x8a, 0x12, 0x9e, 0xff, 0x82,
+	0xbe, 0x04, 0xa2, 0x29, 0x14, 0xbd, 0x01, 0xb7, 0xb7, 0x1a, 0x3b, 0x03, 0x59, 0x1d, 0xb4, 0xfa,
+	0x5b, 0x69, 0xbf, 0x97, 0x4e, 0xcf, 0x2a, 0x37, 0x63, 0xe1, 0xa4, 0xdb, 0x6f, 0x82, 0x94, 0xc4,
+	0x75, 0x9a, 0x2d, 0x9a, 0xcd, 0xeb, 0xdd, 0xfe, 0xe3, 0x41, 0x29, 0x93, 0x06, 0x76, 0x9a, 0xad,
+	0x3e, 0x7f, 0xd2, 0x10, 0xba, 0xdf, 0x24, 0x70, 0x5b, 0x69, 0xf4, 0x06, 0xeb, 0xb2, 0x32, 0x28,
+	0x65, 0xf9, 0x7e, 0x63, 0xd4, 0xb6, 0xa7, 0xd9, 0x64, 0x0f, 0x7b, 0x04, 0xad, 0x42, 0x29, 0x89,
+	0xd9, 0xec, 0xf4, 0xb6, 0x4b, 0x53, 0x65, 0x74, 0x7a, 0x56, 0x29, 0xc6, 0xe2, 0x9b, 0xa6, 0xed,
+	0xa7, 0x25, 0x9b, 0x3b, 0x4a, 0xaf, 0x34, 0x9d, 0x96, 0x6c, 0x06, 0x9e, 0x2d, 0xa2, 0xf1, 0x47,
+	0xfa, 0xd8, 0x4c, 0x0e, 0x18, 0xb4, 0x50, 0x15, 0xf9, 0x83, 0x1d, 0x79, 0xb0, 0xad, 0x0e, 0xb6,
+	0x1b, 0xdb, 0x3b, 0x83, 0x54, 0x4c, 0x58, 0xa1, 0x8e, 0x40, 0x92, 0x61, 0x79, 0x1d, 0x6e, 0xa5,
+	0xd0, 0x5b, 0x72, 0xaf, 0xdd, 0xe9, 0xbd, 0x57, 0xca, 0xf0, 0x93, 0x19, 0x41, 0x6e, 0xf1, 0xaf,
+	0x2c, 0x34, 0x7b, 0x52, 0xa8, 0xf5, 0x9d, 0xee, 0x7a, 0xa7, 0xdb, 0x95, 0x69, 0x75, 0xb3, 0xec,
+	0x19, 0xc1, 0xad, 0x07, 0xd6, 0x9e, 0x69, 0x59, 0xd8, 0xa0, 0xc7, 0x97, 0x42, 0x2a, 0xf2, 0xfb,
+	0x72, 0x6b, 0x5b, 0x6e, 0x97, 0xa6, 0xf8, 0x29, 0x8c, 0x8e, 0x4f, 0xf8, 0x27, 0x58, 0xf7, 0x79,
+	0xe5, 0xa5, 0x70, 0xad, 0x46, 0xaf, 0x25, 0x33, 0x8b, 0xd3, 0xe7, 0x58, 0x6c, 0x69, 0xb6, 0x8e,
+	0xa9, 0x45, 0x1e, 0xb7, 0xa6, 0xfc, 0xd9, 0x57, 0xcb, 0x99, 0xcf, 0xbf, 0x5a, 0xce, 0xfc, 0xeb,
+	0xab, 0xe5, 0xcc, 0xc7, 0x4f, 0x97, 0xaf, 0x7d, 0xfe, 0x74, 0xf9, 0xda, 0xdf, 0x9f, 0x2e, 0x5f,
+	0xfb, 0xd1, 0x77, 0xf6, 0x4d, 0xff, 0x20, 0xd8, 0xad, 0xe9, 0xce, 0xb0, 0xee, 0xd8, 0x06, 0xff,
+	0xdf, 0xac, 0xee, 0x58, 0xf5, 0x80, 0x18, 0x27, 0xaf, 0xda, 0xce, 0xae, 0x85, 0xeb, 0x87, 0x6b,
+	0x75, 0xff, 0xc4, 0xc5, 0x64, 0x37, 0xc7, 0xb8, 0xdf, 0xfb, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0xfc, 0xe2, 0xc6, 0x9b, 0x75, 0x1e, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DirectionalBlockedChannels) > 0 {
+		for iNdEx := len(m.DirectionalBlockedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DirectionalBlockedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.PauseExemptions) > 0 {
+		for iNdEx := len(m.PauseExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PauseExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.PauseWindows) > 0 {
+		for iNdEx := len(m.PauseWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PauseWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.PausedScopes) > 0 {
+		dAtA2 := make([]byte, len(m.PausedScopes)*10)
+		var j1 int
+		for _, num := range m.PausedScopes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.Recoverers) > 0 {
+		for iNdEx := len(m.Recoverers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Recoverers[iNdEx])
+			copy(dAtA[i:], m.Recoverers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Recoverers[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	{
+		size, err := m.ReserveConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xfa
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.Attestors) > 0 {
+		for iNdEx := len(m.Attestors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Attestors[iNdEx])
+			copy(dAtA[i:], m.Attestors[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Attestors[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.DenomMetadata) > 0 {
+		for iNdEx := len(m.DenomMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.LockedLots) > 0 {
+		for iNdEx := len(m.LockedLots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedLots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LockupPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockupPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	if len(m.SwapVolumes) > 0 {
+		for iNdEx := len(m.SwapVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	{
+		size, err := m.SwapLiquidity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.SwapsPaused {
+		i--
+		if m.SwapsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	{
+		size, err := m.SwapConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.NextRedemptionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRedemptionId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.Redemptions) > 0 {
+		for iNdEx := len(m.Redemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.NextSubscriptionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextSubscriptionId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	{
+		size, err := m.Stablecoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.MaxPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.PriceRanges) > 0 {
+		for iNdEx := len(m.PriceRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PriceSetters) > 0 {
+		for iNdEx := len(m.PriceSetters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PriceSetters[iNdEx])
+			copy(dAtA[i:], m.PriceSetters[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PriceSetters[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RateLimitFlows) > 0 {
+		for iNdEx := len(m.RateLimitFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedChannels[iNdEx])
+			copy(dAtA[i:], m.AllowedChannels[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.ChannelMode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ChannelMode))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.BlockedChannels) > 0 {
+		for iNdEx := len(m.BlockedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedChannels[iNdEx])
+			copy(dAtA[i:], m.BlockedChannels[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BlockedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Pausers) > 0 {
+		for iNdEx := len(m.Pausers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pausers[iNdEx])
+			copy(dAtA[i:], m.Pausers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Pausers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Burners) > 0 {
+		for iNdEx := len(m.Burners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.BlocklistState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Burner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Burner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Burner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sources[iNdEx])
+			copy(dAtA[i:], m.Sources[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sources[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintGenesis(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Minter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Recipients[iNdEx])
+			copy(dAtA[i:], m.Recipients[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Recipients[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintGenesis(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockedChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockedChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Direction != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Direction != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recoverers) > 0 {
+		for iNdEx := len(m.Recoverers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Recoverers[iNdEx])
+			copy(dAtA[i:], m.Recoverers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Recoverers[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PauseExemptions) > 0 {
+		for iNdEx := len(m.PauseExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PauseExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.BlocklistBound {
+		i--
+		if m.BlocklistBound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.BlockedChannels) > 0 {
+		for iNdEx := len(m.BlockedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Pausers) > 0 {
+		for iNdEx := len(m.Pausers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pausers[iNdEx])
+			copy(dAtA[i:], m.Pausers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Pausers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Burners) > 0 {
+		for iNdEx := len(m.Burners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PausedScopes) > 0 {
+		dAtA12 := make([]byte, len(m.PausedScopes)*10)
+		var j11 int
+		for _, num := range m.PausedScopes {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintGenesis(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPercentage.Size()
+		i -= size
+		if _, err := m.MaxPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintGenesis(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintGenesis(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StartPrice.Size()
+		i -= size
+		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DailyRate.Size()
+		i -= size
+		if _, err := m.DailyRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintGenesis(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x1a
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintGenesis(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x12
+	if m.Index != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintGenesis(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x32
+	if m.EndHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintGenesis(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x22
+	if m.StartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Scope != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Scope))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Stablecoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stablecoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stablecoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exponent != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Subscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Subscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintGenesis(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Status != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Redemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Redemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintGenesis(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Payout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Status != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DailyLimit.Size()
+		i -= size
+		if _, err := m.DailyLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Day != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockedLot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockedLot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedLot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintGenesis(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Attestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PostedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PostedAt):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintGenesis(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x32
+	if len(m.ReportHash) > 0 {
+		i -= len(m.ReportHash)
+		copy(dAtA[i:], m.ReportHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ReportHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n25, err25 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AsOf, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AsOf):])
+	if err25 != nil {
+		return 0, err25
+	}
+	i -= n25
+	i = encodeVarintGenesis(dAtA, i, uint64(n25))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Attestor) > 0 {
+		i -= len(m.Attestor)
+		copy(dAtA[i:], m.Attestor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Attestor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReserveConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n26, err26 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxStaleness, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxStaleness):])
+	if err26 != nil {
+		return 0, err26
+	}
+	i -= n26
+	i = encodeVarintGenesis(dAtA, i, uint64(n26))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CollateralRatio.Size()
+		i -= size
+		if _, err := m.CollateralRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlocklistState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Burners) > 0 {
+		for _, e := range m.Burners {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Pausers) > 0 {
+		for _, s := range m.Pausers {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockedChannels) > 0 {
+		for _, s := range m.BlockedChannels {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ChannelMode != 0 {
+		n += 1 + sovGenesis(uint64(m.ChannelMode))
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, s := range m.AllowedChannels {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitFlows) > 0 {
+		for _, e := range m.RateLimitFlows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceSetters) > 0 {
+		for _, s := range m.PriceSetters {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceRanges) > 0 {
+		for _, e := range m.PriceRanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.MaxPriceDeviation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Stablecoin.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextSubscriptionId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextSubscriptionId))
+	}
+	if len(m.Redemptions) > 0 {
+		for _, e := range m.Redemptions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextRedemptionId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextRedemptionId))
+	}
+	l = m.SwapConfig.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.SwapsPaused {
+		n += 3
+	}
+	l = m.SwapLiquidity.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.SwapVolumes) > 0 {
+		for _, e := range m.SwapVolumes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockupPeriod)
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.LockedLots) > 0 {
+		for _, e := range m.LockedLots {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomMetadata) > 0 {
+		for _, e := range m.DenomMetadata {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Attestors) > 0 {
+		for _, s := range m.Attestors {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.ReserveConfig.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.Recoverers) > 0 {
+		for _, s := range m.Recoverers {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedScopes) > 0 {
+		l = 0
+		for _, e := range m.PausedScopes {
+			l += sovGenesis(uint64(e))
+		}
+		n += 2 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.PauseWindows) > 0 {
+		for _, e := range m.PauseWindows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PauseExemptions) > 0 {
+		for _, e := range m.PauseExemptions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DirectionalBlockedChannels) > 0 {
+		for _, e := range m.DirectionalBlockedChannels {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Burner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Sources) > 0 {
+		for _, s := range m.Sources {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Recipients) > 0 {
+		for _, s := range m.Recipients {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockedChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovGenesis(uint64(m.Direction))
+	}
+	return n
+}
+
+func (m *PauseExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovGenesis(uint64(m.Direction))
+	}
+	return n
+}
+
+func (m *DenomState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PausedScopes) > 0 {
+		l = 0
+		for _, e := range m.PausedScopes {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Burners) > 0 {
+		for _, e := range m.Burners {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Pausers) > 0 {
+		for _, s := range m.Pausers {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockedChannels) > 0 {
+		for _, e := range m.BlockedChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BlocklistBound {
+		n += 2
+	}
+	if len(m.PauseExemptions) > 0 {
+		for _, e := range m.PauseExemptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Recoverers) > 0 {
+		for _, s := range m.Recoverers {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxPercentage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RateLimitFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Inflow.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PriceRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovGenesis(uint64(m.Index))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DailyRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.StartPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PauseWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Scope != 0 {
+		n += 1 + sovGenesis(uint64(m.Scope))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.StartHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.EndHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.EndHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Stablecoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovGenesis(uint64(m.Exponent))
+	}
+	return n
+}
+
+func (m *Subscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovGenesis(uint64(m.Status))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Redemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovGenesis(uint64(m.Status))
+	}
+	l = m.Payout.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *SwapConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DailyLimit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *SwapVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Day != 0 {
+		n += 1 + sovGenesis(uint64(m.Day))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *LockedLot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Attestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovGenesis(uint64(m.Index))
+	}
+	l = len(m.Attestor)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AsOf)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.ReportHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PostedAt)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ReserveConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CollateralRatio.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxStaleness)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocklistState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlocklistState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burners = append(m.Burners, Burner{})
+			if err := m.Burners[len(m.Burners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, Minter{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pausers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pausers = append(m.Pausers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedChannels = append(m.BlockedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelMode", wireType)
+			}
+			m.ChannelMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelMode |= ChannelMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitFlows = append(m.RateLimitFlows, RateLimitFlow{})
+			if err := m.RateLimitFlows[len(m.RateLimitFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSetters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSetters = append(m.PriceSetters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceRanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceRanges = append(m.PriceRanges, PriceRange{})
+			if err := m.PriceRanges[len(m.PriceRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stablecoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stablecoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, Subscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSubscriptionId", wireType)
+			}
+			m.NextSubscriptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSubscriptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redemptions = append(m.Redemptions, Redemption{})
+			if err := m.Redemptions[len(m.Redemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRedemptionId", wireType)
+			}
+			m.NextRedemptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRedemptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapsPaused = bool(v != 0)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapLiquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapVolumes = append(m.SwapVolumes, SwapVolume{})
+			if err := m.SwapVolumes[len(m.SwapVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LockupPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedLots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedLots = append(m.LockedLots, LockedLot{})
+			if err := m.LockedLots[len(m.LockedLots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, DenomState{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomMetadata = append(m.DenomMetadata, types1.Metadata{})
+			if err := m.DenomMetadata[len(m.DenomMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestors = append(m.Attestors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recoverers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recoverers = append(m.Recoverers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 33:
+			if wireType == 0 {
+				var v PauseScope
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PauseScope(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PausedScopes = append(m.PausedScopes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PausedScopes) == 0 {
+					m.PausedScopes = make([]PauseScope, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PauseScope
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PauseScope(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PausedScopes = append(m.PausedScopes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedScopes", wireType)
+			}
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseWindows = append(m.PauseWindows, PauseWindow{})
+			if err := m.PauseWindows[len(m.PauseWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseExemptions = append(m.PauseExemptions, PauseExemption{})
+			if err := m.PauseExemptions[len(m.PauseExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectionalBlockedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DirectionalBlockedChannels = append(m.DirectionalBlockedChannels, BlockedChannel{})
+			if err := m.DirectionalBlockedChannels[len(m.DirectionalBlockedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Burner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Burner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Burner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockedChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockedChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= ChannelDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= ExemptionDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v PauseScope
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PauseScope(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PausedScopes = append(m.PausedScopes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PausedScopes) == 0 {
+					m.PausedScopes = make([]PauseScope, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PauseScope
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PauseScope(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PausedScopes = append(m.PausedScopes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedScopes", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burners = append(m.Burners, Burner{})
+			if err := m.Burners[len(m.Burners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, Minter{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pausers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pausers = append(m.Pausers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedChannels = append(m.BlockedChannels, BlockedChannel{})
+			if err := m.BlockedChannels[len(m.BlockedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocklistBound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlocklistBound = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseExemptions = append(m.PauseExemptions, PauseExemption{})
+			if err := m.PauseExemptions[len(m.PauseExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recoverers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recoverers = append(m.Recoverers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DailyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			m.Scope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scope |= PauseScope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Stablecoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stablecoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stablecoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Subscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Redemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DailyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *LockedLot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -565,17 +7836,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: LockedLot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LockedLot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocklistState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -585,48 +7856,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlocklistState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Paused = bool(v != 0)
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -654,13 +7904,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -670,29 +7922,80 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -702,31 +8005,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Burners = append(m.Burners, Burner{})
-			if err := m.Burners[len(m.Burners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -736,29 +8024,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Minters = append(m.Minters, Minter{})
-			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Attestor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pausers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -786,13 +8072,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pausers = append(m.Pausers, string(dAtA[iNdEx:postIndex]))
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockedChannels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AsOf", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -802,77 +8090,28 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockedChannels = append(m.BlockedChannels, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AsOf, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Burner) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Burner: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Burner: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -900,13 +8139,13 @@ func (m *Burner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ReportHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -916,23 +8155,22 @@ func (m *Burner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PostedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -957,7 +8195,7 @@ func (m *Burner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *ReserveConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -980,15 +8218,15 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReserveConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReserveConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1016,13 +8254,15 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.CollateralRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1032,23 +8272,22 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxStaleness, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7,11 +7,74 @@ const ModuleName = "aura"
 var ModuleAddress = authtypes.NewModuleAddress(ModuleName)
 
 var (
-	PausedKey            = []byte("paused")
-	OwnerKey             = []byte("owner")
-	PendingOwnerKey      = []byte("pending_owner")
-	BurnerPrefix         = []byte("burner/")
-	MinterPrefix         = []byte("minter/")
-	PauserPrefix         = []byte("pauser/")
-	BlockedChannelPrefix = []byte("blocked_channel/")
+	PausedPrefix           = []byte("denom/pause_scope/")
+	PauseWindowPrefix      = []byte("denom/pause_window/")
+	PauseWindowHeightIndex = []byte("denom/pause_window_by_height/")
+	PauseWindowTimeIndex   = []byte("denom/pause_window_by_time/")
+	PauseExemptionPrefix   = []byte("denom/pause_exemption/")
+	OwnerPrefix            = []byte("denom/owner/")
+	PendingOwnerPrefix     = []byte("denom/pending_owner/")
+	BurnerPrefix           = []byte("denom/burner/")
+	BurnerExpiryPrefix     = []byte("denom/burner_expiry/")
+	BurnerExpiryTimeIndex  = []byte("denom/burner_expiry_by_time/")
+	BurnerSourcePrefix     = []byte("denom/burner_source/")
+	MinterPrefix           = []byte("denom/minter/")
+	MinterExpiryPrefix     = []byte("denom/minter_expiry/")
+	MinterExpiryTimeIndex  = []byte("denom/minter_expiry_by_time/")
+	MinterRecipientPrefix  = []byte("denom/minter_recipient/")
+	PauserPrefix           = []byte("denom/pauser/")
+	RecovererPrefix        = []byte("denom/recoverer/")
+	BlockedChannelPrefix   = []byte("denom/blocked_channel/")
+	BlockedEscrowPrefix    = []byte("denom/blocked_escrow/")
+	BlocklistBindingPrefix = []byte("denom/blocklist_binding/")
+
+	ChannelModeKey       = []byte("channel_mode")
+	AllowedChannelPrefix = []byte("allowed_channel/")
+	RateLimitPrefix      = []byte("rate_limit/")
+	RateLimitFlowPrefix  = []byte("rate_limit_flow/")
+	TransferEscrowPrefix = []byte("transfer_escrow/")
+	IndexedChannelsKey   = []byte("indexed_channels")
+
+	PriceSetterPrefix    = []byte("price_setter/")
+	PriceRangePrefix     = []byte("price_range/")
+	MaxPriceDeviationKey = []byte("max_price_deviation")
+
+	StablecoinKey         = []byte("stablecoin")
+	SubscriptionPrefix    = []byte("subscription/")
+	NextSubscriptionIDKey = []byte("next_subscription_id")
+
+	RedemptionPrefix        = []byte("redemption/")
+	PendingRedemptionPrefix = []byte("pending_redemption/")
+	NextRedemptionIDKey     = []byte("next_redemption_id")
+
+	SwapConfigKey    = []byte("swap_config")
+	SwapsPausedKey   = []byte("swaps_paused")
+	SwapLiquidityKey = []byte("swap_liquidity")
+	SwapVolumePrefix = []byte("swap_volume/")
+
+	LockupPeriodKey = []byte("lockup_period")
+	LockedLotPrefix = []byte("locked_lot/")
+
+	AttestorPrefix    = []byte("attestor/")
+	AttestationPrefix = []byte("attestation/")
+	ReserveConfigKey  = []byte("reserve_config")
+)
+
+// Legacy keys of state that was moved under the default denom when this module
+// started governing multiple denoms. They're only used by migrations.
+var (
+	LegacyPausedKey            = []byte("paused")
+	LegacyOwnerKey             = []byte("owner")
+	LegacyPendingOwnerKey      = []byte("pending_owner")
+	LegacyBurnerPrefix         = []byte("burner/")
+	LegacyMinterPrefix         = []byte("minter/")
+	LegacyPauserPrefix         = []byte("pauser/")
+	LegacyBlockedChannelPrefix = []byte("blocked_channel/")
+	LegacyBlockedEscrowPrefix  = []byte("blocked_escrow/")
+)
+
+// Legacy keys of state that was replaced when pausing became scoped to
+// individual operations. They're only used by migrations.
+var (
+	LegacyDenomPausedPrefix = []byte("denom/paused/")
 )
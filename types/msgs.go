@@ -0,0 +1,798 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types/blocklist"
+)
+
+// ValidatableMsg is implemented by all messages of this module, allowing them
+// to be statelessly validated with the configured address codec before they
+// are executed.
+type ValidatableMsg interface {
+	Validate(cdc address.Codec) error
+}
+
+var (
+	_ ValidatableMsg = &MsgBurn{}
+	_ ValidatableMsg = &MsgMint{}
+	_ ValidatableMsg = &MsgPause{}
+	_ ValidatableMsg = &MsgUnpause{}
+	_ ValidatableMsg = &MsgSchedulePause{}
+	_ ValidatableMsg = &MsgTransferOwnership{}
+	_ ValidatableMsg = &MsgAcceptOwnership{}
+	_ ValidatableMsg = &MsgForceOwnership{}
+	_ ValidatableMsg = &MsgAddBurner{}
+	_ ValidatableMsg = &MsgRemoveBurner{}
+	_ ValidatableMsg = &MsgSetBurnerAllowance{}
+	_ ValidatableMsg = &MsgIncreaseBurnerAllowance{}
+	_ ValidatableMsg = &MsgDecreaseBurnerAllowance{}
+	_ ValidatableMsg = &MsgAddBurnerSource{}
+	_ ValidatableMsg = &MsgRemoveBurnerSource{}
+	_ ValidatableMsg = &MsgAddMinter{}
+	_ ValidatableMsg = &MsgRemoveMinter{}
+	_ ValidatableMsg = &MsgSetMinterAllowance{}
+	_ ValidatableMsg = &MsgIncreaseMinterAllowance{}
+	_ ValidatableMsg = &MsgDecreaseMinterAllowance{}
+	_ ValidatableMsg = &MsgAddMinterRecipient{}
+	_ ValidatableMsg = &MsgRemoveMinterRecipient{}
+	_ ValidatableMsg = &MsgAddPauser{}
+	_ ValidatableMsg = &MsgRemovePauser{}
+	_ ValidatableMsg = &MsgAddBlockedChannel{}
+	_ ValidatableMsg = &MsgRemoveBlockedChannel{}
+	_ ValidatableMsg = &MsgAddPauseExemption{}
+	_ ValidatableMsg = &MsgRemovePauseExemption{}
+	_ ValidatableMsg = &MsgSetChannelMode{}
+	_ ValidatableMsg = &MsgAddAllowedChannel{}
+	_ ValidatableMsg = &MsgRemoveAllowedChannel{}
+	_ ValidatableMsg = &MsgSetRateLimit{}
+	_ ValidatableMsg = &MsgRemoveRateLimit{}
+	_ ValidatableMsg = &MsgAddPriceSetter{}
+	_ ValidatableMsg = &MsgRemovePriceSetter{}
+	_ ValidatableMsg = &MsgSetPriceRange{}
+	_ ValidatableMsg = &MsgSetMaxPriceDeviation{}
+	_ ValidatableMsg = &MsgWrap{}
+	_ ValidatableMsg = &MsgUnwrap{}
+	_ ValidatableMsg = &MsgTransferRebasing{}
+	_ ValidatableMsg = &MsgSetStablecoin{}
+	_ ValidatableMsg = &MsgRequestSubscription{}
+	_ ValidatableMsg = &MsgFulfillSubscription{}
+	_ ValidatableMsg = &MsgRejectSubscription{}
+	_ ValidatableMsg = &MsgRequestRedemption{}
+	_ ValidatableMsg = &MsgCancelRedemption{}
+	_ ValidatableMsg = &MsgSettleRedemption{}
+	_ ValidatableMsg = &MsgRejectRedemption{}
+	_ ValidatableMsg = &MsgSwap{}
+	_ ValidatableMsg = &MsgSetSwapConfig{}
+	_ ValidatableMsg = &MsgFundSwapFacility{}
+	_ ValidatableMsg = &MsgWithdrawSwapFacility{}
+	_ ValidatableMsg = &MsgPauseSwaps{}
+	_ ValidatableMsg = &MsgUnpauseSwaps{}
+	_ ValidatableMsg = &MsgSetLockupPeriod{}
+	_ ValidatableMsg = &MsgSetBlocklistBinding{}
+	_ ValidatableMsg = &MsgSetDenomMetadata{}
+	_ ValidatableMsg = &MsgAddAttestor{}
+	_ ValidatableMsg = &MsgRemoveAttestor{}
+	_ ValidatableMsg = &MsgPostAttestation{}
+	_ ValidatableMsg = &MsgSetReserveConfig{}
+	_ ValidatableMsg = &MsgAddRecoverer{}
+	_ ValidatableMsg = &MsgRemoveRecoverer{}
+	_ ValidatableMsg = &MsgForceTransfer{}
+
+	_ ValidatableMsg = &blocklist.MsgTransferOwnership{}
+	_ ValidatableMsg = &blocklist.MsgAcceptOwnership{}
+	_ ValidatableMsg = &blocklist.MsgAddToBlocklist{}
+	_ ValidatableMsg = &blocklist.MsgRemoveFromBlocklist{}
+)
+
+func (msg *MsgBurn) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "account", msg.From); err != nil {
+		return err
+	}
+
+	return validatePositive("amount", msg.Amount)
+}
+
+func (msg *MsgMint) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "account", msg.To); err != nil {
+		return err
+	}
+
+	return validatePositive("amount", msg.Amount)
+}
+
+func (msg *MsgPause) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if !msg.Scope.IsValid() {
+		return fmt.Errorf("invalid pause scope (%d)", msg.Scope)
+	}
+	if msg.EndHeight < 0 {
+		return errors.New("end height cannot be negative")
+	}
+
+	return nil
+}
+
+func (msg *MsgUnpause) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if !msg.Scope.IsValid() {
+		return fmt.Errorf("invalid pause scope (%d)", msg.Scope)
+	}
+
+	return nil
+}
+
+func (msg *MsgSchedulePause) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+
+	if !msg.Scope.IsValid() {
+		return fmt.Errorf("invalid pause scope (%d)", msg.Scope)
+	}
+
+	window := PauseWindow{
+		Denom:       msg.Denom,
+		StartHeight: msg.StartHeight,
+		StartTime:   msg.StartTime,
+		EndHeight:   msg.EndHeight,
+		EndTime:     msg.EndTime,
+	}
+	if !window.HasStart() {
+		return errors.New("start height or time must be provided")
+	}
+
+	return window.ValidateBounds()
+}
+
+func (msg *MsgTransferOwnership) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+
+	return ValidateAddress(cdc, "owner", msg.NewOwner)
+}
+
+func (msg *MsgAcceptOwnership) Validate(cdc address.Codec) error {
+	return validateSigner(cdc, msg.Signer, msg.Denom)
+}
+
+func (msg *MsgForceOwnership) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if msg.Owner == "" && msg.BlocklistOwner == "" {
+		return errors.New("owner or blocklist owner must be provided")
+	}
+	if msg.Owner != "" {
+		if err := ValidateAddress(cdc, "owner", msg.Owner); err != nil {
+			return err
+		}
+	}
+	if msg.BlocklistOwner != "" {
+		if err := ValidateAddress(cdc, "blocklist owner", msg.BlocklistOwner); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (msg *MsgAddBurner) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "burner", msg.Burner); err != nil {
+		return err
+	}
+
+	return validateNonNegative("allowance", msg.Allowance)
+}
+
+func (msg *MsgRemoveBurner) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+
+	return ValidateAddress(cdc, "burner", msg.Burner)
+}
+
+func (msg *MsgSetBurnerAllowance) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "burner", msg.Burner); err != nil {
+		return err
+	}
+	if msg.ClearExpiry && !msg.Expiry.IsZero() {
+		return errors.New("expiry cannot be provided when clearing it")
+	}
+
+	return validateNonNegative("allowance", msg.Allowance)
+}
+
+func (msg *MsgIncreaseBurnerAllowance) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "burner", msg.Burner); err != nil {
+		return err
+	}
+
+	return validatePositive("amount", msg.Amount)
+}
+
+func (msg *MsgDecreaseBurnerAllowance) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "burner", msg.Burner); err != nil {
+		return err
+	}
+
+	return validatePositive("amount", msg.Amount)
+}
+
+func (msg *MsgAddBurnerSource) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "burner", msg.Burner); err != nil {
+		return err
+	}
+
+	return ValidateAddress(cdc, "source", msg.Source)
+}
+
+func (msg *MsgRemoveBurnerSource) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "burner", msg.Burner); err != nil {
+		return err
+	}
+
+	return ValidateAddress(cdc, "source", msg.Source)
+}
+
+func (msg *MsgAddMinter) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "minter", msg.Minter); err != nil {
+		return err
+	}
+
+	return validateNonNegative("allowance", msg.Allowance)
+}
+
+func (msg *MsgRemoveMinter) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+
+	return ValidateAddress(cdc, "minter", msg.Minter)
+}
+
+func (msg *MsgSetMinterAllowance) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "minter", msg.Minter); err != nil {
+		return err
+	}
+	if msg.ClearExpiry && !msg.Expiry.IsZero() {
+		return errors.New("expiry cannot be provided when clearing it")
+	}
+
+	return validateNonNegative("allowance", msg.Allowance)
+}
+
+func (msg *MsgIncreaseMinterAllowance) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "minter", msg.Minter); err != nil {
+		return err
+	}
+
+	return validatePositive("amount", msg.Amount)
+}
+
+func (msg *MsgDecreaseMinterAllowance) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "minter", msg.Minter); err != nil {
+		return err
+	}
+
+	return validatePositive("amount", msg.Amount)
+}
+
+func (msg *MsgAddMinterRecipient) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "minter", msg.Minter); err != nil {
+		return err
+	}
+
+	return ValidateAddress(cdc, "recipient", msg.Recipient)
+}
+
+func (msg *MsgRemoveMinterRecipient) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "minter", msg.Minter); err != nil {
+		return err
+	}
+
+	return ValidateAddress(cdc, "recipient", msg.Recipient)
+}
+
+func (msg *MsgAddPauser) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+
+	return ValidateAddress(cdc, "pauser", msg.Pauser)
+}
+
+func (msg *MsgRemovePauser) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+
+	return ValidateAddress(cdc, "pauser", msg.Pauser)
+}
+
+func (msg *MsgAddBlockedChannel) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if err := validateChannel(msg.Channel); err != nil {
+		return err
+	}
+	if !msg.Direction.IsValid() {
+		return fmt.Errorf("invalid channel direction (%d)", msg.Direction)
+	}
+
+	return nil
+}
+
+func (msg *MsgRemoveBlockedChannel) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+
+	return validateChannel(msg.Channel)
+}
+
+func (msg *MsgAddPauseExemption) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "exempt", msg.Address); err != nil {
+		return err
+	}
+	if !msg.Direction.IsValid() {
+		return fmt.Errorf("invalid exemption direction (%d)", msg.Direction)
+	}
+
+	return nil
+}
+
+func (msg *MsgRemovePauseExemption) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+
+	return ValidateAddress(cdc, "exempt", msg.Address)
+}
+
+func (msg *MsgSetChannelMode) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+	if !msg.Mode.IsValid() {
+		return fmt.Errorf("invalid channel mode (%d)", msg.Mode)
+	}
+
+	return nil
+}
+
+func (msg *MsgAddAllowedChannel) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+
+	return validateChannel(msg.Channel)
+}
+
+func (msg *MsgRemoveAllowedChannel) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+
+	return validateChannel(msg.Channel)
+}
+
+func (msg *MsgSetRateLimit) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+	if err := validateChannel(msg.Channel); err != nil {
+		return err
+	}
+
+	rateLimit := RateLimit{Window: msg.Window, MaxAmount: msg.MaxAmount, MaxPercentage: msg.MaxPercentage}
+	if rateLimit.MaxAmount.IsNil() {
+		rateLimit.MaxAmount = math.ZeroInt()
+	}
+	if rateLimit.MaxPercentage.IsNil() {
+		rateLimit.MaxPercentage = math.LegacyZeroDec()
+	}
+	if err := rateLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid rate limit: %s", err)
+	}
+
+	return nil
+}
+
+func (msg *MsgRemoveRateLimit) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+
+	return validateChannel(msg.Channel)
+}
+
+func (msg *MsgAddPriceSetter) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+
+	return ValidateAddress(cdc, "price setter", msg.PriceSetter)
+}
+
+func (msg *MsgRemovePriceSetter) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+
+	return ValidateAddress(cdc, "price setter", msg.PriceSetter)
+}
+
+func (msg *MsgSetPriceRange) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+	if msg.DailyRate.IsNil() || !msg.DailyRate.IsPositive() {
+		return errors.New("daily rate must be positive")
+	}
+	if !msg.StartPrice.IsNil() && msg.StartPrice.IsNegative() {
+		return errors.New("start price cannot be negative")
+	}
+
+	return nil
+}
+
+func (msg *MsgSetMaxPriceDeviation) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+	if msg.MaxPriceDeviation.IsNil() || !msg.MaxPriceDeviation.IsPositive() || msg.MaxPriceDeviation.GT(math.LegacyOneDec()) {
+		return errors.New("max price deviation must be between 0 and 1")
+	}
+
+	return nil
+}
+
+func (msg *MsgWrap) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+
+	return validatePositive("amount", msg.Amount)
+}
+
+func (msg *MsgUnwrap) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+
+	return validatePositive("amount", msg.Amount)
+}
+
+func (msg *MsgTransferRebasing) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "recipient", msg.Recipient); err != nil {
+		return err
+	}
+
+	return validatePositive("amount", msg.Amount)
+}
+
+func (msg *MsgSetStablecoin) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+	if err := (Stablecoin{Denom: msg.Denom, Exponent: msg.Exponent}).Validate(); err != nil {
+		return fmt.Errorf("invalid stablecoin: %s", err)
+	}
+
+	return nil
+}
+
+func (msg *MsgRequestSubscription) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+
+	return validatePositive("amount", msg.Amount)
+}
+
+func (msg *MsgFulfillSubscription) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+
+	if msg.Price.IsNil() || !msg.Price.IsPositive() {
+		return errors.New("price must be positive")
+	}
+
+	return nil
+}
+
+func (msg *MsgRejectSubscription) Validate(cdc address.Codec) error {
+	return validateSigner(cdc, msg.Signer, "")
+}
+
+func (msg *MsgRequestRedemption) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+
+	return validatePositive("amount", msg.Amount)
+}
+
+func (msg *MsgCancelRedemption) Validate(cdc address.Codec) error {
+	return validateSigner(cdc, msg.Signer, "")
+}
+
+func (msg *MsgSettleRedemption) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+	if !msg.Payout.IsNil() && msg.Payout.IsNegative() {
+		return errors.New("payout cannot be negative")
+	}
+
+	return nil
+}
+
+func (msg *MsgRejectRedemption) Validate(cdc address.Codec) error {
+	return validateSigner(cdc, msg.Signer, "")
+}
+
+func (msg *MsgSwap) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(msg.Amount.Denom); err != nil {
+		return fmt.Errorf("invalid denom (%s): %s", msg.Amount.Denom, err)
+	}
+	if err := validatePositive("amount", msg.Amount.Amount); err != nil {
+		return err
+	}
+	if !msg.MinAmountOut.IsNil() && msg.MinAmountOut.IsNegative() {
+		return errors.New("min amount out cannot be negative")
+	}
+
+	return nil
+}
+
+func (msg *MsgSetSwapConfig) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+	if err := (SwapConfig{Fee: msg.Fee, DailyLimit: msg.DailyLimit}).Validate(); err != nil {
+		return fmt.Errorf("invalid swap config: %s", err)
+	}
+
+	return nil
+}
+
+func (msg *MsgFundSwapFacility) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+
+	return validatePositive("amount", msg.Amount)
+}
+
+func (msg *MsgWithdrawSwapFacility) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+
+	return validatePositive("amount", msg.Amount)
+}
+
+func (msg *MsgPauseSwaps) Validate(cdc address.Codec) error {
+	return validateSigner(cdc, msg.Signer, "")
+}
+
+func (msg *MsgUnpauseSwaps) Validate(cdc address.Codec) error {
+	return validateSigner(cdc, msg.Signer, "")
+}
+
+func (msg *MsgSetLockupPeriod) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+	if msg.LockupPeriod < 0 {
+		return errors.New("lockup period can't be negative")
+	}
+
+	return nil
+}
+
+func (msg *MsgSetBlocklistBinding) Validate(cdc address.Codec) error {
+	return validateSigner(cdc, msg.Signer, msg.Denom)
+}
+
+func (msg *MsgSetDenomMetadata) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+	if err := msg.Metadata.Validate(); err != nil {
+		return fmt.Errorf("invalid denom metadata (%s): %s", msg.Metadata.Base, err)
+	}
+
+	return nil
+}
+
+func (msg *MsgAddAttestor) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+
+	return ValidateAddress(cdc, "attestor", msg.Attestor)
+}
+
+func (msg *MsgRemoveAttestor) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+
+	return ValidateAddress(cdc, "attestor", msg.Attestor)
+}
+
+func (msg *MsgPostAttestation) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+	if err := validateNonNegative("amount", msg.Amount); err != nil {
+		return err
+	}
+	if msg.ReportHash == "" {
+		return errors.New("report hash must be provided")
+	}
+
+	return nil
+}
+
+func (msg *MsgSetReserveConfig) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, ""); err != nil {
+		return err
+	}
+	if err := (ReserveConfig{CollateralRatio: msg.CollateralRatio, MaxStaleness: msg.MaxStaleness}).Validate(); err != nil {
+		return fmt.Errorf("invalid reserve config: %s", err)
+	}
+
+	return nil
+}
+
+func (msg *MsgAddRecoverer) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+
+	return ValidateAddress(cdc, "recoverer", msg.Recoverer)
+}
+
+func (msg *MsgRemoveRecoverer) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+
+	return ValidateAddress(cdc, "recoverer", msg.Recoverer)
+}
+
+func (msg *MsgForceTransfer) Validate(cdc address.Codec) error {
+	if err := validateSigner(cdc, msg.Signer, msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "account", msg.From); err != nil {
+		return err
+	}
+	if err := ValidateAddress(cdc, "account", msg.To); err != nil {
+		return err
+	}
+	if msg.CaseReference == "" {
+		return errors.New("case reference must be provided")
+	}
+
+	return validatePositive("amount", msg.Amount)
+}
+
+//
+
+// ValidateAddress returns an error if an address can't be decoded with the
+// provided codec.
+func ValidateAddress(cdc address.Codec, name string, address string) error {
+	if _, err := cdc.StringToBytes(address); err != nil {
+		return fmt.Errorf("invalid %s address (%s): %s", name, address, err)
+	}
+
+	return nil
+}
+
+// validateSigner returns an error if the signer, or the optional denom of a
+// message, is invalid.
+func validateSigner(cdc address.Codec, signer string, denom string) error {
+	if err := ValidateAddress(cdc, "signer", signer); err != nil {
+		return err
+	}
+	if denom != "" {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid denom (%s): %s", denom, err)
+		}
+	}
+
+	return nil
+}
+
+func validateChannel(channel string) error {
+	if !channeltypes.IsValidChannelID(channel) {
+		return fmt.Errorf("invalid channel (%s)", channel)
+	}
+
+	return nil
+}
+
+func validatePositive(name string, amount math.Int) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return fmt.Errorf("%s must be positive", name)
+	}
+
+	return nil
+}
+
+func validateNonNegative(name string, amount math.Int) error {
+	if amount.IsNil() || amount.IsNegative() {
+		return fmt.Errorf("%s must be non-negative", name)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// Day is the period over which the daily rate of a price range accrues.
+const Day = 24 * time.Hour
+
+// DefaultMaxPriceDeviation is the maximum price deviation used if none has
+// been configured, allowing a 1% change per day.
+var DefaultMaxPriceDeviation = math.LegacyNewDecWithPrec(1, 2)
+
+// PriceAt returns the price of the range at the provided time, accruing the
+// daily rate for every full day elapsed since the start of the range. Times
+// outside the range are clamped to its start and end.
+func (r PriceRange) PriceAt(t time.Time) math.LegacyDec {
+	if t.Before(r.Start) {
+		t = r.Start
+	}
+	if t.After(r.End) {
+		t = r.End
+	}
+
+	days := uint64(t.Sub(r.Start) / Day)
+	return r.StartPrice.Mul(r.DailyRate.Power(days))
+}
+
+// ClosePrice returns the price of the range at its end.
+func (r PriceRange) ClosePrice() math.LegacyDec {
+	return r.PriceAt(r.End)
+}
+
+// Deviation returns the relative difference between a price and a reference.
+func Deviation(price math.LegacyDec, reference math.LegacyDec) math.LegacyDec {
+	return price.Sub(reference).Abs().Quo(reference)
+}
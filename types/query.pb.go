@@ -5,24 +5,32 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -110,14 +118,103 @@ func (m *QueryDenomResponse) GetDenom() string {
 	return ""
 }
 
+type QueryDenoms struct {
+}
+
+func (m *QueryDenoms) Reset()         { *m = QueryDenoms{} }
+func (m *QueryDenoms) String() string { return proto.CompactTextString(m) }
+func (*QueryDenoms) ProtoMessage()    {}
+func (*QueryDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{2}
+}
+func (m *QueryDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenoms.Merge(m, src)
+}
+func (m *QueryDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenoms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenoms proto.InternalMessageInfo
+
+type QueryDenomsResponse struct {
+	Denoms         []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	BlocklistBound []string `protobuf:"bytes,2,rep,name=blocklist_bound,json=blocklistBound,proto3" json:"blocklist_bound,omitempty"`
+}
+
+func (m *QueryDenomsResponse) Reset()         { *m = QueryDenomsResponse{} }
+func (m *QueryDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomsResponse) ProtoMessage()    {}
+func (*QueryDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{3}
+}
+func (m *QueryDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomsResponse.Merge(m, src)
+}
+func (m *QueryDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryDenomsResponse) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *QueryDenomsResponse) GetBlocklistBound() []string {
+	if m != nil {
+		return m.BlocklistBound
+	}
+	return nil
+}
+
 type QueryPaused struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryPaused) Reset()         { *m = QueryPaused{} }
 func (m *QueryPaused) String() string { return proto.CompactTextString(m) }
 func (*QueryPaused) ProtoMessage()    {}
 func (*QueryPaused) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2046dd993d22edf0, []int{2}
+	return fileDescriptor_2046dd993d22edf0, []int{4}
 }
 func (m *QueryPaused) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,15 +243,27 @@ func (m *QueryPaused) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryPaused proto.InternalMessageInfo
 
+func (m *QueryPaused) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryPausedResponse struct {
+	// paused is whether any operation of the denom is paused.
 	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// scopes are the paused operations of the denom.
+	Scopes []PauseScope `protobuf:"varint,2,rep,packed,name=scopes,proto3,enum=aura.v1.PauseScope" json:"scopes,omitempty"`
+	// windows are the scheduled starts and ends of pauses of the denom.
+	Windows []PauseWindow `protobuf:"bytes,3,rep,name=windows,proto3" json:"windows"`
 }
 
 func (m *QueryPausedResponse) Reset()         { *m = QueryPausedResponse{} }
 func (m *QueryPausedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPausedResponse) ProtoMessage()    {}
 func (*QueryPausedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2046dd993d22edf0, []int{3}
+	return fileDescriptor_2046dd993d22edf0, []int{5}
 }
 func (m *QueryPausedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,14 +299,29 @@ func (m *QueryPausedResponse) GetPaused() bool {
 	return false
 }
 
+func (m *QueryPausedResponse) GetScopes() []PauseScope {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *QueryPausedResponse) GetWindows() []PauseWindow {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
 type QueryOwner struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryOwner) Reset()         { *m = QueryOwner{} }
 func (m *QueryOwner) String() string { return proto.CompactTextString(m) }
 func (*QueryOwner) ProtoMessage()    {}
 func (*QueryOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2046dd993d22edf0, []int{4}
+	return fileDescriptor_2046dd993d22edf0, []int{6}
 }
 func (m *QueryOwner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,6 +350,13 @@ func (m *QueryOwner) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryOwner proto.InternalMessageInfo
 
+func (m *QueryOwner) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryOwnerResponse struct {
 	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	PendingOwner string `protobuf:"bytes,2,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
@@ -235,7 +366,7 @@ func (m *QueryOwnerResponse) Reset()         { *m = QueryOwnerResponse{} }
 func (m *QueryOwnerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOwnerResponse) ProtoMessage()    {}
 func (*QueryOwnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2046dd993d22edf0, []int{5}
+	return fileDescriptor_2046dd993d22edf0, []int{7}
 }
 func (m *QueryOwnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,13 +410,14 @@ func (m *QueryOwnerResponse) GetPendingOwner() string {
 }
 
 type QueryBurners struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryBurners) Reset()         { *m = QueryBurners{} }
 func (m *QueryBurners) String() string { return proto.CompactTextString(m) }
 func (*QueryBurners) ProtoMessage()    {}
 func (*QueryBurners) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2046dd993d22edf0, []int{6}
+	return fileDescriptor_2046dd993d22edf0, []int{8}
 }
 func (m *QueryBurners) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -314,6 +446,13 @@ func (m *QueryBurners) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBurners proto.InternalMessageInfo
 
+func (m *QueryBurners) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryBurnersResponse struct {
 	Burners []Burner `protobuf:"bytes,1,rep,name=burners,proto3" json:"burners"`
 }
@@ -322,7 +461,7 @@ func (m *QueryBurnersResponse) Reset()         { *m = QueryBurnersResponse{} }
 func (m *QueryBurnersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBurnersResponse) ProtoMessage()    {}
 func (*QueryBurnersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2046dd993d22edf0, []int{7}
+	return fileDescriptor_2046dd993d22edf0, []int{9}
 }
 func (m *QueryBurnersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,13 +498,14 @@ func (m *QueryBurnersResponse) GetBurners() []Burner {
 }
 
 type QueryMinters struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryMinters) Reset()         { *m = QueryMinters{} }
 func (m *QueryMinters) String() string { return proto.CompactTextString(m) }
 func (*QueryMinters) ProtoMessage()    {}
 func (*QueryMinters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2046dd993d22edf0, []int{8}
+	return fileDescriptor_2046dd993d22edf0, []int{10}
 }
 func (m *QueryMinters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,6 +534,13 @@ func (m *QueryMinters) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryMinters proto.InternalMessageInfo
 
+func (m *QueryMinters) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryMintersResponse struct {
 	Minters []Minter `protobuf:"bytes,1,rep,name=minters,proto3" json:"minters"`
 }
@@ -402,7 +549,7 @@ func (m *QueryMintersResponse) Reset()         { *m = QueryMintersResponse{} }
 func (m *QueryMintersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMintersResponse) ProtoMessage()    {}
 func (*QueryMintersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2046dd993d22edf0, []int{9}
+	return fileDescriptor_2046dd993d22edf0, []int{11}
 }
 func (m *QueryMintersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,13 +586,14 @@ func (m *QueryMintersResponse) GetMinters() []Minter {
 }
 
 type QueryPausers struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryPausers) Reset()         { *m = QueryPausers{} }
 func (m *QueryPausers) String() string { return proto.CompactTextString(m) }
 func (*QueryPausers) ProtoMessage()    {}
 func (*QueryPausers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2046dd993d22edf0, []int{10}
+	return fileDescriptor_2046dd993d22edf0, []int{12}
 }
 func (m *QueryPausers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,6 +622,13 @@ func (m *QueryPausers) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryPausers proto.InternalMessageInfo
 
+func (m *QueryPausers) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryPausersResponse struct {
 	Pausers []string `protobuf:"bytes,1,rep,name=pausers,proto3" json:"pausers,omitempty"`
 }
@@ -482,7 +637,7 @@ func (m *QueryPausersResponse) Reset()         { *m = QueryPausersResponse{} }
 func (m *QueryPausersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPausersResponse) ProtoMessage()    {}
 func (*QueryPausersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2046dd993d22edf0, []int{11}
+	return fileDescriptor_2046dd993d22edf0, []int{13}
 }
 func (m *QueryPausersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,13 +674,14 @@ func (m *QueryPausersResponse) GetPausers() []string {
 }
 
 type QueryBlockedChannels struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryBlockedChannels) Reset()         { *m = QueryBlockedChannels{} }
 func (m *QueryBlockedChannels) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockedChannels) ProtoMessage()    {}
 func (*QueryBlockedChannels) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2046dd993d22edf0, []int{12}
+	return fileDescriptor_2046dd993d22edf0, []int{14}
 }
 func (m *QueryBlockedChannels) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,15 +710,22 @@ func (m *QueryBlockedChannels) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBlockedChannels proto.InternalMessageInfo
 
+func (m *QueryBlockedChannels) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryBlockedChannelsResponse struct {
-	BlockedChannels []string `protobuf:"bytes,1,rep,name=blocked_channels,json=blockedChannels,proto3" json:"blocked_channels,omitempty"`
+	BlockedChannels []BlockedChannel `protobuf:"bytes,1,rep,name=blocked_channels,json=blockedChannels,proto3" json:"blocked_channels"`
 }
 
 func (m *QueryBlockedChannelsResponse) Reset()         { *m = QueryBlockedChannelsResponse{} }
 func (m *QueryBlockedChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockedChannelsResponse) ProtoMessage()    {}
 func (*QueryBlockedChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2046dd993d22edf0, []int{13}
+	return fileDescriptor_2046dd993d22edf0, []int{15}
 }
 func (m *QueryBlockedChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,953 +754,11156 @@ func (m *QueryBlockedChannelsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBlockedChannelsResponse proto.InternalMessageInfo
 
-func (m *QueryBlockedChannelsResponse) GetBlockedChannels() []string {
+func (m *QueryBlockedChannelsResponse) GetBlockedChannels() []BlockedChannel {
 	if m != nil {
 		return m.BlockedChannels
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryDenom)(nil), "aura.v1.QueryDenom")
-	proto.RegisterType((*QueryDenomResponse)(nil), "aura.v1.QueryDenomResponse")
-	proto.RegisterType((*QueryPaused)(nil), "aura.v1.QueryPaused")
-	proto.RegisterType((*QueryPausedResponse)(nil), "aura.v1.QueryPausedResponse")
-	proto.RegisterType((*QueryOwner)(nil), "aura.v1.QueryOwner")
-	proto.RegisterType((*QueryOwnerResponse)(nil), "aura.v1.QueryOwnerResponse")
-	proto.RegisterType((*QueryBurners)(nil), "aura.v1.QueryBurners")
-	proto.RegisterType((*QueryBurnersResponse)(nil), "aura.v1.QueryBurnersResponse")
-	proto.RegisterType((*QueryMinters)(nil), "aura.v1.QueryMinters")
-	proto.RegisterType((*QueryMintersResponse)(nil), "aura.v1.QueryMintersResponse")
-	proto.RegisterType((*QueryPausers)(nil), "aura.v1.QueryPausers")
-	proto.RegisterType((*QueryPausersResponse)(nil), "aura.v1.QueryPausersResponse")
-	proto.RegisterType((*QueryBlockedChannels)(nil), "aura.v1.QueryBlockedChannels")
-	proto.RegisterType((*QueryBlockedChannelsResponse)(nil), "aura.v1.QueryBlockedChannelsResponse")
+type QueryPauseExemptions struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func init() { proto.RegisterFile("aura/v1/query.proto", fileDescriptor_2046dd993d22edf0) }
-
-var fileDescriptor_2046dd993d22edf0 = []byte{
-	// 600 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0x9b, 0x41, 0x5b, 0xe6, 0x75, 0x6b, 0x71, 0xbb, 0xa9, 0xa4, 0x5d, 0x98, 0x82, 0x40,
-	0x63, 0x68, 0x35, 0x2b, 0x6f, 0x50, 0x40, 0x88, 0x03, 0x1a, 0xf4, 0xc0, 0x61, 0x07, 0xa6, 0xa4,
-	0xb1, 0xb2, 0x88, 0xd6, 0x0e, 0x71, 0x52, 0xd4, 0x13, 0x12, 0x27, 0x8e, 0x48, 0xbc, 0x08, 0x07,
-	0x1e, 0x62, 0xc7, 0x49, 0x5c, 0x38, 0x21, 0xd4, 0x22, 0xf1, 0x1a, 0x28, 0xb6, 0xe3, 0x34, 0xa6,
-	0xe5, 0x96, 0xff, 0xff, 0xfb, 0xbe, 0xdf, 0x67, 0xbb, 0x7f, 0x15, 0x34, 0x9d, 0x24, 0x72, 0xd0,
-	0xf4, 0x04, 0xbd, 0x4b, 0x70, 0x34, 0xeb, 0x85, 0x11, 0x8d, 0x29, 0xac, 0xa6, 0x66, 0x6f, 0x7a,
-	0x62, 0xee, 0x66, 0x55, 0x1f, 0x13, 0xcc, 0x02, 0x26, 0xea, 0x66, 0x67, 0x44, 0xd9, 0x84, 0x32,
-	0x31, 0xa3, 0x0d, 0x9b, 0x2d, 0x9f, 0xfa, 0x94, 0x7f, 0xa2, 0xf4, 0x4b, 0xba, 0x5d, 0x9f, 0x52,
-	0x7f, 0x8c, 0x91, 0x13, 0x06, 0xc8, 0x21, 0x84, 0xc6, 0x4e, 0x1c, 0x50, 0x22, 0x81, 0x76, 0x0d,
-	0x80, 0x57, 0x29, 0xe2, 0x09, 0x26, 0x74, 0x62, 0x1f, 0x01, 0x98, 0xab, 0x21, 0x66, 0x21, 0x25,
-	0x0c, 0xc3, 0x16, 0x28, 0x7b, 0xa9, 0xd1, 0x36, 0x0e, 0x8c, 0xc3, 0xcd, 0xa1, 0x10, 0xf6, 0x36,
-	0xd8, 0xe2, 0xbd, 0x2f, 0x9d, 0x84, 0x61, 0xcf, 0x3e, 0x06, 0xcd, 0x25, 0xa9, 0x66, 0xf7, 0x40,
-	0x25, 0xe4, 0x0e, 0x1f, 0xbe, 0x31, 0x94, 0x4a, 0xed, 0x3d, 0x7d, 0x4f, 0x70, 0x64, 0x9f, 0xca,
-	0xbd, 0x5c, 0x2d, 0xef, 0xa5, 0xa9, 0x91, 0xed, 0xe5, 0x02, 0xde, 0x01, 0xdb, 0x21, 0x26, 0x5e,
-	0x40, 0xfc, 0x73, 0x51, 0xdd, 0xe0, 0xd5, 0x9a, 0x34, 0x05, 0x70, 0x07, 0xd4, 0x38, 0x70, 0x90,
-	0x44, 0x04, 0x47, 0xcc, 0x7e, 0x06, 0x5a, 0xcb, 0x5a, 0xad, 0x40, 0xa0, 0xea, 0x0a, 0xab, 0x6d,
-	0x1c, 0x5c, 0x3b, 0xdc, 0xea, 0xd7, 0x7b, 0xf2, 0x17, 0xe8, 0x89, 0xd6, 0xc1, 0xf5, 0xcb, 0x9f,
-	0xb7, 0x4b, 0xc3, 0xac, 0x4b, 0x81, 0x5f, 0x04, 0x24, 0x5e, 0x06, 0x4b, 0xbd, 0x0c, 0x9e, 0x08,
-	0xeb, 0x1f, 0xb0, 0x68, 0xcd, 0xc0, 0xb2, 0x4b, 0x81, 0xf9, 0xfb, 0x45, 0xcc, 0x7e, 0x28, 0xc1,
-	0x52, 0x2b, 0x70, 0x1b, 0x54, 0x43, 0x61, 0x71, 0xf0, 0xe6, 0x30, 0x93, 0xf6, 0x5e, 0x76, 0xc7,
-	0x31, 0x1d, 0xbd, 0xc5, 0xde, 0xe3, 0x0b, 0x87, 0x10, 0x3c, 0x66, 0xf6, 0x73, 0xd0, 0x5d, 0xe5,
-	0x2b, 0xe2, 0x7d, 0xd0, 0x70, 0x45, 0xe9, 0x7c, 0x24, 0x6b, 0x12, 0x5d, 0x77, 0x8b, 0x23, 0xfd,
-	0x6f, 0x65, 0x50, 0xe6, 0x2c, 0xf8, 0x1a, 0x94, 0x79, 0x48, 0x60, 0x53, 0xdd, 0x2b, 0x4f, 0x8e,
-	0xd9, 0x59, 0x61, 0x66, 0xfb, 0xec, 0xce, 0xa7, 0x3f, 0x5f, 0x8f, 0x8c, 0x8f, 0xdf, 0x7f, 0x7f,
-	0xd9, 0x68, 0xc0, 0x1d, 0x94, 0x05, 0x9d, 0xa7, 0x0a, 0x9e, 0x81, 0x8a, 0x48, 0x10, 0x6c, 0x15,
-	0x19, 0xc2, 0x35, 0xbb, 0xab, 0x5c, 0x85, 0xee, 0xe6, 0xe8, 0x9b, 0xb0, 0xae, 0xd0, 0x22, 0x73,
-	0xe9, 0x99, 0x79, 0x3a, 0xf4, 0x33, 0x73, 0x53, 0x3f, 0x73, 0x21, 0x8a, 0xeb, 0xce, 0x2c, 0x12,
-	0xf9, 0x06, 0x54, 0x65, 0xae, 0xe0, 0x6e, 0x11, 0x22, 0x6d, 0x73, 0x7f, 0xa5, 0xad, 0xe8, 0xfb,
-	0x39, 0x1d, 0xc2, 0x86, 0xa2, 0xcb, 0xcc, 0xa5, 0x7c, 0x19, 0x2f, 0x9d, 0x2f, 0x6d, 0x9d, 0xaf,
-	0x85, 0x71, 0x1d, 0x5f, 0x46, 0x2f, 0xe5, 0xcb, 0x94, 0xe9, 0x7c, 0x69, 0xeb, 0x7c, 0x2d, 0x93,
-	0xeb, 0xf8, 0x32, 0x98, 0xf0, 0x03, 0xa8, 0x6b, 0xd9, 0x83, 0xfa, 0x83, 0x14, 0xcb, 0xe6, 0xdd,
-	0xff, 0x96, 0xd5, 0xde, 0x7b, 0xf9, 0xde, 0x0e, 0xbc, 0x95, 0xbf, 0x9b, 0x96, 0xe6, 0xc1, 0xd3,
-	0xcb, 0xb9, 0x65, 0x5c, 0xcd, 0x2d, 0xe3, 0xd7, 0xdc, 0x32, 0x3e, 0x2f, 0xac, 0xd2, 0xd5, 0xc2,
-	0x2a, 0xfd, 0x58, 0x58, 0xa5, 0xb3, 0x07, 0x7e, 0x10, 0x5f, 0x24, 0x6e, 0x6f, 0x44, 0x27, 0x88,
-	0x12, 0x4f, 0xfc, 0x7b, 0x8e, 0xe8, 0x18, 0x25, 0xcc, 0x9b, 0x1d, 0x13, 0xea, 0x8e, 0x31, 0x9a,
-	0xf6, 0x51, 0x3c, 0x0b, 0x31, 0x73, 0x2b, 0xbc, 0xfa, 0xe8, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xc0, 0xe1, 0x3c, 0x94, 0xba, 0x05, 0x00, 0x00,
+func (m *QueryPauseExemptions) Reset()         { *m = QueryPauseExemptions{} }
+func (m *QueryPauseExemptions) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseExemptions) ProtoMessage()    {}
+func (*QueryPauseExemptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{16}
+}
+func (m *QueryPauseExemptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseExemptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseExemptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseExemptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseExemptions.Merge(m, src)
+}
+func (m *QueryPauseExemptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseExemptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseExemptions.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPauseExemptions proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Denom(ctx context.Context, in *QueryDenom, opts ...grpc.CallOption) (*QueryDenomResponse, error)
-	Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error)
-	Owner(ctx context.Context, in *QueryOwner, opts ...grpc.CallOption) (*QueryOwnerResponse, error)
-	Burners(ctx context.Context, in *QueryBurners, opts ...grpc.CallOption) (*QueryBurnersResponse, error)
-	Minters(ctx context.Context, in *QueryMinters, opts ...grpc.CallOption) (*QueryMintersResponse, error)
-	Pausers(ctx context.Context, in *QueryPausers, opts ...grpc.CallOption) (*QueryPausersResponse, error)
-	BlockedChannels(ctx context.Context, in *QueryBlockedChannels, opts ...grpc.CallOption) (*QueryBlockedChannelsResponse, error)
+func (m *QueryPauseExemptions) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryPauseExemptionsResponse struct {
+	PauseExemptions []PauseExemption `protobuf:"bytes,1,rep,name=pause_exemptions,json=pauseExemptions,proto3" json:"pause_exemptions"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPauseExemptionsResponse) Reset()         { *m = QueryPauseExemptionsResponse{} }
+func (m *QueryPauseExemptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseExemptionsResponse) ProtoMessage()    {}
+func (*QueryPauseExemptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{17}
 }
-
-func (c *queryClient) Denom(ctx context.Context, in *QueryDenom, opts ...grpc.CallOption) (*QueryDenomResponse, error) {
-	out := new(QueryDenomResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Query/Denom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPauseExemptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseExemptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseExemptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPauseExemptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseExemptionsResponse.Merge(m, src)
+}
+func (m *QueryPauseExemptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseExemptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseExemptionsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error) {
-	out := new(QueryPausedResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Query/Paused", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPauseExemptionsResponse proto.InternalMessageInfo
+
+func (m *QueryPauseExemptionsResponse) GetPauseExemptions() []PauseExemption {
+	if m != nil {
+		return m.PauseExemptions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Owner(ctx context.Context, in *QueryOwner, opts ...grpc.CallOption) (*QueryOwnerResponse, error) {
-	out := new(QueryOwnerResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Query/Owner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryChannelMode struct {
 }
 
-func (c *queryClient) Burners(ctx context.Context, in *QueryBurners, opts ...grpc.CallOption) (*QueryBurnersResponse, error) {
-	out := new(QueryBurnersResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Query/Burners", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryChannelMode) Reset()         { *m = QueryChannelMode{} }
+func (m *QueryChannelMode) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelMode) ProtoMessage()    {}
+func (*QueryChannelMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{18}
+}
+func (m *QueryChannelMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryChannelMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelMode.Merge(m, src)
+}
+func (m *QueryChannelMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelMode.DiscardUnknown(m)
 }
 
-func (c *queryClient) Minters(ctx context.Context, in *QueryMinters, opts ...grpc.CallOption) (*QueryMintersResponse, error) {
-	out := new(QueryMintersResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Query/Minters", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryChannelMode proto.InternalMessageInfo
+
+type QueryChannelModeResponse struct {
+	Mode ChannelMode `protobuf:"varint,1,opt,name=mode,proto3,enum=aura.v1.ChannelMode" json:"mode,omitempty"`
 }
 
-func (c *queryClient) Pausers(ctx context.Context, in *QueryPausers, opts ...grpc.CallOption) (*QueryPausersResponse, error) {
-	out := new(QueryPausersResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Query/Pausers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryChannelModeResponse) Reset()         { *m = QueryChannelModeResponse{} }
+func (m *QueryChannelModeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelModeResponse) ProtoMessage()    {}
+func (*QueryChannelModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{19}
+}
+func (m *QueryChannelModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryChannelModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelModeResponse.Merge(m, src)
+}
+func (m *QueryChannelModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelModeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) BlockedChannels(ctx context.Context, in *QueryBlockedChannels, opts ...grpc.CallOption) (*QueryBlockedChannelsResponse, error) {
-	out := new(QueryBlockedChannelsResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Query/BlockedChannels", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryChannelModeResponse proto.InternalMessageInfo
+
+func (m *QueryChannelModeResponse) GetMode() ChannelMode {
+	if m != nil {
+		return m.Mode
 	}
-	return out, nil
+	return ChannelModeUnspecified
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Denom(context.Context, *QueryDenom) (*QueryDenomResponse, error)
-	Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error)
-	Owner(context.Context, *QueryOwner) (*QueryOwnerResponse, error)
-	Burners(context.Context, *QueryBurners) (*QueryBurnersResponse, error)
-	Minters(context.Context, *QueryMinters) (*QueryMintersResponse, error)
-	Pausers(context.Context, *QueryPausers) (*QueryPausersResponse, error)
-	BlockedChannels(context.Context, *QueryBlockedChannels) (*QueryBlockedChannelsResponse, error)
+type QueryAllowedChannels struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAllowedChannels) Reset()         { *m = QueryAllowedChannels{} }
+func (m *QueryAllowedChannels) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedChannels) ProtoMessage()    {}
+func (*QueryAllowedChannels) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{20}
+}
+func (m *QueryAllowedChannels) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedChannels) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedChannels.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedChannels) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedChannels.Merge(m, src)
+}
+func (m *QueryAllowedChannels) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedChannels) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedChannels.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Denom(ctx context.Context, req *QueryDenom) (*QueryDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Denom not implemented")
+var xxx_messageInfo_QueryAllowedChannels proto.InternalMessageInfo
+
+type QueryAllowedChannelsResponse struct {
+	AllowedChannels []string `protobuf:"bytes,1,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty"`
 }
-func (*UnimplementedQueryServer) Paused(ctx context.Context, req *QueryPaused) (*QueryPausedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
+
+func (m *QueryAllowedChannelsResponse) Reset()         { *m = QueryAllowedChannelsResponse{} }
+func (m *QueryAllowedChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedChannelsResponse) ProtoMessage()    {}
+func (*QueryAllowedChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{21}
 }
-func (*UnimplementedQueryServer) Owner(ctx context.Context, req *QueryOwner) (*QueryOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Owner not implemented")
+func (m *QueryAllowedChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Burners(ctx context.Context, req *QueryBurners) (*QueryBurnersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Burners not implemented")
+func (m *QueryAllowedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Minters(ctx context.Context, req *QueryMinters) (*QueryMintersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Minters not implemented")
+func (m *QueryAllowedChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedChannelsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Pausers(ctx context.Context, req *QueryPausers) (*QueryPausersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pausers not implemented")
+func (m *QueryAllowedChannelsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) BlockedChannels(ctx context.Context, req *QueryBlockedChannels) (*QueryBlockedChannelsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlockedChannels not implemented")
+func (m *QueryAllowedChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedChannelsResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryAllowedChannelsResponse proto.InternalMessageInfo
 
-func _Query_Denom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenom)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Denom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Query/Denom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Denom(ctx, req.(*QueryDenom))
+func (m *QueryAllowedChannelsResponse) GetAllowedChannels() []string {
+	if m != nil {
+		return m.AllowedChannels
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaused)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Paused(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Query/Paused",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Paused(ctx, req.(*QueryPaused))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryRateLimits struct {
 }
 
-func _Query_Owner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOwner)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Owner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Query/Owner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Owner(ctx, req.(*QueryOwner))
+func (m *QueryRateLimits) Reset()         { *m = QueryRateLimits{} }
+func (m *QueryRateLimits) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimits) ProtoMessage()    {}
+func (*QueryRateLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{22}
+}
+func (m *QueryRateLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRateLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimits.Merge(m, src)
+}
+func (m *QueryRateLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimits.DiscardUnknown(m)
 }
 
-func _Query_Burners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBurners)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Burners(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Query/Burners",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Burners(ctx, req.(*QueryBurners))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryRateLimits proto.InternalMessageInfo
+
+type QueryRateLimitsResponse struct {
+	RateLimits []RateLimit `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
 }
 
-func _Query_Minters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMinters)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Minters(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Query/Minters",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Minters(ctx, req.(*QueryMinters))
+func (m *QueryRateLimitsResponse) Reset()         { *m = QueryRateLimitsResponse{} }
+func (m *QueryRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsResponse) ProtoMessage()    {}
+func (*QueryRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{23}
+}
+func (m *QueryRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsResponse.DiscardUnknown(m)
 }
 
-func _Query_Pausers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPausers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pausers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Query/Pausers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pausers(ctx, req.(*QueryPausers))
+var xxx_messageInfo_QueryRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitsResponse) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BlockedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlockedChannels)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlockedChannels(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Query/BlockedChannels",
+type QueryRateLimitUsage struct {
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (m *QueryRateLimitUsage) Reset()         { *m = QueryRateLimitUsage{} }
+func (m *QueryRateLimitUsage) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitUsage) ProtoMessage()    {}
+func (*QueryRateLimitUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{24}
+}
+func (m *QueryRateLimitUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlockedChannels(ctx, req.(*QueryBlockedChannels))
+}
+func (m *QueryRateLimitUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitUsage.Merge(m, src)
+}
+func (m *QueryRateLimitUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitUsage proto.InternalMessageInfo
+
+func (m *QueryRateLimitUsage) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "aura.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Denom",
-			Handler:    _Query_Denom_Handler,
-		},
-		{
-			MethodName: "Paused",
-			Handler:    _Query_Paused_Handler,
-		},
-		{
-			MethodName: "Owner",
-			Handler:    _Query_Owner_Handler,
-		},
-		{
-			MethodName: "Burners",
-			Handler:    _Query_Burners_Handler,
-		},
-		{
-			MethodName: "Minters",
-			Handler:    _Query_Minters_Handler,
-		},
-		{
-			MethodName: "Pausers",
-			Handler:    _Query_Pausers_Handler,
-		},
-		{
-			MethodName: "BlockedChannels",
-			Handler:    _Query_BlockedChannels_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "aura/v1/query.proto",
+type QueryRateLimitUsageResponse struct {
+	RateLimit        RateLimit             `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+	Flow             RateLimitFlow         `protobuf:"bytes,2,opt,name=flow,proto3" json:"flow"`
+	Quota            cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=quota,proto3,customtype=cosmossdk.io/math.Int" json:"quota"`
+	RemainingOutflow cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=remaining_outflow,json=remainingOutflow,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_outflow"`
+	RemainingInflow  cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=remaining_inflow,json=remainingInflow,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_inflow"`
 }
 
-func (m *QueryDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRateLimitUsageResponse) Reset()         { *m = QueryRateLimitUsageResponse{} }
+func (m *QueryRateLimitUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitUsageResponse) ProtoMessage()    {}
+func (*QueryRateLimitUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{25}
+}
+func (m *QueryRateLimitUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRateLimitUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitUsageResponse.Merge(m, src)
 }
-
-func (m *QueryDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRateLimitUsageResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRateLimitUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitUsageResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryRateLimitUsageResponse proto.InternalMessageInfo
 
-func (m *QueryDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryRateLimitUsageResponse) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
 	}
-	return len(dAtA) - i, nil
+	return RateLimit{}
 }
 
-func (m *QueryPaused) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRateLimitUsageResponse) GetFlow() RateLimitFlow {
+	if m != nil {
+		return m.Flow
 	}
-	return dAtA[:n], nil
+	return RateLimitFlow{}
 }
 
-func (m *QueryPaused) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPriceSetters struct {
 }
 
-func (m *QueryPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPriceSetters) Reset()         { *m = QueryPriceSetters{} }
+func (m *QueryPriceSetters) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceSetters) ProtoMessage()    {}
+func (*QueryPriceSetters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{26}
 }
-
-func (m *QueryPausedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPriceSetters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceSetters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceSetters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryPriceSetters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceSetters.Merge(m, src)
+}
+func (m *QueryPriceSetters) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceSetters) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceSetters.DiscardUnknown(m)
 }
 
-func (m *QueryPausedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPriceSetters proto.InternalMessageInfo
+
+type QueryPriceSettersResponse struct {
+	PriceSetters []string `protobuf:"bytes,1,rep,name=price_setters,json=priceSetters,proto3" json:"price_setters,omitempty"`
 }
 
-func (m *QueryPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Paused {
-		i--
-		if m.Paused {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryPriceSettersResponse) Reset()         { *m = QueryPriceSettersResponse{} }
+func (m *QueryPriceSettersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceSettersResponse) ProtoMessage()    {}
+func (*QueryPriceSettersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{27}
+}
+func (m *QueryPriceSettersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceSettersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceSettersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPriceSettersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceSettersResponse.Merge(m, src)
+}
+func (m *QueryPriceSettersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceSettersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceSettersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOwner) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPriceSettersResponse proto.InternalMessageInfo
+
+func (m *QueryPriceSettersResponse) GetPriceSetters() []string {
+	if m != nil {
+		return m.PriceSetters
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryOwner) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPriceRanges struct {
 }
 
-func (m *QueryOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPriceRanges) Reset()         { *m = QueryPriceRanges{} }
+func (m *QueryPriceRanges) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceRanges) ProtoMessage()    {}
+func (*QueryPriceRanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{28}
 }
-
-func (m *QueryOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPriceRanges) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceRanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceRanges.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryPriceRanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceRanges.Merge(m, src)
+}
+func (m *QueryPriceRanges) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceRanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceRanges.DiscardUnknown(m)
 }
 
-func (m *QueryOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPriceRanges proto.InternalMessageInfo
+
+type QueryPriceRangesResponse struct {
+	PriceRanges       []PriceRange                `protobuf:"bytes,1,rep,name=price_ranges,json=priceRanges,proto3" json:"price_ranges"`
+	MaxPriceDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_price_deviation,json=maxPriceDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_deviation"`
 }
 
-func (m *QueryOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PendingOwner) > 0 {
-		i -= len(m.PendingOwner)
-		copy(dAtA[i:], m.PendingOwner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingOwner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryPriceRangesResponse) Reset()         { *m = QueryPriceRangesResponse{} }
+func (m *QueryPriceRangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceRangesResponse) ProtoMessage()    {}
+func (*QueryPriceRangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{29}
+}
+func (m *QueryPriceRangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceRangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceRangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPriceRangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceRangesResponse.Merge(m, src)
+}
+func (m *QueryPriceRangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceRangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceRangesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBurners) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPriceRangesResponse proto.InternalMessageInfo
+
+func (m *QueryPriceRangesResponse) GetPriceRanges() []PriceRange {
+	if m != nil {
+		return m.PriceRanges
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBurners) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPrice struct {
 }
 
-func (m *QueryBurners) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPrice) Reset()         { *m = QueryPrice{} }
+func (m *QueryPrice) String() string { return proto.CompactTextString(m) }
+func (*QueryPrice) ProtoMessage()    {}
+func (*QueryPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{30}
 }
-
-func (m *QueryBurnersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrice.Merge(m, src)
+}
+func (m *QueryPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrice.DiscardUnknown(m)
 }
 
-func (m *QueryBurnersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPrice proto.InternalMessageInfo
+
+type QueryPriceResponse struct {
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
 }
 
-func (m *QueryBurnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Burners) > 0 {
-		for iNdEx := len(m.Burners) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Burners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPriceResponse) Reset()         { *m = QueryPriceResponse{} }
+func (m *QueryPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceResponse) ProtoMessage()    {}
+func (*QueryPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{31}
+}
+func (m *QueryPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryMinters) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceResponse.Merge(m, src)
 }
-
-func (m *QueryMinters) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPriceResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryMinters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMintersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryPriceResponse proto.InternalMessageInfo
 
-func (m *QueryMintersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryShares struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryMintersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Minters) > 0 {
-		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryShares) Reset()         { *m = QueryShares{} }
+func (m *QueryShares) String() string { return proto.CompactTextString(m) }
+func (*QueryShares) ProtoMessage()    {}
+func (*QueryShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{32}
+}
+func (m *QueryShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShares.Merge(m, src)
+}
+func (m *QueryShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShares.DiscardUnknown(m)
 }
 
-func (m *QueryPausers) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryShares proto.InternalMessageInfo
+
+func (m *QueryShares) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPausers) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySharesResponse struct {
+	Shares  cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=shares,proto3,customtype=cosmossdk.io/math.Int" json:"shares"`
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
 }
 
-func (m *QueryPausers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySharesResponse) Reset()         { *m = QuerySharesResponse{} }
+func (m *QuerySharesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySharesResponse) ProtoMessage()    {}
+func (*QuerySharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{33}
 }
-
-func (m *QueryPausersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QuerySharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySharesResponse.Merge(m, src)
+}
+func (m *QuerySharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySharesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPausersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySharesResponse proto.InternalMessageInfo
+
+type QueryTotalShares struct {
 }
 
-func (m *QueryPausersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pausers) > 0 {
-		for iNdEx := len(m.Pausers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Pausers[iNdEx])
-			copy(dAtA[i:], m.Pausers[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Pausers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryTotalShares) Reset()         { *m = QueryTotalShares{} }
+func (m *QueryTotalShares) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalShares) ProtoMessage()    {}
+func (*QueryTotalShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{34}
+}
+func (m *QueryTotalShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBlockedChannels) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTotalShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalShares.Merge(m, src)
 }
-
-func (m *QueryBlockedChannels) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTotalShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalShares.DiscardUnknown(m)
 }
 
-func (m *QueryBlockedChannels) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryTotalShares proto.InternalMessageInfo
+
+type QueryTotalSharesResponse struct {
+	TotalShares cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=total_shares,json=totalShares,proto3,customtype=cosmossdk.io/math.Int" json:"total_shares"`
+	TotalSupply cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply"`
 }
 
-func (m *QueryBlockedChannelsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTotalSharesResponse) Reset()         { *m = QueryTotalSharesResponse{} }
+func (m *QueryTotalSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalSharesResponse) ProtoMessage()    {}
+func (*QueryTotalSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{35}
+}
+func (m *QueryTotalSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryTotalSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalSharesResponse.Merge(m, src)
+}
+func (m *QueryTotalSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalSharesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBlockedChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryTotalSharesResponse proto.InternalMessageInfo
+
+type QueryStablecoin struct {
 }
 
-func (m *QueryBlockedChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlockedChannels) > 0 {
-		for iNdEx := len(m.BlockedChannels) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BlockedChannels[iNdEx])
-			copy(dAtA[i:], m.BlockedChannels[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockedChannels[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryStablecoin) Reset()         { *m = QueryStablecoin{} }
+func (m *QueryStablecoin) String() string { return proto.CompactTextString(m) }
+func (*QueryStablecoin) ProtoMessage()    {}
+func (*QueryStablecoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{36}
+}
+func (m *QueryStablecoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStablecoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStablecoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryStablecoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStablecoin.Merge(m, src)
+}
+func (m *QueryStablecoin) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStablecoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStablecoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStablecoin proto.InternalMessageInfo
+
+type QueryStablecoinResponse struct {
+	Stablecoin Stablecoin `protobuf:"bytes,1,opt,name=stablecoin,proto3" json:"stablecoin"`
+}
+
+func (m *QueryStablecoinResponse) Reset()         { *m = QueryStablecoinResponse{} }
+func (m *QueryStablecoinResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStablecoinResponse) ProtoMessage()    {}
+func (*QueryStablecoinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{37}
+}
+func (m *QueryStablecoinResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStablecoinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStablecoinResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *QueryDenom) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStablecoinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStablecoinResponse.Merge(m, src)
+}
+func (m *QueryStablecoinResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStablecoinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStablecoinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStablecoinResponse proto.InternalMessageInfo
+
+func (m *QueryStablecoinResponse) GetStablecoin() Stablecoin {
+	if m != nil {
+		return m.Stablecoin
 	}
-	var l int
-	_ = l
-	return n
+	return Stablecoin{}
 }
 
-func (m *QueryDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QuerySubscription struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QuerySubscription) Reset()         { *m = QuerySubscription{} }
+func (m *QuerySubscription) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscription) ProtoMessage()    {}
+func (*QuerySubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{38}
+}
+func (m *QuerySubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QuerySubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscription.Merge(m, src)
+}
+func (m *QuerySubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubscription proto.InternalMessageInfo
+
+func (m *QuerySubscription) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return 0
 }
 
-func (m *QueryPaused) Size() (n int) {
-	if m == nil {
-		return 0
+type QuerySubscriptionResponse struct {
+	Subscription Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription"`
+}
+
+func (m *QuerySubscriptionResponse) Reset()         { *m = QuerySubscriptionResponse{} }
+func (m *QuerySubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscriptionResponse) ProtoMessage()    {}
+func (*QuerySubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{39}
+}
+func (m *QuerySubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QuerySubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscriptionResponse.Merge(m, src)
+}
+func (m *QuerySubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscriptionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPausedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySubscriptionResponse proto.InternalMessageInfo
+
+func (m *QuerySubscriptionResponse) GetSubscription() Subscription {
+	if m != nil {
+		return m.Subscription
 	}
-	var l int
-	_ = l
-	if m.Paused {
-		n += 2
+	return Subscription{}
+}
+
+type QuerySubscriptions struct {
+	// user filters requests by the requesting account, if set.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// status filters requests by status, if set.
+	Status     RequestStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=aura.v1.RequestStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySubscriptions) Reset()         { *m = QuerySubscriptions{} }
+func (m *QuerySubscriptions) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscriptions) ProtoMessage()    {}
+func (*QuerySubscriptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{40}
+}
+func (m *QuerySubscriptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubscriptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubscriptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QuerySubscriptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscriptions.Merge(m, src)
+}
+func (m *QuerySubscriptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubscriptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscriptions.DiscardUnknown(m)
 }
 
-func (m *QueryOwner) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySubscriptions proto.InternalMessageInfo
+
+func (m *QuerySubscriptions) GetUser() string {
+	if m != nil {
+		return m.User
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySubscriptions) GetStatus() RequestStatus {
+	if m != nil {
+		return m.Status
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return RequestStatusUnspecified
+}
+
+func (m *QuerySubscriptions) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	l = len(m.PendingOwner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QuerySubscriptionsResponse struct {
+	Subscriptions []Subscription      `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySubscriptionsResponse) Reset()         { *m = QuerySubscriptionsResponse{} }
+func (m *QuerySubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscriptionsResponse) ProtoMessage()    {}
+func (*QuerySubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{41}
+}
+func (m *QuerySubscriptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubscriptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubscriptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QuerySubscriptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscriptionsResponse.Merge(m, src)
+}
+func (m *QuerySubscriptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubscriptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscriptionsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBurners) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySubscriptionsResponse proto.InternalMessageInfo
+
+func (m *QuerySubscriptionsResponse) GetSubscriptions() []Subscription {
+	if m != nil {
+		return m.Subscriptions
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryBurnersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySubscriptionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.Burners) > 0 {
-		for _, e := range m.Burners {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryRedemption struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryRedemption) Reset()         { *m = QueryRedemption{} }
+func (m *QueryRedemption) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemption) ProtoMessage()    {}
+func (*QueryRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{42}
+}
+func (m *QueryRedemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemption.Merge(m, src)
+}
+func (m *QueryRedemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemption.DiscardUnknown(m)
 }
 
-func (m *QueryMinters) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryRedemption proto.InternalMessageInfo
+
+func (m *QueryRedemption) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryMintersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Minters) > 0 {
-		for _, e := range m.Minters {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+type QueryRedemptionResponse struct {
+	Redemption Redemption `protobuf:"bytes,1,opt,name=redemption,proto3" json:"redemption"`
+}
+
+func (m *QueryRedemptionResponse) Reset()         { *m = QueryRedemptionResponse{} }
+func (m *QueryRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionResponse) ProtoMessage()    {}
+func (*QueryRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{43}
+}
+func (m *QueryRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionResponse.Merge(m, src)
+}
+func (m *QueryRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPausers) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryRedemptionResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionResponse) GetRedemption() Redemption {
+	if m != nil {
+		return m.Redemption
 	}
-	var l int
-	_ = l
-	return n
+	return Redemption{}
 }
 
-func (m *QueryPausersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pausers) > 0 {
-		for _, s := range m.Pausers {
+type QueryRedemptions struct {
+	// user filters requests by the requesting account, if set.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// status filters requests by status, if set.
+	Status     RequestStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=aura.v1.RequestStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRedemptions) Reset()         { *m = QueryRedemptions{} }
+func (m *QueryRedemptions) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptions) ProtoMessage()    {}
+func (*QueryRedemptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{44}
+}
+func (m *QueryRedemptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedemptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptions.Merge(m, src)
+}
+func (m *QueryRedemptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedemptions proto.InternalMessageInfo
+
+func (m *QueryRedemptions) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *QueryRedemptions) GetStatus() RequestStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RequestStatusUnspecified
+}
+
+func (m *QueryRedemptions) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRedemptionsResponse struct {
+	Redemptions []Redemption        `protobuf:"bytes,1,rep,name=redemptions,proto3" json:"redemptions"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRedemptionsResponse) Reset()         { *m = QueryRedemptionsResponse{} }
+func (m *QueryRedemptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionsResponse) ProtoMessage()    {}
+func (*QueryRedemptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{45}
+}
+func (m *QueryRedemptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedemptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionsResponse.Merge(m, src)
+}
+func (m *QueryRedemptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedemptionsResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionsResponse) GetRedemptions() []Redemption {
+	if m != nil {
+		return m.Redemptions
+	}
+	return nil
+}
+
+func (m *QueryRedemptionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRedemptionQueue struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRedemptionQueue) Reset()         { *m = QueryRedemptionQueue{} }
+func (m *QueryRedemptionQueue) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionQueue) ProtoMessage()    {}
+func (*QueryRedemptionQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{46}
+}
+func (m *QueryRedemptionQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionQueue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedemptionQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionQueue.Merge(m, src)
+}
+func (m *QueryRedemptionQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedemptionQueue proto.InternalMessageInfo
+
+func (m *QueryRedemptionQueue) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRedemptionQueueResponse struct {
+	// redemptions is the list of pending redemption requests, in settlement order.
+	Redemptions []Redemption        `protobuf:"bytes,1,rep,name=redemptions,proto3" json:"redemptions"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRedemptionQueueResponse) Reset()         { *m = QueryRedemptionQueueResponse{} }
+func (m *QueryRedemptionQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionQueueResponse) ProtoMessage()    {}
+func (*QueryRedemptionQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{47}
+}
+func (m *QueryRedemptionQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedemptionQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionQueueResponse.Merge(m, src)
+}
+func (m *QueryRedemptionQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedemptionQueueResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionQueueResponse) GetRedemptions() []Redemption {
+	if m != nil {
+		return m.Redemptions
+	}
+	return nil
+}
+
+func (m *QueryRedemptionQueueResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySwapFacility struct {
+}
+
+func (m *QuerySwapFacility) Reset()         { *m = QuerySwapFacility{} }
+func (m *QuerySwapFacility) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapFacility) ProtoMessage()    {}
+func (*QuerySwapFacility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{48}
+}
+func (m *QuerySwapFacility) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapFacility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapFacility.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapFacility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapFacility.Merge(m, src)
+}
+func (m *QuerySwapFacility) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapFacility) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapFacility.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapFacility proto.InternalMessageInfo
+
+type QuerySwapFacilityResponse struct {
+	Config    SwapConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+	Paused    bool       `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	Liquidity types.Coin `protobuf:"bytes,3,opt,name=liquidity,proto3" json:"liquidity"`
+	// minter_allowance is the remaining allowance of the facility to mint USDY.
+	MinterAllowance cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=minter_allowance,json=minterAllowance,proto3,customtype=cosmossdk.io/math.Int" json:"minter_allowance"`
+	// burner_allowance is the remaining allowance of the facility to burn USDY.
+	BurnerAllowance cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=burner_allowance,json=burnerAllowance,proto3,customtype=cosmossdk.io/math.Int" json:"burner_allowance"`
+}
+
+func (m *QuerySwapFacilityResponse) Reset()         { *m = QuerySwapFacilityResponse{} }
+func (m *QuerySwapFacilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapFacilityResponse) ProtoMessage()    {}
+func (*QuerySwapFacilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{49}
+}
+func (m *QuerySwapFacilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapFacilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapFacilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapFacilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapFacilityResponse.Merge(m, src)
+}
+func (m *QuerySwapFacilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapFacilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapFacilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapFacilityResponse proto.InternalMessageInfo
+
+func (m *QuerySwapFacilityResponse) GetConfig() SwapConfig {
+	if m != nil {
+		return m.Config
+	}
+	return SwapConfig{}
+}
+
+func (m *QuerySwapFacilityResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *QuerySwapFacilityResponse) GetLiquidity() types.Coin {
+	if m != nil {
+		return m.Liquidity
+	}
+	return types.Coin{}
+}
+
+type QuerySwapVolume struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QuerySwapVolume) Reset()         { *m = QuerySwapVolume{} }
+func (m *QuerySwapVolume) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapVolume) ProtoMessage()    {}
+func (*QuerySwapVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{50}
+}
+func (m *QuerySwapVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapVolume.Merge(m, src)
+}
+func (m *QuerySwapVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapVolume proto.InternalMessageInfo
+
+func (m *QuerySwapVolume) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QuerySwapVolumeResponse struct {
+	// volume is the amount of USDY swapped by the account today.
+	Volume cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume"`
+	// remaining is the amount of USDY the account can still swap today, empty if unlimited.
+	Remaining *cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=remaining,proto3,customtype=cosmossdk.io/math.Int" json:"remaining,omitempty"`
+}
+
+func (m *QuerySwapVolumeResponse) Reset()         { *m = QuerySwapVolumeResponse{} }
+func (m *QuerySwapVolumeResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapVolumeResponse) ProtoMessage()    {}
+func (*QuerySwapVolumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{51}
+}
+func (m *QuerySwapVolumeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapVolumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapVolumeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapVolumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapVolumeResponse.Merge(m, src)
+}
+func (m *QuerySwapVolumeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapVolumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapVolumeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapVolumeResponse proto.InternalMessageInfo
+
+type QueryLockupPeriod struct {
+}
+
+func (m *QueryLockupPeriod) Reset()         { *m = QueryLockupPeriod{} }
+func (m *QueryLockupPeriod) String() string { return proto.CompactTextString(m) }
+func (*QueryLockupPeriod) ProtoMessage()    {}
+func (*QueryLockupPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{52}
+}
+func (m *QueryLockupPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockupPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockupPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockupPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockupPeriod.Merge(m, src)
+}
+func (m *QueryLockupPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockupPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockupPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockupPeriod proto.InternalMessageInfo
+
+type QueryLockupPeriodResponse struct {
+	LockupPeriod time.Duration `protobuf:"bytes,1,opt,name=lockup_period,json=lockupPeriod,proto3,stdduration" json:"lockup_period"`
+}
+
+func (m *QueryLockupPeriodResponse) Reset()         { *m = QueryLockupPeriodResponse{} }
+func (m *QueryLockupPeriodResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockupPeriodResponse) ProtoMessage()    {}
+func (*QueryLockupPeriodResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{53}
+}
+func (m *QueryLockupPeriodResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockupPeriodResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockupPeriodResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockupPeriodResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockupPeriodResponse.Merge(m, src)
+}
+func (m *QueryLockupPeriodResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockupPeriodResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockupPeriodResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockupPeriodResponse proto.InternalMessageInfo
+
+func (m *QueryLockupPeriodResponse) GetLockupPeriod() time.Duration {
+	if m != nil {
+		return m.LockupPeriod
+	}
+	return 0
+}
+
+type QueryLockedBalance struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryLockedBalance) Reset()         { *m = QueryLockedBalance{} }
+func (m *QueryLockedBalance) String() string { return proto.CompactTextString(m) }
+func (*QueryLockedBalance) ProtoMessage()    {}
+func (*QueryLockedBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{54}
+}
+func (m *QueryLockedBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockedBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockedBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockedBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedBalance.Merge(m, src)
+}
+func (m *QueryLockedBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockedBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockedBalance proto.InternalMessageInfo
+
+func (m *QueryLockedBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryLockedBalanceResponse struct {
+	// locked is the amount of USDY held by the account that is still locked.
+	Locked cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=locked,proto3,customtype=cosmossdk.io/math.Int" json:"locked"`
+	// unlocked is the amount of USDY held by the account that is transferable.
+	Unlocked cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=unlocked,proto3,customtype=cosmossdk.io/math.Int" json:"unlocked"`
+	// lots is the list of outstanding locked lots of the account.
+	Lots []LockedLot `protobuf:"bytes,3,rep,name=lots,proto3" json:"lots"`
+}
+
+func (m *QueryLockedBalanceResponse) Reset()         { *m = QueryLockedBalanceResponse{} }
+func (m *QueryLockedBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockedBalanceResponse) ProtoMessage()    {}
+func (*QueryLockedBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{55}
+}
+func (m *QueryLockedBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockedBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockedBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockedBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedBalanceResponse.Merge(m, src)
+}
+func (m *QueryLockedBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockedBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockedBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryLockedBalanceResponse) GetLots() []LockedLot {
+	if m != nil {
+		return m.Lots
+	}
+	return nil
+}
+
+type QueryAttestors struct {
+}
+
+func (m *QueryAttestors) Reset()         { *m = QueryAttestors{} }
+func (m *QueryAttestors) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestors) ProtoMessage()    {}
+func (*QueryAttestors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{56}
+}
+func (m *QueryAttestors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestors.Merge(m, src)
+}
+func (m *QueryAttestors) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestors) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestors proto.InternalMessageInfo
+
+type QueryAttestorsResponse struct {
+	Attestors []string `protobuf:"bytes,1,rep,name=attestors,proto3" json:"attestors,omitempty"`
+}
+
+func (m *QueryAttestorsResponse) Reset()         { *m = QueryAttestorsResponse{} }
+func (m *QueryAttestorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestorsResponse) ProtoMessage()    {}
+func (*QueryAttestorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{57}
+}
+func (m *QueryAttestorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestorsResponse.Merge(m, src)
+}
+func (m *QueryAttestorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestorsResponse proto.InternalMessageInfo
+
+func (m *QueryAttestorsResponse) GetAttestors() []string {
+	if m != nil {
+		return m.Attestors
+	}
+	return nil
+}
+
+type QueryAttestations struct {
+}
+
+func (m *QueryAttestations) Reset()         { *m = QueryAttestations{} }
+func (m *QueryAttestations) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestations) ProtoMessage()    {}
+func (*QueryAttestations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{58}
+}
+func (m *QueryAttestations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestations.Merge(m, src)
+}
+func (m *QueryAttestations) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestations) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestations proto.InternalMessageInfo
+
+type QueryAttestationsResponse struct {
+	Attestations []Attestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations"`
+}
+
+func (m *QueryAttestationsResponse) Reset()         { *m = QueryAttestationsResponse{} }
+func (m *QueryAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsResponse) ProtoMessage()    {}
+func (*QueryAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{59}
+}
+func (m *QueryAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsResponse.Merge(m, src)
+}
+func (m *QueryAttestationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationsResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationsResponse) GetAttestations() []Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+type QueryReserves struct {
+}
+
+func (m *QueryReserves) Reset()         { *m = QueryReserves{} }
+func (m *QueryReserves) String() string { return proto.CompactTextString(m) }
+func (*QueryReserves) ProtoMessage()    {}
+func (*QueryReserves) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{60}
+}
+func (m *QueryReserves) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReserves) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReserves.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReserves) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReserves.Merge(m, src)
+}
+func (m *QueryReserves) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReserves) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReserves.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReserves proto.InternalMessageInfo
+
+type QueryReservesResponse struct {
+	Config ReserveConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+	// latest is the latest attestation, if any.
+	Latest *Attestation `protobuf:"bytes,2,opt,name=latest,proto3" json:"latest,omitempty"`
+	// stale is whether the latest attestation is stale.
+	Stale bool `protobuf:"varint,3,opt,name=stale,proto3" json:"stale,omitempty"`
+	// supply is the current supply of USDY.
+	Supply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+	// remaining_capacity is the amount of USDY that can still be minted, nil if minting isn't limited by reserves.
+	RemainingCapacity *cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=remaining_capacity,json=remainingCapacity,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_capacity,omitempty"`
+}
+
+func (m *QueryReservesResponse) Reset()         { *m = QueryReservesResponse{} }
+func (m *QueryReservesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReservesResponse) ProtoMessage()    {}
+func (*QueryReservesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{61}
+}
+func (m *QueryReservesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReservesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReservesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReservesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReservesResponse.Merge(m, src)
+}
+func (m *QueryReservesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReservesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReservesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReservesResponse proto.InternalMessageInfo
+
+func (m *QueryReservesResponse) GetConfig() ReserveConfig {
+	if m != nil {
+		return m.Config
+	}
+	return ReserveConfig{}
+}
+
+func (m *QueryReservesResponse) GetLatest() *Attestation {
+	if m != nil {
+		return m.Latest
+	}
+	return nil
+}
+
+func (m *QueryReservesResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+type QueryRecoverers struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryRecoverers) Reset()         { *m = QueryRecoverers{} }
+func (m *QueryRecoverers) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoverers) ProtoMessage()    {}
+func (*QueryRecoverers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{62}
+}
+func (m *QueryRecoverers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoverers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoverers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecoverers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoverers.Merge(m, src)
+}
+func (m *QueryRecoverers) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoverers) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoverers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecoverers proto.InternalMessageInfo
+
+func (m *QueryRecoverers) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryRecoverersResponse struct {
+	Recoverers []string `protobuf:"bytes,1,rep,name=recoverers,proto3" json:"recoverers,omitempty"`
+}
+
+func (m *QueryRecoverersResponse) Reset()         { *m = QueryRecoverersResponse{} }
+func (m *QueryRecoverersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoverersResponse) ProtoMessage()    {}
+func (*QueryRecoverersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2046dd993d22edf0, []int{63}
+}
+func (m *QueryRecoverersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoverersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoverersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecoverersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoverersResponse.Merge(m, src)
+}
+func (m *QueryRecoverersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoverersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoverersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecoverersResponse proto.InternalMessageInfo
+
+func (m *QueryRecoverersResponse) GetRecoverers() []string {
+	if m != nil {
+		return m.Recoverers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryDenom)(nil), "aura.v1.QueryDenom")
+	proto.RegisterType((*QueryDenomResponse)(nil), "aura.v1.QueryDenomResponse")
+	proto.RegisterType((*QueryDenoms)(nil), "aura.v1.QueryDenoms")
+	proto.RegisterType((*QueryDenomsResponse)(nil), "aura.v1.QueryDenomsResponse")
+	proto.RegisterType((*QueryPaused)(nil), "aura.v1.QueryPaused")
+	proto.RegisterType((*QueryPausedResponse)(nil), "aura.v1.QueryPausedResponse")
+	proto.RegisterType((*QueryOwner)(nil), "aura.v1.QueryOwner")
+	proto.RegisterType((*QueryOwnerResponse)(nil), "aura.v1.QueryOwnerResponse")
+	proto.RegisterType((*QueryBurners)(nil), "aura.v1.QueryBurners")
+	proto.RegisterType((*QueryBurnersResponse)(nil), "aura.v1.QueryBurnersResponse")
+	proto.RegisterType((*QueryMinters)(nil), "aura.v1.QueryMinters")
+	proto.RegisterType((*QueryMintersResponse)(nil), "aura.v1.QueryMintersResponse")
+	proto.RegisterType((*QueryPausers)(nil), "aura.v1.QueryPausers")
+	proto.RegisterType((*QueryPausersResponse)(nil), "aura.v1.QueryPausersResponse")
+	proto.RegisterType((*QueryBlockedChannels)(nil), "aura.v1.QueryBlockedChannels")
+	proto.RegisterType((*QueryBlockedChannelsResponse)(nil), "aura.v1.QueryBlockedChannelsResponse")
+	proto.RegisterType((*QueryPauseExemptions)(nil), "aura.v1.QueryPauseExemptions")
+	proto.RegisterType((*QueryPauseExemptionsResponse)(nil), "aura.v1.QueryPauseExemptionsResponse")
+	proto.RegisterType((*QueryChannelMode)(nil), "aura.v1.QueryChannelMode")
+	proto.RegisterType((*QueryChannelModeResponse)(nil), "aura.v1.QueryChannelModeResponse")
+	proto.RegisterType((*QueryAllowedChannels)(nil), "aura.v1.QueryAllowedChannels")
+	proto.RegisterType((*QueryAllowedChannelsResponse)(nil), "aura.v1.QueryAllowedChannelsResponse")
+	proto.RegisterType((*QueryRateLimits)(nil), "aura.v1.QueryRateLimits")
+	proto.RegisterType((*QueryRateLimitsResponse)(nil), "aura.v1.QueryRateLimitsResponse")
+	proto.RegisterType((*QueryRateLimitUsage)(nil), "aura.v1.QueryRateLimitUsage")
+	proto.RegisterType((*QueryRateLimitUsageResponse)(nil), "aura.v1.QueryRateLimitUsageResponse")
+	proto.RegisterType((*QueryPriceSetters)(nil), "aura.v1.QueryPriceSetters")
+	proto.RegisterType((*QueryPriceSettersResponse)(nil), "aura.v1.QueryPriceSettersResponse")
+	proto.RegisterType((*QueryPriceRanges)(nil), "aura.v1.QueryPriceRanges")
+	proto.RegisterType((*QueryPriceRangesResponse)(nil), "aura.v1.QueryPriceRangesResponse")
+	proto.RegisterType((*QueryPrice)(nil), "aura.v1.QueryPrice")
+	proto.RegisterType((*QueryPriceResponse)(nil), "aura.v1.QueryPriceResponse")
+	proto.RegisterType((*QueryShares)(nil), "aura.v1.QueryShares")
+	proto.RegisterType((*QuerySharesResponse)(nil), "aura.v1.QuerySharesResponse")
+	proto.RegisterType((*QueryTotalShares)(nil), "aura.v1.QueryTotalShares")
+	proto.RegisterType((*QueryTotalSharesResponse)(nil), "aura.v1.QueryTotalSharesResponse")
+	proto.RegisterType((*QueryStablecoin)(nil), "aura.v1.QueryStablecoin")
+	proto.RegisterType((*QueryStablecoinResponse)(nil), "aura.v1.QueryStablecoinResponse")
+	proto.RegisterType((*QuerySubscription)(nil), "aura.v1.QuerySubscription")
+	proto.RegisterType((*QuerySubscriptionResponse)(nil), "aura.v1.QuerySubscriptionResponse")
+	proto.RegisterType((*QuerySubscriptions)(nil), "aura.v1.QuerySubscriptions")
+	proto.RegisterType((*QuerySubscriptionsResponse)(nil), "aura.v1.QuerySubscriptionsResponse")
+	proto.RegisterType((*QueryRedemption)(nil), "aura.v1.QueryRedemption")
+	proto.RegisterType((*QueryRedemptionResponse)(nil), "aura.v1.QueryRedemptionResponse")
+	proto.RegisterType((*QueryRedemptions)(nil), "aura.v1.QueryRedemptions")
+	proto.RegisterType((*QueryRedemptionsResponse)(nil), "aura.v1.QueryRedemptionsResponse")
+	proto.RegisterType((*QueryRedemptionQueue)(nil), "aura.v1.QueryRedemptionQueue")
+	proto.RegisterType((*QueryRedemptionQueueResponse)(nil), "aura.v1.QueryRedemptionQueueResponse")
+	proto.RegisterType((*QuerySwapFacility)(nil), "aura.v1.QuerySwapFacility")
+	proto.RegisterType((*QuerySwapFacilityResponse)(nil), "aura.v1.QuerySwapFacilityResponse")
+	proto.RegisterType((*QuerySwapVolume)(nil), "aura.v1.QuerySwapVolume")
+	proto.RegisterType((*QuerySwapVolumeResponse)(nil), "aura.v1.QuerySwapVolumeResponse")
+	proto.RegisterType((*QueryLockupPeriod)(nil), "aura.v1.QueryLockupPeriod")
+	proto.RegisterType((*QueryLockupPeriodResponse)(nil), "aura.v1.QueryLockupPeriodResponse")
+	proto.RegisterType((*QueryLockedBalance)(nil), "aura.v1.QueryLockedBalance")
+	proto.RegisterType((*QueryLockedBalanceResponse)(nil), "aura.v1.QueryLockedBalanceResponse")
+	proto.RegisterType((*QueryAttestors)(nil), "aura.v1.QueryAttestors")
+	proto.RegisterType((*QueryAttestorsResponse)(nil), "aura.v1.QueryAttestorsResponse")
+	proto.RegisterType((*QueryAttestations)(nil), "aura.v1.QueryAttestations")
+	proto.RegisterType((*QueryAttestationsResponse)(nil), "aura.v1.QueryAttestationsResponse")
+	proto.RegisterType((*QueryReserves)(nil), "aura.v1.QueryReserves")
+	proto.RegisterType((*QueryReservesResponse)(nil), "aura.v1.QueryReservesResponse")
+	proto.RegisterType((*QueryRecoverers)(nil), "aura.v1.QueryRecoverers")
+	proto.RegisterType((*QueryRecoverersResponse)(nil), "aura.v1.QueryRecoverersResponse")
+}
+
+func init() { proto.RegisterFile("aura/v1/query.proto", fileDescriptor_2046dd993d22edf0) }
+
+var fileDescriptor_2046dd993d22edf0 = []byte{
+	// 2595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1b, 0xd7,
+	0x11, 0x37, 0x65, 0x7d, 0x58, 0x23, 0x4a, 0x94, 0x9e, 0x3e, 0x2c, 0x51, 0x0a, 0x2d, 0xaf, 0xe2,
+	0xc8, 0xb5, 0x1d, 0xd2, 0x56, 0x83, 0x14, 0x41, 0x8b, 0xb6, 0x96, 0x15, 0xa7, 0x2e, 0x94, 0xda,
+	0xa1, 0x52, 0x17, 0x48, 0xda, 0x10, 0x4b, 0xf2, 0x89, 0xde, 0x78, 0xb9, 0xbb, 0xe6, 0xdb, 0x95,
+	0x2c, 0x18, 0x86, 0xd1, 0x1e, 0x8a, 0x1e, 0x7a, 0x08, 0xd0, 0x4b, 0x4f, 0x45, 0x0e, 0x3d, 0x04,
+	0xe8, 0xa5, 0x40, 0xfa, 0x07, 0xf4, 0xd0, 0x43, 0x8e, 0x41, 0x7b, 0x29, 0x7a, 0x70, 0x0b, 0x3b,
+	0x40, 0xff, 0x8d, 0x62, 0xdf, 0x9b, 0xf7, 0xb1, 0x6f, 0xb9, 0x72, 0xad, 0xf6, 0x90, 0x4b, 0xa0,
+	0x9d, 0x99, 0xf7, 0x9b, 0x0f, 0xce, 0x9b, 0x37, 0x33, 0x31, 0xcc, 0xbb, 0xc9, 0xc0, 0x6d, 0x1c,
+	0x5c, 0x6b, 0x3c, 0x48, 0xe8, 0xe0, 0xa8, 0x1e, 0x0d, 0xc2, 0x38, 0x24, 0x13, 0x29, 0xb1, 0x7e,
+	0x70, 0xad, 0x3a, 0xe7, 0xf6, 0xbd, 0x20, 0x6c, 0xf0, 0xff, 0x0a, 0x5e, 0x75, 0x51, 0x1e, 0xe8,
+	0xd1, 0x80, 0x32, 0x8f, 0x21, 0xf9, 0x52, 0x27, 0x64, 0xfd, 0x90, 0x35, 0xda, 0x2e, 0xa3, 0x02,
+	0xab, 0x71, 0x70, 0xad, 0x4d, 0x63, 0xf7, 0x5a, 0x23, 0x72, 0x7b, 0x5e, 0xe0, 0xc6, 0x5e, 0x18,
+	0xa0, 0x6c, 0xcd, 0x94, 0x95, 0x52, 0x9d, 0xd0, 0x93, 0xfc, 0x55, 0xe4, 0x4b, 0x18, 0xd3, 0xb6,
+	0xea, 0x8a, 0x60, 0xb6, 0xf8, 0x57, 0x43, 0x7c, 0x20, 0x6b, 0xa1, 0x17, 0xf6, 0x42, 0x41, 0x4f,
+	0xff, 0x42, 0xea, 0x5a, 0x2f, 0x0c, 0x7b, 0x3e, 0x6d, 0xb8, 0x91, 0xd7, 0x70, 0x83, 0x20, 0x8c,
+	0xb9, 0x29, 0xf2, 0x4c, 0x0d, 0xb9, 0xfc, 0xab, 0x9d, 0xec, 0x37, 0xba, 0xc9, 0xc0, 0xb0, 0xd5,
+	0x29, 0x03, 0xbc, 0x97, 0x6a, 0xdf, 0xa1, 0x41, 0xd8, 0x77, 0x2e, 0x01, 0xd1, 0x5f, 0x4d, 0xca,
+	0xa2, 0x30, 0x60, 0x94, 0x2c, 0xc0, 0x58, 0x37, 0x25, 0x2c, 0x97, 0xd6, 0x4b, 0x17, 0x27, 0x9b,
+	0xe2, 0xc3, 0x99, 0x86, 0x29, 0x2d, 0xcb, 0x9c, 0xbb, 0x30, 0x6f, 0x7c, 0xaa, 0xb3, 0x4b, 0x30,
+	0xce, 0xc5, 0xd9, 0x72, 0x69, 0xfd, 0xf4, 0xc5, 0xc9, 0x26, 0x7e, 0x91, 0x4d, 0xa8, 0xb4, 0xfd,
+	0xb0, 0x73, 0xdf, 0xf7, 0x58, 0xdc, 0x6a, 0x87, 0x49, 0xd0, 0x5d, 0x1e, 0xe1, 0x02, 0x33, 0x8a,
+	0xbc, 0x9d, 0x52, 0x9d, 0x0d, 0x54, 0x73, 0xc7, 0x4d, 0x18, 0xed, 0x16, 0xd8, 0xf2, 0x49, 0x09,
+	0xb5, 0x0b, 0x29, 0x53, 0x7b, 0xc4, 0x29, 0x5c, 0xfc, 0x4c, 0x13, 0xbf, 0xc8, 0x65, 0x18, 0x67,
+	0x9d, 0x30, 0xa2, 0x8c, 0x2b, 0x9d, 0xd9, 0x9a, 0xaf, 0x63, 0x46, 0xd4, 0x39, 0xc0, 0x5e, 0xca,
+	0x6b, 0xa2, 0x08, 0x79, 0x03, 0x26, 0x0e, 0xbd, 0xa0, 0x1b, 0x1e, 0xb2, 0xe5, 0xd3, 0xeb, 0xa7,
+	0x2f, 0x4e, 0x6d, 0x2d, 0x64, 0xa5, 0x7f, 0xc2, 0x99, 0xdb, 0xa3, 0x5f, 0x3c, 0x3d, 0x77, 0xaa,
+	0x29, 0x45, 0x1d, 0x07, 0x03, 0x7b, 0xfb, 0x30, 0xa0, 0x83, 0x02, 0xb3, 0x6f, 0x63, 0xb8, 0xb9,
+	0x8c, 0x19, 0xee, 0x30, 0x25, 0x48, 0x59, 0xfe, 0x41, 0x36, 0x60, 0x3a, 0xa2, 0x41, 0xd7, 0x0b,
+	0x7a, 0x2d, 0xc1, 0x1d, 0xe1, 0xdc, 0x32, 0x12, 0x39, 0x84, 0xf3, 0x2a, 0x94, 0x39, 0xe0, 0x76,
+	0x32, 0x08, 0xe8, 0x80, 0x15, 0xa8, 0x7d, 0x07, 0x16, 0x4c, 0x29, 0xa5, 0xb8, 0x01, 0x13, 0x6d,
+	0x41, 0xe2, 0x3f, 0xd6, 0xd4, 0x56, 0x45, 0x39, 0x2a, 0x44, 0xa5, 0x8f, 0x28, 0xa5, 0xd4, 0xbd,
+	0xeb, 0x05, 0xf1, 0x8b, 0xd5, 0xa1, 0x94, 0xa9, 0xae, 0x2f, 0x48, 0x39, 0x75, 0x42, 0x54, 0xaa,
+	0x43, 0x29, 0xa5, 0x8e, 0x47, 0xbd, 0x50, 0xdd, 0x55, 0x54, 0x87, 0x52, 0x4a, 0xdd, 0x32, 0x4c,
+	0x44, 0x82, 0x84, 0xa9, 0x28, 0x3f, 0x9d, 0x2b, 0x32, 0x1e, 0x69, 0xe6, 0xd1, 0xee, 0x8d, 0x7b,
+	0x6e, 0x10, 0x50, 0xbf, 0x08, 0xff, 0x1e, 0xac, 0x0d, 0x93, 0x56, 0x7a, 0x7e, 0x00, 0xb3, 0x6d,
+	0xc1, 0x6a, 0x75, 0x90, 0x87, 0xfe, 0x9d, 0xd5, 0xe1, 0xcc, 0x9c, 0x45, 0x3f, 0x2b, 0xed, 0x2c,
+	0xa2, 0xb2, 0x8b, 0x7b, 0xf2, 0xf6, 0x43, 0xda, 0x8f, 0xf8, 0xcd, 0x7e, 0x81, 0x5d, 0x96, 0xb4,
+	0x69, 0x17, 0x77, 0xb8, 0x45, 0x15, 0x2f, 0x67, 0x57, 0xf6, 0xac, 0xb4, 0x2b, 0xca, 0x22, 0x3a,
+	0x04, 0x66, 0xb9, 0x26, 0x34, 0xf4, 0xdd, 0xb0, 0x4b, 0x9d, 0x1d, 0x58, 0xb6, 0x69, 0x4a, 0xf3,
+	0x45, 0x18, 0xed, 0x87, 0x5d, 0xca, 0xcd, 0x9d, 0x31, 0x6e, 0x8f, 0x29, 0xcb, 0x25, 0x9c, 0x25,
+	0xf4, 0xf8, 0xba, 0xef, 0x87, 0x87, 0x46, 0x24, 0x6e, 0xa1, 0x6f, 0x16, 0x5d, 0x69, 0xf8, 0x06,
+	0xcc, 0xba, 0x82, 0x95, 0x8d, 0xf9, 0x64, 0xb3, 0xe2, 0x5a, 0x50, 0x73, 0x50, 0xe1, 0x50, 0x4d,
+	0x37, 0xa6, 0xbb, 0x5e, 0xdf, 0x8b, 0x99, 0xf3, 0x3e, 0x9c, 0xb5, 0x48, 0x0a, 0xf8, 0x2d, 0x98,
+	0x1a, 0xb8, 0x31, 0x6d, 0xf9, 0x9c, 0x8c, 0xf1, 0x22, 0xca, 0x03, 0x75, 0x02, 0x43, 0x05, 0x03,
+	0x8d, 0xda, 0xc0, 0x92, 0xa4, 0x64, 0x7e, 0xcc, 0xdc, 0x1e, 0x4f, 0x43, 0x34, 0x11, 0x7f, 0x3e,
+	0xf9, 0xe9, 0xfc, 0xfa, 0x34, 0xac, 0x0e, 0x39, 0xa1, 0x6c, 0xf9, 0x16, 0x80, 0xb6, 0x85, 0x1f,
+	0x3e, 0xce, 0x94, 0x49, 0x65, 0x0a, 0xb9, 0x0a, 0xa3, 0xfb, 0x7e, 0x78, 0xc8, 0x2b, 0xc6, 0xd4,
+	0xd6, 0x52, 0xfe, 0xc8, 0x4d, 0x5f, 0xd5, 0x2f, 0x2e, 0x49, 0x6e, 0xc2, 0xd8, 0x83, 0x24, 0x8c,
+	0xdd, 0xe5, 0xd3, 0xa9, 0x89, 0xdb, 0x57, 0x53, 0xd6, 0x3f, 0x9e, 0x9e, 0x5b, 0x14, 0xcf, 0x11,
+	0xeb, 0xde, 0xaf, 0x7b, 0x61, 0xa3, 0xef, 0xc6, 0xf7, 0xea, 0xb7, 0x82, 0xf8, 0xaf, 0x7f, 0x7a,
+	0x1d, 0xf0, 0x9d, 0xba, 0x15, 0xc4, 0x9f, 0xfd, 0xfb, 0x8f, 0x97, 0x4a, 0x4d, 0x71, 0x9c, 0xfc,
+	0x0c, 0xe6, 0x06, 0xb4, 0xef, 0x7a, 0x01, 0x2f, 0x5b, 0x49, 0xcc, 0xcd, 0x18, 0x3d, 0x21, 0xe6,
+	0xac, 0x82, 0xba, 0x2d, 0x90, 0xc8, 0x87, 0xa0, 0x69, 0x2d, 0x2f, 0xe0, 0xe8, 0x63, 0x27, 0x44,
+	0xaf, 0x28, 0xa4, 0x5b, 0x1c, 0xc8, 0x99, 0x87, 0x39, 0x71, 0x9f, 0x06, 0x5e, 0x87, 0xee, 0xd1,
+	0x98, 0x97, 0xa0, 0xef, 0xc3, 0x4a, 0x8e, 0xa8, 0x7e, 0xa0, 0xb4, 0x44, 0xa7, 0xf4, 0x16, 0x13,
+	0x0c, 0x4c, 0xc1, 0x72, 0x64, 0x22, 0xc8, 0xcb, 0xc3, 0x11, 0x9a, 0x6e, 0xd0, 0xa3, 0xcc, 0xf9,
+	0x73, 0x09, 0x6f, 0x8f, 0x41, 0x54, 0xa8, 0xdf, 0x01, 0x01, 0xd0, 0x1a, 0x70, 0x3a, 0xe6, 0xa0,
+	0xf1, 0x62, 0xa9, 0x33, 0xf8, 0x13, 0x4e, 0x45, 0x1a, 0x85, 0xec, 0xc3, 0x7c, 0xdf, 0x7d, 0xd8,
+	0x12, 0x08, 0x5d, 0x7a, 0xe0, 0xf1, 0xc7, 0x5f, 0x3c, 0x1e, 0xdb, 0x6f, 0x62, 0x94, 0x56, 0xf3,
+	0x51, 0xda, 0xa5, 0x3d, 0xb7, 0x73, 0xb4, 0x43, 0x3b, 0x46, 0xac, 0x76, 0x68, 0x47, 0xc4, 0x6a,
+	0xae, 0xef, 0x3e, 0xe4, 0x6a, 0x77, 0x24, 0xa0, 0xea, 0x23, 0x38, 0xd9, 0x69, 0xe3, 0xc3, 0x26,
+	0x6c, 0x93, 0x9e, 0xec, 0xc2, 0x18, 0xb7, 0x43, 0x24, 0xfe, 0x89, 0xb5, 0x0b, 0x10, 0x67, 0x13,
+	0x1b, 0x83, 0xbd, 0x7b, 0xee, 0x80, 0xb2, 0xf4, 0x5e, 0xb9, 0xdd, 0xee, 0x80, 0x32, 0x26, 0xef,
+	0x15, 0x7e, 0x3a, 0x7f, 0x90, 0xcd, 0x81, 0x90, 0x34, 0x0a, 0xe2, 0x38, 0xe3, 0x14, 0xb4, 0xe7,
+	0xe5, 0x73, 0x06, 0xcf, 0x93, 0x1f, 0xc2, 0x44, 0xdb, 0xf5, 0xdd, 0xa0, 0x43, 0x31, 0xb0, 0x2f,
+	0x0f, 0x25, 0x01, 0x54, 0x7e, 0xbc, 0x1f, 0xc6, 0xae, 0x2f, 0x2c, 0x76, 0xfe, 0x22, 0xf3, 0xc3,
+	0x20, 0x2a, 0x37, 0xf6, 0xa0, 0x1c, 0xa7, 0xe4, 0xd6, 0xff, 0xe8, 0xcc, 0x54, 0xac, 0xc1, 0x0d,
+	0xd0, 0x24, 0x8a, 0xfc, 0xa3, 0x13, 0xbb, 0x85, 0xa0, 0x1c, 0x44, 0x95, 0xde, 0xbd, 0xd8, 0x6d,
+	0xfb, 0x34, 0x6d, 0x88, 0x55, 0xe9, 0xd5, 0x24, 0xa3, 0xf4, 0x02, 0x53, 0x54, 0x2c, 0x77, 0x3a,
+	0xeb, 0xf5, 0x01, 0x59, 0x7a, 0xb5, 0xb0, 0xb3, 0x81, 0x57, 0x77, 0x2f, 0x69, 0xb3, 0xce, 0xc0,
+	0xe3, 0xcf, 0x16, 0x99, 0x81, 0x11, 0x4f, 0xf4, 0x81, 0xa3, 0xcd, 0x11, 0xaf, 0xeb, 0xfc, 0x14,
+	0xaf, 0xb2, 0x29, 0xa4, 0x94, 0x7f, 0x0f, 0xca, 0xcc, 0xa0, 0xa3, 0xfa, 0x45, 0xad, 0xde, 0x60,
+	0xa2, 0x01, 0x99, 0x03, 0xce, 0xa7, 0x25, 0xbc, 0x02, 0xa6, 0x24, 0x23, 0x04, 0x46, 0xd3, 0x9e,
+	0x03, 0x53, 0x94, 0xff, 0x4d, 0xea, 0x30, 0xce, 0x62, 0x37, 0x4e, 0x18, 0x8f, 0xf2, 0x8c, 0x59,
+	0xa0, 0xe9, 0x83, 0x84, 0xb2, 0x78, 0x8f, 0x73, 0x9b, 0x28, 0x45, 0x6e, 0x02, 0xe8, 0x91, 0x83,
+	0x57, 0xe8, 0xa9, 0xad, 0xd7, 0xea, 0x18, 0xf9, 0x74, 0xe6, 0xa8, 0x8b, 0x79, 0x02, 0x27, 0x8f,
+	0xfa, 0x1d, 0xfe, 0x88, 0x70, 0xa4, 0xa6, 0x71, 0xd2, 0xf9, 0xac, 0x04, 0xd5, 0xbc, 0x89, 0x2a,
+	0x04, 0xd7, 0x61, 0xda, 0xf4, 0x48, 0x16, 0x9e, 0x63, 0x63, 0x90, 0x3d, 0x41, 0xde, 0xc9, 0x58,
+	0x2a, 0x9e, 0x9f, 0xcd, 0x17, 0x5a, 0x2a, 0xf4, 0x67, 0x4c, 0x3d, 0x2f, 0x1f, 0x6d, 0xda, 0xc5,
+	0x2e, 0x24, 0xf7, 0x73, 0xaa, 0x47, 0x5c, 0x89, 0x98, 0x99, 0x34, 0x50, 0xd4, 0x5c, 0x26, 0xe9,
+	0x03, 0xea, 0x11, 0x57, 0x14, 0xe7, 0x77, 0x25, 0xbc, 0x8e, 0x5a, 0xea, 0xeb, 0xf5, 0x23, 0x7e,
+	0x2a, 0x4b, 0x83, 0x61, 0xa0, 0x72, 0xfc, 0xdb, 0x30, 0xa5, 0x7d, 0xc9, 0xbf, 0x1c, 0x39, 0xcf,
+	0x4d, 0xe9, 0xff, 0xdf, 0x8f, 0xf7, 0x11, 0x36, 0x75, 0x5a, 0xdd, 0x7b, 0x09, 0x4d, 0xa8, 0x15,
+	0x82, 0xd2, 0x89, 0x43, 0xf0, 0xfb, 0x12, 0x76, 0x87, 0x96, 0x82, 0xaf, 0x59, 0x18, 0x64, 0x3f,
+	0xb1, 0x77, 0xe8, 0x46, 0x37, 0xdd, 0x8e, 0xe7, 0x7b, 0xf1, 0x91, 0xf3, 0xd5, 0x88, 0xac, 0x42,
+	0x06, 0x55, 0x19, 0x7e, 0x0d, 0xc6, 0x3b, 0x61, 0xb0, 0xef, 0xf5, 0xf2, 0xe5, 0xef, 0xd0, 0x8d,
+	0x6e, 0x70, 0x16, 0xda, 0x8c, 0x82, 0xc6, 0xc4, 0x3b, 0x92, 0x99, 0x78, 0xb7, 0x61, 0xd2, 0xf7,
+	0x1e, 0x24, 0x5e, 0xd7, 0x8b, 0x8f, 0x30, 0xdd, 0x56, 0x32, 0x5e, 0x48, 0xfb, 0x6f, 0xa4, 0x25,
+	0x75, 0x32, 0xc5, 0x14, 0x15, 0x5c, 0x1f, 0x4b, 0xdb, 0x2d, 0x31, 0x8a, 0xb5, 0x78, 0x53, 0xcd,
+	0xdf, 0xbb, 0x93, 0x36, 0x73, 0x15, 0x81, 0x74, 0x5d, 0x02, 0xa5, 0xe0, 0x62, 0xac, 0x34, 0xc0,
+	0x4f, 0xdc, 0xcb, 0x09, 0x24, 0x05, 0xee, 0x5c, 0x96, 0x2f, 0xcf, 0xa1, 0x1b, 0xdd, 0x0d, 0xfd,
+	0xa4, 0x4f, 0x8f, 0xe9, 0x17, 0x3e, 0x2f, 0xc9, 0x47, 0x49, 0x49, 0x9b, 0x3d, 0xc3, 0x01, 0xa7,
+	0x9c, 0xbc, 0x67, 0x10, 0xe7, 0xc9, 0x8f, 0x60, 0x52, 0x75, 0x9c, 0xc6, 0xf3, 0x5a, 0x7a, 0x29,
+	0x30, 0x0d, 0xa1, 0xd2, 0x6b, 0x37, 0xec, 0xdc, 0x4f, 0xa2, 0x3b, 0x74, 0xe0, 0x85, 0x5d, 0x87,
+	0x62, 0x76, 0x99, 0x44, 0xc3, 0x97, 0x69, 0x9f, 0xd3, 0x5b, 0x11, 0x67, 0x60, 0x92, 0xad, 0xd4,
+	0xc5, 0xca, 0xa8, 0x2e, 0x57, 0x46, 0xf5, 0x1d, 0x5c, 0x19, 0x6d, 0x9f, 0x49, 0xbd, 0xfd, 0xed,
+	0x3f, 0xcf, 0x95, 0x9a, 0x65, 0xdf, 0x54, 0x53, 0xc7, 0xb7, 0x6e, 0x97, 0xcf, 0xaf, 0xdb, 0xa2,
+	0x93, 0x39, 0x26, 0xc2, 0xcf, 0xe4, 0xcb, 0x93, 0x39, 0x60, 0x06, 0x59, 0x4c, 0xc2, 0x27, 0x0f,
+	0xb2, 0x38, 0x4f, 0x76, 0xe1, 0x4c, 0x12, 0x20, 0xd6, 0x49, 0x5b, 0x18, 0x85, 0x40, 0xae, 0xc0,
+	0xa8, 0x1f, 0xc6, 0x72, 0x0b, 0xa4, 0x47, 0x2f, 0xe1, 0xc5, 0x6e, 0x28, 0x47, 0x2f, 0x2e, 0xe5,
+	0xcc, 0xc2, 0x8c, 0x98, 0x59, 0xe3, 0x98, 0xb2, 0x38, 0x1c, 0x30, 0xe7, 0x4d, 0x58, 0xca, 0x52,
+	0x94, 0xc7, 0x6b, 0x30, 0xe9, 0x4a, 0x22, 0x4e, 0x0d, 0x9a, 0xa0, 0x7e, 0x5a, 0x71, 0x4e, 0xac,
+	0xf7, 0x9c, 0x0f, 0xf1, 0xa7, 0x35, 0x89, 0x0a, 0xef, 0xbb, 0x50, 0x76, 0x0d, 0x3a, 0x96, 0x3c,
+	0x3d, 0x79, 0x1b, 0x87, 0x64, 0xf7, 0x62, 0xca, 0x3b, 0x15, 0x98, 0xc6, 0x8a, 0xca, 0xe8, 0xe0,
+	0x80, 0x32, 0xe7, 0xf3, 0x11, 0x58, 0xcc, 0x50, 0x94, 0xaa, 0x37, 0xac, 0x1a, 0x65, 0x3e, 0x7c,
+	0x5c, 0x74, 0x68, 0x99, 0xba, 0x02, 0xe3, 0xbe, 0x9b, 0x2a, 0xc4, 0x8a, 0x3a, 0xd4, 0xb4, 0x26,
+	0xca, 0x90, 0x05, 0x18, 0x63, 0xb1, 0xeb, 0x53, 0x5e, 0xb8, 0xce, 0x34, 0xc5, 0x07, 0xef, 0xdf,
+	0x45, 0x77, 0x3a, 0x7a, 0xe2, 0xfe, 0x9d, 0x9f, 0x27, 0x77, 0x81, 0xe8, 0x39, 0xb2, 0xe3, 0x46,
+	0x6e, 0x27, 0xad, 0x92, 0xa2, 0xfa, 0x6c, 0xfe, 0x97, 0x88, 0x4d, 0x3d, 0xe9, 0xde, 0x40, 0x04,
+	0x67, 0x53, 0xb5, 0x2d, 0x9d, 0xf0, 0x80, 0x0e, 0x8a, 0x77, 0x56, 0x6f, 0xa9, 0xe6, 0x45, 0x0a,
+	0xaa, 0xf8, 0xd6, 0xd2, 0xe6, 0x45, 0x52, 0x31, 0x37, 0x0c, 0xca, 0xd6, 0xd3, 0x55, 0x18, 0xe3,
+	0x67, 0xc9, 0x5d, 0x18, 0xe3, 0xcb, 0x57, 0xa2, 0x9f, 0x09, 0xbd, 0x91, 0xad, 0xae, 0x0e, 0x21,
+	0x4a, 0x25, 0xce, 0xea, 0xaf, 0xd2, 0x78, 0xfc, 0xe2, 0x6f, 0x5f, 0xfd, 0x66, 0x64, 0x96, 0xcc,
+	0x34, 0xe4, 0x0a, 0x9c, 0x1b, 0x47, 0x3e, 0x80, 0x71, 0xb1, 0xd4, 0x25, 0x0b, 0x43, 0x30, 0x58,
+	0x75, 0x6d, 0x18, 0x55, 0x41, 0xaf, 0x69, 0xe8, 0x39, 0x52, 0xc9, 0x42, 0xb3, 0x14, 0x5b, 0x2e,
+	0x76, 0xb3, 0x28, 0x82, 0x6a, 0x63, 0x67, 0xd7, 0xbb, 0x45, 0xd8, 0xf8, 0xe4, 0xdd, 0x85, 0x31,
+	0xb1, 0x7c, 0xb5, 0xe2, 0xc1, 0x89, 0x76, 0x3c, 0x32, 0x2b, 0xd8, 0xa2, 0x78, 0x88, 0x4d, 0xec,
+	0x47, 0x30, 0x21, 0xf7, 0xab, 0x8b, 0x59, 0x10, 0x24, 0x57, 0x5f, 0x19, 0x4a, 0x56, 0xe8, 0xaf,
+	0x68, 0x74, 0x42, 0x66, 0x15, 0x3a, 0x6e, 0x55, 0x53, 0x7c, 0xb9, 0x50, 0xb5, 0xf0, 0x91, 0x6c,
+	0xe3, 0x5b, 0x8b, 0xd5, 0x22, 0x7c, 0x5c, 0xa3, 0xa6, 0xf8, 0x72, 0x83, 0xba, 0x38, 0x24, 0xbc,
+	0x79, 0x7c, 0x6b, 0x93, 0x5a, 0x84, 0x8f, 0xeb, 0x54, 0xf2, 0x04, 0x2a, 0xf6, 0x26, 0xd5, 0x0e,
+	0x48, 0x96, 0x5d, 0xbd, 0x70, 0x2c, 0x5b, 0xe9, 0x7d, 0x4d, 0xeb, 0x5d, 0x25, 0x2b, 0x3a, 0x6e,
+	0xd6, 0xb6, 0x35, 0x35, 0xc0, 0x5e, 0x99, 0x0e, 0xf3, 0x48, 0xb3, 0x6d, 0x03, 0x0a, 0x56, 0xa8,
+	0x45, 0x06, 0xd8, 0x6b, 0x55, 0x12, 0xc2, 0x94, 0xb1, 0xdb, 0x24, 0x2b, 0x59, 0x74, 0x83, 0x55,
+	0x3d, 0x5f, 0xc8, 0x52, 0x4a, 0x1d, 0xad, 0xf4, 0x2c, 0x59, 0x54, 0x4a, 0xd1, 0xdb, 0x56, 0x3f,
+	0xd5, 0xf0, 0x04, 0x2a, 0xd6, 0x6a, 0xd4, 0xf6, 0xd8, 0x62, 0xdb, 0x1e, 0x17, 0x2c, 0x56, 0x8b,
+	0x3c, 0xb6, 0x97, 0xad, 0xe4, 0x3e, 0x80, 0xde, 0x9e, 0x92, 0xe5, 0x2c, 0xb8, 0xe6, 0x54, 0xd7,
+	0x8b, 0x38, 0x4a, 0xe3, 0x79, 0xad, 0x71, 0x89, 0x2c, 0x28, 0x8d, 0xc6, 0x16, 0x96, 0xfc, 0xb2,
+	0x04, 0x33, 0xd6, 0x56, 0x75, 0xad, 0x00, 0x97, 0x73, 0xab, 0xaf, 0x1e, 0xc7, 0x55, 0x9a, 0xaf,
+	0x6a, 0xcd, 0x17, 0xc8, 0xc6, 0x10, 0xcd, 0xad, 0x24, 0x95, 0x6f, 0x3c, 0x42, 0xaf, 0x1f, 0x13,
+	0x06, 0x65, 0x73, 0x11, 0x48, 0xaa, 0x56, 0x1a, 0x19, 0xbc, 0xaa, 0x53, 0xcc, 0x53, 0x16, 0x6c,
+	0x68, 0x0b, 0x96, 0xc9, 0x92, 0xce, 0x2f, 0x73, 0xa9, 0x98, 0x26, 0x97, 0xb1, 0x26, 0xb4, 0x93,
+	0xcb, 0x60, 0xd9, 0xc9, 0x35, 0x64, 0xb9, 0x58, 0x94, 0x5c, 0xe6, 0xc2, 0x31, 0xad, 0xa3, 0xfc,
+	0xa8, 0x5d, 0x47, 0x39, 0xd1, 0xae, 0xa3, 0x99, 0x8d, 0x5f, 0x51, 0x1d, 0xe5, 0xf0, 0xa4, 0x0b,
+	0xe3, 0xb8, 0x6d, 0xb2, 0x6a, 0xbf, 0xa0, 0xda, 0xb5, 0x3f, 0xbb, 0xf6, 0x2a, 0xca, 0x4c, 0xb1,
+	0x04, 0x6b, 0x3c, 0xc2, 0x56, 0xf3, 0x71, 0x1a, 0x2e, 0x63, 0x6b, 0x66, 0x87, 0xcb, 0x60, 0xd9,
+	0xe1, 0x1a, 0xb2, 0x6b, 0x2b, 0x0a, 0x97, 0xb9, 0x7f, 0x23, 0x1f, 0x03, 0xe8, 0xe5, 0x94, 0x7d,
+	0x15, 0x34, 0xc7, 0xbe, 0x0a, 0xf9, 0x0d, 0x98, 0xb3, 0xae, 0xb5, 0x2d, 0x92, 0x79, 0xed, 0xa2,
+	0x46, 0x3f, 0x84, 0x72, 0x66, 0xc7, 0x65, 0x25, 0xa0, 0xc9, 0xb3, 0x13, 0x70, 0xd8, 0xda, 0xcb,
+	0xd9, 0xd4, 0x1a, 0xd7, 0x48, 0x55, 0x6b, 0x34, 0x64, 0x1b, 0x8f, 0xbc, 0xee, 0x63, 0x72, 0x00,
+	0xd3, 0xd9, 0xc5, 0xd6, 0x6a, 0x31, 0x3a, 0xab, 0x6e, 0x1c, 0xc3, 0x7c, 0x51, 0xf2, 0x67, 0x37,
+	0x4a, 0x21, 0x80, 0xb1, 0x03, 0xb2, 0xeb, 0x8c, 0xe2, 0xe4, 0xea, 0x4c, 0x6e, 0x29, 0xe4, 0x5c,
+	0xd0, 0xea, 0xaa, 0x64, 0x59, 0xdf, 0x76, 0x25, 0x29, 0x1c, 0x0d, 0x60, 0xca, 0x5c, 0xfd, 0xac,
+	0x14, 0xe1, 0xe6, 0xd2, 0x67, 0xc8, 0x3e, 0xa6, 0xb0, 0xb6, 0x19, 0x0a, 0x9e, 0x40, 0xc5, 0xde,
+	0x93, 0xbc, 0x52, 0x04, 0xcc, 0xd9, 0x76, 0x25, 0x2f, 0x58, 0x82, 0x14, 0xdd, 0x17, 0xad, 0xbb,
+	0xf5, 0x80, 0x6b, 0x63, 0x50, 0x36, 0x77, 0x11, 0xb9, 0x94, 0x32, 0x78, 0xb9, 0x94, 0x1a, 0xb2,
+	0xc3, 0x28, 0xfc, 0x59, 0x0f, 0xdd, 0xa8, 0xb5, 0x2f, 0x95, 0x24, 0x00, 0xe6, 0x68, 0x9e, 0x87,
+	0x15, 0x9c, 0xdc, 0x9d, 0xc9, 0x0d, 0xe8, 0xce, 0x65, 0xad, 0x6e, 0x9d, 0xd4, 0xb2, 0xea, 0xc4,
+	0xe4, 0x6d, 0xd4, 0x06, 0x06, 0x65, 0x73, 0x32, 0xb6, 0x7d, 0x35, 0x79, 0xb6, 0xaf, 0xc3, 0x26,
+	0xea, 0x22, 0x5f, 0x33, 0x53, 0x36, 0xf9, 0x79, 0x09, 0xa6, 0xb3, 0x83, 0xf2, 0x6a, 0x1e, 0x5a,
+	0x31, 0xed, 0xbb, 0x33, 0x74, 0x62, 0x76, 0xea, 0x5a, 0xf1, 0x06, 0x39, 0x9f, 0x51, 0x4c, 0xbb,
+	0x2d, 0xfc, 0x5f, 0x0b, 0x86, 0xe3, 0xfb, 0x30, 0xa9, 0x86, 0x50, 0x72, 0xd6, 0x6a, 0x05, 0x24,
+	0xa3, 0x7a, 0xae, 0x80, 0xa1, 0xd4, 0x9e, 0xd3, 0x6a, 0x17, 0x08, 0xd1, 0xdd, 0x81, 0x82, 0x1e,
+	0x40, 0xd9, 0x9c, 0x4f, 0xed, 0x00, 0x9b, 0x3c, 0x3b, 0xc0, 0xc3, 0xe6, 0xda, 0xa2, 0xfa, 0x6b,
+	0xce, 0xae, 0xa4, 0x0d, 0x67, 0xe4, 0x90, 0x4a, 0x96, 0xec, 0xbb, 0x21, 0xe8, 0xd5, 0xda, 0x70,
+	0xba, 0xd2, 0x53, 0xd3, 0x7a, 0xe6, 0xc9, 0x9c, 0x71, 0x59, 0x10, 0xf7, 0xe3, 0xb4, 0x0c, 0xa9,
+	0x99, 0x2e, 0x57, 0x86, 0x24, 0x27, 0x5f, 0x86, 0xec, 0xf1, 0xae, 0xa8, 0xc6, 0xeb, 0x01, 0x6f,
+	0xfb, 0xed, 0x2f, 0x9e, 0xd5, 0x4a, 0x5f, 0x3e, 0xab, 0x95, 0xfe, 0xf5, 0xac, 0x56, 0xfa, 0xe4,
+	0x79, 0xed, 0xd4, 0x97, 0xcf, 0x6b, 0xa7, 0xfe, 0xfe, 0xbc, 0x76, 0xea, 0x83, 0xcb, 0x3d, 0x2f,
+	0xbe, 0x97, 0xb4, 0xeb, 0x9d, 0xb0, 0xdf, 0x08, 0x83, 0xae, 0xf8, 0xa7, 0x41, 0x9d, 0xd0, 0x6f,
+	0x24, 0xac, 0x7b, 0xf4, 0x7a, 0x10, 0xb6, 0x7d, 0xda, 0x38, 0xd8, 0x6a, 0xc4, 0x47, 0x11, 0x65,
+	0xed, 0x71, 0xce, 0xfd, 0xe6, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x7c, 0xbb, 0xd2, 0x11,
+	0x25, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Denom(ctx context.Context, in *QueryDenom, opts ...grpc.CallOption) (*QueryDenomResponse, error)
+	Denoms(ctx context.Context, in *QueryDenoms, opts ...grpc.CallOption) (*QueryDenomsResponse, error)
+	Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error)
+	Owner(ctx context.Context, in *QueryOwner, opts ...grpc.CallOption) (*QueryOwnerResponse, error)
+	Burners(ctx context.Context, in *QueryBurners, opts ...grpc.CallOption) (*QueryBurnersResponse, error)
+	Minters(ctx context.Context, in *QueryMinters, opts ...grpc.CallOption) (*QueryMintersResponse, error)
+	Pausers(ctx context.Context, in *QueryPausers, opts ...grpc.CallOption) (*QueryPausersResponse, error)
+	BlockedChannels(ctx context.Context, in *QueryBlockedChannels, opts ...grpc.CallOption) (*QueryBlockedChannelsResponse, error)
+	PauseExemptions(ctx context.Context, in *QueryPauseExemptions, opts ...grpc.CallOption) (*QueryPauseExemptionsResponse, error)
+	ChannelMode(ctx context.Context, in *QueryChannelMode, opts ...grpc.CallOption) (*QueryChannelModeResponse, error)
+	AllowedChannels(ctx context.Context, in *QueryAllowedChannels, opts ...grpc.CallOption) (*QueryAllowedChannelsResponse, error)
+	RateLimits(ctx context.Context, in *QueryRateLimits, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	RateLimitUsage(ctx context.Context, in *QueryRateLimitUsage, opts ...grpc.CallOption) (*QueryRateLimitUsageResponse, error)
+	PriceSetters(ctx context.Context, in *QueryPriceSetters, opts ...grpc.CallOption) (*QueryPriceSettersResponse, error)
+	PriceRanges(ctx context.Context, in *QueryPriceRanges, opts ...grpc.CallOption) (*QueryPriceRangesResponse, error)
+	Price(ctx context.Context, in *QueryPrice, opts ...grpc.CallOption) (*QueryPriceResponse, error)
+	Shares(ctx context.Context, in *QueryShares, opts ...grpc.CallOption) (*QuerySharesResponse, error)
+	TotalShares(ctx context.Context, in *QueryTotalShares, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error)
+	Stablecoin(ctx context.Context, in *QueryStablecoin, opts ...grpc.CallOption) (*QueryStablecoinResponse, error)
+	Subscription(ctx context.Context, in *QuerySubscription, opts ...grpc.CallOption) (*QuerySubscriptionResponse, error)
+	Subscriptions(ctx context.Context, in *QuerySubscriptions, opts ...grpc.CallOption) (*QuerySubscriptionsResponse, error)
+	Redemption(ctx context.Context, in *QueryRedemption, opts ...grpc.CallOption) (*QueryRedemptionResponse, error)
+	Redemptions(ctx context.Context, in *QueryRedemptions, opts ...grpc.CallOption) (*QueryRedemptionsResponse, error)
+	RedemptionQueue(ctx context.Context, in *QueryRedemptionQueue, opts ...grpc.CallOption) (*QueryRedemptionQueueResponse, error)
+	SwapFacility(ctx context.Context, in *QuerySwapFacility, opts ...grpc.CallOption) (*QuerySwapFacilityResponse, error)
+	SwapVolume(ctx context.Context, in *QuerySwapVolume, opts ...grpc.CallOption) (*QuerySwapVolumeResponse, error)
+	LockupPeriod(ctx context.Context, in *QueryLockupPeriod, opts ...grpc.CallOption) (*QueryLockupPeriodResponse, error)
+	LockedBalance(ctx context.Context, in *QueryLockedBalance, opts ...grpc.CallOption) (*QueryLockedBalanceResponse, error)
+	Attestors(ctx context.Context, in *QueryAttestors, opts ...grpc.CallOption) (*QueryAttestorsResponse, error)
+	Attestations(ctx context.Context, in *QueryAttestations, opts ...grpc.CallOption) (*QueryAttestationsResponse, error)
+	Reserves(ctx context.Context, in *QueryReserves, opts ...grpc.CallOption) (*QueryReservesResponse, error)
+	Recoverers(ctx context.Context, in *QueryRecoverers, opts ...grpc.CallOption) (*QueryRecoverersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Denom(ctx context.Context, in *QueryDenom, opts ...grpc.CallOption) (*QueryDenomResponse, error) {
+	out := new(QueryDenomResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Denom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Denoms(ctx context.Context, in *QueryDenoms, opts ...grpc.CallOption) (*QueryDenomsResponse, error) {
+	out := new(QueryDenomsResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Denoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error) {
+	out := new(QueryPausedResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Paused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Owner(ctx context.Context, in *QueryOwner, opts ...grpc.CallOption) (*QueryOwnerResponse, error) {
+	out := new(QueryOwnerResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Owner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Burners(ctx context.Context, in *QueryBurners, opts ...grpc.CallOption) (*QueryBurnersResponse, error) {
+	out := new(QueryBurnersResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Burners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Minters(ctx context.Context, in *QueryMinters, opts ...grpc.CallOption) (*QueryMintersResponse, error) {
+	out := new(QueryMintersResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Minters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Pausers(ctx context.Context, in *QueryPausers, opts ...grpc.CallOption) (*QueryPausersResponse, error) {
+	out := new(QueryPausersResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Pausers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedChannels(ctx context.Context, in *QueryBlockedChannels, opts ...grpc.CallOption) (*QueryBlockedChannelsResponse, error) {
+	out := new(QueryBlockedChannelsResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/BlockedChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PauseExemptions(ctx context.Context, in *QueryPauseExemptions, opts ...grpc.CallOption) (*QueryPauseExemptionsResponse, error) {
+	out := new(QueryPauseExemptionsResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/PauseExemptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelMode(ctx context.Context, in *QueryChannelMode, opts ...grpc.CallOption) (*QueryChannelModeResponse, error) {
+	out := new(QueryChannelModeResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/ChannelMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedChannels(ctx context.Context, in *QueryAllowedChannels, opts ...grpc.CallOption) (*QueryAllowedChannelsResponse, error) {
+	out := new(QueryAllowedChannelsResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/AllowedChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimits, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitUsage(ctx context.Context, in *QueryRateLimitUsage, opts ...grpc.CallOption) (*QueryRateLimitUsageResponse, error) {
+	out := new(QueryRateLimitUsageResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/RateLimitUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceSetters(ctx context.Context, in *QueryPriceSetters, opts ...grpc.CallOption) (*QueryPriceSettersResponse, error) {
+	out := new(QueryPriceSettersResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/PriceSetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceRanges(ctx context.Context, in *QueryPriceRanges, opts ...grpc.CallOption) (*QueryPriceRangesResponse, error) {
+	out := new(QueryPriceRangesResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/PriceRanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Price(ctx context.Context, in *QueryPrice, opts ...grpc.CallOption) (*QueryPriceResponse, error) {
+	out := new(QueryPriceResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Price", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Shares(ctx context.Context, in *QueryShares, opts ...grpc.CallOption) (*QuerySharesResponse, error) {
+	out := new(QuerySharesResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Shares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalShares(ctx context.Context, in *QueryTotalShares, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error) {
+	out := new(QueryTotalSharesResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/TotalShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Stablecoin(ctx context.Context, in *QueryStablecoin, opts ...grpc.CallOption) (*QueryStablecoinResponse, error) {
+	out := new(QueryStablecoinResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Stablecoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Subscription(ctx context.Context, in *QuerySubscription, opts ...grpc.CallOption) (*QuerySubscriptionResponse, error) {
+	out := new(QuerySubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Subscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Subscriptions(ctx context.Context, in *QuerySubscriptions, opts ...grpc.CallOption) (*QuerySubscriptionsResponse, error) {
+	out := new(QuerySubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Subscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Redemption(ctx context.Context, in *QueryRedemption, opts ...grpc.CallOption) (*QueryRedemptionResponse, error) {
+	out := new(QueryRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Redemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Redemptions(ctx context.Context, in *QueryRedemptions, opts ...grpc.CallOption) (*QueryRedemptionsResponse, error) {
+	out := new(QueryRedemptionsResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Redemptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedemptionQueue(ctx context.Context, in *QueryRedemptionQueue, opts ...grpc.CallOption) (*QueryRedemptionQueueResponse, error) {
+	out := new(QueryRedemptionQueueResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/RedemptionQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwapFacility(ctx context.Context, in *QuerySwapFacility, opts ...grpc.CallOption) (*QuerySwapFacilityResponse, error) {
+	out := new(QuerySwapFacilityResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/SwapFacility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwapVolume(ctx context.Context, in *QuerySwapVolume, opts ...grpc.CallOption) (*QuerySwapVolumeResponse, error) {
+	out := new(QuerySwapVolumeResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/SwapVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockupPeriod(ctx context.Context, in *QueryLockupPeriod, opts ...grpc.CallOption) (*QueryLockupPeriodResponse, error) {
+	out := new(QueryLockupPeriodResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/LockupPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockedBalance(ctx context.Context, in *QueryLockedBalance, opts ...grpc.CallOption) (*QueryLockedBalanceResponse, error) {
+	out := new(QueryLockedBalanceResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/LockedBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Attestors(ctx context.Context, in *QueryAttestors, opts ...grpc.CallOption) (*QueryAttestorsResponse, error) {
+	out := new(QueryAttestorsResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Attestors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Attestations(ctx context.Context, in *QueryAttestations, opts ...grpc.CallOption) (*QueryAttestationsResponse, error) {
+	out := new(QueryAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Attestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Reserves(ctx context.Context, in *QueryReserves, opts ...grpc.CallOption) (*QueryReservesResponse, error) {
+	out := new(QueryReservesResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Reserves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Recoverers(ctx context.Context, in *QueryRecoverers, opts ...grpc.CallOption) (*QueryRecoverersResponse, error) {
+	out := new(QueryRecoverersResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Query/Recoverers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Denom(context.Context, *QueryDenom) (*QueryDenomResponse, error)
+	Denoms(context.Context, *QueryDenoms) (*QueryDenomsResponse, error)
+	Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error)
+	Owner(context.Context, *QueryOwner) (*QueryOwnerResponse, error)
+	Burners(context.Context, *QueryBurners) (*QueryBurnersResponse, error)
+	Minters(context.Context, *QueryMinters) (*QueryMintersResponse, error)
+	Pausers(context.Context, *QueryPausers) (*QueryPausersResponse, error)
+	BlockedChannels(context.Context, *QueryBlockedChannels) (*QueryBlockedChannelsResponse, error)
+	PauseExemptions(context.Context, *QueryPauseExemptions) (*QueryPauseExemptionsResponse, error)
+	ChannelMode(context.Context, *QueryChannelMode) (*QueryChannelModeResponse, error)
+	AllowedChannels(context.Context, *QueryAllowedChannels) (*QueryAllowedChannelsResponse, error)
+	RateLimits(context.Context, *QueryRateLimits) (*QueryRateLimitsResponse, error)
+	RateLimitUsage(context.Context, *QueryRateLimitUsage) (*QueryRateLimitUsageResponse, error)
+	PriceSetters(context.Context, *QueryPriceSetters) (*QueryPriceSettersResponse, error)
+	PriceRanges(context.Context, *QueryPriceRanges) (*QueryPriceRangesResponse, error)
+	Price(context.Context, *QueryPrice) (*QueryPriceResponse, error)
+	Shares(context.Context, *QueryShares) (*QuerySharesResponse, error)
+	TotalShares(context.Context, *QueryTotalShares) (*QueryTotalSharesResponse, error)
+	Stablecoin(context.Context, *QueryStablecoin) (*QueryStablecoinResponse, error)
+	Subscription(context.Context, *QuerySubscription) (*QuerySubscriptionResponse, error)
+	Subscriptions(context.Context, *QuerySubscriptions) (*QuerySubscriptionsResponse, error)
+	Redemption(context.Context, *QueryRedemption) (*QueryRedemptionResponse, error)
+	Redemptions(context.Context, *QueryRedemptions) (*QueryRedemptionsResponse, error)
+	RedemptionQueue(context.Context, *QueryRedemptionQueue) (*QueryRedemptionQueueResponse, error)
+	SwapFacility(context.Context, *QuerySwapFacility) (*QuerySwapFacilityResponse, error)
+	SwapVolume(context.Context, *QuerySwapVolume) (*QuerySwapVolumeResponse, error)
+	LockupPeriod(context.Context, *QueryLockupPeriod) (*QueryLockupPeriodResponse, error)
+	LockedBalance(context.Context, *QueryLockedBalance) (*QueryLockedBalanceResponse, error)
+	Attestors(context.Context, *QueryAttestors) (*QueryAttestorsResponse, error)
+	Attestations(context.Context, *QueryAttestations) (*QueryAttestationsResponse, error)
+	Reserves(context.Context, *QueryReserves) (*QueryReservesResponse, error)
+	Recoverers(context.Context, *QueryRecoverers) (*QueryRecoverersResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Denom(ctx context.Context, req *QueryDenom) (*QueryDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Denom not implemented")
+}
+func (*UnimplementedQueryServer) Denoms(ctx context.Context, req *QueryDenoms) (*QueryDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Denoms not implemented")
+}
+func (*UnimplementedQueryServer) Paused(ctx context.Context, req *QueryPaused) (*QueryPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
+}
+func (*UnimplementedQueryServer) Owner(ctx context.Context, req *QueryOwner) (*QueryOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Owner not implemented")
+}
+func (*UnimplementedQueryServer) Burners(ctx context.Context, req *QueryBurners) (*QueryBurnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burners not implemented")
+}
+func (*UnimplementedQueryServer) Minters(ctx context.Context, req *QueryMinters) (*QueryMintersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Minters not implemented")
+}
+func (*UnimplementedQueryServer) Pausers(ctx context.Context, req *QueryPausers) (*QueryPausersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pausers not implemented")
+}
+func (*UnimplementedQueryServer) BlockedChannels(ctx context.Context, req *QueryBlockedChannels) (*QueryBlockedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedChannels not implemented")
+}
+func (*UnimplementedQueryServer) PauseExemptions(ctx context.Context, req *QueryPauseExemptions) (*QueryPauseExemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseExemptions not implemented")
+}
+func (*UnimplementedQueryServer) ChannelMode(ctx context.Context, req *QueryChannelMode) (*QueryChannelModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelMode not implemented")
+}
+func (*UnimplementedQueryServer) AllowedChannels(ctx context.Context, req *QueryAllowedChannels) (*QueryAllowedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedChannels not implemented")
+}
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *QueryRateLimits) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitUsage(ctx context.Context, req *QueryRateLimitUsage) (*QueryRateLimitUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitUsage not implemented")
+}
+func (*UnimplementedQueryServer) PriceSetters(ctx context.Context, req *QueryPriceSetters) (*QueryPriceSettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceSetters not implemented")
+}
+func (*UnimplementedQueryServer) PriceRanges(ctx context.Context, req *QueryPriceRanges) (*QueryPriceRangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceRanges not implemented")
+}
+func (*UnimplementedQueryServer) Price(ctx context.Context, req *QueryPrice) (*QueryPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Price not implemented")
+}
+func (*UnimplementedQueryServer) Shares(ctx context.Context, req *QueryShares) (*QuerySharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Shares not implemented")
+}
+func (*UnimplementedQueryServer) TotalShares(ctx context.Context, req *QueryTotalShares) (*QueryTotalSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalShares not implemented")
+}
+func (*UnimplementedQueryServer) Stablecoin(ctx context.Context, req *QueryStablecoin) (*QueryStablecoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stablecoin not implemented")
+}
+func (*UnimplementedQueryServer) Subscription(ctx context.Context, req *QuerySubscription) (*QuerySubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscription not implemented")
+}
+func (*UnimplementedQueryServer) Subscriptions(ctx context.Context, req *QuerySubscriptions) (*QuerySubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscriptions not implemented")
+}
+func (*UnimplementedQueryServer) Redemption(ctx context.Context, req *QueryRedemption) (*QueryRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redemption not implemented")
+}
+func (*UnimplementedQueryServer) Redemptions(ctx context.Context, req *QueryRedemptions) (*QueryRedemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redemptions not implemented")
+}
+func (*UnimplementedQueryServer) RedemptionQueue(ctx context.Context, req *QueryRedemptionQueue) (*QueryRedemptionQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionQueue not implemented")
+}
+func (*UnimplementedQueryServer) SwapFacility(ctx context.Context, req *QuerySwapFacility) (*QuerySwapFacilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapFacility not implemented")
+}
+func (*UnimplementedQueryServer) SwapVolume(ctx context.Context, req *QuerySwapVolume) (*QuerySwapVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapVolume not implemented")
+}
+func (*UnimplementedQueryServer) LockupPeriod(ctx context.Context, req *QueryLockupPeriod) (*QueryLockupPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockupPeriod not implemented")
+}
+func (*UnimplementedQueryServer) LockedBalance(ctx context.Context, req *QueryLockedBalance) (*QueryLockedBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockedBalance not implemented")
+}
+func (*UnimplementedQueryServer) Attestors(ctx context.Context, req *QueryAttestors) (*QueryAttestorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestors not implemented")
+}
+func (*UnimplementedQueryServer) Attestations(ctx context.Context, req *QueryAttestations) (*QueryAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestations not implemented")
+}
+func (*UnimplementedQueryServer) Reserves(ctx context.Context, req *QueryReserves) (*QueryReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reserves not implemented")
+}
+func (*UnimplementedQueryServer) Recoverers(ctx context.Context, req *QueryRecoverers) (*QueryRecoverersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recoverers not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Denom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Denom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Denom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Denom(ctx, req.(*QueryDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Denoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Denoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Denoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Denoms(ctx, req.(*QueryDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Paused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Paused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Paused(ctx, req.(*QueryPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Owner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Owner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Owner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Owner(ctx, req.(*QueryOwner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Burners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurners)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Burners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Burners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Burners(ctx, req.(*QueryBurners))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Minters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Minters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Minters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Minters(ctx, req.(*QueryMinters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Pausers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pausers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Pausers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pausers(ctx, req.(*QueryPausers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedChannels)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/BlockedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedChannels(ctx, req.(*QueryBlockedChannels))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PauseExemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseExemptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PauseExemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/PauseExemptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PauseExemptions(ctx, req.(*QueryPauseExemptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/ChannelMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelMode(ctx, req.(*QueryChannelMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedChannels)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/AllowedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedChannels(ctx, req.(*QueryAllowedChannels))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitUsage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/RateLimitUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitUsage(ctx, req.(*QueryRateLimitUsage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceSetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceSetters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceSetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/PriceSetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceSetters(ctx, req.(*QueryPriceSetters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceRanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceRanges)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceRanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/PriceRanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceRanges(ctx, req.(*QueryPriceRanges))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Price_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Price(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Price",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Price(ctx, req.(*QueryPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Shares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Shares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Shares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Shares(ctx, req.(*QueryShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/TotalShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalShares(ctx, req.(*QueryTotalShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stablecoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStablecoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stablecoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Stablecoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stablecoin(ctx, req.(*QueryStablecoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Subscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Subscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Subscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Subscription(ctx, req.(*QuerySubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Subscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubscriptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Subscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Subscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Subscriptions(ctx, req.(*QuerySubscriptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Redemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Redemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Redemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Redemption(ctx, req.(*QueryRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Redemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Redemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Redemptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Redemptions(ctx, req.(*QueryRedemptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedemptionQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionQueue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/RedemptionQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionQueue(ctx, req.(*QueryRedemptionQueue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapFacility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapFacility)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapFacility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/SwapFacility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapFacility(ctx, req.(*QuerySwapFacility))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapVolume)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/SwapVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapVolume(ctx, req.(*QuerySwapVolume))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockupPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockupPeriod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockupPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/LockupPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockupPeriod(ctx, req.(*QueryLockupPeriod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockedBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockedBalance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockedBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/LockedBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockedBalance(ctx, req.(*QueryLockedBalance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Attestors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestors)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attestors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Attestors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestors(ctx, req.(*QueryAttestors))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Attestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestations)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Attestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestations(ctx, req.(*QueryAttestations))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Reserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReserves)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Reserves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Reserves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reserves(ctx, req.(*QueryReserves))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Recoverers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecoverers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Recoverers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Query/Recoverers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Recoverers(ctx, req.(*QueryRecoverers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "aura.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Denom",
+			Handler:    _Query_Denom_Handler,
+		},
+		{
+			MethodName: "Denoms",
+			Handler:    _Query_Denoms_Handler,
+		},
+		{
+			MethodName: "Paused",
+			Handler:    _Query_Paused_Handler,
+		},
+		{
+			MethodName: "Owner",
+			Handler:    _Query_Owner_Handler,
+		},
+		{
+			MethodName: "Burners",
+			Handler:    _Query_Burners_Handler,
+		},
+		{
+			MethodName: "Minters",
+			Handler:    _Query_Minters_Handler,
+		},
+		{
+			MethodName: "Pausers",
+			Handler:    _Query_Pausers_Handler,
+		},
+		{
+			MethodName: "BlockedChannels",
+			Handler:    _Query_BlockedChannels_Handler,
+		},
+		{
+			MethodName: "PauseExemptions",
+			Handler:    _Query_PauseExemptions_Handler,
+		},
+		{
+			MethodName: "ChannelMode",
+			Handler:    _Query_ChannelMode_Handler,
+		},
+		{
+			MethodName: "AllowedChannels",
+			Handler:    _Query_AllowedChannels_Handler,
+		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "RateLimitUsage",
+			Handler:    _Query_RateLimitUsage_Handler,
+		},
+		{
+			MethodName: "PriceSetters",
+			Handler:    _Query_PriceSetters_Handler,
+		},
+		{
+			MethodName: "PriceRanges",
+			Handler:    _Query_PriceRanges_Handler,
+		},
+		{
+			MethodName: "Price",
+			Handler:    _Query_Price_Handler,
+		},
+		{
+			MethodName: "Shares",
+			Handler:    _Query_Shares_Handler,
+		},
+		{
+			MethodName: "TotalShares",
+			Handler:    _Query_TotalShares_Handler,
+		},
+		{
+			MethodName: "Stablecoin",
+			Handler:    _Query_Stablecoin_Handler,
+		},
+		{
+			MethodName: "Subscription",
+			Handler:    _Query_Subscription_Handler,
+		},
+		{
+			MethodName: "Subscriptions",
+			Handler:    _Query_Subscriptions_Handler,
+		},
+		{
+			MethodName: "Redemption",
+			Handler:    _Query_Redemption_Handler,
+		},
+		{
+			MethodName: "Redemptions",
+			Handler:    _Query_Redemptions_Handler,
+		},
+		{
+			MethodName: "RedemptionQueue",
+			Handler:    _Query_RedemptionQueue_Handler,
+		},
+		{
+			MethodName: "SwapFacility",
+			Handler:    _Query_SwapFacility_Handler,
+		},
+		{
+			MethodName: "SwapVolume",
+			Handler:    _Query_SwapVolume_Handler,
+		},
+		{
+			MethodName: "LockupPeriod",
+			Handler:    _Query_LockupPeriod_Handler,
+		},
+		{
+			MethodName: "LockedBalance",
+			Handler:    _Query_LockedBalance_Handler,
+		},
+		{
+			MethodName: "Attestors",
+			Handler:    _Query_Attestors_Handler,
+		},
+		{
+			MethodName: "Attestations",
+			Handler:    _Query_Attestations_Handler,
+		},
+		{
+			MethodName: "Reserves",
+			Handler:    _Query_Reserves_Handler,
+		},
+		{
+			MethodName: "Recoverers",
+			Handler:    _Query_Recoverers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "aura/v1/query.proto",
+}
+
+func (m *QueryDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlocklistBound) > 0 {
+		for iNdEx := len(m.BlocklistBound) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlocklistBound[iNdEx])
+			copy(dAtA[i:], m.BlocklistBound[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.BlocklistBound[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Scopes) > 0 {
+		dAtA2 := make([]byte, len(m.Scopes)*10)
+		var j1 int
+		for _, num := range m.Scopes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintQuery(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurners) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurners) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurners) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Burners) > 0 {
+		for iNdEx := len(m.Burners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pausers) > 0 {
+		for iNdEx := len(m.Pausers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pausers[iNdEx])
+			copy(dAtA[i:], m.Pausers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Pausers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedChannels) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedChannels) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedChannels) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockedChannels) > 0 {
+		for iNdEx := len(m.BlockedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseExemptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseExemptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseExemptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseExemptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseExemptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseExemptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PauseExemptions) > 0 {
+		for iNdEx := len(m.PauseExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PauseExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedChannels) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedChannels) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedChannels) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedChannels[iNdEx])
+			copy(dAtA[i:], m.AllowedChannels[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingInflow.Size()
+		i -= size
+		if _, err := m.RemainingInflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RemainingOutflow.Size()
+		i -= size
+		if _, err := m.RemainingOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Quota.Size()
+		i -= size
+		if _, err := m.Quota.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Flow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceSetters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceSetters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceSetters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceSettersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceSettersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceSettersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceSetters) > 0 {
+		for iNdEx := len(m.PriceSetters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PriceSetters[iNdEx])
+			copy(dAtA[i:], m.PriceSetters[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceSetters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceRanges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceRanges) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceRanges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceRangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceRangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceRangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PriceRanges) > 0 {
+		for iNdEx := len(m.PriceRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalShares.Size()
+		i -= size
+		if _, err := m.TotalShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStablecoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStablecoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStablecoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStablecoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStablecoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStablecoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stablecoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Subscription.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubscriptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubscriptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubscriptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubscriptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubscriptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubscriptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Redemption.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Redemptions) > 0 {
+		for iNdEx := len(m.Redemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Redemptions) > 0 {
+		for iNdEx := len(m.Redemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapFacility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapFacility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapFacility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapFacilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapFacilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapFacilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BurnerAllowance.Size()
+		i -= size
+		if _, err := m.BurnerAllowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinterAllowance.Size()
+		i -= size
+		if _, err := m.MinterAllowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Liquidity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapVolumeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapVolumeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapVolumeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remaining != nil {
+		{
+			size := m.Remaining.Size()
+			i -= size
+			if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockupPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockupPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockupPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockupPeriodResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockupPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockupPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n16, err16 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LockupPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockupPeriod):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintQuery(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockedBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockedBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Lots) > 0 {
+		for iNdEx := len(m.Lots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Unlocked.Size()
+		i -= size
+		if _, err := m.Unlocked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Locked.Size()
+		i -= size
+		if _, err := m.Locked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestors) > 0 {
+		for iNdEx := len(m.Attestors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Attestors[iNdEx])
+			copy(dAtA[i:], m.Attestors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Attestors[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReserves) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReserves) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReserves) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReservesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReservesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReservesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingCapacity != nil {
+		{
+			size := m.RemainingCapacity.Size()
+			i -= size
+			if _, err := m.RemainingCapacity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Latest != nil {
+		{
+			size, err := m.Latest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecoverers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecoverers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecoverers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecoverersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecoverersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecoverersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recoverers) > 0 {
+		for iNdEx := len(m.Recoverers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Recoverers[iNdEx])
+			copy(dAtA[i:], m.Recoverers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Recoverers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.BlocklistBound) > 0 {
+		for _, s := range m.BlocklistBound {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	if len(m.Scopes) > 0 {
+		l = 0
+		for _, e := range m.Scopes {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurners) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burners) > 0 {
+		for _, e := range m.Burners {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMinters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPausers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPausersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pausers) > 0 {
+		for _, s := range m.Pausers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBlockedChannels) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockedChannels) > 0 {
+		for _, e := range m.BlockedChannels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPauseExemptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPauseExemptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PauseExemptions) > 0 {
+		for _, e := range m.PauseExemptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryChannelMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryChannelModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovQuery(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *QueryAllowedChannels) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllowedChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedChannels) > 0 {
+		for _, s := range m.AllowedChannels {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRateLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRateLimitUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Flow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Quota.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingOutflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingInflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPriceSetters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPriceSettersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceSetters) > 0 {
+		for _, s := range m.PriceSetters {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPriceRanges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPriceRangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceRanges) > 0 {
+		for _, e := range m.PriceRanges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.MaxPriceDeviation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Shares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalShares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStablecoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStablecoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stablecoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QuerySubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Subscription.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySubscriptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubscriptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Redemption.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRedemptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Redemptions) > 0 {
+		for _, e := range m.Redemptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Redemptions) > 0 {
+		for _, e := range m.Redemptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapFacility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySwapFacilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinterAllowance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BurnerAllowance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapVolumeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Volume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Remaining != nil {
+		l = m.Remaining.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockupPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLockupPeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockupPeriod)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLockedBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockedBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Unlocked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Lots) > 0 {
+		for _, e := range m.Lots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAttestors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAttestorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestors) > 0 {
+		for _, s := range m.Attestors {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAttestations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryReserves) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryReservesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Latest != nil {
+		l = m.Latest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Stale {
+		n += 2
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RemainingCapacity != nil {
+		l = m.RemainingCapacity.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecoverers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecoverersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recoverers) > 0 {
+		for _, s := range m.Recoverers {
 			l = len(s)
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *QueryBlockedChannels) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenoms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenoms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocklistBound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlocklistBound = append(m.BlocklistBound, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
+			if wireType == 0 {
+				var v PauseScope
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PauseScope(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Scopes = append(m.Scopes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Scopes) == 0 {
+					m.Scopes = make([]PauseScope, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PauseScope
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PauseScope(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Scopes = append(m.Scopes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Windows = append(m.Windows, PauseWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurners) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurners: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurners: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burners = append(m.Burners, Burner{})
+			if err := m.Burners[len(m.Burners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, Minter{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pausers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pausers = append(m.Pausers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedChannels) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedChannels: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedChannels: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedChannels = append(m.BlockedChannels, BlockedChannel{})
+			if err := m.BlockedChannels[len(m.BlockedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseExemptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseExemptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseExemptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseExemptionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseExemptionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseExemptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseExemptions = append(m.PauseExemptions, PauseExemption{})
+			if err := m.PauseExemptions[len(m.PauseExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelMode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelMode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelMode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelModeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelModeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= ChannelMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedChannels) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedChannels: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedChannels: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Flow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceSetters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceSetters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceSetters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceSettersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceSettersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceSettersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSetters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSetters = append(m.PriceSetters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceRanges) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceRanges: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceRanges: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceRangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceRangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceRangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceRanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceRanges = append(m.PriceRanges, PriceRange{})
+			if err := m.PriceRanges[len(m.PriceRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStablecoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStablecoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStablecoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStablecoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStablecoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStablecoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stablecoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stablecoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubscriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubscriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Subscription.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubscriptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubscriptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubscriptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubscriptionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubscriptionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubscriptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, Subscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Redemption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redemptions = append(m.Redemptions, Redemption{})
+			if err := m.Redemptions[len(m.Redemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redemptions = append(m.Redemptions, Redemption{})
+			if err := m.Redemptions[len(m.Redemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapFacility) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapFacility: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapFacility: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapFacilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapFacilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapFacilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterAllowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinterAllowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnerAllowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnerAllowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryBlockedChannelsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.BlockedChannels) > 0 {
-		for _, s := range m.BlockedChannels {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryDenom) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1560,12 +11926,44 @@ func (m *QueryDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1587,7 +11985,7 @@ func (m *QueryDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapVolumeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1610,15 +12008,15 @@ func (m *QueryDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapVolumeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapVolumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1646,7 +12044,45 @@ func (m *QueryDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Remaining = &v
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1669,7 +12105,7 @@ func (m *QueryDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaused) Unmarshal(dAtA []byte) error {
+func (m *QueryLockupPeriod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1692,10 +12128,10 @@ func (m *QueryPaused) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaused: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockupPeriod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockupPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1719,7 +12155,7 @@ func (m *QueryPaused) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPausedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLockupPeriodResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1742,17 +12178,17 @@ func (m *QueryPausedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPausedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockupPeriodResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockupPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriod", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1762,12 +12198,25 @@ func (m *QueryPausedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Paused = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LockupPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1789,7 +12238,7 @@ func (m *QueryPausedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOwner) Unmarshal(dAtA []byte) error {
+func (m *QueryLockedBalance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1799,25 +12248,57 @@ func (m *QueryOwner) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOwner: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOwner: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1839,7 +12320,7 @@ func (m *QueryOwner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLockedBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1862,15 +12343,15 @@ func (m *QueryOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockedBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockedBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1898,11 +12379,13 @@ func (m *QueryOwnerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocked", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1930,7 +12413,43 @@ func (m *QueryOwnerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			if err := m.Unlocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lots = append(m.Lots, LockedLot{})
+			if err := m.Lots[len(m.Lots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1953,7 +12472,7 @@ func (m *QueryOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurners) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestors) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1976,10 +12495,10 @@ func (m *QueryBurners) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurners: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestors: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurners: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestors: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2003,7 +12522,7 @@ func (m *QueryBurners) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurnersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2026,17 +12545,17 @@ func (m *QueryBurnersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurnersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestors", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2046,25 +12565,23 @@ func (m *QueryBurnersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Burners = append(m.Burners, Burner{})
-			if err := m.Burners[len(m.Burners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Attestors = append(m.Attestors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2087,7 +12604,7 @@ func (m *QueryBurnersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMinters) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2110,10 +12627,10 @@ func (m *QueryMinters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMinters: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMinters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2137,7 +12654,7 @@ func (m *QueryMinters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMintersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2160,15 +12677,15 @@ func (m *QueryMintersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMintersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMintersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2195,8 +12712,8 @@ func (m *QueryMintersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Minters = append(m.Minters, Minter{})
-			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2221,7 +12738,7 @@ func (m *QueryMintersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPausers) Unmarshal(dAtA []byte) error {
+func (m *QueryReserves) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2244,10 +12761,10 @@ func (m *QueryPausers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPausers: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReserves: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPausers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReserves: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2271,7 +12788,7 @@ func (m *QueryPausers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPausersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReservesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2294,15 +12811,104 @@ func (m *QueryPausersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPausersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReservesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPausersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pausers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Latest == nil {
+				m.Latest = &Attestation{}
+			}
+			if err := m.Latest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2330,7 +12936,45 @@ func (m *QueryPausersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pausers = append(m.Pausers, string(dAtA[iNdEx:postIndex]))
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingCapacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RemainingCapacity = &v
+			if err := m.RemainingCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2353,7 +12997,7 @@ func (m *QueryPausersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockedChannels) Unmarshal(dAtA []byte) error {
+func (m *QueryRecoverers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2376,12 +13020,44 @@ func (m *QueryBlockedChannels) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockedChannels: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecoverers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockedChannels: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecoverers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2403,7 +13079,7 @@ func (m *QueryBlockedChannels) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockedChannelsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRecoverersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2426,15 +13102,15 @@ func (m *QueryBlockedChannelsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockedChannelsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecoverersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockedChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecoverersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockedChannels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recoverers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2462,7 +13138,7 @@ func (m *QueryBlockedChannelsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockedChannels = append(m.BlockedChannels, string(dAtA[iNdEx:postIndex]))
+			m.Recoverers = append(m.Recoverers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
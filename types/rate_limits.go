@@ -0,0 +1,78 @@
+package types
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// refundKey is the context key marking the callbacks of packets sent from
+// this chain, in which transfers out of escrow accounts are refunds.
+type refundKey struct{}
+
+// WithRefund returns a context in which transfers out of escrow accounts are
+// refunds of packets sent from this chain, rather than inbound transfers.
+func WithRefund(ctx context.Context) context.Context {
+	return sdk.UnwrapSDKContext(ctx).WithValue(refundKey{}, true)
+}
+
+// IsRefund returns true if transfers out of escrow accounts are refunds.
+func IsRefund(ctx context.Context) bool {
+	refund, _ := ctx.Value(refundKey{}).(bool)
+	return refund
+}
+
+// Validate returns an error if the rate limit is misconfigured.
+func (r RateLimit) Validate() error {
+	if r.Window <= 0 {
+		return errors.New("window must be positive")
+	}
+
+	if r.MaxAmount.IsNil() || r.MaxAmount.IsNegative() {
+		return errors.New("max amount must be non-negative")
+	}
+
+	if r.MaxPercentage.IsNil() || r.MaxPercentage.IsNegative() || r.MaxPercentage.GT(math.LegacyNewDec(100)) {
+		return errors.New("max percentage must be between 0 and 100")
+	}
+
+	if r.MaxAmount.IsZero() && r.MaxPercentage.IsZero() {
+		return errors.New("either max amount or max percentage must be set")
+	}
+
+	return nil
+}
+
+// Quota returns the maximum net flow of a window, given the supply at the
+// start of the window. If both limits are set, the lower one applies.
+func (r RateLimit) Quota(supply math.Int) math.Int {
+	quota := r.MaxAmount
+
+	if r.MaxPercentage.IsPositive() {
+		percentage := math.LegacyNewDecFromInt(supply).Mul(r.MaxPercentage).QuoInt64(100).TruncateInt()
+		if quota.IsZero() || percentage.LT(quota) {
+			quota = percentage
+		}
+	}
+
+	return quota
+}
+
+// Remaining returns the amount that can still be transferred in the provided
+// direction before the quota is reached.
+func (f RateLimitFlow) Remaining(quota math.Int, direction ChannelDirection) math.Int {
+	var remaining math.Int
+	if direction == ChannelDirectionInbound {
+		remaining = quota.Sub(f.Inflow).Add(f.Outflow)
+	} else {
+		remaining = quota.Sub(f.Outflow).Add(f.Inflow)
+	}
+
+	if remaining.IsNegative() {
+		return math.ZeroInt()
+	}
+
+	return remaining
+}
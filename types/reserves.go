@@ -0,0 +1,44 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultReserveConfig returns a reserve configuration that doesn't limit
+// minting.
+func DefaultReserveConfig() ReserveConfig {
+	return ReserveConfig{
+		CollateralRatio: math.LegacyZeroDec(),
+	}
+}
+
+// Validate returns an error if the reserve configuration is invalid.
+func (c ReserveConfig) Validate() error {
+	if c.CollateralRatio.IsNil() || c.CollateralRatio.IsNegative() {
+		return errors.New("collateral ratio must be non-negative")
+	}
+
+	if c.MaxStaleness < 0 {
+		return errors.New("max staleness must be non-negative")
+	}
+
+	return nil
+}
+
+// Enabled returns true if minting is limited by attested reserves.
+func (c ReserveConfig) Enabled() bool {
+	return c.CollateralRatio.IsPositive()
+}
+
+// IsStale returns true if the attestation is too old at the provided time.
+func (c ReserveConfig) IsStale(attestation Attestation, now time.Time) bool {
+	return c.MaxStaleness > 0 && now.Sub(attestation.AsOf) > c.MaxStaleness
+}
+
+// Capacity returns the maximum supply of USDY backed by the attestation.
+func (c ReserveConfig) Capacity(attestation Attestation) math.Int {
+	return c.CollateralRatio.MulInt(attestation.Amount).TruncateInt()
+}
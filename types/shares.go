@@ -0,0 +1,22 @@
+package types
+
+import "cosmossdk.io/math"
+
+// SharesDenom returns the bank denom of shares of wrapped USDY. Balances of
+// the share denom don't rebase, their value in rUSDY is derived from the
+// current price.
+func SharesDenom(denom string) string {
+	return "shares/" + denom
+}
+
+// SharesToAmount converts an amount of shares into rUSDY at the provided
+// price, rounding down.
+func SharesToAmount(shares math.Int, price math.LegacyDec) math.Int {
+	return price.MulInt(shares).TruncateInt()
+}
+
+// AmountToShares converts an amount of rUSDY into shares at the provided
+// price, rounding down.
+func AmountToShares(amount math.Int, price math.LegacyDec) math.Int {
+	return math.LegacyNewDecFromInt(amount).Quo(price).TruncateInt()
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Exponent is the number of decimals of the USDY base denom.
+const Exponent = 18
+
+// Validate returns an error if the stablecoin is not a valid configuration.
+func (s Stablecoin) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return err
+	}
+
+	if s.Exponent > Exponent {
+		return fmt.Errorf("exponent can't exceed %d", Exponent)
+	}
+
+	return nil
+}
+
+// IsSet returns true if a stablecoin has been configured.
+func (s Stablecoin) IsSet() bool {
+	return s.Denom != ""
+}
+
+// ToUSDY converts an amount of stablecoin into USDY at the provided price,
+// which is denominated in whole stablecoins per whole USDY, rounding down.
+func (s Stablecoin) ToUSDY(amount math.Int, price math.LegacyDec) (math.Int, error) {
+	if price.IsNil() || !price.IsPositive() {
+		return math.Int{}, errors.New("price must be positive")
+	}
+
+	scale := math.NewIntWithDecimal(1, int(Exponent-s.Exponent))
+	return math.LegacyNewDecFromInt(amount.Mul(scale)).Quo(price).TruncateInt(), nil
+}
+
+// IsValid returns true if the status is a known value.
+func (s RequestStatus) IsValid() bool {
+	_, ok := RequestStatus_name[int32(s)]
+	return ok
+}
+
+// FromUSDY converts an amount of USDY into stablecoin at the provided price,
+// which is denominated in whole stablecoins per whole USDY, rounding down.
+func (s Stablecoin) FromUSDY(amount math.Int, price math.LegacyDec) math.Int {
+	scale := math.NewIntWithDecimal(1, int(Exponent-s.Exponent))
+	return price.MulInt(amount).QuoInt(scale).TruncateInt()
+}
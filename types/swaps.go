@@ -0,0 +1,35 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultSwapConfig returns a swap configuration without fees or limits.
+func DefaultSwapConfig() SwapConfig {
+	return SwapConfig{
+		Fee:        math.LegacyZeroDec(),
+		DailyLimit: math.ZeroInt(),
+	}
+}
+
+// Validate returns an error if the swap configuration is invalid.
+func (c SwapConfig) Validate() error {
+	if c.Fee.IsNil() || c.Fee.IsNegative() || c.Fee.GTE(math.LegacyOneDec()) {
+		return errors.New("fee must be in the range [0, 1)")
+	}
+
+	if c.DailyLimit.IsNil() || c.DailyLimit.IsNegative() {
+		return errors.New("daily limit must be non-negative")
+	}
+
+	return nil
+}
+
+// SwapDay returns the number of days since the unix epoch, which is the
+// period that daily swap volumes are tracked over.
+func SwapDay(t time.Time) uint64 {
+	return uint64(t.Unix() / int64((24 * time.Hour).Seconds()))
+}
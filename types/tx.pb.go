@@ -8,23 +8,30 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -36,6 +43,7 @@ type MsgBurn struct {
 	Signer string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	From   string                `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Denom  string                `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MsgBurn) Reset()         { *m = MsgBurn{} }
@@ -111,6 +119,7 @@ type MsgMint struct {
 	Signer string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	To     string                `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Denom  string                `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MsgMint) Reset()         { *m = MsgMint{} }
@@ -183,7 +192,13 @@ func (m *MsgMintResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgMintResponse proto.InternalMessageInfo
 
 type MsgPause struct {
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Signer string     `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Denom  string     `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Scope  PauseScope `protobuf:"varint,3,opt,name=scope,proto3,enum=aura.v1.PauseScope" json:"scope,omitempty"`
+	// end_height optionally lifts the pause at a block height.
+	EndHeight int64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// end_time optionally lifts the pause at a block time.
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
 }
 
 func (m *MsgPause) Reset()         { *m = MsgPause{} }
@@ -256,7 +271,9 @@ func (m *MsgPauseResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgPauseResponse proto.InternalMessageInfo
 
 type MsgUnpause struct {
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Signer string     `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Denom  string     `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Scope  PauseScope `protobuf:"varint,3,opt,name=scope,proto3,enum=aura.v1.PauseScope" json:"scope,omitempty"`
 }
 
 func (m *MsgUnpause) Reset()         { *m = MsgUnpause{} }
@@ -328,16 +345,96 @@ func (m *MsgUnpauseResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnpauseResponse proto.InternalMessageInfo
 
+type MsgSchedulePause struct {
+	Signer      string     `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Denom       string     `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Scope       PauseScope `protobuf:"varint,3,opt,name=scope,proto3,enum=aura.v1.PauseScope" json:"scope,omitempty"`
+	StartHeight int64      `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	StartTime   time.Time  `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndHeight   int64      `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	EndTime     time.Time  `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *MsgSchedulePause) Reset()         { *m = MsgSchedulePause{} }
+func (m *MsgSchedulePause) String() string { return proto.CompactTextString(m) }
+func (*MsgSchedulePause) ProtoMessage()    {}
+func (*MsgSchedulePause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{8}
+}
+func (m *MsgSchedulePause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSchedulePause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSchedulePause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSchedulePause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSchedulePause.Merge(m, src)
+}
+func (m *MsgSchedulePause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSchedulePause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSchedulePause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSchedulePause proto.InternalMessageInfo
+
+type MsgSchedulePauseResponse struct {
+}
+
+func (m *MsgSchedulePauseResponse) Reset()         { *m = MsgSchedulePauseResponse{} }
+func (m *MsgSchedulePauseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSchedulePauseResponse) ProtoMessage()    {}
+func (*MsgSchedulePauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{9}
+}
+func (m *MsgSchedulePauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSchedulePauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSchedulePauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSchedulePauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSchedulePauseResponse.Merge(m, src)
+}
+func (m *MsgSchedulePauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSchedulePauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSchedulePauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSchedulePauseResponse proto.InternalMessageInfo
+
 type MsgTransferOwnership struct {
 	Signer   string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	NewOwner string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	Denom    string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MsgTransferOwnership) Reset()         { *m = MsgTransferOwnership{} }
 func (m *MsgTransferOwnership) String() string { return proto.CompactTextString(m) }
 func (*MsgTransferOwnership) ProtoMessage()    {}
 func (*MsgTransferOwnership) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{8}
+	return fileDescriptor_72e81433c42c397f, []int{10}
 }
 func (m *MsgTransferOwnership) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -373,7 +470,7 @@ func (m *MsgTransferOwnershipResponse) Reset()         { *m = MsgTransferOwnersh
 func (m *MsgTransferOwnershipResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgTransferOwnershipResponse) ProtoMessage()    {}
 func (*MsgTransferOwnershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{9}
+	return fileDescriptor_72e81433c42c397f, []int{11}
 }
 func (m *MsgTransferOwnershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -404,13 +501,14 @@ var xxx_messageInfo_MsgTransferOwnershipResponse proto.InternalMessageInfo
 
 type MsgAcceptOwnership struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MsgAcceptOwnership) Reset()         { *m = MsgAcceptOwnership{} }
 func (m *MsgAcceptOwnership) String() string { return proto.CompactTextString(m) }
 func (*MsgAcceptOwnership) ProtoMessage()    {}
 func (*MsgAcceptOwnership) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{10}
+	return fileDescriptor_72e81433c42c397f, []int{12}
 }
 func (m *MsgAcceptOwnership) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,7 +544,7 @@ func (m *MsgAcceptOwnershipResponse) Reset()         { *m = MsgAcceptOwnershipRe
 func (m *MsgAcceptOwnershipResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAcceptOwnershipResponse) ProtoMessage()    {}
 func (*MsgAcceptOwnershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{11}
+	return fileDescriptor_72e81433c42c397f, []int{13}
 }
 func (m *MsgAcceptOwnershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -475,17 +573,96 @@ func (m *MsgAcceptOwnershipResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAcceptOwnershipResponse proto.InternalMessageInfo
 
+type MsgForceOwnership struct {
+	Signer         string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Denom          string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Owner          string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	BlocklistOwner string `protobuf:"bytes,4,opt,name=blocklist_owner,json=blocklistOwner,proto3" json:"blocklist_owner,omitempty"`
+}
+
+func (m *MsgForceOwnership) Reset()         { *m = MsgForceOwnership{} }
+func (m *MsgForceOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgForceOwnership) ProtoMessage()    {}
+func (*MsgForceOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{14}
+}
+func (m *MsgForceOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceOwnership.Merge(m, src)
+}
+func (m *MsgForceOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceOwnership proto.InternalMessageInfo
+
+type MsgForceOwnershipResponse struct {
+}
+
+func (m *MsgForceOwnershipResponse) Reset()         { *m = MsgForceOwnershipResponse{} }
+func (m *MsgForceOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForceOwnershipResponse) ProtoMessage()    {}
+func (*MsgForceOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{15}
+}
+func (m *MsgForceOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceOwnershipResponse.Merge(m, src)
+}
+func (m *MsgForceOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceOwnershipResponse proto.InternalMessageInfo
+
 type MsgAddBurner struct {
 	Signer    string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	Burner    string                `protobuf:"bytes,2,opt,name=burner,proto3" json:"burner,omitempty"`
 	Allowance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=allowance,proto3,customtype=cosmossdk.io/math.Int" json:"allowance"`
+	Denom     string                `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// expiry optionally expires the allowance at a block time.
+	Expiry time.Time `protobuf:"bytes,5,opt,name=expiry,proto3,stdtime" json:"expiry"`
 }
 
 func (m *MsgAddBurner) Reset()         { *m = MsgAddBurner{} }
 func (m *MsgAddBurner) String() string { return proto.CompactTextString(m) }
 func (*MsgAddBurner) ProtoMessage()    {}
 func (*MsgAddBurner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{12}
+	return fileDescriptor_72e81433c42c397f, []int{16}
 }
 func (m *MsgAddBurner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,7 +698,7 @@ func (m *MsgAddBurnerResponse) Reset()         { *m = MsgAddBurnerResponse{} }
 func (m *MsgAddBurnerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddBurnerResponse) ProtoMessage()    {}
 func (*MsgAddBurnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{13}
+	return fileDescriptor_72e81433c42c397f, []int{17}
 }
 func (m *MsgAddBurnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -553,13 +730,14 @@ var xxx_messageInfo_MsgAddBurnerResponse proto.InternalMessageInfo
 type MsgRemoveBurner struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	Burner string `protobuf:"bytes,2,opt,name=burner,proto3" json:"burner,omitempty"`
+	Denom  string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MsgRemoveBurner) Reset()         { *m = MsgRemoveBurner{} }
 func (m *MsgRemoveBurner) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveBurner) ProtoMessage()    {}
 func (*MsgRemoveBurner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{14}
+	return fileDescriptor_72e81433c42c397f, []int{18}
 }
 func (m *MsgRemoveBurner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -595,7 +773,7 @@ func (m *MsgRemoveBurnerResponse) Reset()         { *m = MsgRemoveBurnerResponse
 func (m *MsgRemoveBurnerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveBurnerResponse) ProtoMessage()    {}
 func (*MsgRemoveBurnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{15}
+	return fileDescriptor_72e81433c42c397f, []int{19}
 }
 func (m *MsgRemoveBurnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -628,13 +806,19 @@ type MsgSetBurnerAllowance struct {
 	Signer    string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	Burner    string                `protobuf:"bytes,2,opt,name=burner,proto3" json:"burner,omitempty"`
 	Allowance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=allowance,proto3,customtype=cosmossdk.io/math.Int" json:"allowance"`
+	Denom     string                `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// expiry optionally expires the allowance at a block time, keeping the
+	// existing expiry when empty.
+	Expiry time.Time `protobuf:"bytes,5,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	// clear_expiry removes the existing expiry, so that the allowance never expires.
+	ClearExpiry bool `protobuf:"varint,6,opt,name=clear_expiry,json=clearExpiry,proto3" json:"clear_expiry,omitempty"`
 }
 
 func (m *MsgSetBurnerAllowance) Reset()         { *m = MsgSetBurnerAllowance{} }
 func (m *MsgSetBurnerAllowance) String() string { return proto.CompactTextString(m) }
 func (*MsgSetBurnerAllowance) ProtoMessage()    {}
 func (*MsgSetBurnerAllowance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{16}
+	return fileDescriptor_72e81433c42c397f, []int{20}
 }
 func (m *MsgSetBurnerAllowance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -670,7 +854,7 @@ func (m *MsgSetBurnerAllowanceResponse) Reset()         { *m = MsgSetBurnerAllow
 func (m *MsgSetBurnerAllowanceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetBurnerAllowanceResponse) ProtoMessage()    {}
 func (*MsgSetBurnerAllowanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{17}
+	return fileDescriptor_72e81433c42c397f, []int{21}
 }
 func (m *MsgSetBurnerAllowanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -699,24 +883,25 @@ func (m *MsgSetBurnerAllowanceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetBurnerAllowanceResponse proto.InternalMessageInfo
 
-type MsgAddMinter struct {
-	Signer    string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Minter    string                `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
-	Allowance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=allowance,proto3,customtype=cosmossdk.io/math.Int" json:"allowance"`
+type MsgIncreaseBurnerAllowance struct {
+	Signer string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Burner string                `protobuf:"bytes,2,opt,name=burner,proto3" json:"burner,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Denom  string                `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *MsgAddMinter) Reset()         { *m = MsgAddMinter{} }
-func (m *MsgAddMinter) String() string { return proto.CompactTextString(m) }
-func (*MsgAddMinter) ProtoMessage()    {}
-func (*MsgAddMinter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{18}
+func (m *MsgIncreaseBurnerAllowance) Reset()         { *m = MsgIncreaseBurnerAllowance{} }
+func (m *MsgIncreaseBurnerAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreaseBurnerAllowance) ProtoMessage()    {}
+func (*MsgIncreaseBurnerAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{22}
 }
-func (m *MsgAddMinter) XXX_Unmarshal(b []byte) error {
+func (m *MsgIncreaseBurnerAllowance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgIncreaseBurnerAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddMinter.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgIncreaseBurnerAllowance.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -726,33 +911,33 @@ func (m *MsgAddMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgAddMinter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddMinter.Merge(m, src)
+func (m *MsgIncreaseBurnerAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreaseBurnerAllowance.Merge(m, src)
 }
-func (m *MsgAddMinter) XXX_Size() int {
+func (m *MsgIncreaseBurnerAllowance) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddMinter) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddMinter.DiscardUnknown(m)
+func (m *MsgIncreaseBurnerAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreaseBurnerAllowance.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddMinter proto.InternalMessageInfo
+var xxx_messageInfo_MsgIncreaseBurnerAllowance proto.InternalMessageInfo
 
-type MsgAddMinterResponse struct {
+type MsgIncreaseBurnerAllowanceResponse struct {
 }
 
-func (m *MsgAddMinterResponse) Reset()         { *m = MsgAddMinterResponse{} }
-func (m *MsgAddMinterResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddMinterResponse) ProtoMessage()    {}
-func (*MsgAddMinterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{19}
+func (m *MsgIncreaseBurnerAllowanceResponse) Reset()         { *m = MsgIncreaseBurnerAllowanceResponse{} }
+func (m *MsgIncreaseBurnerAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreaseBurnerAllowanceResponse) ProtoMessage()    {}
+func (*MsgIncreaseBurnerAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{23}
 }
-func (m *MsgAddMinterResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgIncreaseBurnerAllowanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgIncreaseBurnerAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddMinterResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgIncreaseBurnerAllowanceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -762,35 +947,37 @@ func (m *MsgAddMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgAddMinterResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddMinterResponse.Merge(m, src)
+func (m *MsgIncreaseBurnerAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreaseBurnerAllowanceResponse.Merge(m, src)
 }
-func (m *MsgAddMinterResponse) XXX_Size() int {
+func (m *MsgIncreaseBurnerAllowanceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddMinterResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddMinterResponse.DiscardUnknown(m)
+func (m *MsgIncreaseBurnerAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreaseBurnerAllowanceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddMinterResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgIncreaseBurnerAllowanceResponse proto.InternalMessageInfo
 
-type MsgRemoveMinter struct {
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Minter string `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+type MsgDecreaseBurnerAllowance struct {
+	Signer string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Burner string                `protobuf:"bytes,2,opt,name=burner,proto3" json:"burner,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Denom  string                `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *MsgRemoveMinter) Reset()         { *m = MsgRemoveMinter{} }
-func (m *MsgRemoveMinter) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveMinter) ProtoMessage()    {}
-func (*MsgRemoveMinter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{20}
+func (m *MsgDecreaseBurnerAllowance) Reset()         { *m = MsgDecreaseBurnerAllowance{} }
+func (m *MsgDecreaseBurnerAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgDecreaseBurnerAllowance) ProtoMessage()    {}
+func (*MsgDecreaseBurnerAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{24}
 }
-func (m *MsgRemoveMinter) XXX_Unmarshal(b []byte) error {
+func (m *MsgDecreaseBurnerAllowance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDecreaseBurnerAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveMinter.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDecreaseBurnerAllowance.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -800,33 +987,33 @@ func (m *MsgRemoveMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveMinter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveMinter.Merge(m, src)
+func (m *MsgDecreaseBurnerAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDecreaseBurnerAllowance.Merge(m, src)
 }
-func (m *MsgRemoveMinter) XXX_Size() int {
+func (m *MsgDecreaseBurnerAllowance) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveMinter) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveMinter.DiscardUnknown(m)
+func (m *MsgDecreaseBurnerAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDecreaseBurnerAllowance.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveMinter proto.InternalMessageInfo
+var xxx_messageInfo_MsgDecreaseBurnerAllowance proto.InternalMessageInfo
 
-type MsgRemoveMinterResponse struct {
+type MsgDecreaseBurnerAllowanceResponse struct {
 }
 
-func (m *MsgRemoveMinterResponse) Reset()         { *m = MsgRemoveMinterResponse{} }
-func (m *MsgRemoveMinterResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveMinterResponse) ProtoMessage()    {}
-func (*MsgRemoveMinterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{21}
+func (m *MsgDecreaseBurnerAllowanceResponse) Reset()         { *m = MsgDecreaseBurnerAllowanceResponse{} }
+func (m *MsgDecreaseBurnerAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDecreaseBurnerAllowanceResponse) ProtoMessage()    {}
+func (*MsgDecreaseBurnerAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{25}
 }
-func (m *MsgRemoveMinterResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDecreaseBurnerAllowanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDecreaseBurnerAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveMinterResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDecreaseBurnerAllowanceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -836,36 +1023,37 @@ func (m *MsgRemoveMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveMinterResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveMinterResponse.Merge(m, src)
+func (m *MsgDecreaseBurnerAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDecreaseBurnerAllowanceResponse.Merge(m, src)
 }
-func (m *MsgRemoveMinterResponse) XXX_Size() int {
+func (m *MsgDecreaseBurnerAllowanceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveMinterResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveMinterResponse.DiscardUnknown(m)
+func (m *MsgDecreaseBurnerAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDecreaseBurnerAllowanceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveMinterResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDecreaseBurnerAllowanceResponse proto.InternalMessageInfo
 
-type MsgSetMinterAllowance struct {
-	Signer    string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Minter    string                `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
-	Allowance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=allowance,proto3,customtype=cosmossdk.io/math.Int" json:"allowance"`
+type MsgAddBurnerSource struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Burner string `protobuf:"bytes,2,opt,name=burner,proto3" json:"burner,omitempty"`
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Denom  string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *MsgSetMinterAllowance) Reset()         { *m = MsgSetMinterAllowance{} }
-func (m *MsgSetMinterAllowance) String() string { return proto.CompactTextString(m) }
-func (*MsgSetMinterAllowance) ProtoMessage()    {}
-func (*MsgSetMinterAllowance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{22}
+func (m *MsgAddBurnerSource) Reset()         { *m = MsgAddBurnerSource{} }
+func (m *MsgAddBurnerSource) String() string { return proto.CompactTextString(m) }
+func (*MsgAddBurnerSource) ProtoMessage()    {}
+func (*MsgAddBurnerSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{26}
 }
-func (m *MsgSetMinterAllowance) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddBurnerSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetMinterAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddBurnerSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetMinterAllowance.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddBurnerSource.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -875,33 +1063,33 @@ func (m *MsgSetMinterAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgSetMinterAllowance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetMinterAllowance.Merge(m, src)
+func (m *MsgAddBurnerSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBurnerSource.Merge(m, src)
 }
-func (m *MsgSetMinterAllowance) XXX_Size() int {
+func (m *MsgAddBurnerSource) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetMinterAllowance) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetMinterAllowance.DiscardUnknown(m)
+func (m *MsgAddBurnerSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBurnerSource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetMinterAllowance proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddBurnerSource proto.InternalMessageInfo
 
-type MsgSetMinterAllowanceResponse struct {
+type MsgAddBurnerSourceResponse struct {
 }
 
-func (m *MsgSetMinterAllowanceResponse) Reset()         { *m = MsgSetMinterAllowanceResponse{} }
-func (m *MsgSetMinterAllowanceResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSetMinterAllowanceResponse) ProtoMessage()    {}
-func (*MsgSetMinterAllowanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{23}
+func (m *MsgAddBurnerSourceResponse) Reset()         { *m = MsgAddBurnerSourceResponse{} }
+func (m *MsgAddBurnerSourceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddBurnerSourceResponse) ProtoMessage()    {}
+func (*MsgAddBurnerSourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{27}
 }
-func (m *MsgSetMinterAllowanceResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddBurnerSourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetMinterAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddBurnerSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetMinterAllowanceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddBurnerSourceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -911,35 +1099,37 @@ func (m *MsgSetMinterAllowanceResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgSetMinterAllowanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetMinterAllowanceResponse.Merge(m, src)
+func (m *MsgAddBurnerSourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBurnerSourceResponse.Merge(m, src)
 }
-func (m *MsgSetMinterAllowanceResponse) XXX_Size() int {
+func (m *MsgAddBurnerSourceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetMinterAllowanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetMinterAllowanceResponse.DiscardUnknown(m)
+func (m *MsgAddBurnerSourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBurnerSourceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetMinterAllowanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddBurnerSourceResponse proto.InternalMessageInfo
 
-type MsgAddPauser struct {
+type MsgRemoveBurnerSource struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Pauser string `protobuf:"bytes,2,opt,name=pauser,proto3" json:"pauser,omitempty"`
+	Burner string `protobuf:"bytes,2,opt,name=burner,proto3" json:"burner,omitempty"`
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Denom  string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *MsgAddPauser) Reset()         { *m = MsgAddPauser{} }
-func (m *MsgAddPauser) String() string { return proto.CompactTextString(m) }
-func (*MsgAddPauser) ProtoMessage()    {}
-func (*MsgAddPauser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{24}
+func (m *MsgRemoveBurnerSource) Reset()         { *m = MsgRemoveBurnerSource{} }
+func (m *MsgRemoveBurnerSource) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBurnerSource) ProtoMessage()    {}
+func (*MsgRemoveBurnerSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{28}
 }
-func (m *MsgAddPauser) XXX_Unmarshal(b []byte) error {
+func (m *MsgRemoveBurnerSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddPauser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRemoveBurnerSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddPauser.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRemoveBurnerSource.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -949,33 +1139,33 @@ func (m *MsgAddPauser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgAddPauser) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddPauser.Merge(m, src)
+func (m *MsgRemoveBurnerSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBurnerSource.Merge(m, src)
 }
-func (m *MsgAddPauser) XXX_Size() int {
+func (m *MsgRemoveBurnerSource) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddPauser) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddPauser.DiscardUnknown(m)
+func (m *MsgRemoveBurnerSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBurnerSource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddPauser proto.InternalMessageInfo
+var xxx_messageInfo_MsgRemoveBurnerSource proto.InternalMessageInfo
 
-type MsgAddPauserResponse struct {
+type MsgRemoveBurnerSourceResponse struct {
 }
 
-func (m *MsgAddPauserResponse) Reset()         { *m = MsgAddPauserResponse{} }
-func (m *MsgAddPauserResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddPauserResponse) ProtoMessage()    {}
-func (*MsgAddPauserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{25}
+func (m *MsgRemoveBurnerSourceResponse) Reset()         { *m = MsgRemoveBurnerSourceResponse{} }
+func (m *MsgRemoveBurnerSourceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBurnerSourceResponse) ProtoMessage()    {}
+func (*MsgRemoveBurnerSourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{29}
 }
-func (m *MsgAddPauserResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRemoveBurnerSourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddPauserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRemoveBurnerSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddPauserResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRemoveBurnerSourceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -985,35 +1175,39 @@ func (m *MsgAddPauserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgAddPauserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddPauserResponse.Merge(m, src)
+func (m *MsgRemoveBurnerSourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBurnerSourceResponse.Merge(m, src)
 }
-func (m *MsgAddPauserResponse) XXX_Size() int {
+func (m *MsgRemoveBurnerSourceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddPauserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddPauserResponse.DiscardUnknown(m)
+func (m *MsgRemoveBurnerSourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBurnerSourceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddPauserResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRemoveBurnerSourceResponse proto.InternalMessageInfo
 
-type MsgRemovePauser struct {
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Pauser string `protobuf:"bytes,2,opt,name=pauser,proto3" json:"pauser,omitempty"`
+type MsgAddMinter struct {
+	Signer    string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Minter    string                `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+	Allowance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=allowance,proto3,customtype=cosmossdk.io/math.Int" json:"allowance"`
+	Denom     string                `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// expiry optionally expires the allowance at a block time.
+	Expiry time.Time `protobuf:"bytes,5,opt,name=expiry,proto3,stdtime" json:"expiry"`
 }
 
-func (m *MsgRemovePauser) Reset()         { *m = MsgRemovePauser{} }
-func (m *MsgRemovePauser) String() string { return proto.CompactTextString(m) }
-func (*MsgRemovePauser) ProtoMessage()    {}
-func (*MsgRemovePauser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{26}
+func (m *MsgAddMinter) Reset()         { *m = MsgAddMinter{} }
+func (m *MsgAddMinter) String() string { return proto.CompactTextString(m) }
+func (*MsgAddMinter) ProtoMessage()    {}
+func (*MsgAddMinter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{30}
 }
-func (m *MsgRemovePauser) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddMinter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemovePauser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemovePauser.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddMinter.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1023,33 +1217,33 @@ func (m *MsgRemovePauser) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgRemovePauser) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemovePauser.Merge(m, src)
+func (m *MsgAddMinter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddMinter.Merge(m, src)
 }
-func (m *MsgRemovePauser) XXX_Size() int {
+func (m *MsgAddMinter) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemovePauser) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemovePauser.DiscardUnknown(m)
+func (m *MsgAddMinter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddMinter.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemovePauser proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddMinter proto.InternalMessageInfo
 
-type MsgRemovePauserResponse struct {
+type MsgAddMinterResponse struct {
 }
 
-func (m *MsgRemovePauserResponse) Reset()         { *m = MsgRemovePauserResponse{} }
-func (m *MsgRemovePauserResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRemovePauserResponse) ProtoMessage()    {}
-func (*MsgRemovePauserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{27}
+func (m *MsgAddMinterResponse) Reset()         { *m = MsgAddMinterResponse{} }
+func (m *MsgAddMinterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddMinterResponse) ProtoMessage()    {}
+func (*MsgAddMinterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{31}
 }
-func (m *MsgRemovePauserResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddMinterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemovePauserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemovePauserResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddMinterResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1059,35 +1253,36 @@ func (m *MsgRemovePauserResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgRemovePauserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemovePauserResponse.Merge(m, src)
+func (m *MsgAddMinterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddMinterResponse.Merge(m, src)
 }
-func (m *MsgRemovePauserResponse) XXX_Size() int {
+func (m *MsgAddMinterResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemovePauserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemovePauserResponse.DiscardUnknown(m)
+func (m *MsgAddMinterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddMinterResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemovePauserResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddMinterResponse proto.InternalMessageInfo
 
-type MsgAddBlockedChannel struct {
-	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+type MsgRemoveMinter struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Minter string `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+	Denom  string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *MsgAddBlockedChannel) Reset()         { *m = MsgAddBlockedChannel{} }
-func (m *MsgAddBlockedChannel) String() string { return proto.CompactTextString(m) }
-func (*MsgAddBlockedChannel) ProtoMessage()    {}
-func (*MsgAddBlockedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{28}
+func (m *MsgRemoveMinter) Reset()         { *m = MsgRemoveMinter{} }
+func (m *MsgRemoveMinter) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMinter) ProtoMessage()    {}
+func (*MsgRemoveMinter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{32}
 }
-func (m *MsgAddBlockedChannel) XXX_Unmarshal(b []byte) error {
+func (m *MsgRemoveMinter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddBlockedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRemoveMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddBlockedChannel.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRemoveMinter.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1097,33 +1292,33 @@ func (m *MsgAddBlockedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgAddBlockedChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddBlockedChannel.Merge(m, src)
+func (m *MsgRemoveMinter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMinter.Merge(m, src)
 }
-func (m *MsgAddBlockedChannel) XXX_Size() int {
+func (m *MsgRemoveMinter) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddBlockedChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddBlockedChannel.DiscardUnknown(m)
+func (m *MsgRemoveMinter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMinter.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddBlockedChannel proto.InternalMessageInfo
+var xxx_messageInfo_MsgRemoveMinter proto.InternalMessageInfo
 
-type MsgAddBlockedChannelResponse struct {
+type MsgRemoveMinterResponse struct {
 }
 
-func (m *MsgAddBlockedChannelResponse) Reset()         { *m = MsgAddBlockedChannelResponse{} }
-func (m *MsgAddBlockedChannelResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddBlockedChannelResponse) ProtoMessage()    {}
-func (*MsgAddBlockedChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{29}
+func (m *MsgRemoveMinterResponse) Reset()         { *m = MsgRemoveMinterResponse{} }
+func (m *MsgRemoveMinterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMinterResponse) ProtoMessage()    {}
+func (*MsgRemoveMinterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{33}
 }
-func (m *MsgAddBlockedChannelResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRemoveMinterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddBlockedChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRemoveMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddBlockedChannelResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRemoveMinterResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1133,35 +1328,42 @@ func (m *MsgAddBlockedChannelResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgAddBlockedChannelResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddBlockedChannelResponse.Merge(m, src)
+func (m *MsgRemoveMinterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMinterResponse.Merge(m, src)
 }
-func (m *MsgAddBlockedChannelResponse) XXX_Size() int {
+func (m *MsgRemoveMinterResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddBlockedChannelResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddBlockedChannelResponse.DiscardUnknown(m)
+func (m *MsgRemoveMinterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMinterResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddBlockedChannelResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRemoveMinterResponse proto.InternalMessageInfo
 
-type MsgRemoveBlockedChannel struct {
-	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+type MsgSetMinterAllowance struct {
+	Signer    string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Minter    string                `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+	Allowance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=allowance,proto3,customtype=cosmossdk.io/math.Int" json:"allowance"`
+	Denom     string                `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// expiry optionally expires the allowance at a block time, keeping the
+	// existing expiry when empty.
+	Expiry time.Time `protobuf:"bytes,5,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	// clear_expiry removes the existing expiry, so that the allowance never expires.
+	ClearExpiry bool `protobuf:"varint,6,opt,name=clear_expiry,json=clearExpiry,proto3" json:"clear_expiry,omitempty"`
 }
 
-func (m *MsgRemoveBlockedChannel) Reset()         { *m = MsgRemoveBlockedChannel{} }
-func (m *MsgRemoveBlockedChannel) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveBlockedChannel) ProtoMessage()    {}
-func (*MsgRemoveBlockedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{30}
+func (m *MsgSetMinterAllowance) Reset()         { *m = MsgSetMinterAllowance{} }
+func (m *MsgSetMinterAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMinterAllowance) ProtoMessage()    {}
+func (*MsgSetMinterAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{34}
 }
-func (m *MsgRemoveBlockedChannel) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetMinterAllowance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveBlockedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetMinterAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveBlockedChannel.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetMinterAllowance.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1171,33 +1373,33 @@ func (m *MsgRemoveBlockedChannel) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveBlockedChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveBlockedChannel.Merge(m, src)
+func (m *MsgSetMinterAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMinterAllowance.Merge(m, src)
 }
-func (m *MsgRemoveBlockedChannel) XXX_Size() int {
+func (m *MsgSetMinterAllowance) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveBlockedChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveBlockedChannel.DiscardUnknown(m)
+func (m *MsgSetMinterAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMinterAllowance.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveBlockedChannel proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetMinterAllowance proto.InternalMessageInfo
 
-type MsgRemoveBlockedChannelResponse struct {
+type MsgSetMinterAllowanceResponse struct {
 }
 
-func (m *MsgRemoveBlockedChannelResponse) Reset()         { *m = MsgRemoveBlockedChannelResponse{} }
-func (m *MsgRemoveBlockedChannelResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveBlockedChannelResponse) ProtoMessage()    {}
-func (*MsgRemoveBlockedChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72e81433c42c397f, []int{31}
+func (m *MsgSetMinterAllowanceResponse) Reset()         { *m = MsgSetMinterAllowanceResponse{} }
+func (m *MsgSetMinterAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMinterAllowanceResponse) ProtoMessage()    {}
+func (*MsgSetMinterAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{35}
 }
-func (m *MsgRemoveBlockedChannelResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetMinterAllowanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveBlockedChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetMinterAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveBlockedChannelResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetMinterAllowanceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1207,2179 +1409,22387 @@ func (m *MsgRemoveBlockedChannelResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveBlockedChannelResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveBlockedChannelResponse.Merge(m, src)
+func (m *MsgSetMinterAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMinterAllowanceResponse.Merge(m, src)
 }
-func (m *MsgRemoveBlockedChannelResponse) XXX_Size() int {
+func (m *MsgSetMinterAllowanceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveBlockedChannelResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveBlockedChannelResponse.DiscardUnknown(m)
+func (m *MsgSetMinterAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMinterAllowanceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveBlockedChannelResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetMinterAllowanceResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgBurn)(nil), "aura.v1.MsgBurn")
-	proto.RegisterType((*MsgBurnResponse)(nil), "aura.v1.MsgBurnResponse")
-	proto.RegisterType((*MsgMint)(nil), "aura.v1.MsgMint")
-	proto.RegisterType((*MsgMintResponse)(nil), "aura.v1.MsgMintResponse")
-	proto.RegisterType((*MsgPause)(nil), "aura.v1.MsgPause")
-	proto.RegisterType((*MsgPauseResponse)(nil), "aura.v1.MsgPauseResponse")
-	proto.RegisterType((*MsgUnpause)(nil), "aura.v1.MsgUnpause")
-	proto.RegisterType((*MsgUnpauseResponse)(nil), "aura.v1.MsgUnpauseResponse")
-	proto.RegisterType((*MsgTransferOwnership)(nil), "aura.v1.MsgTransferOwnership")
-	proto.RegisterType((*MsgTransferOwnershipResponse)(nil), "aura.v1.MsgTransferOwnershipResponse")
-	proto.RegisterType((*MsgAcceptOwnership)(nil), "aura.v1.MsgAcceptOwnership")
-	proto.RegisterType((*MsgAcceptOwnershipResponse)(nil), "aura.v1.MsgAcceptOwnershipResponse")
-	proto.RegisterType((*MsgAddBurner)(nil), "aura.v1.MsgAddBurner")
-	proto.RegisterType((*MsgAddBurnerResponse)(nil), "aura.v1.MsgAddBurnerResponse")
-	proto.RegisterType((*MsgRemoveBurner)(nil), "aura.v1.MsgRemoveBurner")
-	proto.RegisterType((*MsgRemoveBurnerResponse)(nil), "aura.v1.MsgRemoveBurnerResponse")
-	proto.RegisterType((*MsgSetBurnerAllowance)(nil), "aura.v1.MsgSetBurnerAllowance")
-	proto.RegisterType((*MsgSetBurnerAllowanceResponse)(nil), "aura.v1.MsgSetBurnerAllowanceResponse")
-	proto.RegisterType((*MsgAddMinter)(nil), "aura.v1.MsgAddMinter")
-	proto.RegisterType((*MsgAddMinterResponse)(nil), "aura.v1.MsgAddMinterResponse")
-	proto.RegisterType((*MsgRemoveMinter)(nil), "aura.v1.MsgRemoveMinter")
-	proto.RegisterType((*MsgRemoveMinterResponse)(nil), "aura.v1.MsgRemoveMinterResponse")
-	proto.RegisterType((*MsgSetMinterAllowance)(nil), "aura.v1.MsgSetMinterAllowance")
-	proto.RegisterType((*MsgSetMinterAllowanceResponse)(nil), "aura.v1.MsgSetMinterAllowanceResponse")
-	proto.RegisterType((*MsgAddPauser)(nil), "aura.v1.MsgAddPauser")
-	proto.RegisterType((*MsgAddPauserResponse)(nil), "aura.v1.MsgAddPauserResponse")
-	proto.RegisterType((*MsgRemovePauser)(nil), "aura.v1.MsgRemovePauser")
-	proto.RegisterType((*MsgRemovePauserResponse)(nil), "aura.v1.MsgRemovePauserResponse")
-	proto.RegisterType((*MsgAddBlockedChannel)(nil), "aura.v1.MsgAddBlockedChannel")
-	proto.RegisterType((*MsgAddBlockedChannelResponse)(nil), "aura.v1.MsgAddBlockedChannelResponse")
-	proto.RegisterType((*MsgRemoveBlockedChannel)(nil), "aura.v1.MsgRemoveBlockedChannel")
-	proto.RegisterType((*MsgRemoveBlockedChannelResponse)(nil), "aura.v1.MsgRemoveBlockedChannelResponse")
+type MsgIncreaseMinterAllowance struct {
+	Signer string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Minter string                `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Denom  string                `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func init() { proto.RegisterFile("aura/v1/tx.proto", fileDescriptor_72e81433c42c397f) }
-
-var fileDescriptor_72e81433c42c397f = []byte{
-	// 1050 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xa6, 0x6d, 0x52, 0x3f, 0x45, 0x34, 0x5e, 0x9c, 0xc6, 0x59, 0x1a, 0x3b, 0x5d, 0xa0,
-	0x0a, 0xa1, 0xf1, 0x26, 0x41, 0xbd, 0x98, 0x53, 0x82, 0x90, 0x28, 0x92, 0x01, 0x39, 0x70, 0x00,
-	0x21, 0x95, 0xb5, 0x77, 0xba, 0xb1, 0xea, 0x9d, 0xb1, 0x76, 0xd6, 0x31, 0xbd, 0x21, 0x4e, 0x88,
-	0x13, 0xe2, 0x84, 0x38, 0xe5, 0xc8, 0x8d, 0x1c, 0xfa, 0x07, 0x70, 0xec, 0xb1, 0xca, 0x01, 0x21,
-	0x0e, 0x15, 0x4a, 0x0e, 0x41, 0xfc, 0x0d, 0x1c, 0xd0, 0xce, 0xcc, 0xce, 0xfe, 0xce, 0x06, 0x47,
-	0x18, 0xf5, 0x12, 0x79, 0xdf, 0x37, 0xef, 0xbd, 0xef, 0x7b, 0xf3, 0x76, 0xe6, 0x6d, 0x60, 0xc1,
-	0x1c, 0xb9, 0xa6, 0x71, 0xb0, 0x65, 0x78, 0x5f, 0x36, 0x87, 0x2e, 0xf1, 0x88, 0x3a, 0xe7, 0x5b,
-	0x9a, 0x07, 0x5b, 0x5a, 0xc5, 0x74, 0xfa, 0x98, 0x18, 0xec, 0x2f, 0xc7, 0xb4, 0xa5, 0x1e, 0xa1,
-	0x0e, 0xa1, 0x86, 0x43, 0x6d, 0xdf, 0xc7, 0xa1, 0xb6, 0x00, 0x96, 0x39, 0xf0, 0x80, 0x3d, 0x19,
-	0xfc, 0x41, 0x40, 0x55, 0x9b, 0xd8, 0x84, 0xdb, 0xfd, 0x5f, 0xdc, 0xaa, 0xff, 0xaa, 0xc0, 0x5c,
-	0x9b, 0xda, 0xbb, 0x23, 0x17, 0xab, 0x9b, 0x30, 0x4b, 0xfb, 0x36, 0x46, 0x6e, 0x4d, 0x59, 0x55,
-	0xd6, 0xca, 0xbb, 0xb5, 0xe3, 0x27, 0x1b, 0x55, 0x11, 0x63, 0xc7, 0xb2, 0x5c, 0x44, 0xe9, 0x9e,
-	0xe7, 0xf6, 0xb1, 0xdd, 0x11, 0xeb, 0xd4, 0xbb, 0x70, 0xf5, 0xa1, 0x4b, 0x9c, 0xda, 0x4c, 0xc1,
-	0x7a, 0xb6, 0x4a, 0x7d, 0x0f, 0x66, 0x4d, 0x87, 0x8c, 0xb0, 0x57, 0xbb, 0xc2, 0xd6, 0x6f, 0x3e,
-	0x7d, 0xde, 0x28, 0xfd, 0xfe, 0xbc, 0xb1, 0xc8, 0x7d, 0xa8, 0xf5, 0xa8, 0xd9, 0x27, 0x86, 0x63,
-	0x7a, 0xfb, 0xcd, 0xfb, 0xd8, 0x3b, 0x7e, 0xb2, 0x01, 0x22, 0xd8, 0x7d, 0xec, 0xfd, 0x74, 0x76,
-	0xb4, 0xae, 0x74, 0x84, 0x7f, 0xeb, 0xf6, 0x37, 0x87, 0x8d, 0xd2, 0x9f, 0x87, 0x8d, 0xd2, 0xd7,
-	0x67, 0x47, 0xeb, 0x82, 0xcc, 0xb7, 0x67, 0x47, 0xeb, 0x65, 0x56, 0x45, 0x5f, 0x8c, 0x5e, 0x81,
-	0x1b, 0x42, 0x57, 0x07, 0xd1, 0x21, 0xc1, 0x14, 0xe9, 0xc7, 0x5c, 0x6b, 0xbb, 0x8f, 0xbd, 0x09,
-	0xb4, 0xae, 0xc1, 0x8c, 0x47, 0x0a, 0x95, 0xce, 0x78, 0x64, 0x6a, 0x3a, 0x7d, 0x21, 0x42, 0xa7,
-	0xff, 0x53, 0xea, 0xfc, 0x02, 0xae, 0xb7, 0xa9, 0xfd, 0x91, 0x39, 0xa2, 0xe8, 0xdf, 0xeb, 0x6c,
-	0xe9, 0x39, 0x39, 0x81, 0xe5, 0x64, 0x51, 0x75, 0x15, 0x16, 0x82, 0x0c, 0x32, 0xab, 0x05, 0xd0,
-	0xa6, 0xf6, 0x27, 0x78, 0x38, 0x61, 0xde, 0xd7, 0x72, 0xf2, 0xce, 0xb3, 0xbc, 0x22, 0xae, 0x5e,
-	0x05, 0x35, 0xcc, 0x22, 0x73, 0xff, 0xac, 0x40, 0xb5, 0x4d, 0xed, 0x8f, 0x5d, 0x13, 0xd3, 0x87,
-	0xc8, 0xfd, 0x70, 0x8c, 0x91, 0x4b, 0xf7, 0xfb, 0xc3, 0x09, 0xb6, 0xf9, 0x1e, 0x94, 0x31, 0x1a,
-	0x3f, 0x20, 0x7e, 0x88, 0xc2, 0xdd, 0xbe, 0x8e, 0xd1, 0x98, 0x25, 0x6b, 0x35, 0x73, 0xd8, 0xdf,
-	0x64, 0xec, 0x53, 0xc4, 0xf4, 0x3a, 0xdc, 0xca, 0x22, 0x2c, 0x15, 0x79, 0x4c, 0xe7, 0x4e, 0xaf,
-	0x87, 0x86, 0xde, 0x25, 0xe4, 0xb4, 0xee, 0xe6, 0xf0, 0xaa, 0x32, 0x5e, 0x89, 0xf8, 0xfa, 0x2d,
-	0xd0, 0xd2, 0x59, 0x25, 0xa7, 0xbf, 0x14, 0x98, 0xf7, 0x61, 0xcb, 0xf2, 0x5f, 0x2b, 0xe4, 0x4e,
-	0x50, 0xdd, 0x4d, 0x98, 0xed, 0x32, 0xdf, 0xc2, 0xd2, 0x8a, 0x75, 0xea, 0x07, 0x50, 0x36, 0x07,
-	0x03, 0x32, 0x36, 0x71, 0x0f, 0x4d, 0xfc, 0x3e, 0x85, 0x21, 0x5a, 0x77, 0x72, 0x0a, 0xf2, 0x12,
-	0x2f, 0x48, 0xa0, 0x4d, 0xbf, 0xc9, 0x3a, 0x4a, 0x3e, 0xcb, 0x22, 0x1c, 0x2a, 0xec, 0x85, 0xeb,
-	0x20, 0x87, 0x1c, 0xa0, 0xe9, 0xd5, 0xa1, 0xf5, 0x46, 0x0e, 0xef, 0x0a, 0xe3, 0x1d, 0xa5, 0xa3,
-	0x2f, 0xc3, 0x52, 0x82, 0xa1, 0x64, 0xff, 0xb7, 0x02, 0x8b, 0x6d, 0x6a, 0xef, 0x21, 0x8f, 0x03,
-	0x3b, 0x41, 0x5d, 0x5e, 0xc8, 0xbd, 0x34, 0x72, 0x6a, 0xb2, 0xc4, 0x6a, 0x92, 0x16, 0xa9, 0x37,
-	0x60, 0x25, 0x53, 0x7d, 0x46, 0x8b, 0xfb, 0x27, 0xea, 0xa4, 0x5b, 0xeb, 0x30, 0xdf, 0xe2, 0xb2,
-	0xf0, 0x75, 0xd3, 0x6f, 0x71, 0xae, 0x2d, 0x6c, 0x71, 0xfe, 0x9c, 0xdd, 0xe2, 0xd3, 0xab, 0xc3,
-	0x85, 0x5a, 0x5c, 0x50, 0x8f, 0xb6, 0x78, 0x82, 0x7d, 0xd8, 0xe2, 0x1c, 0xb8, 0x64, 0x8b, 0xff,
-	0xcf, 0x7b, 0x59, 0xd8, 0xe2, 0x09, 0x91, 0x61, 0x8b, 0x27, 0x00, 0x59, 0x9f, 0x1f, 0x65, 0x8b,
-	0xb3, 0xfb, 0x7b, 0xc2, 0xad, 0x65, 0xf7, 0xef, 0x05, 0xca, 0xc2, 0xd7, 0x15, 0xb7, 0x24, 0xe7,
-	0x12, 0xb6, 0x24, 0x7f, 0xce, 0x6e, 0xc9, 0x29, 0xf2, 0xbe, 0x48, 0x4b, 0x0a, 0xea, 0xd1, 0x96,
-	0x4c, 0xb0, 0xff, 0x5e, 0x91, 0x97, 0xc9, 0x80, 0xf4, 0x1e, 0x21, 0xeb, 0x9d, 0x7d, 0x13, 0x63,
-	0x34, 0x98, 0x40, 0x42, 0x0d, 0xe6, 0x7a, 0xdc, 0x99, 0x6b, 0xe8, 0x04, 0x8f, 0x05, 0x13, 0x48,
-	0x2a, 0xb7, 0x98, 0x40, 0x52, 0x76, 0x49, 0xfa, 0x07, 0x25, 0x7a, 0x8d, 0xfc, 0x77, 0xbc, 0xb7,
-	0x72, 0x78, 0x2f, 0x47, 0x2f, 0xb6, 0x38, 0xf5, 0xdb, 0xd0, 0xc8, 0x61, 0x16, 0xb0, 0xdf, 0xfe,
-	0xa5, 0x0c, 0x57, 0xda, 0xd4, 0x56, 0xb7, 0xe1, 0x2a, 0xfb, 0xb6, 0x59, 0x68, 0x8a, 0xcf, 0xa9,
-	0xa6, 0xf8, 0x2a, 0xd0, 0x6a, 0x49, 0x4b, 0xe0, 0xeb, 0xfb, 0xb0, 0x6f, 0x84, 0x98, 0x8f, 0x6f,
-	0x89, 0xfb, 0x44, 0x67, 0x6e, 0xf5, 0x1e, 0x5c, 0xe3, 0x03, 0x77, 0x25, 0xba, 0x84, 0x99, 0xb4,
-	0xe5, 0x94, 0x49, 0xba, 0xbd, 0x0d, 0x73, 0xc1, 0xc4, 0xfc, 0x72, 0x74, 0x95, 0x30, 0x6a, 0xaf,
-	0x64, 0x18, 0xa5, 0xf3, 0xa7, 0x50, 0x49, 0x4f, 0xbc, 0x2b, 0x51, 0x8f, 0x14, 0xac, 0xbd, 0x7e,
-	0x2e, 0x2c, 0x43, 0xef, 0xc1, 0x8d, 0xe4, 0xec, 0x19, 0xa3, 0x92, 0x00, 0xb5, 0x57, 0xcf, 0x01,
-	0x65, 0xd0, 0x1d, 0x28, 0x87, 0xb3, 0xe3, 0x62, 0xcc, 0x23, 0x30, 0x6b, 0x2b, 0x99, 0x66, 0x19,
-	0xe2, 0x7d, 0x98, 0x8f, 0x4d, 0x5e, 0xb1, 0x0d, 0x89, 0x22, 0xda, 0x6a, 0x1e, 0x22, 0x63, 0x7d,
-	0x0e, 0x6a, 0xc6, 0x1c, 0x54, 0x8f, 0xfa, 0xa5, 0x71, 0xed, 0xce, 0xf9, 0x78, 0x42, 0xac, 0xb8,
-	0x3d, 0x93, 0x62, 0xb9, 0x39, 0x25, 0x36, 0x7e, 0x93, 0x85, 0x62, 0x45, 0x94, 0x0c, 0xb1, 0x22,
-	0xd0, 0x6a, 0x1e, 0x92, 0x10, 0x9b, 0xbc, 0x11, 0x93, 0x62, 0x13, 0x78, 0x4a, 0x6c, 0xce, 0x9d,
-	0x22, 0xc4, 0x8a, 0x73, 0x39, 0x29, 0x96, 0x9b, 0x53, 0x62, 0xe3, 0x67, 0x64, 0x28, 0x56, 0x44,
-	0xc9, 0x10, 0x2b, 0x02, 0xad, 0xe6, 0x21, 0xd1, 0x17, 0x23, 0x7d, 0xd6, 0xa6, 0x3a, 0x2b, 0x06,
-	0xc7, 0x5f, 0x8c, 0xdc, 0x53, 0x51, 0xed, 0x42, 0x35, 0xf3, 0x44, 0xcc, 0x6a, 0xb7, 0x78, 0x82,
-	0xb5, 0xa2, 0x15, 0x41, 0x0e, 0xed, 0xda, 0x57, 0xfe, 0x14, 0xb0, 0xfb, 0xee, 0xd3, 0x93, 0xba,
-	0xf2, 0xec, 0xa4, 0xae, 0xfc, 0x71, 0x52, 0x57, 0xbe, 0x3b, 0xad, 0x97, 0x9e, 0x9d, 0xd6, 0x4b,
-	0xbf, 0x9d, 0xd6, 0x4b, 0x9f, 0xbd, 0x69, 0xf7, 0xbd, 0xfd, 0x51, 0xb7, 0xd9, 0x23, 0x8e, 0x41,
-	0xb0, 0xc5, 0xff, 0xab, 0xd3, 0x23, 0x03, 0x63, 0x44, 0xad, 0xc7, 0x1b, 0x98, 0x74, 0x07, 0xc8,
-	0x38, 0xd8, 0x36, 0xbc, 0xc7, 0x43, 0x44, 0xbb, 0xb3, 0x0c, 0x7d, 0xeb, 0x9f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x45, 0x70, 0x28, 0xea, 0x62, 0x12, 0x00, 0x00,
+func (m *MsgIncreaseMinterAllowance) Reset()         { *m = MsgIncreaseMinterAllowance{} }
+func (m *MsgIncreaseMinterAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreaseMinterAllowance) ProtoMessage()    {}
+func (*MsgIncreaseMinterAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{36}
+}
+func (m *MsgIncreaseMinterAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIncreaseMinterAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIncreaseMinterAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIncreaseMinterAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreaseMinterAllowance.Merge(m, src)
+}
+func (m *MsgIncreaseMinterAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIncreaseMinterAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreaseMinterAllowance.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgIncreaseMinterAllowance proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type MsgIncreaseMinterAllowanceResponse struct {
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
-	Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error)
-	Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error)
-	Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error)
-	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
-	AcceptOwnership(ctx context.Context, in *MsgAcceptOwnership, opts ...grpc.CallOption) (*MsgAcceptOwnershipResponse, error)
-	AddBurner(ctx context.Context, in *MsgAddBurner, opts ...grpc.CallOption) (*MsgAddBurnerResponse, error)
-	RemoveBurner(ctx context.Context, in *MsgRemoveBurner, opts ...grpc.CallOption) (*MsgRemoveBurnerResponse, error)
-	SetBurnerAllowance(ctx context.Context, in *MsgSetBurnerAllowance, opts ...grpc.CallOption) (*MsgSetBurnerAllowanceResponse, error)
-	AddMinter(ctx context.Context, in *MsgAddMinter, opts ...grpc.CallOption) (*MsgAddMinterResponse, error)
-	RemoveMinter(ctx context.Context, in *MsgRemoveMinter, opts ...grpc.CallOption) (*MsgRemoveMinterResponse, error)
-	SetMinterAllowance(ctx context.Context, in *MsgSetMinterAllowance, opts ...grpc.CallOption) (*MsgSetMinterAllowanceResponse, error)
-	AddPauser(ctx context.Context, in *MsgAddPauser, opts ...grpc.CallOption) (*MsgAddPauserResponse, error)
-	RemovePauser(ctx context.Context, in *MsgRemovePauser, opts ...grpc.CallOption) (*MsgRemovePauserResponse, error)
-	AddBlockedChannel(ctx context.Context, in *MsgAddBlockedChannel, opts ...grpc.CallOption) (*MsgAddBlockedChannelResponse, error)
-	RemoveBlockedChannel(ctx context.Context, in *MsgRemoveBlockedChannel, opts ...grpc.CallOption) (*MsgRemoveBlockedChannelResponse, error)
-}
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgIncreaseMinterAllowanceResponse) Reset()         { *m = MsgIncreaseMinterAllowanceResponse{} }
+func (m *MsgIncreaseMinterAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreaseMinterAllowanceResponse) ProtoMessage()    {}
+func (*MsgIncreaseMinterAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{37}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgIncreaseMinterAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
-	out := new(MsgBurnResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Msg/Burn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgIncreaseMinterAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIncreaseMinterAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error) {
-	out := new(MsgMintResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Msg/Mint", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgIncreaseMinterAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreaseMinterAllowanceResponse.Merge(m, src)
 }
-
-func (c *msgClient) Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error) {
-	out := new(MsgPauseResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Msg/Pause", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgIncreaseMinterAllowanceResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error) {
-	out := new(MsgUnpauseResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Msg/Unpause", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgIncreaseMinterAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreaseMinterAllowanceResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error) {
-	out := new(MsgTransferOwnershipResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Msg/TransferOwnership", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgIncreaseMinterAllowanceResponse proto.InternalMessageInfo
 
-func (c *msgClient) AcceptOwnership(ctx context.Context, in *MsgAcceptOwnership, opts ...grpc.CallOption) (*MsgAcceptOwnershipResponse, error) {
-	out := new(MsgAcceptOwnershipResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Msg/AcceptOwnership", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgDecreaseMinterAllowance struct {
+	Signer string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Minter string                `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Denom  string                `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (c *msgClient) AddBurner(ctx context.Context, in *MsgAddBurner, opts ...grpc.CallOption) (*MsgAddBurnerResponse, error) {
-	out := new(MsgAddBurnerResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Msg/AddBurner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgDecreaseMinterAllowance) Reset()         { *m = MsgDecreaseMinterAllowance{} }
+func (m *MsgDecreaseMinterAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgDecreaseMinterAllowance) ProtoMessage()    {}
+func (*MsgDecreaseMinterAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{38}
 }
-
-func (c *msgClient) RemoveBurner(ctx context.Context, in *MsgRemoveBurner, opts ...grpc.CallOption) (*MsgRemoveBurnerResponse, error) {
-	out := new(MsgRemoveBurnerResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RemoveBurner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgDecreaseMinterAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) SetBurnerAllowance(ctx context.Context, in *MsgSetBurnerAllowance, opts ...grpc.CallOption) (*MsgSetBurnerAllowanceResponse, error) {
-	out := new(MsgSetBurnerAllowanceResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Msg/SetBurnerAllowance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDecreaseMinterAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDecreaseMinterAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) AddMinter(ctx context.Context, in *MsgAddMinter, opts ...grpc.CallOption) (*MsgAddMinterResponse, error) {
-	out := new(MsgAddMinterResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Msg/AddMinter", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgDecreaseMinterAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDecreaseMinterAllowance.Merge(m, src)
 }
-
-func (c *msgClient) RemoveMinter(ctx context.Context, in *MsgRemoveMinter, opts ...grpc.CallOption) (*MsgRemoveMinterResponse, error) {
-	out := new(MsgRemoveMinterResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RemoveMinter", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgDecreaseMinterAllowance) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) SetMinterAllowance(ctx context.Context, in *MsgSetMinterAllowance, opts ...grpc.CallOption) (*MsgSetMinterAllowanceResponse, error) {
-	out := new(MsgSetMinterAllowanceResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Msg/SetMinterAllowance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgDecreaseMinterAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDecreaseMinterAllowance.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddPauser(ctx context.Context, in *MsgAddPauser, opts ...grpc.CallOption) (*MsgAddPauserResponse, error) {
-	out := new(MsgAddPauserResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Msg/AddPauser", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgDecreaseMinterAllowance proto.InternalMessageInfo
 
-func (c *msgClient) RemovePauser(ctx context.Context, in *MsgRemovePauser, opts ...grpc.CallOption) (*MsgRemovePauserResponse, error) {
-	out := new(MsgRemovePauserResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RemovePauser", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgDecreaseMinterAllowanceResponse struct {
 }
 
-func (c *msgClient) AddBlockedChannel(ctx context.Context, in *MsgAddBlockedChannel, opts ...grpc.CallOption) (*MsgAddBlockedChannelResponse, error) {
-	out := new(MsgAddBlockedChannelResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Msg/AddBlockedChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgDecreaseMinterAllowanceResponse) Reset()         { *m = MsgDecreaseMinterAllowanceResponse{} }
+func (m *MsgDecreaseMinterAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDecreaseMinterAllowanceResponse) ProtoMessage()    {}
+func (*MsgDecreaseMinterAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{39}
 }
-
-func (c *msgClient) RemoveBlockedChannel(ctx context.Context, in *MsgRemoveBlockedChannel, opts ...grpc.CallOption) (*MsgRemoveBlockedChannelResponse, error) {
-	out := new(MsgRemoveBlockedChannelResponse)
-	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RemoveBlockedChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDecreaseMinterAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDecreaseMinterAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDecreaseMinterAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
-	Mint(context.Context, *MsgMint) (*MsgMintResponse, error)
-	Pause(context.Context, *MsgPause) (*MsgPauseResponse, error)
-	Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error)
-	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
-	AcceptOwnership(context.Context, *MsgAcceptOwnership) (*MsgAcceptOwnershipResponse, error)
-	AddBurner(context.Context, *MsgAddBurner) (*MsgAddBurnerResponse, error)
-	RemoveBurner(context.Context, *MsgRemoveBurner) (*MsgRemoveBurnerResponse, error)
-	SetBurnerAllowance(context.Context, *MsgSetBurnerAllowance) (*MsgSetBurnerAllowanceResponse, error)
-	AddMinter(context.Context, *MsgAddMinter) (*MsgAddMinterResponse, error)
-	RemoveMinter(context.Context, *MsgRemoveMinter) (*MsgRemoveMinterResponse, error)
-	SetMinterAllowance(context.Context, *MsgSetMinterAllowance) (*MsgSetMinterAllowanceResponse, error)
-	AddPauser(context.Context, *MsgAddPauser) (*MsgAddPauserResponse, error)
-	RemovePauser(context.Context, *MsgRemovePauser) (*MsgRemovePauserResponse, error)
-	AddBlockedChannel(context.Context, *MsgAddBlockedChannel) (*MsgAddBlockedChannelResponse, error)
-	RemoveBlockedChannel(context.Context, *MsgRemoveBlockedChannel) (*MsgRemoveBlockedChannelResponse, error)
+func (m *MsgDecreaseMinterAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDecreaseMinterAllowanceResponse.Merge(m, src)
+}
+func (m *MsgDecreaseMinterAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDecreaseMinterAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDecreaseMinterAllowanceResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgDecreaseMinterAllowanceResponse proto.InternalMessageInfo
+
+type MsgAddMinterRecipient struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Minter    string `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Denom     string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurn) (*MsgBurnResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+func (m *MsgAddMinterRecipient) Reset()         { *m = MsgAddMinterRecipient{} }
+func (m *MsgAddMinterRecipient) String() string { return proto.CompactTextString(m) }
+func (*MsgAddMinterRecipient) ProtoMessage()    {}
+func (*MsgAddMinterRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{40}
 }
-func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgMint) (*MsgMintResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+func (m *MsgAddMinterRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Pause(ctx context.Context, req *MsgPause) (*MsgPauseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+func (m *MsgAddMinterRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddMinterRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Unpause(ctx context.Context, req *MsgUnpause) (*MsgUnpauseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unpause not implemented")
+func (m *MsgAddMinterRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddMinterRecipient.Merge(m, src)
 }
-func (*UnimplementedMsgServer) TransferOwnership(ctx context.Context, req *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+func (m *MsgAddMinterRecipient) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) AcceptOwnership(ctx context.Context, req *MsgAcceptOwnership) (*MsgAcceptOwnershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcceptOwnership not implemented")
+func (m *MsgAddMinterRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddMinterRecipient.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) AddBurner(ctx context.Context, req *MsgAddBurner) (*MsgAddBurnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddBurner not implemented")
+
+var xxx_messageInfo_MsgAddMinterRecipient proto.InternalMessageInfo
+
+type MsgAddMinterRecipientResponse struct {
 }
-func (*UnimplementedMsgServer) RemoveBurner(ctx context.Context, req *MsgRemoveBurner) (*MsgRemoveBurnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveBurner not implemented")
+
+func (m *MsgAddMinterRecipientResponse) Reset()         { *m = MsgAddMinterRecipientResponse{} }
+func (m *MsgAddMinterRecipientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddMinterRecipientResponse) ProtoMessage()    {}
+func (*MsgAddMinterRecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{41}
 }
-func (*UnimplementedMsgServer) SetBurnerAllowance(ctx context.Context, req *MsgSetBurnerAllowance) (*MsgSetBurnerAllowanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetBurnerAllowance not implemented")
+func (m *MsgAddMinterRecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) AddMinter(ctx context.Context, req *MsgAddMinter) (*MsgAddMinterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMinter not implemented")
+func (m *MsgAddMinterRecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddMinterRecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RemoveMinter(ctx context.Context, req *MsgRemoveMinter) (*MsgRemoveMinterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveMinter not implemented")
+func (m *MsgAddMinterRecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddMinterRecipientResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SetMinterAllowance(ctx context.Context, req *MsgSetMinterAllowance) (*MsgSetMinterAllowanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetMinterAllowance not implemented")
+func (m *MsgAddMinterRecipientResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) AddPauser(ctx context.Context, req *MsgAddPauser) (*MsgAddPauserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPauser not implemented")
+func (m *MsgAddMinterRecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddMinterRecipientResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) RemovePauser(ctx context.Context, req *MsgRemovePauser) (*MsgRemovePauserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemovePauser not implemented")
+
+var xxx_messageInfo_MsgAddMinterRecipientResponse proto.InternalMessageInfo
+
+type MsgRemoveMinterRecipient struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Minter    string `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Denom     string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 }
-func (*UnimplementedMsgServer) AddBlockedChannel(ctx context.Context, req *MsgAddBlockedChannel) (*MsgAddBlockedChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddBlockedChannel not implemented")
+
+func (m *MsgRemoveMinterRecipient) Reset()         { *m = MsgRemoveMinterRecipient{} }
+func (m *MsgRemoveMinterRecipient) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMinterRecipient) ProtoMessage()    {}
+func (*MsgRemoveMinterRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{42}
 }
-func (*UnimplementedMsgServer) RemoveBlockedChannel(ctx context.Context, req *MsgRemoveBlockedChannel) (*MsgRemoveBlockedChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveBlockedChannel not implemented")
+func (m *MsgRemoveMinterRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMinterRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMinterRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMinterRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMinterRecipient.Merge(m, src)
+}
+func (m *MsgRemoveMinterRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMinterRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMinterRecipient.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRemoveMinterRecipient proto.InternalMessageInfo
+
+type MsgRemoveMinterRecipientResponse struct {
 }
 
-func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBurn)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Burn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Msg/Burn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Burn(ctx, req.(*MsgBurn))
+func (m *MsgRemoveMinterRecipientResponse) Reset()         { *m = MsgRemoveMinterRecipientResponse{} }
+func (m *MsgRemoveMinterRecipientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMinterRecipientResponse) ProtoMessage()    {}
+func (*MsgRemoveMinterRecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{43}
+}
+func (m *MsgRemoveMinterRecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMinterRecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMinterRecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRemoveMinterRecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMinterRecipientResponse.Merge(m, src)
+}
+func (m *MsgRemoveMinterRecipientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMinterRecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMinterRecipientResponse.DiscardUnknown(m)
 }
 
-func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMint)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Mint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Msg/Mint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Mint(ctx, req.(*MsgMint))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgRemoveMinterRecipientResponse proto.InternalMessageInfo
+
+type MsgAddPauser struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Pauser string `protobuf:"bytes,2,opt,name=pauser,proto3" json:"pauser,omitempty"`
+	Denom  string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func _Msg_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPause)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Pause(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Msg/Pause",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Pause(ctx, req.(*MsgPause))
+func (m *MsgAddPauser) Reset()         { *m = MsgAddPauser{} }
+func (m *MsgAddPauser) String() string { return proto.CompactTextString(m) }
+func (*MsgAddPauser) ProtoMessage()    {}
+func (*MsgAddPauser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{44}
+}
+func (m *MsgAddPauser) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddPauser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddPauser.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAddPauser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddPauser.Merge(m, src)
+}
+func (m *MsgAddPauser) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddPauser) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddPauser.DiscardUnknown(m)
 }
 
-func _Msg_Unpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnpause)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Unpause(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Msg/Unpause",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Unpause(ctx, req.(*MsgUnpause))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgAddPauser proto.InternalMessageInfo
+
+type MsgAddPauserResponse struct {
 }
 
-func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTransferOwnership)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).TransferOwnership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Msg/TransferOwnership",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TransferOwnership(ctx, req.(*MsgTransferOwnership))
+func (m *MsgAddPauserResponse) Reset()         { *m = MsgAddPauserResponse{} }
+func (m *MsgAddPauserResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddPauserResponse) ProtoMessage()    {}
+func (*MsgAddPauserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{45}
+}
+func (m *MsgAddPauserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddPauserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddPauserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAddPauserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddPauserResponse.Merge(m, src)
+}
+func (m *MsgAddPauserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddPauserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddPauserResponse.DiscardUnknown(m)
 }
 
-func _Msg_AcceptOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAcceptOwnership)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AcceptOwnership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Msg/AcceptOwnership",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AcceptOwnership(ctx, req.(*MsgAcceptOwnership))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgAddPauserResponse proto.InternalMessageInfo
+
+type MsgRemovePauser struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Pauser string `protobuf:"bytes,2,opt,name=pauser,proto3" json:"pauser,omitempty"`
+	Denom  string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func _Msg_AddBurner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddBurner)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddBurner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Msg/AddBurner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddBurner(ctx, req.(*MsgAddBurner))
+func (m *MsgRemovePauser) Reset()         { *m = MsgRemovePauser{} }
+func (m *MsgRemovePauser) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePauser) ProtoMessage()    {}
+func (*MsgRemovePauser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{46}
+}
+func (m *MsgRemovePauser) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePauser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePauser.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRemovePauser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePauser.Merge(m, src)
+}
+func (m *MsgRemovePauser) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemovePauser) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePauser.DiscardUnknown(m)
 }
 
-func _Msg_RemoveBurner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveBurner)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveBurner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Msg/RemoveBurner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveBurner(ctx, req.(*MsgRemoveBurner))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgRemovePauser proto.InternalMessageInfo
+
+type MsgRemovePauserResponse struct {
 }
 
-func _Msg_SetBurnerAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetBurnerAllowance)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetBurnerAllowance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Msg/SetBurnerAllowance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetBurnerAllowance(ctx, req.(*MsgSetBurnerAllowance))
+func (m *MsgRemovePauserResponse) Reset()         { *m = MsgRemovePauserResponse{} }
+func (m *MsgRemovePauserResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePauserResponse) ProtoMessage()    {}
+func (*MsgRemovePauserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{47}
+}
+func (m *MsgRemovePauserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePauserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePauserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRemovePauserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePauserResponse.Merge(m, src)
+}
+func (m *MsgRemovePauserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemovePauserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePauserResponse.DiscardUnknown(m)
 }
 
-func _Msg_AddMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddMinter)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddMinter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Msg/AddMinter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddMinter(ctx, req.(*MsgAddMinter))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgRemovePauserResponse proto.InternalMessageInfo
+
+type MsgAddBlockedChannel struct {
+	Signer    string           `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Channel   string           `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Direction ChannelDirection `protobuf:"varint,3,opt,name=direction,proto3,enum=aura.v1.ChannelDirection" json:"direction,omitempty"`
+	Denom     string           `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func _Msg_RemoveMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveMinter)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveMinter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Msg/RemoveMinter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveMinter(ctx, req.(*MsgRemoveMinter))
+func (m *MsgAddBlockedChannel) Reset()         { *m = MsgAddBlockedChannel{} }
+func (m *MsgAddBlockedChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgAddBlockedChannel) ProtoMessage()    {}
+func (*MsgAddBlockedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{48}
+}
+func (m *MsgAddBlockedChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddBlockedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddBlockedChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAddBlockedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBlockedChannel.Merge(m, src)
+}
+func (m *MsgAddBlockedChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddBlockedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBlockedChannel.DiscardUnknown(m)
 }
 
-func _Msg_SetMinterAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetMinterAllowance)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetMinterAllowance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Msg/SetMinterAllowance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetMinterAllowance(ctx, req.(*MsgSetMinterAllowance))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgAddBlockedChannel proto.InternalMessageInfo
+
+type MsgAddBlockedChannelResponse struct {
 }
 
-func _Msg_AddPauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddPauser)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddPauser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Msg/AddPauser",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddPauser(ctx, req.(*MsgAddPauser))
+func (m *MsgAddBlockedChannelResponse) Reset()         { *m = MsgAddBlockedChannelResponse{} }
+func (m *MsgAddBlockedChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddBlockedChannelResponse) ProtoMessage()    {}
+func (*MsgAddBlockedChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{49}
+}
+func (m *MsgAddBlockedChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddBlockedChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddBlockedChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAddBlockedChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBlockedChannelResponse.Merge(m, src)
+}
+func (m *MsgAddBlockedChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddBlockedChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBlockedChannelResponse.DiscardUnknown(m)
 }
 
-func _Msg_RemovePauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemovePauser)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemovePauser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Msg/RemovePauser",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemovePauser(ctx, req.(*MsgRemovePauser))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgAddBlockedChannelResponse proto.InternalMessageInfo
+
+type MsgRemoveBlockedChannel struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Denom   string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func _Msg_AddBlockedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddBlockedChannel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddBlockedChannel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Msg/AddBlockedChannel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddBlockedChannel(ctx, req.(*MsgAddBlockedChannel))
+func (m *MsgRemoveBlockedChannel) Reset()         { *m = MsgRemoveBlockedChannel{} }
+func (m *MsgRemoveBlockedChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBlockedChannel) ProtoMessage()    {}
+func (*MsgRemoveBlockedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{50}
+}
+func (m *MsgRemoveBlockedChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveBlockedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveBlockedChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRemoveBlockedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBlockedChannel.Merge(m, src)
+}
+func (m *MsgRemoveBlockedChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveBlockedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBlockedChannel.DiscardUnknown(m)
 }
 
-func _Msg_RemoveBlockedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveBlockedChannel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveBlockedChannel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aura.v1.Msg/RemoveBlockedChannel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveBlockedChannel(ctx, req.(*MsgRemoveBlockedChannel))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgRemoveBlockedChannel proto.InternalMessageInfo
+
+type MsgRemoveBlockedChannelResponse struct {
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "aura.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Burn",
-			Handler:    _Msg_Burn_Handler,
-		},
-		{
-			MethodName: "Mint",
-			Handler:    _Msg_Mint_Handler,
-		},
-		{
-			MethodName: "Pause",
-			Handler:    _Msg_Pause_Handler,
-		},
-		{
-			MethodName: "Unpause",
-			Handler:    _Msg_Unpause_Handler,
-		},
-		{
-			MethodName: "TransferOwnership",
-			Handler:    _Msg_TransferOwnership_Handler,
-		},
-		{
-			MethodName: "AcceptOwnership",
-			Handler:    _Msg_AcceptOwnership_Handler,
-		},
-		{
-			MethodName: "AddBurner",
-			Handler:    _Msg_AddBurner_Handler,
-		},
-		{
-			MethodName: "RemoveBurner",
-			Handler:    _Msg_RemoveBurner_Handler,
-		},
-		{
-			MethodName: "SetBurnerAllowance",
-			Handler:    _Msg_SetBurnerAllowance_Handler,
-		},
-		{
-			MethodName: "AddMinter",
-			Handler:    _Msg_AddMinter_Handler,
-		},
-		{
-			MethodName: "RemoveMinter",
-			Handler:    _Msg_RemoveMinter_Handler,
-		},
-		{
-			MethodName: "SetMinterAllowance",
-			Handler:    _Msg_SetMinterAllowance_Handler,
-		},
-		{
-			MethodName: "AddPauser",
-			Handler:    _Msg_AddPauser_Handler,
-		},
-		{
-			MethodName: "RemovePauser",
-			Handler:    _Msg_RemovePauser_Handler,
-		},
-		{
-			MethodName: "AddBlockedChannel",
-			Handler:    _Msg_AddBlockedChannel_Handler,
-		},
-		{
-			MethodName: "RemoveBlockedChannel",
-			Handler:    _Msg_RemoveBlockedChannel_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "aura/v1/tx.proto",
+func (m *MsgRemoveBlockedChannelResponse) Reset()         { *m = MsgRemoveBlockedChannelResponse{} }
+func (m *MsgRemoveBlockedChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBlockedChannelResponse) ProtoMessage()    {}
+func (*MsgRemoveBlockedChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{51}
 }
-
-func (m *MsgBurn) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveBlockedChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveBlockedChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveBlockedChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveBlockedChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBlockedChannelResponse.Merge(m, src)
+}
+func (m *MsgRemoveBlockedChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveBlockedChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBlockedChannelResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBurn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveBlockedChannelResponse proto.InternalMessageInfo
+
+type MsgAddPauseExemption struct {
+	Signer    string             `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Address   string             `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Direction ExemptionDirection `protobuf:"varint,3,opt,name=direction,proto3,enum=aura.v1.ExemptionDirection" json:"direction,omitempty"`
+	Denom     string             `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *MsgBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgAddPauseExemption) Reset()         { *m = MsgAddPauseExemption{} }
+func (m *MsgAddPauseExemption) String() string { return proto.CompactTextString(m) }
+func (*MsgAddPauseExemption) ProtoMessage()    {}
+func (*MsgAddPauseExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{52}
+}
+func (m *MsgAddPauseExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddPauseExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddPauseExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgAddPauseExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddPauseExemption.Merge(m, src)
 }
-
-func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAddPauseExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddPauseExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddPauseExemption.DiscardUnknown(m)
 }
 
-func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgAddPauseExemption proto.InternalMessageInfo
+
+type MsgAddPauseExemptionResponse struct {
 }
 
-func (m *MsgMint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAddPauseExemptionResponse) Reset()         { *m = MsgAddPauseExemptionResponse{} }
+func (m *MsgAddPauseExemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddPauseExemptionResponse) ProtoMessage()    {}
+func (*MsgAddPauseExemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{53}
+}
+func (m *MsgAddPauseExemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddPauseExemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddPauseExemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAddPauseExemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddPauseExemptionResponse.Merge(m, src)
+}
+func (m *MsgAddPauseExemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddPauseExemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddPauseExemptionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMint) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAddPauseExemptionResponse proto.InternalMessageInfo
+
+type MsgRemovePauseExemption struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *MsgMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgRemovePauseExemption) Reset()         { *m = MsgRemovePauseExemption{} }
+func (m *MsgRemovePauseExemption) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePauseExemption) ProtoMessage()    {}
+func (*MsgRemovePauseExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{54}
+}
+func (m *MsgRemovePauseExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePauseExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePauseExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgMintResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRemovePauseExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePauseExemption.Merge(m, src)
 }
-
-func (m *MsgMintResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemovePauseExemption) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemovePauseExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePauseExemption.DiscardUnknown(m)
 }
 
-func (m *MsgPause) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgRemovePauseExemption proto.InternalMessageInfo
+
+type MsgRemovePauseExemptionResponse struct {
 }
 
-func (m *MsgPause) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemovePauseExemptionResponse) Reset()         { *m = MsgRemovePauseExemptionResponse{} }
+func (m *MsgRemovePauseExemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePauseExemptionResponse) ProtoMessage()    {}
+func (*MsgRemovePauseExemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{55}
 }
-
-func (m *MsgPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRemovePauseExemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgPauseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemovePauseExemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePauseExemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgPauseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemovePauseExemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePauseExemptionResponse.Merge(m, src)
 }
-
-func (m *MsgPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemovePauseExemptionResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUnpause) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRemovePauseExemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePauseExemptionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUnpause) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgRemovePauseExemptionResponse proto.InternalMessageInfo
 
-func (m *MsgUnpause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type MsgSetChannelMode struct {
+	Signer string      `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Mode   ChannelMode `protobuf:"varint,2,opt,name=mode,proto3,enum=aura.v1.ChannelMode" json:"mode,omitempty"`
 }
 
-func (m *MsgUnpauseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetChannelMode) Reset()         { *m = MsgSetChannelMode{} }
+func (m *MsgSetChannelMode) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChannelMode) ProtoMessage()    {}
+func (*MsgSetChannelMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{56}
+}
+func (m *MsgSetChannelMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChannelMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChannelMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUnpauseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetChannelMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChannelMode.Merge(m, src)
+}
+func (m *MsgSetChannelMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChannelMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChannelMode.DiscardUnknown(m)
 }
 
-func (m *MsgUnpauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetChannelMode proto.InternalMessageInfo
+
+type MsgSetChannelModeResponse struct {
 }
 
-func (m *MsgTransferOwnership) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetChannelModeResponse) Reset()         { *m = MsgSetChannelModeResponse{} }
+func (m *MsgSetChannelModeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChannelModeResponse) ProtoMessage()    {}
+func (*MsgSetChannelModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{57}
+}
+func (m *MsgSetChannelModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChannelModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChannelModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgTransferOwnership) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetChannelModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChannelModeResponse.Merge(m, src)
+}
+func (m *MsgSetChannelModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChannelModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChannelModeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgTransferOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewOwner) > 0 {
-		i -= len(m.NewOwner)
-		copy(dAtA[i:], m.NewOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetChannelModeResponse proto.InternalMessageInfo
+
+type MsgAddAllowedChannel struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
-func (m *MsgTransferOwnershipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAddAllowedChannel) Reset()         { *m = MsgAddAllowedChannel{} }
+func (m *MsgAddAllowedChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAllowedChannel) ProtoMessage()    {}
+func (*MsgAddAllowedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{58}
+}
+func (m *MsgAddAllowedChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAllowedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAllowedChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgTransferOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAddAllowedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAllowedChannel.Merge(m, src)
+}
+func (m *MsgAddAllowedChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAllowedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAllowedChannel.DiscardUnknown(m)
 }
 
-func (m *MsgTransferOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgAddAllowedChannel proto.InternalMessageInfo
+
+type MsgAddAllowedChannelResponse struct {
 }
 
-func (m *MsgAcceptOwnership) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAddAllowedChannelResponse) Reset()         { *m = MsgAddAllowedChannelResponse{} }
+func (m *MsgAddAllowedChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAllowedChannelResponse) ProtoMessage()    {}
+func (*MsgAddAllowedChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{59}
+}
+func (m *MsgAddAllowedChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAllowedChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAllowedChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgAcceptOwnership) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAddAllowedChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAllowedChannelResponse.Merge(m, src)
+}
+func (m *MsgAddAllowedChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAllowedChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAllowedChannelResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAcceptOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgAddAllowedChannelResponse proto.InternalMessageInfo
+
+type MsgRemoveAllowedChannel struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
-func (m *MsgAcceptOwnershipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveAllowedChannel) Reset()         { *m = MsgRemoveAllowedChannel{} }
+func (m *MsgRemoveAllowedChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAllowedChannel) ProtoMessage()    {}
+func (*MsgRemoveAllowedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{60}
+}
+func (m *MsgRemoveAllowedChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAllowedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAllowedChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgAcceptOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveAllowedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAllowedChannel.Merge(m, src)
+}
+func (m *MsgRemoveAllowedChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAllowedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAllowedChannel.DiscardUnknown(m)
 }
 
-func (m *MsgAcceptOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRemoveAllowedChannel proto.InternalMessageInfo
+
+type MsgRemoveAllowedChannelResponse struct {
 }
 
-func (m *MsgAddBurner) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveAllowedChannelResponse) Reset()         { *m = MsgRemoveAllowedChannelResponse{} }
+func (m *MsgRemoveAllowedChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAllowedChannelResponse) ProtoMessage()    {}
+func (*MsgRemoveAllowedChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{61}
+}
+func (m *MsgRemoveAllowedChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAllowedChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAllowedChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveAllowedChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAllowedChannelResponse.Merge(m, src)
+}
+func (m *MsgRemoveAllowedChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAllowedChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAllowedChannelResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddBurner) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveAllowedChannelResponse proto.InternalMessageInfo
+
+type MsgSetRateLimit struct {
+	Signer        string                      `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Channel       string                      `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Window        time.Duration               `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window"`
+	MaxAmount     cosmossdk_io_math.Int       `protobuf:"bytes,4,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount"`
+	MaxPercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_percentage,json=maxPercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_percentage"`
 }
 
-func (m *MsgAddBurner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Allowance.Size()
-		i -= size
-		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSetRateLimit) Reset()         { *m = MsgSetRateLimit{} }
+func (m *MsgSetRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRateLimit) ProtoMessage()    {}
+func (*MsgSetRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{62}
+}
+func (m *MsgSetRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Burner) > 0 {
-		i -= len(m.Burner)
-		copy(dAtA[i:], m.Burner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Burner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgAddBurnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRateLimit.Merge(m, src)
 }
-
-func (m *MsgAddBurnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRateLimit.DiscardUnknown(m)
 }
 
-func (m *MsgAddBurnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetRateLimit proto.InternalMessageInfo
+
+type MsgSetRateLimitResponse struct {
 }
 
-func (m *MsgRemoveBurner) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetRateLimitResponse) Reset()         { *m = MsgSetRateLimitResponse{} }
+func (m *MsgSetRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRateLimitResponse) ProtoMessage()    {}
+func (*MsgSetRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{63}
+}
+func (m *MsgSetRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRemoveBurner) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRateLimitResponse.Merge(m, src)
+}
+func (m *MsgSetRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRateLimitResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveBurner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Burner) > 0 {
-		i -= len(m.Burner)
-		copy(dAtA[i:], m.Burner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Burner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetRateLimitResponse proto.InternalMessageInfo
+
+type MsgRemoveRateLimit struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
-func (m *MsgRemoveBurnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveRateLimit) Reset()         { *m = MsgRemoveRateLimit{} }
+func (m *MsgRemoveRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRateLimit) ProtoMessage()    {}
+func (*MsgRemoveRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{64}
+}
+func (m *MsgRemoveRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRemoveBurnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRateLimit.Merge(m, src)
+}
+func (m *MsgRemoveRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRateLimit.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveBurnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRemoveRateLimit proto.InternalMessageInfo
+
+type MsgRemoveRateLimitResponse struct {
 }
 
-func (m *MsgSetBurnerAllowance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveRateLimitResponse) Reset()         { *m = MsgRemoveRateLimitResponse{} }
+func (m *MsgRemoveRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRateLimitResponse) ProtoMessage()    {}
+func (*MsgRemoveRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{65}
+}
+func (m *MsgRemoveRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRateLimitResponse.Merge(m, src)
+}
+func (m *MsgRemoveRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRateLimitResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetBurnerAllowance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveRateLimitResponse proto.InternalMessageInfo
+
+type MsgAddPriceSetter struct {
+	Signer      string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	PriceSetter string `protobuf:"bytes,2,opt,name=price_setter,json=priceSetter,proto3" json:"price_setter,omitempty"`
 }
 
-func (m *MsgSetBurnerAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Allowance.Size()
-		i -= size
-		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgAddPriceSetter) Reset()         { *m = MsgAddPriceSetter{} }
+func (m *MsgAddPriceSetter) String() string { return proto.CompactTextString(m) }
+func (*MsgAddPriceSetter) ProtoMessage()    {}
+func (*MsgAddPriceSetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{66}
+}
+func (m *MsgAddPriceSetter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddPriceSetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddPriceSetter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Burner) > 0 {
-		i -= len(m.Burner)
-		copy(dAtA[i:], m.Burner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Burner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSetBurnerAllowanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgAddPriceSetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddPriceSetter.Merge(m, src)
 }
-
-func (m *MsgSetBurnerAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAddPriceSetter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddPriceSetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddPriceSetter.DiscardUnknown(m)
 }
 
-func (m *MsgSetBurnerAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgAddPriceSetter proto.InternalMessageInfo
+
+type MsgAddPriceSetterResponse struct {
 }
 
-func (m *MsgAddMinter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgAddPriceSetterResponse) Reset()         { *m = MsgAddPriceSetterResponse{} }
+func (m *MsgAddPriceSetterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddPriceSetterResponse) ProtoMessage()    {}
+func (*MsgAddPriceSetterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{67}
 }
-
-func (m *MsgAddMinter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAddPriceSetterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgAddMinter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Allowance.Size()
-		i -= size
-		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgAddPriceSetterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddPriceSetterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Minter) > 0 {
-		i -= len(m.Minter)
-		copy(dAtA[i:], m.Minter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgAddMinterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgAddPriceSetterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddPriceSetterResponse.Merge(m, src)
 }
-
-func (m *MsgAddMinterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAddPriceSetterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddPriceSetterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddPriceSetterResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgAddPriceSetterResponse proto.InternalMessageInfo
+
+type MsgRemovePriceSetter struct {
+	Signer      string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	PriceSetter string `protobuf:"bytes,2,opt,name=price_setter,json=priceSetter,proto3" json:"price_setter,omitempty"`
 }
 
-func (m *MsgRemoveMinter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemovePriceSetter) Reset()         { *m = MsgRemovePriceSetter{} }
+func (m *MsgRemovePriceSetter) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePriceSetter) ProtoMessage()    {}
+func (*MsgRemovePriceSetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{68}
+}
+func (m *MsgRemovePriceSetter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePriceSetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePriceSetter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRemoveMinter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemovePriceSetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePriceSetter.Merge(m, src)
+}
+func (m *MsgRemovePriceSetter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemovePriceSetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePriceSetter.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveMinter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Minter) > 0 {
-		i -= len(m.Minter)
-		copy(dAtA[i:], m.Minter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRemovePriceSetter proto.InternalMessageInfo
+
+type MsgRemovePriceSetterResponse struct {
 }
 
-func (m *MsgRemoveMinterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemovePriceSetterResponse) Reset()         { *m = MsgRemovePriceSetterResponse{} }
+func (m *MsgRemovePriceSetterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePriceSetterResponse) ProtoMessage()    {}
+func (*MsgRemovePriceSetterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{69}
+}
+func (m *MsgRemovePriceSetterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePriceSetterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePriceSetterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRemoveMinterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemovePriceSetterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePriceSetterResponse.Merge(m, src)
+}
+func (m *MsgRemovePriceSetterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemovePriceSetterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePriceSetterResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRemovePriceSetterResponse proto.InternalMessageInfo
+
+type MsgSetPriceRange struct {
+	Signer     string                      `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	End        time.Time                   `protobuf:"bytes,2,opt,name=end,proto3,stdtime" json:"end"`
+	DailyRate  cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=daily_rate,json=dailyRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"daily_rate"`
+	StartPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=start_price,json=startPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"start_price"`
 }
 
-func (m *MsgSetMinterAllowance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetPriceRange) Reset()         { *m = MsgSetPriceRange{} }
+func (m *MsgSetPriceRange) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPriceRange) ProtoMessage()    {}
+func (*MsgSetPriceRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{70}
+}
+func (m *MsgSetPriceRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPriceRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPriceRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetPriceRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPriceRange.Merge(m, src)
+}
+func (m *MsgSetPriceRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPriceRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPriceRange.DiscardUnknown(m)
 }
 
-func (m *MsgSetMinterAllowance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetPriceRange proto.InternalMessageInfo
+
+type MsgSetPriceRangeResponse struct {
 }
 
-func (m *MsgSetMinterAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Allowance.Size()
-		i -= size
-		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSetPriceRangeResponse) Reset()         { *m = MsgSetPriceRangeResponse{} }
+func (m *MsgSetPriceRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPriceRangeResponse) ProtoMessage()    {}
+func (*MsgSetPriceRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{71}
+}
+func (m *MsgSetPriceRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPriceRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPriceRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Minter) > 0 {
-		i -= len(m.Minter)
-		copy(dAtA[i:], m.Minter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSetMinterAllowanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetPriceRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPriceRangeResponse.Merge(m, src)
 }
-
-func (m *MsgSetMinterAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetPriceRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPriceRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPriceRangeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetMinterAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetPriceRangeResponse proto.InternalMessageInfo
+
+type MsgSetMaxPriceDeviation struct {
+	Signer            string                      `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	MaxPriceDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_price_deviation,json=maxPriceDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_deviation"`
 }
 
-func (m *MsgAddPauser) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetMaxPriceDeviation) Reset()         { *m = MsgSetMaxPriceDeviation{} }
+func (m *MsgSetMaxPriceDeviation) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxPriceDeviation) ProtoMessage()    {}
+func (*MsgSetMaxPriceDeviation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{72}
+}
+func (m *MsgSetMaxPriceDeviation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxPriceDeviation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxPriceDeviation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgAddPauser) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetMaxPriceDeviation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxPriceDeviation.Merge(m, src)
+}
+func (m *MsgSetMaxPriceDeviation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxPriceDeviation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxPriceDeviation.DiscardUnknown(m)
 }
 
-func (m *MsgAddPauser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pauser) > 0 {
-		i -= len(m.Pauser)
-		copy(dAtA[i:], m.Pauser)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Pauser)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetMaxPriceDeviation proto.InternalMessageInfo
+
+type MsgSetMaxPriceDeviationResponse struct {
 }
 
-func (m *MsgAddPauserResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetMaxPriceDeviationResponse) Reset()         { *m = MsgSetMaxPriceDeviationResponse{} }
+func (m *MsgSetMaxPriceDeviationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxPriceDeviationResponse) ProtoMessage()    {}
+func (*MsgSetMaxPriceDeviationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{73}
+}
+func (m *MsgSetMaxPriceDeviationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxPriceDeviationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxPriceDeviationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgAddPauserResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetMaxPriceDeviationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxPriceDeviationResponse.Merge(m, src)
+}
+func (m *MsgSetMaxPriceDeviationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxPriceDeviationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxPriceDeviationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddPauserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetMaxPriceDeviationResponse proto.InternalMessageInfo
+
+type MsgWrap struct {
+	Signer string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *MsgRemovePauser) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWrap) Reset()         { *m = MsgWrap{} }
+func (m *MsgWrap) String() string { return proto.CompactTextString(m) }
+func (*MsgWrap) ProtoMessage()    {}
+func (*MsgWrap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{74}
+}
+func (m *MsgWrap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWrap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWrap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRemovePauser) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWrap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWrap.Merge(m, src)
+}
+func (m *MsgWrap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWrap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWrap.DiscardUnknown(m)
 }
 
-func (m *MsgRemovePauser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pauser) > 0 {
-		i -= len(m.Pauser)
-		copy(dAtA[i:], m.Pauser)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Pauser)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgWrap proto.InternalMessageInfo
+
+type MsgWrapResponse struct {
 }
 
-func (m *MsgRemovePauserResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWrapResponse) Reset()         { *m = MsgWrapResponse{} }
+func (m *MsgWrapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWrapResponse) ProtoMessage()    {}
+func (*MsgWrapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{75}
+}
+func (m *MsgWrapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWrapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWrapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRemovePauserResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWrapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWrapResponse.Merge(m, src)
+}
+func (m *MsgWrapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWrapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWrapResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemovePauserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgWrapResponse proto.InternalMessageInfo
+
+type MsgUnwrap struct {
+	Signer string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *MsgAddBlockedChannel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnwrap) Reset()         { *m = MsgUnwrap{} }
+func (m *MsgUnwrap) String() string { return proto.CompactTextString(m) }
+func (*MsgUnwrap) ProtoMessage()    {}
+func (*MsgUnwrap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{76}
+}
+func (m *MsgUnwrap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnwrap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnwrap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUnwrap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnwrap.Merge(m, src)
+}
+func (m *MsgUnwrap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnwrap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnwrap.DiscardUnknown(m)
 }
 
-func (m *MsgAddBlockedChannel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUnwrap proto.InternalMessageInfo
+
+type MsgUnwrapResponse struct {
 }
 
-func (m *MsgAddBlockedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUnwrapResponse) Reset()         { *m = MsgUnwrapResponse{} }
+func (m *MsgUnwrapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnwrapResponse) ProtoMessage()    {}
+func (*MsgUnwrapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{77}
+}
+func (m *MsgUnwrapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnwrapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnwrapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUnwrapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnwrapResponse.Merge(m, src)
+}
+func (m *MsgUnwrapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnwrapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnwrapResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddBlockedChannelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgUnwrapResponse proto.InternalMessageInfo
+
+type MsgTransferRebasing struct {
+	Signer    string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Recipient string                `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *MsgAddBlockedChannelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTransferRebasing) Reset()         { *m = MsgTransferRebasing{} }
+func (m *MsgTransferRebasing) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferRebasing) ProtoMessage()    {}
+func (*MsgTransferRebasing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{78}
+}
+func (m *MsgTransferRebasing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferRebasing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferRebasing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferRebasing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferRebasing.Merge(m, src)
+}
+func (m *MsgTransferRebasing) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferRebasing) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferRebasing.DiscardUnknown(m)
 }
 
-func (m *MsgAddBlockedChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgTransferRebasing proto.InternalMessageInfo
+
+type MsgTransferRebasingResponse struct {
 }
 
-func (m *MsgRemoveBlockedChannel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferRebasingResponse) Reset()         { *m = MsgTransferRebasingResponse{} }
+func (m *MsgTransferRebasingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferRebasingResponse) ProtoMessage()    {}
+func (*MsgTransferRebasingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{79}
+}
+func (m *MsgTransferRebasingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferRebasingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferRebasingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgTransferRebasingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferRebasingResponse.Merge(m, src)
+}
+func (m *MsgTransferRebasingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferRebasingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferRebasingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveBlockedChannel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgTransferRebasingResponse proto.InternalMessageInfo
+
+type MsgSetStablecoin struct {
+	Signer   string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Denom    string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
 }
 
-func (m *MsgRemoveBlockedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSetStablecoin) Reset()         { *m = MsgSetStablecoin{} }
+func (m *MsgSetStablecoin) String() string { return proto.CompactTextString(m) }
+func (*MsgSetStablecoin) ProtoMessage()    {}
+func (*MsgSetStablecoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{80}
 }
-
-func (m *MsgRemoveBlockedChannelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetStablecoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetStablecoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetStablecoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRemoveBlockedChannelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetStablecoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetStablecoin.Merge(m, src)
+}
+func (m *MsgSetStablecoin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetStablecoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetStablecoin.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveBlockedChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetStablecoin proto.InternalMessageInfo
+
+type MsgSetStablecoinResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgSetStablecoinResponse) Reset()         { *m = MsgSetStablecoinResponse{} }
+func (m *MsgSetStablecoinResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetStablecoinResponse) ProtoMessage()    {}
+func (*MsgSetStablecoinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{81}
 }
-func (m *MsgBurn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetStablecoinResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetStablecoinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetStablecoinResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgSetStablecoinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetStablecoinResponse.Merge(m, src)
+}
+func (m *MsgSetStablecoinResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetStablecoinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetStablecoinResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBurnResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgSetStablecoinResponse proto.InternalMessageInfo
+
+type MsgRequestSubscription struct {
+	Signer string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *MsgMint) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRequestSubscription) Reset()         { *m = MsgRequestSubscription{} }
+func (m *MsgRequestSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestSubscription) ProtoMessage()    {}
+func (*MsgRequestSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{82}
+}
+func (m *MsgRequestSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgRequestSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestSubscription.Merge(m, src)
+}
+func (m *MsgRequestSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestSubscription.DiscardUnknown(m)
 }
 
-func (m *MsgMintResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgRequestSubscription proto.InternalMessageInfo
+
+type MsgRequestSubscriptionResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgPause) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRequestSubscriptionResponse) Reset()         { *m = MsgRequestSubscriptionResponse{} }
+func (m *MsgRequestSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestSubscriptionResponse) ProtoMessage()    {}
+func (*MsgRequestSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{83}
+}
+func (m *MsgRequestSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgRequestSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestSubscriptionResponse.Merge(m, src)
+}
+func (m *MsgRequestSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestSubscriptionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPauseResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRequestSubscriptionResponse proto.InternalMessageInfo
+
+func (m *MsgRequestSubscriptionResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgUnpause) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+type MsgFulfillSubscription struct {
+	Signer string                      `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Id     uint64                      `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Price  cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
 }
 
-func (m *MsgUnpauseResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFulfillSubscription) Reset()         { *m = MsgFulfillSubscription{} }
+func (m *MsgFulfillSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillSubscription) ProtoMessage()    {}
+func (*MsgFulfillSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{84}
+}
+func (m *MsgFulfillSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgFulfillSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillSubscription.Merge(m, src)
+}
+func (m *MsgFulfillSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillSubscription.DiscardUnknown(m)
 }
 
-func (m *MsgTransferOwnership) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+var xxx_messageInfo_MsgFulfillSubscription proto.InternalMessageInfo
+
+type MsgFulfillSubscriptionResponse struct {
 }
 
-func (m *MsgTransferOwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFulfillSubscriptionResponse) Reset()         { *m = MsgFulfillSubscriptionResponse{} }
+func (m *MsgFulfillSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillSubscriptionResponse) ProtoMessage()    {}
+func (*MsgFulfillSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{85}
+}
+func (m *MsgFulfillSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgFulfillSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillSubscriptionResponse.Merge(m, src)
+}
+func (m *MsgFulfillSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillSubscriptionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAcceptOwnership) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+var xxx_messageInfo_MsgFulfillSubscriptionResponse proto.InternalMessageInfo
+
+type MsgRejectSubscription struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgAcceptOwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgRejectSubscription) Reset()         { *m = MsgRejectSubscription{} }
+func (m *MsgRejectSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectSubscription) ProtoMessage()    {}
+func (*MsgRejectSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{86}
 }
-
-func (m *MsgAddBurner) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Burner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRejectSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Allowance.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgRejectSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectSubscription.Merge(m, src)
+}
+func (m *MsgRejectSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectSubscription.DiscardUnknown(m)
 }
 
-func (m *MsgAddBurnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgRejectSubscription proto.InternalMessageInfo
+
+type MsgRejectSubscriptionResponse struct {
 }
 
-func (m *MsgRemoveBurner) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Burner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRejectSubscriptionResponse) Reset()         { *m = MsgRejectSubscriptionResponse{} }
+func (m *MsgRejectSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectSubscriptionResponse) ProtoMessage()    {}
+func (*MsgRejectSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{87}
+}
+func (m *MsgRejectSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgRejectSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectSubscriptionResponse.Merge(m, src)
+}
+func (m *MsgRejectSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectSubscriptionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveBurnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgRejectSubscriptionResponse proto.InternalMessageInfo
+
+type MsgRequestRedemption struct {
+	Signer string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *MsgSetBurnerAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Burner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRequestRedemption) Reset()         { *m = MsgRequestRedemption{} }
+func (m *MsgRequestRedemption) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestRedemption) ProtoMessage()    {}
+func (*MsgRequestRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{88}
+}
+func (m *MsgRequestRedemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestRedemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Allowance.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgRequestRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestRedemption.Merge(m, src)
+}
+func (m *MsgRequestRedemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestRedemption.DiscardUnknown(m)
 }
 
-func (m *MsgSetBurnerAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgRequestRedemption proto.InternalMessageInfo
+
+type MsgRequestRedemptionResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgAddMinter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Minter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRequestRedemptionResponse) Reset()         { *m = MsgRequestRedemptionResponse{} }
+func (m *MsgRequestRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestRedemptionResponse) ProtoMessage()    {}
+func (*MsgRequestRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{89}
+}
+func (m *MsgRequestRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Allowance.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgRequestRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestRedemptionResponse.Merge(m, src)
+}
+func (m *MsgRequestRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestRedemptionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddMinterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRequestRedemptionResponse proto.InternalMessageInfo
+
+func (m *MsgRequestRedemptionResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgRemoveMinter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Minter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+type MsgCancelRedemption struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgRemoveMinterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelRedemption) Reset()         { *m = MsgCancelRedemption{} }
+func (m *MsgCancelRedemption) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRedemption) ProtoMessage()    {}
+func (*MsgCancelRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{90}
+}
+func (m *MsgCancelRedemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRedemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgCancelRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRedemption.Merge(m, src)
+}
+func (m *MsgCancelRedemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRedemption.DiscardUnknown(m)
 }
 
-func (m *MsgSetMinterAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Minter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Allowance.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+var xxx_messageInfo_MsgCancelRedemption proto.InternalMessageInfo
+
+type MsgCancelRedemptionResponse struct {
 }
 
-func (m *MsgSetMinterAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelRedemptionResponse) Reset()         { *m = MsgCancelRedemptionResponse{} }
+func (m *MsgCancelRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRedemptionResponse) ProtoMessage()    {}
+func (*MsgCancelRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{91}
+}
+func (m *MsgCancelRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgCancelRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRedemptionResponse.Merge(m, src)
+}
+func (m *MsgCancelRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRedemptionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddPauser) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Pauser)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+var xxx_messageInfo_MsgCancelRedemptionResponse proto.InternalMessageInfo
+
+type MsgSettleRedemption struct {
+	Signer string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Id     uint64                `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Payout cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=payout,proto3,customtype=cosmossdk.io/math.Int" json:"payout"`
 }
 
-func (m *MsgAddPauserResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSettleRedemption) Reset()         { *m = MsgSettleRedemption{} }
+func (m *MsgSettleRedemption) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleRedemption) ProtoMessage()    {}
+func (*MsgSettleRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{92}
+}
+func (m *MsgSettleRedemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettleRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettleRedemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSettleRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleRedemption.Merge(m, src)
+}
+func (m *MsgSettleRedemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettleRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleRedemption.DiscardUnknown(m)
 }
 
-func (m *MsgRemovePauser) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSettleRedemption proto.InternalMessageInfo
+
+type MsgSettleRedemptionResponse struct {
+}
+
+func (m *MsgSettleRedemptionResponse) Reset()         { *m = MsgSettleRedemptionResponse{} }
+func (m *MsgSettleRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleRedemptionResponse) ProtoMessage()    {}
+func (*MsgSettleRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{93}
+}
+func (m *MsgSettleRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettleRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettleRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSettleRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleRedemptionResponse.Merge(m, src)
+}
+func (m *MsgSettleRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettleRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleRedemptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSettleRedemptionResponse proto.InternalMessageInfo
+
+type MsgRejectRedemption struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgRejectRedemption) Reset()         { *m = MsgRejectRedemption{} }
+func (m *MsgRejectRedemption) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectRedemption) ProtoMessage()    {}
+func (*MsgRejectRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{94}
+}
+func (m *MsgRejectRedemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectRedemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Pauser)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgRejectRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectRedemption.Merge(m, src)
+}
+func (m *MsgRejectRedemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectRedemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRejectRedemption proto.InternalMessageInfo
+
+type MsgRejectRedemptionResponse struct {
+}
+
+func (m *MsgRejectRedemptionResponse) Reset()         { *m = MsgRejectRedemptionResponse{} }
+func (m *MsgRejectRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectRedemptionResponse) ProtoMessage()    {}
+func (*MsgRejectRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{95}
+}
+func (m *MsgRejectRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgRejectRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectRedemptionResponse.Merge(m, src)
+}
+func (m *MsgRejectRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectRedemptionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemovePauserResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRejectRedemptionResponse proto.InternalMessageInfo
+
+type MsgSwap struct {
+	Signer       string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Amount       types.Coin            `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	MinAmountOut cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=min_amount_out,json=minAmountOut,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount_out"`
+}
+
+func (m *MsgSwap) Reset()         { *m = MsgSwap{} }
+func (m *MsgSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgSwap) ProtoMessage()    {}
+func (*MsgSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{96}
+}
+func (m *MsgSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwap.Merge(m, src)
+}
+func (m *MsgSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwap.DiscardUnknown(m)
 }
 
-func (m *MsgAddBlockedChannel) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSwap proto.InternalMessageInfo
+
+type MsgSwapResponse struct {
+	AmountOut types.Coin `protobuf:"bytes,1,opt,name=amount_out,json=amountOut,proto3" json:"amount_out"`
+}
+
+func (m *MsgSwapResponse) Reset()         { *m = MsgSwapResponse{} }
+func (m *MsgSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapResponse) ProtoMessage()    {}
+func (*MsgSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{97}
+}
+func (m *MsgSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapResponse.Merge(m, src)
+}
+func (m *MsgSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapResponse proto.InternalMessageInfo
+
+func (m *MsgSwapResponse) GetAmountOut() types.Coin {
+	if m != nil {
+		return m.AmountOut
 	}
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return types.Coin{}
+}
+
+type MsgSetSwapConfig struct {
+	Signer     string                      `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Fee        cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=fee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee"`
+	DailyLimit cosmossdk_io_math.Int       `protobuf:"bytes,3,opt,name=daily_limit,json=dailyLimit,proto3,customtype=cosmossdk.io/math.Int" json:"daily_limit"`
+}
+
+func (m *MsgSetSwapConfig) Reset()         { *m = MsgSetSwapConfig{} }
+func (m *MsgSetSwapConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSwapConfig) ProtoMessage()    {}
+func (*MsgSetSwapConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{98}
+}
+func (m *MsgSetSwapConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSwapConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSwapConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetSwapConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSwapConfig.Merge(m, src)
+}
+func (m *MsgSetSwapConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSwapConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSwapConfig.DiscardUnknown(m)
 }
 
-func (m *MsgAddBlockedChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetSwapConfig proto.InternalMessageInfo
+
+type MsgSetSwapConfigResponse struct {
+}
+
+func (m *MsgSetSwapConfigResponse) Reset()         { *m = MsgSetSwapConfigResponse{} }
+func (m *MsgSetSwapConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSwapConfigResponse) ProtoMessage()    {}
+func (*MsgSetSwapConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{99}
+}
+func (m *MsgSetSwapConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSwapConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSwapConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgSetSwapConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSwapConfigResponse.Merge(m, src)
+}
+func (m *MsgSetSwapConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSwapConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSwapConfigResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveBlockedChannel) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetSwapConfigResponse proto.InternalMessageInfo
+
+type MsgFundSwapFacility struct {
+	Signer string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *MsgFundSwapFacility) Reset()         { *m = MsgFundSwapFacility{} }
+func (m *MsgFundSwapFacility) String() string { return proto.CompactTextString(m) }
+func (*MsgFundSwapFacility) ProtoMessage()    {}
+func (*MsgFundSwapFacility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{100}
+}
+func (m *MsgFundSwapFacility) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundSwapFacility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundSwapFacility.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgFundSwapFacility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundSwapFacility.Merge(m, src)
+}
+func (m *MsgFundSwapFacility) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundSwapFacility) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundSwapFacility.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundSwapFacility proto.InternalMessageInfo
+
+type MsgFundSwapFacilityResponse struct {
+}
+
+func (m *MsgFundSwapFacilityResponse) Reset()         { *m = MsgFundSwapFacilityResponse{} }
+func (m *MsgFundSwapFacilityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundSwapFacilityResponse) ProtoMessage()    {}
+func (*MsgFundSwapFacilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{101}
+}
+func (m *MsgFundSwapFacilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundSwapFacilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundSwapFacilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgFundSwapFacilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundSwapFacilityResponse.Merge(m, src)
+}
+func (m *MsgFundSwapFacilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundSwapFacilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundSwapFacilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundSwapFacilityResponse proto.InternalMessageInfo
+
+type MsgWithdrawSwapFacility struct {
+	Signer string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *MsgWithdrawSwapFacility) Reset()         { *m = MsgWithdrawSwapFacility{} }
+func (m *MsgWithdrawSwapFacility) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSwapFacility) ProtoMessage()    {}
+func (*MsgWithdrawSwapFacility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{102}
+}
+func (m *MsgWithdrawSwapFacility) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSwapFacility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSwapFacility.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawSwapFacility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSwapFacility.Merge(m, src)
+}
+func (m *MsgWithdrawSwapFacility) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSwapFacility) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSwapFacility.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawSwapFacility proto.InternalMessageInfo
+
+type MsgWithdrawSwapFacilityResponse struct {
+}
+
+func (m *MsgWithdrawSwapFacilityResponse) Reset()         { *m = MsgWithdrawSwapFacilityResponse{} }
+func (m *MsgWithdrawSwapFacilityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSwapFacilityResponse) ProtoMessage()    {}
+func (*MsgWithdrawSwapFacilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{103}
+}
+func (m *MsgWithdrawSwapFacilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSwapFacilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSwapFacilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawSwapFacilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSwapFacilityResponse.Merge(m, src)
+}
+func (m *MsgWithdrawSwapFacilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSwapFacilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSwapFacilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawSwapFacilityResponse proto.InternalMessageInfo
+
+type MsgPauseSwaps struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgPauseSwaps) Reset()         { *m = MsgPauseSwaps{} }
+func (m *MsgPauseSwaps) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseSwaps) ProtoMessage()    {}
+func (*MsgPauseSwaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{104}
+}
+func (m *MsgPauseSwaps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseSwaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseSwaps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseSwaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseSwaps.Merge(m, src)
+}
+func (m *MsgPauseSwaps) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseSwaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseSwaps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseSwaps proto.InternalMessageInfo
+
+type MsgPauseSwapsResponse struct {
+}
+
+func (m *MsgPauseSwapsResponse) Reset()         { *m = MsgPauseSwapsResponse{} }
+func (m *MsgPauseSwapsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseSwapsResponse) ProtoMessage()    {}
+func (*MsgPauseSwapsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{105}
+}
+func (m *MsgPauseSwapsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseSwapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseSwapsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseSwapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseSwapsResponse.Merge(m, src)
+}
+func (m *MsgPauseSwapsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseSwapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseSwapsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseSwapsResponse proto.InternalMessageInfo
+
+type MsgUnpauseSwaps struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgUnpauseSwaps) Reset()         { *m = MsgUnpauseSwaps{} }
+func (m *MsgUnpauseSwaps) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseSwaps) ProtoMessage()    {}
+func (*MsgUnpauseSwaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{106}
+}
+func (m *MsgUnpauseSwaps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseSwaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseSwaps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpauseSwaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseSwaps.Merge(m, src)
+}
+func (m *MsgUnpauseSwaps) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseSwaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseSwaps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseSwaps proto.InternalMessageInfo
+
+type MsgUnpauseSwapsResponse struct {
+}
+
+func (m *MsgUnpauseSwapsResponse) Reset()         { *m = MsgUnpauseSwapsResponse{} }
+func (m *MsgUnpauseSwapsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseSwapsResponse) ProtoMessage()    {}
+func (*MsgUnpauseSwapsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{107}
+}
+func (m *MsgUnpauseSwapsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseSwapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseSwapsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpauseSwapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseSwapsResponse.Merge(m, src)
+}
+func (m *MsgUnpauseSwapsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseSwapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseSwapsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseSwapsResponse proto.InternalMessageInfo
+
+type MsgSetLockupPeriod struct {
+	Signer       string        `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	LockupPeriod time.Duration `protobuf:"bytes,2,opt,name=lockup_period,json=lockupPeriod,proto3,stdduration" json:"lockup_period"`
+}
+
+func (m *MsgSetLockupPeriod) Reset()         { *m = MsgSetLockupPeriod{} }
+func (m *MsgSetLockupPeriod) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLockupPeriod) ProtoMessage()    {}
+func (*MsgSetLockupPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{108}
+}
+func (m *MsgSetLockupPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLockupPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLockupPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetLockupPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLockupPeriod.Merge(m, src)
+}
+func (m *MsgSetLockupPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLockupPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLockupPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetLockupPeriod proto.InternalMessageInfo
+
+type MsgSetLockupPeriodResponse struct {
+}
+
+func (m *MsgSetLockupPeriodResponse) Reset()         { *m = MsgSetLockupPeriodResponse{} }
+func (m *MsgSetLockupPeriodResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLockupPeriodResponse) ProtoMessage()    {}
+func (*MsgSetLockupPeriodResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{109}
+}
+func (m *MsgSetLockupPeriodResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLockupPeriodResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLockupPeriodResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetLockupPeriodResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLockupPeriodResponse.Merge(m, src)
+}
+func (m *MsgSetLockupPeriodResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLockupPeriodResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLockupPeriodResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetLockupPeriodResponse proto.InternalMessageInfo
+
+type MsgSetBlocklistBinding struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Bound  bool   `protobuf:"varint,3,opt,name=bound,proto3" json:"bound,omitempty"`
+}
+
+func (m *MsgSetBlocklistBinding) Reset()         { *m = MsgSetBlocklistBinding{} }
+func (m *MsgSetBlocklistBinding) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBlocklistBinding) ProtoMessage()    {}
+func (*MsgSetBlocklistBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{110}
+}
+func (m *MsgSetBlocklistBinding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBlocklistBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBlocklistBinding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBlocklistBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBlocklistBinding.Merge(m, src)
+}
+func (m *MsgSetBlocklistBinding) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBlocklistBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBlocklistBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBlocklistBinding proto.InternalMessageInfo
+
+type MsgSetBlocklistBindingResponse struct {
+}
+
+func (m *MsgSetBlocklistBindingResponse) Reset()         { *m = MsgSetBlocklistBindingResponse{} }
+func (m *MsgSetBlocklistBindingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBlocklistBindingResponse) ProtoMessage()    {}
+func (*MsgSetBlocklistBindingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{111}
+}
+func (m *MsgSetBlocklistBindingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBlocklistBindingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBlocklistBindingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBlocklistBindingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBlocklistBindingResponse.Merge(m, src)
+}
+func (m *MsgSetBlocklistBindingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBlocklistBindingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBlocklistBindingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBlocklistBindingResponse proto.InternalMessageInfo
+
+type MsgSetDenomMetadata struct {
+	Signer   string          `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Metadata types1.Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *MsgSetDenomMetadata) Reset()         { *m = MsgSetDenomMetadata{} }
+func (m *MsgSetDenomMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomMetadata) ProtoMessage()    {}
+func (*MsgSetDenomMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{112}
+}
+func (m *MsgSetDenomMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomMetadata.Merge(m, src)
+}
+func (m *MsgSetDenomMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomMetadata proto.InternalMessageInfo
+
+type MsgSetDenomMetadataResponse struct {
+}
+
+func (m *MsgSetDenomMetadataResponse) Reset()         { *m = MsgSetDenomMetadataResponse{} }
+func (m *MsgSetDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomMetadataResponse) ProtoMessage()    {}
+func (*MsgSetDenomMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{113}
+}
+func (m *MsgSetDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomMetadataResponse.Merge(m, src)
+}
+func (m *MsgSetDenomMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomMetadataResponse proto.InternalMessageInfo
+
+type MsgAddAttestor struct {
+	Signer   string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Attestor string `protobuf:"bytes,2,opt,name=attestor,proto3" json:"attestor,omitempty"`
+}
+
+func (m *MsgAddAttestor) Reset()         { *m = MsgAddAttestor{} }
+func (m *MsgAddAttestor) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAttestor) ProtoMessage()    {}
+func (*MsgAddAttestor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{114}
+}
+func (m *MsgAddAttestor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAttestor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAttestor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAttestor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAttestor.Merge(m, src)
+}
+func (m *MsgAddAttestor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAttestor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAttestor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAttestor proto.InternalMessageInfo
+
+type MsgAddAttestorResponse struct {
+}
+
+func (m *MsgAddAttestorResponse) Reset()         { *m = MsgAddAttestorResponse{} }
+func (m *MsgAddAttestorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAttestorResponse) ProtoMessage()    {}
+func (*MsgAddAttestorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{115}
+}
+func (m *MsgAddAttestorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAttestorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAttestorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAttestorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAttestorResponse.Merge(m, src)
+}
+func (m *MsgAddAttestorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAttestorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAttestorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAttestorResponse proto.InternalMessageInfo
+
+type MsgRemoveAttestor struct {
+	Signer   string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Attestor string `protobuf:"bytes,2,opt,name=attestor,proto3" json:"attestor,omitempty"`
+}
+
+func (m *MsgRemoveAttestor) Reset()         { *m = MsgRemoveAttestor{} }
+func (m *MsgRemoveAttestor) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAttestor) ProtoMessage()    {}
+func (*MsgRemoveAttestor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{116}
+}
+func (m *MsgRemoveAttestor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAttestor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAttestor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAttestor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAttestor.Merge(m, src)
+}
+func (m *MsgRemoveAttestor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAttestor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAttestor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAttestor proto.InternalMessageInfo
+
+type MsgRemoveAttestorResponse struct {
+}
+
+func (m *MsgRemoveAttestorResponse) Reset()         { *m = MsgRemoveAttestorResponse{} }
+func (m *MsgRemoveAttestorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAttestorResponse) ProtoMessage()    {}
+func (*MsgRemoveAttestorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{117}
+}
+func (m *MsgRemoveAttestorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAttestorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAttestorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAttestorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAttestorResponse.Merge(m, src)
+}
+func (m *MsgRemoveAttestorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAttestorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAttestorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAttestorResponse proto.InternalMessageInfo
+
+type MsgPostAttestation struct {
+	Signer     string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Amount     cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	AsOf       time.Time             `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3,stdtime" json:"as_of"`
+	ReportHash string                `protobuf:"bytes,4,opt,name=report_hash,json=reportHash,proto3" json:"report_hash,omitempty"`
+}
+
+func (m *MsgPostAttestation) Reset()         { *m = MsgPostAttestation{} }
+func (m *MsgPostAttestation) String() string { return proto.CompactTextString(m) }
+func (*MsgPostAttestation) ProtoMessage()    {}
+func (*MsgPostAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{118}
+}
+func (m *MsgPostAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPostAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPostAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPostAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPostAttestation.Merge(m, src)
+}
+func (m *MsgPostAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPostAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPostAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPostAttestation proto.InternalMessageInfo
+
+type MsgPostAttestationResponse struct {
+}
+
+func (m *MsgPostAttestationResponse) Reset()         { *m = MsgPostAttestationResponse{} }
+func (m *MsgPostAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPostAttestationResponse) ProtoMessage()    {}
+func (*MsgPostAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{119}
+}
+func (m *MsgPostAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPostAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPostAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPostAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPostAttestationResponse.Merge(m, src)
+}
+func (m *MsgPostAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPostAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPostAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPostAttestationResponse proto.InternalMessageInfo
+
+type MsgSetReserveConfig struct {
+	Signer          string                      `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	CollateralRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=collateral_ratio,json=collateralRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"collateral_ratio"`
+	MaxStaleness    time.Duration               `protobuf:"bytes,3,opt,name=max_staleness,json=maxStaleness,proto3,stdduration" json:"max_staleness"`
+}
+
+func (m *MsgSetReserveConfig) Reset()         { *m = MsgSetReserveConfig{} }
+func (m *MsgSetReserveConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReserveConfig) ProtoMessage()    {}
+func (*MsgSetReserveConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{120}
+}
+func (m *MsgSetReserveConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReserveConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReserveConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetReserveConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReserveConfig.Merge(m, src)
+}
+func (m *MsgSetReserveConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReserveConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReserveConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetReserveConfig proto.InternalMessageInfo
+
+type MsgSetReserveConfigResponse struct {
+}
+
+func (m *MsgSetReserveConfigResponse) Reset()         { *m = MsgSetReserveConfigResponse{} }
+func (m *MsgSetReserveConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReserveConfigResponse) ProtoMessage()    {}
+func (*MsgSetReserveConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{121}
+}
+func (m *MsgSetReserveConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReserveConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReserveConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetReserveConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReserveConfigResponse.Merge(m, src)
+}
+func (m *MsgSetReserveConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReserveConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReserveConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetReserveConfigResponse proto.InternalMessageInfo
+
+type MsgAddRecoverer struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Recoverer string `protobuf:"bytes,2,opt,name=recoverer,proto3" json:"recoverer,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgAddRecoverer) Reset()         { *m = MsgAddRecoverer{} }
+func (m *MsgAddRecoverer) String() string { return proto.CompactTextString(m) }
+func (*MsgAddRecoverer) ProtoMessage()    {}
+func (*MsgAddRecoverer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{122}
+}
+func (m *MsgAddRecoverer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddRecoverer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddRecoverer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddRecoverer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddRecoverer.Merge(m, src)
+}
+func (m *MsgAddRecoverer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddRecoverer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddRecoverer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddRecoverer proto.InternalMessageInfo
+
+type MsgAddRecovererResponse struct {
+}
+
+func (m *MsgAddRecovererResponse) Reset()         { *m = MsgAddRecovererResponse{} }
+func (m *MsgAddRecovererResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddRecovererResponse) ProtoMessage()    {}
+func (*MsgAddRecovererResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{123}
+}
+func (m *MsgAddRecovererResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddRecovererResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddRecovererResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddRecovererResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddRecovererResponse.Merge(m, src)
+}
+func (m *MsgAddRecovererResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddRecovererResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddRecovererResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddRecovererResponse proto.InternalMessageInfo
+
+type MsgRemoveRecoverer struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Recoverer string `protobuf:"bytes,2,opt,name=recoverer,proto3" json:"recoverer,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRemoveRecoverer) Reset()         { *m = MsgRemoveRecoverer{} }
+func (m *MsgRemoveRecoverer) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRecoverer) ProtoMessage()    {}
+func (*MsgRemoveRecoverer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{124}
+}
+func (m *MsgRemoveRecoverer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRecoverer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRecoverer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRecoverer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRecoverer.Merge(m, src)
+}
+func (m *MsgRemoveRecoverer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRecoverer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRecoverer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRecoverer proto.InternalMessageInfo
+
+type MsgRemoveRecovererResponse struct {
+}
+
+func (m *MsgRemoveRecovererResponse) Reset()         { *m = MsgRemoveRecovererResponse{} }
+func (m *MsgRemoveRecovererResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRecovererResponse) ProtoMessage()    {}
+func (*MsgRemoveRecovererResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{125}
+}
+func (m *MsgRemoveRecovererResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRecovererResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRecovererResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRecovererResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRecovererResponse.Merge(m, src)
+}
+func (m *MsgRemoveRecovererResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRecovererResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRecovererResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRecovererResponse proto.InternalMessageInfo
+
+type MsgForceTransfer struct {
+	Signer        string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Denom         string                `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	From          string                `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Amount        cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	CaseReference string                `protobuf:"bytes,6,opt,name=case_reference,json=caseReference,proto3" json:"case_reference,omitempty"`
+}
+
+func (m *MsgForceTransfer) Reset()         { *m = MsgForceTransfer{} }
+func (m *MsgForceTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgForceTransfer) ProtoMessage()    {}
+func (*MsgForceTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{126}
+}
+func (m *MsgForceTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceTransfer.Merge(m, src)
+}
+func (m *MsgForceTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceTransfer proto.InternalMessageInfo
+
+type MsgForceTransferResponse struct {
+}
+
+func (m *MsgForceTransferResponse) Reset()         { *m = MsgForceTransferResponse{} }
+func (m *MsgForceTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForceTransferResponse) ProtoMessage()    {}
+func (*MsgForceTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e81433c42c397f, []int{127}
+}
+func (m *MsgForceTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceTransferResponse.Merge(m, src)
+}
+func (m *MsgForceTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceTransferResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgBurn)(nil), "aura.v1.MsgBurn")
+	proto.RegisterType((*MsgBurnResponse)(nil), "aura.v1.MsgBurnResponse")
+	proto.RegisterType((*MsgMint)(nil), "aura.v1.MsgMint")
+	proto.RegisterType((*MsgMintResponse)(nil), "aura.v1.MsgMintResponse")
+	proto.RegisterType((*MsgPause)(nil), "aura.v1.MsgPause")
+	proto.RegisterType((*MsgPauseResponse)(nil), "aura.v1.MsgPauseResponse")
+	proto.RegisterType((*MsgUnpause)(nil), "aura.v1.MsgUnpause")
+	proto.RegisterType((*MsgUnpauseResponse)(nil), "aura.v1.MsgUnpauseResponse")
+	proto.RegisterType((*MsgSchedulePause)(nil), "aura.v1.MsgSchedulePause")
+	proto.RegisterType((*MsgSchedulePauseResponse)(nil), "aura.v1.MsgSchedulePauseResponse")
+	proto.RegisterType((*MsgTransferOwnership)(nil), "aura.v1.MsgTransferOwnership")
+	proto.RegisterType((*MsgTransferOwnershipResponse)(nil), "aura.v1.MsgTransferOwnershipResponse")
+	proto.RegisterType((*MsgAcceptOwnership)(nil), "aura.v1.MsgAcceptOwnership")
+	proto.RegisterType((*MsgAcceptOwnershipResponse)(nil), "aura.v1.MsgAcceptOwnershipResponse")
+	proto.RegisterType((*MsgForceOwnership)(nil), "aura.v1.MsgForceOwnership")
+	proto.RegisterType((*MsgForceOwnershipResponse)(nil), "aura.v1.MsgForceOwnershipResponse")
+	proto.RegisterType((*MsgAddBurner)(nil), "aura.v1.MsgAddBurner")
+	proto.RegisterType((*MsgAddBurnerResponse)(nil), "aura.v1.MsgAddBurnerResponse")
+	proto.RegisterType((*MsgRemoveBurner)(nil), "aura.v1.MsgRemoveBurner")
+	proto.RegisterType((*MsgRemoveBurnerResponse)(nil), "aura.v1.MsgRemoveBurnerResponse")
+	proto.RegisterType((*MsgSetBurnerAllowance)(nil), "aura.v1.MsgSetBurnerAllowance")
+	proto.RegisterType((*MsgSetBurnerAllowanceResponse)(nil), "aura.v1.MsgSetBurnerAllowanceResponse")
+	proto.RegisterType((*MsgIncreaseBurnerAllowance)(nil), "aura.v1.MsgIncreaseBurnerAllowance")
+	proto.RegisterType((*MsgIncreaseBurnerAllowanceResponse)(nil), "aura.v1.MsgIncreaseBurnerAllowanceResponse")
+	proto.RegisterType((*MsgDecreaseBurnerAllowance)(nil), "aura.v1.MsgDecreaseBurnerAllowance")
+	proto.RegisterType((*MsgDecreaseBurnerAllowanceResponse)(nil), "aura.v1.MsgDecreaseBurnerAllowanceResponse")
+	proto.RegisterType((*MsgAddBurnerSource)(nil), "aura.v1.MsgAddBurnerSource")
+	proto.RegisterType((*MsgAddBurnerSourceResponse)(nil), "aura.v1.MsgAddBurnerSourceResponse")
+	proto.RegisterType((*MsgRemoveBurnerSource)(nil), "aura.v1.MsgRemoveBurnerSource")
+	proto.RegisterType((*MsgRemoveBurnerSourceResponse)(nil), "aura.v1.MsgRemoveBurnerSourceResponse")
+	proto.RegisterType((*MsgAddMinter)(nil), "aura.v1.MsgAddMinter")
+	proto.RegisterType((*MsgAddMinterResponse)(nil), "aura.v1.MsgAddMinterResponse")
+	proto.RegisterType((*MsgRemoveMinter)(nil), "aura.v1.MsgRemoveMinter")
+	proto.RegisterType((*MsgRemoveMinterResponse)(nil), "aura.v1.MsgRemoveMinterResponse")
+	proto.RegisterType((*MsgSetMinterAllowance)(nil), "aura.v1.MsgSetMinterAllowance")
+	proto.RegisterType((*MsgSetMinterAllowanceResponse)(nil), "aura.v1.MsgSetMinterAllowanceResponse")
+	proto.RegisterType((*MsgIncreaseMinterAllowance)(nil), "aura.v1.MsgIncreaseMinterAllowance")
+	proto.RegisterType((*MsgIncreaseMinterAllowanceResponse)(nil), "aura.v1.MsgIncreaseMinterAllowanceResponse")
+	proto.RegisterType((*MsgDecreaseMinterAllowance)(nil), "aura.v1.MsgDecreaseMinterAllowance")
+	proto.RegisterType((*MsgDecreaseMinterAllowanceResponse)(nil), "aura.v1.MsgDecreaseMinterAllowanceResponse")
+	proto.RegisterType((*MsgAddMinterRecipient)(nil), "aura.v1.MsgAddMinterRecipient")
+	proto.RegisterType((*MsgAddMinterRecipientResponse)(nil), "aura.v1.MsgAddMinterRecipientResponse")
+	proto.RegisterType((*MsgRemoveMinterRecipient)(nil), "aura.v1.MsgRemoveMinterRecipient")
+	proto.RegisterType((*MsgRemoveMinterRecipientResponse)(nil), "aura.v1.MsgRemoveMinterRecipientResponse")
+	proto.RegisterType((*MsgAddPauser)(nil), "aura.v1.MsgAddPauser")
+	proto.RegisterType((*MsgAddPauserResponse)(nil), "aura.v1.MsgAddPauserResponse")
+	proto.RegisterType((*MsgRemovePauser)(nil), "aura.v1.MsgRemovePauser")
+	proto.RegisterType((*MsgRemovePauserResponse)(nil), "aura.v1.MsgRemovePauserResponse")
+	proto.RegisterType((*MsgAddBlockedChannel)(nil), "aura.v1.MsgAddBlockedChannel")
+	proto.RegisterType((*MsgAddBlockedChannelResponse)(nil), "aura.v1.MsgAddBlockedChannelResponse")
+	proto.RegisterType((*MsgRemoveBlockedChannel)(nil), "aura.v1.MsgRemoveBlockedChannel")
+	proto.RegisterType((*MsgRemoveBlockedChannelResponse)(nil), "aura.v1.MsgRemoveBlockedChannelResponse")
+	proto.RegisterType((*MsgAddPauseExemption)(nil), "aura.v1.MsgAddPauseExemption")
+	proto.RegisterType((*MsgAddPauseExemptionResponse)(nil), "aura.v1.MsgAddPauseExemptionResponse")
+	proto.RegisterType((*MsgRemovePauseExemption)(nil), "aura.v1.MsgRemovePauseExemption")
+	proto.RegisterType((*MsgRemovePauseExemptionResponse)(nil), "aura.v1.MsgRemovePauseExemptionResponse")
+	proto.RegisterType((*MsgSetChannelMode)(nil), "aura.v1.MsgSetChannelMode")
+	proto.RegisterType((*MsgSetChannelModeResponse)(nil), "aura.v1.MsgSetChannelModeResponse")
+	proto.RegisterType((*MsgAddAllowedChannel)(nil), "aura.v1.MsgAddAllowedChannel")
+	proto.RegisterType((*MsgAddAllowedChannelResponse)(nil), "aura.v1.MsgAddAllowedChannelResponse")
+	proto.RegisterType((*MsgRemoveAllowedChannel)(nil), "aura.v1.MsgRemoveAllowedChannel")
+	proto.RegisterType((*MsgRemoveAllowedChannelResponse)(nil), "aura.v1.MsgRemoveAllowedChannelResponse")
+	proto.RegisterType((*MsgSetRateLimit)(nil), "aura.v1.MsgSetRateLimit")
+	proto.RegisterType((*MsgSetRateLimitResponse)(nil), "aura.v1.MsgSetRateLimitResponse")
+	proto.RegisterType((*MsgRemoveRateLimit)(nil), "aura.v1.MsgRemoveRateLimit")
+	proto.RegisterType((*MsgRemoveRateLimitResponse)(nil), "aura.v1.MsgRemoveRateLimitResponse")
+	proto.RegisterType((*MsgAddPriceSetter)(nil), "aura.v1.MsgAddPriceSetter")
+	proto.RegisterType((*MsgAddPriceSetterResponse)(nil), "aura.v1.MsgAddPriceSetterResponse")
+	proto.RegisterType((*MsgRemovePriceSetter)(nil), "aura.v1.MsgRemovePriceSetter")
+	proto.RegisterType((*MsgRemovePriceSetterResponse)(nil), "aura.v1.MsgRemovePriceSetterResponse")
+	proto.RegisterType((*MsgSetPriceRange)(nil), "aura.v1.MsgSetPriceRange")
+	proto.RegisterType((*MsgSetPriceRangeResponse)(nil), "aura.v1.MsgSetPriceRangeResponse")
+	proto.RegisterType((*MsgSetMaxPriceDeviation)(nil), "aura.v1.MsgSetMaxPriceDeviation")
+	proto.RegisterType((*MsgSetMaxPriceDeviationResponse)(nil), "aura.v1.MsgSetMaxPriceDeviationResponse")
+	proto.RegisterType((*MsgWrap)(nil), "aura.v1.MsgWrap")
+	proto.RegisterType((*MsgWrapResponse)(nil), "aura.v1.MsgWrapResponse")
+	proto.RegisterType((*MsgUnwrap)(nil), "aura.v1.MsgUnwrap")
+	proto.RegisterType((*MsgUnwrapResponse)(nil), "aura.v1.MsgUnwrapResponse")
+	proto.RegisterType((*MsgTransferRebasing)(nil), "aura.v1.MsgTransferRebasing")
+	proto.RegisterType((*MsgTransferRebasingResponse)(nil), "aura.v1.MsgTransferRebasingResponse")
+	proto.RegisterType((*MsgSetStablecoin)(nil), "aura.v1.MsgSetStablecoin")
+	proto.RegisterType((*MsgSetStablecoinResponse)(nil), "aura.v1.MsgSetStablecoinResponse")
+	proto.RegisterType((*MsgRequestSubscription)(nil), "aura.v1.MsgRequestSubscription")
+	proto.RegisterType((*MsgRequestSubscriptionResponse)(nil), "aura.v1.MsgRequestSubscriptionResponse")
+	proto.RegisterType((*MsgFulfillSubscription)(nil), "aura.v1.MsgFulfillSubscription")
+	proto.RegisterType((*MsgFulfillSubscriptionResponse)(nil), "aura.v1.MsgFulfillSubscriptionResponse")
+	proto.RegisterType((*MsgRejectSubscription)(nil), "aura.v1.MsgRejectSubscription")
+	proto.RegisterType((*MsgRejectSubscriptionResponse)(nil), "aura.v1.MsgRejectSubscriptionResponse")
+	proto.RegisterType((*MsgRequestRedemption)(nil), "aura.v1.MsgRequestRedemption")
+	proto.RegisterType((*MsgRequestRedemptionResponse)(nil), "aura.v1.MsgRequestRedemptionResponse")
+	proto.RegisterType((*MsgCancelRedemption)(nil), "aura.v1.MsgCancelRedemption")
+	proto.RegisterType((*MsgCancelRedemptionResponse)(nil), "aura.v1.MsgCancelRedemptionResponse")
+	proto.RegisterType((*MsgSettleRedemption)(nil), "aura.v1.MsgSettleRedemption")
+	proto.RegisterType((*MsgSettleRedemptionResponse)(nil), "aura.v1.MsgSettleRedemptionResponse")
+	proto.RegisterType((*MsgRejectRedemption)(nil), "aura.v1.MsgRejectRedemption")
+	proto.RegisterType((*MsgRejectRedemptionResponse)(nil), "aura.v1.MsgRejectRedemptionResponse")
+	proto.RegisterType((*MsgSwap)(nil), "aura.v1.MsgSwap")
+	proto.RegisterType((*MsgSwapResponse)(nil), "aura.v1.MsgSwapResponse")
+	proto.RegisterType((*MsgSetSwapConfig)(nil), "aura.v1.MsgSetSwapConfig")
+	proto.RegisterType((*MsgSetSwapConfigResponse)(nil), "aura.v1.MsgSetSwapConfigResponse")
+	proto.RegisterType((*MsgFundSwapFacility)(nil), "aura.v1.MsgFundSwapFacility")
+	proto.RegisterType((*MsgFundSwapFacilityResponse)(nil), "aura.v1.MsgFundSwapFacilityResponse")
+	proto.RegisterType((*MsgWithdrawSwapFacility)(nil), "aura.v1.MsgWithdrawSwapFacility")
+	proto.RegisterType((*MsgWithdrawSwapFacilityResponse)(nil), "aura.v1.MsgWithdrawSwapFacilityResponse")
+	proto.RegisterType((*MsgPauseSwaps)(nil), "aura.v1.MsgPauseSwaps")
+	proto.RegisterType((*MsgPauseSwapsResponse)(nil), "aura.v1.MsgPauseSwapsResponse")
+	proto.RegisterType((*MsgUnpauseSwaps)(nil), "aura.v1.MsgUnpauseSwaps")
+	proto.RegisterType((*MsgUnpauseSwapsResponse)(nil), "aura.v1.MsgUnpauseSwapsResponse")
+	proto.RegisterType((*MsgSetLockupPeriod)(nil), "aura.v1.MsgSetLockupPeriod")
+	proto.RegisterType((*MsgSetLockupPeriodResponse)(nil), "aura.v1.MsgSetLockupPeriodResponse")
+	proto.RegisterType((*MsgSetBlocklistBinding)(nil), "aura.v1.MsgSetBlocklistBinding")
+	proto.RegisterType((*MsgSetBlocklistBindingResponse)(nil), "aura.v1.MsgSetBlocklistBindingResponse")
+	proto.RegisterType((*MsgSetDenomMetadata)(nil), "aura.v1.MsgSetDenomMetadata")
+	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "aura.v1.MsgSetDenomMetadataResponse")
+	proto.RegisterType((*MsgAddAttestor)(nil), "aura.v1.MsgAddAttestor")
+	proto.RegisterType((*MsgAddAttestorResponse)(nil), "aura.v1.MsgAddAttestorResponse")
+	proto.RegisterType((*MsgRemoveAttestor)(nil), "aura.v1.MsgRemoveAttestor")
+	proto.RegisterType((*MsgRemoveAttestorResponse)(nil), "aura.v1.MsgRemoveAttestorResponse")
+	proto.RegisterType((*MsgPostAttestation)(nil), "aura.v1.MsgPostAttestation")
+	proto.RegisterType((*MsgPostAttestationResponse)(nil), "aura.v1.MsgPostAttestationResponse")
+	proto.RegisterType((*MsgSetReserveConfig)(nil), "aura.v1.MsgSetReserveConfig")
+	proto.RegisterType((*MsgSetReserveConfigResponse)(nil), "aura.v1.MsgSetReserveConfigResponse")
+	proto.RegisterType((*MsgAddRecoverer)(nil), "aura.v1.MsgAddRecoverer")
+	proto.RegisterType((*MsgAddRecovererResponse)(nil), "aura.v1.MsgAddRecovererResponse")
+	proto.RegisterType((*MsgRemoveRecoverer)(nil), "aura.v1.MsgRemoveRecoverer")
+	proto.RegisterType((*MsgRemoveRecovererResponse)(nil), "aura.v1.MsgRemoveRecovererResponse")
+	proto.RegisterType((*MsgForceTransfer)(nil), "aura.v1.MsgForceTransfer")
+	proto.RegisterType((*MsgForceTransferResponse)(nil), "aura.v1.MsgForceTransferResponse")
+}
+
+func init() { proto.RegisterFile("aura/v1/tx.proto", fileDescriptor_72e81433c42c397f) }
+
+var fileDescriptor_72e81433c42c397f = []byte{
+	// 3836 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1c, 0x5b, 0x6c, 0x1d, 0x47,
+	0x35, 0xf7, 0xfa, 0x11, 0xdf, 0xb1, 0xe3, 0xc4, 0x1b, 0x27, 0xb1, 0xd7, 0xf1, 0x23, 0x9b, 0x3e,
+	0xd2, 0xa4, 0xb9, 0x4e, 0x4c, 0x5b, 0xa8, 0x5b, 0xa9, 0x8a, 0xe3, 0x86, 0x16, 0xc5, 0x24, 0x5c,
+	0xb7, 0xa9, 0x40, 0x45, 0x57, 0xeb, 0xdd, 0xf1, 0xf5, 0x36, 0xf7, 0xee, 0x5e, 0x76, 0xf7, 0xfa,
+	0xf1, 0x57, 0xf8, 0x40, 0xa8, 0x52, 0x51, 0xc5, 0x07, 0xaa, 0x10, 0x1f, 0x15, 0x08, 0xa9, 0x1f,
+	0x08, 0xf2, 0x51, 0xe0, 0x07, 0x21, 0x84, 0x04, 0xea, 0x1f, 0x55, 0xbf, 0xaa, 0x7e, 0x14, 0x68,
+	0x25, 0xd2, 0x0f, 0xc4, 0x0f, 0x20, 0xf1, 0x10, 0x12, 0x9a, 0xc7, 0xce, 0xce, 0xcc, 0xce, 0xdc,
+	0xb5, 0xd7, 0x4e, 0xdc, 0x07, 0x3f, 0x91, 0xef, 0x39, 0xb3, 0x67, 0xce, 0x6b, 0xce, 0x9c, 0x39,
+	0x73, 0x26, 0xe0, 0x88, 0xdd, 0x09, 0xed, 0xd9, 0xf5, 0x8b, 0xb3, 0xf1, 0x66, 0xb5, 0x1d, 0x06,
+	0x71, 0x60, 0x1c, 0x44, 0x90, 0xea, 0xfa, 0x45, 0x73, 0xc4, 0x6e, 0x79, 0x7e, 0x30, 0x8b, 0xff,
+	0x25, 0x38, 0xf3, 0x58, 0x32, 0xba, 0x01, 0x7d, 0x18, 0x79, 0x11, 0x05, 0x4f, 0x39, 0x41, 0xd4,
+	0x0a, 0xa2, 0xd9, 0x15, 0xdb, 0xbf, 0x39, 0xbb, 0x7e, 0x71, 0x05, 0xc6, 0xf6, 0x45, 0xfc, 0x23,
+	0x83, 0x8f, 0x20, 0xc3, 0x3b, 0x81, 0xe7, 0x53, 0xfc, 0x09, 0x8a, 0x6f, 0x45, 0x0d, 0x44, 0xbc,
+	0x15, 0x35, 0x28, 0x62, 0x9c, 0x20, 0xea, 0xf8, 0xd7, 0x2c, 0xf9, 0x41, 0x51, 0xa3, 0x8d, 0xa0,
+	0x11, 0x10, 0x38, 0xfa, 0x2b, 0x99, 0xa9, 0x11, 0x04, 0x8d, 0x26, 0x9c, 0xc5, 0xbf, 0x56, 0x3a,
+	0xab, 0xb3, 0x6e, 0x27, 0xb4, 0x63, 0x2f, 0x48, 0x66, 0x9a, 0x96, 0xf1, 0xb1, 0xd7, 0x82, 0x51,
+	0x6c, 0xb7, 0xda, 0x64, 0x80, 0xf5, 0x97, 0x12, 0x38, 0xb8, 0x14, 0x35, 0x16, 0x3a, 0xa1, 0x6f,
+	0x5c, 0x00, 0xfd, 0x91, 0xd7, 0xf0, 0x61, 0x38, 0x56, 0x9a, 0x29, 0x9d, 0xa9, 0x2c, 0x8c, 0xbd,
+	0xfd, 0xc6, 0xf9, 0x51, 0xca, 0xc4, 0x25, 0xd7, 0x0d, 0x61, 0x14, 0x2d, 0xc7, 0xa1, 0xe7, 0x37,
+	0x6a, 0x74, 0x9c, 0xf1, 0x20, 0xe8, 0x5d, 0x0d, 0x83, 0xd6, 0x58, 0x39, 0x67, 0x3c, 0x1e, 0x65,
+	0x3c, 0x05, 0xfa, 0xed, 0x56, 0xd0, 0xf1, 0xe3, 0xb1, 0x1e, 0x3c, 0xfe, 0xc2, 0x9b, 0xef, 0x4d,
+	0x1f, 0x78, 0xf7, 0xbd, 0xe9, 0x63, 0xe4, 0x9b, 0xc8, 0xbd, 0x59, 0xf5, 0x82, 0xd9, 0x96, 0x1d,
+	0xaf, 0x55, 0x9f, 0xf6, 0xe3, 0xb7, 0xdf, 0x38, 0x0f, 0x28, 0xb1, 0xa7, 0xfd, 0xf8, 0xf5, 0xdb,
+	0xb7, 0xce, 0x96, 0x6a, 0xf4, 0x7b, 0x63, 0x14, 0xf4, 0xb9, 0xd0, 0x0f, 0x5a, 0x63, 0xbd, 0x88,
+	0x50, 0x8d, 0xfc, 0x98, 0x3f, 0xf5, 0xad, 0xd7, 0xa6, 0x0f, 0x7c, 0xf8, 0xda, 0xf4, 0x81, 0x6f,
+	0xdc, 0xbe, 0x75, 0x96, 0xb2, 0xf8, 0xd2, 0xed, 0x5b, 0x67, 0x2b, 0xd8, 0x8a, 0x48, 0x44, 0x6b,
+	0x04, 0x1c, 0xa6, 0xd2, 0xd6, 0x60, 0xd4, 0x0e, 0xfc, 0x08, 0x5a, 0x1f, 0x12, 0x0d, 0x2c, 0x79,
+	0x7e, 0x5c, 0x40, 0x03, 0x67, 0x40, 0x39, 0x0e, 0x72, 0xe5, 0x2f, 0xc7, 0xc1, 0x3e, 0x4b, 0x8f,
+	0xc4, 0xa3, 0xd2, 0xa3, 0x3f, 0x99, 0xf4, 0x5f, 0x2f, 0x83, 0x81, 0xa5, 0xa8, 0x71, 0xdd, 0xee,
+	0x44, 0xb0, 0x80, 0xf8, 0x8c, 0x95, 0x32, 0xc7, 0x8a, 0xf1, 0x00, 0xe8, 0x8b, 0x9c, 0xa0, 0x0d,
+	0xb1, 0xa4, 0xc3, 0x73, 0x47, 0xab, 0x74, 0x89, 0x55, 0xf1, 0x34, 0xcb, 0x08, 0x55, 0x23, 0x23,
+	0x8c, 0x49, 0x00, 0xa0, 0xef, 0xd6, 0xd7, 0xa0, 0xd7, 0x58, 0x8b, 0xb1, 0x40, 0x3d, 0xb5, 0x0a,
+	0xf4, 0xdd, 0xa7, 0x30, 0xc0, 0x78, 0x02, 0x0c, 0x20, 0x34, 0xf2, 0xda, 0xb1, 0xbe, 0x99, 0xd2,
+	0x99, 0xc1, 0x39, 0xb3, 0x4a, 0x5c, 0xba, 0x9a, 0xb8, 0x74, 0xf5, 0x99, 0xc4, 0xa5, 0x17, 0x06,
+	0x90, 0x4a, 0x5f, 0xf9, 0xc3, 0x74, 0xa9, 0x76, 0x10, 0xfa, 0x2e, 0x82, 0xcf, 0x5b, 0x1a, 0xad,
+	0x00, 0xac, 0x15, 0xcc, 0x8f, 0x65, 0x80, 0x23, 0x89, 0x0a, 0x98, 0x5e, 0x7e, 0x54, 0x02, 0x60,
+	0x29, 0x6a, 0x3c, 0xeb, 0xb7, 0xf7, 0x49, 0x33, 0xf3, 0xf7, 0x68, 0x38, 0x1f, 0xc2, 0x9c, 0x53,
+	0xc6, 0xac, 0x51, 0x60, 0xa4, 0x6c, 0x32, 0xee, 0xff, 0x55, 0xc6, 0x22, 0x2d, 0x3b, 0x6b, 0xd0,
+	0xed, 0x34, 0xe1, 0xbe, 0x59, 0xf7, 0x14, 0x18, 0x8a, 0x62, 0x3b, 0x8c, 0x45, 0xfb, 0x0e, 0x62,
+	0x18, 0xb5, 0xf0, 0x65, 0x00, 0xc8, 0x90, 0x1d, 0xdb, 0xb8, 0x82, 0xbf, 0x43, 0x18, 0xc9, 0x8b,
+	0xfa, 0xbb, 0x79, 0xd1, 0xc1, 0x22, 0x5e, 0x74, 0x56, 0x63, 0x0b, 0x03, 0xdb, 0x42, 0x50, 0xb3,
+	0x65, 0x82, 0x31, 0x59, 0xf5, 0xcc, 0x2e, 0xbf, 0x2d, 0x81, 0xd1, 0xa5, 0xa8, 0xf1, 0x4c, 0x68,
+	0xfb, 0xd1, 0x2a, 0x0c, 0xaf, 0x6d, 0xf8, 0x30, 0x8c, 0xd6, 0xbc, 0x76, 0x01, 0xdb, 0x3c, 0x0c,
+	0x2a, 0x3e, 0xdc, 0xa8, 0x07, 0x88, 0x44, 0x6e, 0xfc, 0x19, 0xf0, 0xe1, 0x06, 0x9e, 0x2c, 0x35,
+	0x69, 0x0f, 0x1f, 0x3b, 0xaa, 0x1a, 0xf9, 0x8e, 0x63, 0xf9, 0x32, 0xec, 0x5a, 0x53, 0xe0, 0xa4,
+	0x4a, 0x0c, 0x26, 0xe7, 0x4b, 0x25, 0xec, 0x96, 0x97, 0x1c, 0x07, 0xb6, 0xe3, 0xdd, 0x48, 0xa9,
+	0xf4, 0xc0, 0xf9, 0x07, 0x35, 0xec, 0x8e, 0x62, 0x76, 0xa5, 0x59, 0xad, 0x93, 0xc0, 0xcc, 0xf2,
+	0xc2, 0x58, 0xfd, 0x4f, 0x09, 0x8c, 0x2c, 0x45, 0x8d, 0x2b, 0x41, 0xe8, 0xc0, 0x3d, 0xe7, 0xd4,
+	0xa8, 0x82, 0x3e, 0x62, 0xa1, 0x9e, 0x1c, 0x32, 0x64, 0x98, 0x71, 0x09, 0x1c, 0x5e, 0x69, 0x06,
+	0xce, 0xcd, 0xa6, 0x17, 0xc5, 0xd4, 0xb6, 0xbd, 0x39, 0x5f, 0x0e, 0xb3, 0x0f, 0x30, 0xfb, 0xf3,
+	0xe7, 0x34, 0xca, 0x39, 0x8a, 0x95, 0x23, 0xca, 0x69, 0x4d, 0x80, 0xf1, 0x8c, 0xf0, 0x4c, 0x35,
+	0xbf, 0x2a, 0x83, 0x21, 0xa4, 0x39, 0xd7, 0x45, 0x1b, 0x26, 0x0c, 0x0b, 0x68, 0xe5, 0x02, 0xe8,
+	0x5f, 0xc1, 0xdf, 0xe6, 0xba, 0x28, 0x1d, 0x67, 0x7c, 0x11, 0x54, 0xec, 0x66, 0x33, 0xd8, 0xb0,
+	0x7d, 0x07, 0x16, 0xde, 0x29, 0x53, 0x12, 0xea, 0xcd, 0xd2, 0x78, 0x1c, 0xf4, 0xc3, 0xcd, 0xb6,
+	0x17, 0x6e, 0xed, 0x28, 0xe2, 0xd0, 0x6f, 0xe6, 0xef, 0xd3, 0xa8, 0x78, 0x98, 0xf8, 0x5f, 0xa2,
+	0x2f, 0xeb, 0x38, 0x5e, 0xed, 0xec, 0x37, 0x53, 0xec, 0xcf, 0x4b, 0x78, 0x23, 0xae, 0xc1, 0x56,
+	0xb0, 0x0e, 0xef, 0xa2, 0x6e, 0xd5, 0x8b, 0xff, 0x01, 0x8d, 0x34, 0x23, 0x58, 0x1a, 0x9e, 0x49,
+	0x6b, 0x1c, 0x9c, 0x90, 0xf8, 0x66, 0x32, 0xfd, 0xad, 0x0c, 0x8e, 0xa1, 0xb8, 0x07, 0x63, 0x82,
+	0xb8, 0xc4, 0x2c, 0xf0, 0x7f, 0xaf, 0xc1, 0xdf, 0xa0, 0xcd, 0xd0, 0x69, 0x42, 0x3b, 0xac, 0x53,
+	0x1a, 0x68, 0x9b, 0x1a, 0xa8, 0x0d, 0x62, 0xd8, 0x93, 0xc4, 0xb1, 0x66, 0x35, 0xa6, 0x38, 0x41,
+	0xf6, 0x99, 0x8c, 0x6e, 0xad, 0x69, 0x30, 0xa9, 0x54, 0x3a, 0x33, 0xcb, 0x77, 0xcb, 0x38, 0xfa,
+	0x3d, 0xed, 0x3b, 0x21, 0xb4, 0x23, 0xb8, 0x1f, 0xb6, 0xb9, 0xd3, 0x89, 0xef, 0x43, 0x1a, 0xa5,
+	0x9d, 0xc4, 0x4a, 0xd3, 0x48, 0x6e, 0xdd, 0x03, 0x2c, 0xbd, 0x5e, 0x64, 0xf5, 0x2d, 0xc2, 0x4f,
+	0xab, 0xfa, 0x34, 0x92, 0x53, 0xf5, 0x69, 0xb0, 0x4c, 0x7d, 0x7f, 0xa5, 0x79, 0x40, 0x12, 0x01,
+	0x97, 0x83, 0x4e, 0x78, 0x97, 0xd4, 0x86, 0xe6, 0xc0, 0xb3, 0xe5, 0x6e, 0xbd, 0x74, 0x9c, 0x46,
+	0x3d, 0x39, 0xb9, 0x86, 0x28, 0x59, 0x92, 0x6b, 0x88, 0x50, 0xa6, 0x8e, 0xbf, 0x97, 0x70, 0x8c,
+	0xe4, 0xe3, 0xe7, 0xc7, 0x50, 0x23, 0xdd, 0x83, 0x54, 0x56, 0x38, 0x1a, 0xa4, 0xb2, 0x08, 0x45,
+	0xa2, 0x81, 0xce, 0xa6, 0x45, 0x37, 0xc3, 0x16, 0xfe, 0x36, 0x5f, 0x1d, 0x64, 0xdc, 0x27, 0x23,
+	0xd1, 0x20, 0xfa, 0x4a, 0x13, 0x0d, 0xf2, 0x5b, 0x9d, 0x68, 0xdc, 0x45, 0xdd, 0x16, 0x4f, 0x34,
+	0xa8, 0x40, 0x7c, 0xa2, 0x21, 0xc9, 0x94, 0x26, 0x1a, 0x04, 0xb1, 0xcb, 0x68, 0xfc, 0xc9, 0xf3,
+	0x9a, 0x3d, 0x49, 0x34, 0x24, 0xdd, 0xa6, 0x89, 0x86, 0x84, 0xd0, 0x25, 0x1a, 0xfb, 0x61, 0x9b,
+	0x8f, 0x46, 0xa2, 0x21, 0x6b, 0x4e, 0x4c, 0x34, 0x72, 0xd4, 0x97, 0x6c, 0xa8, 0x9f, 0x3e, 0xf5,
+	0x69, 0x24, 0x97, 0x12, 0x0d, 0x9d, 0xfa, 0xfe, 0x4d, 0x76, 0x56, 0x2e, 0x02, 0x3a, 0x5e, 0xdb,
+	0x83, 0x85, 0x4a, 0xba, 0x3b, 0xd7, 0xdc, 0x23, 0xa0, 0x12, 0x26, 0x13, 0xe6, 0x6e, 0xae, 0xe9,
+	0xd0, 0x42, 0xfb, 0x6b, 0x56, 0x44, 0xba, 0x36, 0xb3, 0x08, 0xa6, 0x9d, 0x17, 0xcb, 0xb8, 0x26,
+	0x25, 0x86, 0xd3, 0x8f, 0xaf, 0x82, 0xe6, 0x34, 0x0a, 0x32, 0x33, 0xfb, 0x48, 0xaa, 0x23, 0x0b,
+	0xcc, 0xe8, 0x34, 0xc0, 0xd4, 0x74, 0xab, 0x94, 0xa4, 0x21, 0xb8, 0x6a, 0x57, 0x70, 0xab, 0xc4,
+	0x95, 0xd8, 0x6d, 0xa8, 0x86, 0x8c, 0xd3, 0x6c, 0x95, 0xb9, 0x1b, 0x3f, 0xe1, 0x30, 0xdd, 0xf8,
+	0xc9, 0x6f, 0xf5, 0xc6, 0xbf, 0xef, 0xd2, 0x6c, 0x67, 0xe3, 0xa7, 0x02, 0xf1, 0x1b, 0xbf, 0x24,
+	0xd3, 0x9f, 0x4a, 0xac, 0x9c, 0xd2, 0x0c, 0x9c, 0x9b, 0xd0, 0xbd, 0xbc, 0x66, 0xfb, 0x3e, 0x6c,
+	0x16, 0x10, 0x6c, 0x0c, 0x1c, 0x74, 0xc8, 0xc7, 0xb4, 0x5c, 0x97, 0xfc, 0x34, 0x3e, 0x0b, 0x2a,
+	0xae, 0x17, 0x42, 0x27, 0xf6, 0x02, 0x9f, 0x16, 0xb8, 0xc7, 0x59, 0x81, 0x9b, 0x4e, 0xb8, 0x98,
+	0x0c, 0xa8, 0xa5, 0x63, 0x35, 0xae, 0xda, 0xbd, 0xb0, 0x9a, 0x11, 0x85, 0x16, 0x56, 0x33, 0x70,
+	0xa6, 0x83, 0x9f, 0x94, 0xf8, 0x0a, 0xcc, 0x9d, 0x53, 0x83, 0xda, 0x8e, 0x17, 0x35, 0xd2, 0x8c,
+	0xf3, 0x99, 0xbf, 0x28, 0xd0, 0x29, 0x30, 0xad, 0xe1, 0x97, 0xc9, 0xf4, 0xdf, 0x92, 0xe0, 0xc4,
+	0x4f, 0x6e, 0xc2, 0x56, 0x1b, 0xab, 0x74, 0xe7, 0x02, 0xcd, 0x81, 0x83, 0x36, 0x41, 0xe4, 0x7a,
+	0x6c, 0x32, 0xd0, 0x78, 0x34, 0x6b, 0xf1, 0x09, 0x66, 0x71, 0xc6, 0xcc, 0x5e, 0xda, 0x5c, 0x14,
+	0x33, 0xb5, 0xb9, 0x08, 0x67, 0xfa, 0x79, 0xb3, 0x24, 0xaf, 0x89, 0xbb, 0xad, 0xa2, 0xe2, 0xde,
+	0x20, 0x89, 0xca, 0x7b, 0x83, 0x46, 0xda, 0xef, 0x93, 0x7a, 0xfc, 0x32, 0x8c, 0xa9, 0x9f, 0x2c,
+	0x05, 0x2e, 0x2c, 0x74, 0x31, 0xdb, 0xdb, 0x0a, 0x5c, 0x88, 0x85, 0x1c, 0x9e, 0x1b, 0x95, 0xd7,
+	0x30, 0xa2, 0x5a, 0xc3, 0x23, 0x72, 0x0a, 0xe6, 0x22, 0x23, 0xb4, 0x60, 0x2e, 0x02, 0x19, 0xef,
+	0xdf, 0x61, 0x9e, 0x8c, 0x33, 0x94, 0x3b, 0xb2, 0x34, 0xf3, 0xdd, 0x4b, 0x9c, 0x3b, 0x75, 0x2f,
+	0x11, 0xce, 0x98, 0x7e, 0x95, 0x77, 0xaf, 0x3b, 0xc8, 0xf7, 0x76, 0xdc, 0x45, 0x62, 0x9d, 0x77,
+	0x17, 0x0d, 0xf7, 0xff, 0x28, 0xe3, 0x8d, 0x6e, 0x19, 0xc6, 0x35, 0x3b, 0x86, 0x57, 0xbd, 0x96,
+	0x17, 0xef, 0x69, 0x20, 0x7c, 0x0c, 0xf4, 0x6f, 0x78, 0xbe, 0x1b, 0x6c, 0x60, 0xdf, 0x1f, 0x9c,
+	0x1b, 0xcf, 0x9c, 0xc4, 0x16, 0x69, 0xc7, 0x05, 0x39, 0x88, 0xbd, 0x8a, 0x0f, 0x62, 0xe4, 0x13,
+	0xe3, 0x1a, 0x00, 0x2d, 0x7b, 0xb3, 0x4e, 0xb3, 0xea, 0xde, 0xa2, 0xa7, 0xc5, 0x96, 0xbd, 0x79,
+	0x89, 0x24, 0xd6, 0x5f, 0x05, 0xc3, 0x88, 0x60, 0x1b, 0x86, 0x0e, 0xf4, 0x63, 0xbb, 0x41, 0x2e,
+	0x4c, 0x2b, 0x0b, 0x8f, 0x50, 0xa2, 0x13, 0x59, 0xa2, 0x57, 0x61, 0xc3, 0x76, 0xb6, 0x16, 0xa1,
+	0xc3, 0x91, 0x5e, 0x84, 0x0e, 0x21, 0x7d, 0xa8, 0x65, 0x6f, 0x5e, 0x67, 0xc4, 0x72, 0xf6, 0x69,
+	0x5e, 0xc7, 0x74, 0x9f, 0xe6, 0x41, 0xcc, 0x24, 0xdf, 0x26, 0x45, 0x3f, 0x62, 0xb6, 0x3b, 0x62,
+	0x95, 0x9c, 0xa2, 0x9c, 0x34, 0x33, 0x2d, 0xca, 0x49, 0x50, 0xc6, 0xee, 0x4f, 0x49, 0xc0, 0x41,
+	0xf1, 0x37, 0xf4, 0x1c, 0xb8, 0x0c, 0xe3, 0x62, 0x55, 0x92, 0xc7, 0xc0, 0x50, 0x1b, 0x11, 0xa8,
+	0x47, 0x98, 0x42, 0x6e, 0x74, 0x1d, 0x6c, 0xa7, 0xd3, 0xe5, 0xc4, 0x20, 0x91, 0x37, 0x1a, 0x83,
+	0x44, 0x20, 0x13, 0xe7, 0x67, 0x24, 0x06, 0xd1, 0x18, 0xbb, 0x7f, 0x12, 0x75, 0x0f, 0x53, 0x19,
+	0xf6, 0x68, 0x98, 0xca, 0xc0, 0x99, 0x5c, 0xef, 0xd0, 0x96, 0x06, 0x18, 0x63, 0x6c, 0xcd, 0xf6,
+	0x1b, 0x45, 0xb6, 0x85, 0x47, 0x40, 0x0f, 0xf4, 0x5d, 0x2c, 0xca, 0x76, 0xcb, 0x2a, 0xe8, 0x03,
+	0xe3, 0x59, 0x00, 0x5c, 0xdb, 0x6b, 0x6e, 0xd5, 0x43, 0x3b, 0x4e, 0x0a, 0x3f, 0x45, 0x57, 0x5d,
+	0x05, 0x53, 0x42, 0x9e, 0x68, 0x3c, 0x07, 0x48, 0x33, 0x44, 0x1d, 0xab, 0x8e, 0x86, 0x88, 0xa2,
+	0x74, 0x49, 0x23, 0x05, 0x56, 0x4f, 0x5e, 0xc7, 0x02, 0xaf, 0xc5, 0xa4, 0x63, 0x81, 0x87, 0x31,
+	0xb5, 0xff, 0xb9, 0x94, 0x2c, 0xf4, 0x25, 0x7b, 0x13, 0xe3, 0x17, 0xe1, 0xba, 0x67, 0x17, 0x4c,
+	0x3e, 0x56, 0xc1, 0x51, 0x1c, 0xbf, 0xb0, 0x57, 0xb9, 0x09, 0x21, 0xea, 0x58, 0x45, 0xc5, 0x1e,
+	0x69, 0xc9, 0x9c, 0xe5, 0xec, 0x35, 0x2a, 0x61, 0xe8, 0x5e, 0xa3, 0x42, 0x31, 0x5d, 0xbc, 0x4e,
+	0x3a, 0xc5, 0x9e, 0x0b, 0xed, 0x22, 0x0d, 0x02, 0x69, 0x79, 0xa5, 0xbc, 0xbb, 0xf2, 0x4a, 0x4e,
+	0xa7, 0x17, 0x62, 0x8f, 0x76, 0x7a, 0xa1, 0x3f, 0x19, 0xf7, 0x3f, 0x2e, 0x81, 0x0a, 0x6e, 0x15,
+	0xda, 0xd8, 0x6f, 0xfe, 0x4f, 0x6b, 0xf8, 0x1f, 0xa4, 0x9d, 0x4d, 0x88, 0x41, 0xeb, 0x28, 0x8e,
+	0xca, 0xe4, 0x07, 0x93, 0xe1, 0x9f, 0x25, 0x70, 0x94, 0x6b, 0x3c, 0xa9, 0xc1, 0x15, 0x3b, 0xf2,
+	0xfc, 0x46, 0xa1, 0x38, 0xc0, 0x55, 0x24, 0xca, 0xdb, 0xaf, 0x48, 0xec, 0x59, 0x91, 0x6c, 0xfe,
+	0xbc, 0x46, 0x0b, 0xc7, 0x84, 0x9e, 0x9b, 0x44, 0x44, 0x6b, 0x12, 0x4c, 0x28, 0x24, 0x67, 0x9a,
+	0xf9, 0x41, 0x29, 0x09, 0x8f, 0xcb, 0xb1, 0xbd, 0xd2, 0x84, 0x4e, 0xe0, 0xf9, 0x7b, 0xd6, 0xc5,
+	0x62, 0x82, 0x01, 0xb8, 0xd9, 0x0e, 0xfc, 0xa4, 0x7a, 0x73, 0xa8, 0xc6, 0x7e, 0xe7, 0x07, 0x9a,
+	0x94, 0x9f, 0x34, 0xd0, 0xa4, 0x30, 0x26, 0xc0, 0xef, 0x4a, 0xe0, 0x38, 0xde, 0x00, 0xbe, 0xd6,
+	0x81, 0x51, 0xbc, 0xdc, 0x59, 0x89, 0x9c, 0xd0, 0x2b, 0x7a, 0xc8, 0xd9, 0x3b, 0x5f, 0xbd, 0xa0,
+	0x11, 0x6f, 0x8c, 0x6e, 0x63, 0x19, 0x6e, 0xad, 0x0b, 0x60, 0x4a, 0x2d, 0x47, 0x22, 0xaa, 0x31,
+	0x0c, 0xca, 0x9e, 0x8b, 0x65, 0xe9, 0xad, 0x95, 0x3d, 0xd7, 0x7a, 0x97, 0x88, 0x7e, 0xa5, 0xd3,
+	0x5c, 0xf5, 0x9a, 0xcd, 0x5d, 0x8a, 0x4e, 0x88, 0x97, 0x13, 0xe2, 0xc6, 0x55, 0xd0, 0x47, 0xf6,
+	0x96, 0xdd, 0xed, 0x59, 0x84, 0x48, 0x8e, 0x3a, 0x14, 0x12, 0x58, 0x33, 0x58, 0x1d, 0x0a, 0x0c,
+	0xdf, 0x2c, 0x46, 0x6e, 0x45, 0x5f, 0x80, 0x4e, 0xbc, 0xb7, 0xd2, 0xe7, 0xde, 0x55, 0xca, 0x53,
+	0xb2, 0xbb, 0x4a, 0x19, 0xc1, 0xb8, 0xfd, 0x4d, 0x92, 0x5f, 0x61, 0xfb, 0xd6, 0xa0, 0x5b, 0xfc,
+	0x28, 0xbe, 0x77, 0x5e, 0x9a, 0x97, 0x6c, 0x49, 0xbc, 0x5a, 0x55, 0x9a, 0x6c, 0x49, 0x70, 0xad,
+	0x87, 0x7e, 0x93, 0xc4, 0xdd, 0xcb, 0xb6, 0xef, 0xa0, 0xb3, 0xd7, 0x2e, 0x64, 0x96, 0x0d, 0xd4,
+	0x3d, 0x0a, 0xca, 0x13, 0xd2, 0x28, 0x28, 0x83, 0x99, 0x71, 0xde, 0x22, 0x7c, 0xa2, 0xd4, 0xb1,
+	0x09, 0xf7, 0x92, 0x4f, 0x64, 0xab, 0xb6, 0xbd, 0x15, 0x74, 0x76, 0x11, 0xf7, 0xc9, 0xf7, 0x39,
+	0x12, 0xcb, 0xac, 0x53, 0x89, 0x65, 0x30, 0x93, 0x98, 0x5a, 0x86, 0x38, 0xec, 0x5d, 0xb4, 0x8c,
+	0x3c, 0x21, 0xe5, 0x53, 0x06, 0xf3, 0xad, 0x0f, 0x28, 0x77, 0x5a, 0xde, 0x28, 0x94, 0x7b, 0x3c,
+	0x2e, 0xac, 0x14, 0x74, 0x0a, 0xa7, 0xc3, 0x57, 0xec, 0x08, 0x56, 0xe9, 0x0b, 0x8b, 0xea, 0xe5,
+	0xc0, 0xf3, 0x17, 0x2a, 0xc8, 0x30, 0xe2, 0x75, 0xd4, 0x0d, 0x30, 0xdc, 0xf2, 0x7c, 0x7a, 0x0c,
+	0xaf, 0xef, 0xc6, 0x86, 0x43, 0x2d, 0xcf, 0x27, 0x47, 0xf1, 0x6b, 0x9d, 0xbc, 0x3c, 0x0c, 0x89,
+	0x6a, 0xdd, 0x20, 0xd5, 0x89, 0x8d, 0x34, 0x87, 0x31, 0x2e, 0x03, 0xc0, 0x71, 0x52, 0xda, 0x81,
+	0x3c, 0x15, 0x3b, 0x99, 0xda, 0x7a, 0x99, 0x9d, 0x86, 0x10, 0xed, 0xcb, 0x81, 0xbf, 0xea, 0x35,
+	0x0a, 0x45, 0xa0, 0x9e, 0x55, 0x08, 0x77, 0x99, 0x7f, 0x23, 0x12, 0xc6, 0x97, 0xc0, 0x20, 0x39,
+	0x1f, 0x35, 0xd1, 0xe1, 0xba, 0xb0, 0x82, 0xc9, 0x21, 0x0b, 0x1f, 0xd0, 0xb7, 0x91, 0x59, 0x30,
+	0xd1, 0xb9, 0xcc, 0x82, 0xc1, 0x98, 0xeb, 0xfd, 0x9a, 0x2c, 0x91, 0x2b, 0x1d, 0xdf, 0x45, 0xd8,
+	0x2b, 0xb6, 0xe3, 0x35, 0xbd, 0x78, 0x6b, 0x5f, 0x03, 0x76, 0xf7, 0xc5, 0x25, 0xb3, 0x4a, 0x17,
+	0x97, 0x0c, 0x96, 0x2b, 0xc4, 0xcf, 0x79, 0xf1, 0x9a, 0x1b, 0xda, 0x1b, 0x1f, 0x19, 0x29, 0xbb,
+	0x1f, 0xc3, 0x54, 0xec, 0xd2, 0x63, 0x98, 0x0a, 0xc5, 0xa4, 0x7d, 0x01, 0x1c, 0x4a, 0x9e, 0x6b,
+	0x20, 0x7c, 0xb4, 0x73, 0x11, 0xe7, 0xef, 0xd7, 0x30, 0x76, 0x38, 0x7d, 0x15, 0x82, 0x49, 0x5b,
+	0x27, 0x70, 0x6a, 0x92, 0x02, 0x18, 0x13, 0x3e, 0x5e, 0xd8, 0xf4, 0xdd, 0x45, 0x51, 0x36, 0xba,
+	0xd7, 0xdb, 0x78, 0xe2, 0xb4, 0xde, 0xc6, 0x83, 0xd2, 0xee, 0x29, 0x52, 0x6f, 0x5b, 0x86, 0xf1,
+	0xd5, 0xc0, 0xb9, 0xd9, 0x69, 0x5f, 0x87, 0xa1, 0x17, 0xb8, 0x85, 0x0c, 0x7f, 0xa8, 0x89, 0x29,
+	0xd4, 0xdb, 0x98, 0x04, 0x0b, 0xb6, 0xdb, 0x28, 0x79, 0x0e, 0x35, 0xb9, 0xb9, 0x73, 0xea, 0x73,
+	0x12, 0xa7, 0xb4, 0x3e, 0x27, 0x41, 0xf9, 0x53, 0xf7, 0x71, 0xda, 0xe3, 0x9a, 0x34, 0xba, 0x2f,
+	0x78, 0xbe, 0x5b, 0xec, 0xd8, 0xa7, 0x3e, 0xdf, 0x8c, 0x82, 0xbe, 0x95, 0xa0, 0xe3, 0xbb, 0x38,
+	0x6c, 0x0d, 0xd4, 0xc8, 0x8f, 0x9c, 0x5c, 0x57, 0xc1, 0x0f, 0xcd, 0x75, 0x15, 0x18, 0x26, 0xcc,
+	0x2f, 0x58, 0x82, 0xb2, 0x88, 0x66, 0x5e, 0x82, 0xb1, 0xed, 0xda, 0xb1, 0x5d, 0x40, 0x92, 0x27,
+	0xc0, 0x40, 0x8b, 0x7e, 0x4d, 0xed, 0x34, 0x99, 0x6e, 0x22, 0xfe, 0x4d, 0xb6, 0x89, 0x24, 0x53,
+	0x2c, 0xf4, 0x22, 0x5b, 0xd5, 0xd8, 0x47, 0xf9, 0x79, 0x88, 0xc0, 0x61, 0x9a, 0x87, 0x08, 0x60,
+	0xfe, 0xfc, 0x39, 0x4c, 0xaf, 0x19, 0xe2, 0x18, 0x46, 0x71, 0x50, 0xa4, 0xe0, 0xf8, 0x10, 0x18,
+	0xb0, 0xe9, 0xd7, 0xf9, 0x4f, 0x5a, 0x92, 0x91, 0xf3, 0x67, 0x34, 0x82, 0x1c, 0x61, 0x17, 0x22,
+	0x74, 0xa4, 0x35, 0x86, 0x3d, 0x89, 0x83, 0xf0, 0x4e, 0x36, 0x92, 0x5e, 0x35, 0xdc, 0x6d, 0x09,
+	0xba, 0x57, 0x7f, 0x45, 0xa6, 0x68, 0xf5, 0x57, 0x04, 0x32, 0x39, 0xbe, 0x57, 0xc6, 0xb1, 0xe0,
+	0x7a, 0x10, 0xc5, 0x04, 0x67, 0xef, 0xf7, 0xd9, 0xc4, 0x78, 0x14, 0xf4, 0xd9, 0x51, 0x3d, 0x58,
+	0xa5, 0x17, 0x28, 0xdb, 0xab, 0xb9, 0xf6, 0xda, 0xd1, 0xb5, 0x55, 0x63, 0x1a, 0x0c, 0x86, 0xb0,
+	0x1d, 0x84, 0x71, 0x7d, 0xcd, 0x8e, 0xd6, 0xe8, 0x2d, 0x2b, 0x20, 0xa0, 0xa7, 0xec, 0x68, 0x2d,
+	0x27, 0xce, 0x48, 0x5a, 0xa0, 0x71, 0x46, 0x82, 0x32, 0xd5, 0xfd, 0xb0, 0x9c, 0x2c, 0xcd, 0x1a,
+	0x8c, 0x60, 0xb8, 0x0e, 0x0b, 0x67, 0x55, 0x36, 0x38, 0xe2, 0x04, 0xcd, 0xa6, 0x1d, 0xc3, 0xd0,
+	0x6e, 0xd6, 0x71, 0xa8, 0xdc, 0x65, 0x8a, 0x75, 0x38, 0xa5, 0x57, 0x43, 0xe4, 0x50, 0xa8, 0x6e,
+	0xd9, 0x9b, 0xf5, 0x28, 0xb6, 0x9b, 0xd0, 0x87, 0x51, 0xb4, 0x93, 0xdb, 0xa9, 0xa1, 0x96, 0xbd,
+	0xb9, 0x9c, 0x7c, 0x98, 0x1f, 0x06, 0x04, 0x6d, 0xa4, 0x61, 0x40, 0x00, 0x33, 0x25, 0xfe, 0x92,
+	0xf4, 0x9d, 0x5c, 0x72, 0xdd, 0x1a, 0x74, 0x82, 0x75, 0x18, 0x16, 0xba, 0x78, 0x20, 0xc5, 0x39,
+	0xf2, 0xf9, 0xb6, 0x8a, 0x73, 0x74, 0xa6, 0x22, 0xdd, 0x27, 0x3c, 0xab, 0x74, 0x97, 0xe5, 0x41,
+	0xfc, 0xb9, 0x9f, 0xbb, 0xd5, 0xfa, 0xc8, 0x08, 0xb7, 0xad, 0x9b, 0x30, 0x26, 0x9f, 0x70, 0x13,
+	0x96, 0x11, 0xf1, 0xf7, 0xe4, 0x50, 0x81, 0x5f, 0x83, 0x25, 0x85, 0xc6, 0x3d, 0xdb, 0x63, 0x93,
+	0xa7, 0xe2, 0x3d, 0xdb, 0x7a, 0x2a, 0x4e, 0x9e, 0x55, 0xf7, 0xee, 0xe8, 0x59, 0x75, 0xdf, 0x2e,
+	0x03, 0xd5, 0xbd, 0x60, 0xd8, 0xb1, 0x23, 0x58, 0x0f, 0xe1, 0x2a, 0x0c, 0xa1, 0xef, 0x40, 0xdc,
+	0x38, 0x5b, 0xa9, 0x1d, 0x42, 0xd0, 0x5a, 0x02, 0xcc, 0x39, 0x96, 0x08, 0xca, 0xa3, 0xc7, 0x12,
+	0x01, 0x96, 0x68, 0x7b, 0xee, 0xe5, 0xb3, 0xa0, 0x67, 0x29, 0x6a, 0x18, 0x73, 0xa0, 0x17, 0xbf,
+	0xbe, 0x3f, 0xc2, 0x5a, 0x14, 0xe8, 0x0b, 0x75, 0x73, 0x4c, 0x86, 0xb0, 0x33, 0xe4, 0x1c, 0xe8,
+	0xc5, 0xef, 0xd5, 0x85, 0x6f, 0x10, 0x44, 0xfc, 0x86, 0x7f, 0xe9, 0x6d, 0x3c, 0x0c, 0xfa, 0xc8,
+	0x3b, 0xe0, 0x11, 0x7e, 0x08, 0x06, 0x99, 0xe3, 0x19, 0x10, 0xfb, 0xec, 0x31, 0x70, 0x30, 0x79,
+	0x04, 0x7d, 0x94, 0x1f, 0x45, 0x81, 0xe6, 0x84, 0x02, 0xc8, 0x3e, 0x5e, 0x02, 0x87, 0xc4, 0x37,
+	0xc8, 0xc2, 0x44, 0x02, 0xca, 0x3c, 0xa5, 0x45, 0x31, 0x72, 0x5f, 0x06, 0x23, 0xd9, 0xa7, 0xb3,
+	0x93, 0xfc, 0x77, 0x19, 0xb4, 0x79, 0x6f, 0x57, 0x34, 0x23, 0xbd, 0x0c, 0x0e, 0xcb, 0xaf, 0x55,
+	0x05, 0xc9, 0x24, 0xa4, 0x79, 0xba, 0x0b, 0x92, 0x11, 0xbd, 0x0e, 0x86, 0xa5, 0x77, 0xa5, 0x26,
+	0xff, 0x99, 0x88, 0x33, 0x2d, 0x3d, 0x8e, 0x51, 0xbc, 0x04, 0x2a, 0xe9, 0x73, 0xcc, 0x63, 0x02,
+	0x0f, 0x09, 0xd8, 0x9c, 0x54, 0x82, 0x19, 0x89, 0x2f, 0x80, 0x21, 0xe1, 0xe1, 0xa1, 0xe0, 0x31,
+	0x3c, 0xc6, 0x9c, 0xd1, 0x61, 0x18, 0xad, 0xe7, 0x81, 0xa1, 0x78, 0xf0, 0x37, 0x25, 0x58, 0x32,
+	0x83, 0x37, 0xef, 0xeb, 0x8e, 0x67, 0xd4, 0x6f, 0x82, 0x13, 0xba, 0x77, 0x6b, 0x82, 0xfa, 0x35,
+	0x83, 0xcc, 0x73, 0xdb, 0x18, 0xc4, 0x4f, 0xa6, 0x7b, 0xe5, 0x25, 0x4c, 0xa6, 0x19, 0x24, 0x4e,
+	0x96, 0xf3, 0x2e, 0x0a, 0x7b, 0x9b, 0xf4, 0x26, 0x6a, 0x42, 0x69, 0x35, 0x82, 0x94, 0xbc, 0x4d,
+	0xfd, 0xba, 0x08, 0x19, 0x43, 0xf1, 0xb2, 0x68, 0x4a, 0x67, 0x44, 0x4a, 0xfa, 0xbe, 0xee, 0x78,
+	0xc9, 0xf3, 0xe8, 0x1b, 0x12, 0xd9, 0xf3, 0x08, 0x38, 0xe3, 0x79, 0xe2, 0xcb, 0x8d, 0xd4, 0xf3,
+	0x28, 0x15, 0x85, 0xe7, 0x51, 0x42, 0x33, 0x3a, 0x8c, 0xe4, 0x79, 0x72, 0x9b, 0xbc, 0xec, 0x79,
+	0x12, 0x3e, 0xe3, 0x79, 0x9a, 0x76, 0x72, 0xde, 0xf3, 0xe4, 0x29, 0x94, 0x9e, 0x27, 0xcf, 0x73,
+	0x6e, 0x1b, 0x83, 0x54, 0x9e, 0xd7, 0x75, 0x32, 0xcd, 0x20, 0xb5, 0xe7, 0xe9, 0x26, 0x7b, 0x1e,
+	0x18, 0x8a, 0x26, 0xf9, 0x29, 0x8d, 0xe1, 0x28, 0x5e, 0xd4, 0x9b, 0xbe, 0xd1, 0xdc, 0x80, 0xe0,
+	0x98, 0xba, 0xc9, 0xfc, 0x94, 0xde, 0xa0, 0xc9, 0x1c, 0x0f, 0xe4, 0x0e, 0x91, 0x7c, 0x91, 0xb6,
+	0x35, 0xcb, 0xbe, 0x48, 0xc0, 0x19, 0x5f, 0x14, 0x9b, 0x89, 0x53, 0x5f, 0xa4, 0x54, 0x14, 0xbe,
+	0x48, 0x09, 0xcd, 0xe8, 0x30, 0xfc, 0xb6, 0x94, 0x6d, 0x4a, 0xce, 0x44, 0x61, 0x01, 0x2d, 0x6e,
+	0x4b, 0xda, 0x7e, 0x5f, 0x63, 0x05, 0x8c, 0x2a, 0x7b, 0x7d, 0x55, 0xa1, 0x59, 0x9c, 0xe0, 0x4c,
+	0xde, 0x08, 0x89, 0x7d, 0xa9, 0xb1, 0x54, 0xa9, 0x3e, 0x86, 0xce, 0xb0, 0xaf, 0x6e, 0xe6, 0x4c,
+	0xd9, 0x97, 0xa8, 0xeb, 0x74, 0x9a, 0x4e, 0x70, 0x26, 0x6f, 0x04, 0xbf, 0xc9, 0x4a, 0xcd, 0xa2,
+	0xa6, 0xb4, 0xca, 0x39, 0x9c, 0xb8, 0xc9, 0xaa, 0xdb, 0x38, 0xa9, 0x42, 0xa4, 0x56, 0x48, 0x59,
+	0x21, 0x22, 0x3a, 0xa3, 0x10, 0x75, 0xbb, 0x62, 0xaa, 0x10, 0x89, 0xba, 0x42, 0x21, 0xd2, 0x04,
+	0x67, 0xf2, 0x46, 0xf0, 0xae, 0x2d, 0xb4, 0x43, 0x8e, 0x49, 0x22, 0x33, 0x8c, 0xe8, 0xda, 0xaa,
+	0x5e, 0x3e, 0xb4, 0x51, 0xc9, 0x7d, 0x7c, 0x13, 0x59, 0x46, 0x52, 0x8a, 0xa7, 0xbb, 0x20, 0x79,
+	0x8b, 0x49, 0xdd, 0x76, 0xa6, 0xec, 0x4e, 0x29, 0x4e, 0xb4, 0x98, 0xba, 0xe9, 0x0d, 0x59, 0x2c,
+	0xdb, 0xf0, 0x36, 0xa9, 0x70, 0x21, 0x8e, 0xee, 0xbd, 0x5d, 0xd1, 0x42, 0x0a, 0x2b, 0xf4, 0x9c,
+	0x8d, 0x4b, 0x4a, 0x4b, 0x51, 0x52, 0x0a, 0xab, 0xea, 0xa7, 0x42, 0x0e, 0xa0, 0xec, 0xa5, 0x92,
+	0x4d, 0x91, 0x19, 0x21, 0x3a, 0x40, 0xb7, 0x3e, 0x25, 0x74, 0x3a, 0xc0, 0x3d, 0x4a, 0xc2, 0xe9,
+	0x00, 0x41, 0xc4, 0xd3, 0x01, 0xdf, 0x1d, 0x64, 0x7c, 0x0e, 0xf4, 0xd3, 0xce, 0x20, 0x43, 0x4c,
+	0xe8, 0x11, 0xcc, 0x34, 0xb3, 0x30, 0xf6, 0xe5, 0x0d, 0x70, 0x24, 0xd3, 0x8f, 0x73, 0x52, 0x95,
+	0x74, 0x27, 0x58, 0xf3, 0x9e, 0x6e, 0x58, 0x49, 0xf1, 0x5c, 0x37, 0x8b, 0xac, 0xf8, 0x14, 0x95,
+	0x51, 0x7c, 0xb6, 0xbf, 0xc4, 0xa8, 0x83, 0xa3, 0xaa, 0xde, 0x92, 0x69, 0xd1, 0x0b, 0x32, 0x03,
+	0xcc, 0xfb, 0x73, 0x06, 0xf0, 0x13, 0xa8, 0x3a, 0x38, 0x84, 0x09, 0x14, 0x03, 0xc4, 0x09, 0xba,
+	0xf4, 0x49, 0x90, 0xfc, 0x2e, 0xd3, 0x23, 0x21, 0xe5, 0x77, 0x32, 0x5e, 0xce, 0xef, 0x74, 0x7d,
+	0x0d, 0x64, 0x09, 0xc9, 0x3d, 0x0d, 0x93, 0x0a, 0xe1, 0x53, 0xb4, 0xbc, 0x84, 0x74, 0xdd, 0x04,
+	0x37, 0xc0, 0x91, 0x4c, 0xe7, 0x80, 0xe0, 0x21, 0x32, 0x56, 0xf4, 0x10, 0xdd, 0x6d, 0x3f, 0xa2,
+	0x9b, 0xb9, 0xe9, 0x3f, 0x29, 0x79, 0x82, 0x80, 0x15, 0xe9, 0xea, 0xee, 0xd4, 0x11, 0xdd, 0xcc,
+	0x7d, 0xfa, 0xc9, 0xac, 0x1a, 0x75, 0x74, 0x75, 0x77, 0xe0, 0x68, 0x5d, 0xe2, 0xfb, 0x6f, 0x61,
+	0x5d, 0x22, 0x88, 0xb8, 0x2e, 0x85, 0xdb, 0x62, 0xba, 0x0a, 0xd2, 0x4b, 0xde, 0xcc, 0x2a, 0x60,
+	0xa8, 0xec, 0x2a, 0xc8, 0xdc, 0x85, 0x22, 0xd1, 0x32, 0xf7, 0xa0, 0x27, 0x45, 0x07, 0x14, 0xb1,
+	0xa2, 0x68, 0xba, 0x1b, 0x48, 0x14, 0xd6, 0x94, 0xb7, 0x8f, 0x42, 0x58, 0x53, 0x8d, 0x10, 0xc3,
+	0x5a, 0xb7, 0x7b, 0x3f, 0x63, 0x11, 0x00, 0xee, 0xd2, 0xef, 0x78, 0xa6, 0x64, 0x81, 0xe1, 0xe6,
+	0x94, 0x1a, 0xce, 0xef, 0x8e, 0xc2, 0xad, 0xdd, 0x98, 0xa2, 0x7e, 0x41, 0x28, 0xcd, 0xe8, 0x30,
+	0xfc, 0xee, 0x28, 0xdf, 0xba, 0x4d, 0x48, 0x36, 0xe0, 0x91, 0xe2, 0xee, 0xa8, 0xb9, 0xef, 0x42,
+	0x71, 0x44, 0x75, 0xd7, 0x35, 0x2d, 0x1f, 0x9a, 0xa5, 0x01, 0x62, 0x1c, 0xe9, 0x72, 0x07, 0x45,
+	0x97, 0x8d, 0x78, 0xff, 0x24, 0x2f, 0x1b, 0x01, 0x9b, 0x59, 0x36, 0xca, 0x2b, 0x20, 0xe3, 0xf3,
+	0x60, 0x90, 0xbf, 0xfe, 0x39, 0x21, 0x67, 0x44, 0x14, 0x61, 0x4e, 0x6b, 0x10, 0x7c, 0x7e, 0x20,
+	0x5d, 0xc4, 0x98, 0x8a, 0xe4, 0x27, 0x21, 0x67, 0xe9, 0x71, 0xbc, 0xa1, 0xe4, 0x2b, 0x11, 0xc1,
+	0x50, 0x12, 0x52, 0x34, 0x94, 0xe6, 0xc2, 0x80, 0xea, 0x51, 0xbc, 0x2c, 0x90, 0xf5, 0x28, 0x60,
+	0x33, 0x7a, 0x54, 0xd6, 0xd0, 0x91, 0x87, 0x0a, 0xf5, 0xf3, 0x31, 0x49, 0x5f, 0x0c, 0x23, 0x7a,
+	0xa8, 0xaa, 0x6a, 0xcd, 0xe5, 0x6f, 0x8c, 0x9c, 0x2a, 0x7f, 0x63, 0x14, 0x4f, 0x77, 0x41, 0xf2,
+	0x31, 0x49, 0xac, 0x11, 0x8f, 0x67, 0x2a, 0x57, 0x09, 0x4a, 0x8c, 0x49, 0xca, 0x42, 0xa8, 0xd9,
+	0xf7, 0xe2, 0xed, 0x5b, 0x67, 0x4b, 0x0b, 0x4f, 0xbe, 0xf9, 0xfe, 0x54, 0xe9, 0xad, 0xf7, 0xa7,
+	0x4a, 0x7f, 0x7c, 0x7f, 0xaa, 0xf4, 0xca, 0x07, 0x53, 0x07, 0xde, 0xfa, 0x60, 0xea, 0xc0, 0x3b,
+	0x1f, 0x4c, 0x1d, 0xf8, 0xca, 0xb9, 0x86, 0x17, 0xaf, 0x75, 0x56, 0xaa, 0x4e, 0xd0, 0x9a, 0x0d,
+	0x7c, 0x97, 0xfc, 0x2f, 0xa8, 0x4e, 0xd0, 0x9c, 0xed, 0x44, 0xee, 0xd6, 0x79, 0x3f, 0x58, 0x69,
+	0xc2, 0xd9, 0xf5, 0xb9, 0xd9, 0x78, 0xab, 0x0d, 0xa3, 0x95, 0x7e, 0x8c, 0xfd, 0xcc, 0xff, 0x02,
+	0x00, 0x00, 0xff, 0xff, 0x95, 0xfd, 0x64, 0x67, 0xe9, 0x55, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
+	Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error)
+	Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error)
+	Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error)
+	SchedulePause(ctx context.Context, in *MsgSchedulePause, opts ...grpc.CallOption) (*MsgSchedulePauseResponse, error)
+	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
+	AcceptOwnership(ctx context.Context, in *MsgAcceptOwnership, opts ...grpc.CallOption) (*MsgAcceptOwnershipResponse, error)
+	ForceOwnership(ctx context.Context, in *MsgForceOwnership, opts ...grpc.CallOption) (*MsgForceOwnershipResponse, error)
+	AddBurner(ctx context.Context, in *MsgAddBurner, opts ...grpc.CallOption) (*MsgAddBurnerResponse, error)
+	RemoveBurner(ctx context.Context, in *MsgRemoveBurner, opts ...grpc.CallOption) (*MsgRemoveBurnerResponse, error)
+	SetBurnerAllowance(ctx context.Context, in *MsgSetBurnerAllowance, opts ...grpc.CallOption) (*MsgSetBurnerAllowanceResponse, error)
+	IncreaseBurnerAllowance(ctx context.Context, in *MsgIncreaseBurnerAllowance, opts ...grpc.CallOption) (*MsgIncreaseBurnerAllowanceResponse, error)
+	DecreaseBurnerAllowance(ctx context.Context, in *MsgDecreaseBurnerAllowance, opts ...grpc.CallOption) (*MsgDecreaseBurnerAllowanceResponse, error)
+	AddBurnerSource(ctx context.Context, in *MsgAddBurnerSource, opts ...grpc.CallOption) (*MsgAddBurnerSourceResponse, error)
+	RemoveBurnerSource(ctx context.Context, in *MsgRemoveBurnerSource, opts ...grpc.CallOption) (*MsgRemoveBurnerSourceResponse, error)
+	AddMinter(ctx context.Context, in *MsgAddMinter, opts ...grpc.CallOption) (*MsgAddMinterResponse, error)
+	RemoveMinter(ctx context.Context, in *MsgRemoveMinter, opts ...grpc.CallOption) (*MsgRemoveMinterResponse, error)
+	SetMinterAllowance(ctx context.Context, in *MsgSetMinterAllowance, opts ...grpc.CallOption) (*MsgSetMinterAllowanceResponse, error)
+	IncreaseMinterAllowance(ctx context.Context, in *MsgIncreaseMinterAllowance, opts ...grpc.CallOption) (*MsgIncreaseMinterAllowanceResponse, error)
+	DecreaseMinterAllowance(ctx context.Context, in *MsgDecreaseMinterAllowance, opts ...grpc.CallOption) (*MsgDecreaseMinterAllowanceResponse, error)
+	AddMinterRecipient(ctx context.Context, in *MsgAddMinterRecipient, opts ...grpc.CallOption) (*MsgAddMinterRecipientResponse, error)
+	RemoveMinterRecipient(ctx context.Context, in *MsgRemoveMinterRecipient, opts ...grpc.CallOption) (*MsgRemoveMinterRecipientResponse, error)
+	AddPauser(ctx context.Context, in *MsgAddPauser, opts ...grpc.CallOption) (*MsgAddPauserResponse, error)
+	RemovePauser(ctx context.Context, in *MsgRemovePauser, opts ...grpc.CallOption) (*MsgRemovePauserResponse, error)
+	AddBlockedChannel(ctx context.Context, in *MsgAddBlockedChannel, opts ...grpc.CallOption) (*MsgAddBlockedChannelResponse, error)
+	RemoveBlockedChannel(ctx context.Context, in *MsgRemoveBlockedChannel, opts ...grpc.CallOption) (*MsgRemoveBlockedChannelResponse, error)
+	AddPauseExemption(ctx context.Context, in *MsgAddPauseExemption, opts ...grpc.CallOption) (*MsgAddPauseExemptionResponse, error)
+	RemovePauseExemption(ctx context.Context, in *MsgRemovePauseExemption, opts ...grpc.CallOption) (*MsgRemovePauseExemptionResponse, error)
+	SetChannelMode(ctx context.Context, in *MsgSetChannelMode, opts ...grpc.CallOption) (*MsgSetChannelModeResponse, error)
+	AddAllowedChannel(ctx context.Context, in *MsgAddAllowedChannel, opts ...grpc.CallOption) (*MsgAddAllowedChannelResponse, error)
+	RemoveAllowedChannel(ctx context.Context, in *MsgRemoveAllowedChannel, opts ...grpc.CallOption) (*MsgRemoveAllowedChannelResponse, error)
+	SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error)
+	RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error)
+	AddPriceSetter(ctx context.Context, in *MsgAddPriceSetter, opts ...grpc.CallOption) (*MsgAddPriceSetterResponse, error)
+	RemovePriceSetter(ctx context.Context, in *MsgRemovePriceSetter, opts ...grpc.CallOption) (*MsgRemovePriceSetterResponse, error)
+	SetPriceRange(ctx context.Context, in *MsgSetPriceRange, opts ...grpc.CallOption) (*MsgSetPriceRangeResponse, error)
+	SetMaxPriceDeviation(ctx context.Context, in *MsgSetMaxPriceDeviation, opts ...grpc.CallOption) (*MsgSetMaxPriceDeviationResponse, error)
+	Wrap(ctx context.Context, in *MsgWrap, opts ...grpc.CallOption) (*MsgWrapResponse, error)
+	Unwrap(ctx context.Context, in *MsgUnwrap, opts ...grpc.CallOption) (*MsgUnwrapResponse, error)
+	TransferRebasing(ctx context.Context, in *MsgTransferRebasing, opts ...grpc.CallOption) (*MsgTransferRebasingResponse, error)
+	SetStablecoin(ctx context.Context, in *MsgSetStablecoin, opts ...grpc.CallOption) (*MsgSetStablecoinResponse, error)
+	RequestSubscription(ctx context.Context, in *MsgRequestSubscription, opts ...grpc.CallOption) (*MsgRequestSubscriptionResponse, error)
+	FulfillSubscription(ctx context.Context, in *MsgFulfillSubscription, opts ...grpc.CallOption) (*MsgFulfillSubscriptionResponse, error)
+	RejectSubscription(ctx context.Context, in *MsgRejectSubscription, opts ...grpc.CallOption) (*MsgRejectSubscriptionResponse, error)
+	RequestRedemption(ctx context.Context, in *MsgRequestRedemption, opts ...grpc.CallOption) (*MsgRequestRedemptionResponse, error)
+	CancelRedemption(ctx context.Context, in *MsgCancelRedemption, opts ...grpc.CallOption) (*MsgCancelRedemptionResponse, error)
+	SettleRedemption(ctx context.Context, in *MsgSettleRedemption, opts ...grpc.CallOption) (*MsgSettleRedemptionResponse, error)
+	RejectRedemption(ctx context.Context, in *MsgRejectRedemption, opts ...grpc.CallOption) (*MsgRejectRedemptionResponse, error)
+	Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error)
+	SetSwapConfig(ctx context.Context, in *MsgSetSwapConfig, opts ...grpc.CallOption) (*MsgSetSwapConfigResponse, error)
+	FundSwapFacility(ctx context.Context, in *MsgFundSwapFacility, opts ...grpc.CallOption) (*MsgFundSwapFacilityResponse, error)
+	WithdrawSwapFacility(ctx context.Context, in *MsgWithdrawSwapFacility, opts ...grpc.CallOption) (*MsgWithdrawSwapFacilityResponse, error)
+	PauseSwaps(ctx context.Context, in *MsgPauseSwaps, opts ...grpc.CallOption) (*MsgPauseSwapsResponse, error)
+	UnpauseSwaps(ctx context.Context, in *MsgUnpauseSwaps, opts ...grpc.CallOption) (*MsgUnpauseSwapsResponse, error)
+	SetLockupPeriod(ctx context.Context, in *MsgSetLockupPeriod, opts ...grpc.CallOption) (*MsgSetLockupPeriodResponse, error)
+	SetBlocklistBinding(ctx context.Context, in *MsgSetBlocklistBinding, opts ...grpc.CallOption) (*MsgSetBlocklistBindingResponse, error)
+	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
+	AddAttestor(ctx context.Context, in *MsgAddAttestor, opts ...grpc.CallOption) (*MsgAddAttestorResponse, error)
+	RemoveAttestor(ctx context.Context, in *MsgRemoveAttestor, opts ...grpc.CallOption) (*MsgRemoveAttestorResponse, error)
+	PostAttestation(ctx context.Context, in *MsgPostAttestation, opts ...grpc.CallOption) (*MsgPostAttestationResponse, error)
+	SetReserveConfig(ctx context.Context, in *MsgSetReserveConfig, opts ...grpc.CallOption) (*MsgSetReserveConfigResponse, error)
+	AddRecoverer(ctx context.Context, in *MsgAddRecoverer, opts ...grpc.CallOption) (*MsgAddRecovererResponse, error)
+	RemoveRecoverer(ctx context.Context, in *MsgRemoveRecoverer, opts ...grpc.CallOption) (*MsgRemoveRecovererResponse, error)
+	ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
+	out := new(MsgBurnResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/Burn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error) {
+	out := new(MsgMintResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/Mint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error) {
+	out := new(MsgPauseResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/Pause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error) {
+	out := new(MsgUnpauseResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/Unpause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SchedulePause(ctx context.Context, in *MsgSchedulePause, opts ...grpc.CallOption) (*MsgSchedulePauseResponse, error) {
+	out := new(MsgSchedulePauseResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/SchedulePause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error) {
+	out := new(MsgTransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/TransferOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptOwnership(ctx context.Context, in *MsgAcceptOwnership, opts ...grpc.CallOption) (*MsgAcceptOwnershipResponse, error) {
+	out := new(MsgAcceptOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/AcceptOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceOwnership(ctx context.Context, in *MsgForceOwnership, opts ...grpc.CallOption) (*MsgForceOwnershipResponse, error) {
+	out := new(MsgForceOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/ForceOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddBurner(ctx context.Context, in *MsgAddBurner, opts ...grpc.CallOption) (*MsgAddBurnerResponse, error) {
+	out := new(MsgAddBurnerResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/AddBurner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveBurner(ctx context.Context, in *MsgRemoveBurner, opts ...grpc.CallOption) (*MsgRemoveBurnerResponse, error) {
+	out := new(MsgRemoveBurnerResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RemoveBurner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBurnerAllowance(ctx context.Context, in *MsgSetBurnerAllowance, opts ...grpc.CallOption) (*MsgSetBurnerAllowanceResponse, error) {
+	out := new(MsgSetBurnerAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/SetBurnerAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) IncreaseBurnerAllowance(ctx context.Context, in *MsgIncreaseBurnerAllowance, opts ...grpc.CallOption) (*MsgIncreaseBurnerAllowanceResponse, error) {
+	out := new(MsgIncreaseBurnerAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/IncreaseBurnerAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DecreaseBurnerAllowance(ctx context.Context, in *MsgDecreaseBurnerAllowance, opts ...grpc.CallOption) (*MsgDecreaseBurnerAllowanceResponse, error) {
+	out := new(MsgDecreaseBurnerAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/DecreaseBurnerAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddBurnerSource(ctx context.Context, in *MsgAddBurnerSource, opts ...grpc.CallOption) (*MsgAddBurnerSourceResponse, error) {
+	out := new(MsgAddBurnerSourceResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/AddBurnerSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveBurnerSource(ctx context.Context, in *MsgRemoveBurnerSource, opts ...grpc.CallOption) (*MsgRemoveBurnerSourceResponse, error) {
+	out := new(MsgRemoveBurnerSourceResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RemoveBurnerSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddMinter(ctx context.Context, in *MsgAddMinter, opts ...grpc.CallOption) (*MsgAddMinterResponse, error) {
+	out := new(MsgAddMinterResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/AddMinter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveMinter(ctx context.Context, in *MsgRemoveMinter, opts ...grpc.CallOption) (*MsgRemoveMinterResponse, error) {
+	out := new(MsgRemoveMinterResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RemoveMinter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMinterAllowance(ctx context.Context, in *MsgSetMinterAllowance, opts ...grpc.CallOption) (*MsgSetMinterAllowanceResponse, error) {
+	out := new(MsgSetMinterAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/SetMinterAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) IncreaseMinterAllowance(ctx context.Context, in *MsgIncreaseMinterAllowance, opts ...grpc.CallOption) (*MsgIncreaseMinterAllowanceResponse, error) {
+	out := new(MsgIncreaseMinterAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/IncreaseMinterAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DecreaseMinterAllowance(ctx context.Context, in *MsgDecreaseMinterAllowance, opts ...grpc.CallOption) (*MsgDecreaseMinterAllowanceResponse, error) {
+	out := new(MsgDecreaseMinterAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/DecreaseMinterAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddMinterRecipient(ctx context.Context, in *MsgAddMinterRecipient, opts ...grpc.CallOption) (*MsgAddMinterRecipientResponse, error) {
+	out := new(MsgAddMinterRecipientResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/AddMinterRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveMinterRecipient(ctx context.Context, in *MsgRemoveMinterRecipient, opts ...grpc.CallOption) (*MsgRemoveMinterRecipientResponse, error) {
+	out := new(MsgRemoveMinterRecipientResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RemoveMinterRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddPauser(ctx context.Context, in *MsgAddPauser, opts ...grpc.CallOption) (*MsgAddPauserResponse, error) {
+	out := new(MsgAddPauserResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/AddPauser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemovePauser(ctx context.Context, in *MsgRemovePauser, opts ...grpc.CallOption) (*MsgRemovePauserResponse, error) {
+	out := new(MsgRemovePauserResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RemovePauser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddBlockedChannel(ctx context.Context, in *MsgAddBlockedChannel, opts ...grpc.CallOption) (*MsgAddBlockedChannelResponse, error) {
+	out := new(MsgAddBlockedChannelResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/AddBlockedChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveBlockedChannel(ctx context.Context, in *MsgRemoveBlockedChannel, opts ...grpc.CallOption) (*MsgRemoveBlockedChannelResponse, error) {
+	out := new(MsgRemoveBlockedChannelResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RemoveBlockedChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddPauseExemption(ctx context.Context, in *MsgAddPauseExemption, opts ...grpc.CallOption) (*MsgAddPauseExemptionResponse, error) {
+	out := new(MsgAddPauseExemptionResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/AddPauseExemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemovePauseExemption(ctx context.Context, in *MsgRemovePauseExemption, opts ...grpc.CallOption) (*MsgRemovePauseExemptionResponse, error) {
+	out := new(MsgRemovePauseExemptionResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RemovePauseExemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetChannelMode(ctx context.Context, in *MsgSetChannelMode, opts ...grpc.CallOption) (*MsgSetChannelModeResponse, error) {
+	out := new(MsgSetChannelModeResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/SetChannelMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAllowedChannel(ctx context.Context, in *MsgAddAllowedChannel, opts ...grpc.CallOption) (*MsgAddAllowedChannelResponse, error) {
+	out := new(MsgAddAllowedChannelResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/AddAllowedChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAllowedChannel(ctx context.Context, in *MsgRemoveAllowedChannel, opts ...grpc.CallOption) (*MsgRemoveAllowedChannelResponse, error) {
+	out := new(MsgRemoveAllowedChannelResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RemoveAllowedChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error) {
+	out := new(MsgSetRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/SetRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error) {
+	out := new(MsgRemoveRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RemoveRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddPriceSetter(ctx context.Context, in *MsgAddPriceSetter, opts ...grpc.CallOption) (*MsgAddPriceSetterResponse, error) {
+	out := new(MsgAddPriceSetterResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/AddPriceSetter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemovePriceSetter(ctx context.Context, in *MsgRemovePriceSetter, opts ...grpc.CallOption) (*MsgRemovePriceSetterResponse, error) {
+	out := new(MsgRemovePriceSetterResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RemovePriceSetter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPriceRange(ctx context.Context, in *MsgSetPriceRange, opts ...grpc.CallOption) (*MsgSetPriceRangeResponse, error) {
+	out := new(MsgSetPriceRangeResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/SetPriceRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMaxPriceDeviation(ctx context.Context, in *MsgSetMaxPriceDeviation, opts ...grpc.CallOption) (*MsgSetMaxPriceDeviationResponse, error) {
+	out := new(MsgSetMaxPriceDeviationResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/SetMaxPriceDeviation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Wrap(ctx context.Context, in *MsgWrap, opts ...grpc.CallOption) (*MsgWrapResponse, error) {
+	out := new(MsgWrapResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/Wrap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unwrap(ctx context.Context, in *MsgUnwrap, opts ...grpc.CallOption) (*MsgUnwrapResponse, error) {
+	out := new(MsgUnwrapResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/Unwrap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferRebasing(ctx context.Context, in *MsgTransferRebasing, opts ...grpc.CallOption) (*MsgTransferRebasingResponse, error) {
+	out := new(MsgTransferRebasingResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/TransferRebasing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetStablecoin(ctx context.Context, in *MsgSetStablecoin, opts ...grpc.CallOption) (*MsgSetStablecoinResponse, error) {
+	out := new(MsgSetStablecoinResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/SetStablecoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestSubscription(ctx context.Context, in *MsgRequestSubscription, opts ...grpc.CallOption) (*MsgRequestSubscriptionResponse, error) {
+	out := new(MsgRequestSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RequestSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FulfillSubscription(ctx context.Context, in *MsgFulfillSubscription, opts ...grpc.CallOption) (*MsgFulfillSubscriptionResponse, error) {
+	out := new(MsgFulfillSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/FulfillSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectSubscription(ctx context.Context, in *MsgRejectSubscription, opts ...grpc.CallOption) (*MsgRejectSubscriptionResponse, error) {
+	out := new(MsgRejectSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RejectSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestRedemption(ctx context.Context, in *MsgRequestRedemption, opts ...grpc.CallOption) (*MsgRequestRedemptionResponse, error) {
+	out := new(MsgRequestRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RequestRedemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRedemption(ctx context.Context, in *MsgCancelRedemption, opts ...grpc.CallOption) (*MsgCancelRedemptionResponse, error) {
+	out := new(MsgCancelRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/CancelRedemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SettleRedemption(ctx context.Context, in *MsgSettleRedemption, opts ...grpc.CallOption) (*MsgSettleRedemptionResponse, error) {
+	out := new(MsgSettleRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/SettleRedemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectRedemption(ctx context.Context, in *MsgRejectRedemption, opts ...grpc.CallOption) (*MsgRejectRedemptionResponse, error) {
+	out := new(MsgRejectRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RejectRedemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error) {
+	out := new(MsgSwapResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/Swap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSwapConfig(ctx context.Context, in *MsgSetSwapConfig, opts ...grpc.CallOption) (*MsgSetSwapConfigResponse, error) {
+	out := new(MsgSetSwapConfigResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/SetSwapConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundSwapFacility(ctx context.Context, in *MsgFundSwapFacility, opts ...grpc.CallOption) (*MsgFundSwapFacilityResponse, error) {
+	out := new(MsgFundSwapFacilityResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/FundSwapFacility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawSwapFacility(ctx context.Context, in *MsgWithdrawSwapFacility, opts ...grpc.CallOption) (*MsgWithdrawSwapFacilityResponse, error) {
+	out := new(MsgWithdrawSwapFacilityResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/WithdrawSwapFacility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseSwaps(ctx context.Context, in *MsgPauseSwaps, opts ...grpc.CallOption) (*MsgPauseSwapsResponse, error) {
+	out := new(MsgPauseSwapsResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/PauseSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpauseSwaps(ctx context.Context, in *MsgUnpauseSwaps, opts ...grpc.CallOption) (*MsgUnpauseSwapsResponse, error) {
+	out := new(MsgUnpauseSwapsResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/UnpauseSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetLockupPeriod(ctx context.Context, in *MsgSetLockupPeriod, opts ...grpc.CallOption) (*MsgSetLockupPeriodResponse, error) {
+	out := new(MsgSetLockupPeriodResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/SetLockupPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBlocklistBinding(ctx context.Context, in *MsgSetBlocklistBinding, opts ...grpc.CallOption) (*MsgSetBlocklistBindingResponse, error) {
+	out := new(MsgSetBlocklistBindingResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/SetBlocklistBinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
+	out := new(MsgSetDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/SetDenomMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAttestor(ctx context.Context, in *MsgAddAttestor, opts ...grpc.CallOption) (*MsgAddAttestorResponse, error) {
+	out := new(MsgAddAttestorResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/AddAttestor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAttestor(ctx context.Context, in *MsgRemoveAttestor, opts ...grpc.CallOption) (*MsgRemoveAttestorResponse, error) {
+	out := new(MsgRemoveAttestorResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RemoveAttestor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PostAttestation(ctx context.Context, in *MsgPostAttestation, opts ...grpc.CallOption) (*MsgPostAttestationResponse, error) {
+	out := new(MsgPostAttestationResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/PostAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetReserveConfig(ctx context.Context, in *MsgSetReserveConfig, opts ...grpc.CallOption) (*MsgSetReserveConfigResponse, error) {
+	out := new(MsgSetReserveConfigResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/SetReserveConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddRecoverer(ctx context.Context, in *MsgAddRecoverer, opts ...grpc.CallOption) (*MsgAddRecovererResponse, error) {
+	out := new(MsgAddRecovererResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/AddRecoverer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRecoverer(ctx context.Context, in *MsgRemoveRecoverer, opts ...grpc.CallOption) (*MsgRemoveRecovererResponse, error) {
+	out := new(MsgRemoveRecovererResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/RemoveRecoverer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error) {
+	out := new(MsgForceTransferResponse)
+	err := c.cc.Invoke(ctx, "/aura.v1.Msg/ForceTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
+	Mint(context.Context, *MsgMint) (*MsgMintResponse, error)
+	Pause(context.Context, *MsgPause) (*MsgPauseResponse, error)
+	Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error)
+	SchedulePause(context.Context, *MsgSchedulePause) (*MsgSchedulePauseResponse, error)
+	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
+	AcceptOwnership(context.Context, *MsgAcceptOwnership) (*MsgAcceptOwnershipResponse, error)
+	ForceOwnership(context.Context, *MsgForceOwnership) (*MsgForceOwnershipResponse, error)
+	AddBurner(context.Context, *MsgAddBurner) (*MsgAddBurnerResponse, error)
+	RemoveBurner(context.Context, *MsgRemoveBurner) (*MsgRemoveBurnerResponse, error)
+	SetBurnerAllowance(context.Context, *MsgSetBurnerAllowance) (*MsgSetBurnerAllowanceResponse, error)
+	IncreaseBurnerAllowance(context.Context, *MsgIncreaseBurnerAllowance) (*MsgIncreaseBurnerAllowanceResponse, error)
+	DecreaseBurnerAllowance(context.Context, *MsgDecreaseBurnerAllowance) (*MsgDecreaseBurnerAllowanceResponse, error)
+	AddBurnerSource(context.Context, *MsgAddBurnerSource) (*MsgAddBurnerSourceResponse, error)
+	RemoveBurnerSource(context.Context, *MsgRemoveBurnerSource) (*MsgRemoveBurnerSourceResponse, error)
+	AddMinter(context.Context, *MsgAddMinter) (*MsgAddMinterResponse, error)
+	RemoveMinter(context.Context, *MsgRemoveMinter) (*MsgRemoveMinterResponse, error)
+	SetMinterAllowance(context.Context, *MsgSetMinterAllowance) (*MsgSetMinterAllowanceResponse, error)
+	IncreaseMinterAllowance(context.Context, *MsgIncreaseMinterAllowance) (*MsgIncreaseMinterAllowanceResponse, error)
+	DecreaseMinterAllowance(context.Context, *MsgDecreaseMinterAllowance) (*MsgDecreaseMinterAllowanceResponse, error)
+	AddMinterRecipient(context.Context, *MsgAddMinterRecipient) (*MsgAddMinterRecipientResponse, error)
+	RemoveMinterRecipient(context.Context, *MsgRemoveMinterRecipient) (*MsgRemoveMinterRecipientResponse, error)
+	AddPauser(context.Context, *MsgAddPauser) (*MsgAddPauserResponse, error)
+	RemovePauser(context.Context, *MsgRemovePauser) (*MsgRemovePauserResponse, error)
+	AddBlockedChannel(context.Context, *MsgAddBlockedChannel) (*MsgAddBlockedChannelResponse, error)
+	RemoveBlockedChannel(context.Context, *MsgRemoveBlockedChannel) (*MsgRemoveBlockedChannelResponse, error)
+	AddPauseExemption(context.Context, *MsgAddPauseExemption) (*MsgAddPauseExemptionResponse, error)
+	RemovePauseExemption(context.Context, *MsgRemovePauseExemption) (*MsgRemovePauseExemptionResponse, error)
+	SetChannelMode(context.Context, *MsgSetChannelMode) (*MsgSetChannelModeResponse, error)
+	AddAllowedChannel(context.Context, *MsgAddAllowedChannel) (*MsgAddAllowedChannelResponse, error)
+	RemoveAllowedChannel(context.Context, *MsgRemoveAllowedChannel) (*MsgRemoveAllowedChannelResponse, error)
+	SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error)
+	RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error)
+	AddPriceSetter(context.Context, *MsgAddPriceSetter) (*MsgAddPriceSetterResponse, error)
+	RemovePriceSetter(context.Context, *MsgRemovePriceSetter) (*MsgRemovePriceSetterResponse, error)
+	SetPriceRange(context.Context, *MsgSetPriceRange) (*MsgSetPriceRangeResponse, error)
+	SetMaxPriceDeviation(context.Context, *MsgSetMaxPriceDeviation) (*MsgSetMaxPriceDeviationResponse, error)
+	Wrap(context.Context, *MsgWrap) (*MsgWrapResponse, error)
+	Unwrap(context.Context, *MsgUnwrap) (*MsgUnwrapResponse, error)
+	TransferRebasing(context.Context, *MsgTransferRebasing) (*MsgTransferRebasingResponse, error)
+	SetStablecoin(context.Context, *MsgSetStablecoin) (*MsgSetStablecoinResponse, error)
+	RequestSubscription(context.Context, *MsgRequestSubscription) (*MsgRequestSubscriptionResponse, error)
+	FulfillSubscription(context.Context, *MsgFulfillSubscription) (*MsgFulfillSubscriptionResponse, error)
+	RejectSubscription(context.Context, *MsgRejectSubscription) (*MsgRejectSubscriptionResponse, error)
+	RequestRedemption(context.Context, *MsgRequestRedemption) (*MsgRequestRedemptionResponse, error)
+	CancelRedemption(context.Context, *MsgCancelRedemption) (*MsgCancelRedemptionResponse, error)
+	SettleRedemption(context.Context, *MsgSettleRedemption) (*MsgSettleRedemptionResponse, error)
+	RejectRedemption(context.Context, *MsgRejectRedemption) (*MsgRejectRedemptionResponse, error)
+	Swap(context.Context, *MsgSwap) (*MsgSwapResponse, error)
+	SetSwapConfig(context.Context, *MsgSetSwapConfig) (*MsgSetSwapConfigResponse, error)
+	FundSwapFacility(context.Context, *MsgFundSwapFacility) (*MsgFundSwapFacilityResponse, error)
+	WithdrawSwapFacility(context.Context, *MsgWithdrawSwapFacility) (*MsgWithdrawSwapFacilityResponse, error)
+	PauseSwaps(context.Context, *MsgPauseSwaps) (*MsgPauseSwapsResponse, error)
+	UnpauseSwaps(context.Context, *MsgUnpauseSwaps) (*MsgUnpauseSwapsResponse, error)
+	SetLockupPeriod(context.Context, *MsgSetLockupPeriod) (*MsgSetLockupPeriodResponse, error)
+	SetBlocklistBinding(context.Context, *MsgSetBlocklistBinding) (*MsgSetBlocklistBindingResponse, error)
+	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
+	AddAttestor(context.Context, *MsgAddAttestor) (*MsgAddAttestorResponse, error)
+	RemoveAttestor(context.Context, *MsgRemoveAttestor) (*MsgRemoveAttestorResponse, error)
+	PostAttestation(context.Context, *MsgPostAttestation) (*MsgPostAttestationResponse, error)
+	SetReserveConfig(context.Context, *MsgSetReserveConfig) (*MsgSetReserveConfigResponse, error)
+	AddRecoverer(context.Context, *MsgAddRecoverer) (*MsgAddRecovererResponse, error)
+	RemoveRecoverer(context.Context, *MsgRemoveRecoverer) (*MsgRemoveRecovererResponse, error)
+	ForceTransfer(context.Context, *MsgForceTransfer) (*MsgForceTransferResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurn) (*MsgBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+}
+func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgMint) (*MsgMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+}
+func (*UnimplementedMsgServer) Pause(ctx context.Context, req *MsgPause) (*MsgPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (*UnimplementedMsgServer) Unpause(ctx context.Context, req *MsgUnpause) (*MsgUnpauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpause not implemented")
+}
+func (*UnimplementedMsgServer) SchedulePause(ctx context.Context, req *MsgSchedulePause) (*MsgSchedulePauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePause not implemented")
+}
+func (*UnimplementedMsgServer) TransferOwnership(ctx context.Context, req *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (*UnimplementedMsgServer) AcceptOwnership(ctx context.Context, req *MsgAcceptOwnership) (*MsgAcceptOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOwnership not implemented")
+}
+func (*UnimplementedMsgServer) ForceOwnership(ctx context.Context, req *MsgForceOwnership) (*MsgForceOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceOwnership not implemented")
+}
+func (*UnimplementedMsgServer) AddBurner(ctx context.Context, req *MsgAddBurner) (*MsgAddBurnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBurner not implemented")
+}
+func (*UnimplementedMsgServer) RemoveBurner(ctx context.Context, req *MsgRemoveBurner) (*MsgRemoveBurnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBurner not implemented")
+}
+func (*UnimplementedMsgServer) SetBurnerAllowance(ctx context.Context, req *MsgSetBurnerAllowance) (*MsgSetBurnerAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBurnerAllowance not implemented")
+}
+func (*UnimplementedMsgServer) IncreaseBurnerAllowance(ctx context.Context, req *MsgIncreaseBurnerAllowance) (*MsgIncreaseBurnerAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncreaseBurnerAllowance not implemented")
+}
+func (*UnimplementedMsgServer) DecreaseBurnerAllowance(ctx context.Context, req *MsgDecreaseBurnerAllowance) (*MsgDecreaseBurnerAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecreaseBurnerAllowance not implemented")
+}
+func (*UnimplementedMsgServer) AddBurnerSource(ctx context.Context, req *MsgAddBurnerSource) (*MsgAddBurnerSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBurnerSource not implemented")
+}
+func (*UnimplementedMsgServer) RemoveBurnerSource(ctx context.Context, req *MsgRemoveBurnerSource) (*MsgRemoveBurnerSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBurnerSource not implemented")
+}
+func (*UnimplementedMsgServer) AddMinter(ctx context.Context, req *MsgAddMinter) (*MsgAddMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMinter not implemented")
+}
+func (*UnimplementedMsgServer) RemoveMinter(ctx context.Context, req *MsgRemoveMinter) (*MsgRemoveMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMinter not implemented")
+}
+func (*UnimplementedMsgServer) SetMinterAllowance(ctx context.Context, req *MsgSetMinterAllowance) (*MsgSetMinterAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMinterAllowance not implemented")
+}
+func (*UnimplementedMsgServer) IncreaseMinterAllowance(ctx context.Context, req *MsgIncreaseMinterAllowance) (*MsgIncreaseMinterAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncreaseMinterAllowance not implemented")
+}
+func (*UnimplementedMsgServer) DecreaseMinterAllowance(ctx context.Context, req *MsgDecreaseMinterAllowance) (*MsgDecreaseMinterAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecreaseMinterAllowance not implemented")
+}
+func (*UnimplementedMsgServer) AddMinterRecipient(ctx context.Context, req *MsgAddMinterRecipient) (*MsgAddMinterRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMinterRecipient not implemented")
+}
+func (*UnimplementedMsgServer) RemoveMinterRecipient(ctx context.Context, req *MsgRemoveMinterRecipient) (*MsgRemoveMinterRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMinterRecipient not implemented")
+}
+func (*UnimplementedMsgServer) AddPauser(ctx context.Context, req *MsgAddPauser) (*MsgAddPauserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPauser not implemented")
+}
+func (*UnimplementedMsgServer) RemovePauser(ctx context.Context, req *MsgRemovePauser) (*MsgRemovePauserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePauser not implemented")
+}
+func (*UnimplementedMsgServer) AddBlockedChannel(ctx context.Context, req *MsgAddBlockedChannel) (*MsgAddBlockedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBlockedChannel not implemented")
+}
+func (*UnimplementedMsgServer) RemoveBlockedChannel(ctx context.Context, req *MsgRemoveBlockedChannel) (*MsgRemoveBlockedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBlockedChannel not implemented")
+}
+func (*UnimplementedMsgServer) AddPauseExemption(ctx context.Context, req *MsgAddPauseExemption) (*MsgAddPauseExemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPauseExemption not implemented")
+}
+func (*UnimplementedMsgServer) RemovePauseExemption(ctx context.Context, req *MsgRemovePauseExemption) (*MsgRemovePauseExemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePauseExemption not implemented")
+}
+func (*UnimplementedMsgServer) SetChannelMode(ctx context.Context, req *MsgSetChannelMode) (*MsgSetChannelModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelMode not implemented")
+}
+func (*UnimplementedMsgServer) AddAllowedChannel(ctx context.Context, req *MsgAddAllowedChannel) (*MsgAddAllowedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedChannel not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAllowedChannel(ctx context.Context, req *MsgRemoveAllowedChannel) (*MsgRemoveAllowedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowedChannel not implemented")
+}
+func (*UnimplementedMsgServer) SetRateLimit(ctx context.Context, req *MsgSetRateLimit) (*MsgSetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRateLimit(ctx context.Context, req *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) AddPriceSetter(ctx context.Context, req *MsgAddPriceSetter) (*MsgAddPriceSetterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPriceSetter not implemented")
+}
+func (*UnimplementedMsgServer) RemovePriceSetter(ctx context.Context, req *MsgRemovePriceSetter) (*MsgRemovePriceSetterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePriceSetter not implemented")
+}
+func (*UnimplementedMsgServer) SetPriceRange(ctx context.Context, req *MsgSetPriceRange) (*MsgSetPriceRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPriceRange not implemented")
+}
+func (*UnimplementedMsgServer) SetMaxPriceDeviation(ctx context.Context, req *MsgSetMaxPriceDeviation) (*MsgSetMaxPriceDeviationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxPriceDeviation not implemented")
+}
+func (*UnimplementedMsgServer) Wrap(ctx context.Context, req *MsgWrap) (*MsgWrapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Wrap not implemented")
+}
+func (*UnimplementedMsgServer) Unwrap(ctx context.Context, req *MsgUnwrap) (*MsgUnwrapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unwrap not implemented")
+}
+func (*UnimplementedMsgServer) TransferRebasing(ctx context.Context, req *MsgTransferRebasing) (*MsgTransferRebasingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRebasing not implemented")
+}
+func (*UnimplementedMsgServer) SetStablecoin(ctx context.Context, req *MsgSetStablecoin) (*MsgSetStablecoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStablecoin not implemented")
+}
+func (*UnimplementedMsgServer) RequestSubscription(ctx context.Context, req *MsgRequestSubscription) (*MsgRequestSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestSubscription not implemented")
+}
+func (*UnimplementedMsgServer) FulfillSubscription(ctx context.Context, req *MsgFulfillSubscription) (*MsgFulfillSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillSubscription not implemented")
+}
+func (*UnimplementedMsgServer) RejectSubscription(ctx context.Context, req *MsgRejectSubscription) (*MsgRejectSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectSubscription not implemented")
+}
+func (*UnimplementedMsgServer) RequestRedemption(ctx context.Context, req *MsgRequestRedemption) (*MsgRequestRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRedemption not implemented")
+}
+func (*UnimplementedMsgServer) CancelRedemption(ctx context.Context, req *MsgCancelRedemption) (*MsgCancelRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRedemption not implemented")
+}
+func (*UnimplementedMsgServer) SettleRedemption(ctx context.Context, req *MsgSettleRedemption) (*MsgSettleRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleRedemption not implemented")
+}
+func (*UnimplementedMsgServer) RejectRedemption(ctx context.Context, req *MsgRejectRedemption) (*MsgRejectRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRedemption not implemented")
+}
+func (*UnimplementedMsgServer) Swap(ctx context.Context, req *MsgSwap) (*MsgSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+}
+func (*UnimplementedMsgServer) SetSwapConfig(ctx context.Context, req *MsgSetSwapConfig) (*MsgSetSwapConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSwapConfig not implemented")
+}
+func (*UnimplementedMsgServer) FundSwapFacility(ctx context.Context, req *MsgFundSwapFacility) (*MsgFundSwapFacilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundSwapFacility not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawSwapFacility(ctx context.Context, req *MsgWithdrawSwapFacility) (*MsgWithdrawSwapFacilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawSwapFacility not implemented")
+}
+func (*UnimplementedMsgServer) PauseSwaps(ctx context.Context, req *MsgPauseSwaps) (*MsgPauseSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSwaps not implemented")
+}
+func (*UnimplementedMsgServer) UnpauseSwaps(ctx context.Context, req *MsgUnpauseSwaps) (*MsgUnpauseSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseSwaps not implemented")
+}
+func (*UnimplementedMsgServer) SetLockupPeriod(ctx context.Context, req *MsgSetLockupPeriod) (*MsgSetLockupPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLockupPeriod not implemented")
+}
+func (*UnimplementedMsgServer) SetBlocklistBinding(ctx context.Context, req *MsgSetBlocklistBinding) (*MsgSetBlocklistBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBlocklistBinding not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomMetadata(ctx context.Context, req *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+}
+func (*UnimplementedMsgServer) AddAttestor(ctx context.Context, req *MsgAddAttestor) (*MsgAddAttestorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAttestor not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAttestor(ctx context.Context, req *MsgRemoveAttestor) (*MsgRemoveAttestorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAttestor not implemented")
+}
+func (*UnimplementedMsgServer) PostAttestation(ctx context.Context, req *MsgPostAttestation) (*MsgPostAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostAttestation not implemented")
+}
+func (*UnimplementedMsgServer) SetReserveConfig(ctx context.Context, req *MsgSetReserveConfig) (*MsgSetReserveConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReserveConfig not implemented")
+}
+func (*UnimplementedMsgServer) AddRecoverer(ctx context.Context, req *MsgAddRecoverer) (*MsgAddRecovererResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRecoverer not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRecoverer(ctx context.Context, req *MsgRemoveRecoverer) (*MsgRemoveRecovererResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRecoverer not implemented")
+}
+func (*UnimplementedMsgServer) ForceTransfer(ctx context.Context, req *MsgForceTransfer) (*MsgForceTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceTransfer not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Burn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/Burn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Burn(ctx, req.(*MsgBurn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/Mint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Mint(ctx, req.(*MsgMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Pause(ctx, req.(*MsgPause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unpause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/Unpause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unpause(ctx, req.(*MsgUnpause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SchedulePause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSchedulePause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SchedulePause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/SchedulePause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SchedulePause(ctx, req.(*MsgSchedulePause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/TransferOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferOwnership(ctx, req.(*MsgTransferOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/AcceptOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptOwnership(ctx, req.(*MsgAcceptOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/ForceOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceOwnership(ctx, req.(*MsgForceOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddBurner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddBurner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddBurner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/AddBurner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddBurner(ctx, req.(*MsgAddBurner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveBurner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveBurner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveBurner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/RemoveBurner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveBurner(ctx, req.(*MsgRemoveBurner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBurnerAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBurnerAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBurnerAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/SetBurnerAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBurnerAllowance(ctx, req.(*MsgSetBurnerAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_IncreaseBurnerAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIncreaseBurnerAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IncreaseBurnerAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/IncreaseBurnerAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IncreaseBurnerAllowance(ctx, req.(*MsgIncreaseBurnerAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DecreaseBurnerAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDecreaseBurnerAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DecreaseBurnerAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/DecreaseBurnerAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DecreaseBurnerAllowance(ctx, req.(*MsgDecreaseBurnerAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddBurnerSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddBurnerSource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddBurnerSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/AddBurnerSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddBurnerSource(ctx, req.(*MsgAddBurnerSource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveBurnerSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveBurnerSource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveBurnerSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/RemoveBurnerSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveBurnerSource(ctx, req.(*MsgRemoveBurnerSource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMinter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/AddMinter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMinter(ctx, req.(*MsgAddMinter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMinter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/RemoveMinter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMinter(ctx, req.(*MsgRemoveMinter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMinterAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMinterAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMinterAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/SetMinterAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMinterAllowance(ctx, req.(*MsgSetMinterAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_IncreaseMinterAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIncreaseMinterAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IncreaseMinterAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/IncreaseMinterAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IncreaseMinterAllowance(ctx, req.(*MsgIncreaseMinterAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DecreaseMinterAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDecreaseMinterAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DecreaseMinterAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/DecreaseMinterAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DecreaseMinterAllowance(ctx, req.(*MsgDecreaseMinterAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddMinterRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMinterRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddMinterRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/AddMinterRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMinterRecipient(ctx, req.(*MsgAddMinterRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveMinterRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMinterRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMinterRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/RemoveMinterRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMinterRecipient(ctx, req.(*MsgRemoveMinterRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddPauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddPauser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddPauser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/AddPauser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddPauser(ctx, req.(*MsgAddPauser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemovePauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemovePauser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemovePauser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/RemovePauser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemovePauser(ctx, req.(*MsgRemovePauser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddBlockedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddBlockedChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddBlockedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/AddBlockedChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddBlockedChannel(ctx, req.(*MsgAddBlockedChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveBlockedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveBlockedChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveBlockedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/RemoveBlockedChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveBlockedChannel(ctx, req.(*MsgRemoveBlockedChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddPauseExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddPauseExemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddPauseExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/AddPauseExemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddPauseExemption(ctx, req.(*MsgAddPauseExemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemovePauseExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemovePauseExemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemovePauseExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/RemovePauseExemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemovePauseExemption(ctx, req.(*MsgRemovePauseExemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetChannelMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChannelMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetChannelMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/SetChannelMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChannelMode(ctx, req.(*MsgSetChannelMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAllowedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAllowedChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAllowedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/AddAllowedChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAllowedChannel(ctx, req.(*MsgAddAllowedChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAllowedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAllowedChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAllowedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/RemoveAllowedChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAllowedChannel(ctx, req.(*MsgRemoveAllowedChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/SetRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRateLimit(ctx, req.(*MsgSetRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/RemoveRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRateLimit(ctx, req.(*MsgRemoveRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddPriceSetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddPriceSetter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddPriceSetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/AddPriceSetter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddPriceSetter(ctx, req.(*MsgAddPriceSetter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemovePriceSetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemovePriceSetter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemovePriceSetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/RemovePriceSetter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemovePriceSetter(ctx, req.(*MsgRemovePriceSetter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPriceRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPriceRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPriceRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/SetPriceRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPriceRange(ctx, req.(*MsgSetPriceRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMaxPriceDeviation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaxPriceDeviation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaxPriceDeviation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/SetMaxPriceDeviation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaxPriceDeviation(ctx, req.(*MsgSetMaxPriceDeviation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Wrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWrap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Wrap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/Wrap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Wrap(ctx, req.(*MsgWrap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unwrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnwrap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unwrap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/Unwrap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unwrap(ctx, req.(*MsgUnwrap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferRebasing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferRebasing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferRebasing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/TransferRebasing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferRebasing(ctx, req.(*MsgTransferRebasing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetStablecoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetStablecoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetStablecoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/SetStablecoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetStablecoin(ctx, req.(*MsgSetStablecoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/RequestSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestSubscription(ctx, req.(*MsgRequestSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FulfillSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/FulfillSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillSubscription(ctx, req.(*MsgFulfillSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/RejectSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectSubscription(ctx, req.(*MsgRejectSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/RequestRedemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestRedemption(ctx, req.(*MsgRequestRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/CancelRedemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRedemption(ctx, req.(*MsgCancelRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SettleRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSettleRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SettleRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/SettleRedemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SettleRedemption(ctx, req.(*MsgSettleRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/RejectRedemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectRedemption(ctx, req.(*MsgRejectRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Swap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/Swap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Swap(ctx, req.(*MsgSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSwapConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSwapConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSwapConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/SetSwapConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSwapConfig(ctx, req.(*MsgSetSwapConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundSwapFacility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundSwapFacility)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundSwapFacility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/FundSwapFacility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundSwapFacility(ctx, req.(*MsgFundSwapFacility))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawSwapFacility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawSwapFacility)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawSwapFacility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/WithdrawSwapFacility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawSwapFacility(ctx, req.(*MsgWithdrawSwapFacility))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseSwaps)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/PauseSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseSwaps(ctx, req.(*MsgPauseSwaps))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpauseSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseSwaps)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/UnpauseSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseSwaps(ctx, req.(*MsgUnpauseSwaps))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetLockupPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetLockupPeriod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetLockupPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/SetLockupPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetLockupPeriod(ctx, req.(*MsgSetLockupPeriod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBlocklistBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBlocklistBinding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBlocklistBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/SetBlocklistBinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBlocklistBinding(ctx, req.(*MsgSetBlocklistBinding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/SetDenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAttestor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAttestor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAttestor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/AddAttestor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAttestor(ctx, req.(*MsgAddAttestor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAttestor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAttestor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAttestor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/RemoveAttestor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAttestor(ctx, req.(*MsgRemoveAttestor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PostAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPostAttestation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PostAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/PostAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PostAttestation(ctx, req.(*MsgPostAttestation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetReserveConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReserveConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetReserveConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/SetReserveConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetReserveConfig(ctx, req.(*MsgSetReserveConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddRecoverer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddRecoverer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddRecoverer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/AddRecoverer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddRecoverer(ctx, req.(*MsgAddRecoverer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRecoverer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRecoverer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRecoverer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/RemoveRecoverer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRecoverer(ctx, req.(*MsgRemoveRecoverer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aura.v1.Msg/ForceTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceTransfer(ctx, req.(*MsgForceTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "aura.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Burn",
+			Handler:    _Msg_Burn_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _Msg_Mint_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Msg_Pause_Handler,
+		},
+		{
+			MethodName: "Unpause",
+			Handler:    _Msg_Unpause_Handler,
+		},
+		{
+			MethodName: "SchedulePause",
+			Handler:    _Msg_SchedulePause_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _Msg_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptOwnership",
+			Handler:    _Msg_AcceptOwnership_Handler,
+		},
+		{
+			MethodName: "ForceOwnership",
+			Handler:    _Msg_ForceOwnership_Handler,
+		},
+		{
+			MethodName: "AddBurner",
+			Handler:    _Msg_AddBurner_Handler,
+		},
+		{
+			MethodName: "RemoveBurner",
+			Handler:    _Msg_RemoveBurner_Handler,
+		},
+		{
+			MethodName: "SetBurnerAllowance",
+			Handler:    _Msg_SetBurnerAllowance_Handler,
+		},
+		{
+			MethodName: "IncreaseBurnerAllowance",
+			Handler:    _Msg_IncreaseBurnerAllowance_Handler,
+		},
+		{
+			MethodName: "DecreaseBurnerAllowance",
+			Handler:    _Msg_DecreaseBurnerAllowance_Handler,
+		},
+		{
+			MethodName: "AddBurnerSource",
+			Handler:    _Msg_AddBurnerSource_Handler,
+		},
+		{
+			MethodName: "RemoveBurnerSource",
+			Handler:    _Msg_RemoveBurnerSource_Handler,
+		},
+		{
+			MethodName: "AddMinter",
+			Handler:    _Msg_AddMinter_Handler,
+		},
+		{
+			MethodName: "RemoveMinter",
+			Handler:    _Msg_RemoveMinter_Handler,
+		},
+		{
+			MethodName: "SetMinterAllowance",
+			Handler:    _Msg_SetMinterAllowance_Handler,
+		},
+		{
+			MethodName: "IncreaseMinterAllowance",
+			Handler:    _Msg_IncreaseMinterAllowance_Handler,
+		},
+		{
+			MethodName: "DecreaseMinterAllowance",
+			Handler:    _Msg_DecreaseMinterAllowance_Handler,
+		},
+		{
+			MethodName: "AddMinterRecipient",
+			Handler:    _Msg_AddMinterRecipient_Handler,
+		},
+		{
+			MethodName: "RemoveMinterRecipient",
+			Handler:    _Msg_RemoveMinterRecipient_Handler,
+		},
+		{
+			MethodName: "AddPauser",
+			Handler:    _Msg_AddPauser_Handler,
+		},
+		{
+			MethodName: "RemovePauser",
+			Handler:    _Msg_RemovePauser_Handler,
+		},
+		{
+			MethodName: "AddBlockedChannel",
+			Handler:    _Msg_AddBlockedChannel_Handler,
+		},
+		{
+			MethodName: "RemoveBlockedChannel",
+			Handler:    _Msg_RemoveBlockedChannel_Handler,
+		},
+		{
+			MethodName: "AddPauseExemption",
+			Handler:    _Msg_AddPauseExemption_Handler,
+		},
+		{
+			MethodName: "RemovePauseExemption",
+			Handler:    _Msg_RemovePauseExemption_Handler,
+		},
+		{
+			MethodName: "SetChannelMode",
+			Handler:    _Msg_SetChannelMode_Handler,
+		},
+		{
+			MethodName: "AddAllowedChannel",
+			Handler:    _Msg_AddAllowedChannel_Handler,
+		},
+		{
+			MethodName: "RemoveAllowedChannel",
+			Handler:    _Msg_RemoveAllowedChannel_Handler,
+		},
+		{
+			MethodName: "SetRateLimit",
+			Handler:    _Msg_SetRateLimit_Handler,
+		},
+		{
+			MethodName: "RemoveRateLimit",
+			Handler:    _Msg_RemoveRateLimit_Handler,
+		},
+		{
+			MethodName: "AddPriceSetter",
+			Handler:    _Msg_AddPriceSetter_Handler,
+		},
+		{
+			MethodName: "RemovePriceSetter",
+			Handler:    _Msg_RemovePriceSetter_Handler,
+		},
+		{
+			MethodName: "SetPriceRange",
+			Handler:    _Msg_SetPriceRange_Handler,
+		},
+		{
+			MethodName: "SetMaxPriceDeviation",
+			Handler:    _Msg_SetMaxPriceDeviation_Handler,
+		},
+		{
+			MethodName: "Wrap",
+			Handler:    _Msg_Wrap_Handler,
+		},
+		{
+			MethodName: "Unwrap",
+			Handler:    _Msg_Unwrap_Handler,
+		},
+		{
+			MethodName: "TransferRebasing",
+			Handler:    _Msg_TransferRebasing_Handler,
+		},
+		{
+			MethodName: "SetStablecoin",
+			Handler:    _Msg_SetStablecoin_Handler,
+		},
+		{
+			MethodName: "RequestSubscription",
+			Handler:    _Msg_RequestSubscription_Handler,
+		},
+		{
+			MethodName: "FulfillSubscription",
+			Handler:    _Msg_FulfillSubscription_Handler,
+		},
+		{
+			MethodName: "RejectSubscription",
+			Handler:    _Msg_RejectSubscription_Handler,
+		},
+		{
+			MethodName: "RequestRedemption",
+			Handler:    _Msg_RequestRedemption_Handler,
+		},
+		{
+			MethodName: "CancelRedemption",
+			Handler:    _Msg_CancelRedemption_Handler,
+		},
+		{
+			MethodName: "SettleRedemption",
+			Handler:    _Msg_SettleRedemption_Handler,
+		},
+		{
+			MethodName: "RejectRedemption",
+			Handler:    _Msg_RejectRedemption_Handler,
+		},
+		{
+			MethodName: "Swap",
+			Handler:    _Msg_Swap_Handler,
+		},
+		{
+			MethodName: "SetSwapConfig",
+			Handler:    _Msg_SetSwapConfig_Handler,
+		},
+		{
+			MethodName: "FundSwapFacility",
+			Handler:    _Msg_FundSwapFacility_Handler,
+		},
+		{
+			MethodName: "WithdrawSwapFacility",
+			Handler:    _Msg_WithdrawSwapFacility_Handler,
+		},
+		{
+			MethodName: "PauseSwaps",
+			Handler:    _Msg_PauseSwaps_Handler,
+		},
+		{
+			MethodName: "UnpauseSwaps",
+			Handler:    _Msg_UnpauseSwaps_Handler,
+		},
+		{
+			MethodName: "SetLockupPeriod",
+			Handler:    _Msg_SetLockupPeriod_Handler,
+		},
+		{
+			MethodName: "SetBlocklistBinding",
+			Handler:    _Msg_SetBlocklistBinding_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadata",
+			Handler:    _Msg_SetDenomMetadata_Handler,
+		},
+		{
+			MethodName: "AddAttestor",
+			Handler:    _Msg_AddAttestor_Handler,
+		},
+		{
+			MethodName: "RemoveAttestor",
+			Handler:    _Msg_RemoveAttestor_Handler,
+		},
+		{
+			MethodName: "PostAttestation",
+			Handler:    _Msg_PostAttestation_Handler,
+		},
+		{
+			MethodName: "SetReserveConfig",
+			Handler:    _Msg_SetReserveConfig_Handler,
+		},
+		{
+			MethodName: "AddRecoverer",
+			Handler:    _Msg_AddRecoverer_Handler,
+		},
+		{
+			MethodName: "RemoveRecoverer",
+			Handler:    _Msg_RemoveRecoverer_Handler,
+		},
+		{
+			MethodName: "ForceTransfer",
+			Handler:    _Msg_ForceTransfer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "aura/v1/tx.proto",
+}
+
+func (m *MsgBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.EndHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Scope != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Scope))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Scope != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Scope))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSchedulePause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSchedulePause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSchedulePause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if m.EndHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Scope != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Scope))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSchedulePauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSchedulePauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSchedulePauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlocklistOwner) > 0 {
+		i -= len(m.BlocklistOwner)
+		copy(dAtA[i:], m.BlocklistOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlocklistOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddBurner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBurner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBurner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Burner) > 0 {
+		i -= len(m.Burner)
+		copy(dAtA[i:], m.Burner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Burner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddBurnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBurnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBurnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBurner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBurner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBurner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Burner) > 0 {
+		i -= len(m.Burner)
+		copy(dAtA[i:], m.Burner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Burner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBurnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBurnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBurnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBurnerAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBurnerAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBurnerAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClearExpiry {
+		i--
+		if m.ClearExpiry {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Burner) > 0 {
+		i -= len(m.Burner)
+		copy(dAtA[i:], m.Burner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Burner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBurnerAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBurnerAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBurnerAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIncreaseBurnerAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIncreaseBurnerAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIncreaseBurnerAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Burner) > 0 {
+		i -= len(m.Burner)
+		copy(dAtA[i:], m.Burner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Burner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIncreaseBurnerAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIncreaseBurnerAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIncreaseBurnerAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDecreaseBurnerAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDecreaseBurnerAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDecreaseBurnerAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Burner) > 0 {
+		i -= len(m.Burner)
+		copy(dAtA[i:], m.Burner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Burner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDecreaseBurnerAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDecreaseBurnerAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDecreaseBurnerAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddBurnerSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBurnerSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBurnerSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Burner) > 0 {
+		i -= len(m.Burner)
+		copy(dAtA[i:], m.Burner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Burner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddBurnerSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBurnerSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBurnerSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBurnerSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBurnerSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBurnerSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Burner) > 0 {
+		i -= len(m.Burner)
+		copy(dAtA[i:], m.Burner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Burner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBurnerSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBurnerSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBurnerSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMinter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMinter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMinter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMinter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMinter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMinter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMinterAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMinterAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMinterAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClearExpiry {
+		i--
+		if m.ClearExpiry {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMinterAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMinterAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMinterAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIncreaseMinterAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIncreaseMinterAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIncreaseMinterAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIncreaseMinterAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIncreaseMinterAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIncreaseMinterAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDecreaseMinterAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDecreaseMinterAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDecreaseMinterAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDecreaseMinterAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDecreaseMinterAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDecreaseMinterAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMinterRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMinterRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMinterRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMinterRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMinterRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMinterRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMinterRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMinterRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMinterRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMinterRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMinterRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMinterRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddPauser) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddPauser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddPauser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Pauser) > 0 {
+		i -= len(m.Pauser)
+		copy(dAtA[i:], m.Pauser)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Pauser)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddPauserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddPauserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddPauserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePauser) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePauser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePauser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Pauser) > 0 {
+		i -= len(m.Pauser)
+		copy(dAtA[i:], m.Pauser)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Pauser)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePauserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePauserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePauserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddBlockedChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBlockedChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBlockedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Direction != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddBlockedChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBlockedChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBlockedChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBlockedChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBlockedChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBlockedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBlockedChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBlockedChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBlockedChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddPauseExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddPauseExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddPauseExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Direction != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddPauseExemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddPauseExemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddPauseExemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePauseExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePauseExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePauseExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePauseExemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePauseExemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePauseExemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetChannelMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChannelMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChannelMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetChannelModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChannelModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChannelModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAllowedChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAllowedChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAllowedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAllowedChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAllowedChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAllowedChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAllowedChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAllowedChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAllowedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAllowedChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAllowedChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAllowedChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPercentage.Size()
+		i -= size
+		if _, err := m.MaxPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTx(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddPriceSetter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddPriceSetter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddPriceSetter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceSetter) > 0 {
+		i -= len(m.PriceSetter)
+		copy(dAtA[i:], m.PriceSetter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PriceSetter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddPriceSetterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddPriceSetterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddPriceSetterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePriceSetter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePriceSetter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePriceSetter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceSetter) > 0 {
+		i -= len(m.PriceSetter)
+		copy(dAtA[i:], m.PriceSetter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PriceSetter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePriceSetterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePriceSetterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePriceSetterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPriceRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPriceRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPriceRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StartPrice.Size()
+		i -= size
+		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DailyRate.Size()
+		i -= size
+		if _, err := m.DailyRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPriceRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPriceRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPriceRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMaxPriceDeviation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMaxPriceDeviation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaxPriceDeviation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMaxPriceDeviationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMaxPriceDeviationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaxPriceDeviationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWrap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWrap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWrap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWrapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWrapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWrapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnwrap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnwrap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnwrap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnwrapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnwrapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnwrapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferRebasing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferRebasing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferRebasing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferRebasingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferRebasingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferRebasingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetStablecoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetStablecoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetStablecoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exponent != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetStablecoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetStablecoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetStablecoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestRedemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestRedemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRedemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRedemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleRedemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleRedemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Payout.Size()
+		i -= size
+		if _, err := m.Payout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectRedemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectRedemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinAmountOut.Size()
+		i -= size
+		if _, err := m.MinAmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AmountOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSwapConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSwapConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSwapConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DailyLimit.Size()
+		i -= size
+		if _, err := m.DailyLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSwapConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSwapConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSwapConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundSwapFacility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundSwapFacility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundSwapFacility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundSwapFacilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundSwapFacilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundSwapFacilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSwapFacility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSwapFacility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSwapFacility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSwapFacilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSwapFacilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSwapFacilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseSwaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseSwaps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseSwaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseSwapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseSwapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseSwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseSwaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseSwaps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseSwaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseSwapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseSwapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseSwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLockupPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLockupPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLockupPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LockupPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockupPeriod):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTx(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLockupPeriodResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLockupPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLockupPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBlocklistBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBlocklistBinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBlocklistBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bound {
+		i--
+		if m.Bound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBlocklistBindingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBlocklistBindingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBlocklistBindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAttestor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAttestor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAttestor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestor) > 0 {
+		i -= len(m.Attestor)
+		copy(dAtA[i:], m.Attestor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Attestor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAttestorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAttestorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAttestorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAttestor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAttestor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAttestor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestor) > 0 {
+		i -= len(m.Attestor)
+		copy(dAtA[i:], m.Attestor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Attestor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAttestorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAttestorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAttestorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPostAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPostAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPostAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReportHash) > 0 {
+		i -= len(m.ReportHash)
+		copy(dAtA[i:], m.ReportHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReportHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AsOf, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AsOf):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTx(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPostAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPostAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPostAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReserveConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReserveConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReserveConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxStaleness, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxStaleness):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintTx(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CollateralRatio.Size()
+		i -= size
+		if _, err := m.CollateralRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReserveConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReserveConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReserveConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddRecoverer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddRecoverer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddRecoverer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recoverer) > 0 {
+		i -= len(m.Recoverer)
+		copy(dAtA[i:], m.Recoverer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recoverer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddRecovererResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddRecovererResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddRecovererResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRecoverer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRecoverer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRecoverer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recoverer) > 0 {
+		i -= len(m.Recoverer)
+		copy(dAtA[i:], m.Recoverer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recoverer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRecovererResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRecovererResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRecovererResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CaseReference) > 0 {
+		i -= len(m.CaseReference)
+		copy(dAtA[i:], m.CaseReference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CaseReference)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Scope != 0 {
+		n += 1 + sovTx(uint64(m.Scope))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTx(uint64(m.EndHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Scope != 0 {
+		n += 1 + sovTx(uint64(m.Scope))
+	}
+	return n
+}
+
+func (m *MsgUnpauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSchedulePause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Scope != 0 {
+		n += 1 + sovTx(uint64(m.Scope))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.EndHeight != 0 {
+		n += 1 + sovTx(uint64(m.EndHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSchedulePauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgForceOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlocklistOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgForceOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddBurner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Burner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddBurnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveBurner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Burner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveBurnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBurnerAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Burner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovTx(uint64(l))
+	if m.ClearExpiry {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetBurnerAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgIncreaseBurnerAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Burner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgIncreaseBurnerAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDecreaseBurnerAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Burner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDecreaseBurnerAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddBurnerSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Burner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddBurnerSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveBurnerSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Burner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveBurnerSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddMinter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveMinter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMinterAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovTx(uint64(l))
+	if m.ClearExpiry {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetMinterAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgIncreaseMinterAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgIncreaseMinterAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDecreaseMinterAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDecreaseMinterAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddMinterRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddMinterRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveMinterRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveMinterRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddPauser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Pauser)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddPauserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemovePauser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Pauser)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemovePauserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddBlockedChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovTx(uint64(m.Direction))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddBlockedChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveBlockedChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveBlockedChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddPauseExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovTx(uint64(m.Direction))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddPauseExemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemovePauseExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemovePauseExemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetChannelMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *MsgSetChannelModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAllowedChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAllowedChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAllowedChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAllowedChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxPercentage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddPriceSetter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PriceSetter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddPriceSetterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemovePriceSetter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PriceSetter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemovePriceSetterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPriceRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.DailyRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.StartPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetPriceRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMaxPriceDeviation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxPriceDeviation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetMaxPriceDeviationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWrap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWrapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnwrap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnwrapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferRebasing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferRebasingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetStablecoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovTx(uint64(m.Exponent))
+	}
+	return n
+}
+
+func (m *MsgSetStablecoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRequestSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgFulfillSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFulfillSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRejectSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRequestRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSettleRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.Payout.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSettleRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRejectRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinAmountOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AmountOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetSwapConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.DailyLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetSwapConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundSwapFacility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundSwapFacilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawSwapFacility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawSwapFacilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseSwaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseSwapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpauseSwaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpauseSwapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetLockupPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockupPeriod)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetLockupPeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBlocklistBinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Bound {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetBlocklistBindingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAttestor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Attestor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAttestorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAttestor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Attestor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAttestorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPostAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AsOf)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ReportHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPostAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetReserveConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CollateralRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxStaleness)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetReserveConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddRecoverer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recoverer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddRecovererResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRecoverer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recoverer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRecovererResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgForceTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CaseReference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgForceTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			m.Scope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scope |= PauseScope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			m.Scope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scope |= PauseScope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSchedulePause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSchedulePause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSchedulePause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			m.Scope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scope |= PauseScope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSchedulePauseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSchedulePauseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSchedulePauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForceOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocklistOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlocklistOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForceOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBurner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBurner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBurner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBurnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBurnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBurnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveBurner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveBurner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveBurner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveBurnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveBurnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveBurnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBurnerAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBurnerAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBurnerAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearExpiry", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClearExpiry = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBurnerAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBurnerAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBurnerAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIncreaseBurnerAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIncreaseBurnerAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIncreaseBurnerAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIncreaseBurnerAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIncreaseBurnerAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIncreaseBurnerAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDecreaseBurnerAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDecreaseBurnerAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDecreaseBurnerAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDecreaseBurnerAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDecreaseBurnerAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDecreaseBurnerAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBurnerSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBurnerSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBurnerSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBurnerSourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBurnerSourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBurnerSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveBurnerSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveBurnerSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveBurnerSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveBurnerSourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveBurnerSourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveBurnerSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMinter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMinter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMinter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMinter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMinter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMinter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMinterAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMinterAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMinterAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearExpiry", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClearExpiry = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMinterAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMinterAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMinterAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIncreaseMinterAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIncreaseMinterAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIncreaseMinterAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIncreaseMinterAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIncreaseMinterAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIncreaseMinterAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDecreaseMinterAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDecreaseMinterAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDecreaseMinterAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDecreaseMinterAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDecreaseMinterAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDecreaseMinterAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMinterRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMinterRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMinterRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMinterRecipientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMinterRecipientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMinterRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMinterRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMinterRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMinterRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMinterRecipientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMinterRecipientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMinterRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddPauser) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddPauser: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddPauser: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pauser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddPauserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddPauserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddPauserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemovePauser) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemovePauser: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemovePauser: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pauser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemovePauserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemovePauserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemovePauserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBlockedChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBlockedChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBlockedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= ChannelDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBlockedChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBlockedChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBlockedChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveBlockedChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveBlockedChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveBlockedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveBlockedChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveBlockedChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveBlockedChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddPauseExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddPauseExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddPauseExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= ExemptionDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddPauseExemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddPauseExemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddPauseExemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemovePauseExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemovePauseExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemovePauseExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemovePauseExemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemovePauseExemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemovePauseExemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetChannelMode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetChannelMode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetChannelMode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= ChannelMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetChannelModeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetChannelModeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetChannelModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAllowedChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAllowedChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAllowedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAllowedChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAllowedChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAllowedChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAllowedChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAllowedChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAllowedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAllowedChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAllowedChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAllowedChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddPriceSetter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddPriceSetter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddPriceSetter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSetter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSetter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddPriceSetterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddPriceSetterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddPriceSetterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemovePriceSetter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemovePriceSetter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemovePriceSetter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSetter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSetter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemovePriceSetterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemovePriceSetterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemovePriceSetterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPriceRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPriceRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPriceRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DailyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPriceRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPriceRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPriceRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMaxPriceDeviation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMaxPriceDeviation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMaxPriceDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMaxPriceDeviationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMaxPriceDeviationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMaxPriceDeviationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWrap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWrap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWrap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWrapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWrapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWrapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnwrap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnwrap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnwrap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnwrapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnwrapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnwrapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferRebasing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferRebasing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferRebasing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferRebasingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferRebasingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferRebasingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetStablecoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetStablecoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetStablecoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetStablecoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetStablecoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetStablecoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestSubscriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestSubscriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillSubscriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillSubscriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectSubscriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectSubscriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestRedemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestRedemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestRedemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRedemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRedemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRedemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRedemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSettleRedemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSettleRedemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSettleRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSettleRedemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSettleRedemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSettleRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectRedemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectRedemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectRedemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectRedemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRemoveBlockedChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3402,10 +23812,10 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3442,9 +23852,9 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3454,27 +23864,28 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmountOut", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3502,7 +23913,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3527,7 +23938,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3550,12 +23961,45 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3577,7 +24021,7 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMint) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSwapConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3600,10 +24044,10 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSwapConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSwapConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3640,7 +24084,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3668,11 +24112,13 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3700,7 +24146,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DailyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3725,7 +24171,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSwapConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3748,10 +24194,10 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSwapConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSwapConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3775,7 +24221,7 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPause) Unmarshal(dAtA []byte) error {
+func (m *MsgFundSwapFacility) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3798,10 +24244,10 @@ func (m *MsgPause) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPause: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundSwapFacility: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPause: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundSwapFacility: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3836,6 +24282,40 @@ func (m *MsgPause) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3857,7 +24337,7 @@ func (m *MsgPause) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPauseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundSwapFacilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3880,10 +24360,10 @@ func (m *MsgPauseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPauseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundSwapFacilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundSwapFacilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3907,7 +24387,7 @@ func (m *MsgPauseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnpause) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawSwapFacility) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3930,10 +24410,10 @@ func (m *MsgUnpause) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnpause: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawSwapFacility: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnpause: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawSwapFacility: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3968,6 +24448,40 @@ func (m *MsgUnpause) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3989,7 +24503,7 @@ func (m *MsgUnpause) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnpauseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawSwapFacilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4012,10 +24526,10 @@ func (m *MsgUnpauseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnpauseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawSwapFacilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnpauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawSwapFacilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4039,7 +24553,7 @@ func (m *MsgUnpauseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseSwaps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4062,10 +24576,10 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseSwaps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseSwaps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4095,14 +24609,114 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseSwapsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseSwapsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseSwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseSwaps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseSwaps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseSwaps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4130,7 +24744,7 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4153,7 +24767,7 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpauseSwapsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4176,10 +24790,10 @@ func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpauseSwapsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpauseSwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4203,7 +24817,7 @@ func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcceptOwnership) Unmarshal(dAtA []byte) error {
+func (m *MsgSetLockupPeriod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4226,10 +24840,10 @@ func (m *MsgAcceptOwnership) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcceptOwnership: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetLockupPeriod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcceptOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetLockupPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4264,6 +24878,39 @@ func (m *MsgAcceptOwnership) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LockupPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4285,7 +24932,7 @@ func (m *MsgAcceptOwnership) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcceptOwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetLockupPeriodResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4308,10 +24955,10 @@ func (m *MsgAcceptOwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcceptOwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetLockupPeriodResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcceptOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetLockupPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4335,7 +24982,7 @@ func (m *MsgAcceptOwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddBurner) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBlocklistBinding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4358,10 +25005,10 @@ func (m *MsgAddBurner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddBurner: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBlocklistBinding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddBurner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBlocklistBinding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4398,7 +25045,7 @@ func (m *MsgAddBurner) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4426,13 +25073,13 @@ func (m *MsgAddBurner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Burner = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bound", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4442,26 +25089,12 @@ func (m *MsgAddBurner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Bound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4483,7 +25116,7 @@ func (m *MsgAddBurner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddBurnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBlocklistBindingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4506,10 +25139,10 @@ func (m *MsgAddBurnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddBurnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBlocklistBindingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddBurnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBlocklistBindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4533,7 +25166,7 @@ func (m *MsgAddBurnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveBurner) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4556,10 +25189,10 @@ func (m *MsgRemoveBurner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveBurner: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveBurner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4596,9 +25229,9 @@ func (m *MsgRemoveBurner) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4608,23 +25241,24 @@ func (m *MsgRemoveBurner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Burner = string(dAtA[iNdEx:postIndex])
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4647,7 +25281,7 @@ func (m *MsgRemoveBurner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveBurnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4670,10 +25304,10 @@ func (m *MsgRemoveBurnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveBurnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveBurnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4697,7 +25331,7 @@ func (m *MsgRemoveBurnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBurnerAllowance) Unmarshal(dAtA []byte) error {
+func (m *MsgAddAttestor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4720,10 +25354,10 @@ func (m *MsgSetBurnerAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBurnerAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddAttestor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBurnerAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddAttestor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4760,39 +25394,7 @@ func (m *MsgSetBurnerAllowance) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Burner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4820,9 +25422,7 @@ func (m *MsgSetBurnerAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Attestor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4845,7 +25445,7 @@ func (m *MsgSetBurnerAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBurnerAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddAttestorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4868,10 +25468,10 @@ func (m *MsgSetBurnerAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBurnerAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddAttestorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBurnerAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddAttestorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4895,7 +25495,7 @@ func (m *MsgSetBurnerAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMinter) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAttestor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4918,10 +25518,10 @@ func (m *MsgAddMinter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMinter: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAttestor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMinter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAttestor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4958,39 +25558,7 @@ func (m *MsgAddMinter) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Minter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5018,9 +25586,7 @@ func (m *MsgAddMinter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Attestor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5043,7 +25609,7 @@ func (m *MsgAddMinter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMinterResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAttestorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5066,10 +25632,10 @@ func (m *MsgAddMinterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMinterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAttestorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAttestorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5093,7 +25659,7 @@ func (m *MsgAddMinterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMinter) Unmarshal(dAtA []byte) error {
+func (m *MsgPostAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5116,10 +25682,10 @@ func (m *MsgRemoveMinter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMinter: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPostAttestation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMinter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPostAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5156,7 +25722,74 @@ func (m *MsgRemoveMinter) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsOf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AsOf, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5184,7 +25817,7 @@ func (m *MsgRemoveMinter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Minter = string(dAtA[iNdEx:postIndex])
+			m.ReportHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5207,7 +25840,7 @@ func (m *MsgRemoveMinter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMinterResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPostAttestationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5230,10 +25863,10 @@ func (m *MsgRemoveMinterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMinterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPostAttestationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPostAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5257,7 +25890,7 @@ func (m *MsgRemoveMinterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMinterAllowance) Unmarshal(dAtA []byte) error {
+func (m *MsgSetReserveConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5280,10 +25913,10 @@ func (m *MsgSetMinterAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMinterAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetReserveConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMinterAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetReserveConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5320,7 +25953,7 @@ func (m *MsgSetMinterAllowance) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5348,13 +25981,15 @@ func (m *MsgSetMinterAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Minter = string(dAtA[iNdEx:postIndex])
+			if err := m.CollateralRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5364,23 +25999,22 @@ func (m *MsgSetMinterAllowance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxStaleness, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5405,7 +26039,7 @@ func (m *MsgSetMinterAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMinterAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetReserveConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5428,10 +26062,10 @@ func (m *MsgSetMinterAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMinterAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetReserveConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMinterAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetReserveConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5455,7 +26089,7 @@ func (m *MsgSetMinterAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddPauser) Unmarshal(dAtA []byte) error {
+func (m *MsgAddRecoverer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5478,10 +26112,10 @@ func (m *MsgAddPauser) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddPauser: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddRecoverer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddPauser: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddRecoverer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5518,7 +26152,7 @@ func (m *MsgAddPauser) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recoverer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5546,7 +26180,39 @@ func (m *MsgAddPauser) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pauser = string(dAtA[iNdEx:postIndex])
+			m.Recoverer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5569,7 +26235,7 @@ func (m *MsgAddPauser) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddPauserResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddRecovererResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5592,10 +26258,10 @@ func (m *MsgAddPauserResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddPauserResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddRecovererResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddPauserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddRecovererResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5619,7 +26285,7 @@ func (m *MsgAddPauserResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemovePauser) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRecoverer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5642,10 +26308,10 @@ func (m *MsgRemovePauser) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemovePauser: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRecoverer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemovePauser: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRecoverer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5682,7 +26348,7 @@ func (m *MsgRemovePauser) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recoverer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5710,7 +26376,39 @@ func (m *MsgRemovePauser) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pauser = string(dAtA[iNdEx:postIndex])
+			m.Recoverer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5733,7 +26431,7 @@ func (m *MsgRemovePauser) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemovePauserResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRecovererResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5756,10 +26454,10 @@ func (m *MsgRemovePauserResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemovePauserResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRecovererResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemovePauserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRecovererResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5783,7 +26481,7 @@ func (m *MsgRemovePauserResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddBlockedChannel) Unmarshal(dAtA []byte) error {
+func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5806,10 +26504,10 @@ func (m *MsgAddBlockedChannel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddBlockedChannel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddBlockedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5846,7 +26544,7 @@ func (m *MsgAddBlockedChannel) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5874,111 +26572,75 @@ func (m *MsgAddBlockedChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddBlockedChannelResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddBlockedChannelResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddBlockedChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRemoveBlockedChannel) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveBlockedChannel: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveBlockedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6006,11 +26668,13 @@ func (m *MsgRemoveBlockedChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CaseReference", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6038,7 +26702,7 @@ func (m *MsgRemoveBlockedChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			m.CaseReference = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6061,7 +26725,7 @@ func (m *MsgRemoveBlockedChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveBlockedChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6084,10 +26748,10 @@ func (m *MsgRemoveBlockedChannelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveBlockedChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveBlockedChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2,14 +2,21 @@ package mocks
 
 import (
 	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ondoprotocol/usdy-noble/v2/keeper"
 	"github.com/ondoprotocol/usdy-noble/v2/types"
 )
 
+// Authority is the governance module account, used as the authority of the
+// module in tests.
+const Authority = "noble10d07y265gmmuvt4z0w9aw880jnsr700jjpxdwa"
+
 func AuraKeeper() (*keeper.Keeper, sdk.Context) {
 	return AuraKeeperWithBank(BankKeeper{
 		Restriction: NoOpSendRestrictionFn,
@@ -22,14 +29,27 @@ func AuraKeeperWithBank(bank BankKeeper) (*keeper.Keeper, sdk.Context) {
 
 	k := keeper.NewKeeper(
 		"ausdy",
+		[]string{"atbill"},
+		Authority,
 		runtime.NewKVStoreService(key),
 		runtime.ProvideEventService(),
+		Codec(),
 		address.NewBech32Codec("noble"),
 		nil,
 	)
 
+	if bank.Balances == nil {
+		bank.Balances = make(map[string]sdk.Coins)
+	}
+	if bank.Metadata == nil {
+		bank.Metadata = make(map[string]banktypes.Metadata)
+	}
 	bank = bank.WithSendCoinsRestriction(k.SendRestrictionFn)
 	k.SetBankKeeper(bank)
 
 	return k, testutil.DefaultContext(key, tkey)
 }
+
+func Codec() codec.Codec {
+	return codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 
 	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ondoprotocol/usdy-noble/v2/types"
 )
 
@@ -14,6 +16,7 @@ var _ types.BankKeeper = BankKeeper{}
 
 type BankKeeper struct {
 	Balances    map[string]sdk.Coins
+	Metadata    map[string]banktypes.Metadata
 	Restriction SendRestrictionFn
 }
 
@@ -31,6 +34,24 @@ func (k BankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coin
 	return nil
 }
 
+func (k BankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, k.Balances[addr.String()].AmountOf(denom))
+}
+
+func (k BankKeeper) GetDenomMetaData(_ context.Context, denom string) (banktypes.Metadata, bool) {
+	metadata, found := k.Metadata[denom]
+	return metadata, found
+}
+
+func (k BankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	supply := sdk.NewCoin(denom, math.ZeroInt())
+	for _, balance := range k.Balances {
+		supply = supply.AddAmount(balance.AmountOf(denom))
+	}
+
+	return supply
+}
+
 func (k BankKeeper) MintCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
 	address := authtypes.NewModuleAddress(moduleName).String()
 	k.Balances[address] = k.Balances[address].Add(amt...)
@@ -50,6 +71,10 @@ func (k BankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModu
 	return k.SendCoins(ctx, senderAddr, recipientAddr, amt)
 }
 
+func (k BankKeeper) SetDenomMetaData(_ context.Context, denomMetaData banktypes.Metadata) {
+	k.Metadata[denomMetaData.Base] = denomMetaData
+}
+
 //
 
 type SendRestrictionFn func(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
@@ -0,0 +1,16 @@
+package mocks
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ondoprotocol/usdy-noble/v2/types"
+)
+
+var _ types.ChannelKeeper = ChannelKeeper{}
+
+type ChannelKeeper struct {
+	NextSequence uint64
+}
+
+func (k ChannelKeeper) GetNextChannelSequence(_ sdk.Context) uint64 {
+	return k.NextSequence
+}